@@ -13,6 +13,12 @@ var ErrInvalidJSONRequest = errors.New("invalid json request")
 // ErrCouldNotGetAccount signals that a requested account could not be retrieved
 var ErrCouldNotGetAccount = errors.New("could not get requested account")
 
+// ErrCouldNotGetAccounts signals that the requested accounts could not be retrieved
+var ErrCouldNotGetAccounts = errors.New("could not get requested accounts")
+
+// ErrTooManyAddresses signals that too many addresses were provided in a single request
+var ErrTooManyAddresses = errors.New("too many addresses")
+
 // ErrGetBalance signals an error in getting the balance for an account
 var ErrGetBalance = errors.New("get balance error")
 
@@ -73,9 +79,33 @@ var ErrValidationEmptyBlockHash = errors.New("block hash is empty")
 // ErrGetTransaction signals an error happening when trying to fetch a transaction
 var ErrGetTransaction = errors.New("getting transaction failed")
 
+// ErrGetTransactionStateDiff signals an error happening when trying to fetch the state diff of a transaction
+var ErrGetTransactionStateDiff = errors.New("getting transaction state diff failed")
+
+// ErrGetTransactionTrace signals an error happening when trying to replay a transaction for its execution trace
+var ErrGetTransactionTrace = errors.New("getting transaction trace failed")
+
+// ErrScheduleTransaction signals an error happening when trying to add a transaction to the node's outbox
+var ErrScheduleTransaction = errors.New("scheduling transaction failed")
+
+// ErrCancelScheduledTransaction signals an error happening when trying to remove a transaction from the node's outbox
+var ErrCancelScheduledTransaction = errors.New("cancelling scheduled transaction failed")
+
+// ErrWaitForTransactionStatus signals an error happening when trying to wait for the status of a transaction
+var ErrWaitForTransactionStatus = errors.New("waiting for transaction status failed")
+
+// ErrInvalidTimeout signals that an invalid timeout was provided
+var ErrInvalidTimeout = errors.New("invalid timeout")
+
 // ErrGetBlock signals an error happening when trying to fetch a block
 var ErrGetBlock = errors.New("getting block failed")
 
+// ErrGetHyperblock signals an error happening when trying to fetch a hyperblock
+var ErrGetHyperblock = errors.New("getting hyperblock failed")
+
+// ErrGetBlocksRange signals an error happening when trying to stream a range of blocks
+var ErrGetBlocksRange = errors.New("getting blocks range failed")
+
 // ErrQueryError signals a general query error
 var ErrQueryError = errors.New("query error")
 
@@ -139,5 +169,53 @@ var ErrFetchingLatestNonceCannotIncludeFields = errors.New("fetching latest nonc
 // ErrFetchingNonceGapsCannotIncludeFields signals that an error happened when trying to fetch nonce gaps
 var ErrFetchingNonceGapsCannotIncludeFields = errors.New("fetching nonce gaps cannot include fields")
 
+// ErrEmptySenderToGetReplacements signals that an error happened when trying to fetch the replacements by fee
+var ErrEmptySenderToGetReplacements = errors.New("empty sender to get replacements")
+
+// ErrFetchingReplacementsCannotIncludeFields signals that an error happened when trying to fetch the replacements by fee
+var ErrFetchingReplacementsCannotIncludeFields = errors.New("fetching replacements cannot include fields")
+
+// ErrEmptySenderToGetStats signals that an error happened when trying to fetch the statistics of a sender from pool
+var ErrEmptySenderToGetStats = errors.New("empty sender to get stats")
+
+// ErrFetchingStatsCannotIncludeFields signals that an error happened when trying to fetch the statistics of a sender from pool
+var ErrFetchingStatsCannotIncludeFields = errors.New("fetching stats cannot include fields")
+
+// ErrFetchingEvictionsCannotIncludeSenderOrFields signals that an error happened when trying to fetch the eviction rounds of the pool
+var ErrFetchingEvictionsCannotIncludeSenderOrFields = errors.New("fetching evictions cannot include sender or fields")
+
+// ErrOrderingOrFilteringTheTransactionsPoolCannotIncludeSender signals that an error happened when trying to order or filter the transactions from pool
+var ErrOrderingOrFilteringTheTransactionsPoolCannotIncludeSender = errors.New("ordering or filtering the transactions pool cannot include sender")
+
+// ErrInvalidTransactionsPoolOrder signals that an invalid order of the transactions from pool has been provided
+var ErrInvalidTransactionsPoolOrder = errors.New("invalid order of the transactions from pool, allowed values: gasprice, score")
+
 // ErrInvalidFields signals that invalid fields were provided
 var ErrInvalidFields = errors.New("invalid fields")
+
+// ErrGetTransactionsByAddress signals an error in getting the transactions of an address
+var ErrGetTransactionsByAddress = errors.New("get transactions by address error")
+
+// ErrGetLogEvents signals an error in querying the log events
+var ErrGetLogEvents = errors.New("get log events error")
+
+// ErrEventsSubscriptionDisabled signals that the websocket events subscription is not enabled on the node
+var ErrEventsSubscriptionDisabled = errors.New("events subscription is disabled")
+
+// ErrJsonRpc signals an error in handling a JSON-RPC request
+var ErrJsonRpc = errors.New("json-rpc error")
+
+// ErrJsonRpcNotStarted signals that the JSON-RPC requests processor was not started
+var ErrJsonRpcNotStarted = errors.New("json-rpc requests processor not started")
+
+// ErrJsonRpcMissingParams signals that the params of a JSON-RPC request are missing
+var ErrJsonRpcMissingParams = errors.New("missing params")
+
+// ErrInvalidTLSConfig signals that the provided TLS configuration is invalid
+var ErrInvalidTLSConfig = errors.New("invalid TLS config")
+
+// ErrGetESDTSupplyHistory signals that an error occurred while trying to fetch the supply history of an ESDT token
+var ErrGetESDTSupplyHistory = errors.New("getting ESDT supply history failed")
+
+// ErrGetESDTHolders signals that an error occurred while trying to fetch the holders of an ESDT token
+var ErrGetESDTHolders = errors.New("getting ESDT holders failed")
@@ -1,6 +1,7 @@
 package gin
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"reflect"
@@ -9,6 +10,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/logs"
+	"github.com/ElrondNetwork/elrond-go/api/openapi"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
@@ -16,6 +19,16 @@ import (
 	"gopkg.in/go-playground/validator.v8"
 )
 
+const (
+	logRoute     = "/log"
+	openAPIRoute = "/openapi.json"
+)
+
+var openAPIInfo = openapi.Info{
+	Title:   "Elrond node REST API",
+	Version: "1.0.0",
+}
+
 type validatorInput struct {
 	Name      string
 	Validator validator.Func
@@ -42,18 +55,37 @@ func checkArgs(args ArgsNewWebServer) error {
 	if check.IfNil(args.Facade) {
 		return errHandler("nil facade")
 	}
+	if args.ApiConfig.Auth.Enabled && args.ApiConfig.Auth.QuotaResetIntervalInSec == 0 {
+		return errHandler("QuotaResetIntervalInSec should not be 0 when the authentication is enabled")
+	}
+
+	tlsConfig := args.ApiConfig.TLS
+	if tlsConfig.Enabled && (len(tlsConfig.CertificateFile) == 0 || len(tlsConfig.KeyFile) == 0) {
+		return errHandler("CertificateFile and KeyFile should be provided when TLS is enabled")
+	}
+	if tlsConfig.Enabled && tlsConfig.RequireClientCertificate && len(tlsConfig.ClientCAFile) == 0 {
+		return errHandler("ClientCAFile should be provided when client certificates are required")
+	}
 
 	return nil
 }
 
 func isLogRouteEnabled(routesConfig config.ApiRoutesConfig) bool {
-	logConfig, ok := routesConfig.APIPackages["log"]
+	return isRootRouteEnabled(routesConfig, "log", logRoute)
+}
+
+func isOpenAPIRouteEnabled(routesConfig config.ApiRoutesConfig) bool {
+	return isRootRouteEnabled(routesConfig, "openapi", openAPIRoute)
+}
+
+func isRootRouteEnabled(routesConfig config.ApiRoutesConfig, packageName string, route string) bool {
+	packageConfig, ok := routesConfig.APIPackages[packageName]
 	if !ok {
 		return false
 	}
 
-	for _, cfg := range logConfig.Routes {
-		if cfg.Name == "/log" && cfg.Open {
+	for _, cfg := range packageConfig.Routes {
+		if cfg.Name == route && cfg.Open {
 			return true
 		}
 	}
@@ -83,7 +115,7 @@ func registerValidators() error {
 func registerLoggerWsRoute(ws *gin.Engine, marshalizer marshal.Marshalizer) {
 	upgrader := websocket.Upgrader{}
 
-	ws.GET("/log", func(c *gin.Context) {
+	ws.GET(logRoute, func(c *gin.Context) {
 		upgrader.CheckOrigin = func(r *http.Request) bool {
 			return true
 		}
@@ -103,3 +135,24 @@ func registerLoggerWsRoute(ws *gin.Engine, marshalizer marshal.Marshalizer) {
 		ls.StartSendingBlocking()
 	})
 }
+
+func registerOpenAPIRoute(ws *gin.Engine, groups map[string]shared.GroupHandler, apiConfig config.ApiRoutesConfig) error {
+	groupsEndpoints := make([]openapi.GroupEndpoints, 0, len(groups))
+	for groupName, groupHandler := range groups {
+		groupsEndpoints = append(groupsEndpoints, openapi.GroupEndpoints{
+			Name:      groupName,
+			Endpoints: groupHandler.GetEndpoints(),
+		})
+	}
+
+	document, err := json.Marshal(openapi.NewDocument(openAPIInfo, groupsEndpoints, apiConfig))
+	if err != nil {
+		return err
+	}
+
+	ws.GET(openAPIRoute, func(c *gin.Context) {
+		c.Data(http.StatusOK, "application/json", document)
+	})
+
+	return nil
+}
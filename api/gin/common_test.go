@@ -1,12 +1,20 @@
 package gin
 
 import (
+	"encoding/json"
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/groups"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/openapi"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/facade/initial"
+	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/require"
 )
 
@@ -24,6 +32,28 @@ func TestCommon_checkArgs(t *testing.T) {
 	args.Facade = initial.NewInitialNodeFacade("api interface", false)
 	err = checkArgs(args)
 	require.NoError(t, err)
+
+	args.ApiConfig.Auth.Enabled = true
+	err = checkArgs(args)
+	require.True(t, errors.Is(err, apiErrors.ErrCannotCreateGinWebServer))
+
+	args.ApiConfig.Auth.QuotaResetIntervalInSec = 60
+	err = checkArgs(args)
+	require.NoError(t, err)
+
+	args.ApiConfig.TLS.Enabled = true
+	err = checkArgs(args)
+	require.True(t, errors.Is(err, apiErrors.ErrCannotCreateGinWebServer))
+
+	args.ApiConfig.TLS.CertificateFile = "server.crt"
+	args.ApiConfig.TLS.KeyFile = "server.key"
+	args.ApiConfig.TLS.RequireClientCertificate = true
+	err = checkArgs(args)
+	require.True(t, errors.Is(err, apiErrors.ErrCannotCreateGinWebServer))
+
+	args.ApiConfig.TLS.ClientCAFile = "ca.crt"
+	err = checkArgs(args)
+	require.NoError(t, err)
 }
 
 func TestCommon_isLogRouteEnabled(t *testing.T) {
@@ -45,3 +75,43 @@ func TestCommon_isLogRouteEnabled(t *testing.T) {
 	}
 	require.True(t, isLogRouteEnabled(routesConfig))
 }
+
+func TestCommon_registerOpenAPIRoute(t *testing.T) {
+	t.Parallel()
+
+	routesConfig := config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"openapi": {
+				Routes: []config.RouteConfig{
+					{Name: "/openapi.json", Open: true},
+				},
+			},
+			"block": {
+				Routes: []config.RouteConfig{
+					{Name: "/by-nonce/:nonce", Open: true},
+					{Name: "/by-hash/:hash", Open: false},
+				},
+			},
+		},
+	}
+	require.True(t, isOpenAPIRouteEnabled(routesConfig))
+
+	blockGroup, err := groups.NewBlockGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	ws := gin.New()
+	err = registerOpenAPIRoute(ws, map[string]shared.GroupHandler{"block": blockGroup}, routesConfig)
+	require.NoError(t, err)
+
+	req, _ := http.NewRequest(http.MethodGet, "/openapi.json", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	document := &openapi.Document{}
+	err = json.Unmarshal(resp.Body.Bytes(), document)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(document.Paths))
+	require.NotNil(t, (*document.Paths["/block/by-nonce/{nonce}"])["get"])
+	require.NotNil(t, document.Components.Schemas["api.Block"])
+}
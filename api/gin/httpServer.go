@@ -26,7 +26,13 @@ func NewHttpServer(server *http.Server) (*httpServer, error) {
 // Start will handle the starting of the gin web server. This call is blocking and it should be
 // called on a go routine (different than the main one)
 func (h *httpServer) Start() {
-	err := h.server.ListenAndServe()
+	var err error
+	if h.server.TLSConfig != nil {
+		// the certificate is provided by the TLS config
+		err = h.server.ListenAndServeTLS("", "")
+	} else {
+		err = h.server.ListenAndServe()
+	}
 	if err != nil {
 		if err != http.ErrServerClosed {
 			log.Error("could not start webserver",
@@ -0,0 +1,153 @@
+package gin
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/config"
+)
+
+// certificateReloader holds the server certificate and reloads it whenever the certificate or the key file changes
+type certificateReloader struct {
+	certFile    string
+	keyFile     string
+	mutCert     sync.RWMutex
+	certificate *tls.Certificate
+	certModTime time.Time
+	keyModTime  time.Time
+	cancelFunc  func()
+}
+
+func newCertificateReloader(certFile string, keyFile string) (*certificateReloader, error) {
+	cr := &certificateReloader{
+		certFile:   certFile,
+		keyFile:    keyFile,
+		cancelFunc: func() {},
+	}
+
+	_, err := cr.reloadIfChanged()
+	if err != nil {
+		return nil, err
+	}
+
+	return cr, nil
+}
+
+// GetCertificate returns the current server certificate. It is meant to be used as tls.Config.GetCertificate
+func (cr *certificateReloader) GetCertificate(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	cr.mutCert.RLock()
+	defer cr.mutCert.RUnlock()
+
+	return cr.certificate, nil
+}
+
+// reloadIfChanged loads the certificate/key pair if any of the files was modified since the last load. On error,
+// the previous certificate is kept
+func (cr *certificateReloader) reloadIfChanged() (bool, error) {
+	certModTime, err := getModTime(cr.certFile)
+	if err != nil {
+		return false, err
+	}
+	keyModTime, err := getModTime(cr.keyFile)
+	if err != nil {
+		return false, err
+	}
+
+	cr.mutCert.RLock()
+	isUnchanged := cr.certificate != nil && certModTime.Equal(cr.certModTime) && keyModTime.Equal(cr.keyModTime)
+	cr.mutCert.RUnlock()
+	if isUnchanged {
+		return false, nil
+	}
+
+	certificate, err := tls.LoadX509KeyPair(cr.certFile, cr.keyFile)
+	if err != nil {
+		return false, fmt.Errorf("%w: %v", apiErrors.ErrInvalidTLSConfig, err)
+	}
+
+	cr.mutCert.Lock()
+	cr.certificate = &certificate
+	cr.certModTime = certModTime
+	cr.keyModTime = keyModTime
+	cr.mutCert.Unlock()
+
+	return true, nil
+}
+
+func (cr *certificateReloader) startWatching(betweenChecksDuration time.Duration) {
+	var ctx context.Context
+	ctx, cr.cancelFunc = context.WithCancel(context.Background())
+
+	go func() {
+		for {
+			select {
+			case <-time.After(betweenChecksDuration):
+				reloaded, err := cr.reloadIfChanged()
+				if err != nil {
+					log.Warn("cannot reload the REST API certificate, keeping the previous one", "error", err)
+					continue
+				}
+				if reloaded {
+					log.Info("reloaded the REST API certificate", "file", cr.certFile)
+				}
+			case <-ctx.Done():
+				log.Debug("closing certificateReloader.startWatching go routine")
+				return
+			}
+		}
+	}()
+}
+
+// Close stops watching the certificate files
+func (cr *certificateReloader) Close() error {
+	cr.cancelFunc()
+
+	return nil
+}
+
+func getModTime(file string) (time.Time, error) {
+	info, err := os.Stat(file)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %v", apiErrors.ErrInvalidTLSConfig, err)
+	}
+
+	return info.ModTime(), nil
+}
+
+// createTLSConfig creates the server TLS config. Client certificates are verified against the client CA, if one
+// is provided
+func createTLSConfig(tlsConfig config.ApiTLSConfig, reloader *certificateReloader) (*tls.Config, error) {
+	serverTLSConfig := &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: reloader.GetCertificate,
+	}
+
+	if len(tlsConfig.ClientCAFile) == 0 {
+		return serverTLSConfig, nil
+	}
+
+	caBuff, err := ioutil.ReadFile(tlsConfig.ClientCAFile)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", apiErrors.ErrInvalidTLSConfig, err)
+	}
+
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM(caBuff) {
+		return nil, fmt.Errorf("%w: no certificate found in %s", apiErrors.ErrInvalidTLSConfig, tlsConfig.ClientCAFile)
+	}
+
+	serverTLSConfig.ClientCAs = clientCAs
+	serverTLSConfig.ClientAuth = tls.VerifyClientCertIfGiven
+	if tlsConfig.RequireClientCertificate {
+		serverTLSConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return serverTLSConfig, nil
+}
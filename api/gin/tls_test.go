@@ -0,0 +1,237 @@
+package gin
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testCertificate struct {
+	certificate *x509.Certificate
+	key         *ecdsa.PrivateKey
+	certPEM     []byte
+	keyPEM      []byte
+}
+
+func generateTestCertificate(t *testing.T, commonName string, isCA bool, parent *testCertificate) *testCertificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+
+	serialNumber, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.Nil(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+	}
+
+	parentCertificate, parentKey := template, key
+	if parent != nil {
+		parentCertificate, parentKey = parent.certificate, parent.key
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, parentCertificate, &key.PublicKey, parentKey)
+	require.Nil(t, err)
+	certificate, err := x509.ParseCertificate(der)
+	require.Nil(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.Nil(t, err)
+
+	return &testCertificate{
+		certificate: certificate,
+		key:         key,
+		certPEM:     pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		keyPEM:      pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}),
+	}
+}
+
+func writeTestCertificate(t *testing.T, dir string, name string, cert *testCertificate) (string, string) {
+	certFile := filepath.Join(dir, name+".crt")
+	keyFile := filepath.Join(dir, name+".key")
+	require.Nil(t, ioutil.WriteFile(certFile, cert.certPEM, os.ModePerm))
+	require.Nil(t, ioutil.WriteFile(keyFile, cert.keyPEM, os.ModePerm))
+
+	return certFile, keyFile
+}
+
+func startTestTLSServer(t *testing.T, tlsConfig *tls.Config) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+
+	server := &http.Server{
+		Handler: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}),
+		TLSConfig: tlsConfig,
+	}
+	go func() {
+		_ = server.ServeTLS(listener, "", "")
+	}()
+	t.Cleanup(func() {
+		_ = server.Close()
+	})
+
+	return "https://" + listener.Addr().String()
+}
+
+func createTestClient(ca *testCertificate, clientCert *testCertificate) *http.Client {
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(ca.certificate)
+
+	clientTLSConfig := &tls.Config{RootCAs: rootCAs}
+	if clientCert != nil {
+		certificate, _ := tls.X509KeyPair(clientCert.certPEM, clientCert.keyPEM)
+		clientTLSConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	return &http.Client{
+		Transport: &http.Transport{TLSClientConfig: clientTLSConfig},
+		Timeout:   time.Second * 5,
+	}
+}
+
+func TestNewCertificateReloader_MissingFilesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	reloader, err := newCertificateReloader(filepath.Join(dir, "missing.crt"), filepath.Join(dir, "missing.key"))
+	assert.Nil(t, reloader)
+	assert.True(t, errors.Is(err, apiErrors.ErrInvalidTLSConfig))
+}
+
+func TestCertificateReloader_ReloadIfChanged(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	ca := generateTestCertificate(t, "ca", true, nil)
+	certFile, keyFile := writeTestCertificate(t, dir, "server", generateTestCertificate(t, "server", false, ca))
+
+	reloader, err := newCertificateReloader(certFile, keyFile)
+	require.Nil(t, err)
+	initialCertificate, _ := reloader.GetCertificate(nil)
+
+	reloaded, err := reloader.reloadIfChanged()
+	assert.Nil(t, err)
+	assert.False(t, reloaded)
+
+	newServerCert := generateTestCertificate(t, "server-renewed", false, ca)
+	writeTestCertificate(t, dir, "server", newServerCert)
+	newModTime := time.Now().Add(time.Minute)
+	require.Nil(t, os.Chtimes(certFile, newModTime, newModTime))
+	require.Nil(t, os.Chtimes(keyFile, newModTime, newModTime))
+
+	reloaded, err = reloader.reloadIfChanged()
+	assert.Nil(t, err)
+	assert.True(t, reloaded)
+	currentCertificate, _ := reloader.GetCertificate(nil)
+	assert.NotEqual(t, initialCertificate, currentCertificate)
+	assert.Equal(t, newServerCert.certificate.Raw, currentCertificate.Certificate[0])
+
+	// an invalid pair should keep the previous certificate
+	require.Nil(t, ioutil.WriteFile(keyFile, []byte("invalid"), os.ModePerm))
+	newModTime = newModTime.Add(time.Minute)
+	require.Nil(t, os.Chtimes(keyFile, newModTime, newModTime))
+
+	reloaded, err = reloader.reloadIfChanged()
+	assert.True(t, errors.Is(err, apiErrors.ErrInvalidTLSConfig))
+	assert.False(t, reloaded)
+	certificate, _ := reloader.GetCertificate(nil)
+	assert.Equal(t, currentCertificate, certificate)
+
+	assert.Nil(t, reloader.Close())
+}
+
+func TestCreateTLSConfig_InvalidClientCAShouldErr(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	ca := generateTestCertificate(t, "ca", true, nil)
+	certFile, keyFile := writeTestCertificate(t, dir, "server", generateTestCertificate(t, "server", false, ca))
+	reloader, err := newCertificateReloader(certFile, keyFile)
+	require.Nil(t, err)
+
+	invalidCAFile := filepath.Join(dir, "ca.crt")
+	require.Nil(t, ioutil.WriteFile(invalidCAFile, []byte("not a certificate"), os.ModePerm))
+
+	serverTLSConfig, err := createTLSConfig(config.ApiTLSConfig{ClientCAFile: invalidCAFile}, reloader)
+	assert.Nil(t, serverTLSConfig)
+	assert.True(t, errors.Is(err, apiErrors.ErrInvalidTLSConfig))
+}
+
+func TestCreateTLSConfig_ServeTLS(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	ca := generateTestCertificate(t, "ca", true, nil)
+	certFile, keyFile := writeTestCertificate(t, dir, "server", generateTestCertificate(t, "server", false, ca))
+	reloader, err := newCertificateReloader(certFile, keyFile)
+	require.Nil(t, err)
+
+	serverTLSConfig, err := createTLSConfig(config.ApiTLSConfig{}, reloader)
+	require.Nil(t, err)
+	assert.Equal(t, tls.NoClientCert, serverTLSConfig.ClientAuth)
+
+	url := startTestTLSServer(t, serverTLSConfig)
+	resp, err := createTestClient(ca, nil).Get(url)
+	require.Nil(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestCreateTLSConfig_MutualTLS(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	ca := generateTestCertificate(t, "ca", true, nil)
+	certFile, keyFile := writeTestCertificate(t, dir, "server", generateTestCertificate(t, "server", false, ca))
+	caFile, _ := writeTestCertificate(t, dir, "ca", ca)
+	reloader, err := newCertificateReloader(certFile, keyFile)
+	require.Nil(t, err)
+
+	tlsConfig := config.ApiTLSConfig{
+		ClientCAFile:             caFile,
+		RequireClientCertificate: true,
+	}
+	serverTLSConfig, err := createTLSConfig(tlsConfig, reloader)
+	require.Nil(t, err)
+	assert.Equal(t, tls.RequireAndVerifyClientCert, serverTLSConfig.ClientAuth)
+
+	url := startTestTLSServer(t, serverTLSConfig)
+
+	_, err = createTestClient(ca, nil).Get(url)
+	assert.NotNil(t, err)
+
+	otherCA := generateTestCertificate(t, "other ca", true, nil)
+	_, err = createTestClient(ca, generateTestCertificate(t, "intruder", false, otherCA)).Get(url)
+	assert.NotNil(t, err)
+
+	resp, err := createTestClient(ca, generateTestCertificate(t, "client", false, ca)).Get(url)
+	require.Nil(t, err)
+	_ = resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
@@ -2,6 +2,7 @@ package gin
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net/http"
 	"sync"
@@ -36,6 +37,7 @@ type webServer struct {
 	antiFloodConfig config.WebServerAntifloodConfig
 	httpServer      shared.HttpServerCloser
 	groups          map[string]shared.GroupHandler
+	certReloader    *certificateReloader
 	cancelFunc      func()
 }
 
@@ -120,7 +122,14 @@ func (ws *webServer) StartHttpServer() error {
 	ws.registerRoutes(engine)
 
 	server := &http.Server{Addr: ws.facade.RestApiInterface(), Handler: engine}
-	log.Debug("creating gin web sever", "interface", ws.facade.RestApiInterface())
+	if ws.apiConfig.TLS.Enabled {
+		server.TLSConfig, err = ws.createServerTLSConfig()
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Debug("creating gin web sever", "interface", ws.facade.RestApiInterface(), "TLS", ws.apiConfig.TLS.Enabled)
 	ws.httpServer, err = NewHttpServer(server)
 	if err != nil {
 		return err
@@ -137,6 +146,25 @@ func (ws *webServer) StartHttpServer() error {
 	return nil
 }
 
+func (ws *webServer) createServerTLSConfig() (*tls.Config, error) {
+	reloader, err := newCertificateReloader(ws.apiConfig.TLS.CertificateFile, ws.apiConfig.TLS.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	serverTLSConfig, err := createTLSConfig(ws.apiConfig.TLS, reloader)
+	if err != nil {
+		return nil, err
+	}
+
+	if ws.apiConfig.TLS.ReloadIntervalInSec > 0 {
+		reloader.startWatching(time.Second * time.Duration(ws.apiConfig.TLS.ReloadIntervalInSec))
+	}
+	ws.certReloader = reloader
+
+	return serverTLSConfig, nil
+}
+
 func (ws *webServer) createGroups() error {
 	groupsMap := make(map[string]shared.GroupHandler)
 	addressGroup, err := groups.NewAddressGroup(ws.facade)
@@ -151,6 +179,18 @@ func (ws *webServer) createGroups() error {
 	}
 	groupsMap["block"] = blockGroup
 
+	blocksGroup, err := groups.NewBlocksGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["blocks"] = blocksGroup
+
+	eventsGroup, err := groups.NewEventsGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["events"] = eventsGroup
+
 	internalBlockGroup, err := groups.NewInternalBlockGroup(ws.facade)
 	if err != nil {
 		return err
@@ -163,6 +203,18 @@ func (ws *webServer) createGroups() error {
 	}
 	groupsMap["hardfork"] = hardforkGroup
 
+	jsonRpcGroup, err := groups.NewJsonRpcGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["jsonrpc"] = jsonRpcGroup
+
+	logsGroup, err := groups.NewLogsGroup(ws.facade)
+	if err != nil {
+		return err
+	}
+	groupsMap["logs"] = logsGroup
+
 	networkGroup, err := groups.NewNetworkGroup(ws.facade)
 	if err != nil {
 		return err
@@ -216,6 +268,13 @@ func (ws *webServer) registerRoutes(ginRouter *gin.Engine) {
 		registerLoggerWsRoute(ginRouter, marshalizerForLogs)
 	}
 
+	if isOpenAPIRouteEnabled(ws.apiConfig) {
+		err := registerOpenAPIRoute(ginRouter, ws.groups, ws.apiConfig)
+		if err != nil {
+			log.Error("cannot register the OpenAPI route", "error", err)
+		}
+	}
+
 	if ws.facade.PprofEnabled() {
 		pprof.Register(ginRouter)
 	}
@@ -237,7 +296,8 @@ func (ws *webServer) createMiddlewareLimiters() ([]shared.MiddlewareProcessor, e
 	var ctx context.Context
 	ctx, ws.cancelFunc = context.WithCancel(context.Background())
 
-	go ws.sourceLimiterReset(ctx, sourceLimiter)
+	sourceLimiterResetDuration := time.Second * time.Duration(ws.antiFloodConfig.SameSourceResetIntervalInSec)
+	go ws.resetPeriodically(ctx, sourceLimiter, sourceLimiterResetDuration, "WS source limiter")
 
 	middlewares = append(middlewares, sourceLimiter)
 
@@ -248,18 +308,29 @@ func (ws *webServer) createMiddlewareLimiters() ([]shared.MiddlewareProcessor, e
 
 	middlewares = append(middlewares, globalLimiter)
 
+	if ws.apiConfig.Auth.Enabled {
+		authenticator, errCreate := middleware.NewAuthenticator(ws.apiConfig.Auth)
+		if errCreate != nil {
+			return nil, errCreate
+		}
+
+		quotaResetDuration := time.Second * time.Duration(ws.apiConfig.Auth.QuotaResetIntervalInSec)
+		go ws.resetPeriodically(ctx, authenticator, quotaResetDuration, "API clients quotas")
+
+		middlewares = append(middlewares, authenticator)
+	}
+
 	return middlewares, nil
 }
 
-func (ws *webServer) sourceLimiterReset(ctx context.Context, reset resetHandler) {
-	betweenResetDuration := time.Second * time.Duration(ws.antiFloodConfig.SameSourceResetIntervalInSec)
+func (ws *webServer) resetPeriodically(ctx context.Context, reset resetHandler, betweenResetDuration time.Duration, name string) {
 	for {
 		select {
 		case <-time.After(betweenResetDuration):
-			log.Trace("calling reset on WS source limiter")
+			log.Trace("calling reset", "component", name)
 			reset.Reset()
 		case <-ctx.Done():
-			log.Debug("closing nodeFacade.sourceLimiterReset go routine")
+			log.Debug("closing webServer.resetPeriodically go routine", "component", name)
 			return
 		}
 	}
@@ -272,6 +343,9 @@ func (ws *webServer) Close() error {
 	}
 
 	ws.Lock()
+	if ws.certReloader != nil {
+		_ = ws.certReloader.Close()
+	}
 	err := ws.httpServer.Close()
 	ws.Unlock()
 
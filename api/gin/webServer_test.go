@@ -0,0 +1,32 @@
+package gin
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebServer_createGroupsShouldDocumentAllEndpoints(t *testing.T) {
+	t.Parallel()
+
+	ws, err := NewGinWebServerHandler(ArgsNewWebServer{
+		Facade:          &mock.FacadeStub{},
+		ApiConfig:       config.ApiRoutesConfig{},
+		AntiFloodConfig: config.WebServerAntifloodConfig{},
+	})
+	require.Nil(t, err)
+
+	err = ws.createGroups()
+	require.Nil(t, err)
+
+	for groupName, group := range ws.groups {
+		for _, endpoint := range group.GetEndpoints() {
+			documentation := endpoint.Documentation
+			require.NotNil(t, documentation, "%s%s is not documented", groupName, endpoint.Path)
+			assert.NotEmpty(t, documentation.Summary, "%s%s has no summary", groupName, endpoint.Path)
+		}
+	}
+}
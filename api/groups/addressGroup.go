@@ -13,11 +13,13 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/gin-gonic/gin"
 )
 
 const (
 	getAccountPath            = "/:address"
+	getAccountsPath           = "/bulk"
 	getBalancePath            = "/:address/balance"
 	getUsernamePath           = "/:address/username"
 	getKeysPath               = "/:address/keys"
@@ -28,12 +30,20 @@ const (
 	getESDTsRolesPath         = "/:address/esdts/roles"
 	getRegisteredNFTsPath     = "/:address/registered-nfts"
 	getESDTNFTDataPath        = "/:address/nft/:tokenIdentifier/nonce/:nonce"
+	getTransactionsPath       = "/:address/transactions"
 	urlParamOnFinalBlock      = "onFinalBlock"
 	urlParamOnStartOfEpoch    = "onStartOfEpoch"
 	urlParamBlockNonce        = "blockNonce"
 	urlParamBlockHash         = "blockHash"
 	urlParamBlockRootHash     = "blockRootHash"
 	urlParamHintEpoch         = "hintEpoch"
+	urlParamFrom              = "from"
+	urlParamSize              = "size"
+	urlParamOrder             = "order"
+	urlParamPrefix            = "prefix"
+	urlParamLimit             = "limit"
+	urlParamContinuationToken = "continuationToken"
+	maxBulkAccountsAddresses  = 100
 )
 
 // addressFacadeHandler defines the methods to be implemented by a facade for handling address requests
@@ -42,12 +52,15 @@ type addressFacadeHandler interface {
 	GetUsername(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
 	GetValueForKey(address string, key string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
 	GetAccount(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error)
+	GetAccounts(addresses []string, options api.AccountQueryOptions) (map[string]*api.AccountResponse, api.BlockInfo, error)
 	GetESDTData(address string, key string, nonce uint64, options api.AccountQueryOptions) (*esdt.ESDigitalToken, api.BlockInfo, error)
 	GetESDTsRoles(address string, options api.AccountQueryOptions) (map[string][]string, api.BlockInfo, error)
 	GetNFTTokenIDsRegisteredByAddress(address string, options api.AccountQueryOptions) ([]string, api.BlockInfo, error)
 	GetESDTsWithRole(address string, role string, options api.AccountQueryOptions) ([]string, api.BlockInfo, error)
 	GetAllESDTTokens(address string, options api.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, api.BlockInfo, error)
 	GetKeyValuePairs(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error)
+	GetKeyValuePairsPage(address string, options api.AccountQueryOptions, pageOptions common.KeyValuePairsQueryOptions) (*common.KeyValuePairsApiResponse, api.BlockInfo, error)
+	GetTransactionsByAddress(address string, options common.AddressTransactionsQueryOptions) (*common.AddressTransactionsApiResponse, error)
 	IsInterfaceNil() bool
 }
 
@@ -92,56 +105,132 @@ func NewAddressGroup(facade addressFacadeHandler) (*addressGroup, error) {
 			Path:    getAccountPath,
 			Method:  http.MethodGet,
 			Handler: ag.getAccount,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:         "returns the account of an address",
+				QueryParameters: accountQueryParameters,
+				Response:        gin.H{"account": api.AccountResponse{}, "blockInfo": api.BlockInfo{}},
+			},
+		},
+		{
+			Path:    getAccountsPath,
+			Method:  http.MethodPost,
+			Handler: ag.getAccounts,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:         "returns the accounts of a list of addresses, all resolved against the same state",
+				QueryParameters: accountQueryParameters,
+				Request:         []string{},
+				Response:        gin.H{"accounts": map[string]*api.AccountResponse{}, "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getBalancePath,
 			Method:  http.MethodGet,
 			Handler: ag.getBalance,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:         "returns the balance of an address",
+				QueryParameters: accountQueryParameters,
+				Response:        gin.H{"balance": "", "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getUsernamePath,
 			Method:  http.MethodGet,
 			Handler: ag.getUsername,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:         "returns the username of an address",
+				QueryParameters: accountQueryParameters,
+				Response:        gin.H{"username": "", "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getKeyPath,
 			Method:  http.MethodGet,
 			Handler: ag.getValueForKey,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:         "returns the value stored under a hex encoded key in the data trie of an address",
+				QueryParameters: accountQueryParameters,
+				Response:        gin.H{"value": "", "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getKeysPath,
 			Method:  http.MethodGet,
 			Handler: ag.getKeyValuePairs,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:         "returns the key-value pairs stored in the data trie of an address, optionally paginated",
+				QueryParameters: append(keyValuePairsQueryParameters, accountQueryParameters...),
+				Response:        gin.H{"pairs": map[string]string{}, "continuationToken": "", "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getESDTBalancePath,
 			Method:  http.MethodGet,
 			Handler: ag.getESDTBalance,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:         "returns the balance of an address for an ESDT token",
+				QueryParameters: accountQueryParameters,
+				Response:        gin.H{"tokenData": esdtTokenData{}, "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getESDTNFTDataPath,
 			Method:  http.MethodGet,
 			Handler: ag.getESDTNFTData,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:         "returns the data of an address for an NFT token",
+				QueryParameters: accountQueryParameters,
+				Response:        gin.H{"tokenData": esdtNFTTokenData{}, "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getESDTTokensPath,
 			Method:  http.MethodGet,
 			Handler: ag.getAllESDTData,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:         "returns all the ESDT tokens of an address",
+				QueryParameters: accountQueryParameters,
+				Response:        gin.H{"esdts": map[string]*esdtNFTTokenData{}, "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getRegisteredNFTsPath,
 			Method:  http.MethodGet,
 			Handler: ag.getNFTTokenIDsRegisteredByAddress,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:         "returns the identifiers of the NFT tokens registered by an address",
+				QueryParameters: accountQueryParameters,
+				Response:        gin.H{"tokens": []string{}, "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getESDTTokensWithRolePath,
 			Method:  http.MethodGet,
 			Handler: ag.getESDTTokensWithRole,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:         "returns the identifiers of the ESDT tokens for which an address has a role",
+				QueryParameters: accountQueryParameters,
+				Response:        gin.H{"tokens": []string{}, "blockInfo": api.BlockInfo{}},
+			},
 		},
 		{
 			Path:    getESDTsRolesPath,
 			Method:  http.MethodGet,
 			Handler: ag.getESDTsRoles,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:         "returns the roles of an address for each of its ESDT tokens",
+				QueryParameters: accountQueryParameters,
+				Response:        gin.H{"roles": map[string][]string{}, "blockInfo": api.BlockInfo{}},
+			},
+		},
+		{
+			Path:    getTransactionsPath,
+			Method:  http.MethodGet,
+			Handler: ag.getTransactions,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:         "returns the transactions of an address",
+				QueryParameters: addressTransactionsQueryParameters,
+				Response:        common.AddressTransactionsApiResponse{},
+			},
 		},
 	}
 	ag.endpoints = endpoints
@@ -173,6 +262,42 @@ func (ag *addressGroup) getAccount(c *gin.Context) {
 	shared.RespondWithSuccess(c, gin.H{"account": accountResponse, "blockInfo": blockInfo})
 }
 
+// getAccounts returns the accounts of the addresses provided in the request body, resolved against the same state
+func (ag *addressGroup) getAccounts(c *gin.Context) {
+	var addresses []string
+	err := c.ShouldBindJSON(&addresses)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrCouldNotGetAccounts, err)
+		return
+	}
+	if len(addresses) == 0 {
+		shared.RespondWithValidationError(c, errors.ErrCouldNotGetAccounts, errors.ErrEmptyAddress)
+		return
+	}
+	if len(addresses) > maxBulkAccountsAddresses {
+		shared.RespondWithValidationError(c, errors.ErrCouldNotGetAccounts,
+			fmt.Errorf("%w: provided %d, maximum %d", errors.ErrTooManyAddresses, len(addresses), maxBulkAccountsAddresses))
+		return
+	}
+
+	options, err := extractAccountQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrCouldNotGetAccounts, err)
+		return
+	}
+
+	accounts, blockInfo, err := ag.getFacade().GetAccounts(addresses, options)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrCouldNotGetAccounts, err)
+		return
+	}
+
+	for address, account := range accounts {
+		account.Address = address
+	}
+	shared.RespondWithSuccess(c, gin.H{"accounts": accounts, "blockInfo": blockInfo})
+}
+
 // getBalance returns the balance for the address parameter
 func (ag *addressGroup) getBalance(c *gin.Context) {
 	addr := c.Param("address")
@@ -262,6 +387,16 @@ func (ag *addressGroup) getKeyValuePairs(c *gin.Context) {
 		return
 	}
 
+	pageOptions, isPaginated, err := extractKeyValuePairsQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetKeyValuePairs, err)
+		return
+	}
+	if isPaginated {
+		ag.getKeyValuePairsPage(c, addr, options, pageOptions)
+		return
+	}
+
 	value, blockInfo, err := ag.getFacade().GetKeyValuePairs(addr, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrGetKeyValuePairs, err)
@@ -271,6 +406,21 @@ func (ag *addressGroup) getKeyValuePairs(c *gin.Context) {
 	shared.RespondWithSuccess(c, gin.H{"pairs": value, "blockInfo": blockInfo})
 }
 
+func (ag *addressGroup) getKeyValuePairsPage(
+	c *gin.Context,
+	addr string,
+	options api.AccountQueryOptions,
+	pageOptions common.KeyValuePairsQueryOptions,
+) {
+	page, blockInfo, err := ag.getFacade().GetKeyValuePairsPage(addr, options, pageOptions)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetKeyValuePairs, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"pairs": page.Pairs, "continuationToken": page.ContinuationToken, "blockInfo": blockInfo})
+}
+
 // getESDTBalance returns the balance for the given address and esdt token
 func (ag *addressGroup) getESDTBalance(c *gin.Context) {
 	addr := c.Param("address")
@@ -458,6 +608,29 @@ func (ag *addressGroup) getAllESDTData(c *gin.Context) {
 	shared.RespondWithSuccess(c, gin.H{"esdts": formattedTokens, "blockInfo": blockInfo})
 }
 
+// getTransactions returns a page of the transactions involving the given address
+func (ag *addressGroup) getTransactions(c *gin.Context) {
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetTransactionsByAddress, errors.ErrEmptyAddress)
+		return
+	}
+
+	options, err := extractAddressTransactionsQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetTransactionsByAddress, err)
+		return
+	}
+
+	response, err := ag.getFacade().GetTransactionsByAddress(addr, options)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetTransactionsByAddress, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"address": response.Address, "transactions": response.Transactions})
+}
+
 func buildTokenDataApiResponse(tokenIdentifier string, esdtData *esdt.ESDigitalToken) *esdtNFTTokenData {
 	tokenData := &esdtNFTTokenData{
 		TokenIdentifier: tokenIdentifier,
@@ -3,12 +3,48 @@ package groups
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
 	customErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/gin-gonic/gin"
 )
 
+const (
+	defaultAddressTransactionsPageSize = 20
+	maxAddressTransactionsPageSize     = 100
+	defaultKeyValuePairsPageSize       = 100
+	maxKeyValuePairsPageSize           = 1000
+	orderAscending                     = "asc"
+	orderDescending                    = "desc"
+)
+
+// accountQueryParameters holds the URL parameters selecting the state on which an account is queried
+var accountQueryParameters = []shared.QueryParameter{
+	{Name: urlParamOnFinalBlock, Type: shared.BooleanQueryParameter},
+	{Name: urlParamOnStartOfEpoch, Type: shared.IntegerQueryParameter},
+	{Name: urlParamBlockNonce, Type: shared.IntegerQueryParameter},
+	{Name: urlParamBlockHash, Type: shared.StringQueryParameter},
+	{Name: urlParamBlockRootHash, Type: shared.StringQueryParameter},
+	{Name: urlParamHintEpoch, Type: shared.IntegerQueryParameter},
+}
+
+// keyValuePairsQueryParameters holds the URL parameters paginating the key-value pairs of an account
+var keyValuePairsQueryParameters = []shared.QueryParameter{
+	{Name: urlParamPrefix, Type: shared.StringQueryParameter},
+	{Name: urlParamLimit, Type: shared.IntegerQueryParameter},
+	{Name: urlParamContinuationToken, Type: shared.StringQueryParameter},
+}
+
+// addressTransactionsQueryParameters holds the URL parameters paginating the transactions of an address
+var addressTransactionsQueryParameters = []shared.QueryParameter{
+	{Name: urlParamFrom, Type: shared.IntegerQueryParameter},
+	{Name: urlParamSize, Type: shared.IntegerQueryParameter},
+	{Name: urlParamOrder, Type: shared.StringQueryParameter},
+}
+
 func extractAccountQueryOptions(c *gin.Context) (api.AccountQueryOptions, error) {
 	options, err := parseAccountQueryOptions(c)
 	if err != nil {
@@ -93,3 +129,72 @@ func checkAccountQueryOptions(options api.AccountQueryOptions) error {
 
 	return nil
 }
+
+func extractAddressTransactionsQueryOptions(c *gin.Context) (common.AddressTransactionsQueryOptions, error) {
+	options, err := parseAddressTransactionsQueryOptions(c)
+	if err != nil {
+		return common.AddressTransactionsQueryOptions{}, fmt.Errorf("%w: %v", customErrors.ErrBadUrlParams, err)
+	}
+
+	return options, nil
+}
+
+func parseAddressTransactionsQueryOptions(c *gin.Context) (common.AddressTransactionsQueryOptions, error) {
+	from, err := parseUint32UrlParam(c, urlParamFrom)
+	if err != nil {
+		return common.AddressTransactionsQueryOptions{}, err
+	}
+
+	size, err := parseUint32UrlParam(c, urlParamSize)
+	if err != nil {
+		return common.AddressTransactionsQueryOptions{}, err
+	}
+	if !size.HasValue {
+		size.Value = defaultAddressTransactionsPageSize
+	}
+	if size.Value == 0 || size.Value > maxAddressTransactionsPageSize {
+		return common.AddressTransactionsQueryOptions{}, fmt.Errorf("size must be between 1 and %d", maxAddressTransactionsPageSize)
+	}
+
+	order := strings.ToLower(c.Request.URL.Query().Get(urlParamOrder))
+	if order != "" && order != orderAscending && order != orderDescending {
+		return common.AddressTransactionsQueryOptions{}, errors.New("order must be either asc or desc")
+	}
+
+	options := common.AddressTransactionsQueryOptions{
+		From:      from.Value,
+		Size:      size.Value,
+		Ascending: order == orderAscending,
+	}
+	return options, nil
+}
+
+// extractKeyValuePairsQueryOptions returns the pagination options of a key-value pairs request and whether any of them
+// was provided, since the key-value pairs are only paginated on demand
+func extractKeyValuePairsQueryOptions(c *gin.Context) (common.KeyValuePairsQueryOptions, bool, error) {
+	prefix, err := parseHexBytesUrlParam(c, urlParamPrefix)
+	if err != nil {
+		return common.KeyValuePairsQueryOptions{}, false, fmt.Errorf("%w: %v", customErrors.ErrBadUrlParams, err)
+	}
+
+	limit, err := parseUint32UrlParam(c, urlParamLimit)
+	if err != nil {
+		return common.KeyValuePairsQueryOptions{}, false, fmt.Errorf("%w: %v", customErrors.ErrBadUrlParams, err)
+	}
+	if !limit.HasValue {
+		limit.Value = defaultKeyValuePairsPageSize
+	}
+	if limit.Value == 0 || limit.Value > maxKeyValuePairsPageSize {
+		return common.KeyValuePairsQueryOptions{}, false, fmt.Errorf("%w: limit must be between 1 and %d", customErrors.ErrBadUrlParams, maxKeyValuePairsPageSize)
+	}
+
+	continuationToken := c.Request.URL.Query().Get(urlParamContinuationToken)
+	isPaginated := len(prefix) > 0 || limit.HasValue || len(continuationToken) > 0
+
+	options := common.KeyValuePairsQueryOptions{
+		Prefix:            prefix,
+		Limit:             limit.Value,
+		ContinuationToken: continuationToken,
+	}
+	return options, isPaginated, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/stretchr/testify/require"
 )
@@ -64,3 +65,37 @@ func TestExtractAccountQueryOptions(t *testing.T) {
 
 	})
 }
+
+func TestExtractAddressTransactionsQueryOptions(t *testing.T) {
+	t.Run("good options", func(t *testing.T) {
+		options, err := extractAddressTransactionsQueryOptions(testscommon.CreateGinContextWithRawQuery(""))
+		require.Nil(t, err)
+		require.Equal(t, common.AddressTransactionsQueryOptions{From: 0, Size: defaultAddressTransactionsPageSize}, options)
+
+		options, err = extractAddressTransactionsQueryOptions(testscommon.CreateGinContextWithRawQuery("from=7&size=100&order=ASC"))
+		require.Nil(t, err)
+		require.Equal(t, common.AddressTransactionsQueryOptions{From: 7, Size: 100, Ascending: true}, options)
+
+		options, err = extractAddressTransactionsQueryOptions(testscommon.CreateGinContextWithRawQuery("order=desc"))
+		require.Nil(t, err)
+		require.False(t, options.Ascending)
+	})
+
+	t.Run("bad options", func(t *testing.T) {
+		options, err := extractAddressTransactionsQueryOptions(testscommon.CreateGinContextWithRawQuery("size=0"))
+		require.ErrorContains(t, err, "size must be between")
+		require.Equal(t, common.AddressTransactionsQueryOptions{}, options)
+
+		options, err = extractAddressTransactionsQueryOptions(testscommon.CreateGinContextWithRawQuery("size=101"))
+		require.ErrorContains(t, err, "size must be between")
+		require.Equal(t, common.AddressTransactionsQueryOptions{}, options)
+
+		options, err = extractAddressTransactionsQueryOptions(testscommon.CreateGinContextWithRawQuery("order=newest"))
+		require.ErrorContains(t, err, "order must be either asc or desc")
+		require.Equal(t, common.AddressTransactionsQueryOptions{}, options)
+
+		options, err = extractAddressTransactionsQueryOptions(testscommon.CreateGinContextWithRawQuery("from=abc"))
+		require.ErrorContains(t, err, "bad url parameter(s)")
+		require.Equal(t, common.AddressTransactionsQueryOptions{}, options)
+	})
+}
@@ -10,12 +10,14 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
 	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
 	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/groups"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -40,6 +42,17 @@ type accountResponse struct {
 	} `json:"account"`
 }
 
+type accountsResponseData struct {
+	Accounts  map[string]*api.AccountResponse `json:"accounts"`
+	BlockInfo api.BlockInfo                   `json:"blockInfo"`
+}
+
+type accountsResponse struct {
+	Data  accountsResponseData `json:"data"`
+	Error string               `json:"error"`
+	Code  string               `json:"code"`
+}
+
 type valueForKeyResponseData struct {
 	Value string `json:"value"`
 }
@@ -110,7 +123,8 @@ type esdtTokensCompleteResponse struct {
 }
 
 type keyValuePairsResponseData struct {
-	Pairs map[string]string `json:"pairs"`
+	Pairs             map[string]string `json:"pairs"`
+	ContinuationToken string            `json:"continuationToken"`
 }
 
 type keyValuePairsResponse struct {
@@ -129,6 +143,17 @@ type esdtRolesResponse struct {
 	Code  string
 }
 
+type addressTransactionsResponseData struct {
+	Address      string                                 `json:"address"`
+	Transactions []common.AddressTransactionApiResponse `json:"transactions"`
+}
+
+type addressTransactionsResponse struct {
+	Data  addressTransactionsResponseData `json:"data"`
+	Error string                          `json:"error"`
+	Code  string                          `json:"code"`
+}
+
 type usernameResponseData struct {
 	Username string `json:"username"`
 }
@@ -511,6 +536,107 @@ func TestGetAccount_WithQueryOptionsShouldWork(t *testing.T) {
 	require.Equal(t, api.AccountQueryOptions{OnFinalBlock: true}, calledWithOptions)
 }
 
+func TestGetAccounts_WithBadRequestShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		GetAccountsCalled: func(_ []string, _ api.AccountQueryOptions) (map[string]*api.AccountResponse, api.BlockInfo, error) {
+			require.Fail(t, "should have not been called")
+			return nil, api.BlockInfo{}, nil
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	response, code := httpPostAccounts(ws, "/address/bulk", "not a list")
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Contains(t, response.Error, apiErrors.ErrCouldNotGetAccounts.Error())
+
+	response, code = httpPostAccounts(ws, "/address/bulk", "[]")
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Contains(t, response.Error, apiErrors.ErrEmptyAddress.Error())
+
+	addresses := make([]string, 101)
+	for i := range addresses {
+		addresses[i] = fmt.Sprintf("address%d", i)
+	}
+	body, _ := json.Marshal(addresses)
+	response, code = httpPostAccounts(ws, "/address/bulk", string(body))
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Contains(t, response.Error, apiErrors.ErrTooManyAddresses.Error())
+
+	response, code = httpPostAccounts(ws, "/address/bulk?blockNonce=bad", `["alice"]`)
+	require.Equal(t, http.StatusBadRequest, code)
+	require.Contains(t, response.Error, apiErrors.ErrBadUrlParams.Error())
+}
+
+func TestGetAccounts_FacadeFailsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetAccountsCalled: func(_ []string, _ api.AccountQueryOptions) (map[string]*api.AccountResponse, api.BlockInfo, error) {
+			return nil, api.BlockInfo{}, expectedErr
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	response, code := httpPostAccounts(ws, "/address/bulk", `["alice"]`)
+	require.Equal(t, http.StatusInternalServerError, code)
+	require.Contains(t, response.Error, expectedErr.Error())
+}
+
+func TestGetAccounts_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	var calledWithAddresses []string
+	var calledWithOptions api.AccountQueryOptions
+	blockInfo := api.BlockInfo{Nonce: 37, RootHash: "abcd"}
+	facade := mock.FacadeStub{
+		GetAccountsCalled: func(addresses []string, options api.AccountQueryOptions) (map[string]*api.AccountResponse, api.BlockInfo, error) {
+			calledWithAddresses = addresses
+			calledWithOptions = options
+			return map[string]*api.AccountResponse{
+				"alice": {Nonce: 1, Balance: "100"},
+				"bob":   {Nonce: 2, Balance: "200"},
+			}, blockInfo, nil
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	response, code := httpPostAccounts(ws, "/address/bulk?blockNonce=37", `["alice","bob"]`)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, []string{"alice", "bob"}, calledWithAddresses)
+	require.Equal(t, core.OptionalUint64{Value: 37, HasValue: true}, calledWithOptions.BlockNonce)
+	require.Equal(t, blockInfo, response.Data.BlockInfo)
+	require.Equal(t, 2, len(response.Data.Accounts))
+	require.Equal(t, "alice", response.Data.Accounts["alice"].Address)
+	require.Equal(t, uint64(1), response.Data.Accounts["alice"].Nonce)
+	require.Equal(t, "bob", response.Data.Accounts["bob"].Address)
+	require.Equal(t, "200", response.Data.Accounts["bob"].Balance)
+}
+
+func httpPostAccounts(ws *gin.Engine, url string, body string) (accountsResponse, int) {
+	httpRequest, _ := http.NewRequest("POST", url, strings.NewReader(body))
+	httpResponse := httptest.NewRecorder()
+	ws.ServeHTTP(httpResponse, httpRequest)
+
+	response := accountsResponse{}
+	loadResponse(httpResponse.Body, &response)
+	return response, httpResponse.Code
+}
+
 func httpGetAccount(ws *gin.Engine, url string) (wrappedAcountResponse, int) {
 	httpRequest, _ := http.NewRequest("GET", url, nil)
 	httpResponse := httptest.NewRecorder()
@@ -900,6 +1026,73 @@ func TestGetKeyValuePairs_ShouldWork(t *testing.T) {
 	assert.Equal(t, pairs, response.Data.Pairs)
 }
 
+func TestGetKeyValuePairs_WithBadPaginationOptionsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		GetKeyValuePairsPageCalled: func(_ string, _ api.AccountQueryOptions, _ common.KeyValuePairsQueryOptions) (*common.KeyValuePairsApiResponse, api.BlockInfo, error) {
+			require.Fail(t, "should have not been called")
+			return nil, api.BlockInfo{}, nil
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	for _, query := range []string{"limit=0", "limit=1001", "limit=bad", "prefix=zz"} {
+		req, _ := http.NewRequest("GET", "/address/alice/keys?"+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := keyValuePairsResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, response.Error, apiErrors.ErrBadUrlParams.Error())
+	}
+}
+
+func TestGetKeyValuePairs_PaginatedShouldWork(t *testing.T) {
+	t.Parallel()
+
+	pairs := map[string]string{
+		"k1": "v1",
+	}
+	var calledWithPageOptions common.KeyValuePairsQueryOptions
+	facade := mock.FacadeStub{
+		GetKeyValuePairsCalled: func(_ string, _ api.AccountQueryOptions) (map[string]string, api.BlockInfo, error) {
+			require.Fail(t, "should have not been called")
+			return nil, api.BlockInfo{}, nil
+		},
+		GetKeyValuePairsPageCalled: func(_ string, _ api.AccountQueryOptions, pageOptions common.KeyValuePairsQueryOptions) (*common.KeyValuePairsApiResponse, api.BlockInfo, error) {
+			calledWithPageOptions = pageOptions
+			return &common.KeyValuePairsApiResponse{Pairs: pairs, ContinuationToken: "next"}, api.BlockInfo{}, nil
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/address/alice/keys?prefix=6b&continuationToken=token", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := keyValuePairsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, pairs, response.Data.Pairs)
+	assert.Equal(t, "next", response.Data.ContinuationToken)
+	expectedPageOptions := common.KeyValuePairsQueryOptions{
+		Prefix:            []byte("k"),
+		Limit:             100,
+		ContinuationToken: "token",
+	}
+	assert.Equal(t, expectedPageOptions, calledWithPageOptions)
+}
+
 func TestGetESDTsRoles_WithEmptyAddressShouldReturnError(t *testing.T) {
 	t.Parallel()
 	facade := mock.FacadeStub{}
@@ -979,6 +1172,94 @@ func TestGetESDTsRoles_ShouldWork(t *testing.T) {
 	assert.Equal(t, roles, response.Data.Roles)
 }
 
+func TestGetTransactions_WithBadQueryOptionsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		GetTransactionsByAddressCalled: func(_ string, _ common.AddressTransactionsQueryOptions) (*common.AddressTransactionsApiResponse, error) {
+			require.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	for _, query := range []string{"from=-1", "size=0", "size=101", "size=abc", "order=random"} {
+		req, _ := http.NewRequest("GET", "/address/addr/transactions?"+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code, query)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetTransactionsByAddress.Error()), query)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrBadUrlParams.Error()), query)
+	}
+}
+
+func TestGetTransactions_NodeFailsShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetTransactionsByAddressCalled: func(_ string, _ common.AddressTransactionsQueryOptions) (*common.AddressTransactionsApiResponse, error) {
+			return nil, expectedErr
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/address/addr/transactions", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := &shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetTransactions_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	transactions := []common.AddressTransactionApiResponse{
+		{Hash: "aa", MiniblockType: "TxBlock", Direction: "out", BlockNonce: 2, BlockHash: "bb", Round: 3, Epoch: 1},
+	}
+	facade := mock.FacadeStub{
+		GetTransactionsByAddressCalled: func(address string, options common.AddressTransactionsQueryOptions) (*common.AddressTransactionsApiResponse, error) {
+			assert.Equal(t, testAddress, address)
+			assert.Equal(t, common.AddressTransactionsQueryOptions{From: 10, Size: 5, Ascending: true}, options)
+
+			return &common.AddressTransactionsApiResponse{
+				Address:      address,
+				Transactions: transactions,
+			}, nil
+		},
+	}
+
+	addrGroup, err := groups.NewAddressGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+	req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/transactions?from=10&size=5&order=asc", testAddress), nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := addressTransactionsResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, testAddress, response.Data.Address)
+	assert.Equal(t, transactions, response.Data.Transactions)
+}
+
 func TestAddressGroup_UpdateFacadeStub(t *testing.T) {
 	t.Parallel()
 
@@ -1032,6 +1313,7 @@ func getAddressRoutesConfig() config.ApiRoutesConfig {
 			"address": {
 				Routes: []config.RouteConfig{
 					{Name: "/:address", Open: true},
+					{Name: "/bulk", Open: true},
 					{Name: "/:address/balance", Open: true},
 					{Name: "/:address/username", Open: true},
 					{Name: "/:address/keys", Open: true},
@@ -1042,6 +1324,7 @@ func getAddressRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:address/nft/:tokenIdentifier/nonce/:nonce", Open: true},
 					{Name: "/:address/esdts-with-role/:role", Open: true},
 					{Name: "/:address/registered-nfts", Open: true},
+					{Name: "/:address/transactions", Open: true},
 				},
 			},
 		},
@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/gin-gonic/gin"
@@ -12,7 +13,8 @@ import (
 var log = logger.GetOrCreate("api/groups")
 
 type endpointProperties struct {
-	isOpen bool
+	isOpen       bool
+	requiresAuth bool
 }
 
 type baseGroup struct {
@@ -38,6 +40,11 @@ func (bg *baseGroup) RegisterRoutes(
 		}
 
 		middlewares := make([]gin.HandlerFunc, 0)
+		if properties.requiresAuth {
+			route := "/" + getGroupName(ws) + handlerData.Path
+			middlewares = append(middlewares, middleware.CreateAuthRequiredHandler(route))
+		}
+
 		beforeSpecifiesMiddlewares, afterSpecificMiddlewares := extractSpecificMiddlewares(handlerData.AdditionalMiddlewares)
 
 		middlewares = append(middlewares, beforeSpecifiesMiddlewares...)
@@ -67,13 +74,11 @@ func extractSpecificMiddlewares(middlewares []shared.AdditionalMiddleware) ([]gi
 }
 
 func getEndpointProperties(ws *gin.RouterGroup, path string, apiConfig config.ApiRoutesConfig) endpointProperties {
-	basePath := ws.BasePath()
-
-	// ws.BasePath will return paths like /group or /v1.0/group so we need the last token after splitting by /
-	splitPath := strings.Split(basePath, "/")
-	basePath = splitPath[len(splitPath)-1]
+	return getRouteProperties(getGroupName(ws), path, apiConfig)
+}
 
-	group, ok := apiConfig.APIPackages[basePath]
+func getRouteProperties(groupName string, path string, apiConfig config.ApiRoutesConfig) endpointProperties {
+	group, ok := apiConfig.APIPackages[groupName]
 	if !ok {
 		return endpointProperties{
 			isOpen: false,
@@ -83,7 +88,8 @@ func getEndpointProperties(ws *gin.RouterGroup, path string, apiConfig config.Ap
 	for _, route := range group.Routes {
 		if route.Name == path {
 			return endpointProperties{
-				isOpen: route.Open,
+				isOpen:       route.Open,
+				requiresAuth: route.Auth && apiConfig.Auth.Enabled,
 			}
 		}
 	}
@@ -92,3 +98,10 @@ func getEndpointProperties(ws *gin.RouterGroup, path string, apiConfig config.Ap
 		isOpen: false,
 	}
 }
+
+func getGroupName(ws *gin.RouterGroup) string {
+	// ws.BasePath will return paths like /group or /v1.0/group so we need the last token after splitting by /
+	splitPath := strings.Split(ws.BasePath(), "/")
+
+	return splitPath[len(splitPath)-1]
+}
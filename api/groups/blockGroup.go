@@ -12,22 +12,30 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/shared/logging"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/gin-gonic/gin"
 )
 
 const (
-	getBlockByNoncePath = "/by-nonce/:nonce"
-	getBlockByHashPath  = "/by-hash/:hash"
-	getBlockByRoundPath = "/by-round/:round"
-	urlParamWithTxs     = "withTxs"
-	urlParamWithLogs    = "withLogs"
+	getBlockByNoncePath      = "/by-nonce/:nonce"
+	getBlockByHashPath       = "/by-hash/:hash"
+	getBlockByRoundPath      = "/by-round/:round"
+	getHyperblockByNoncePath = "/hyperblock/by-nonce/:nonce"
+	urlParamWithTxs          = "withTxs"
+	urlParamWithLogs         = "withLogs"
 )
 
+var blockQueryParameters = []shared.QueryParameter{
+	{Name: urlParamWithTxs, Type: shared.BooleanQueryParameter},
+	{Name: urlParamWithLogs, Type: shared.BooleanQueryParameter},
+}
+
 // blockFacadeHandler defines the methods to be implemented by a facade for handling block requests
 type blockFacadeHandler interface {
 	GetBlockByHash(hash string, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByNonce(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByRound(round uint64, options api.BlockQueryOptions) (*api.Block, error)
+	GetHyperblockByNonce(nonce uint64, options api.BlockQueryOptions) (*common.ApiHyperblock, error)
 	IsInterfaceNil() bool
 }
 
@@ -53,16 +61,41 @@ func NewBlockGroup(facade blockFacadeHandler) (*blockGroup, error) {
 			Path:    getBlockByNoncePath,
 			Method:  http.MethodGet,
 			Handler: bg.getBlockByNonce,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:         "returns a block by its nonce",
+				QueryParameters: blockQueryParameters,
+				Response:        gin.H{"block": api.Block{}},
+			},
 		},
 		{
 			Path:    getBlockByHashPath,
 			Method:  http.MethodGet,
 			Handler: bg.getBlockByHash,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:         "returns a block by its hash",
+				QueryParameters: blockQueryParameters,
+				Response:        gin.H{"block": api.Block{}},
+			},
 		},
 		{
 			Path:    getBlockByRoundPath,
 			Method:  http.MethodGet,
 			Handler: bg.getBlockByRound,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:         "returns a block by its round",
+				QueryParameters: blockQueryParameters,
+				Response:        gin.H{"block": api.Block{}},
+			},
+		},
+		{
+			Path:    getHyperblockByNoncePath,
+			Method:  http.MethodGet,
+			Handler: bg.getHyperblockByNonce,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:         "returns a meta block together with the notarized shard blocks",
+				QueryParameters: blockQueryParameters,
+				Response:        gin.H{"hyperblock": common.ApiHyperblock{}},
+			},
 		},
 	}
 	bg.endpoints = endpoints
@@ -143,6 +176,30 @@ func (bg *blockGroup) getBlockByRound(c *gin.Context) {
 	shared.RespondWith(c, http.StatusOK, gin.H{"block": block}, "", shared.ReturnCodeSuccess)
 }
 
+func (bg *blockGroup) getHyperblockByNonce(c *gin.Context) {
+	nonce, err := getQueryParamNonce(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetHyperblock, errors.ErrInvalidBlockNonce)
+		return
+	}
+
+	options, err := parseBlockQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetHyperblock, errors.ErrBadUrlParams)
+		return
+	}
+
+	start := time.Now()
+	hyperblock, err := bg.getFacade().GetHyperblockByNonce(nonce, options)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: GetHyperblockByNonce")
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetHyperblock, err)
+		return
+	}
+
+	shared.RespondWith(c, http.StatusOK, gin.H{"hyperblock": hyperblock}, "", shared.ReturnCodeSuccess)
+}
+
 func parseBlockQueryOptions(c *gin.Context) (api.BlockQueryOptions, error) {
 	withTxs, err := parseBoolUrlParam(c, urlParamWithTxs)
 	if err != nil {
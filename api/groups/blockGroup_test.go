@@ -11,6 +11,7 @@ import (
 	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/groups"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
@@ -234,6 +235,7 @@ func getBlockRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/by-nonce/:nonce", Open: true},
 					{Name: "/by-hash/:hash", Open: true},
 					{Name: "/by-round/:round", Open: true},
+					{Name: "/hyperblock/by-nonce/:nonce", Open: true},
 				},
 			},
 		},
@@ -435,3 +437,88 @@ func httpGetBlock(ws *gin.Engine, url string) (blockResponse, int) {
 	loadResponse(httpResponse.Body, &blockResponse)
 	return blockResponse, httpResponse.Code
 }
+
+// ---- hyperblock by nonce
+
+type hyperblockResponseData struct {
+	Hyperblock common.ApiHyperblock `json:"hyperblock"`
+}
+
+type hyperblockResponse struct {
+	Data  hyperblockResponseData `json:"data"`
+	Error string                 `json:"error"`
+	Code  string                 `json:"code"`
+}
+
+func TestGetHyperblockByNonce_InvalidNonceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	blockGroup, err := groups.NewBlockGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(blockGroup, "block", getBlockRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/block/hyperblock/by-nonce/invalid", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := hyperblockResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetHyperblock.Error()))
+}
+
+func TestGetHyperblockByNonce_FacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("local err")
+	facade := mock.FacadeStub{
+		GetHyperblockByNonceCalled: func(_ uint64, _ api.BlockQueryOptions) (*common.ApiHyperblock, error) {
+			return nil, expectedErr
+		},
+	}
+
+	blockGroup, err := groups.NewBlockGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(blockGroup, "block", getBlockRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/block/hyperblock/by-nonce/37", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := hyperblockResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+}
+
+func TestGetHyperblockByNonce_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedHyperblock := common.ApiHyperblock{
+		MetaBlock:   &api.Block{Nonce: 37, Round: 39},
+		ShardBlocks: []*api.Block{{Nonce: 40, Shard: 1}},
+	}
+	facade := mock.FacadeStub{
+		GetHyperblockByNonceCalled: func(nonce uint64, options api.BlockQueryOptions) (*common.ApiHyperblock, error) {
+			require.Equal(t, uint64(37), nonce)
+			require.Equal(t, api.BlockQueryOptions{WithTransactions: true}, options)
+			return &expectedHyperblock, nil
+		},
+	}
+
+	blockGroup, err := groups.NewBlockGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(blockGroup, "block", getBlockRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/block/hyperblock/by-nonce/37?withTxs=true", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := hyperblockResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedHyperblock, response.Data.Hyperblock)
+}
@@ -0,0 +1,217 @@
+package groups
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	getBlocksRangePath      = "/range"
+	getHyperblocksRangePath = "/hyperblocks/range"
+	urlParamTo              = "to"
+	ndjsonContentType       = "application/x-ndjson"
+
+	// maxNumBlocksInRange is the maximum number of blocks that can be streamed by a single request
+	maxNumBlocksInRange = 1000
+)
+
+// blocksRangeQueryParameters holds the URL parameters selecting the streamed nonces range and the blocks content
+var blocksRangeQueryParameters = append([]shared.QueryParameter{
+	{Name: urlParamFrom, Type: shared.IntegerQueryParameter},
+	{Name: urlParamTo, Type: shared.IntegerQueryParameter},
+}, blockQueryParameters...)
+
+// blocksFacadeHandler defines the methods to be implemented by a facade for handling blocks range requests
+type blocksFacadeHandler interface {
+	GetBlockByNonce(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
+	GetHyperblockByNonce(nonce uint64, options api.BlockQueryOptions) (*common.ApiHyperblock, error)
+	IsInterfaceNil() bool
+}
+
+// blocksRangeStreamError is written as the last line of a stream which could not be completed
+type blocksRangeStreamError struct {
+	Nonce uint64 `json:"nonce"`
+	Error string `json:"error"`
+}
+
+type blocksGroup struct {
+	*baseGroup
+	facade    blocksFacadeHandler
+	mutFacade sync.RWMutex
+}
+
+// NewBlocksGroup returns a new instance of blocksGroup
+func NewBlocksGroup(facade blocksFacadeHandler) (*blocksGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for blocks group", apiErrors.ErrNilFacadeHandler)
+	}
+
+	bg := &blocksGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    getBlocksRangePath,
+			Method:  http.MethodGet,
+			Handler: bg.getBlocksRange,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:             "streams the blocks within a nonces range as newline-delimited JSON, ending with a line holding the nonce and the error if a block cannot be fetched",
+				QueryParameters:     blocksRangeQueryParameters,
+				Response:            api.Block{},
+				ResponseContentType: ndjsonContentType,
+			},
+		},
+		{
+			Path:    getHyperblocksRangePath,
+			Method:  http.MethodGet,
+			Handler: bg.getHyperblocksRange,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:             "streams the hyperblocks within a meta nonces range as newline-delimited JSON, ending with a line holding the nonce and the error if a hyperblock cannot be fetched",
+				QueryParameters:     blocksRangeQueryParameters,
+				Response:            common.ApiHyperblock{},
+				ResponseContentType: ndjsonContentType,
+			},
+		},
+	}
+	bg.endpoints = endpoints
+
+	return bg, nil
+}
+
+// getBlocksRange streams the blocks within the requested nonces range as newline-delimited JSON
+func (bg *blocksGroup) getBlocksRange(c *gin.Context) {
+	from, to, options, err := parseBlocksRangeQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrGetBlocksRange, err)
+		return
+	}
+
+	facade := bg.getFacade()
+	streamBlocksRange(c, from, to, func(nonce uint64) (interface{}, error) {
+		return facade.GetBlockByNonce(nonce, options)
+	})
+}
+
+// getHyperblocksRange streams the hyperblocks within the requested meta nonces range as newline-delimited JSON
+func (bg *blocksGroup) getHyperblocksRange(c *gin.Context) {
+	from, to, options, err := parseBlocksRangeQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, apiErrors.ErrGetBlocksRange, err)
+		return
+	}
+
+	facade := bg.getFacade()
+	streamBlocksRange(c, from, to, func(nonce uint64) (interface{}, error) {
+		return facade.GetHyperblockByNonce(nonce, options)
+	})
+}
+
+// streamBlocksRange writes one JSON line for each nonce. Since the status code is already sent when the first line
+// is flushed, a failure is reported as a final line holding the nonce and the error
+func streamBlocksRange(c *gin.Context, from uint64, to uint64, fetch func(nonce uint64) (interface{}, error)) {
+	c.Header("Content-Type", ndjsonContentType)
+	c.Status(http.StatusOK)
+
+	encoder := json.NewEncoder(c.Writer)
+	for nonce := from; ; nonce++ {
+		if c.Request.Context().Err() != nil {
+			return
+		}
+
+		item, err := fetch(nonce)
+		if err != nil {
+			_ = encoder.Encode(blocksRangeStreamError{Nonce: nonce, Error: err.Error()})
+			return
+		}
+
+		err = encoder.Encode(item)
+		if err != nil {
+			log.Debug("blocksGroup: cannot write block in stream", "nonce", nonce, "error", err)
+			return
+		}
+		c.Writer.Flush()
+
+		if nonce == to {
+			return
+		}
+	}
+}
+
+func parseBlocksRangeQueryOptions(c *gin.Context) (uint64, uint64, api.BlockQueryOptions, error) {
+	from, to, err := parseBlocksRange(c)
+	if err != nil {
+		return 0, 0, api.BlockQueryOptions{}, fmt.Errorf("%w: %v", apiErrors.ErrBadUrlParams, err)
+	}
+
+	options, err := parseBlockQueryOptions(c)
+	if err != nil {
+		return 0, 0, api.BlockQueryOptions{}, fmt.Errorf("%w: %v", apiErrors.ErrBadUrlParams, err)
+	}
+
+	return from, to, options, nil
+}
+
+func parseBlocksRange(c *gin.Context) (uint64, uint64, error) {
+	from, err := parseUint64UrlParam(c, urlParamFrom)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	to, err := parseUint64UrlParam(c, urlParamTo)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if !from.HasValue || !to.HasValue {
+		return 0, 0, errors.New("both from and to must be specified")
+	}
+	if to.Value < from.Value {
+		return 0, 0, errors.New("to must not be lower than from")
+	}
+	if to.Value-from.Value >= maxNumBlocksInRange {
+		return 0, 0, fmt.Errorf("at most %d blocks can be requested at once", maxNumBlocksInRange)
+	}
+
+	return from.Value, to.Value, nil
+}
+
+func (bg *blocksGroup) getFacade() blocksFacadeHandler {
+	bg.mutFacade.RLock()
+	defer bg.mutFacade.RUnlock()
+
+	return bg.facade
+}
+
+// UpdateFacade will update the facade
+func (bg *blocksGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return apiErrors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(blocksFacadeHandler)
+	if !ok {
+		return apiErrors.ErrFacadeWrongTypeAssertion
+	}
+
+	bg.mutFacade.Lock()
+	bg.facade = castFacade
+	bg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (bg *blocksGroup) IsInterfaceNil() bool {
+	return bg == nil
+}
@@ -0,0 +1,219 @@
+package groups_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/groups"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type blocksRangeStreamLine struct {
+	Nonce uint64 `json:"nonce"`
+	Hash  string `json:"hash"`
+	Error string `json:"error"`
+}
+
+func TestNewBlocksGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		bg, err := groups.NewBlocksGroup(nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, bg)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		bg, err := groups.NewBlocksGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		require.NotNil(t, bg)
+	})
+}
+
+func TestBlocksGroup_GetBlocksRangeInvalidParamsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetBlockByNonceCalled: func(_ uint64, _ api.BlockQueryOptions) (*api.Block, error) {
+			require.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+
+	blocksGroup, err := groups.NewBlocksGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(blocksGroup, "blocks", getBlocksRoutesConfig())
+
+	urls := []string{
+		"/blocks/range",
+		"/blocks/range?from=1",
+		"/blocks/range?to=1",
+		"/blocks/range?from=a&to=1",
+		"/blocks/range?from=5&to=4",
+		"/blocks/range?from=0&to=1000",
+		"/blocks/range?from=1&to=2&withTxs=maybe",
+	}
+	for _, url := range urls {
+		req, _ := http.NewRequest("GET", url, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code, url)
+		assert.Contains(t, response.Error, apiErrors.ErrGetBlocksRange.Error(), url)
+		assert.Contains(t, response.Error, apiErrors.ErrBadUrlParams.Error(), url)
+	}
+}
+
+func TestBlocksGroup_GetBlocksRangeShouldStream(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetBlockByNonceCalled: func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error) {
+			require.True(t, options.WithTransactions)
+			return &api.Block{Nonce: nonce, Hash: "hash"}, nil
+		},
+	}
+
+	blocksGroup, err := groups.NewBlocksGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(blocksGroup, "blocks", getBlocksRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/blocks/range?from=10&to=12&withTxs=true", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, "application/x-ndjson", resp.Header().Get("Content-Type"))
+
+	lines := readStreamLines(t, resp)
+	require.Len(t, lines, 3)
+	for i, line := range lines {
+		assert.Equal(t, uint64(10+i), line.Nonce)
+		assert.Equal(t, "hash", line.Hash)
+		assert.Empty(t, line.Error)
+	}
+}
+
+func TestBlocksGroup_GetBlocksRangeErrorShouldEndStream(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := &mock.FacadeStub{
+		GetBlockByNonceCalled: func(nonce uint64, _ api.BlockQueryOptions) (*api.Block, error) {
+			if nonce == 11 {
+				return nil, expectedErr
+			}
+			return &api.Block{Nonce: nonce}, nil
+		},
+	}
+
+	blocksGroup, err := groups.NewBlocksGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(blocksGroup, "blocks", getBlocksRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/blocks/range?from=10&to=20", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	lines := readStreamLines(t, resp)
+	require.Len(t, lines, 2)
+	assert.Equal(t, uint64(10), lines[0].Nonce)
+	assert.Empty(t, lines[0].Error)
+	assert.Equal(t, uint64(11), lines[1].Nonce)
+	assert.Equal(t, expectedErr.Error(), lines[1].Error)
+}
+
+func TestBlocksGroup_GetHyperblocksRangeShouldStream(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetHyperblockByNonceCalled: func(nonce uint64, _ api.BlockQueryOptions) (*common.ApiHyperblock, error) {
+			return &common.ApiHyperblock{
+				MetaBlock:   &api.Block{Nonce: nonce},
+				ShardBlocks: []*api.Block{{Nonce: nonce + 100}},
+			}, nil
+		},
+	}
+
+	blocksGroup, err := groups.NewBlocksGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(blocksGroup, "blocks", getBlocksRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/blocks/hyperblocks/range?from=7&to=8", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	scanner := bufio.NewScanner(resp.Body)
+	numLines := 0
+	for scanner.Scan() {
+		hyperblock := common.ApiHyperblock{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &hyperblock))
+		assert.Equal(t, uint64(7+numLines), hyperblock.MetaBlock.Nonce)
+		assert.Equal(t, uint64(107+numLines), hyperblock.ShardBlocks[0].Nonce)
+		numLines++
+	}
+	assert.Equal(t, 2, numLines)
+}
+
+func TestBlocksGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	blocksGroup, err := groups.NewBlocksGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	err = blocksGroup.UpdateFacade(nil)
+	require.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+
+	err = blocksGroup.UpdateFacade("not a facade")
+	require.Equal(t, apiErrors.ErrFacadeWrongTypeAssertion, err)
+
+	err = blocksGroup.UpdateFacade(&mock.FacadeStub{})
+	require.NoError(t, err)
+}
+
+func readStreamLines(t *testing.T, resp *httptest.ResponseRecorder) []blocksRangeStreamLine {
+	lines := make([]blocksRangeStreamLine, 0)
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		line := blocksRangeStreamLine{}
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+func getBlocksRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"blocks": {
+				Routes: []config.RouteConfig{
+					{Name: "/range", Open: true},
+					{Name: "/hyperblocks/range", Open: true},
+				},
+			},
+		},
+	}
+}
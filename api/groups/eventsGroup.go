@@ -0,0 +1,118 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+const getEventsWSPath = "/ws"
+
+// eventsFacadeHandler defines the methods to be implemented by a facade for events subscription requests
+type eventsFacadeHandler interface {
+	IsEventsSubscriptionEnabled() bool
+	ServeEventsSubscriber(conn outport.WSConnection) error
+	IsInterfaceNil() bool
+}
+
+type eventsGroup struct {
+	*baseGroup
+	facade    eventsFacadeHandler
+	mutFacade sync.RWMutex
+	upgrader  websocket.Upgrader
+}
+
+// NewEventsGroup returns a new instance of eventsGroup
+func NewEventsGroup(facade eventsFacadeHandler) (*eventsGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for events group", errors.ErrNilFacadeHandler)
+	}
+
+	eg := &eventsGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+		upgrader: websocket.Upgrader{
+			CheckOrigin: func(r *http.Request) bool {
+				return true
+			},
+		},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    getEventsWSPath,
+			Method:  http.MethodGet,
+			Handler: eg.subscribe,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:   "opens a websocket on which the first message sent by the client selects the notification types and the log event filters, after which the matching blocks and log events are pushed",
+				Websocket: true,
+			},
+		},
+	}
+	eg.endpoints = endpoints
+
+	return eg, nil
+}
+
+// subscribe upgrades the connection to websocket and pushes the blocks and log events matching the
+// subscription sent by the client, until the connection is closed
+func (eg *eventsGroup) subscribe(c *gin.Context) {
+	facade := eg.getFacade()
+	if !facade.IsEventsSubscriptionEnabled() {
+		shared.RespondWith(
+			c,
+			http.StatusServiceUnavailable,
+			nil,
+			errors.ErrEventsSubscriptionDisabled.Error(),
+			shared.ReturnCodeInternalError,
+		)
+		return
+	}
+
+	conn, err := eg.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		log.Debug("events subscription: cannot upgrade connection", "error", err.Error())
+		return
+	}
+
+	err = facade.ServeEventsSubscriber(conn)
+	if err != nil {
+		log.Debug("events subscription: subscriber disconnected", "error", err.Error())
+	}
+}
+
+func (eg *eventsGroup) getFacade() eventsFacadeHandler {
+	eg.mutFacade.RLock()
+	defer eg.mutFacade.RUnlock()
+
+	return eg.facade
+}
+
+// UpdateFacade will update the facade
+func (eg *eventsGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(eventsFacadeHandler)
+	if !ok {
+		return errors.ErrFacadeWrongTypeAssertion
+	}
+
+	eg.mutFacade.Lock()
+	eg.facade = castFacade
+	eg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (eg *eventsGroup) IsInterfaceNil() bool {
+	return eg == nil
+}
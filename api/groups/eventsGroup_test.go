@@ -0,0 +1,146 @@
+package groups_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/groups"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewEventsGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		eg, err := groups.NewEventsGroup(nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, eg)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		eg, err := groups.NewEventsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		require.NotNil(t, eg)
+	})
+}
+
+func TestEventsGroup_SubscribeDisabledShouldErr(t *testing.T) {
+	t.Parallel()
+
+	serveCalled := false
+	facade := &mock.FacadeStub{
+		IsEventsSubscriptionEnabledCalled: func() bool {
+			return false
+		},
+		ServeEventsSubscriberCalled: func(_ outport.WSConnection) error {
+			serveCalled = true
+			return nil
+		},
+	}
+
+	eventsGroup, err := groups.NewEventsGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(eventsGroup, "events", getEventsRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/events/ws", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+	assert.Equal(t, apiErrors.ErrEventsSubscriptionDisabled.Error(), response.Error)
+	assert.False(t, serveCalled)
+}
+
+func TestEventsGroup_SubscribeShouldServeTheUpgradedConnection(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		IsEventsSubscriptionEnabledCalled: func() bool {
+			return true
+		},
+		ServeEventsSubscriberCalled: func(conn outport.WSConnection) error {
+			_, message, err := conn.ReadMessage()
+			if err != nil {
+				return err
+			}
+
+			return conn.WriteMessage(websocket.TextMessage, message)
+		},
+	}
+
+	eventsGroup, err := groups.NewEventsGroup(facade)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(startWebServer(eventsGroup, "events", getEventsRoutesConfig()))
+	defer server.Close()
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/events/ws"
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	subscription := []byte(`{"types":["saveBlock"]}`)
+	err = conn.WriteMessage(websocket.TextMessage, subscription)
+	require.NoError(t, err)
+
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second * 5))
+	_, message, err := conn.ReadMessage()
+	require.NoError(t, err)
+	assert.Equal(t, subscription, message)
+}
+
+func TestEventsGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	eventsGroup, err := groups.NewEventsGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	err = eventsGroup.UpdateFacade(nil)
+	assert.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+
+	err = eventsGroup.UpdateFacade("not a facade")
+	assert.Equal(t, apiErrors.ErrFacadeWrongTypeAssertion, err)
+
+	newFacade := &mock.FacadeStub{
+		IsEventsSubscriptionEnabledCalled: func() bool {
+			return false
+		},
+	}
+	err = eventsGroup.UpdateFacade(newFacade)
+	require.NoError(t, err)
+
+	ws := startWebServer(eventsGroup, "events", getEventsRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/events/ws", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+}
+
+func getEventsRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"events": {
+				Routes: []config.RouteConfig{
+					{Name: "/ws", Open: true},
+				},
+			},
+		},
+	}
+}
@@ -46,6 +46,11 @@ func NewHardforkGroup(facade hardforkFacadeHandler) (*hardforkGroup, error) {
 			Path:    triggerPath,
 			Method:  http.MethodPost,
 			Handler: hg.triggerHandler,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "triggers a hardfork at the given epoch, broadcasting the trigger to the other peers if the node is allowed to",
+				Request:  HardforkRequest{},
+				Response: gin.H{"status": ""},
+			},
 		},
 	}
 	hg.endpoints = endpoints
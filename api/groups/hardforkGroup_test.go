@@ -11,6 +11,7 @@ import (
 
 	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/groups"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/config"
@@ -165,6 +166,42 @@ func TestTrigger_BroadcastShouldWork(t *testing.T) {
 	assert.Equal(t, groups.ExecBroadcastTrigger, triggerResponse.Status)
 }
 
+func TestTrigger_AuthRequiredShouldErrWithoutApiClient(t *testing.T) {
+	t.Parallel()
+
+	triggerCalled := uint32(0)
+	hardforkFacade := &mock.HardforkFacade{
+		TriggerCalled: func(_ uint32, _ bool) error {
+			atomic.AddUint32(&triggerCalled, 1)
+			return nil
+		},
+	}
+
+	hardforkGroup, err := groups.NewHardforkGroup(hardforkFacade)
+	require.NoError(t, err)
+
+	routesConfig := getHardforkRoutesConfig()
+	routesConfig.Auth.Enabled = true
+	routesConfig.APIPackages["hardfork"] = config.APIPackageConfig{
+		Routes: []config.RouteConfig{
+			{Name: "/trigger", Open: true, Auth: true},
+		},
+	}
+	ws := startWebServer(hardforkGroup, "hardfork", routesConfig)
+
+	buffHr, _ := json.Marshal(&groups.HardforkRequest{Epoch: 4})
+	req, _ := http.NewRequest("POST", "/hardfork/trigger", bytes.NewBuffer(buffHr))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	assert.Equal(t, middleware.ErrAuthenticationRequired.Error(), response.Error)
+	assert.Equal(t, uint32(0), atomic.LoadUint32(&triggerCalled))
+}
+
 func getHardforkRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
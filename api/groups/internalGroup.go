@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
 	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/shared/logging"
@@ -69,81 +70,145 @@ func NewInternalBlockGroup(facade internalBlockFacadeHandler) (*internalBlockGro
 			Path:    getRawMetaBlockByNoncePath,
 			Method:  http.MethodGet,
 			Handler: ib.getRawMetaBlockByNonce,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns a meta block by its nonce, as protobuf bytes",
+				Response: gin.H{"block": []byte{}},
+			},
 		},
 		{
 			Path:    getRawMetaBlockByHashPath,
 			Method:  http.MethodGet,
 			Handler: ib.getRawMetaBlockByHash,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns a meta block by its hash, as protobuf bytes",
+				Response: gin.H{"block": []byte{}},
+			},
 		},
 		{
 			Path:    getRawMetaBlockByRoundPath,
 			Method:  http.MethodGet,
 			Handler: ib.getRawMetaBlockByRound,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns a meta block by its round, as protobuf bytes",
+				Response: gin.H{"block": []byte{}},
+			},
 		},
 		{
 			Path:    getRawStartOfEpochMetaBlockPath,
 			Method:  http.MethodGet,
 			Handler: ib.getRawStartOfEpochMetaBlock,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns the start of epoch meta block of an epoch, as protobuf bytes",
+				Response: gin.H{"block": []byte{}},
+			},
 		},
 		{
 			Path:    getRawShardBlockByNoncePath,
 			Method:  http.MethodGet,
 			Handler: ib.getRawShardBlockByNonce,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns a shard block by its nonce, as protobuf bytes",
+				Response: gin.H{"block": []byte{}},
+			},
 		},
 		{
 			Path:    getRawShardBlockByHashPath,
 			Method:  http.MethodGet,
 			Handler: ib.getRawShardBlockByHash,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns a shard block by its hash, as protobuf bytes",
+				Response: gin.H{"block": []byte{}},
+			},
 		},
 		{
 			Path:    getRawShardBlockByRoundPath,
 			Method:  http.MethodGet,
 			Handler: ib.getRawShardBlockByRound,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns a shard block by its round, as protobuf bytes",
+				Response: gin.H{"block": []byte{}},
+			},
 		},
 		{
 			Path:    getJSONMetaBlockByNoncePath,
 			Method:  http.MethodGet,
 			Handler: ib.getJSONMetaBlockByNonce,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns a meta block by its nonce, as stored",
+				Response: gin.H{"block": block.MetaBlock{}},
+			},
 		},
 		{
 			Path:    getJSONMetaBlockByHashPath,
 			Method:  http.MethodGet,
 			Handler: ib.getJSONMetaBlockByHash,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns a meta block by its hash, as stored",
+				Response: gin.H{"block": block.MetaBlock{}},
+			},
 		},
 		{
 			Path:    getJSONMetaBlockByRoundPath,
 			Method:  http.MethodGet,
 			Handler: ib.getJSONMetaBlockByRound,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns a meta block by its round, as stored",
+				Response: gin.H{"block": block.MetaBlock{}},
+			},
 		},
 		{
 			Path:    getJSONStartOfEpochMetaBlockPath,
 			Method:  http.MethodGet,
 			Handler: ib.getJSONStartOfEpochMetaBlock,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns the start of epoch meta block of an epoch, as stored",
+				Response: gin.H{"block": block.MetaBlock{}},
+			},
 		},
 		{
 			Path:    getJSONShardBlockByNoncePath,
 			Method:  http.MethodGet,
 			Handler: ib.getJSONShardBlockByNonce,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns a shard block by its nonce, as stored",
+				Response: gin.H{"block": block.Header{}},
+			},
 		},
 		{
 			Path:    getJSONShardBlockByHashPath,
 			Method:  http.MethodGet,
 			Handler: ib.getJSONShardBlockByHash,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns a shard block by its hash, as stored",
+				Response: gin.H{"block": block.Header{}},
+			},
 		},
 		{
 			Path:    getJSONShardBlockByRoundPath,
 			Method:  http.MethodGet,
 			Handler: ib.getJSONShardBlockByRound,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns a shard block by its round, as stored",
+				Response: gin.H{"block": block.Header{}},
+			},
 		},
 		{
 			Path:    getRawMiniBlockByHashPath,
 			Method:  http.MethodGet,
 			Handler: ib.getRawMiniBlockByHash,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns a miniblock of an epoch by its hash, as protobuf bytes",
+				Response: gin.H{"miniblock": []byte{}},
+			},
 		},
 		{
 			Path:    getJSONMiniBlockByHashPath,
 			Method:  http.MethodGet,
 			Handler: ib.getJSONMiniBlockByHash,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns a miniblock of an epoch by its hash, as stored",
+				Response: gin.H{"miniblock": block.MiniBlock{}},
+			},
 		},
 	}
 	ib.endpoints = endpoints
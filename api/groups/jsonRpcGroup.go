@@ -0,0 +1,572 @@
+package groups
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/data/vm"
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/jsonrpc"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/process"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	jsonRpcHttpPath = ""
+	jsonRpcWSPath   = "/ws"
+
+	jsonRpcMethodGetAccount          = "getAccount"
+	jsonRpcMethodSendTransaction     = "sendTransaction"
+	jsonRpcMethodSimulateTransaction = "simulateTransaction"
+	jsonRpcMethodGetBlockByNonce     = "getBlockByNonce"
+	jsonRpcMethodQuerySC             = "querySC"
+	jsonRpcMethodGetProof            = "getProof"
+
+	// the JSON-RPC methods share the endpoint throttlers of the REST routes they mirror
+	getAccountEndpoint      = "/address/:address"
+	getBlockByNonceEndpoint = "/block/by-nonce/:nonce"
+	querySCEndpoint         = "/vm-values/query"
+
+	maxJsonRpcBatchSize = 100
+)
+
+// jsonRpcFacadeHandler defines the methods to be implemented by a facade for JSON-RPC requests
+type jsonRpcFacadeHandler interface {
+	GetAccount(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error)
+	CreateTransaction(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
+		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*transaction.Transaction, []byte, error)
+	ValidateTransaction(tx *transaction.Transaction) error
+	ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool) error
+	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
+	SimulateTransactionExecution(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error)
+	GetBlockByNonce(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
+	ExecuteSCQuery(*process.SCQuery) (*vm.VMOutputApi, error)
+	DecodeAddressPubkey(pk string) ([]byte, error)
+	GetProof(rootHash string, address string) (*common.GetProofResponse, error)
+	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
+	IsInterfaceNil() bool
+}
+
+// requestsProcessor defines the component able to execute the JSON-RPC payloads
+type requestsProcessor interface {
+	Process(payload []byte, facade interface{}, authorizer jsonrpc.RouteAuthorizer, quotaConsumer jsonrpc.QuotaConsumer) []byte
+	IsInterfaceNil() bool
+}
+
+// JsonRpcAccountParams represents the params of the getAccount JSON-RPC method
+type JsonRpcAccountParams struct {
+	Address        string  `json:"address"`
+	OnFinalBlock   bool    `json:"onFinalBlock"`
+	OnStartOfEpoch *uint32 `json:"onStartOfEpoch"`
+	BlockNonce     *uint64 `json:"blockNonce"`
+	BlockHash      string  `json:"blockHash"`
+	BlockRootHash  string  `json:"blockRootHash"`
+	HintEpoch      *uint32 `json:"hintEpoch"`
+}
+
+// JsonRpcSimulateTransactionParams represents the params of the simulateTransaction JSON-RPC method
+type JsonRpcSimulateTransactionParams struct {
+	SendTxRequest
+	CheckSignature *bool `json:"checkSignature"`
+	WithTrace      bool  `json:"withTrace"`
+	WithStateDiff  bool  `json:"withStateDiff"`
+}
+
+// JsonRpcBlockByNonceParams represents the params of the getBlockByNonce JSON-RPC method
+type JsonRpcBlockByNonceParams struct {
+	Nonce    uint64 `json:"nonce"`
+	WithTxs  bool   `json:"withTxs"`
+	WithLogs bool   `json:"withLogs"`
+}
+
+// JsonRpcProofParams represents the params of the getProof JSON-RPC method
+type JsonRpcProofParams struct {
+	RootHash string `json:"rootHash"`
+	Address  string `json:"address"`
+}
+
+type jsonRpcGroup struct {
+	*baseGroup
+	facade       jsonRpcFacadeHandler
+	mutFacade    sync.RWMutex
+	processor    requestsProcessor
+	mutProcessor sync.RWMutex
+	upgrader     websocket.Upgrader
+}
+
+// NewJsonRpcGroup returns a new instance of jsonRpcGroup
+func NewJsonRpcGroup(facade jsonRpcFacadeHandler) (*jsonRpcGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for json-rpc group", errors.ErrNilFacadeHandler)
+	}
+
+	jg := &jsonRpcGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+		upgrader: websocket.Upgrader{
+			CheckOrigin: func(r *http.Request) bool {
+				return true
+			},
+		},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    jsonRpcHttpPath,
+			Method:  http.MethodPost,
+			Handler: jg.handleHttpRequest,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:             "executes a JSON-RPC 2.0 request, or a batch of requests, calling the enabled methods",
+				Request:             jsonrpc.Request{},
+				Response:            jsonrpc.Response{},
+				ResponseContentType: "application/json",
+			},
+		},
+		{
+			Path:    jsonRpcWSPath,
+			Method:  http.MethodGet,
+			Handler: jg.handleWebsocket,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:   "opens a websocket on which JSON-RPC 2.0 requests are sent as text messages and answered on the same connection",
+				Websocket: true,
+			},
+		},
+	}
+	jg.endpoints = endpoints
+
+	return jg, nil
+}
+
+// RegisterRoutes will register the transport endpoints and will enable the JSON-RPC methods which are
+// opened in the group's routes configuration
+func (jg *jsonRpcGroup) RegisterRoutes(ws *gin.RouterGroup, apiConfig config.ApiRoutesConfig) {
+	enabledMethods := make([]*jsonrpc.MethodHandlerData, 0)
+	for _, method := range jg.createMethods() {
+		properties := getEndpointProperties(ws, method.Name, apiConfig)
+		if !properties.isOpen {
+			log.Debug("JSON-RPC method is closed", "method", method.Name)
+			continue
+		}
+
+		if properties.requiresAuth {
+			method.AuthRoutes = append(method.AuthRoutes, "/"+getGroupName(ws)+"/"+method.Name)
+		}
+		// a method is callable without credentials only if the REST route it mirrors is callable without them
+		if getMirroredRouteProperties(method.ThrottlerName, apiConfig).requiresAuth {
+			method.AuthRoutes = append(method.AuthRoutes, method.ThrottlerName)
+		}
+
+		enabledMethods = append(enabledMethods, method)
+	}
+
+	processor, err := jsonrpc.NewRequestsProcessor(jsonrpc.ArgsRequestsProcessor{
+		Methods:      enabledMethods,
+		MaxBatchSize: maxJsonRpcBatchSize,
+	})
+	if err != nil {
+		log.Error("cannot create the JSON-RPC requests processor", "error", err)
+		return
+	}
+
+	jg.mutProcessor.Lock()
+	jg.processor = processor
+	jg.mutProcessor.Unlock()
+
+	jg.baseGroup.RegisterRoutes(ws, apiConfig)
+}
+
+func getMirroredRouteProperties(route string, apiConfig config.ApiRoutesConfig) endpointProperties {
+	// the mirrored routes have the form /group/path
+	tokens := strings.SplitN(strings.TrimPrefix(route, "/"), "/", 2)
+	if len(tokens) != 2 {
+		return endpointProperties{}
+	}
+
+	return getRouteProperties(tokens[0], "/"+tokens[1], apiConfig)
+}
+
+func (jg *jsonRpcGroup) createMethods() []*jsonrpc.MethodHandlerData {
+	return []*jsonrpc.MethodHandlerData{
+		{
+			Name:          jsonRpcMethodGetAccount,
+			Handler:       jg.getAccount,
+			ThrottlerName: getAccountEndpoint,
+		},
+		{
+			Name:          jsonRpcMethodSendTransaction,
+			Handler:       jg.sendTransaction,
+			ThrottlerName: sendTransactionEndpoint,
+		},
+		{
+			Name:          jsonRpcMethodSimulateTransaction,
+			Handler:       jg.simulateTransaction,
+			ThrottlerName: simulateTransactionEndpoint,
+		},
+		{
+			Name:          jsonRpcMethodGetBlockByNonce,
+			Handler:       jg.getBlockByNonce,
+			ThrottlerName: getBlockByNonceEndpoint,
+		},
+		{
+			Name:          jsonRpcMethodQuerySC,
+			Handler:       jg.querySC,
+			ThrottlerName: querySCEndpoint,
+		},
+		{
+			Name:          jsonRpcMethodGetProof,
+			Handler:       jg.getProof,
+			ThrottlerName: getProofEndpoint,
+		},
+	}
+}
+
+// handleHttpRequest executes the single or batch JSON-RPC request found in the body
+func (jg *jsonRpcGroup) handleHttpRequest(c *gin.Context) {
+	processor := jg.getProcessor()
+	if check.IfNil(processor) {
+		shared.RespondWithInternalError(c, errors.ErrJsonRpc, errors.ErrJsonRpcNotStarted)
+		return
+	}
+
+	payload, err := ioutil.ReadAll(c.Request.Body)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrJsonRpc, err)
+		return
+	}
+
+	// the first call was charged when the request was received
+	response := processor.Process(payload, jg.getFacade(), createRouteAuthorizer(c), createQuotaConsumer(c, 1))
+	if response == nil {
+		c.Status(http.StatusNoContent)
+		return
+	}
+
+	c.Data(http.StatusOK, "application/json", response)
+}
+
+// handleWebsocket upgrades the connection to websocket and executes the JSON-RPC requests received as text
+// messages, until the connection is closed
+func (jg *jsonRpcGroup) handleWebsocket(c *gin.Context) {
+	processor := jg.getProcessor()
+	if check.IfNil(processor) {
+		shared.RespondWithInternalError(c, errors.ErrJsonRpc, errors.ErrJsonRpcNotStarted)
+		return
+	}
+
+	conn, err := jg.upgrader.Upgrade(c.Writer, c.Request, nil)
+	if err != nil {
+		log.Debug("json-rpc: cannot upgrade connection", "error", err.Error())
+		return
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	// the API client is identified once, on the upgrade request, and is charged for each call sent afterwards
+	authorizer := createRouteAuthorizer(c)
+	quotaConsumer := createQuotaConsumer(c, 0)
+
+	for {
+		messageType, payload, errRead := conn.ReadMessage()
+		if errRead != nil {
+			log.Debug("json-rpc: websocket connection closed", "error", errRead.Error())
+			return
+		}
+		if messageType != websocket.TextMessage {
+			continue
+		}
+
+		response := processor.Process(payload, jg.getFacade(), authorizer, quotaConsumer)
+		if response == nil {
+			continue
+		}
+
+		errWrite := conn.WriteMessage(websocket.TextMessage, response)
+		if errWrite != nil {
+			log.Debug("json-rpc: cannot write on websocket connection", "error", errWrite.Error())
+			return
+		}
+	}
+}
+
+func createRouteAuthorizer(c *gin.Context) jsonrpc.RouteAuthorizer {
+	return func(route string) error {
+		return middleware.CheckRouteAllowed(c, route)
+	}
+}
+
+func createQuotaConsumer(c *gin.Context, numPrechargedCalls int) jsonrpc.QuotaConsumer {
+	numCalls := 0
+	return func() error {
+		numCalls++
+		if numCalls <= numPrechargedCalls {
+			return nil
+		}
+
+		return middleware.ConsumeQuota(c)
+	}
+}
+
+func (jg *jsonRpcGroup) getAccount(params json.RawMessage) (interface{}, error) {
+	request := JsonRpcAccountParams{}
+	err := unmarshalJsonRpcParams(params, &request)
+	if err != nil {
+		return nil, err
+	}
+	if request.Address == "" {
+		return nil, newJsonRpcValidationError(errors.ErrEmptyAddress)
+	}
+
+	options, err := request.toAccountQueryOptions()
+	if err != nil {
+		return nil, newJsonRpcValidationError(err)
+	}
+
+	accountResponse, blockInfo, err := jg.getFacade().GetAccount(request.Address, options)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrCouldNotGetAccount, err)
+	}
+
+	accountResponse.Address = request.Address
+	return gin.H{"account": accountResponse, "blockInfo": blockInfo}, nil
+}
+
+func (request *JsonRpcAccountParams) toAccountQueryOptions() (api.AccountQueryOptions, error) {
+	blockHash, err := hex.DecodeString(request.BlockHash)
+	if err != nil {
+		return api.AccountQueryOptions{}, err
+	}
+
+	blockRootHash, err := hex.DecodeString(request.BlockRootHash)
+	if err != nil {
+		return api.AccountQueryOptions{}, err
+	}
+
+	options := api.AccountQueryOptions{
+		OnFinalBlock:  request.OnFinalBlock,
+		BlockHash:     blockHash,
+		BlockRootHash: blockRootHash,
+	}
+	if request.OnStartOfEpoch != nil {
+		options.OnStartOfEpoch = core.OptionalUint32{Value: *request.OnStartOfEpoch, HasValue: true}
+	}
+	if request.BlockNonce != nil {
+		options.BlockNonce = core.OptionalUint64{Value: *request.BlockNonce, HasValue: true}
+	}
+	if request.HintEpoch != nil {
+		options.HintEpoch = core.OptionalUint32{Value: *request.HintEpoch, HasValue: true}
+	}
+
+	return options, checkAccountQueryOptions(options)
+}
+
+func (jg *jsonRpcGroup) sendTransaction(params json.RawMessage) (interface{}, error) {
+	request := SendTxRequest{}
+	err := unmarshalJsonRpcParams(params, &request)
+	if err != nil {
+		return nil, err
+	}
+
+	facade := jg.getFacade()
+	tx, txHash, err := jg.createTransaction(facade, &request)
+	if err != nil {
+		return nil, err
+	}
+
+	err = facade.ValidateTransaction(tx)
+	if err != nil {
+		return nil, jsonrpc.NewInvalidParamsError(fmt.Errorf("%w: %v", errors.ErrTxGenerationFailed, err))
+	}
+
+	_, err = facade.SendBulkTransactions([]*transaction.Transaction{tx})
+	if err != nil {
+		return nil, err
+	}
+
+	return gin.H{"txHash": hex.EncodeToString(txHash)}, nil
+}
+
+func (jg *jsonRpcGroup) simulateTransaction(params json.RawMessage) (interface{}, error) {
+	request := JsonRpcSimulateTransactionParams{}
+	err := unmarshalJsonRpcParams(params, &request)
+	if err != nil {
+		return nil, err
+	}
+
+	checkSignature := true
+	if request.CheckSignature != nil {
+		checkSignature = *request.CheckSignature
+	}
+
+	facade := jg.getFacade()
+	tx, txHash, err := jg.createTransaction(facade, &request.SendTxRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	err = facade.ValidateTransactionForSimulation(tx, checkSignature)
+	if err != nil {
+		return nil, jsonrpc.NewInvalidParamsError(fmt.Errorf("%w: %v", errors.ErrTxGenerationFailed, err))
+	}
+
+	options := txSimData.SimulationOptions{
+		WithTrace:     request.WithTrace,
+		WithStateDiff: request.WithStateDiff,
+	}
+	executionResults, err := facade.SimulateTransactionExecution(tx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	executionResults.Hash = hex.EncodeToString(txHash)
+	return gin.H{"result": executionResults}, nil
+}
+
+func (jg *jsonRpcGroup) createTransaction(facade jsonRpcFacadeHandler, request *SendTxRequest) (*transaction.Transaction, []byte, error) {
+	tx, txHash, err := facade.CreateTransaction(
+		request.Nonce,
+		request.Value,
+		request.Receiver,
+		request.ReceiverUsername,
+		request.Sender,
+		request.SenderUsername,
+		request.GasPrice,
+		request.GasLimit,
+		request.Data,
+		request.Signature,
+		request.ChainID,
+		request.Version,
+		request.Options,
+	)
+	if err != nil {
+		return nil, nil, jsonrpc.NewInvalidParamsError(fmt.Errorf("%w: %v", errors.ErrTxGenerationFailed, err))
+	}
+
+	return tx, txHash, nil
+}
+
+func (jg *jsonRpcGroup) getBlockByNonce(params json.RawMessage) (interface{}, error) {
+	request := JsonRpcBlockByNonceParams{}
+	err := unmarshalJsonRpcParams(params, &request)
+	if err != nil {
+		return nil, err
+	}
+
+	options := api.BlockQueryOptions{WithTransactions: request.WithTxs, WithLogs: request.WithLogs}
+	block, err := jg.getFacade().GetBlockByNonce(request.Nonce, options)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrGetBlock, err)
+	}
+
+	return gin.H{"block": block}, nil
+}
+
+func (jg *jsonRpcGroup) querySC(params json.RawMessage) (interface{}, error) {
+	request := VMValueRequest{}
+	err := unmarshalJsonRpcParams(params, &request)
+	if err != nil {
+		return nil, err
+	}
+
+	facade := jg.getFacade()
+	scQuery, err := newSCQuery(facade, &request)
+	if err != nil {
+		return nil, newJsonRpcValidationError(err)
+	}
+
+	vmOutputApi, err := facade.ExecuteSCQuery(scQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	return gin.H{"data": vmOutputApi}, nil
+}
+
+func (jg *jsonRpcGroup) getProof(params json.RawMessage) (interface{}, error) {
+	request := JsonRpcProofParams{}
+	err := unmarshalJsonRpcParams(params, &request)
+	if err != nil {
+		return nil, err
+	}
+	if request.RootHash == "" {
+		return nil, newJsonRpcValidationError(errors.ErrValidationEmptyRootHash)
+	}
+	if request.Address == "" {
+		return nil, newJsonRpcValidationError(errors.ErrValidationEmptyAddress)
+	}
+
+	response, err := jg.getFacade().GetProof(request.RootHash, request.Address)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errors.ErrGetProof, err)
+	}
+
+	return gin.H{"proof": bytesToHex(response.Proof), "value": hex.EncodeToString(response.Value)}, nil
+}
+
+func unmarshalJsonRpcParams(params json.RawMessage, destination interface{}) error {
+	if len(params) == 0 {
+		return newJsonRpcValidationError(errors.ErrJsonRpcMissingParams)
+	}
+
+	err := json.Unmarshal(params, destination)
+	if err != nil {
+		return newJsonRpcValidationError(err)
+	}
+
+	return nil
+}
+
+func newJsonRpcValidationError(err error) error {
+	return jsonrpc.NewInvalidParamsError(fmt.Errorf("%w: %v", errors.ErrValidation, err))
+}
+
+func (jg *jsonRpcGroup) getProcessor() requestsProcessor {
+	jg.mutProcessor.RLock()
+	defer jg.mutProcessor.RUnlock()
+
+	return jg.processor
+}
+
+func (jg *jsonRpcGroup) getFacade() jsonRpcFacadeHandler {
+	jg.mutFacade.RLock()
+	defer jg.mutFacade.RUnlock()
+
+	return jg.facade
+}
+
+// UpdateFacade will update the facade
+func (jg *jsonRpcGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(jsonRpcFacadeHandler)
+	if !ok {
+		return errors.ErrFacadeWrongTypeAssertion
+	}
+
+	jg.mutFacade.Lock()
+	jg.facade = castFacade
+	jg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (jg *jsonRpcGroup) IsInterfaceNil() bool {
+	return jg == nil
+}
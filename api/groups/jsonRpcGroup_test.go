@@ -0,0 +1,384 @@
+package groups_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/groups"
+	"github.com/ElrondNetwork/elrond-go/api/jsonrpc"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewJsonRpcGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		jg, err := groups.NewJsonRpcGroup(nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, jg)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		jg, err := groups.NewJsonRpcGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		require.NotNil(t, jg)
+	})
+}
+
+func TestJsonRpcGroup_GetAccountShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetAccountCalled: func(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error) {
+			assert.True(t, options.OnFinalBlock)
+			return api.AccountResponse{Nonce: 7}, api.BlockInfo{Nonce: 37}, nil
+		},
+	}
+
+	payload := `{"jsonrpc":"2.0","id":1,"method":"getAccount","params":{"address":"erd1alice","onFinalBlock":true}}`
+	response := doJsonRpcHttpRequest(t, facade, getJsonRpcRoutesConfig(), payload)
+	require.Nil(t, response.Error)
+
+	result := response.Result.(map[string]interface{})
+	account := result["account"].(map[string]interface{})
+	assert.Equal(t, "erd1alice", account["address"])
+	assert.Equal(t, float64(7), account["nonce"])
+	assert.Equal(t, float64(37), result["blockInfo"].(map[string]interface{})["nonce"])
+}
+
+func TestJsonRpcGroup_GetAccountInvalidParamsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	payload := `{"jsonrpc":"2.0","id":1,"method":"getAccount","params":{"address":"erd1alice","blockHash":"zz"}}`
+	response := doJsonRpcHttpRequest(t, &mock.FacadeStub{}, getJsonRpcRoutesConfig(), payload)
+	require.NotNil(t, response.Error)
+	assert.Equal(t, jsonrpc.ErrCodeInvalidParams, response.Error.Code)
+	assert.True(t, strings.Contains(response.Error.Message, apiErrors.ErrValidation.Error()))
+}
+
+func TestJsonRpcGroup_SendTransactionShouldWork(t *testing.T) {
+	t.Parallel()
+
+	sentTxs := 0
+	facade := &mock.FacadeStub{
+		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
+			gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*transaction.Transaction, []byte, error) {
+			return &transaction.Transaction{Nonce: nonce}, []byte("hash"), nil
+		},
+		ValidateTransactionHandler: func(tx *transaction.Transaction) error {
+			return nil
+		},
+		SendBulkTransactionsHandler: func(txs []*transaction.Transaction) (uint64, error) {
+			sentTxs += len(txs)
+			return uint64(len(txs)), nil
+		},
+	}
+
+	payload := `{"jsonrpc":"2.0","id":1,"method":"sendTransaction","params":{"nonce":1,"sender":"erd1alice"}}`
+	response := doJsonRpcHttpRequest(t, facade, getJsonRpcRoutesConfig(), payload)
+	require.Nil(t, response.Error)
+	assert.Equal(t, "68617368", response.Result.(map[string]interface{})["txHash"])
+	assert.Equal(t, 1, sentTxs)
+}
+
+func TestJsonRpcGroup_GetBlockByNonceFacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := &mock.FacadeStub{
+		GetBlockByNonceCalled: func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error) {
+			return nil, expectedErr
+		},
+	}
+
+	payload := `{"jsonrpc":"2.0","id":1,"method":"getBlockByNonce","params":{"nonce":10}}`
+	response := doJsonRpcHttpRequest(t, facade, getJsonRpcRoutesConfig(), payload)
+	require.NotNil(t, response.Error)
+	assert.Equal(t, jsonrpc.ErrCodeServer, response.Error.Code)
+	assert.True(t, strings.Contains(response.Error.Message, expectedErr.Error()))
+}
+
+func TestJsonRpcGroup_ClosedMethodShouldNotBeFound(t *testing.T) {
+	t.Parallel()
+
+	routesConfig := getJsonRpcRoutesConfig()
+	routesConfig.APIPackages["jsonrpc"] = config.APIPackageConfig{
+		Routes: []config.RouteConfig{
+			{Name: "", Open: true},
+			{Name: "getAccount", Open: false},
+		},
+	}
+
+	payload := `{"jsonrpc":"2.0","id":1,"method":"getAccount","params":{"address":"erd1alice"}}`
+	response := doJsonRpcHttpRequest(t, &mock.FacadeStub{}, routesConfig, payload)
+	require.NotNil(t, response.Error)
+	assert.Equal(t, jsonrpc.ErrCodeMethodNotFound, response.Error.Code)
+}
+
+func TestJsonRpcGroup_MethodMirroringAuthRequiredRouteShouldErrWithoutApiClient(t *testing.T) {
+	t.Parallel()
+
+	sentTxs := 0
+	facade := &mock.FacadeStub{
+		SendBulkTransactionsHandler: func(txs []*transaction.Transaction) (uint64, error) {
+			sentTxs += len(txs)
+			return uint64(len(txs)), nil
+		},
+		GetAccountCalled: func(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error) {
+			return api.AccountResponse{Nonce: 7}, api.BlockInfo{}, nil
+		},
+	}
+
+	routesConfig := getJsonRpcRoutesConfig()
+	routesConfig.Auth.Enabled = true
+	routesConfig.APIPackages["transaction"] = config.APIPackageConfig{
+		Routes: []config.RouteConfig{
+			{Name: "/send", Open: true, Auth: true},
+		},
+	}
+
+	payload := `{"jsonrpc":"2.0","id":1,"method":"sendTransaction","params":{"nonce":1,"sender":"erd1alice"}}`
+	response := doJsonRpcHttpRequest(t, facade, routesConfig, payload)
+	require.NotNil(t, response.Error)
+	assert.Equal(t, jsonrpc.ErrCodeUnauthorized, response.Error.Code)
+	assert.Equal(t, middleware.ErrAuthenticationRequired.Error(), response.Error.Message)
+	assert.Equal(t, 0, sentTxs)
+
+	payload = `{"jsonrpc":"2.0","id":2,"method":"getAccount","params":{"address":"erd1alice"}}`
+	response = doJsonRpcHttpRequest(t, facade, routesConfig, payload)
+	require.Nil(t, response.Error)
+}
+
+func TestJsonRpcGroup_MethodRequiringAuthShouldErrWithoutApiClient(t *testing.T) {
+	t.Parallel()
+
+	routesConfig := getJsonRpcRoutesConfig()
+	routesConfig.Auth.Enabled = true
+	routesConfig.APIPackages["jsonrpc"] = config.APIPackageConfig{
+		Routes: []config.RouteConfig{
+			{Name: "", Open: true},
+			{Name: "getAccount", Open: true, Auth: true},
+		},
+	}
+
+	payload := `{"jsonrpc":"2.0","id":1,"method":"getAccount","params":{"address":"erd1alice"}}`
+	response := doJsonRpcHttpRequest(t, &mock.FacadeStub{}, routesConfig, payload)
+	require.NotNil(t, response.Error)
+	assert.Equal(t, jsonrpc.ErrCodeUnauthorized, response.Error.Code)
+}
+
+func TestJsonRpcGroup_BatchShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetBlockByNonceCalled: func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error) {
+			return &api.Block{Nonce: nonce}, nil
+		},
+	}
+
+	jsonRpcGroup, err := groups.NewJsonRpcGroup(facade)
+	require.NoError(t, err)
+	ws := startWebServer(jsonRpcGroup, "jsonrpc", getJsonRpcRoutesConfig())
+
+	payload := `[
+		{"jsonrpc":"2.0","id":1,"method":"getBlockByNonce","params":{"nonce":10}},
+		{"jsonrpc":"2.0","method":"getBlockByNonce","params":{"nonce":11}},
+		{"jsonrpc":"2.0","id":2,"method":"getBlockByNonce","params":{"nonce":12}}
+	]`
+	req, _ := http.NewRequest("POST", "/jsonrpc", bytes.NewBufferString(payload))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	responses := make([]jsonrpc.Response, 0)
+	loadResponse(resp.Body, &responses)
+	require.Equal(t, 2, len(responses))
+	assert.Equal(t, json.RawMessage("1"), responses[0].ID)
+	assert.Equal(t, json.RawMessage("2"), responses[1].ID)
+}
+
+func TestJsonRpcGroup_BatchShouldChargeTheQuotaForEachCall(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetBlockByNonceCalled: func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error) {
+			return &api.Block{Nonce: nonce}, nil
+		},
+	}
+
+	authenticator, err := middleware.NewAuthenticator(config.ApiAuthConfig{
+		Enabled:                 true,
+		QuotaResetIntervalInSec: 60,
+		Clients: []config.ApiClientConfig{
+			{Name: "explorer", APIKey: "explorer key", MaxRequestsPerInterval: 2},
+		},
+	})
+	require.NoError(t, err)
+	jsonRpcGroup, err := groups.NewJsonRpcGroup(facade)
+	require.NoError(t, err)
+	ws := gin.New()
+	ws.Use(authenticator.MiddlewareHandlerFunc())
+	jsonRpcGroup.RegisterRoutes(ws.Group("jsonrpc"), getJsonRpcRoutesConfig())
+
+	payload := `[
+		{"jsonrpc":"2.0","id":1,"method":"getBlockByNonce","params":{"nonce":10}},
+		{"jsonrpc":"2.0","id":2,"method":"getBlockByNonce","params":{"nonce":11}},
+		{"jsonrpc":"2.0","id":3,"method":"getBlockByNonce","params":{"nonce":12}}
+	]`
+	req, _ := http.NewRequest("POST", "/jsonrpc", bytes.NewBufferString(payload))
+	req.Header.Set(middleware.ApiKeyHeader, "explorer key")
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	responses := make([]jsonrpc.Response, 0)
+	loadResponse(resp.Body, &responses)
+	require.Equal(t, 3, len(responses))
+	assert.Nil(t, responses[0].Error)
+	assert.Nil(t, responses[1].Error)
+	require.NotNil(t, responses[2].Error)
+	assert.Equal(t, jsonrpc.ErrCodeTooManyRequests, responses[2].Error.Code)
+}
+
+func TestJsonRpcGroup_NotificationShouldRespondWithNoContent(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetBlockByNonceCalled: func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error) {
+			return &api.Block{Nonce: nonce}, nil
+		},
+	}
+
+	jsonRpcGroup, err := groups.NewJsonRpcGroup(facade)
+	require.NoError(t, err)
+	ws := startWebServer(jsonRpcGroup, "jsonrpc", getJsonRpcRoutesConfig())
+
+	payload := `{"jsonrpc":"2.0","method":"getBlockByNonce","params":{"nonce":10}}`
+	req, _ := http.NewRequest("POST", "/jsonrpc", bytes.NewBufferString(payload))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusNoContent, resp.Code)
+}
+
+func TestJsonRpcGroup_WebsocketShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetBlockByNonceCalled: func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error) {
+			return &api.Block{Nonce: nonce}, nil
+		},
+	}
+
+	jsonRpcGroup, err := groups.NewJsonRpcGroup(facade)
+	require.NoError(t, err)
+
+	server := httptest.NewServer(startWebServer(jsonRpcGroup, "jsonrpc", getJsonRpcRoutesConfig()))
+	defer server.Close()
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/jsonrpc/ws"
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	require.NoError(t, err)
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	request := []byte(`{"jsonrpc":"2.0","id":5,"method":"getBlockByNonce","params":{"nonce":10}}`)
+	err = conn.WriteMessage(websocket.TextMessage, request)
+	require.NoError(t, err)
+
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second * 5))
+	_, message, err := conn.ReadMessage()
+	require.NoError(t, err)
+
+	response := jsonrpc.Response{}
+	err = json.Unmarshal(message, &response)
+	require.NoError(t, err)
+	require.Nil(t, response.Error)
+	assert.Equal(t, json.RawMessage("5"), response.ID)
+	block := response.Result.(map[string]interface{})["block"].(map[string]interface{})
+	assert.Equal(t, float64(10), block["nonce"])
+}
+
+func TestJsonRpcGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	jsonRpcGroup, err := groups.NewJsonRpcGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	err = jsonRpcGroup.UpdateFacade(nil)
+	assert.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+
+	err = jsonRpcGroup.UpdateFacade("not a facade")
+	assert.Equal(t, apiErrors.ErrFacadeWrongTypeAssertion, err)
+
+	newFacade := &mock.FacadeStub{
+		GetBlockByNonceCalled: func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error) {
+			return &api.Block{Nonce: 100}, nil
+		},
+	}
+	err = jsonRpcGroup.UpdateFacade(newFacade)
+	require.NoError(t, err)
+
+	ws := startWebServer(jsonRpcGroup, "jsonrpc", getJsonRpcRoutesConfig())
+	payload := `{"jsonrpc":"2.0","id":1,"method":"getBlockByNonce","params":{"nonce":10}}`
+	req, _ := http.NewRequest("POST", "/jsonrpc", bytes.NewBufferString(payload))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := jsonrpc.Response{}
+	loadResponse(resp.Body, &response)
+	require.Nil(t, response.Error)
+	block := response.Result.(map[string]interface{})["block"].(map[string]interface{})
+	assert.Equal(t, float64(100), block["nonce"])
+}
+
+func doJsonRpcHttpRequest(t *testing.T, facade *mock.FacadeStub, routesConfig config.ApiRoutesConfig, payload string) jsonrpc.Response {
+	jsonRpcGroup, err := groups.NewJsonRpcGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(jsonRpcGroup, "jsonrpc", routesConfig)
+
+	req, _ := http.NewRequest("POST", "/jsonrpc", bytes.NewBufferString(payload))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	response := jsonrpc.Response{}
+	loadResponse(resp.Body, &response)
+
+	return response
+}
+
+func getJsonRpcRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"jsonrpc": {
+				Routes: []config.RouteConfig{
+					{Name: "", Open: true},
+					{Name: "/ws", Open: true},
+					{Name: "getAccount", Open: true},
+					{Name: "sendTransaction", Open: true},
+					{Name: "simulateTransaction", Open: true},
+					{Name: "getBlockByNonce", Open: true},
+					{Name: "querySC", Open: true},
+					{Name: "getProof", Open: true},
+				},
+			},
+		},
+	}
+}
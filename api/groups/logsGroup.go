@@ -0,0 +1,117 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	getLogEventsPath   = ""
+	urlParamAddress    = "address"
+	urlParamIdentifier = "identifier"
+	urlParamTopic      = "topic"
+	urlParamFromNonce  = "fromNonce"
+	urlParamToNonce    = "toNonce"
+)
+
+// logEventsQueryParameters holds the URL parameters filtering the log events and selecting the queried block range
+var logEventsQueryParameters = []shared.QueryParameter{
+	{Name: urlParamAddress, Type: shared.StringQueryParameter},
+	{Name: urlParamIdentifier, Type: shared.StringQueryParameter},
+	{Name: urlParamTopic, Type: shared.StringQueryParameter},
+	{Name: urlParamFromNonce, Type: shared.IntegerQueryParameter},
+	{Name: urlParamToNonce, Type: shared.IntegerQueryParameter},
+}
+
+// logsFacadeHandler defines the methods to be implemented by a facade for logs requests
+type logsFacadeHandler interface {
+	GetLogEvents(options common.LogEventsQueryOptions) (*common.LogEventsApiResponse, error)
+	IsInterfaceNil() bool
+}
+
+type logsGroup struct {
+	*baseGroup
+	facade    logsFacadeHandler
+	mutFacade sync.RWMutex
+}
+
+// NewLogsGroup returns a new instance of logsGroup
+func NewLogsGroup(facade logsFacadeHandler) (*logsGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for logs group", errors.ErrNilFacadeHandler)
+	}
+
+	lg := &logsGroup{
+		facade:    facade,
+		baseGroup: &baseGroup{},
+	}
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    getLogEventsPath,
+			Method:  http.MethodGet,
+			Handler: lg.getLogEvents,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:         "returns the log events matching the address, identifier and hex encoded topic filters, within a block nonces range",
+				QueryParameters: logEventsQueryParameters,
+				Response:        common.LogEventsApiResponse{},
+			},
+		},
+	}
+	lg.endpoints = endpoints
+
+	return lg, nil
+}
+
+// getLogEvents returns the log events matching the address, identifier and topic filters, within the requested block range
+func (lg *logsGroup) getLogEvents(c *gin.Context) {
+	options, err := extractLogEventsQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetLogEvents, err)
+		return
+	}
+
+	response, err := lg.getFacade().GetLogEvents(options)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetLogEvents, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"fromNonce": response.FromNonce, "toNonce": response.ToNonce, "events": response.Events})
+}
+
+func (lg *logsGroup) getFacade() logsFacadeHandler {
+	lg.mutFacade.RLock()
+	defer lg.mutFacade.RUnlock()
+
+	return lg.facade
+}
+
+// UpdateFacade will update the facade
+func (lg *logsGroup) UpdateFacade(newFacade interface{}) error {
+	if newFacade == nil {
+		return errors.ErrNilFacadeHandler
+	}
+	castFacade, ok := newFacade.(logsFacadeHandler)
+	if !ok {
+		return errors.ErrFacadeWrongTypeAssertion
+	}
+
+	lg.mutFacade.Lock()
+	lg.facade = castFacade
+	lg.mutFacade.Unlock()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (lg *logsGroup) IsInterfaceNil() bool {
+	return lg == nil
+}
@@ -0,0 +1,57 @@
+package groups
+
+import (
+	"errors"
+	"fmt"
+
+	customErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/node/external/logs"
+	"github.com/gin-gonic/gin"
+)
+
+func extractLogEventsQueryOptions(c *gin.Context) (common.LogEventsQueryOptions, error) {
+	options, err := parseLogEventsQueryOptions(c)
+	if err != nil {
+		return common.LogEventsQueryOptions{}, fmt.Errorf("%w: %v", customErrors.ErrBadUrlParams, err)
+	}
+
+	return options, nil
+}
+
+func parseLogEventsQueryOptions(c *gin.Context) (common.LogEventsQueryOptions, error) {
+	topic, err := parseHexBytesUrlParam(c, urlParamTopic)
+	if err != nil {
+		return common.LogEventsQueryOptions{}, err
+	}
+
+	fromNonce, err := parseUint64UrlParam(c, urlParamFromNonce)
+	if err != nil {
+		return common.LogEventsQueryOptions{}, err
+	}
+
+	toNonce, err := parseUint64UrlParam(c, urlParamToNonce)
+	if err != nil {
+		return common.LogEventsQueryOptions{}, err
+	}
+
+	if !fromNonce.HasValue || !toNonce.HasValue {
+		return common.LogEventsQueryOptions{}, errors.New("both fromNonce and toNonce must be specified")
+	}
+	if toNonce.Value < fromNonce.Value {
+		return common.LogEventsQueryOptions{}, errors.New("toNonce must not be lower than fromNonce")
+	}
+	if toNonce.Value-fromNonce.Value >= logs.MaxNumBlocksInLogEventsQuery {
+		return common.LogEventsQueryOptions{}, fmt.Errorf("at most %d blocks can be queried at once", logs.MaxNumBlocksInLogEventsQuery)
+	}
+
+	query := c.Request.URL.Query()
+	options := common.LogEventsQueryOptions{
+		Address:    query.Get(urlParamAddress),
+		Identifier: query.Get(urlParamIdentifier),
+		Topic:      topic,
+		FromNonce:  fromNonce.Value,
+		ToNonce:    toNonce.Value,
+	}
+	return options, nil
+}
@@ -0,0 +1,186 @@
+package groups_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/groups"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type logEventsResponseData struct {
+	FromNonce uint64                        `json:"fromNonce"`
+	ToNonce   uint64                        `json:"toNonce"`
+	Events    []*common.LogEventApiResponse `json:"events"`
+}
+
+type logEventsResponse struct {
+	Data  logEventsResponseData `json:"data"`
+	Error string                `json:"error"`
+	Code  string                `json:"code"`
+}
+
+func TestNewLogsGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade", func(t *testing.T) {
+		lg, err := groups.NewLogsGroup(nil)
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.Nil(t, lg)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		lg, err := groups.NewLogsGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+		require.NotNil(t, lg)
+	})
+}
+
+func TestLogsGroup_GetLogEventsInvalidParamsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetLogEventsCalled: func(_ common.LogEventsQueryOptions) (*common.LogEventsApiResponse, error) {
+			require.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+
+	logsGroup, err := groups.NewLogsGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(logsGroup, "logs", getLogsRoutesConfig())
+
+	urls := []string{
+		"/logs",
+		"/logs?fromNonce=1",
+		"/logs?toNonce=1",
+		"/logs?fromNonce=a&toNonce=1",
+		"/logs?fromNonce=5&toNonce=4",
+		"/logs?fromNonce=0&toNonce=1000",
+		"/logs?fromNonce=1&toNonce=2&topic=zz",
+	}
+	for _, url := range urls {
+		req, _ := http.NewRequest("GET", url, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := logEventsResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code, url)
+		assert.Contains(t, response.Error, apiErrors.ErrGetLogEvents.Error(), url)
+		assert.Contains(t, response.Error, apiErrors.ErrBadUrlParams.Error(), url)
+	}
+}
+
+func TestLogsGroup_GetLogEventsFacadeErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := &mock.FacadeStub{
+		GetLogEventsCalled: func(_ common.LogEventsQueryOptions) (*common.LogEventsApiResponse, error) {
+			return nil, expectedErr
+		},
+	}
+
+	logsGroup, err := groups.NewLogsGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(logsGroup, "logs", getLogsRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/logs?fromNonce=1&toNonce=5", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := logEventsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, response.Error, expectedErr.Error())
+}
+
+func TestLogsGroup_GetLogEventsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedEvents := []*common.LogEventApiResponse{
+		{
+			TxHash:     "aa",
+			BlockNonce: 3,
+			BlockHash:  "bb",
+			Address:    "erd1",
+			Identifier: "ESDTTransfer",
+			Topics:     [][]byte{[]byte("TKN")},
+		},
+	}
+	facade := &mock.FacadeStub{
+		GetLogEventsCalled: func(options common.LogEventsQueryOptions) (*common.LogEventsApiResponse, error) {
+			require.Equal(t, common.LogEventsQueryOptions{
+				Address:    "erd1",
+				Identifier: "ESDTTransfer",
+				Topic:      []byte("TKN"),
+				FromNonce:  1,
+				ToNonce:    5,
+			}, options)
+
+			return &common.LogEventsApiResponse{
+				FromNonce: options.FromNonce,
+				ToNonce:   options.ToNonce,
+				Events:    expectedEvents,
+			}, nil
+		},
+	}
+
+	logsGroup, err := groups.NewLogsGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(logsGroup, "logs", getLogsRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/logs?address=erd1&identifier=ESDTTransfer&topic=544b4e&fromNonce=1&toNonce=5", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := logEventsResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, response.Error)
+	assert.Equal(t, uint64(1), response.Data.FromNonce)
+	assert.Equal(t, uint64(5), response.Data.ToNonce)
+	assert.Equal(t, expectedEvents, response.Data.Events)
+}
+
+func TestLogsGroup_UpdateFacade(t *testing.T) {
+	t.Parallel()
+
+	logsGroup, err := groups.NewLogsGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	err = logsGroup.UpdateFacade(nil)
+	require.Equal(t, apiErrors.ErrNilFacadeHandler, err)
+
+	err = logsGroup.UpdateFacade("not a facade")
+	require.Equal(t, apiErrors.ErrFacadeWrongTypeAssertion, err)
+
+	err = logsGroup.UpdateFacade(&mock.FacadeStub{})
+	require.NoError(t, err)
+}
+
+func getLogsRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"logs": {
+				Routes: []config.RouteConfig{
+					{Name: "", Open: true},
+				},
+			},
+		},
+	}
+}
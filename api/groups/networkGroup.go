@@ -27,6 +27,8 @@ const (
 	getSFTsPath            = "/esdt/semi-fungible-tokens"
 	getNFTsPath            = "/esdt/non-fungible-tokens"
 	getESDTSupplyPath      = "/esdt/supply/:token"
+	getESDTSupplyHistory   = "/esdt/supply/:token/history"
+	getESDTHoldersPath     = "/esdt/holders/:token"
 	directStakedInfoPath   = "/direct-staked-info"
 	delegatedInfoPath      = "/delegated-info"
 	ratingsPath            = "/ratings"
@@ -43,6 +45,8 @@ type networkFacadeHandler interface {
 	StatusMetrics() external.StatusMetricsHandler
 	GetAllIssuedESDTs(tokenType string) ([]string, error)
 	GetTokenSupply(token string) (*api.ESDTSupply, error)
+	GetTokenSupplyHistory(token string, fromEpoch uint32, toEpoch uint32) (*common.ESDTSupplyHistoryApiResponse, error)
+	GetESDTHolders(options common.ESDTHoldersQueryOptions) (*common.ESDTHoldersApiResponse, error)
 	GetGenesisNodesPubKeys() (map[uint32][]string, map[uint32][]string, error)
 	GetGenesisBalances() ([]*common.InitialAccountAPI, error)
 	GetGasConfigs() (map[string]map[string]uint64, error)
@@ -83,76 +87,156 @@ func NewNetworkGroup(facade networkFacadeHandler) (*networkGroup, error) {
 			Path:    getConfigPath,
 			Method:  http.MethodGet,
 			Handler: ng.getNetworkConfig,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns the configuration metrics of the network",
+				Response: gin.H{"config": map[string]interface{}{}},
+			},
 		},
 		{
 			Path:    getStatusPath,
 			Method:  http.MethodGet,
 			Handler: ng.getNetworkStatus,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns the status metrics of the network, as seen by the node",
+				Response: gin.H{"status": map[string]interface{}{}},
+			},
 		},
 		{
 			Path:    economicsPath,
 			Method:  http.MethodGet,
 			Handler: ng.economicsMetrics,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns the economics metrics of the network, such as the total supply and the staked value",
+				Response: gin.H{"metrics": map[string]interface{}{}},
+			},
 		},
 		{
 			Path:    enableEpochsPath,
 			Method:  http.MethodGet,
 			Handler: ng.getEnableEpochs,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns the activation epochs of the protocol features",
+				Response: gin.H{"enableEpochs": map[string]interface{}{}},
+			},
 		},
 		{
 			Path:    getESDTsPath,
 			Method:  http.MethodGet,
 			Handler: ng.getHandlerFuncForEsdt(""),
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns the identifiers of all the issued ESDT tokens",
+				Response: gin.H{"tokens": []string{}},
+			},
 		},
 		{
 			Path:    getFFTsPath,
 			Method:  http.MethodGet,
 			Handler: ng.getHandlerFuncForEsdt(core.FungibleESDT),
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns the identifiers of the issued fungible ESDT tokens",
+				Response: gin.H{"tokens": []string{}},
+			},
 		},
 		{
 			Path:    getSFTsPath,
 			Method:  http.MethodGet,
 			Handler: ng.getHandlerFuncForEsdt(core.SemiFungibleESDT),
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns the identifiers of the issued semi-fungible ESDT tokens",
+				Response: gin.H{"tokens": []string{}},
+			},
 		},
 		{
 			Path:    getNFTsPath,
 			Method:  http.MethodGet,
 			Handler: ng.getHandlerFuncForEsdt(core.NonFungibleESDT),
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns the identifiers of the issued non-fungible ESDT tokens",
+				Response: gin.H{"tokens": []string{}},
+			},
 		},
 		{
 			Path:    directStakedInfoPath,
 			Method:  http.MethodGet,
 			Handler: ng.directStakedInfo,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns the values staked directly by each staker",
+				Response: gin.H{"list": []*api.DirectStakedValue{}},
+			},
 		},
 		{
 			Path:    delegatedInfoPath,
 			Method:  http.MethodGet,
 			Handler: ng.delegatedInfo,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns the values delegated by each delegator",
+				Response: gin.H{"list": []*api.Delegator{}},
+			},
 		},
 		{
 			Path:    getESDTSupplyPath,
 			Method:  http.MethodGet,
 			Handler: ng.getESDTTokenSupply,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns the supply of a token",
+				Response: api.ESDTSupply{},
+			},
+		},
+		{
+			Path:    getESDTSupplyHistory,
+			Method:  http.MethodGet,
+			Handler: ng.getESDTTokenSupplyHistory,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:         "returns the supply changes of a token, for each epoch of the range in which the supply changed",
+				QueryParameters: esdtSupplyHistoryQueryParameters,
+				Response:        common.ESDTSupplyHistoryApiResponse{},
+			},
+		},
+		{
+			Path:    getESDTHoldersPath,
+			Method:  http.MethodGet,
+			Handler: ng.getESDTHolders,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:         "returns the holders of a token and their balances, at the current state or at the provided root hash",
+				QueryParameters: esdtHoldersQueryParameters,
+				Response:        common.ESDTHoldersApiResponse{},
+			},
 		},
 		{
 			Path:    ratingsPath,
 			Method:  http.MethodGet,
 			Handler: ng.getRatingsConfig,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns the ratings configuration metrics",
+				Response: gin.H{"config": map[string]interface{}{}},
+			},
 		},
 		{
 			Path:    genesisNodesConfigPath,
 			Method:  http.MethodGet,
 			Handler: ng.getGenesisNodesConfig,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns the eligible and waiting nodes of each shard at genesis",
+				Response: gin.H{"nodes": GenesisNodesConfig{}},
+			},
 		},
 		{
 			Path:    genesisBalances,
 			Method:  http.MethodGet,
 			Handler: ng.getGenesisBalances,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns the initial accounts at genesis",
+				Response: gin.H{"balances": []*common.InitialAccountAPI{}},
+			},
 		},
 		{
 			Path:    gasConfigPath,
 			Method:  http.MethodGet,
 			Handler: ng.getGasConfig,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns the gas costs of the built-in functions and of the metachain system smart contracts",
+				Response: gin.H{"gasConfigs": GasConfig{}},
+			},
 		},
 	}
 	ng.endpoints = endpoints
@@ -382,6 +466,46 @@ func (ng *networkGroup) getESDTTokenSupply(c *gin.Context) {
 	)
 }
 
+// getESDTTokenSupplyHistory returns the supply changes of a token, for each epoch in which the supply changed
+func (ng *networkGroup) getESDTTokenSupplyHistory(c *gin.Context) {
+	token := c.Param("token")
+	if token == "" {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrBadUrlParams)
+		return
+	}
+
+	fromEpoch, toEpoch, err := extractESDTSupplyHistoryRange(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetESDTSupplyHistory, err)
+		return
+	}
+
+	supplyHistory, err := ng.getFacade().GetTokenSupplyHistory(token, fromEpoch, toEpoch)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetESDTSupplyHistory, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, supplyHistory)
+}
+
+// getESDTHolders returns the holders of a token, together with their balances
+func (ng *networkGroup) getESDTHolders(c *gin.Context) {
+	options, err := extractESDTHoldersQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetESDTHolders, err)
+		return
+	}
+
+	holders, err := ng.getFacade().GetESDTHolders(options)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetESDTHolders, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, holders)
+}
+
 // getRatingsConfig returns metrics related to ratings configuration
 func (ng *networkGroup) getRatingsConfig(c *gin.Context) {
 	ratingsConfig, err := ng.getFacade().StatusMetrics().RatingsMetrics()
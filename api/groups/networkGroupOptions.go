@@ -0,0 +1,103 @@
+package groups
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	customErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	urlParamFromEpoch           = "fromEpoch"
+	urlParamToEpoch             = "toEpoch"
+	urlParamTokenNonce          = "nonce"
+	maxNumEpochsInSupplyHistory = 100
+)
+
+// esdtSupplyHistoryQueryParameters holds the URL parameters selecting the epochs range of a supply history
+var esdtSupplyHistoryQueryParameters = []shared.QueryParameter{
+	{Name: urlParamFromEpoch, Type: shared.IntegerQueryParameter},
+	{Name: urlParamToEpoch, Type: shared.IntegerQueryParameter},
+}
+
+// esdtHoldersQueryParameters holds the URL parameters selecting the token nonce and the state of a holders query
+var esdtHoldersQueryParameters = []shared.QueryParameter{
+	{Name: urlParamTokenNonce, Type: shared.IntegerQueryParameter},
+	{Name: urlParamBlockRootHash, Type: shared.StringQueryParameter},
+}
+
+func extractESDTSupplyHistoryRange(c *gin.Context) (uint32, uint32, error) {
+	fromEpoch, toEpoch, err := parseESDTSupplyHistoryRange(c)
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: %v", customErrors.ErrBadUrlParams, err)
+	}
+
+	return fromEpoch, toEpoch, nil
+}
+
+// parseESDTSupplyHistoryRange returns the epochs range of a supply history request. The range starts by default with
+// epoch 0 and spans by default the maximum number of epochs allowed
+func parseESDTSupplyHistoryRange(c *gin.Context) (uint32, uint32, error) {
+	fromEpoch, err := parseUint32UrlParam(c, urlParamFromEpoch)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	toEpoch, err := parseUint32UrlParam(c, urlParamToEpoch)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if !toEpoch.HasValue {
+		lastEpoch := uint64(fromEpoch.Value) + maxNumEpochsInSupplyHistory - 1
+		if lastEpoch > math.MaxUint32 {
+			lastEpoch = math.MaxUint32
+		}
+		return fromEpoch.Value, uint32(lastEpoch), nil
+	}
+	if toEpoch.Value < fromEpoch.Value {
+		return 0, 0, errors.New("toEpoch must not be lower than fromEpoch")
+	}
+	if toEpoch.Value-fromEpoch.Value >= maxNumEpochsInSupplyHistory {
+		return 0, 0, fmt.Errorf("at most %d epochs can be queried at once", maxNumEpochsInSupplyHistory)
+	}
+
+	return fromEpoch.Value, toEpoch.Value, nil
+}
+
+func extractESDTHoldersQueryOptions(c *gin.Context) (common.ESDTHoldersQueryOptions, error) {
+	options, err := parseESDTHoldersQueryOptions(c)
+	if err != nil {
+		return common.ESDTHoldersQueryOptions{}, fmt.Errorf("%w: %v", customErrors.ErrBadUrlParams, err)
+	}
+
+	return options, nil
+}
+
+func parseESDTHoldersQueryOptions(c *gin.Context) (common.ESDTHoldersQueryOptions, error) {
+	token := c.Param("token")
+	if len(token) == 0 {
+		return common.ESDTHoldersQueryOptions{}, errors.New("empty token identifier")
+	}
+
+	nonce, err := parseUint64UrlParam(c, urlParamTokenNonce)
+	if err != nil {
+		return common.ESDTHoldersQueryOptions{}, err
+	}
+
+	rootHash, err := parseHexBytesUrlParam(c, urlParamBlockRootHash)
+	if err != nil {
+		return common.ESDTHoldersQueryOptions{}, err
+	}
+
+	options := common.ESDTHoldersQueryOptions{
+		Token:    token,
+		Nonce:    nonce.Value,
+		RootHash: rootHash,
+	}
+	return options, nil
+}
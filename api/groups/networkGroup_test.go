@@ -699,6 +699,186 @@ func TestGetESDTTotalSupply(t *testing.T) {
 	}}, respSupply)
 }
 
+func TestGetESDTSupplyHistory(t *testing.T) {
+	t.Parallel()
+
+	type supplyHistoryResponse struct {
+		Data  *common.ESDTSupplyHistoryApiResponse `json:"data"`
+		Error string                               `json:"error"`
+	}
+
+	t.Run("invalid epochs range, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetTokenSupplyHistoryCalled: func(token string, fromEpoch uint32, toEpoch uint32) (*common.ESDTSupplyHistoryApiResponse, error) {
+				require.Fail(t, "should not have been called")
+				return nil, nil
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		for _, query := range []string{"?fromEpoch=5&toEpoch=4", "?fromEpoch=0&toEpoch=100", "?toEpoch=a"} {
+			req, _ := http.NewRequest("GET", "/network/esdt/supply/mytoken-aabb/history"+query, nil)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			response := &shared.GenericAPIResponse{}
+			loadResponse(resp.Body, response)
+			assert.Equal(t, http.StatusBadRequest, resp.Code)
+			assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetESDTSupplyHistory.Error()))
+		}
+	})
+	t.Run("facade error, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetTokenSupplyHistoryCalled: func(token string, fromEpoch uint32, toEpoch uint32) (*common.ESDTSupplyHistoryApiResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/esdt/supply/mytoken-aabb/history", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedHistory := &common.ESDTSupplyHistoryApiResponse{
+			Token: "mytoken-aabb",
+			Epochs: []*common.ESDTSupplyInEpochApiResponse{
+				{Epoch: 3, SupplyDelta: "100", Minted: "150", Burned: "50"},
+			},
+		}
+		facade := mock.FacadeStub{
+			GetTokenSupplyHistoryCalled: func(token string, fromEpoch uint32, toEpoch uint32) (*common.ESDTSupplyHistoryApiResponse, error) {
+				assert.Equal(t, "mytoken-aabb", token)
+				assert.Equal(t, uint32(2), fromEpoch)
+				assert.Equal(t, uint32(101), toEpoch)
+				return expectedHistory, nil
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/esdt/supply/mytoken-aabb/history?fromEpoch=2", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &supplyHistoryResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedHistory, response.Data)
+	})
+}
+
+func TestGetESDTHolders(t *testing.T) {
+	t.Parallel()
+
+	type holdersResponse struct {
+		Data  *common.ESDTHoldersApiResponse `json:"data"`
+		Error string                         `json:"error"`
+	}
+
+	t.Run("invalid root hash, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{}
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/esdt/holders/mytoken-aabb?blockRootHash=zz", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetESDTHolders.Error()))
+	})
+	t.Run("facade error, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetESDTHoldersCalled: func(options common.ESDTHoldersQueryOptions) (*common.ESDTHoldersApiResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/esdt/holders/mytoken-aabb", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedHolders := &common.ESDTHoldersApiResponse{
+			Token:    "mytoken-aabb",
+			Nonce:    7,
+			RootHash: "aabb",
+			Holders: []*common.ESDTHolderApiResponse{
+				{Address: "erd1", Balance: "10"},
+			},
+		}
+		facade := mock.FacadeStub{
+			GetESDTHoldersCalled: func(options common.ESDTHoldersQueryOptions) (*common.ESDTHoldersApiResponse, error) {
+				assert.Equal(t, common.ESDTHoldersQueryOptions{
+					Token:    "mytoken-aabb",
+					Nonce:    7,
+					RootHash: []byte{0xaa, 0xbb},
+				}, options)
+				return expectedHolders, nil
+			},
+		}
+
+		networkGroup, err := groups.NewNetworkGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(networkGroup, "network", getNetworkRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/network/esdt/holders/mytoken-aabb?nonce=7&blockRootHash=aabb", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &holdersResponse{}
+		loadResponse(resp.Body, response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedHolders, response.Data)
+	})
+}
+
 func TestGetGenesisNodes(t *testing.T) {
 	t.Parallel()
 
@@ -916,6 +1096,8 @@ func getNetworkRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/direct-staked-info", Open: true},
 					{Name: "/delegated-info", Open: true},
 					{Name: "/esdt/supply/:token", Open: true},
+					{Name: "/esdt/supply/:token/history", Open: true},
+					{Name: "/esdt/holders/:token", Open: true},
 					{Name: "/genesis-nodes", Open: true},
 					{Name: "/genesis-balances", Open: true},
 					{Name: "/ratings", Open: true},
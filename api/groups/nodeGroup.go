@@ -65,36 +65,67 @@ func NewNodeGroup(facade nodeFacadeHandler) (*nodeGroup, error) {
 			Path:    heartbeatStatusPath,
 			Method:  http.MethodGet,
 			Handler: ng.heartbeatStatus,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns the heartbeat status of the known validators and observers",
+				Response: gin.H{"heartbeats": []data.PubKeyHeartbeat{}},
+			},
 		},
 		{
 			Path:    statusPath,
 			Method:  http.MethodGet,
 			Handler: ng.statusMetrics,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns the status metrics of the node, without the p2p ones",
+				Response: gin.H{"metrics": map[string]interface{}{}},
+			},
 		},
 		{
 			Path:    p2pStatusPath,
 			Method:  http.MethodGet,
 			Handler: ng.p2pStatusMetrics,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns the p2p status metrics of the node",
+				Response: gin.H{"metrics": map[string]interface{}{}},
+			},
 		},
 		{
 			Path:    metricsPath,
 			Method:  http.MethodGet,
 			Handler: ng.prometheusMetrics,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:             "returns the status metrics of the node, without the p2p ones, in the Prometheus text format",
+				Response:            "",
+				ResponseContentType: "text/plain",
+			},
 		},
 		{
 			Path:    debugPath,
 			Method:  http.MethodPost,
 			Handler: ng.queryDebug,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns the debug information of the named query handler matching the search",
+				Request:  QueryDebugRequest{},
+				Response: gin.H{"result": []string{}},
+			},
 		},
 		{
 			Path:    peerInfoPath,
 			Method:  http.MethodGet,
 			Handler: ng.peerInfo,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:         "returns the p2p information of the peers having the provided pid or public key",
+				QueryParameters: []shared.QueryParameter{{Name: pidQueryParam, Type: shared.StringQueryParameter}},
+				Response:        gin.H{"info": []core.QueryP2PPeerInfo{}},
+			},
 		},
 		{
 			Path:    epochStartDataForEpoch,
 			Method:  http.MethodGet,
 			Handler: ng.epochStartDataForEpoch,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns the data of the start of epoch meta block of an epoch",
+				Response: gin.H{"epochStart": common.EpochStartDataAPI{}},
+			},
 		},
 	}
 	ng.endpoints = endpoints
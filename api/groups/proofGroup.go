@@ -58,6 +58,10 @@ func NewProofGroup(facade proofFacadeHandler) (*proofGroup, error) {
 			Path:    getProofPath,
 			Method:  http.MethodGet,
 			Handler: pg.getProof,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns the Merkle proof of an address in the state trie with the given root hash",
+				Response: gin.H{"proof": []string{}, "value": ""},
+			},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(getProofEndpoint, facade),
@@ -69,6 +73,10 @@ func NewProofGroup(facade proofFacadeHandler) (*proofGroup, error) {
 			Path:    getProofDataTriePath,
 			Method:  http.MethodGet,
 			Handler: pg.getProofDataTrie,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns the Merkle proofs of an address in the state trie with the given root hash and of a key in the data trie of that address",
+				Response: gin.H{"proofs": map[string][]string{}, "value": "", "dataTrieRootHash": ""},
+			},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(getProofDataTrieEndpoint, facade),
@@ -80,6 +88,10 @@ func NewProofGroup(facade proofFacadeHandler) (*proofGroup, error) {
 			Path:    getProofCurrentRootHashPath,
 			Method:  http.MethodGet,
 			Handler: pg.getProofCurrentRootHash,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns the Merkle proof of an address in the current state trie, along with the root hash of that trie",
+				Response: gin.H{"proof": []string{}, "value": "", "rootHash": ""},
+			},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(getProofCurrentRootHashEndpoint, facade),
@@ -91,6 +103,11 @@ func NewProofGroup(facade proofFacadeHandler) (*proofGroup, error) {
 			Path:    verifyProofPath,
 			Method:  http.MethodPost,
 			Handler: pg.verifyProof,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "verifies the Merkle proof of an address against a state trie root hash",
+				Request:  VerifyProofRequest{},
+				Response: gin.H{"ok": false},
+			},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(verifyProofEndpoint, facade),
@@ -0,0 +1,90 @@
+package groups
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+// maxNumStateOverrides is the maximum number of accounts which can be overridden in a request
+const maxNumStateOverrides = 100
+
+// AccountStateOverrideRequest holds the values which will replace the state of an account while executing a
+// simulation or a query. The code and the storage keys and values are hex encoded
+type AccountStateOverrideRequest struct {
+	Balance string            `json:"balance,omitempty"`
+	Nonce   *uint64           `json:"nonce,omitempty"`
+	Code    string            `json:"code,omitempty"`
+	Storage map[string]string `json:"storage,omitempty"`
+}
+
+func newStateOverrides(decoder addressPubkeyDecoder, requests map[string]*AccountStateOverrideRequest) (map[string]*state.AccountOverride, error) {
+	if len(requests) == 0 {
+		return nil, nil
+	}
+	if len(requests) > maxNumStateOverrides {
+		return nil, fmt.Errorf("too many state overrides: provided %d, maximum %d", len(requests), maxNumStateOverrides)
+	}
+
+	overrides := make(map[string]*state.AccountOverride, len(requests))
+	for address, request := range requests {
+		decodedAddress, err := decoder.DecodeAddressPubkey(address)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a valid address: %s", address, err.Error())
+		}
+		if request == nil {
+			continue
+		}
+
+		override, err := newAccountOverride(request)
+		if err != nil {
+			return nil, fmt.Errorf("invalid state override for %s: %w", address, err)
+		}
+
+		overrides[string(decodedAddress)] = override
+	}
+
+	return overrides, nil
+}
+
+func newAccountOverride(request *AccountStateOverrideRequest) (*state.AccountOverride, error) {
+	override := &state.AccountOverride{
+		Nonce: request.Nonce,
+	}
+
+	if len(request.Balance) > 0 {
+		balance, ok := big.NewInt(0).SetString(request.Balance, 10)
+		if !ok || balance.Sign() < 0 {
+			return nil, fmt.Errorf("invalid balance %s", request.Balance)
+		}
+		override.Balance = balance
+	}
+
+	if len(request.Code) > 0 {
+		code, err := hex.DecodeString(request.Code)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a valid hex string: %s", request.Code, err.Error())
+		}
+		override.Code = code
+	}
+
+	if len(request.Storage) > 0 {
+		override.Storage = make(map[string][]byte, len(request.Storage))
+	}
+	for key, value := range request.Storage {
+		keyBytes, err := hex.DecodeString(key)
+		if err != nil || len(keyBytes) == 0 {
+			return nil, fmt.Errorf("'%s' is not a valid storage key", key)
+		}
+		valueBytes, err := hex.DecodeString(value)
+		if err != nil {
+			return nil, fmt.Errorf("'%s' is not a valid hex string: %s", value, err.Error())
+		}
+
+		override.Storage[string(keyBytes)] = valueBytes
+	}
+
+	return override, nil
+}
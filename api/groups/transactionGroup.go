@@ -2,6 +2,7 @@ package groups
 
 import (
 	"encoding/hex"
+	errorsGo "errors"
 	"fmt"
 	"math/big"
 	"net/http"
@@ -17,45 +18,111 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/api/shared/logging"
 	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/gin-gonic/gin"
 )
 
 const (
-	sendTransactionEndpoint          = "/transaction/send"
-	simulateTransactionEndpoint      = "/transaction/simulate"
-	sendMultipleTransactionsEndpoint = "/transaction/send-multiple"
-	getTransactionEndpoint           = "/transaction/:hash"
-	sendTransactionPath              = "/send"
-	simulateTransactionPath          = "/simulate"
-	costPath                         = "/cost"
-	sendMultiplePath                 = "/send-multiple"
-	getTransactionPath               = "/:txhash"
-	getTransactionsPool              = "/pool"
+	sendTransactionEndpoint           = "/transaction/send"
+	simulateTransactionEndpoint       = "/transaction/simulate"
+	simulateTransactionsBatchEndpoint = "/transaction/simulate-batch"
+	sendMultipleTransactionsEndpoint  = "/transaction/send-multiple"
+	getTransactionEndpoint            = "/transaction/:hash"
+	getTransactionTraceEndpoint       = "/transaction/:hash/trace"
+	waitForTransactionStatusEndpoint  = "/transaction/:hash/wait"
+	estimateTransactionGasEndpoint    = "/transaction/estimate-gas"
+	estimateScrGasEndpoint            = "/transaction/estimate-gas-scr"
+	scheduleTransactionEndpoint       = "/transaction/schedule"
+	sendTransactionPath               = "/send"
+	simulateTransactionPath           = "/simulate"
+	simulateTransactionsBatchPath     = "/simulate-batch"
+	costPath                          = "/cost"
+	estimateGasPath                   = "/estimate-gas"
+	estimateScrGasPath                = "/estimate-gas-scr"
+	sendMultiplePath                  = "/send-multiple"
+	getTransactionPath                = "/:txhash"
+	getTransactionTracePath           = "/:txhash/trace"
+	waitForTransactionStatusPath      = "/:txhash/wait"
+	getTransactionsPool               = "/pool"
+	scheduleTransactionPath           = "/schedule"
+	getScheduledTransactionsPath      = "/scheduled"
+	cancelScheduledTransactionPath    = "/scheduled/:txhash"
 
 	queryParamWithResults    = "withResults"
 	queryParamCheckSignature = "checkSignature"
+	queryParamWithTrace      = "withTrace"
+	queryParamWithStateDiff  = "withStateDiff"
 	queryParamSender         = "by-sender"
 	queryParamFields         = "fields"
 	queryParamLastNonce      = "last-nonce"
 	queryParamNonceGaps      = "nonce-gaps"
+	queryParamReplacements   = "replacements"
+	queryParamStats          = "stats"
+	queryParamEvictions      = "evictions"
+	queryParamOrderBy        = "order-by"
+	queryParamReceiver       = "receiver"
+	queryParamDataPrefix     = "data-prefix"
+	queryParamStatus         = "status"
+	queryParamTimeout        = "timeout"
+
+	defaultTransactionStatusWaitTimeout = 30 * time.Second
+	maxTransactionStatusWaitTimeout     = 60 * time.Second
 )
 
+// simulationQueryParameters holds the URL parameters of the simulation endpoints
+var simulationQueryParameters = []shared.QueryParameter{
+	{Name: queryParamCheckSignature, Type: shared.BooleanQueryParameter},
+	{Name: queryParamWithTrace, Type: shared.BooleanQueryParameter},
+	{Name: queryParamWithStateDiff, Type: shared.BooleanQueryParameter},
+}
+
+// transactionsPoolQueryParameters holds the URL parameters filtering the transactions pool and selecting the entry
+// returned for a sender
+var transactionsPoolQueryParameters = []shared.QueryParameter{
+	{Name: queryParamSender, Type: shared.StringQueryParameter},
+	{Name: queryParamFields, Type: shared.StringQueryParameter},
+	{Name: queryParamOrderBy, Type: shared.StringQueryParameter},
+	{Name: queryParamReceiver, Type: shared.StringQueryParameter},
+	{Name: queryParamDataPrefix, Type: shared.StringQueryParameter},
+	{Name: queryParamLastNonce, Type: shared.BooleanQueryParameter},
+	{Name: queryParamNonceGaps, Type: shared.BooleanQueryParameter},
+	{Name: queryParamReplacements, Type: shared.BooleanQueryParameter},
+	{Name: queryParamStats, Type: shared.BooleanQueryParameter},
+	{Name: queryParamEvictions, Type: shared.BooleanQueryParameter},
+}
+
 // transactionFacadeHandler defines the methods to be implemented by a facade for transaction requests
 type transactionFacadeHandler interface {
 	CreateTransaction(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
 		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*transaction.Transaction, []byte, error)
 	ValidateTransaction(tx *transaction.Transaction) error
 	ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool) error
+	ValidateTransactionForScheduling(tx *transaction.Transaction) error
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
-	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ScheduleTransaction(tx *transaction.Transaction, condition string, conditionValue uint64) (string, error)
+	GetScheduledTransactions() ([]common.ScheduledTransactionApiResponse, error)
+	CancelScheduledTransaction(txHash string) error
+	SimulateTransactionExecution(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error)
+	SimulateTransactionExecutionWithStateOverrides(tx *transaction.Transaction, overrides map[string]*state.AccountOverride, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error)
+	GetTransactionTrace(hash string) (*txSimData.SimulationResults, error)
+	SimulateTransactionsBatchExecution(txs []*transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.BatchSimulationResults, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
-	GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error)
+	GetTransactionStateDiff(hash string) ([]*txSimData.AccountStateDiff, error)
+	WaitForTransactionStatus(hash string, status string, timeout time.Duration) (*common.TransactionStatusWaitApiResponse, error)
+	GetTransactionsPool(options common.TransactionsPoolQueryOptions) (*common.TransactionsPoolAPIResponse, error)
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionsPoolReplacementsForSender(sender string) (*common.TransactionsPoolReplacementsForSenderApiResponse, error)
+	GetTransactionsPoolStatsForSender(sender string) (*common.TransactionsPoolSenderStatsApiResponse, error)
+	GetTransactionsPoolEvictions() (*common.TransactionsPoolEvictionsApiResponse, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	EstimateTransactionGas(tx *transaction.Transaction) (*txSimData.GasEstimation, error)
+	EstimateSmartContractResultGas(scr *transaction.ApiSmartContractResult) (*txSimData.GasEstimation, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
+	DecodeAddressPubkey(pk string) ([]byte, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
 	IsInterfaceNil() bool
 }
@@ -82,6 +149,11 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 			Path:    sendTransactionPath,
 			Method:  http.MethodPost,
 			Handler: tg.sendTransaction,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "sends a signed transaction",
+				Request:  SendTxRequest{},
+				Response: gin.H{"txHash": ""},
+			},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(sendTransactionEndpoint, facade),
@@ -93,6 +165,12 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 			Path:    simulateTransactionPath,
 			Method:  http.MethodPost,
 			Handler: tg.simulateTransaction,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:         "simulates the execution of a transaction",
+				QueryParameters: simulationQueryParameters,
+				Request:         SimulateTxRequest{},
+				Response:        gin.H{"result": txSimData.SimulationResults{}},
+			},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(simulateTransactionEndpoint, facade),
@@ -100,15 +178,81 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 				},
 			},
 		},
+		{
+			Path:    simulateTransactionsBatchPath,
+			Method:  http.MethodPost,
+			Handler: tg.simulateTransactionsBatch,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:         "simulates, in order, the execution of a batch of transactions with chained state",
+				QueryParameters: simulationQueryParameters,
+				Request:         []SendTxRequest{},
+				Response:        gin.H{"result": txSimData.BatchSimulationResults{}},
+			},
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(simulateTransactionsBatchEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
 		{
 			Path:    costPath,
 			Method:  http.MethodPost,
 			Handler: tg.computeTransactionGasLimit,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "estimates the gas needed by a transaction",
+				Request:  SendTxRequest{},
+				Response: transaction.CostResponse{},
+			},
+		},
+		{
+			Path:    estimateGasPath,
+			Method:  http.MethodPost,
+			Handler: tg.estimateTransactionGas,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "estimates the gas needed by a transaction, along with its breakdown and the calls to other shards which were not simulated",
+				Request:  SendTxRequest{},
+				Response: txSimData.GasEstimation{},
+			},
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(estimateTransactionGasEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    estimateScrGasPath,
+			Method:  http.MethodPost,
+			Handler: tg.estimateSmartContractResultGas,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "estimates the gas consumed in this shard by a smart contract result sent from another shard, along with its breakdown and the smart contract results it generates",
+				Request:  transaction.ApiSmartContractResult{},
+				Response: txSimData.GasEstimation{},
+			},
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(estimateScrGasEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
 		},
 		{
 			Path:    getTransactionsPool,
 			Method:  http.MethodGet,
 			Handler: tg.getTransactionsPool,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:         "returns the transactions in pool, or, for a sender, its transactions in pool or the entry selected by the last-nonce, nonce-gaps, replacements or stats flag; the evictions flag returns the recent pool evictions",
+				QueryParameters: transactionsPoolQueryParameters,
+				Response: gin.H{
+					"txPool":       common.TransactionsPoolAPIResponse{},
+					"nonce":        uint64(0),
+					"nonceGaps":    common.TransactionsPoolNonceGapsForSenderApiResponse{},
+					"replacements": common.TransactionsPoolReplacementsForSenderApiResponse{},
+					"stats":        common.TransactionsPoolSenderStatsApiResponse{},
+					"evictions":    common.TransactionsPoolEvictionsApiResponse{},
+				},
+			},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(getTransactionPath, facade),
@@ -116,10 +260,49 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 				},
 			},
 		},
+		{
+			Path:    scheduleTransactionPath,
+			Method:  http.MethodPost,
+			Handler: tg.scheduleTransaction,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "adds a signed transaction to the node's outbox, to be sent once a round, an epoch or a nonce of the sender's account is reached",
+				Request:  ScheduleTxRequest{},
+				Response: gin.H{"txHash": ""},
+			},
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(scheduleTransactionEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    getScheduledTransactionsPath,
+			Method:  http.MethodGet,
+			Handler: tg.getScheduledTransactions,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns the transactions waiting in the node's outbox",
+				Response: gin.H{"transactions": []common.ScheduledTransactionApiResponse{}},
+			},
+		},
+		{
+			Path:    cancelScheduledTransactionPath,
+			Method:  http.MethodDelete,
+			Handler: tg.cancelScheduledTransaction,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "removes a transaction from the node's outbox, so that it is never sent",
+				Response: gin.H{"txHash": ""},
+			},
+		},
 		{
 			Path:    sendMultiplePath,
 			Method:  http.MethodPost,
 			Handler: tg.sendMultipleTransactions,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "sends a batch of signed transactions",
+				Request:  []SendTxRequest{},
+				Response: gin.H{"txsSent": 0, "txsHashes": map[int]string{}},
+			},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(sendMultipleTransactionsEndpoint, facade),
@@ -131,6 +314,15 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 			Path:    getTransactionPath,
 			Method:  http.MethodGet,
 			Handler: tg.getTransaction,
+			Documentation: &shared.EndpointDocumentation{
+				Summary: "returns a transaction by its hash",
+				QueryParameters: []shared.QueryParameter{
+					{Name: queryParamWithResults, Type: shared.BooleanQueryParameter},
+					{Name: queryParamSender, Type: shared.StringQueryParameter},
+					{Name: queryParamWithStateDiff, Type: shared.BooleanQueryParameter},
+				},
+				Response: gin.H{"transaction": transaction.ApiTransactionResult{}, "stateDiff": []txSimData.AccountStateDiff{}},
+			},
 			AdditionalMiddlewares: []shared.AdditionalMiddleware{
 				{
 					Middleware: middleware.CreateEndpointThrottlerFromFacade(getTransactionEndpoint, facade),
@@ -138,6 +330,40 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 				},
 			},
 		},
+		{
+			Path:    getTransactionTracePath,
+			Method:  http.MethodGet,
+			Handler: tg.getTransactionTrace,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "replays a processed transaction on the state from before its block and returns its execution trace",
+				Response: gin.H{"result": txSimData.SimulationResults{}},
+			},
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getTransactionTraceEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    waitForTransactionStatusPath,
+			Method:  http.MethodGet,
+			Handler: tg.waitForTransactionStatus,
+			Documentation: &shared.EndpointDocumentation{
+				Summary: "waits until a transaction reaches the given status (by default, until its outcome is final) or until the timeout elapses",
+				QueryParameters: []shared.QueryParameter{
+					{Name: queryParamStatus, Type: shared.StringQueryParameter},
+					{Name: queryParamTimeout, Type: shared.StringQueryParameter},
+				},
+				Response: gin.H{"transaction": transaction.ApiTransactionResult{}, "statusReached": false},
+			},
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(waitForTransactionStatusEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
 	}
 	tg.endpoints = endpoints
 
@@ -176,6 +402,21 @@ type SendTxRequest struct {
 	Options          uint32 `json:"options,omitempty"`
 }
 
+// ScheduleTxRequest represents the structure that maps and validates user input for scheduling a transaction, which is
+// sent once the release condition is met: the round, the epoch or the nonce of the sender's account reaches the given value
+type ScheduleTxRequest struct {
+	Transaction    SendTxRequest `json:"transaction"`
+	Condition      string        `json:"condition"`
+	ConditionValue uint64        `json:"conditionValue"`
+}
+
+// SimulateTxRequest represents the structure that maps and validates user input for simulating a transaction, with
+// optional overrides of the accounts state
+type SimulateTxRequest struct {
+	SendTxRequest
+	StateOverrides map[string]*AccountStateOverrideRequest `json:"stateOverrides,omitempty"`
+}
+
 // TxResponse represents the structure on which the response will be validated against
 type TxResponse struct {
 	SendTxRequest
@@ -188,7 +429,7 @@ type TxResponse struct {
 
 // simulateTransaction will receive a transaction from the client and will simulate it's execution and return the results
 func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
-	var gtx = SendTxRequest{}
+	var gtx = SimulateTxRequest{}
 	err := c.ShouldBindJSON(&gtx)
 	if err != nil {
 		c.JSON(
@@ -215,6 +456,32 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 		return
 	}
 
+	withTrace, err := getQueryParamWithTrace(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrValidation.Error(),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	withStateDiff, err := getQueryParamWithStateDiff(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrValidation.Error(),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
 	start := time.Now()
 	tx, txHash, err := tg.getFacade().CreateTransaction(
 		gtx.Nonce,
@@ -259,9 +526,21 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 		return
 	}
 
-	start = time.Now()
-	executionResults, err := tg.getFacade().SimulateTransactionExecution(tx)
-	logging.LogAPIActionDurationIfNeeded(start, "API call: SimulateTransactionExecution")
+	overrides, err := newStateOverrides(tg.getFacade(), gtx.StateOverrides)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	options := txSimData.SimulationOptions{WithTrace: withTrace, WithStateDiff: withStateDiff}
+	executionResults, err := tg.simulateTransactionExecution(tx, overrides, options)
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
@@ -285,6 +564,157 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 	)
 }
 
+func (tg *transactionGroup) simulateTransactionExecution(
+	tx *transaction.Transaction,
+	overrides map[string]*state.AccountOverride,
+	options txSimData.SimulationOptions,
+) (*txSimData.SimulationResults, error) {
+	if len(overrides) == 0 {
+		start := time.Now()
+		executionResults, err := tg.getFacade().SimulateTransactionExecution(tx, options)
+		logging.LogAPIActionDurationIfNeeded(start, "API call: SimulateTransactionExecution")
+
+		return executionResults, err
+	}
+
+	start := time.Now()
+	executionResults, err := tg.getFacade().SimulateTransactionExecutionWithStateOverrides(tx, overrides, options)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: SimulateTransactionExecutionWithStateOverrides")
+
+	return executionResults, err
+}
+
+// simulateTransactionsBatch will receive an ordered list of transactions from the client and will simulate their
+// execution, each transaction seeing the state changes produced by the previous ones
+func (tg *transactionGroup) simulateTransactionsBatch(c *gin.Context) {
+	var gtxs []SendTxRequest
+	err := c.ShouldBindJSON(&gtxs)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	checkSignature, err := getQueryParameterCheckSignature(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrValidation.Error(),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	withTrace, err := getQueryParamWithTrace(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrValidation.Error(),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	withStateDiff, err := getQueryParamWithStateDiff(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrValidation.Error(),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	txs := make([]*transaction.Transaction, 0, len(gtxs))
+	for idx, gtx := range gtxs {
+		start := time.Now()
+		tx, _, errCreate := tg.getFacade().CreateTransaction(
+			gtx.Nonce,
+			gtx.Value,
+			gtx.Receiver,
+			gtx.ReceiverUsername,
+			gtx.Sender,
+			gtx.SenderUsername,
+			gtx.GasPrice,
+			gtx.GasLimit,
+			gtx.Data,
+			gtx.Signature,
+			gtx.ChainID,
+			gtx.Version,
+			gtx.Options,
+		)
+		logging.LogAPIActionDurationIfNeeded(start, "API call: CreateTransaction")
+		if errCreate != nil {
+			c.JSON(
+				http.StatusBadRequest,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: fmt.Sprintf("%s: transaction %d: %s", errors.ErrTxGenerationFailed.Error(), idx, errCreate.Error()),
+					Code:  shared.ReturnCodeRequestError,
+				},
+			)
+			return
+		}
+
+		start = time.Now()
+		errCreate = tg.getFacade().ValidateTransactionForSimulation(tx, checkSignature)
+		logging.LogAPIActionDurationIfNeeded(start, "API call: ValidateTransactionForSimulation")
+		if errCreate != nil {
+			c.JSON(
+				http.StatusBadRequest,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: fmt.Sprintf("%s: transaction %d: %s", errors.ErrTxGenerationFailed.Error(), idx, errCreate.Error()),
+					Code:  shared.ReturnCodeRequestError,
+				},
+			)
+			return
+		}
+
+		txs = append(txs, tx)
+	}
+
+	start := time.Now()
+	options := txSimData.SimulationOptions{WithTrace: withTrace, WithStateDiff: withStateDiff}
+	executionResults, err := tg.getFacade().SimulateTransactionsBatchExecution(txs, options)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: SimulateTransactionsBatchExecution")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"result": executionResults},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 // sendTransaction will receive a transaction from the client and propagate it for processing
 func (tg *transactionGroup) sendTransaction(c *gin.Context) {
 	var gtx = SendTxRequest{}
@@ -371,6 +801,170 @@ func (tg *transactionGroup) sendTransaction(c *gin.Context) {
 	)
 }
 
+// scheduleTransaction adds a signed transaction to the node's outbox, to be sent once its release condition is met
+func (tg *transactionGroup) scheduleTransaction(c *gin.Context) {
+	var request = ScheduleTxRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	gtx := request.Transaction
+	start := time.Now()
+	tx, _, err := tg.getFacade().CreateTransaction(
+		gtx.Nonce,
+		gtx.Value,
+		gtx.Receiver,
+		gtx.ReceiverUsername,
+		gtx.Sender,
+		gtx.SenderUsername,
+		gtx.GasPrice,
+		gtx.GasLimit,
+		gtx.Data,
+		gtx.Signature,
+		gtx.ChainID,
+		gtx.Version,
+		gtx.Options,
+	)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: CreateTransaction")
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrTxGenerationFailed.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	start = time.Now()
+	err = tg.getFacade().ValidateTransactionForScheduling(tx)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: ValidateTransactionForScheduling")
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrTxGenerationFailed.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	start = time.Now()
+	txHash, err := tg.getFacade().ScheduleTransaction(tx, request.Condition, request.ConditionValue)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: ScheduleTransaction")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrScheduleTransaction.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"txHash": txHash},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// getScheduledTransactions returns the transactions waiting in the node's outbox
+func (tg *transactionGroup) getScheduledTransactions(c *gin.Context) {
+	start := time.Now()
+	scheduledTxs, err := tg.getFacade().GetScheduledTransactions()
+	logging.LogAPIActionDurationIfNeeded(start, "API call: GetScheduledTransactions")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"transactions": scheduledTxs},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// cancelScheduledTransaction removes a transaction from the node's outbox
+func (tg *transactionGroup) cancelScheduledTransaction(c *gin.Context) {
+	txhash := c.Param("txhash")
+	if txhash == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyTxHash.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	start := time.Now()
+	err := tg.getFacade().CancelScheduledTransaction(txhash)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: CancelScheduledTransaction")
+	if errorsGo.Is(err, process.ErrScheduledTxNotFound) {
+		c.JSON(
+			http.StatusNotFound,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrCancelScheduledTransaction.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrCancelScheduledTransaction.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"txHash": txhash},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 // sendMultipleTransactions will receive a number of transactions and will propagate them for processing
 func (tg *transactionGroup) sendMultipleTransactions(c *gin.Context) {
 	var gtx []SendTxRequest
@@ -426,15 +1020,151 @@ func (tg *transactionGroup) sendMultipleTransactions(c *gin.Context) {
 		txsHashes[idx] = hex.EncodeToString(txHash)
 	}
 
-	start = time.Now()
-	numOfSentTxs, err := tg.getFacade().SendBulkTransactions(txs)
-	logging.LogAPIActionDurationIfNeeded(start, "API call: SendBulkTransactions")
+	start = time.Now()
+	numOfSentTxs, err := tg.getFacade().SendBulkTransactions(txs)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: SendBulkTransactions")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data: gin.H{
+				"txsSent":   numOfSentTxs,
+				"txsHashes": txsHashes,
+			},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// getTransaction returns transaction details for a given txhash
+func (tg *transactionGroup) getTransaction(c *gin.Context) {
+	txhash := c.Param("txhash")
+	if txhash == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyTxHash.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	withResults, err := getQueryParamWithResults(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrValidation.Error(),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	withStateDiff, err := getQueryParamWithStateDiff(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrValidation.Error(),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	start := time.Now()
+	tx, err := tg.getFacade().GetTransaction(txhash, withResults)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: GetTransaction")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransaction.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	if !withStateDiff {
+		c.JSON(
+			http.StatusOK,
+			shared.GenericAPIResponse{
+				Data:  gin.H{"transaction": tx},
+				Error: "",
+				Code:  shared.ReturnCodeSuccess,
+			},
+		)
+		return
+	}
+
+	start = time.Now()
+	stateDiff, err := tg.getFacade().GetTransactionStateDiff(txhash)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: GetTransactionStateDiff")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionStateDiff.Error(), err.Error()),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"transaction": tx, "stateDiff": stateDiff},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// getTransactionTrace replays a processed transaction and returns the results, including the execution trace
+func (tg *transactionGroup) getTransactionTrace(c *gin.Context) {
+	txhash := c.Param("txhash")
+	if txhash == "" {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrValidationEmptyTxHash.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	start := time.Now()
+	executionResults, err := tg.getFacade().GetTransactionTrace(txhash)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: GetTransactionTrace")
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
 			shared.GenericAPIResponse{
 				Data:  nil,
-				Error: err.Error(),
+				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransactionTrace.Error(), err.Error()),
 				Code:  shared.ReturnCodeInternalError,
 			},
 		)
@@ -444,18 +1174,16 @@ func (tg *transactionGroup) sendMultipleTransactions(c *gin.Context) {
 	c.JSON(
 		http.StatusOK,
 		shared.GenericAPIResponse{
-			Data: gin.H{
-				"txsSent":   numOfSentTxs,
-				"txsHashes": txsHashes,
-			},
+			Data:  gin.H{"result": executionResults},
 			Error: "",
 			Code:  shared.ReturnCodeSuccess,
 		},
 	)
 }
 
-// getTransaction returns transaction details for a given txhash
-func (tg *transactionGroup) getTransaction(c *gin.Context) {
+// waitForTransactionStatus blocks until the transaction with the given hash reaches the requested status, or until its
+// outcome is final, and returns the transaction. The status is not reached if the timeout elapsed
+func (tg *transactionGroup) waitForTransactionStatus(c *gin.Context) {
 	txhash := c.Param("txhash")
 	if txhash == "" {
 		c.JSON(
@@ -469,13 +1197,13 @@ func (tg *transactionGroup) getTransaction(c *gin.Context) {
 		return
 	}
 
-	withResults, err := getQueryParamWithResults(c)
+	timeout, err := getQueryParamTimeout(c)
 	if err != nil {
 		c.JSON(
 			http.StatusBadRequest,
 			shared.GenericAPIResponse{
 				Data:  nil,
-				Error: errors.ErrValidation.Error(),
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
 				Code:  shared.ReturnCodeRequestError,
 			},
 		)
@@ -483,14 +1211,14 @@ func (tg *transactionGroup) getTransaction(c *gin.Context) {
 	}
 
 	start := time.Now()
-	tx, err := tg.getFacade().GetTransaction(txhash, withResults)
-	logging.LogAPIActionDurationIfNeeded(start, "API call: GetTransaction")
+	response, err := tg.getFacade().WaitForTransactionStatus(txhash, getQueryParamStatus(c), timeout)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: WaitForTransactionStatus")
 	if err != nil {
 		c.JSON(
 			http.StatusInternalServerError,
 			shared.GenericAPIResponse{
 				Data:  nil,
-				Error: fmt.Sprintf("%s: %s", errors.ErrGetTransaction.Error(), err.Error()),
+				Error: fmt.Sprintf("%s: %s", errors.ErrWaitForTransactionStatus.Error(), err.Error()),
 				Code:  shared.ReturnCodeInternalError,
 			},
 		)
@@ -500,7 +1228,7 @@ func (tg *transactionGroup) getTransaction(c *gin.Context) {
 	c.JSON(
 		http.StatusOK,
 		shared.GenericAPIResponse{
-			Data:  gin.H{"transaction": tx},
+			Data:  gin.H{"transaction": response.Transaction, "statusReached": response.StatusReached},
 			Error: "",
 			Code:  shared.ReturnCodeSuccess,
 		},
@@ -577,10 +1305,136 @@ func (tg *transactionGroup) computeTransactionGasLimit(c *gin.Context) {
 	)
 }
 
+// estimateTransactionGas returns the gas units needed by a transaction, split by the phases of its execution
+func (tg *transactionGroup) estimateTransactionGas(c *gin.Context) {
+	var gtx SendTxRequest
+	err := c.ShouldBindJSON(&gtx)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	start := time.Now()
+	tx, _, err := tg.getFacade().CreateTransaction(
+		gtx.Nonce,
+		gtx.Value,
+		gtx.Receiver,
+		gtx.ReceiverUsername,
+		gtx.Sender,
+		gtx.SenderUsername,
+		gtx.GasPrice,
+		gtx.GasLimit,
+		gtx.Data,
+		gtx.Signature,
+		gtx.ChainID,
+		gtx.Version,
+		gtx.Options,
+	)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: CreateTransaction")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	start = time.Now()
+	estimation, err := tg.getFacade().EstimateTransactionGas(tx)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: EstimateTransactionGas")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  estimation,
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// estimateSmartContractResultGas returns the gas consumed in this shard by a smart contract result sent from another
+// shard. It is called by the nodes of other shards estimating the gas of a cross-shard transaction
+func (tg *transactionGroup) estimateSmartContractResultGas(c *gin.Context) {
+	var scr transaction.ApiSmartContractResult
+	err := c.ShouldBindJSON(&scr)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	start := time.Now()
+	estimation, err := tg.getFacade().EstimateSmartContractResultGas(&scr)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: EstimateSmartContractResultGas")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  estimation,
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// txPoolQueryParameters holds the query parameters of a request for the transactions pool
+type txPoolQueryParameters struct {
+	sender       string
+	fields       string
+	lastNonce    bool
+	nonceGaps    bool
+	replacements bool
+	stats        bool
+	evictions    bool
+	orderBy      string
+	receiver     string
+	dataPrefix   string
+}
+
 // getTransactionsPool returns the transactions details in the pool
 func (tg *transactionGroup) getTransactionsPool(c *gin.Context) {
 	// extract and validate query parameters
-	sender, fields, lastNonce, nonceGaps, err := tg.extractQueryParameters(c)
+	params, err := tg.extractQueryParameters(c)
 	if err != nil {
 		c.JSON(
 			http.StatusBadRequest,
@@ -593,7 +1447,7 @@ func (tg *transactionGroup) getTransactionsPool(c *gin.Context) {
 		return
 	}
 
-	err = validateQuery(sender, fields, lastNonce, nonceGaps)
+	err = validateQuery(params)
 	if err != nil {
 		c.JSON(
 			http.StatusBadRequest,
@@ -606,45 +1460,88 @@ func (tg *transactionGroup) getTransactionsPool(c *gin.Context) {
 		return
 	}
 
+	if params.evictions {
+		tg.getTransactionsPoolEvictions(c)
+		return
+	}
+
 	// if no sender was provided, the fields for all transactions from pool should be returned in response
-	if sender == "" {
-		tg.getTxPool(fields, c)
+	if params.sender == "" {
+		options := common.TransactionsPoolQueryOptions{
+			Fields:     params.fields,
+			OrderBy:    params.orderBy,
+			Receiver:   params.receiver,
+			DataPrefix: params.dataPrefix,
+		}
+		tg.getTxPool(options, c)
+		return
+	}
+
+	if params.lastNonce {
+		tg.getLastPoolNonceForSender(params.sender, c)
+		return
+	}
+
+	if params.nonceGaps {
+		tg.getTransactionsPoolNonceGapsForSender(params.sender, c)
 		return
 	}
 
-	if lastNonce {
-		tg.getLastPoolNonceForSender(sender, c)
+	if params.replacements {
+		tg.getTransactionsPoolReplacementsForSender(params.sender, c)
 		return
 	}
 
-	if nonceGaps {
-		tg.getTransactionsPoolNonceGapsForSender(sender, c)
+	if params.stats {
+		tg.getTransactionsPoolStatsForSender(params.sender, c)
 		return
 	}
 
-	tg.getTxPoolForSender(sender, fields, c)
+	tg.getTxPoolForSender(params.sender, params.fields, c)
 }
 
-func (tg *transactionGroup) extractQueryParameters(c *gin.Context) (string, string, bool, bool, error) {
-	senderAddress := getQueryParameterSender(c)
-	fields := getQueryParameterFields(c)
-	lastNonce, err := getQueryParameterLastNonce(c)
+func (tg *transactionGroup) extractQueryParameters(c *gin.Context) (*txPoolQueryParameters, error) {
+	params := &txPoolQueryParameters{
+		sender:     getQueryParameterSender(c),
+		fields:     getQueryParameterFields(c),
+		orderBy:    c.Request.URL.Query().Get(queryParamOrderBy),
+		receiver:   c.Request.URL.Query().Get(queryParamReceiver),
+		dataPrefix: c.Request.URL.Query().Get(queryParamDataPrefix),
+	}
+
+	var err error
+	params.lastNonce, err = getQueryParameterLastNonce(c)
+	if err != nil {
+		return nil, err
+	}
+
+	params.nonceGaps, err = getQueryParameterNonceGaps(c)
+	if err != nil {
+		return nil, err
+	}
+
+	params.replacements, err = getQueryParameterReplacements(c)
+	if err != nil {
+		return nil, err
+	}
+
+	params.stats, err = getQueryParameterBool(c, queryParamStats)
 	if err != nil {
-		return "", "", false, false, err
+		return nil, err
 	}
 
-	nonceGaps, err := getQueryParameterNonceGaps(c)
+	params.evictions, err = getQueryParameterBool(c, queryParamEvictions)
 	if err != nil {
-		return "", "", false, false, err
+		return nil, err
 	}
 
-	return senderAddress, fields, lastNonce, nonceGaps, nil
+	return params, nil
 }
 
 // getTxPool returns the fields for all txs in pool
-func (tg *transactionGroup) getTxPool(fields string, c *gin.Context) {
+func (tg *transactionGroup) getTxPool(options common.TransactionsPoolQueryOptions, c *gin.Context) {
 	start := time.Now()
-	txPool, err := tg.getFacade().GetTransactionsPool(fields)
+	txPool, err := tg.getFacade().GetTransactionsPool(options)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: GetTransactionsPool")
 	if err != nil {
 		c.JSON(
@@ -749,30 +1646,151 @@ func (tg *transactionGroup) getTransactionsPoolNonceGapsForSender(sender string,
 	)
 }
 
-func validateQuery(sender, fields string, lastNonce, nonceGaps bool) error {
-	if fields != "" && lastNonce {
+// getTransactionsPoolReplacementsForSender returns the recent replacements by fee of the transactions of the sender
+func (tg *transactionGroup) getTransactionsPoolReplacementsForSender(sender string, c *gin.Context) {
+	start := time.Now()
+	replacements, err := tg.getFacade().GetTransactionsPoolReplacementsForSender(sender)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: GetTransactionsPoolReplacementsForSender")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"replacements": replacements},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// getTransactionsPoolStatsForSender returns the statistics of the sender's transactions, as seen by the selection and
+// the eviction processes of the pool
+func (tg *transactionGroup) getTransactionsPoolStatsForSender(sender string, c *gin.Context) {
+	start := time.Now()
+	stats, err := tg.getFacade().GetTransactionsPoolStatsForSender(sender)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: GetTransactionsPoolStatsForSender")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"stats": stats},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+// getTransactionsPoolEvictions returns the recent eviction rounds of the transactions pool
+func (tg *transactionGroup) getTransactionsPoolEvictions(c *gin.Context) {
+	start := time.Now()
+	evictions, err := tg.getFacade().GetTransactionsPoolEvictions()
+	logging.LogAPIActionDurationIfNeeded(start, "API call: GetTransactionsPoolEvictions")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"evictions": evictions},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
+func validateQuery(params *txPoolQueryParameters) error {
+	if params.fields != "" && params.lastNonce {
 		return errors.ErrFetchingLatestNonceCannotIncludeFields
 	}
 
-	if fields != "" && nonceGaps {
+	if params.fields != "" && params.nonceGaps {
 		return errors.ErrFetchingNonceGapsCannotIncludeFields
 	}
 
-	if sender == "" && lastNonce {
+	if params.sender == "" && params.lastNonce {
 		return errors.ErrEmptySenderToGetLatestNonce
 	}
 
-	if sender == "" && nonceGaps {
+	if params.sender == "" && params.nonceGaps {
 		return errors.ErrEmptySenderToGetNonceGaps
 	}
 
-	if fields != "" {
-		return validateFields(fields)
+	if params.fields != "" && params.replacements {
+		return errors.ErrFetchingReplacementsCannotIncludeFields
+	}
+
+	if params.sender == "" && params.replacements {
+		return errors.ErrEmptySenderToGetReplacements
+	}
+
+	if params.fields != "" && params.stats {
+		return errors.ErrFetchingStatsCannotIncludeFields
+	}
+
+	if params.sender == "" && params.stats {
+		return errors.ErrEmptySenderToGetStats
+	}
+
+	if params.evictions && (params.sender != "" || params.fields != "") {
+		return errors.ErrFetchingEvictionsCannotIncludeSenderOrFields
+	}
+
+	isOrderedOrFiltered := params.orderBy != "" || params.receiver != "" || params.dataPrefix != ""
+	if params.sender != "" && isOrderedOrFiltered {
+		return errors.ErrOrderingOrFilteringTheTransactionsPoolCannotIncludeSender
+	}
+
+	err := validateTxPoolOrder(params.orderBy)
+	if err != nil {
+		return err
+	}
+
+	if params.fields != "" {
+		return validateFields(params.fields)
 	}
 
 	return nil
 }
 
+func validateTxPoolOrder(orderBy string) error {
+	switch orderBy {
+	case "", common.TransactionsPoolOrderByGasPrice, common.TransactionsPoolOrderByScore:
+		return nil
+	default:
+		return errors.ErrInvalidTransactionsPoolOrder
+	}
+}
+
 func validateFields(fields string) error {
 	for _, c := range fields {
 		if c == ',' {
@@ -798,6 +1816,50 @@ func getQueryParamWithResults(c *gin.Context) (bool, error) {
 	return strconv.ParseBool(withResultsStr)
 }
 
+func getQueryParamWithTrace(c *gin.Context) (bool, error) {
+	withTraceStr := c.Request.URL.Query().Get(queryParamWithTrace)
+	if withTraceStr == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(withTraceStr)
+}
+
+func getQueryParamWithStateDiff(c *gin.Context) (bool, error) {
+	withStateDiffStr := c.Request.URL.Query().Get(queryParamWithStateDiff)
+	if withStateDiffStr == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(withStateDiffStr)
+}
+
+func getQueryParamStatus(c *gin.Context) string {
+	status := c.Request.URL.Query().Get(queryParamStatus)
+	if status == "" {
+		return common.TransactionStatusExecuted
+	}
+
+	return status
+}
+
+func getQueryParamTimeout(c *gin.Context) (time.Duration, error) {
+	timeoutStr := c.Request.URL.Query().Get(queryParamTimeout)
+	if timeoutStr == "" {
+		return defaultTransactionStatusWaitTimeout, nil
+	}
+
+	timeout, err := time.ParseDuration(timeoutStr)
+	if err != nil {
+		return 0, err
+	}
+	if timeout <= 0 || timeout > maxTransactionStatusWaitTimeout {
+		return 0, fmt.Errorf("%w, maximum allowed: %s", errors.ErrInvalidTimeout, maxTransactionStatusWaitTimeout)
+	}
+
+	return timeout, nil
+}
+
 func getQueryParameterCheckSignature(c *gin.Context) (bool, error) {
 	bypassSignatureStr := c.Request.URL.Query().Get(queryParamCheckSignature)
 	if bypassSignatureStr == "" {
@@ -835,6 +1897,24 @@ func getQueryParameterNonceGaps(c *gin.Context) (bool, error) {
 	return strconv.ParseBool(nonceGapsStr)
 }
 
+func getQueryParameterReplacements(c *gin.Context) (bool, error) {
+	replacementsStr := c.Request.URL.Query().Get(queryParamReplacements)
+	if replacementsStr == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(replacementsStr)
+}
+
+func getQueryParameterBool(c *gin.Context, name string) (bool, error) {
+	valueStr := c.Request.URL.Query().Get(name)
+	if valueStr == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(valueStr)
+}
+
 func (tg *transactionGroup) getFacade() transactionFacadeHandler {
 	tg.mutFacade.RLock()
 	defer tg.mutFacade.RUnlock()
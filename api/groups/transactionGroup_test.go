@@ -11,6 +11,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	dataTx "github.com/ElrondNetwork/elrond-go-core/data/transaction"
@@ -20,7 +21,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/api/shared"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/process"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -51,6 +54,17 @@ type transactionResponse struct {
 	Code  string                  `json:"code"`
 }
 
+type transactionWithStateDiffResponseData struct {
+	TxResp    *groups.TxResponse            `json:"transaction,omitempty"`
+	StateDiff []*txSimData.AccountStateDiff `json:"stateDiff,omitempty"`
+}
+
+type transactionWithStateDiffResponse struct {
+	Data  transactionWithStateDiffResponseData `json:"data"`
+	Error string                               `json:"error"`
+	Code  string                               `json:"code"`
+}
+
 type sendMultipleTxsResponseData struct {
 	TxsSent   int      `json:"txsSent"`
 	TxsHashes []string `json:"txsHashes"`
@@ -68,6 +82,27 @@ type simulateTxResponse struct {
 	Code  string      `json:"code"`
 }
 
+type traceTxResponseData struct {
+	Result *txSimData.SimulationResults `json:"result"`
+}
+
+type traceTxResponse struct {
+	Data  traceTxResponseData `json:"data"`
+	Error string              `json:"error"`
+	Code  string              `json:"code"`
+}
+
+type waitForTxStatusResponseData struct {
+	Transaction   *dataTx.ApiTransactionResult `json:"transaction"`
+	StatusReached bool                         `json:"statusReached"`
+}
+
+type waitForTxStatusResponse struct {
+	Data  waitForTxStatusResponseData `json:"data"`
+	Error string                      `json:"error"`
+	Code  string                      `json:"code"`
+}
+
 type sendSingleTxResponseData struct {
 	TxHash string `json:"txHash"`
 }
@@ -88,6 +123,22 @@ type transactionCostResponse struct {
 	Code  string                      `json:"code"`
 }
 
+type gasEstimationResponse struct {
+	Data  txSimData.GasEstimation `json:"data"`
+	Error string                  `json:"error"`
+	Code  string                  `json:"code"`
+}
+
+type scheduledTxsResponseData struct {
+	Transactions []common.ScheduledTransactionApiResponse `json:"transactions"`
+}
+
+type scheduledTxsResponse struct {
+	Data  scheduledTxsResponseData `json:"data"`
+	Error string                   `json:"error"`
+	Code  string                   `json:"code"`
+}
+
 type txsPoolResponseData struct {
 	TxPool common.TransactionsPoolAPIResponse `json:"txPool"`
 }
@@ -122,6 +173,36 @@ type txPoolNonceGapsForSenderResponseData struct {
 	NonceGaps common.TransactionsPoolNonceGapsForSenderApiResponse `json:"nonceGaps"`
 }
 
+type txPoolReplacementsForSenderResponseData struct {
+	Replacements common.TransactionsPoolReplacementsForSenderApiResponse `json:"replacements"`
+}
+
+type txPoolReplacementsForSenderResponse struct {
+	Data  txPoolReplacementsForSenderResponseData `json:"data"`
+	Error string                                  `json:"error"`
+	Code  string                                  `json:"code"`
+}
+
+type txPoolStatsForSenderResponseData struct {
+	Stats common.TransactionsPoolSenderStatsApiResponse `json:"stats"`
+}
+
+type txPoolStatsForSenderResponse struct {
+	Data  txPoolStatsForSenderResponseData `json:"data"`
+	Error string                           `json:"error"`
+	Code  string                           `json:"code"`
+}
+
+type txPoolEvictionsResponseData struct {
+	Evictions common.TransactionsPoolEvictionsApiResponse `json:"evictions"`
+}
+
+type txPoolEvictionsResponse struct {
+	Data  txPoolEvictionsResponseData `json:"data"`
+	Error string                      `json:"error"`
+	Code  string                      `json:"code"`
+}
+
 type txPoolNonceGapsForSenderResponse struct {
 	Data  txPoolNonceGapsForSenderResponseData `json:"data"`
 	Error string                               `json:"error"`
@@ -165,6 +246,96 @@ func TestGetTransaction_WithCorrectHashShouldReturnTransaction(t *testing.T) {
 	assert.Equal(t, txData, txResp.Data)
 }
 
+func TestGetTransaction_WithStateDiff(t *testing.T) {
+	t.Parallel()
+
+	getTransactionHandler := func(hash string, withEvents bool) (*dataTx.ApiTransactionResult, error) {
+		return &dataTx.ApiTransactionResult{Sender: "sender"}, nil
+	}
+
+	t.Run("invalid query parameter should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetTransactionHandler: getTransactionHandler,
+		}
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/transaction/aabb?withStateDiff=not-a-bool", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetTransactionHandler: getTransactionHandler,
+			GetTransactionStateDiffHandler: func(hash string) ([]*txSimData.AccountStateDiff, error) {
+				return nil, expectedErr
+			},
+		}
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/transaction/aabb?withStateDiff=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := transactionWithStateDiffResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetTransactionStateDiff.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedStateDiff := []*txSimData.AccountStateDiff{
+			{
+				Address:       "alice",
+				BalanceBefore: "100",
+				BalanceAfter:  "90",
+				NonceBefore:   1,
+				NonceAfter:    2,
+				ESDT: map[string]*txSimData.ESDTBalanceDiff{
+					"WEGLD-abcdef": {Before: "0", After: "5"},
+				},
+			},
+		}
+		facade := mock.FacadeStub{
+			GetTransactionHandler: getTransactionHandler,
+			GetTransactionStateDiffHandler: func(hash string) ([]*txSimData.AccountStateDiff, error) {
+				require.Equal(t, "aabb", hash)
+				return expectedStateDiff, nil
+			},
+		}
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/transaction/aabb?withStateDiff=true", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := transactionWithStateDiffResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "sender", response.Data.TxResp.Sender)
+		assert.Equal(t, expectedStateDiff, response.Data.StateDiff)
+	})
+}
+
 func TestGetTransaction_WithUnknownHashShouldReturnNil(t *testing.T) {
 	sender := "sender"
 	receiver := "receiver"
@@ -556,312 +727,1229 @@ func TestComputeTransactionGasLimit(t *testing.T) {
 	assert.Equal(t, expectedGasLimit, txCostResp.Data.Cost)
 }
 
-func TestSimulateTransaction_BadRequestShouldErr(t *testing.T) {
+func TestEstimateTransactionGas(t *testing.T) {
 	t.Parallel()
 
-	facade := mock.FacadeStub{}
+	t.Run("invalid request should error", func(t *testing.T) {
+		t.Parallel()
 
-	transactionGroup, err := groups.NewTransactionGroup(&facade)
-	require.NoError(t, err)
+		transactionGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
 
-	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
 
-	req, _ := http.NewRequest("POST", "/transaction/simulate", bytes.NewBuffer([]byte("invalid bytes")))
+		req, _ := http.NewRequest("POST", "/transaction/estimate-gas", bytes.NewBuffer([]byte("invalid bytes")))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
 
-	resp := httptest.NewRecorder()
-	ws.ServeHTTP(resp, req)
+		estimationResp := gasEstimationResponse{}
+		loadResponse(resp.Body, &estimationResp)
 
-	simulateResponse := simulateTxResponse{}
-	loadResponse(resp.Body, &simulateResponse)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(estimationResp.Error, apiErrors.ErrValidation.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
 
-	assert.Equal(t, http.StatusBadRequest, resp.Code)
-}
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{}, nil, nil
+			},
+			EstimateTransactionGasHandler: func(tx *dataTx.Transaction) (*txSimData.GasEstimation, error) {
+				return nil, expectedErr
+			},
+		}
 
-func TestSimulateTransaction_CreateErrorsShouldErr(t *testing.T) {
-	t.Parallel()
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
 
-	processTxWasCalled := false
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
 
-	expectedErr := errors.New("expected error")
-	facade := mock.FacadeStub{
-		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResults, error) {
-			processTxWasCalled = true
-			return &txSimData.SimulationResults{
-				Status:     "ok",
-				FailReason: "no reason",
-				ScResults:  nil,
-				Receipts:   nil,
-				Hash:       "hash",
-			}, nil
-		},
-		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
-			return nil, nil, expectedErr
-		},
-		ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
-			return nil
-		},
-	}
+		jsonBytes, _ := json.Marshal(groups.SendTxRequest{Sender: "sender", Receiver: "receiver", Value: "0"})
+		req, _ := http.NewRequest("POST", "/transaction/estimate-gas", bytes.NewBuffer(jsonBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
 
-	transactionGroup, err := groups.NewTransactionGroup(&facade)
-	require.NoError(t, err)
+		estimationResp := gasEstimationResponse{}
+		loadResponse(resp.Body, &estimationResp)
 
-	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, expectedErr.Error(), estimationResp.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
 
-	tx := groups.SendTxRequest{
-		Sender:    "sender1",
-		Receiver:  "receiver1",
-		Value:     "100",
-		Data:      make([]byte, 0),
-		Nonce:     0,
-		GasPrice:  0,
-		GasLimit:  0,
-		Signature: "",
-	}
-	jsonBytes, _ := json.Marshal(tx)
+		expectedEstimation := txSimData.GasEstimation{
+			GasUnits: 1500,
+			Breakdown: &txSimData.GasBreakdown{
+				MoveBalance:   500,
+				Execution:     1000,
+				AsyncCallback: 0,
+				Refund:        200,
+			},
+			NotSimulatedCalls: []*txSimData.CrossShardCall{
+				{Receiver: "erd1contract", ShardID: 2, Function: "claim", GasForwarded: 300},
+			},
+		}
+		facade := mock.FacadeStub{
+			CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{}, nil, nil
+			},
+			EstimateTransactionGasHandler: func(tx *dataTx.Transaction) (*txSimData.GasEstimation, error) {
+				return &expectedEstimation, nil
+			},
+		}
 
-	req, _ := http.NewRequest("POST", "/transaction/simulate", bytes.NewBuffer(jsonBytes))
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
 
-	resp := httptest.NewRecorder()
-	ws.ServeHTTP(resp, req)
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
 
-	simulateResponse := simulateTxResponse{}
-	loadResponse(resp.Body, &simulateResponse)
+		jsonBytes, _ := json.Marshal(groups.SendTxRequest{Sender: "sender", Receiver: "receiver", Value: "0"})
+		req, _ := http.NewRequest("POST", "/transaction/estimate-gas", bytes.NewBuffer(jsonBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
 
-	assert.Equal(t, http.StatusBadRequest, resp.Code)
-	assert.False(t, processTxWasCalled)
-	assert.Contains(t, simulateResponse.Error, expectedErr.Error())
+		estimationResp := gasEstimationResponse{}
+		loadResponse(resp.Body, &estimationResp)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedEstimation, estimationResp.Data)
+	})
 }
 
-func TestSimulateTransaction_ValidateErrorsShouldErr(t *testing.T) {
+func TestEstimateSmartContractResultGas(t *testing.T) {
 	t.Parallel()
 
-	processTxWasCalled := false
+	t.Run("invalid request should error", func(t *testing.T) {
+		t.Parallel()
 
-	expectedErr := errors.New("expected error")
-	facade := mock.FacadeStub{
-		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResults, error) {
-			processTxWasCalled = true
-			return &txSimData.SimulationResults{
-				Status:     "ok",
-				FailReason: "no reason",
-				ScResults:  nil,
-				Receipts:   nil,
-				Hash:       "hash",
-			}, nil
-		},
-		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
-			return &dataTx.Transaction{}, []byte("hash"), nil
-		},
-		ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
-			return expectedErr
-		},
-	}
+		transactionGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
 
-	transactionGroup, err := groups.NewTransactionGroup(&facade)
-	require.NoError(t, err)
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
 
-	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+		req, _ := http.NewRequest("POST", "/transaction/estimate-gas-scr", bytes.NewBuffer([]byte("invalid bytes")))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
 
-	tx := groups.SendTxRequest{
-		Sender:    "sender1",
-		Receiver:  "receiver1",
-		Value:     "100",
-		Data:      make([]byte, 0),
-		Nonce:     0,
-		GasPrice:  0,
-		GasLimit:  0,
-		Signature: "",
-	}
-	jsonBytes, _ := json.Marshal(tx)
+		estimationResp := gasEstimationResponse{}
+		loadResponse(resp.Body, &estimationResp)
 
-	req, _ := http.NewRequest("POST", "/transaction/simulate", bytes.NewBuffer(jsonBytes))
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(estimationResp.Error, apiErrors.ErrValidation.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
 
-	resp := httptest.NewRecorder()
-	ws.ServeHTTP(resp, req)
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			EstimateSmartContractResultGasHandler: func(scr *dataTx.ApiSmartContractResult) (*txSimData.GasEstimation, error) {
+				return nil, expectedErr
+			},
+		}
 
-	simulateResponse := simulateTxResponse{}
-	loadResponse(resp.Body, &simulateResponse)
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
 
-	assert.Equal(t, http.StatusBadRequest, resp.Code)
-	assert.False(t, processTxWasCalled)
-	assert.Contains(t, simulateResponse.Error, expectedErr.Error())
-}
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
 
-func TestSimulateTransaction_CannotParseParameterShouldErr(t *testing.T) {
-	t.Parallel()
+		jsonBytes, _ := json.Marshal(dataTx.ApiSmartContractResult{SndAddr: "sender", RcvAddr: "receiver"})
+		req, _ := http.NewRequest("POST", "/transaction/estimate-gas-scr", bytes.NewBuffer(jsonBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
 
-	facade := mock.FacadeStub{}
+		estimationResp := gasEstimationResponse{}
+		loadResponse(resp.Body, &estimationResp)
 
-	transactionGroup, err := groups.NewTransactionGroup(&facade)
-	require.NoError(t, err)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, expectedErr.Error(), estimationResp.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
 
-	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+		expectedEstimation := txSimData.GasEstimation{
+			GasUnits:  2000,
+			Breakdown: &txSimData.GasBreakdown{Execution: 2000, Refund: 4000},
+			SmartContractResults: map[string]*dataTx.ApiSmartContractResult{
+				"hash": {SndAddr: "receiver", RcvAddr: "sender", Data: "@00", GasLimit: 4000},
+			},
+		}
+		providedScr := dataTx.ApiSmartContractResult{SndAddr: "sender", RcvAddr: "receiver", Data: "claim", GasLimit: 6000}
+		facade := mock.FacadeStub{
+			EstimateSmartContractResultGasHandler: func(scr *dataTx.ApiSmartContractResult) (*txSimData.GasEstimation, error) {
+				assert.Equal(t, &providedScr, scr)
+				return &expectedEstimation, nil
+			},
+		}
 
-	tx := groups.SendTxRequest{
-		Sender:    "sender1",
-		Receiver:  "receiver1",
-		Value:     "100",
-		Data:      make([]byte, 0),
-		Nonce:     0,
-		GasPrice:  0,
-		GasLimit:  0,
-		Signature: "",
-	}
-	jsonBytes, _ := json.Marshal(tx)
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
 
-	req, _ := http.NewRequest("POST", "/transaction/simulate?checkSignature=tttt", bytes.NewBuffer(jsonBytes))
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
 
-	resp := httptest.NewRecorder()
-	ws.ServeHTTP(resp, req)
+		jsonBytes, _ := json.Marshal(providedScr)
+		req, _ := http.NewRequest("POST", "/transaction/estimate-gas-scr", bytes.NewBuffer(jsonBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		estimationResp := gasEstimationResponse{}
+		loadResponse(resp.Body, &estimationResp)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedEstimation, estimationResp.Data)
+	})
+}
+
+func TestScheduleTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid request should error", func(t *testing.T) {
+		t.Parallel()
+
+		transactionGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/transaction/schedule", bytes.NewBuffer([]byte("invalid bytes")))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		txResp := sendSingleTxResponse{}
+		loadResponse(resp.Body, &txResp)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(txResp.Error, apiErrors.ErrValidation.Error()))
+	})
+	t.Run("validation error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{}, nil, nil
+			},
+			ValidateTransactionForSchedulingHandler: func(tx *dataTx.Transaction) error {
+				return expectedErr
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		jsonBytes, _ := json.Marshal(groups.ScheduleTxRequest{Condition: common.ScheduledTxConditionRound, ConditionValue: 100})
+		req, _ := http.NewRequest("POST", "/transaction/schedule", bytes.NewBuffer(jsonBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		txResp := sendSingleTxResponse{}
+		loadResponse(resp.Body, &txResp)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(txResp.Error, apiErrors.ErrTxGenerationFailed.Error()))
+		assert.True(t, strings.Contains(txResp.Error, expectedErr.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{}, nil, nil
+			},
+			ValidateTransactionForSchedulingHandler: func(tx *dataTx.Transaction) error {
+				return nil
+			},
+			ScheduleTransactionCalled: func(tx *dataTx.Transaction, condition string, conditionValue uint64) (string, error) {
+				return "", expectedErr
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		jsonBytes, _ := json.Marshal(groups.ScheduleTxRequest{Condition: common.ScheduledTxConditionRound, ConditionValue: 100})
+		req, _ := http.NewRequest("POST", "/transaction/schedule", bytes.NewBuffer(jsonBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		txResp := sendSingleTxResponse{}
+		loadResponse(resp.Body, &txResp)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(txResp.Error, apiErrors.ErrScheduleTransaction.Error()))
+		assert.True(t, strings.Contains(txResp.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedTxHash := "aabbcc"
+		facade := mock.FacadeStub{
+			CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{Nonce: nonce}, nil, nil
+			},
+			ValidateTransactionForSchedulingHandler: func(tx *dataTx.Transaction) error {
+				return nil
+			},
+			ScheduleTransactionCalled: func(tx *dataTx.Transaction, condition string, conditionValue uint64) (string, error) {
+				assert.Equal(t, uint64(5), tx.Nonce)
+				assert.Equal(t, common.ScheduledTxConditionEpoch, condition)
+				assert.Equal(t, uint64(12), conditionValue)
+
+				return expectedTxHash, nil
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		jsonBytes, _ := json.Marshal(groups.ScheduleTxRequest{
+			Transaction:    groups.SendTxRequest{Nonce: 5, Sender: "sender", Receiver: "receiver", Value: "0"},
+			Condition:      common.ScheduledTxConditionEpoch,
+			ConditionValue: 12,
+		})
+		req, _ := http.NewRequest("POST", "/transaction/schedule", bytes.NewBuffer(jsonBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		txResp := sendSingleTxResponse{}
+		loadResponse(resp.Body, &txResp)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Empty(t, txResp.Error)
+		assert.Equal(t, expectedTxHash, txResp.Data.TxHash)
+	})
+}
+
+func TestGetScheduledTransactions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetScheduledTransactionsCalled: func() ([]common.ScheduledTransactionApiResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/transaction/scheduled", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		scheduledResp := scheduledTxsResponse{}
+		loadResponse(resp.Body, &scheduledResp)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Equal(t, expectedErr.Error(), scheduledResp.Error)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedTxs := []common.ScheduledTransactionApiResponse{
+			{
+				TxHash:         "aabbcc",
+				Sender:         "erd1sender",
+				Receiver:       "erd1receiver",
+				Nonce:          3,
+				Condition:      common.ScheduledTxConditionNonce,
+				ConditionValue: 2,
+				ScheduledAt:    1650000000,
+				Status:         common.ScheduledTxStatusPending,
+			},
+			{
+				TxHash:         "ddeeff",
+				Sender:         "erd1sender",
+				Receiver:       "erd1receiver",
+				Nonce:          1,
+				Condition:      common.ScheduledTxConditionRound,
+				ConditionValue: 10,
+				ScheduledAt:    1650000001,
+				Status:         common.ScheduledTxStatusDiscarded,
+				DiscardReason:  "lower nonce in transaction",
+			},
+		}
+		facade := mock.FacadeStub{
+			GetScheduledTransactionsCalled: func() ([]common.ScheduledTransactionApiResponse, error) {
+				return expectedTxs, nil
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/transaction/scheduled", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		scheduledResp := scheduledTxsResponse{}
+		loadResponse(resp.Body, &scheduledResp)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedTxs, scheduledResp.Data.Transactions)
+	})
+}
+
+func TestCancelScheduledTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			CancelScheduledTransactionCalled: func(txHash string) error {
+				return expectedErr
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest(http.MethodDelete, "/transaction/scheduled/aabbcc", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		txResp := sendSingleTxResponse{}
+		loadResponse(resp.Body, &txResp)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(txResp.Error, apiErrors.ErrCancelScheduledTransaction.Error()))
+		assert.True(t, strings.Contains(txResp.Error, expectedErr.Error()))
+	})
+	t.Run("unknown transaction should return not found", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			CancelScheduledTransactionCalled: func(txHash string) error {
+				return process.ErrScheduledTxNotFound
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest(http.MethodDelete, "/transaction/scheduled/aabbcc", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		txResp := sendSingleTxResponse{}
+		loadResponse(resp.Body, &txResp)
+
+		assert.Equal(t, http.StatusNotFound, resp.Code)
+		assert.True(t, strings.Contains(txResp.Error, process.ErrScheduledTxNotFound.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		cancelledTxHash := ""
+		facade := mock.FacadeStub{
+			CancelScheduledTransactionCalled: func(txHash string) error {
+				cancelledTxHash = txHash
+				return nil
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest(http.MethodDelete, "/transaction/scheduled/aabbcc", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		txResp := sendSingleTxResponse{}
+		loadResponse(resp.Body, &txResp)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "aabbcc", txResp.Data.TxHash)
+		assert.Equal(t, "aabbcc", cancelledTxHash)
+	})
+}
+
+func TestSimulateTransaction_BadRequestShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	req, _ := http.NewRequest("POST", "/transaction/simulate", bytes.NewBuffer([]byte("invalid bytes")))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
 
 	simulateResponse := simulateTxResponse{}
 	loadResponse(resp.Body, &simulateResponse)
 
 	assert.Equal(t, http.StatusBadRequest, resp.Code)
-	assert.Equal(t, apiErrors.ErrValidation.Error(), simulateResponse.Error)
 }
 
-func TestSimulateTransaction_UseQueryParameterShouldWork(t *testing.T) {
+func TestSimulateTransaction_CreateErrorsShouldErr(t *testing.T) {
 	t.Parallel()
 
+	processTxWasCalled := false
+
+	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
+		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, _ txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+			processTxWasCalled = true
+			return &txSimData.SimulationResults{
+				Status:     "ok",
+				FailReason: "no reason",
+				ScResults:  nil,
+				Receipts:   nil,
+				Hash:       "hash",
+			}, nil
+		},
+		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
+			return nil, nil, expectedErr
+		},
 		ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
-			assert.True(t, bypassSignature)
 			return nil
 		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	tx := groups.SendTxRequest{
+		Sender:    "sender1",
+		Receiver:  "receiver1",
+		Value:     "100",
+		Data:      make([]byte, 0),
+		Nonce:     0,
+		GasPrice:  0,
+		GasLimit:  0,
+		Signature: "",
+	}
+	jsonBytes, _ := json.Marshal(tx)
+
+	req, _ := http.NewRequest("POST", "/transaction/simulate", bytes.NewBuffer(jsonBytes))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulateResponse := simulateTxResponse{}
+	loadResponse(resp.Body, &simulateResponse)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.False(t, processTxWasCalled)
+	assert.Contains(t, simulateResponse.Error, expectedErr.Error())
+}
+
+func TestSimulateTransaction_ValidateErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	processTxWasCalled := false
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, _ txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+			processTxWasCalled = true
+			return &txSimData.SimulationResults{
+				Status:     "ok",
+				FailReason: "no reason",
+				ScResults:  nil,
+				Receipts:   nil,
+				Hash:       "hash",
+			}, nil
+		},
 		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
 			return &dataTx.Transaction{}, []byte("hash"), nil
 		},
-		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResults, error) {
-			return &txSimData.SimulationResults{}, nil
+		ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+			return expectedErr
 		},
 	}
 
 	transactionGroup, err := groups.NewTransactionGroup(&facade)
 	require.NoError(t, err)
 
-	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	tx := groups.SendTxRequest{
+		Sender:    "sender1",
+		Receiver:  "receiver1",
+		Value:     "100",
+		Data:      make([]byte, 0),
+		Nonce:     0,
+		GasPrice:  0,
+		GasLimit:  0,
+		Signature: "",
+	}
+	jsonBytes, _ := json.Marshal(tx)
+
+	req, _ := http.NewRequest("POST", "/transaction/simulate", bytes.NewBuffer(jsonBytes))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulateResponse := simulateTxResponse{}
+	loadResponse(resp.Body, &simulateResponse)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.False(t, processTxWasCalled)
+	assert.Contains(t, simulateResponse.Error, expectedErr.Error())
+}
+
+func TestSimulateTransaction_CannotParseParameterShouldErr(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	tx := groups.SendTxRequest{
+		Sender:    "sender1",
+		Receiver:  "receiver1",
+		Value:     "100",
+		Data:      make([]byte, 0),
+		Nonce:     0,
+		GasPrice:  0,
+		GasLimit:  0,
+		Signature: "",
+	}
+	jsonBytes, _ := json.Marshal(tx)
+
+	req, _ := http.NewRequest("POST", "/transaction/simulate?checkSignature=tttt", bytes.NewBuffer(jsonBytes))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulateResponse := simulateTxResponse{}
+	loadResponse(resp.Body, &simulateResponse)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Equal(t, apiErrors.ErrValidation.Error(), simulateResponse.Error)
+}
+
+func TestSimulateTransaction_UseQueryParameterShouldWork(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+			assert.True(t, bypassSignature)
+			return nil
+		},
+		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
+			return &dataTx.Transaction{}, []byte("hash"), nil
+		},
+		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, _ txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+			return &txSimData.SimulationResults{}, nil
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	tx := groups.SendTxRequest{
+		Sender:    "sender1",
+		Receiver:  "receiver1",
+		Value:     "100",
+		Data:      make([]byte, 0),
+		Nonce:     0,
+		GasPrice:  0,
+		GasLimit:  0,
+		Signature: "",
+	}
+	jsonBytes, _ := json.Marshal(tx)
+
+	req, _ := http.NewRequest("POST", "/transaction/simulate?bypassSignature=true", bytes.NewBuffer(jsonBytes))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulateResponse := simulateTxResponse{}
+	loadResponse(resp.Body, &simulateResponse)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestSimulateTransaction_ProcessErrorsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, _ txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+			return nil, expectedErr
+		},
+		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
+			return &dataTx.Transaction{}, []byte("hash"), nil
+		},
+		ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+			return nil
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	tx := groups.SendTxRequest{
+		Sender:    "sender1",
+		Receiver:  "receiver1",
+		Value:     "100",
+		Data:      make([]byte, 0),
+		Nonce:     0,
+		GasPrice:  0,
+		GasLimit:  0,
+		Signature: "",
+	}
+	jsonBytes, _ := json.Marshal(tx)
+
+	req, _ := http.NewRequest("POST", "/transaction/simulate", bytes.NewBuffer(jsonBytes))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulateResponse := simulateTxResponse{}
+	loadResponse(resp.Body, &simulateResponse)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Contains(t, simulateResponse.Error, expectedErr.Error())
+}
+
+func TestSimulateTransaction(t *testing.T) {
+	t.Parallel()
+
+	processTxWasCalled := false
+
+	facade := mock.FacadeStub{
+		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, _ txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+			processTxWasCalled = true
+			return &txSimData.SimulationResults{
+				Status:     "ok",
+				FailReason: "no reason",
+				ScResults:  nil,
+				Receipts:   nil,
+				Hash:       "hash",
+			}, nil
+		},
+		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
+			return &dataTx.Transaction{}, []byte("hash"), nil
+		},
+		ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+			return nil
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	tx := groups.SendTxRequest{
+		Sender:    "sender1",
+		Receiver:  "receiver1",
+		Value:     "100",
+		Data:      make([]byte, 0),
+		Nonce:     0,
+		GasPrice:  0,
+		GasLimit:  0,
+		Signature: "",
+	}
+	jsonBytes, _ := json.Marshal(tx)
+
+	req, _ := http.NewRequest("POST", "/transaction/simulate", bytes.NewBuffer(jsonBytes))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulateResponse := simulateTxResponse{}
+	loadResponse(resp.Body, &simulateResponse)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.True(t, processTxWasCalled)
+	assert.Equal(t, string(shared.ReturnCodeSuccess), simulateResponse.Code)
+}
+
+func TestSimulateTransaction_WithStateOverrides(t *testing.T) {
+	t.Parallel()
+
+	createFacade := func(providedOverrides *map[string]*state.AccountOverride) *mock.FacadeStub {
+		return &mock.FacadeStub{
+			SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, _ txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+				require.Fail(t, "should have called the simulation with overrides")
+				return nil, nil
+			},
+			SimulateTransactionWithOverridesHandler: func(tx *dataTx.Transaction, overrides map[string]*state.AccountOverride, _ txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+				*providedOverrides = overrides
+				return &txSimData.SimulationResults{Status: "ok"}, nil
+			},
+			CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{}, []byte("hash"), nil
+			},
+			ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+				return nil
+			},
+		}
+	}
+
+	t.Run("invalid override should error", func(t *testing.T) {
+		t.Parallel()
+
+		var providedOverrides map[string]*state.AccountOverride
+		transactionGroup, err := groups.NewTransactionGroup(createFacade(&providedOverrides))
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		tx := groups.SimulateTxRequest{
+			SendTxRequest: groups.SendTxRequest{Sender: "sender1", Receiver: "receiver1", Value: "100"},
+			StateOverrides: map[string]*groups.AccountStateOverrideRequest{
+				"aabb": {Balance: "-1"},
+			},
+		}
+		jsonBytes, _ := json.Marshal(tx)
+
+		req, _ := http.NewRequest("POST", "/transaction/simulate", bytes.NewBuffer(jsonBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		simulateResponse := simulateTxResponse{}
+		loadResponse(resp.Body, &simulateResponse)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(simulateResponse.Error, "invalid balance"))
+		assert.Nil(t, providedOverrides)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		var providedOverrides map[string]*state.AccountOverride
+		transactionGroup, err := groups.NewTransactionGroup(createFacade(&providedOverrides))
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		nonce := uint64(7)
+		tx := groups.SimulateTxRequest{
+			SendTxRequest: groups.SendTxRequest{Sender: "sender1", Receiver: "receiver1", Value: "100"},
+			StateOverrides: map[string]*groups.AccountStateOverrideRequest{
+				"aabb": {
+					Balance: "1000",
+					Nonce:   &nonce,
+					Code:    "0102",
+					Storage: map[string]string{"6b6579": "76616c7565"},
+				},
+			},
+		}
+		jsonBytes, _ := json.Marshal(tx)
+
+		req, _ := http.NewRequest("POST", "/transaction/simulate", bytes.NewBuffer(jsonBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		simulateResponse := simulateTxResponse{}
+		loadResponse(resp.Body, &simulateResponse)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, string(shared.ReturnCodeSuccess), simulateResponse.Code)
+
+		expectedOverrides := map[string]*state.AccountOverride{
+			string([]byte{0xaa, 0xbb}): {
+				Balance: big.NewInt(1000),
+				Nonce:   &nonce,
+				Code:    []byte{1, 2},
+				Storage: map[string][]byte{"key": []byte("value")},
+			},
+		}
+		assert.Equal(t, expectedOverrides, providedOverrides)
+	})
+}
+
+func TestSimulateTransaction_WithTrace(t *testing.T) {
+	t.Parallel()
+
+	facade := mock.FacadeStub{
+		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+			results := &txSimData.SimulationResults{Status: "ok"}
+			if options.WithTrace {
+				results.Trace = &txSimData.ExecutionTrace{Call: &txSimData.CallTrace{Function: "swap"}}
+			}
+
+			return results, nil
+		},
+		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
+			return &dataTx.Transaction{}, []byte("hash"), nil
+		},
+		ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+			return nil
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	jsonBytes, _ := json.Marshal(groups.SendTxRequest{Sender: "sender1", Receiver: "receiver1", Value: "100"})
+	for _, withTrace := range []bool{false, true} {
+		req, _ := http.NewRequest("POST", fmt.Sprintf("/transaction/simulate?withTrace=%v", withTrace), bytes.NewBuffer(jsonBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := traceTxResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, withTrace, response.Data.Result.Trace != nil)
+	}
+
+	req, _ := http.NewRequest("POST", "/transaction/simulate?withTrace=invalid", bytes.NewBuffer(jsonBytes))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func TestGetTransactionTrace(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetTransactionTraceHandler: func(hash string) (*txSimData.SimulationResults, error) {
+				return nil, expectedErr
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/transaction/aabb/trace", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := traceTxResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetTransactionTrace.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetTransactionTraceHandler: func(hash string) (*txSimData.SimulationResults, error) {
+				require.Equal(t, "aabb", hash)
+				return &txSimData.SimulationResults{
+					Hash:  hash,
+					Trace: &txSimData.ExecutionTrace{Call: &txSimData.CallTrace{Function: "swap"}},
+				}, nil
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/transaction/aabb/trace", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := traceTxResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "aabb", response.Data.Result.Hash)
+		assert.Equal(t, "swap", response.Data.Result.Trace.Call.Function)
+	})
+}
+
+func TestWaitForTransactionStatus(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid timeout should error", testWaitForTransactionStatusWithInvalidTimeout("?timeout=abc"))
+	t.Run("negative timeout should error", testWaitForTransactionStatusWithInvalidTimeout("?timeout=-1s"))
+	t.Run("too large timeout should error", testWaitForTransactionStatusWithInvalidTimeout("?timeout=2m"))
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			WaitForTransactionStatusHandler: func(hash string, status string, timeout time.Duration) (*common.TransactionStatusWaitApiResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/transaction/aabb/wait", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := waitForTxStatusResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrWaitForTransactionStatus.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("default parameters should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			WaitForTransactionStatusHandler: func(hash string, status string, timeout time.Duration) (*common.TransactionStatusWaitApiResponse, error) {
+				require.Equal(t, "aabb", hash)
+				require.Equal(t, common.TransactionStatusExecuted, status)
+				require.Equal(t, 30*time.Second, timeout)
+
+				return &common.TransactionStatusWaitApiResponse{
+					Transaction:   &dataTx.ApiTransactionResult{Hash: hash, Status: dataTx.TxStatusSuccess},
+					StatusReached: true,
+				}, nil
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/transaction/aabb/wait", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := waitForTxStatusResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.True(t, response.Data.StatusReached)
+		assert.Equal(t, "aabb", response.Data.Transaction.Hash)
+		assert.Equal(t, dataTx.TxStatusSuccess, response.Data.Transaction.Status)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			WaitForTransactionStatusHandler: func(hash string, status string, timeout time.Duration) (*common.TransactionStatusWaitApiResponse, error) {
+				require.Equal(t, string(dataTx.TxStatusSuccess), status)
+				require.Equal(t, 5*time.Second, timeout)
+
+				return &common.TransactionStatusWaitApiResponse{
+					Transaction:   &dataTx.ApiTransactionResult{Hash: hash, Status: dataTx.TxStatusPending},
+					StatusReached: false,
+				}, nil
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/transaction/aabb/wait?status=success&timeout=5s", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := waitForTxStatusResponse{}
+		loadResponse(resp.Body, &response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.False(t, response.Data.StatusReached)
+		assert.Equal(t, dataTx.TxStatusPending, response.Data.Transaction.Status)
+	})
+}
+
+func testWaitForTransactionStatusWithInvalidTimeout(query string) func(t *testing.T) {
+	return func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			WaitForTransactionStatusHandler: func(hash string, status string, timeout time.Duration) (*common.TransactionStatusWaitApiResponse, error) {
+				require.Fail(t, "should not have been called")
+				return nil, nil
+			},
+		}
 
-	tx := groups.SendTxRequest{
-		Sender:    "sender1",
-		Receiver:  "receiver1",
-		Value:     "100",
-		Data:      make([]byte, 0),
-		Nonce:     0,
-		GasPrice:  0,
-		GasLimit:  0,
-		Signature: "",
-	}
-	jsonBytes, _ := json.Marshal(tx)
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
 
-	req, _ := http.NewRequest("POST", "/transaction/simulate?bypassSignature=true", bytes.NewBuffer(jsonBytes))
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
 
-	resp := httptest.NewRecorder()
-	ws.ServeHTTP(resp, req)
+		req, _ := http.NewRequest("GET", "/transaction/aabb/wait"+query, nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
 
-	simulateResponse := simulateTxResponse{}
-	loadResponse(resp.Body, &simulateResponse)
+		response := waitForTxStatusResponse{}
+		loadResponse(resp.Body, &response)
 
-	assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+	}
 }
 
-func TestSimulateTransaction_ProcessErrorsShouldErr(t *testing.T) {
+func TestSimulateTransactionsBatch(t *testing.T) {
 	t.Parallel()
 
-	expectedErr := errors.New("expected error")
-	facade := mock.FacadeStub{
-		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResults, error) {
-			return nil, expectedErr
-		},
-		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
-			return &dataTx.Transaction{}, []byte("hash"), nil
-		},
-		ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
-			return nil
-		},
+	txs := []groups.SendTxRequest{
+		{Sender: "sender1", Receiver: "receiver1", Value: "100", Nonce: 0},
+		{Sender: "sender1", Receiver: "receiver2", Value: "50", Nonce: 1},
 	}
+	jsonBytes, _ := json.Marshal(txs)
 
-	transactionGroup, err := groups.NewTransactionGroup(&facade)
-	require.NoError(t, err)
+	t.Run("invalid request should error", func(t *testing.T) {
+		t.Parallel()
 
-	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+		transactionGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
 
-	tx := groups.SendTxRequest{
-		Sender:    "sender1",
-		Receiver:  "receiver1",
-		Value:     "100",
-		Data:      make([]byte, 0),
-		Nonce:     0,
-		GasPrice:  0,
-		GasLimit:  0,
-		Signature: "",
-	}
-	jsonBytes, _ := json.Marshal(tx)
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
 
-	req, _ := http.NewRequest("POST", "/transaction/simulate", bytes.NewBuffer(jsonBytes))
+		req, _ := http.NewRequest("POST", "/transaction/simulate-batch", bytes.NewBuffer([]byte("invalid bytes")))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
 
-	resp := httptest.NewRecorder()
-	ws.ServeHTTP(resp, req)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("validate error should error", func(t *testing.T) {
+		t.Parallel()
 
-	simulateResponse := simulateTxResponse{}
-	loadResponse(resp.Body, &simulateResponse)
+		expectedErr := errors.New("expected error")
+		processBatchWasCalled := false
+		facade := mock.FacadeStub{
+			SimulateTransactionsBatchExecutionHandler: func(txs []*dataTx.Transaction, _ txSimData.SimulationOptions) (*txSimData.BatchSimulationResults, error) {
+				processBatchWasCalled = true
+				return &txSimData.BatchSimulationResults{}, nil
+			},
+			CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{Nonce: nonce}, []byte("hash"), nil
+			},
+			ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+				if tx.Nonce == 1 {
+					return expectedErr
+				}
+				return nil
+			},
+		}
 
-	assert.Equal(t, http.StatusInternalServerError, resp.Code)
-	assert.Contains(t, simulateResponse.Error, expectedErr.Error())
-}
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
 
-func TestSimulateTransaction(t *testing.T) {
-	t.Parallel()
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
 
-	processTxWasCalled := false
+		req, _ := http.NewRequest("POST", "/transaction/simulate-batch", bytes.NewBuffer(jsonBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
 
-	facade := mock.FacadeStub{
-		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResults, error) {
-			processTxWasCalled = true
-			return &txSimData.SimulationResults{
-				Status:     "ok",
-				FailReason: "no reason",
-				ScResults:  nil,
-				Receipts:   nil,
-				Hash:       "hash",
-			}, nil
-		},
-		CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
-			return &dataTx.Transaction{}, []byte("hash"), nil
-		},
-		ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
-			return nil
-		},
-	}
+		simulateResponse := simulateTxResponse{}
+		loadResponse(resp.Body, &simulateResponse)
 
-	transactionGroup, err := groups.NewTransactionGroup(&facade)
-	require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.False(t, processBatchWasCalled)
+		assert.Contains(t, simulateResponse.Error, "transaction 1")
+		assert.Contains(t, simulateResponse.Error, expectedErr.Error())
+	})
+	t.Run("process error should error", func(t *testing.T) {
+		t.Parallel()
 
-	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			SimulateTransactionsBatchExecutionHandler: func(txs []*dataTx.Transaction, _ txSimData.SimulationOptions) (*txSimData.BatchSimulationResults, error) {
+				return nil, expectedErr
+			},
+			CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{Nonce: nonce}, []byte("hash"), nil
+			},
+			ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+				return nil
+			},
+		}
 
-	tx := groups.SendTxRequest{
-		Sender:    "sender1",
-		Receiver:  "receiver1",
-		Value:     "100",
-		Data:      make([]byte, 0),
-		Nonce:     0,
-		GasPrice:  0,
-		GasLimit:  0,
-		Signature: "",
-	}
-	jsonBytes, _ := json.Marshal(tx)
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
 
-	req, _ := http.NewRequest("POST", "/transaction/simulate", bytes.NewBuffer(jsonBytes))
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
 
-	resp := httptest.NewRecorder()
-	ws.ServeHTTP(resp, req)
+		req, _ := http.NewRequest("POST", "/transaction/simulate-batch", bytes.NewBuffer(jsonBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
 
-	simulateResponse := simulateTxResponse{}
-	loadResponse(resp.Body, &simulateResponse)
+		simulateResponse := simulateTxResponse{}
+		loadResponse(resp.Body, &simulateResponse)
 
-	assert.Equal(t, http.StatusOK, resp.Code)
-	assert.True(t, processTxWasCalled)
-	assert.Equal(t, string(shared.ReturnCodeSuccess), simulateResponse.Code)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.Contains(t, simulateResponse.Error, expectedErr.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		var providedNonces []uint64
+		facade := mock.FacadeStub{
+			SimulateTransactionsBatchExecutionHandler: func(txs []*dataTx.Transaction, _ txSimData.SimulationOptions) (*txSimData.BatchSimulationResults, error) {
+				for _, tx := range txs {
+					providedNonces = append(providedNonces, tx.Nonce)
+				}
+				return &txSimData.BatchSimulationResults{
+					Results: []*txSimData.SimulationResults{{Status: "success"}, {Status: "success"}},
+				}, nil
+			},
+			CreateTransactionHandler: func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64, gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{Nonce: nonce}, []byte("hash"), nil
+			},
+			ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+				return nil
+			},
+		}
+
+		transactionGroup, err := groups.NewTransactionGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/transaction/simulate-batch", bytes.NewBuffer(jsonBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		simulateResponse := simulateTxResponse{}
+		loadResponse(resp.Body, &simulateResponse)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, string(shared.ReturnCodeSuccess), simulateResponse.Code)
+		assert.Equal(t, []uint64{0, 1}, providedNonces)
+	})
 }
 
 func TestGetTransactionsPoolShouldError(t *testing.T) {
@@ -869,7 +1957,7 @@ func TestGetTransactionsPoolShouldError(t *testing.T) {
 
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		GetTransactionsPoolCalled: func(fields string) (*common.TransactionsPoolAPIResponse, error) {
+		GetTransactionsPoolCalled: func(options common.TransactionsPoolQueryOptions) (*common.TransactionsPoolAPIResponse, error) {
 			return nil, expectedErr
 		},
 	}
@@ -909,7 +1997,7 @@ func TestGetTransactionsPoolShouldWork(t *testing.T) {
 		},
 	}
 	facade := mock.FacadeStub{
-		GetTransactionsPoolCalled: func(fields string) (*common.TransactionsPoolAPIResponse, error) {
+		GetTransactionsPoolCalled: func(options common.TransactionsPoolQueryOptions) (*common.TransactionsPoolAPIResponse, error) {
 			return expectedTxPool, nil
 		},
 	}
@@ -1132,6 +2220,192 @@ func TestGetTransactionsPoolNonceGapsForSenderShouldWork(t *testing.T) {
 	assert.Equal(t, *expectedNonceGaps, nonceGapsResp.Data.NonceGaps)
 }
 
+func TestGetTransactionsPoolReplacementsForSenderShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedSender := "sender"
+	query := "?by-sender=" + expectedSender + "&replacements=true"
+	expectedReplacements := &common.TransactionsPoolReplacementsForSenderApiResponse{
+		Sender: expectedSender,
+		Replacements: []common.TxReplacementApiResponse{
+			{
+				Nonce:               5,
+				ReplacedTxHash:      "aa",
+				ReplacedGasPrice:    1000,
+				ReplacementTxHash:   "bb",
+				ReplacementGasPrice: 1100,
+			},
+		},
+	}
+	facade := mock.FacadeStub{
+		GetTransactionsPoolReplacementsForSenderCalled: func(sender string) (*common.TransactionsPoolReplacementsForSenderApiResponse, error) {
+			assert.Equal(t, expectedSender, sender)
+			return expectedReplacements, nil
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/transaction/pool"+query, nil)
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	replacementsResp := txPoolReplacementsForSenderResponse{}
+	loadResponse(resp.Body, &replacementsResp)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, replacementsResp.Error)
+	assert.Equal(t, *expectedReplacements, replacementsResp.Data.Replacements)
+}
+
+func TestGetTransactionsPoolShouldPassTheOrderingAndFilteringOptions(t *testing.T) {
+	t.Parallel()
+
+	query := "?fields=hash,gasprice&order-by=gasprice&receiver=erd1receiver&data-prefix=claim"
+	expectedOptions := common.TransactionsPoolQueryOptions{
+		Fields:     "hash,gasprice",
+		OrderBy:    common.TransactionsPoolOrderByGasPrice,
+		Receiver:   "erd1receiver",
+		DataPrefix: "claim",
+	}
+	wasCalled := false
+	facade := mock.FacadeStub{
+		GetTransactionsPoolCalled: func(options common.TransactionsPoolQueryOptions) (*common.TransactionsPoolAPIResponse, error) {
+			wasCalled = true
+			assert.Equal(t, expectedOptions, options)
+			return &common.TransactionsPoolAPIResponse{}, nil
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/transaction/pool"+query, nil)
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.True(t, wasCalled)
+}
+
+func TestGetTransactionsPoolStatsForSenderShouldError(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		GetTransactionsPoolStatsForSenderCalled: func(sender string) (*common.TransactionsPoolSenderStatsApiResponse, error) {
+			return nil, expectedErr
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/transaction/pool?by-sender=sender&stats=true", nil)
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	statsResp := txPoolStatsForSenderResponse{}
+	loadResponse(resp.Body, &statsResp)
+
+	assert.Equal(t, http.StatusInternalServerError, resp.Code)
+	assert.Equal(t, expectedErr.Error(), statsResp.Error)
+}
+
+func TestGetTransactionsPoolStatsForSenderShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedSender := "sender"
+	expectedStats := &common.TransactionsPoolSenderStatsApiResponse{
+		Sender:              expectedSender,
+		Score:               42,
+		NumTxs:              3,
+		NumBytes:            300,
+		TotalGas:            150000,
+		AccountNonce:        7,
+		AccountNonceKnown:   true,
+		NumFailedSelections: 1,
+		NonceGaps: []common.NonceGapApiResponse{
+			{From: 8, To: 9},
+		},
+	}
+	facade := mock.FacadeStub{
+		GetTransactionsPoolStatsForSenderCalled: func(sender string) (*common.TransactionsPoolSenderStatsApiResponse, error) {
+			assert.Equal(t, expectedSender, sender)
+			return expectedStats, nil
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/transaction/pool?by-sender="+expectedSender+"&stats=true", nil)
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	statsResp := txPoolStatsForSenderResponse{}
+	loadResponse(resp.Body, &statsResp)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, statsResp.Error)
+	assert.Equal(t, *expectedStats, statsResp.Data.Stats)
+}
+
+func TestGetTransactionsPoolEvictionsShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedEvictions := &common.TransactionsPoolEvictionsApiResponse{
+		Rounds: []common.EvictionRoundApiResponse{
+			{
+				Timestamp:         1000,
+				DurationInMillis:  5,
+				NumTxsBefore:      100,
+				NumSendersBefore:  10,
+				NumBytesBefore:    10000,
+				NumTxsEvicted:     20,
+				NumSendersEvicted: 2,
+				NumSteps:          1,
+				EvictedSenders:    []string{"alice", "bob"},
+			},
+		},
+	}
+	facade := mock.FacadeStub{
+		GetTransactionsPoolEvictionsCalled: func() (*common.TransactionsPoolEvictionsApiResponse, error) {
+			return expectedEvictions, nil
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/transaction/pool?evictions=true", nil)
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	evictionsResp := txPoolEvictionsResponse{}
+	loadResponse(resp.Body, &evictionsResp)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Empty(t, evictionsResp.Error)
+	assert.Equal(t, *expectedEvictions, evictionsResp.Data.Evictions)
+}
+
 func TestGetTransactionsPoolInvalidQueries(t *testing.T) {
 	t.Parallel()
 
@@ -1139,8 +2413,17 @@ func TestGetTransactionsPoolInvalidQueries(t *testing.T) {
 	t.Run("empty sender, requesting nonce gaps", testTxPoolWithInvalidQuery("?nonce-gaps=true", apiErrors.ErrEmptySenderToGetNonceGaps))
 	t.Run("fields + latest nonce", testTxPoolWithInvalidQuery("?fields=sender,receiver&last-nonce=true", apiErrors.ErrFetchingLatestNonceCannotIncludeFields))
 	t.Run("fields + nonce gaps", testTxPoolWithInvalidQuery("?fields=sender,receiver&nonce-gaps=true", apiErrors.ErrFetchingNonceGapsCannotIncludeFields))
+	t.Run("empty sender, requesting replacements", testTxPoolWithInvalidQuery("?replacements=true", apiErrors.ErrEmptySenderToGetReplacements))
+	t.Run("fields + replacements", testTxPoolWithInvalidQuery("?fields=sender,receiver&replacements=true", apiErrors.ErrFetchingReplacementsCannotIncludeFields))
 	t.Run("fields has spaces", testTxPoolWithInvalidQuery("?fields=sender ,receiver", apiErrors.ErrInvalidFields))
 	t.Run("fields has numbers", testTxPoolWithInvalidQuery("?fields=sender1", apiErrors.ErrInvalidFields))
+	t.Run("empty sender, requesting stats", testTxPoolWithInvalidQuery("?stats=true", apiErrors.ErrEmptySenderToGetStats))
+	t.Run("fields + stats", testTxPoolWithInvalidQuery("?by-sender=sender&fields=sender,receiver&stats=true", apiErrors.ErrFetchingStatsCannotIncludeFields))
+	t.Run("sender + evictions", testTxPoolWithInvalidQuery("?by-sender=sender&evictions=true", apiErrors.ErrFetchingEvictionsCannotIncludeSenderOrFields))
+	t.Run("fields + evictions", testTxPoolWithInvalidQuery("?fields=sender&evictions=true", apiErrors.ErrFetchingEvictionsCannotIncludeSenderOrFields))
+	t.Run("sender + order", testTxPoolWithInvalidQuery("?by-sender=sender&order-by=gasprice", apiErrors.ErrOrderingOrFilteringTheTransactionsPoolCannotIncludeSender))
+	t.Run("sender + receiver filter", testTxPoolWithInvalidQuery("?by-sender=sender&receiver=erd1receiver", apiErrors.ErrOrderingOrFilteringTheTransactionsPoolCannotIncludeSender))
+	t.Run("invalid order", testTxPoolWithInvalidQuery("?order-by=nonce", apiErrors.ErrInvalidTransactionsPoolOrder))
 }
 
 func testTxPoolWithInvalidQuery(query string, expectedErr error) func(t *testing.T) {
@@ -1174,10 +2457,18 @@ func getTransactionRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/send", Open: true},
 					{Name: "/send-multiple", Open: true},
 					{Name: "/cost", Open: true},
+					{Name: "/estimate-gas", Open: true},
+					{Name: "/estimate-gas-scr", Open: true},
+					{Name: "/schedule", Open: true},
+					{Name: "/scheduled", Open: true},
+					{Name: "/scheduled/:txhash", Open: true},
 					{Name: "/pool", Open: true},
 					{Name: "/:txhash", Open: true},
 					{Name: "/:txhash/status", Open: true},
+					{Name: "/:txhash/trace", Open: true},
+					{Name: "/:txhash/wait", Open: true},
 					{Name: "/simulate", Open: true},
+					{Name: "/simulate-batch", Open: true},
 				},
 			},
 		},
@@ -42,6 +42,10 @@ func NewValidatorGroup(facade validatorFacadeHandler) (*validatorGroup, error) {
 			Path:    statisticsPath,
 			Method:  http.MethodGet,
 			Handler: ng.statistics,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "returns the rating and the validation statistics of all the validators",
+				Response: gin.H{"statistics": map[string]*state.ValidatorApiResponse{}},
+			},
 		},
 	}
 	ng.endpoints = endpoints
@@ -52,21 +52,41 @@ func NewVmValuesGroup(facade vmValuesFacadeHandler) (*vmValuesGroup, error) {
 			Path:    hexPath,
 			Method:  http.MethodPost,
 			Handler: vvg.getHex,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "executes a smart contract view function and returns its first result, hex encoded",
+				Request:  VMValueRequest{},
+				Response: gin.H{"data": ""},
+			},
 		},
 		{
 			Path:    stringPath,
 			Method:  http.MethodPost,
 			Handler: vvg.getString,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "executes a smart contract view function and returns its first result as a string",
+				Request:  VMValueRequest{},
+				Response: gin.H{"data": ""},
+			},
 		},
 		{
 			Path:    intPath,
 			Method:  http.MethodPost,
 			Handler: vvg.getInt,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "executes a smart contract view function and returns its first result as a big integer string",
+				Request:  VMValueRequest{},
+				Response: gin.H{"data": ""},
+			},
 		},
 		{
 			Path:    queryPath,
 			Method:  http.MethodPost,
 			Handler: vvg.executeQuery,
+			Documentation: &shared.EndpointDocumentation{
+				Summary:  "executes a smart contract view function",
+				Request:  VMValueRequest{},
+				Response: gin.H{"data": vm.VMOutputApi{}},
+			},
 		},
 	}
 	vvg.endpoints = endpoints
@@ -76,13 +96,14 @@ func NewVmValuesGroup(facade vmValuesFacadeHandler) (*vmValuesGroup, error) {
 
 // VMValueRequest represents the structure on which user input for generating a new transaction will validate against
 type VMValueRequest struct {
-	ScAddress      string   `json:"scAddress"`
-	FuncName       string   `json:"funcName"`
-	CallerAddr     string   `json:"caller"`
-	CallValue      string   `json:"value"`
-	Args           []string `json:"args"`
-	SameScState    bool     `json:"sameScState"`
-	ShouldBeSynced bool     `json:"shouldBeSynced"`
+	ScAddress      string                                  `json:"scAddress"`
+	FuncName       string                                  `json:"funcName"`
+	CallerAddr     string                                  `json:"caller"`
+	CallValue      string                                  `json:"value"`
+	Args           []string                                `json:"args"`
+	SameScState    bool                                    `json:"sameScState"`
+	ShouldBeSynced bool                                    `json:"shouldBeSynced"`
+	StateOverrides map[string]*AccountStateOverrideRequest `json:"stateOverrides,omitempty"`
 }
 
 // getHex returns the data as bytes, hex-encoded
@@ -153,7 +174,16 @@ func (vvg *vmValuesGroup) doExecuteQuery(context *gin.Context) (*vm.VMOutputApi,
 }
 
 func (vvg *vmValuesGroup) createSCQuery(request *VMValueRequest) (*process.SCQuery, error) {
-	decodedAddress, err := vvg.getFacade().DecodeAddressPubkey(request.ScAddress)
+	return newSCQuery(vvg.getFacade(), request)
+}
+
+// addressPubkeyDecoder defines the component able to decode the human-readable addresses
+type addressPubkeyDecoder interface {
+	DecodeAddressPubkey(pk string) ([]byte, error)
+}
+
+func newSCQuery(decoder addressPubkeyDecoder, request *VMValueRequest) (*process.SCQuery, error) {
+	decodedAddress, err := decoder.DecodeAddressPubkey(request.ScAddress)
 	if err != nil {
 		return nil, fmt.Errorf("'%s' is not a valid address: %s", request.ScAddress, err.Error())
 	}
@@ -178,7 +208,7 @@ func (vvg *vmValuesGroup) createSCQuery(request *VMValueRequest) (*process.SCQue
 	}
 
 	if len(request.CallerAddr) > 0 {
-		callerAddress, errDecodeCaller := vvg.getFacade().DecodeAddressPubkey(request.CallerAddr)
+		callerAddress, errDecodeCaller := decoder.DecodeAddressPubkey(request.CallerAddr)
 		if errDecodeCaller != nil {
 			return nil, errDecodeCaller
 		}
@@ -194,6 +224,11 @@ func (vvg *vmValuesGroup) createSCQuery(request *VMValueRequest) (*process.SCQue
 		scQuery.CallValue = callValue
 	}
 
+	scQuery.StateOverrides, err = newStateOverrides(decoder, request.StateOverrides)
+	if err != nil {
+		return nil, err
+	}
+
 	return scQuery, nil
 }
 
@@ -0,0 +1,64 @@
+package jsonrpc
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNilMethodHandler signals that a nil method handler has been provided
+var ErrNilMethodHandler = errors.New("nil JSON-RPC method handler")
+
+// ErrEmptyMethodName signals that a method with an empty name has been provided
+var ErrEmptyMethodName = errors.New("empty JSON-RPC method name")
+
+// ErrDuplicatedMethod signals that the same method has been provided more than once
+var ErrDuplicatedMethod = errors.New("duplicated JSON-RPC method")
+
+// ErrInvalidMaxBatchSize signals that an invalid maximum batch size has been provided
+var ErrInvalidMaxBatchSize = errors.New("invalid JSON-RPC max batch size")
+
+const (
+	// ErrCodeParse is returned when the received payload is not a valid JSON
+	ErrCodeParse = -32700
+	// ErrCodeInvalidRequest is returned when the received JSON is not a valid request object
+	ErrCodeInvalidRequest = -32600
+	// ErrCodeMethodNotFound is returned when the requested method does not exist or is not enabled
+	ErrCodeMethodNotFound = -32601
+	// ErrCodeInvalidParams is returned when the params of the request are not valid for the requested method
+	ErrCodeInvalidParams = -32602
+	// ErrCodeInternal is returned when the request could not be processed because of an internal error
+	ErrCodeInternal = -32603
+	// ErrCodeServer is returned when the node failed to execute the requested method
+	ErrCodeServer = -32000
+	// ErrCodeUnauthorized is returned when the requested method requires authentication and the caller is not
+	// authenticated or not allowed to call it
+	ErrCodeUnauthorized = -32001
+	// ErrCodeTooManyRequests is returned when the throttler of the requested method or the caller's quota is exhausted
+	ErrCodeTooManyRequests = -32005
+)
+
+// Error is the error object of a JSON-RPC 2.0 response
+type Error struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Error returns the error message
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s (code %d)", e.Message, e.Code)
+}
+
+// NewInvalidParamsError creates an error to be returned by the method handlers when the params are not valid
+func NewInvalidParamsError(err error) *Error {
+	return &Error{
+		Code:    ErrCodeInvalidParams,
+		Message: err.Error(),
+	}
+}
+
+func newError(code int, message string) *Error {
+	return &Error{
+		Code:    code,
+		Message: message,
+	}
+}
@@ -0,0 +1,47 @@
+package jsonrpc
+
+import "encoding/json"
+
+// Version is the only JSON-RPC version accepted
+const Version = "2.0"
+
+// Request is a JSON-RPC 2.0 request object. A request without id is a notification and gets no response
+type Request struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// Response is a JSON-RPC 2.0 response object, holding either a result or an error
+type Response struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *Error          `json:"error,omitempty"`
+}
+
+func (r *Request) isNotification() bool {
+	return len(r.ID) == 0
+}
+
+func newResultResponse(id json.RawMessage, result interface{}) *Response {
+	if result == nil {
+		// the result member is required on success, even when null
+		result = json.RawMessage("null")
+	}
+
+	return &Response{
+		JSONRPC: Version,
+		ID:      id,
+		Result:  result,
+	}
+}
+
+func newErrorResponse(id json.RawMessage, err *Error) *Response {
+	return &Response{
+		JSONRPC: Version,
+		ID:      id,
+		Error:   err,
+	}
+}
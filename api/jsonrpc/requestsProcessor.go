@@ -0,0 +1,225 @@
+package jsonrpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	apiErrors "github.com/ElrondNetwork/elrond-go/api/errors"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+)
+
+var log = logger.GetOrCreate("api/jsonrpc")
+
+// MethodHandler executes a JSON-RPC method with the provided params and returns its result. The params are not
+// valid when the returned error is created by NewInvalidParamsError
+type MethodHandler func(params json.RawMessage) (interface{}, error)
+
+// RouteAuthorizer returns an error if the caller of the processed requests is not allowed to call the provided route
+type RouteAuthorizer func(route string) error
+
+// QuotaConsumer charges one call to the caller of the processed requests and returns an error if its quota is reached
+type QuotaConsumer func() error
+
+// MethodHandlerData holds the items needed for registering a JSON-RPC method
+type MethodHandlerData struct {
+	Name    string
+	Handler MethodHandler
+	// ThrottlerName is the name of the endpoint throttler that guards the method, if any is defined
+	ThrottlerName string
+	// AuthRoutes are the routes the caller has to be allowed to call, if the method requires authentication
+	AuthRoutes []string
+}
+
+// ArgsRequestsProcessor holds the arguments needed to create a new instance of requestsProcessor
+type ArgsRequestsProcessor struct {
+	Methods      []*MethodHandlerData
+	MaxBatchSize int
+}
+
+type requestsProcessor struct {
+	methods      map[string]*MethodHandlerData
+	maxBatchSize int
+}
+
+// NewRequestsProcessor creates a component able to process JSON-RPC 2.0 payloads, holding either a single request
+// or a batch of requests
+func NewRequestsProcessor(args ArgsRequestsProcessor) (*requestsProcessor, error) {
+	if args.MaxBatchSize < 1 {
+		return nil, ErrInvalidMaxBatchSize
+	}
+
+	methods := make(map[string]*MethodHandlerData, len(args.Methods))
+	for _, method := range args.Methods {
+		if method == nil || method.Handler == nil {
+			return nil, ErrNilMethodHandler
+		}
+		if len(method.Name) == 0 {
+			return nil, ErrEmptyMethodName
+		}
+		_, exists := methods[method.Name]
+		if exists {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicatedMethod, method.Name)
+		}
+
+		methods[method.Name] = method
+	}
+
+	return &requestsProcessor{
+		methods:      methods,
+		maxBatchSize: args.MaxBatchSize,
+	}, nil
+}
+
+// Process executes the requests found in the provided payload and returns the marshalled response. A nil response
+// is returned when the payload holds only notifications. The facade provides the endpoint throttlers, while the
+// authorizer checks the caller of the methods requiring authentication, which are rejected if no authorizer is provided.
+// The quota consumer, if any is provided, is charged for each executed method, so a batch costs as many requests as
+// the calls it holds
+func (rp *requestsProcessor) Process(payload []byte, facade interface{}, authorizer RouteAuthorizer, quotaConsumer QuotaConsumer) []byte {
+	payload = bytes.TrimSpace(payload)
+	isBatch := len(payload) > 0 && payload[0] == '['
+	if !isBatch {
+		response := rp.processRequest(payload, facade, authorizer, quotaConsumer)
+		if response == nil {
+			return nil
+		}
+
+		return marshalResponse(response)
+	}
+
+	var rawRequests []json.RawMessage
+	err := json.Unmarshal(payload, &rawRequests)
+	if err != nil {
+		return marshalResponse(newErrorResponse(nil, newError(ErrCodeParse, err.Error())))
+	}
+	if len(rawRequests) == 0 {
+		return marshalResponse(newErrorResponse(nil, newError(ErrCodeInvalidRequest, "empty batch")))
+	}
+	if len(rawRequests) > rp.maxBatchSize {
+		message := fmt.Sprintf("batch too large, at most %d requests are allowed", rp.maxBatchSize)
+		return marshalResponse(newErrorResponse(nil, newError(ErrCodeInvalidRequest, message)))
+	}
+
+	responses := make([]*Response, 0, len(rawRequests))
+	for _, rawRequest := range rawRequests {
+		response := rp.processRequest(rawRequest, facade, authorizer, quotaConsumer)
+		if response != nil {
+			responses = append(responses, response)
+		}
+	}
+	if len(responses) == 0 {
+		return nil
+	}
+
+	return marshalResponse(responses)
+}
+
+func (rp *requestsProcessor) processRequest(
+	rawRequest []byte,
+	facade interface{},
+	authorizer RouteAuthorizer,
+	quotaConsumer QuotaConsumer,
+) *Response {
+	if !json.Valid(rawRequest) {
+		return newErrorResponse(nil, newError(ErrCodeParse, "invalid JSON"))
+	}
+
+	request := &Request{}
+	err := json.Unmarshal(rawRequest, request)
+	if err != nil {
+		return newErrorResponse(nil, newError(ErrCodeInvalidRequest, err.Error()))
+	}
+	if request.JSONRPC != Version || len(request.Method) == 0 {
+		return newErrorResponse(request.ID, newError(ErrCodeInvalidRequest, "invalid request object"))
+	}
+
+	result, rpcErr := rp.executeMethod(request, facade, authorizer, quotaConsumer)
+	if request.isNotification() {
+		return nil
+	}
+	if rpcErr != nil {
+		return newErrorResponse(request.ID, rpcErr)
+	}
+
+	return newResultResponse(request.ID, result)
+}
+
+func (rp *requestsProcessor) executeMethod(
+	request *Request,
+	facade interface{},
+	authorizer RouteAuthorizer,
+	quotaConsumer QuotaConsumer,
+) (interface{}, *Error) {
+	method, found := rp.methods[request.Method]
+	if !found {
+		return nil, newError(ErrCodeMethodNotFound, fmt.Sprintf("method %s not found", request.Method))
+	}
+
+	rpcErr := authorize(method, authorizer)
+	if rpcErr != nil {
+		return nil, rpcErr
+	}
+	if quotaConsumer != nil {
+		err := quotaConsumer()
+		if err != nil {
+			return nil, newError(ErrCodeTooManyRequests, err.Error())
+		}
+	}
+
+	endProcessing, err := middleware.StartEndpointProcessing(method.ThrottlerName, facade)
+	if errors.Is(err, apiErrors.ErrInvalidAppContext) {
+		return nil, newError(ErrCodeInternal, err.Error())
+	}
+	if err != nil {
+		return nil, newError(ErrCodeTooManyRequests, err.Error())
+	}
+	defer endProcessing()
+
+	result, err := method.Handler(request.Params)
+	if err == nil {
+		return result, nil
+	}
+
+	rpcErr = &Error{}
+	if errors.As(err, &rpcErr) {
+		return nil, rpcErr
+	}
+
+	return nil, newError(ErrCodeServer, err.Error())
+}
+
+func authorize(method *MethodHandlerData, authorizer RouteAuthorizer) *Error {
+	if len(method.AuthRoutes) == 0 {
+		return nil
+	}
+	if authorizer == nil {
+		return newError(ErrCodeUnauthorized, fmt.Sprintf("method %s requires authentication", method.Name))
+	}
+
+	for _, route := range method.AuthRoutes {
+		err := authorizer(route)
+		if err != nil {
+			return newError(ErrCodeUnauthorized, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func marshalResponse(response interface{}) []byte {
+	buff, err := json.Marshal(response)
+	if err != nil {
+		log.Warn("cannot marshal JSON-RPC response", "error", err)
+		buff, _ = json.Marshal(newErrorResponse(nil, newError(ErrCodeInternal, err.Error())))
+	}
+
+	return buff
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rp *requestsProcessor) IsInterfaceNil() bool {
+	return rp == nil
+}
@@ -0,0 +1,300 @@
+package jsonrpc_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/api/jsonrpc"
+	"github.com/ElrondNetwork/elrond-go/api/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type echoParams struct {
+	Value string `json:"value"`
+}
+
+func createMockArgsRequestsProcessor() jsonrpc.ArgsRequestsProcessor {
+	return jsonrpc.ArgsRequestsProcessor{
+		Methods: []*jsonrpc.MethodHandlerData{
+			{
+				Name: "echo",
+				Handler: func(params json.RawMessage) (interface{}, error) {
+					request := echoParams{}
+					err := json.Unmarshal(params, &request)
+					if err != nil {
+						return nil, jsonrpc.NewInvalidParamsError(err)
+					}
+
+					return request.Value, nil
+				},
+				ThrottlerName: "echo-throttler",
+			},
+			{
+				Name: "fail",
+				Handler: func(params json.RawMessage) (interface{}, error) {
+					return nil, errors.New("expected error")
+				},
+			},
+		},
+		MaxBatchSize: 2,
+	}
+}
+
+type requestsProcessorHandler interface {
+	Process(payload []byte, facade interface{}, authorizer jsonrpc.RouteAuthorizer, quotaConsumer jsonrpc.QuotaConsumer) []byte
+}
+
+func createProcessor(t *testing.T) requestsProcessorHandler {
+	processor, err := jsonrpc.NewRequestsProcessor(createMockArgsRequestsProcessor())
+	require.Nil(t, err)
+
+	return processor
+}
+
+func TestNewRequestsProcessor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid max batch size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRequestsProcessor()
+		args.MaxBatchSize = 0
+		processor, err := jsonrpc.NewRequestsProcessor(args)
+		assert.True(t, check.IfNil(processor))
+		assert.Equal(t, jsonrpc.ErrInvalidMaxBatchSize, err)
+	})
+	t.Run("nil method handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRequestsProcessor()
+		args.Methods[0].Handler = nil
+		processor, err := jsonrpc.NewRequestsProcessor(args)
+		assert.True(t, check.IfNil(processor))
+		assert.Equal(t, jsonrpc.ErrNilMethodHandler, err)
+	})
+	t.Run("empty method name should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRequestsProcessor()
+		args.Methods[0].Name = ""
+		processor, err := jsonrpc.NewRequestsProcessor(args)
+		assert.True(t, check.IfNil(processor))
+		assert.Equal(t, jsonrpc.ErrEmptyMethodName, err)
+	})
+	t.Run("duplicated method should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRequestsProcessor()
+		args.Methods[1].Name = args.Methods[0].Name
+		processor, err := jsonrpc.NewRequestsProcessor(args)
+		assert.True(t, check.IfNil(processor))
+		assert.True(t, errors.Is(err, jsonrpc.ErrDuplicatedMethod))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		processor, err := jsonrpc.NewRequestsProcessor(createMockArgsRequestsProcessor())
+		assert.False(t, check.IfNil(processor))
+		assert.Nil(t, err)
+	})
+}
+
+func TestRequestsProcessor_Process(t *testing.T) {
+	t.Parallel()
+
+	t.Run("single request should work", func(t *testing.T) {
+		t.Parallel()
+
+		payload := `{"jsonrpc":"2.0","id":1,"method":"echo","params":{"value":"test"}}`
+		response := createProcessor(t).Process([]byte(payload), &mock.FacadeStub{}, nil, nil)
+		assert.JSONEq(t, `{"jsonrpc":"2.0","id":1,"result":"test"}`, string(response))
+	})
+	t.Run("invalid JSON should return parse error", func(t *testing.T) {
+		t.Parallel()
+
+		response := createProcessor(t).Process([]byte(`{"jsonrpc":`), &mock.FacadeStub{}, nil, nil)
+		assertErrorCode(t, response, jsonrpc.ErrCodeParse)
+	})
+	t.Run("invalid version should return invalid request", func(t *testing.T) {
+		t.Parallel()
+
+		payload := `{"jsonrpc":"1.0","id":1,"method":"echo"}`
+		response := createProcessor(t).Process([]byte(payload), &mock.FacadeStub{}, nil, nil)
+		assertErrorCode(t, response, jsonrpc.ErrCodeInvalidRequest)
+	})
+	t.Run("unknown method should return method not found", func(t *testing.T) {
+		t.Parallel()
+
+		payload := `{"jsonrpc":"2.0","id":1,"method":"missing"}`
+		response := createProcessor(t).Process([]byte(payload), &mock.FacadeStub{}, nil, nil)
+		assertErrorCode(t, response, jsonrpc.ErrCodeMethodNotFound)
+	})
+	t.Run("invalid params should return invalid params", func(t *testing.T) {
+		t.Parallel()
+
+		payload := `{"jsonrpc":"2.0","id":1,"method":"echo","params":[1]}`
+		response := createProcessor(t).Process([]byte(payload), &mock.FacadeStub{}, nil, nil)
+		assertErrorCode(t, response, jsonrpc.ErrCodeInvalidParams)
+	})
+	t.Run("handler error should return server error", func(t *testing.T) {
+		t.Parallel()
+
+		payload := `{"jsonrpc":"2.0","id":"a","method":"fail"}`
+		response := createProcessor(t).Process([]byte(payload), &mock.FacadeStub{}, nil, nil)
+		assertErrorCode(t, response, jsonrpc.ErrCodeServer)
+	})
+	t.Run("invalid facade should return internal error", func(t *testing.T) {
+		t.Parallel()
+
+		payload := `{"jsonrpc":"2.0","id":1,"method":"echo","params":{"value":"test"}}`
+		response := createProcessor(t).Process([]byte(payload), "not a facade", nil, nil)
+		assertErrorCode(t, response, jsonrpc.ErrCodeInternal)
+	})
+	t.Run("throttled method should return too many requests", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetThrottlerForEndpointCalled: func(endpoint string) (core.Throttler, bool) {
+				assert.Equal(t, "echo-throttler", endpoint)
+				return &mock.ThrottlerStub{
+					CanProcessCalled: func() bool {
+						return false
+					},
+				}, true
+			},
+		}
+
+		payload := `{"jsonrpc":"2.0","id":1,"method":"echo","params":{"value":"test"}}`
+		response := createProcessor(t).Process([]byte(payload), facade, nil, nil)
+		assertErrorCode(t, response, jsonrpc.ErrCodeTooManyRequests)
+	})
+	t.Run("method requiring authentication without authorizer should return unauthorized", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRequestsProcessor()
+		args.Methods[0].AuthRoutes = []string{"/echo"}
+		processor, _ := jsonrpc.NewRequestsProcessor(args)
+
+		payload := `{"jsonrpc":"2.0","id":1,"method":"echo","params":{"value":"test"}}`
+		response := processor.Process([]byte(payload), &mock.FacadeStub{}, nil, nil)
+		assertErrorCode(t, response, jsonrpc.ErrCodeUnauthorized)
+	})
+	t.Run("method requiring authentication with failing authorizer should return unauthorized", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRequestsProcessor()
+		args.Methods[0].AuthRoutes = []string{"/echo"}
+		processor, _ := jsonrpc.NewRequestsProcessor(args)
+
+		authorizer := func(route string) error {
+			assert.Equal(t, "/echo", route)
+			return errors.New("not allowed")
+		}
+		payload := `{"jsonrpc":"2.0","id":1,"method":"echo","params":{"value":"test"}}`
+		response := processor.Process([]byte(payload), &mock.FacadeStub{}, authorizer, nil)
+		assertErrorCode(t, response, jsonrpc.ErrCodeUnauthorized)
+	})
+	t.Run("method requiring authentication with passing authorizer should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRequestsProcessor()
+		args.Methods[0].AuthRoutes = []string{"/echo"}
+		processor, _ := jsonrpc.NewRequestsProcessor(args)
+
+		authorizer := func(route string) error {
+			return nil
+		}
+		payload := `{"jsonrpc":"2.0","id":1,"method":"echo","params":{"value":"test"}}`
+		response := processor.Process([]byte(payload), &mock.FacadeStub{}, authorizer, nil)
+
+		rpcResponse := jsonrpc.Response{}
+		err := json.Unmarshal(response, &rpcResponse)
+		require.Nil(t, err)
+		assert.Nil(t, rpcResponse.Error)
+		assert.Equal(t, "test", rpcResponse.Result)
+	})
+	t.Run("batch should charge the quota for each executed call", func(t *testing.T) {
+		t.Parallel()
+
+		numCharged := 0
+		quotaConsumer := func() error {
+			numCharged++
+			if numCharged > 1 {
+				return errors.New("quota reached")
+			}
+
+			return nil
+		}
+		payload := `[
+			{"jsonrpc":"2.0","id":1,"method":"echo","params":{"value":"test"}},
+			{"jsonrpc":"2.0","id":2,"method":"echo","params":{"value":"test"}}
+		]`
+		response := createProcessor(t).Process([]byte(payload), &mock.FacadeStub{}, nil, quotaConsumer)
+
+		responses := make([]jsonrpc.Response, 0)
+		err := json.Unmarshal(response, &responses)
+		require.Nil(t, err)
+		require.Equal(t, 2, len(responses))
+		assert.Nil(t, responses[0].Error)
+		assert.Equal(t, jsonrpc.ErrCodeTooManyRequests, responses[1].Error.Code)
+		assert.Equal(t, 2, numCharged)
+	})
+	t.Run("notification should not respond", func(t *testing.T) {
+		t.Parallel()
+
+		payload := `{"jsonrpc":"2.0","method":"echo","params":{"value":"test"}}`
+		response := createProcessor(t).Process([]byte(payload), &mock.FacadeStub{}, nil, nil)
+		assert.Nil(t, response)
+	})
+	t.Run("batch should work", func(t *testing.T) {
+		t.Parallel()
+
+		payload := `[
+			{"jsonrpc":"2.0","id":1,"method":"echo","params":{"value":"test"}},
+			{"jsonrpc":"2.0","id":2,"method":"missing"}
+		]`
+		response := createProcessor(t).Process([]byte(payload), &mock.FacadeStub{}, nil, nil)
+
+		responses := make([]jsonrpc.Response, 0)
+		err := json.Unmarshal(response, &responses)
+		require.Nil(t, err)
+		require.Equal(t, 2, len(responses))
+		assert.Equal(t, "test", responses[0].Result)
+		assert.Nil(t, responses[0].Error)
+		assert.Equal(t, jsonrpc.ErrCodeMethodNotFound, responses[1].Error.Code)
+		assert.Equal(t, json.RawMessage("2"), responses[1].ID)
+	})
+	t.Run("batch with notifications only should not respond", func(t *testing.T) {
+		t.Parallel()
+
+		payload := `[{"jsonrpc":"2.0","method":"echo","params":{"value":"test"}}]`
+		response := createProcessor(t).Process([]byte(payload), &mock.FacadeStub{}, nil, nil)
+		assert.Nil(t, response)
+	})
+	t.Run("empty batch should return invalid request", func(t *testing.T) {
+		t.Parallel()
+
+		response := createProcessor(t).Process([]byte(`[]`), &mock.FacadeStub{}, nil, nil)
+		assertErrorCode(t, response, jsonrpc.ErrCodeInvalidRequest)
+	})
+	t.Run("batch too large should return invalid request", func(t *testing.T) {
+		t.Parallel()
+
+		request := `{"jsonrpc":"2.0","id":1,"method":"echo","params":{"value":"test"}}`
+		payload := "[" + request + "," + request + "," + request + "]"
+		response := createProcessor(t).Process([]byte(payload), &mock.FacadeStub{}, nil, nil)
+		assertErrorCode(t, response, jsonrpc.ErrCodeInvalidRequest)
+	})
+}
+
+func assertErrorCode(t *testing.T, response []byte, expectedCode int) {
+	rpcResponse := jsonrpc.Response{}
+	err := json.Unmarshal(response, &rpcResponse)
+	require.Nil(t, err)
+	require.NotNil(t, rpcResponse.Error)
+	assert.Equal(t, expectedCode, rpcResponse.Error.Code)
+}
@@ -0,0 +1,261 @@
+package middleware
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// ApiKeyHeader is the header holding the API key of a client
+	ApiKeyHeader = "X-API-Key"
+	// AllRoutesWildcard allows an API client to call any route requiring authentication
+	AllRoutesWildcard = "*"
+
+	authorizationHeader     = "Authorization"
+	bearerPrefix            = "Bearer "
+	apiClientContextKey     = "apiClient"
+	authenticatorContextKey = "apiAuthenticator"
+)
+
+type apiClient struct {
+	name                   string
+	apiKey                 []byte
+	maxRequestsPerInterval uint32
+	allowedRoutes          map[string]struct{}
+}
+
+// authenticator is a middleware which identifies the API clients by their API key or JWT and limits the number of
+// requests each client can make. Requests without credentials pass through unauthenticated
+type authenticator struct {
+	clients     map[string]*apiClient
+	jwtSecret   []byte
+	mutRequests sync.Mutex
+	numRequests map[string]uint32
+}
+
+// NewAuthenticator creates a new instance of an authenticator
+func NewAuthenticator(cfg config.ApiAuthConfig) (*authenticator, error) {
+	clients := make(map[string]*apiClient, len(cfg.Clients))
+	apiKeys := make(map[string]struct{}, len(cfg.Clients))
+	for _, clientConfig := range cfg.Clients {
+		if len(clientConfig.Name) == 0 {
+			return nil, ErrEmptyApiClientName
+		}
+		if len(clientConfig.APIKey) == 0 && len(cfg.JWTSecret) == 0 {
+			return nil, fmt.Errorf("%w: %s", ErrApiClientWithoutCredentials, clientConfig.Name)
+		}
+		_, exists := clients[clientConfig.Name]
+		if exists {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicatedApiClient, clientConfig.Name)
+		}
+		if len(clientConfig.APIKey) > 0 {
+			_, exists = apiKeys[clientConfig.APIKey]
+			if exists {
+				return nil, fmt.Errorf("%w: API key of %s", ErrDuplicatedApiClient, clientConfig.Name)
+			}
+			apiKeys[clientConfig.APIKey] = struct{}{}
+		}
+
+		client := &apiClient{
+			name:                   clientConfig.Name,
+			apiKey:                 []byte(clientConfig.APIKey),
+			maxRequestsPerInterval: clientConfig.MaxRequestsPerInterval,
+			allowedRoutes:          make(map[string]struct{}, len(clientConfig.AllowedRoutes)),
+		}
+		for _, route := range clientConfig.AllowedRoutes {
+			client.allowedRoutes[route] = struct{}{}
+		}
+		clients[client.name] = client
+	}
+
+	return &authenticator{
+		clients:     clients,
+		jwtSecret:   []byte(cfg.JWTSecret),
+		numRequests: make(map[string]uint32),
+	}, nil
+}
+
+// MiddlewareHandlerFunc returns the handler func used by the gin server when processing requests
+func (a *authenticator) MiddlewareHandlerFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		client, err := a.identifyClient(c.Request)
+		if err != nil {
+			abortWithStatus(c, http.StatusUnauthorized, err.Error(), shared.ReturnCodeRequestError)
+			return
+		}
+		if client == nil {
+			c.Next()
+			return
+		}
+
+		err = a.consumeQuota(client)
+		if err != nil {
+			abortWithStatus(c, http.StatusTooManyRequests, err.Error(), shared.ReturnCodeSystemBusy)
+			return
+		}
+
+		c.Set(apiClientContextKey, client)
+		c.Set(authenticatorContextKey, a)
+		c.Next()
+	}
+}
+
+func (a *authenticator) identifyClient(request *http.Request) (*apiClient, error) {
+	apiKey := request.Header.Get(ApiKeyHeader)
+	if len(apiKey) > 0 {
+		return a.getClientByApiKey([]byte(apiKey))
+	}
+
+	authorization := request.Header.Get(authorizationHeader)
+	if !strings.HasPrefix(authorization, bearerPrefix) {
+		return nil, nil
+	}
+	if len(a.jwtSecret) == 0 {
+		return nil, ErrInvalidToken
+	}
+
+	claims, err := verifyJWT(strings.TrimPrefix(authorization, bearerPrefix), a.jwtSecret, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	client, found := a.clients[claims.Subject]
+	if !found {
+		return nil, ErrUnknownApiClient
+	}
+
+	return client, nil
+}
+
+func (a *authenticator) getClientByApiKey(apiKey []byte) (*apiClient, error) {
+	for _, client := range a.clients {
+		if len(client.apiKey) == 0 {
+			continue
+		}
+		if subtle.ConstantTimeCompare(client.apiKey, apiKey) == 1 {
+			return client, nil
+		}
+	}
+
+	return nil, ErrInvalidApiKey
+}
+
+func (a *authenticator) consumeQuota(client *apiClient) error {
+	if a.isQuotaReached(client) {
+		return fmt.Errorf("%w for API client %s", ErrTooManyRequests, client.name)
+	}
+
+	return nil
+}
+
+func (a *authenticator) isQuotaReached(client *apiClient) bool {
+	if client.maxRequestsPerInterval == 0 {
+		return false
+	}
+
+	a.mutRequests.Lock()
+	defer a.mutRequests.Unlock()
+
+	requests := a.numRequests[client.name]
+	if requests >= client.maxRequestsPerInterval {
+		return true
+	}
+	a.numRequests[client.name]++
+
+	return false
+}
+
+// Reset resets the requests counters of all API clients
+func (a *authenticator) Reset() {
+	a.mutRequests.Lock()
+	a.numRequests = make(map[string]uint32)
+	a.mutRequests.Unlock()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (a *authenticator) IsInterfaceNil() bool {
+	return a == nil
+}
+
+// CreateAuthRequiredHandler will create a middleware-type of handler which only lets through the requests of the
+// authenticated API clients allowed to call the provided route
+func CreateAuthRequiredHandler(route string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		err := CheckRouteAllowed(c, route)
+		if errors.Is(err, ErrAuthenticationRequired) {
+			abortWithStatus(c, http.StatusUnauthorized, err.Error(), shared.ReturnCodeRequestError)
+			return
+		}
+		if err != nil {
+			abortWithStatus(c, http.StatusForbidden, err.Error(), shared.ReturnCodeRequestError)
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// CheckRouteAllowed returns an error if the request was not made by an authenticated API client allowed to call
+// the provided route
+func CheckRouteAllowed(c *gin.Context, route string) error {
+	value, exists := c.Get(apiClientContextKey)
+	client, ok := value.(*apiClient)
+	if !exists || !ok {
+		return ErrAuthenticationRequired
+	}
+
+	if !client.isRouteAllowed(route) {
+		return fmt.Errorf("%w: %s", ErrRouteNotAllowed, route)
+	}
+
+	return nil
+}
+
+// ConsumeQuota charges one more request to the authenticated API client which made the request, on top of the one
+// charged when the request was received, and returns an error if the client's quota is reached. It is used by the
+// endpoints executing more than one call per request. Requests without credentials are not limited
+func ConsumeQuota(c *gin.Context) error {
+	value, exists := c.Get(apiClientContextKey)
+	client, ok := value.(*apiClient)
+	if !exists || !ok {
+		return nil
+	}
+
+	value, exists = c.Get(authenticatorContextKey)
+	a, ok := value.(*authenticator)
+	if !exists || !ok {
+		return nil
+	}
+
+	return a.consumeQuota(client)
+}
+
+func (client *apiClient) isRouteAllowed(route string) bool {
+	_, allowed := client.allowedRoutes[AllRoutesWildcard]
+	if allowed {
+		return true
+	}
+
+	_, allowed = client.allowedRoutes[route]
+	return allowed
+}
+
+func abortWithStatus(c *gin.Context, status int, message string, code shared.ReturnCode) {
+	c.AbortWithStatusJSON(
+		status,
+		shared.GenericAPIResponse{
+			Data:  nil,
+			Error: message,
+			Code:  code,
+		},
+	)
+}
@@ -0,0 +1,250 @@
+package middleware_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testJWTSecret = "jwt secret"
+
+func createMockAuthConfig() config.ApiAuthConfig {
+	return config.ApiAuthConfig{
+		Enabled:                 true,
+		JWTSecret:               testJWTSecret,
+		QuotaResetIntervalInSec: 1,
+		Clients: []config.ApiClientConfig{
+			{
+				Name:                   "operator",
+				APIKey:                 "operator key",
+				MaxRequestsPerInterval: 0,
+				AllowedRoutes:          []string{middleware.AllRoutesWildcard},
+			},
+			{
+				Name:                   "explorer",
+				APIKey:                 "explorer key",
+				MaxRequestsPerInterval: 2,
+				AllowedRoutes:          []string{"/node/open"},
+			},
+			{
+				Name:          "service",
+				AllowedRoutes: []string{"/node/restricted"},
+			},
+		},
+	}
+}
+
+func createSignedJWT(header string, claims string, secret string) string {
+	encodedHeader := base64.RawURLEncoding.EncodeToString([]byte(header))
+	encodedClaims := base64.RawURLEncoding.EncodeToString([]byte(claims))
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write([]byte(encodedHeader + "." + encodedClaims))
+
+	return encodedHeader + "." + encodedClaims + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func startNodeServerWithAuthenticator(t *testing.T, cfg config.ApiAuthConfig) (*gin.Engine, interface{ Reset() }) {
+	authenticator, err := middleware.NewAuthenticator(cfg)
+	require.Nil(t, err)
+
+	handler := func(c *gin.Context) {
+		c.JSON(http.StatusOK, "ok")
+	}
+
+	ws := gin.New()
+	ws.Use(authenticator.MiddlewareHandlerFunc())
+	ginNodeRoutes := ws.Group("/node")
+	ginNodeRoutes.Handle(http.MethodGet, "/status", handler)
+	ginNodeRoutes.Handle(http.MethodGet, "/open", middleware.CreateAuthRequiredHandler("/node/open"), handler)
+	ginNodeRoutes.Handle(http.MethodGet, "/restricted", middleware.CreateAuthRequiredHandler("/node/restricted"), handler)
+
+	return ws, authenticator
+}
+
+func doRequestWithHeader(ws *gin.Engine, path string, header string, value string) int {
+	req, _ := http.NewRequest(http.MethodGet, path, nil)
+	if len(header) > 0 {
+		req.Header.Set(header, value)
+	}
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	return resp.Code
+}
+
+func TestNewAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty client name should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockAuthConfig()
+		cfg.Clients[0].Name = ""
+		authenticator, err := middleware.NewAuthenticator(cfg)
+		assert.True(t, check.IfNil(authenticator))
+		assert.Equal(t, middleware.ErrEmptyApiClientName, err)
+	})
+	t.Run("client without credentials should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockAuthConfig()
+		cfg.JWTSecret = ""
+		authenticator, err := middleware.NewAuthenticator(cfg)
+		assert.True(t, check.IfNil(authenticator))
+		assert.True(t, errors.Is(err, middleware.ErrApiClientWithoutCredentials))
+	})
+	t.Run("duplicated client name should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockAuthConfig()
+		cfg.Clients[1].Name = cfg.Clients[0].Name
+		authenticator, err := middleware.NewAuthenticator(cfg)
+		assert.True(t, check.IfNil(authenticator))
+		assert.True(t, errors.Is(err, middleware.ErrDuplicatedApiClient))
+	})
+	t.Run("duplicated API key should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createMockAuthConfig()
+		cfg.Clients[1].APIKey = cfg.Clients[0].APIKey
+		authenticator, err := middleware.NewAuthenticator(cfg)
+		assert.True(t, check.IfNil(authenticator))
+		assert.True(t, errors.Is(err, middleware.ErrDuplicatedApiClient))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		authenticator, err := middleware.NewAuthenticator(createMockAuthConfig())
+		assert.False(t, check.IfNil(authenticator))
+		assert.Nil(t, err)
+	})
+}
+
+func TestAuthenticator_ApiKey(t *testing.T) {
+	t.Parallel()
+
+	ws, _ := startNodeServerWithAuthenticator(t, createMockAuthConfig())
+
+	assert.Equal(t, http.StatusOK, doRequestWithHeader(ws, "/node/status", "", ""))
+	assert.Equal(t, http.StatusUnauthorized, doRequestWithHeader(ws, "/node/open", "", ""))
+	assert.Equal(t, http.StatusUnauthorized, doRequestWithHeader(ws, "/node/status", middleware.ApiKeyHeader, "wrong key"))
+	assert.Equal(t, http.StatusOK, doRequestWithHeader(ws, "/node/open", middleware.ApiKeyHeader, "explorer key"))
+	assert.Equal(t, http.StatusForbidden, doRequestWithHeader(ws, "/node/restricted", middleware.ApiKeyHeader, "explorer key"))
+	assert.Equal(t, http.StatusOK, doRequestWithHeader(ws, "/node/restricted", middleware.ApiKeyHeader, "operator key"))
+}
+
+func TestAuthenticator_JWT(t *testing.T) {
+	t.Parallel()
+
+	ws, _ := startNodeServerWithAuthenticator(t, createMockAuthConfig())
+	header := `{"alg":"HS256","typ":"JWT"}`
+	expiresAt := time.Now().Add(time.Hour).Unix()
+
+	t.Run("valid token should work", func(t *testing.T) {
+		token := createSignedJWT(header, fmt.Sprintf(`{"sub":"service","exp":%d}`, expiresAt), testJWTSecret)
+		assert.Equal(t, http.StatusOK, doRequestWithHeader(ws, "/node/restricted", "Authorization", "Bearer "+token))
+		assert.Equal(t, http.StatusForbidden, doRequestWithHeader(ws, "/node/open", "Authorization", "Bearer "+token))
+	})
+	t.Run("wrong signature should error", func(t *testing.T) {
+		token := createSignedJWT(header, `{"sub":"service"}`, "other secret")
+		assert.Equal(t, http.StatusUnauthorized, doRequestWithHeader(ws, "/node/restricted", "Authorization", "Bearer "+token))
+	})
+	t.Run("other algorithm should error", func(t *testing.T) {
+		token := createSignedJWT(`{"alg":"none"}`, `{"sub":"service"}`, testJWTSecret)
+		assert.Equal(t, http.StatusUnauthorized, doRequestWithHeader(ws, "/node/restricted", "Authorization", "Bearer "+token))
+	})
+	t.Run("expired token should error", func(t *testing.T) {
+		token := createSignedJWT(header, fmt.Sprintf(`{"sub":"service","exp":%d}`, time.Now().Unix()-1), testJWTSecret)
+		assert.Equal(t, http.StatusUnauthorized, doRequestWithHeader(ws, "/node/restricted", "Authorization", "Bearer "+token))
+	})
+	t.Run("token without expiration should error", func(t *testing.T) {
+		token := createSignedJWT(header, `{"sub":"service"}`, testJWTSecret)
+		assert.Equal(t, http.StatusUnauthorized, doRequestWithHeader(ws, "/node/restricted", "Authorization", "Bearer "+token))
+	})
+	t.Run("unknown client should error", func(t *testing.T) {
+		token := createSignedJWT(header, fmt.Sprintf(`{"sub":"unknown","exp":%d}`, expiresAt), testJWTSecret)
+		assert.Equal(t, http.StatusUnauthorized, doRequestWithHeader(ws, "/node/restricted", "Authorization", "Bearer "+token))
+	})
+	t.Run("malformed token should error", func(t *testing.T) {
+		assert.Equal(t, http.StatusUnauthorized, doRequestWithHeader(ws, "/node/restricted", "Authorization", "Bearer a.b"))
+	})
+}
+
+func TestAuthenticator_QuotaShouldLimitAndReset(t *testing.T) {
+	t.Parallel()
+
+	ws, authenticator := startNodeServerWithAuthenticator(t, createMockAuthConfig())
+
+	assert.Equal(t, http.StatusOK, doRequestWithHeader(ws, "/node/status", middleware.ApiKeyHeader, "explorer key"))
+	assert.Equal(t, http.StatusOK, doRequestWithHeader(ws, "/node/open", middleware.ApiKeyHeader, "explorer key"))
+	assert.Equal(t, http.StatusTooManyRequests, doRequestWithHeader(ws, "/node/open", middleware.ApiKeyHeader, "explorer key"))
+
+	// the quota of a client does not affect the others
+	for i := 0; i < 5; i++ {
+		assert.Equal(t, http.StatusOK, doRequestWithHeader(ws, "/node/open", middleware.ApiKeyHeader, "operator key"))
+	}
+
+	authenticator.Reset()
+	assert.Equal(t, http.StatusOK, doRequestWithHeader(ws, "/node/open", middleware.ApiKeyHeader, "explorer key"))
+}
+
+func TestConsumeQuota(t *testing.T) {
+	t.Parallel()
+
+	authenticator, err := middleware.NewAuthenticator(createMockAuthConfig())
+	require.Nil(t, err)
+
+	numCalls := 0
+	var errConsume error
+	ws := gin.New()
+	ws.Use(authenticator.MiddlewareHandlerFunc())
+	ws.Handle(http.MethodGet, "/batch", func(c *gin.Context) {
+		for i := 0; i < numCalls; i++ {
+			errConsume = middleware.ConsumeQuota(c)
+		}
+		c.JSON(http.StatusOK, "ok")
+	})
+
+	numCalls = 2
+	assert.Equal(t, http.StatusOK, doRequestWithHeader(ws, "/batch", middleware.ApiKeyHeader, "explorer key"))
+	assert.True(t, errors.Is(errConsume, middleware.ErrTooManyRequests))
+
+	authenticator.Reset()
+	numCalls = 1
+	assert.Equal(t, http.StatusOK, doRequestWithHeader(ws, "/batch", middleware.ApiKeyHeader, "explorer key"))
+	assert.Nil(t, errConsume)
+	assert.Equal(t, http.StatusTooManyRequests, doRequestWithHeader(ws, "/batch", middleware.ApiKeyHeader, "explorer key"))
+
+	// the requests without credentials are not limited
+	numCalls = 5
+	assert.Equal(t, http.StatusOK, doRequestWithHeader(ws, "/batch", "", ""))
+	assert.Nil(t, errConsume)
+}
+
+func TestCreateAuthRequiredHandler_ErrorMessages(t *testing.T) {
+	t.Parallel()
+
+	ws, _ := startNodeServerWithAuthenticator(t, createMockAuthConfig())
+
+	req, _ := http.NewRequest(http.MethodGet, "/node/restricted", nil)
+	req.Header.Set(middleware.ApiKeyHeader, "explorer key")
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	assert.Equal(t, http.StatusForbidden, resp.Code)
+	assert.True(t, strings.Contains(resp.Body.String(), middleware.ErrRouteNotAllowed.Error()))
+}
@@ -18,40 +18,53 @@ type throttlerGetter interface {
 // REST API end points that need to be better protected
 func CreateEndpointThrottlerFromFacade(throttlerName string, facade interface{}) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		tg, ok := facade.(throttlerGetter)
-		if !ok {
+		endProcessing, err := StartEndpointProcessing(throttlerName, facade)
+		if err == errors.ErrInvalidAppContext {
 			c.AbortWithStatusJSON(
 				http.StatusInternalServerError,
 				shared.GenericAPIResponse{
 					Data:  nil,
-					Error: errors.ErrInvalidAppContext.Error(),
+					Error: err.Error(),
 					Code:  shared.ReturnCodeInternalError,
 				},
 			)
 			return
 		}
-
-		endpointThrottler, ok := tg.GetThrottlerForEndpoint(throttlerName)
-		if !ok {
-			c.Next()
-			return
-		}
-
-		if !endpointThrottler.CanProcess() {
+		if err != nil {
 			c.AbortWithStatusJSON(
 				http.StatusTooManyRequests,
 				shared.GenericAPIResponse{
 					Data:  nil,
-					Error: fmt.Sprintf("%s for endpoint %s", errors.ErrTooManyRequests.Error(), throttlerName),
+					Error: err.Error(),
 					Code:  shared.ReturnCodeSystemBusy,
 				},
 			)
 			return
 		}
-
-		endpointThrottler.StartProcessing()
-		defer endpointThrottler.EndProcessing()
+		defer endProcessing()
 
 		c.Next()
 	}
 }
+
+// StartEndpointProcessing reserves a processing slot on the throttler defined for the provided endpoint, if any.
+// The returned function must be called once the processing is done
+func StartEndpointProcessing(throttlerName string, facade interface{}) (func(), error) {
+	tg, ok := facade.(throttlerGetter)
+	if !ok {
+		return nil, errors.ErrInvalidAppContext
+	}
+
+	endpointThrottler, ok := tg.GetThrottlerForEndpoint(throttlerName)
+	if !ok {
+		return func() {}, nil
+	}
+
+	if !endpointThrottler.CanProcess() {
+		return nil, fmt.Errorf("%s for endpoint %s", errors.ErrTooManyRequests.Error(), throttlerName)
+	}
+
+	endpointThrottler.StartProcessing()
+
+	return endpointThrottler.EndProcessing, nil
+}
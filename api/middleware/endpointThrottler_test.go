@@ -2,11 +2,13 @@ package middleware_test
 
 import (
 	"net/http"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/api/errors"
 	"github.com/ElrondNetwork/elrond-go/api/middleware"
 	"github.com/ElrondNetwork/elrond-go/api/mock"
 	"github.com/gin-contrib/cors"
@@ -117,3 +119,60 @@ func TestCreateEndpointThrottler_ThrottlerStartShouldExecute(t *testing.T) {
 	assert.Equal(t, uint32(1), atomic.LoadUint32(&numEnd))
 	assert.Equal(t, 1, responses[http.StatusOK])
 }
+
+func TestStartEndpointProcessing(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid facade should error", func(t *testing.T) {
+		t.Parallel()
+
+		endProcessing, err := middleware.StartEndpointProcessing("test", "not a facade")
+		assert.Nil(t, endProcessing)
+		assert.Equal(t, errors.ErrInvalidAppContext, err)
+	})
+	t.Run("no throttler should return no-op function", func(t *testing.T) {
+		t.Parallel()
+
+		endProcessing, err := middleware.StartEndpointProcessing("test", &mock.FacadeStub{})
+		assert.Nil(t, err)
+		assert.NotNil(t, endProcessing)
+		endProcessing()
+	})
+	t.Run("throttler can not process should error", func(t *testing.T) {
+		t.Parallel()
+
+		throttler := &mock.ThrottlerStub{
+			CanProcessCalled: func() bool {
+				return false
+			},
+		}
+		facade := &mock.FacadeStub{
+			GetThrottlerForEndpointCalled: func(endpoint string) (core.Throttler, bool) {
+				return throttler, true
+			},
+		}
+
+		endProcessing, err := middleware.StartEndpointProcessing("test", facade)
+		assert.Nil(t, endProcessing)
+		assert.True(t, strings.Contains(err.Error(), errors.ErrTooManyRequests.Error()))
+		assert.False(t, throttler.StartWasCalled)
+	})
+	t.Run("should start and end processing", func(t *testing.T) {
+		t.Parallel()
+
+		throttler := &mock.ThrottlerStub{}
+		facade := &mock.FacadeStub{
+			GetThrottlerForEndpointCalled: func(endpoint string) (core.Throttler, bool) {
+				return throttler, true
+			},
+		}
+
+		endProcessing, err := middleware.StartEndpointProcessing("test", facade)
+		assert.Nil(t, err)
+		assert.True(t, throttler.StartWasCalled)
+		assert.False(t, throttler.EndWasCalled)
+
+		endProcessing()
+		assert.True(t, throttler.EndWasCalled)
+	})
+}
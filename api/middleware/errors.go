@@ -7,3 +7,33 @@ var ErrInvalidMaxNumRequests = errors.New("max number of requests value is inval
 
 // ErrTooManyRequests signals that too many requests were simultaneously received
 var ErrTooManyRequests = errors.New("too many requests")
+
+// ErrEmptyApiClientName signals that an API client without a name was provided
+var ErrEmptyApiClientName = errors.New("empty API client name")
+
+// ErrDuplicatedApiClient signals that the same API client name or API key was provided more than once
+var ErrDuplicatedApiClient = errors.New("duplicated API client")
+
+// ErrApiClientWithoutCredentials signals that an API client has neither an API key nor a JWT secret to authenticate with
+var ErrApiClientWithoutCredentials = errors.New("API client without credentials")
+
+// ErrInvalidApiKey signals that the provided API key is not known
+var ErrInvalidApiKey = errors.New("invalid API key")
+
+// ErrInvalidToken signals that the provided JWT is malformed or its signature is not valid
+var ErrInvalidToken = errors.New("invalid token")
+
+// ErrTokenExpired signals that the provided JWT expired or is not yet valid
+var ErrTokenExpired = errors.New("token expired or not yet valid")
+
+// ErrTokenWithoutExpiration signals that the provided JWT has no expiration time
+var ErrTokenWithoutExpiration = errors.New("token without expiration time")
+
+// ErrUnknownApiClient signals that the provided JWT was issued for an unknown API client
+var ErrUnknownApiClient = errors.New("unknown API client")
+
+// ErrAuthenticationRequired signals that a route requiring authentication was called without credentials
+var ErrAuthenticationRequired = errors.New("authentication required")
+
+// ErrRouteNotAllowed signals that the authenticated API client is not allowed to call the route
+var ErrRouteNotAllowed = errors.New("route not allowed for API client")
@@ -0,0 +1,88 @@
+package middleware
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+const jwtAlgorithmHS256 = "HS256"
+
+type jwtHeader struct {
+	Algorithm string `json:"alg"`
+}
+
+type jwtClaims struct {
+	Subject   string `json:"sub"`
+	ExpiresAt int64  `json:"exp"`
+	NotBefore int64  `json:"nbf"`
+}
+
+// verifyJWT checks the HMAC-SHA256 signature and the time claims of the provided token and returns its claims. The
+// tokens without an expiration time are rejected, so a leaked token can not be used forever
+func verifyJWT(token string, secret []byte, now time.Time) (*jwtClaims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, ErrInvalidToken
+	}
+
+	header := &jwtHeader{}
+	err := decodeJWTPart(parts[0], header)
+	if err != nil {
+		return nil, err
+	}
+	if header.Algorithm != jwtAlgorithmHS256 {
+		return nil, ErrInvalidToken
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+	if !hmac.Equal(signature, computeJWTSignature(parts[0]+"."+parts[1], secret)) {
+		return nil, ErrInvalidToken
+	}
+
+	claims := &jwtClaims{}
+	err = decodeJWTPart(parts[1], claims)
+	if err != nil {
+		return nil, err
+	}
+
+	unixNow := now.Unix()
+	if claims.ExpiresAt == 0 {
+		return nil, ErrTokenWithoutExpiration
+	}
+	if unixNow >= claims.ExpiresAt {
+		return nil, ErrTokenExpired
+	}
+	if claims.NotBefore != 0 && unixNow < claims.NotBefore {
+		return nil, ErrTokenExpired
+	}
+
+	return claims, nil
+}
+
+func decodeJWTPart(part string, destination interface{}) error {
+	buff, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return ErrInvalidToken
+	}
+
+	err = json.Unmarshal(buff, destination)
+	if err != nil {
+		return ErrInvalidToken
+	}
+
+	return nil
+}
+
+func computeJWTSignature(signingInput string, secret []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write([]byte(signingInput))
+
+	return mac.Sum(nil)
+}
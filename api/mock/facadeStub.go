@@ -3,6 +3,7 @@ package mock
 import (
 	"encoding/hex"
 	"math/big"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
@@ -13,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/outport"
 	"github.com/ElrondNetwork/elrond-go/process"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/state"
@@ -25,58 +27,81 @@ type FacadeStub struct {
 	GetHeartbeatsHandler       func() ([]data.PubKeyHeartbeat, error)
 	GetBalanceCalled           func(address string, options api.AccountQueryOptions) (*big.Int, api.BlockInfo, error)
 	GetAccountCalled           func(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error)
+	GetAccountsCalled          func(addresses []string, options api.AccountQueryOptions) (map[string]*api.AccountResponse, api.BlockInfo, error)
 	GenerateTransactionHandler func(sender string, receiver string, value *big.Int, code string) (*transaction.Transaction, error)
 	GetTransactionHandler      func(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	CreateTransactionHandler   func(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
 		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*transaction.Transaction, []byte, error)
-	ValidateTransactionHandler                  func(tx *transaction.Transaction) error
-	ValidateTransactionForSimulationHandler     func(tx *transaction.Transaction, bypassSignature bool) error
-	SendBulkTransactionsHandler                 func(txs []*transaction.Transaction) (uint64, error)
-	ExecuteSCQueryHandler                       func(query *process.SCQuery) (*vm.VMOutputApi, error)
-	StatusMetricsHandler                        func() external.StatusMetricsHandler
-	ValidatorStatisticsHandler                  func() (map[string]*state.ValidatorApiResponse, error)
-	ComputeTransactionGasLimitHandler           func(tx *transaction.Transaction) (*transaction.CostResponse, error)
-	NodeConfigCalled                            func() map[string]interface{}
-	GetQueryHandlerCalled                       func(name string) (debug.QueryHandler, error)
-	GetValueForKeyCalled                        func(address string, key string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
-	GetPeerInfoCalled                           func(pid string) ([]core.QueryP2PPeerInfo, error)
-	GetEpochStartDataAPICalled                  func(epoch uint32) (*common.EpochStartDataAPI, error)
-	GetThrottlerForEndpointCalled               func(endpoint string) (core.Throttler, bool)
-	GetUsernameCalled                           func(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
-	GetKeyValuePairsCalled                      func(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error)
-	SimulateTransactionExecutionHandler         func(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
-	GetESDTDataCalled                           func(address string, key string, nonce uint64, options api.AccountQueryOptions) (*esdt.ESDigitalToken, api.BlockInfo, error)
-	GetAllESDTTokensCalled                      func(address string, options api.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, api.BlockInfo, error)
-	GetESDTsWithRoleCalled                      func(address string, role string, options api.AccountQueryOptions) ([]string, api.BlockInfo, error)
-	GetESDTsRolesCalled                         func(address string, options api.AccountQueryOptions) (map[string][]string, api.BlockInfo, error)
-	GetNFTTokenIDsRegisteredByAddressCalled     func(address string, options api.AccountQueryOptions) ([]string, api.BlockInfo, error)
-	GetBlockByHashCalled                        func(hash string, options api.BlockQueryOptions) (*api.Block, error)
-	GetBlockByNonceCalled                       func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
-	GetBlockByRoundCalled                       func(round uint64, options api.BlockQueryOptions) (*api.Block, error)
-	GetInternalShardBlockByNonceCalled          func(format common.ApiOutputFormat, nonce uint64) (interface{}, error)
-	GetInternalShardBlockByHashCalled           func(format common.ApiOutputFormat, hash string) (interface{}, error)
-	GetInternalShardBlockByRoundCalled          func(format common.ApiOutputFormat, round uint64) (interface{}, error)
-	GetInternalMetaBlockByNonceCalled           func(format common.ApiOutputFormat, nonce uint64) (interface{}, error)
-	GetInternalMetaBlockByHashCalled            func(format common.ApiOutputFormat, hash string) (interface{}, error)
-	GetInternalMetaBlockByRoundCalled           func(format common.ApiOutputFormat, round uint64) (interface{}, error)
-	GetInternalStartOfEpochMetaBlockCalled      func(format common.ApiOutputFormat, epoch uint32) (interface{}, error)
-	GetInternalMiniBlockByHashCalled            func(format common.ApiOutputFormat, txHash string, epoch uint32) (interface{}, error)
-	GetTotalStakedValueHandler                  func() (*api.StakeValues, error)
-	GetAllIssuedESDTsCalled                     func(tokenType string) ([]string, error)
-	GetDirectStakedListHandler                  func() ([]*api.DirectStakedValue, error)
-	GetDelegatorsListHandler                    func() ([]*api.Delegator, error)
-	GetProofCalled                              func(string, string) (*common.GetProofResponse, error)
-	GetProofCurrentRootHashCalled               func(string) (*common.GetProofResponse, error)
-	GetProofDataTrieCalled                      func(string, string, string) (*common.GetProofResponse, *common.GetProofResponse, error)
-	VerifyProofCalled                           func(string, string, [][]byte) (bool, error)
-	GetTokenSupplyCalled                        func(token string) (*api.ESDTSupply, error)
-	GetGenesisNodesPubKeysCalled                func() (map[uint32][]string, map[uint32][]string, error)
-	GetGenesisBalancesCalled                    func() ([]*common.InitialAccountAPI, error)
-	GetTransactionsPoolCalled                   func(fields string) (*common.TransactionsPoolAPIResponse, error)
-	GetTransactionsPoolForSenderCalled          func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
-	GetLastPoolNonceForSenderCalled             func(sender string) (uint64, error)
-	GetTransactionsPoolNonceGapsForSenderCalled func(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
-	GetGasConfigsCalled                         func() (map[string]map[string]uint64, error)
+	ValidateTransactionHandler                     func(tx *transaction.Transaction) error
+	ValidateTransactionForSimulationHandler        func(tx *transaction.Transaction, bypassSignature bool) error
+	ValidateTransactionForSchedulingHandler        func(tx *transaction.Transaction) error
+	SendBulkTransactionsHandler                    func(txs []*transaction.Transaction) (uint64, error)
+	ExecuteSCQueryHandler                          func(query *process.SCQuery) (*vm.VMOutputApi, error)
+	StatusMetricsHandler                           func() external.StatusMetricsHandler
+	ValidatorStatisticsHandler                     func() (map[string]*state.ValidatorApiResponse, error)
+	ComputeTransactionGasLimitHandler              func(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	EstimateTransactionGasHandler                  func(tx *transaction.Transaction) (*txSimData.GasEstimation, error)
+	EstimateSmartContractResultGasHandler          func(scr *transaction.ApiSmartContractResult) (*txSimData.GasEstimation, error)
+	ScheduleTransactionCalled                      func(tx *transaction.Transaction, condition string, conditionValue uint64) (string, error)
+	GetScheduledTransactionsCalled                 func() ([]common.ScheduledTransactionApiResponse, error)
+	CancelScheduledTransactionCalled               func(txHash string) error
+	NodeConfigCalled                               func() map[string]interface{}
+	GetQueryHandlerCalled                          func(name string) (debug.QueryHandler, error)
+	GetValueForKeyCalled                           func(address string, key string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
+	GetPeerInfoCalled                              func(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetEpochStartDataAPICalled                     func(epoch uint32) (*common.EpochStartDataAPI, error)
+	GetThrottlerForEndpointCalled                  func(endpoint string) (core.Throttler, bool)
+	GetUsernameCalled                              func(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
+	GetKeyValuePairsCalled                         func(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error)
+	GetKeyValuePairsPageCalled                     func(address string, options api.AccountQueryOptions, pageOptions common.KeyValuePairsQueryOptions) (*common.KeyValuePairsApiResponse, api.BlockInfo, error)
+	SimulateTransactionExecutionHandler            func(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error)
+	SimulateTransactionWithOverridesHandler        func(tx *transaction.Transaction, overrides map[string]*state.AccountOverride, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error)
+	GetTransactionTraceHandler                     func(hash string) (*txSimData.SimulationResults, error)
+	GetTransactionStateDiffHandler                 func(hash string) ([]*txSimData.AccountStateDiff, error)
+	WaitForTransactionStatusHandler                func(hash string, status string, timeout time.Duration) (*common.TransactionStatusWaitApiResponse, error)
+	SimulateTransactionsBatchExecutionHandler      func(txs []*transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.BatchSimulationResults, error)
+	GetESDTDataCalled                              func(address string, key string, nonce uint64, options api.AccountQueryOptions) (*esdt.ESDigitalToken, api.BlockInfo, error)
+	GetAllESDTTokensCalled                         func(address string, options api.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, api.BlockInfo, error)
+	GetESDTsWithRoleCalled                         func(address string, role string, options api.AccountQueryOptions) ([]string, api.BlockInfo, error)
+	GetESDTsRolesCalled                            func(address string, options api.AccountQueryOptions) (map[string][]string, api.BlockInfo, error)
+	GetNFTTokenIDsRegisteredByAddressCalled        func(address string, options api.AccountQueryOptions) ([]string, api.BlockInfo, error)
+	GetBlockByHashCalled                           func(hash string, options api.BlockQueryOptions) (*api.Block, error)
+	GetBlockByNonceCalled                          func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
+	GetBlockByRoundCalled                          func(round uint64, options api.BlockQueryOptions) (*api.Block, error)
+	GetHyperblockByNonceCalled                     func(nonce uint64, options api.BlockQueryOptions) (*common.ApiHyperblock, error)
+	GetInternalShardBlockByNonceCalled             func(format common.ApiOutputFormat, nonce uint64) (interface{}, error)
+	GetInternalShardBlockByHashCalled              func(format common.ApiOutputFormat, hash string) (interface{}, error)
+	GetInternalShardBlockByRoundCalled             func(format common.ApiOutputFormat, round uint64) (interface{}, error)
+	GetInternalMetaBlockByNonceCalled              func(format common.ApiOutputFormat, nonce uint64) (interface{}, error)
+	GetInternalMetaBlockByHashCalled               func(format common.ApiOutputFormat, hash string) (interface{}, error)
+	GetInternalMetaBlockByRoundCalled              func(format common.ApiOutputFormat, round uint64) (interface{}, error)
+	GetInternalStartOfEpochMetaBlockCalled         func(format common.ApiOutputFormat, epoch uint32) (interface{}, error)
+	GetInternalMiniBlockByHashCalled               func(format common.ApiOutputFormat, txHash string, epoch uint32) (interface{}, error)
+	GetTotalStakedValueHandler                     func() (*api.StakeValues, error)
+	GetAllIssuedESDTsCalled                        func(tokenType string) ([]string, error)
+	GetDirectStakedListHandler                     func() ([]*api.DirectStakedValue, error)
+	GetDelegatorsListHandler                       func() ([]*api.Delegator, error)
+	GetProofCalled                                 func(string, string) (*common.GetProofResponse, error)
+	GetProofCurrentRootHashCalled                  func(string) (*common.GetProofResponse, error)
+	GetProofDataTrieCalled                         func(string, string, string) (*common.GetProofResponse, *common.GetProofResponse, error)
+	VerifyProofCalled                              func(string, string, [][]byte) (bool, error)
+	GetTokenSupplyCalled                           func(token string) (*api.ESDTSupply, error)
+	GetTokenSupplyHistoryCalled                    func(token string, fromEpoch uint32, toEpoch uint32) (*common.ESDTSupplyHistoryApiResponse, error)
+	GetESDTHoldersCalled                           func(options common.ESDTHoldersQueryOptions) (*common.ESDTHoldersApiResponse, error)
+	GetGenesisNodesPubKeysCalled                   func() (map[uint32][]string, map[uint32][]string, error)
+	GetGenesisBalancesCalled                       func() ([]*common.InitialAccountAPI, error)
+	GetTransactionsPoolCalled                      func(options common.TransactionsPoolQueryOptions) (*common.TransactionsPoolAPIResponse, error)
+	GetTransactionsPoolForSenderCalled             func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
+	GetLastPoolNonceForSenderCalled                func(sender string) (uint64, error)
+	GetTransactionsPoolNonceGapsForSenderCalled    func(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionsPoolReplacementsForSenderCalled func(sender string) (*common.TransactionsPoolReplacementsForSenderApiResponse, error)
+	GetTransactionsPoolStatsForSenderCalled        func(sender string) (*common.TransactionsPoolSenderStatsApiResponse, error)
+	GetTransactionsPoolEvictionsCalled             func() (*common.TransactionsPoolEvictionsApiResponse, error)
+	GetTransactionsByAddressCalled                 func(address string, options common.AddressTransactionsQueryOptions) (*common.AddressTransactionsApiResponse, error)
+	GetGasConfigsCalled                            func() (map[string]map[string]uint64, error)
+	GetLogEventsCalled                             func(options common.LogEventsQueryOptions) (*common.LogEventsApiResponse, error)
+	IsEventsSubscriptionEnabledCalled              func() bool
+	ServeEventsSubscriberCalled                    func(conn outport.WSConnection) error
 }
 
 // GetTokenSupply -
@@ -88,6 +113,24 @@ func (f *FacadeStub) GetTokenSupply(token string) (*api.ESDTSupply, error) {
 	return nil, nil
 }
 
+// GetTokenSupplyHistory -
+func (f *FacadeStub) GetTokenSupplyHistory(token string, fromEpoch uint32, toEpoch uint32) (*common.ESDTSupplyHistoryApiResponse, error) {
+	if f.GetTokenSupplyHistoryCalled != nil {
+		return f.GetTokenSupplyHistoryCalled(token, fromEpoch, toEpoch)
+	}
+
+	return nil, nil
+}
+
+// GetESDTHolders -
+func (f *FacadeStub) GetESDTHolders(options common.ESDTHoldersQueryOptions) (*common.ESDTHoldersApiResponse, error) {
+	if f.GetESDTHoldersCalled != nil {
+		return f.GetESDTHoldersCalled(options)
+	}
+
+	return nil, nil
+}
+
 // GetProof -
 func (f *FacadeStub) GetProof(rootHash string, address string) (*common.GetProofResponse, error) {
 	if f.GetProofCalled != nil {
@@ -185,6 +228,15 @@ func (f *FacadeStub) GetKeyValuePairs(address string, options api.AccountQueryOp
 	return nil, api.BlockInfo{}, nil
 }
 
+// GetKeyValuePairsPage -
+func (f *FacadeStub) GetKeyValuePairsPage(address string, options api.AccountQueryOptions, pageOptions common.KeyValuePairsQueryOptions) (*common.KeyValuePairsApiResponse, api.BlockInfo, error) {
+	if f.GetKeyValuePairsPageCalled != nil {
+		return f.GetKeyValuePairsPageCalled(address, options, pageOptions)
+	}
+
+	return &common.KeyValuePairsApiResponse{}, api.BlockInfo{}, nil
+}
+
 // GetESDTData -
 func (f *FacadeStub) GetESDTData(address string, key string, nonce uint64, options api.AccountQueryOptions) (*esdt.ESDigitalToken, api.BlockInfo, error) {
 	if f.GetESDTDataCalled != nil {
@@ -244,6 +296,15 @@ func (f *FacadeStub) GetAccount(address string, options api.AccountQueryOptions)
 	return f.GetAccountCalled(address, options)
 }
 
+// GetAccounts -
+func (f *FacadeStub) GetAccounts(addresses []string, options api.AccountQueryOptions) (map[string]*api.AccountResponse, api.BlockInfo, error) {
+	if f.GetAccountsCalled != nil {
+		return f.GetAccountsCalled(addresses, options)
+	}
+
+	return make(map[string]*api.AccountResponse), api.BlockInfo{}, nil
+}
+
 // CreateTransaction is  mock implementation of a handler's CreateTransaction method
 func (f *FacadeStub) CreateTransaction(
 	nonce uint64,
@@ -269,8 +330,53 @@ func (f *FacadeStub) GetTransaction(hash string, withResults bool) (*transaction
 }
 
 // SimulateTransactionExecution is the mock implementation of a handler's SimulateTransactionExecution method
-func (f *FacadeStub) SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
-	return f.SimulateTransactionExecutionHandler(tx)
+func (f *FacadeStub) SimulateTransactionExecution(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+	return f.SimulateTransactionExecutionHandler(tx, options)
+}
+
+// SimulateTransactionExecutionWithStateOverrides is the mock implementation of a handler's SimulateTransactionExecutionWithStateOverrides method
+func (f *FacadeStub) SimulateTransactionExecutionWithStateOverrides(tx *transaction.Transaction, overrides map[string]*state.AccountOverride, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+	if f.SimulateTransactionWithOverridesHandler != nil {
+		return f.SimulateTransactionWithOverridesHandler(tx, overrides, options)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionTrace is the mock implementation of a handler's GetTransactionTrace method
+func (f *FacadeStub) GetTransactionTrace(hash string) (*txSimData.SimulationResults, error) {
+	if f.GetTransactionTraceHandler != nil {
+		return f.GetTransactionTraceHandler(hash)
+	}
+
+	return nil, nil
+}
+
+// WaitForTransactionStatus is the mock implementation of a handler's WaitForTransactionStatus method
+func (f *FacadeStub) WaitForTransactionStatus(hash string, status string, timeout time.Duration) (*common.TransactionStatusWaitApiResponse, error) {
+	if f.WaitForTransactionStatusHandler != nil {
+		return f.WaitForTransactionStatusHandler(hash, status, timeout)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionStateDiff is the mock implementation of a handler's GetTransactionStateDiff method
+func (f *FacadeStub) GetTransactionStateDiff(hash string) ([]*txSimData.AccountStateDiff, error) {
+	if f.GetTransactionStateDiffHandler != nil {
+		return f.GetTransactionStateDiffHandler(hash)
+	}
+
+	return nil, nil
+}
+
+// SimulateTransactionsBatchExecution is the mock implementation of a handler's SimulateTransactionsBatchExecution method
+func (f *FacadeStub) SimulateTransactionsBatchExecution(txs []*transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.BatchSimulationResults, error) {
+	if f.SimulateTransactionsBatchExecutionHandler != nil {
+		return f.SimulateTransactionsBatchExecutionHandler(txs, options)
+	}
+
+	return nil, nil
 }
 
 // SendBulkTransactions is the mock implementation of a handler's SendBulkTransactions method
@@ -278,6 +384,33 @@ func (f *FacadeStub) SendBulkTransactions(txs []*transaction.Transaction) (uint6
 	return f.SendBulkTransactionsHandler(txs)
 }
 
+// ScheduleTransaction -
+func (f *FacadeStub) ScheduleTransaction(tx *transaction.Transaction, condition string, conditionValue uint64) (string, error) {
+	if f.ScheduleTransactionCalled != nil {
+		return f.ScheduleTransactionCalled(tx, condition, conditionValue)
+	}
+
+	return "", nil
+}
+
+// GetScheduledTransactions -
+func (f *FacadeStub) GetScheduledTransactions() ([]common.ScheduledTransactionApiResponse, error) {
+	if f.GetScheduledTransactionsCalled != nil {
+		return f.GetScheduledTransactionsCalled()
+	}
+
+	return make([]common.ScheduledTransactionApiResponse, 0), nil
+}
+
+// CancelScheduledTransaction -
+func (f *FacadeStub) CancelScheduledTransaction(txHash string) error {
+	if f.CancelScheduledTransactionCalled != nil {
+		return f.CancelScheduledTransactionCalled(txHash)
+	}
+
+	return nil
+}
+
 // ValidateTransaction -
 func (f *FacadeStub) ValidateTransaction(tx *transaction.Transaction) error {
 	return f.ValidateTransactionHandler(tx)
@@ -288,6 +421,15 @@ func (f *FacadeStub) ValidateTransactionForSimulation(tx *transaction.Transactio
 	return f.ValidateTransactionForSimulationHandler(tx, bypassSignature)
 }
 
+// ValidateTransactionForScheduling -
+func (f *FacadeStub) ValidateTransactionForScheduling(tx *transaction.Transaction) error {
+	if f.ValidateTransactionForSchedulingHandler != nil {
+		return f.ValidateTransactionForSchedulingHandler(tx)
+	}
+
+	return nil
+}
+
 // ValidatorStatisticsApi is the mock implementation of a handler's ValidatorStatisticsApi method
 func (f *FacadeStub) ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error) {
 	return f.ValidatorStatisticsHandler()
@@ -323,6 +465,24 @@ func (f *FacadeStub) ComputeTransactionGasLimit(tx *transaction.Transaction) (*t
 	return f.ComputeTransactionGasLimitHandler(tx)
 }
 
+// EstimateTransactionGas -
+func (f *FacadeStub) EstimateTransactionGas(tx *transaction.Transaction) (*txSimData.GasEstimation, error) {
+	if f.EstimateTransactionGasHandler != nil {
+		return f.EstimateTransactionGasHandler(tx)
+	}
+
+	return nil, nil
+}
+
+// EstimateSmartContractResultGas -
+func (f *FacadeStub) EstimateSmartContractResultGas(scr *transaction.ApiSmartContractResult) (*txSimData.GasEstimation, error) {
+	if f.EstimateSmartContractResultGasHandler != nil {
+		return f.EstimateSmartContractResultGasHandler(scr)
+	}
+
+	return nil, nil
+}
+
 // NodeConfig -
 func (f *FacadeStub) NodeConfig() map[string]interface{} {
 	return f.NodeConfigCalled()
@@ -371,6 +531,14 @@ func (f *FacadeStub) GetBlockByRound(round uint64, options api.BlockQueryOptions
 	return nil, nil
 }
 
+// GetHyperblockByNonce -
+func (f *FacadeStub) GetHyperblockByNonce(nonce uint64, options api.BlockQueryOptions) (*common.ApiHyperblock, error) {
+	if f.GetHyperblockByNonceCalled != nil {
+		return f.GetHyperblockByNonceCalled(nonce, options)
+	}
+	return nil, nil
+}
+
 // GetInternalMetaBlockByNonce -
 func (f *FacadeStub) GetInternalMetaBlockByNonce(format common.ApiOutputFormat, nonce uint64) (interface{}, error) {
 	if f.GetInternalMetaBlockByNonceCalled != nil {
@@ -453,9 +621,9 @@ func (f *FacadeStub) GetGenesisBalances() ([]*common.InitialAccountAPI, error) {
 }
 
 // GetTransactionsPool -
-func (f *FacadeStub) GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error) {
+func (f *FacadeStub) GetTransactionsPool(options common.TransactionsPoolQueryOptions) (*common.TransactionsPoolAPIResponse, error) {
 	if f.GetTransactionsPoolCalled != nil {
-		return f.GetTransactionsPoolCalled(fields)
+		return f.GetTransactionsPoolCalled(options)
 	}
 
 	return nil, nil
@@ -488,6 +656,69 @@ func (f *FacadeStub) GetTransactionsPoolNonceGapsForSender(sender string) (*comm
 	return nil, nil
 }
 
+// GetTransactionsPoolReplacementsForSender -
+func (f *FacadeStub) GetTransactionsPoolReplacementsForSender(sender string) (*common.TransactionsPoolReplacementsForSenderApiResponse, error) {
+	if f.GetTransactionsPoolReplacementsForSenderCalled != nil {
+		return f.GetTransactionsPoolReplacementsForSenderCalled(sender)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolStatsForSender -
+func (f *FacadeStub) GetTransactionsPoolStatsForSender(sender string) (*common.TransactionsPoolSenderStatsApiResponse, error) {
+	if f.GetTransactionsPoolStatsForSenderCalled != nil {
+		return f.GetTransactionsPoolStatsForSenderCalled(sender)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolEvictions -
+func (f *FacadeStub) GetTransactionsPoolEvictions() (*common.TransactionsPoolEvictionsApiResponse, error) {
+	if f.GetTransactionsPoolEvictionsCalled != nil {
+		return f.GetTransactionsPoolEvictionsCalled()
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsByAddress -
+func (f *FacadeStub) GetTransactionsByAddress(address string, options common.AddressTransactionsQueryOptions) (*common.AddressTransactionsApiResponse, error) {
+	if f.GetTransactionsByAddressCalled != nil {
+		return f.GetTransactionsByAddressCalled(address, options)
+	}
+
+	return nil, nil
+}
+
+// GetLogEvents -
+func (f *FacadeStub) GetLogEvents(options common.LogEventsQueryOptions) (*common.LogEventsApiResponse, error) {
+	if f.GetLogEventsCalled != nil {
+		return f.GetLogEventsCalled(options)
+	}
+
+	return nil, nil
+}
+
+// IsEventsSubscriptionEnabled -
+func (f *FacadeStub) IsEventsSubscriptionEnabled() bool {
+	if f.IsEventsSubscriptionEnabledCalled != nil {
+		return f.IsEventsSubscriptionEnabledCalled()
+	}
+
+	return false
+}
+
+// ServeEventsSubscriber -
+func (f *FacadeStub) ServeEventsSubscriber(conn outport.WSConnection) error {
+	if f.ServeEventsSubscriberCalled != nil {
+		return f.ServeEventsSubscriberCalled(conn)
+	}
+
+	return nil
+}
+
 // GetGasConfigs -
 func (f *FacadeStub) GetGasConfigs() (map[string]map[string]uint64, error) {
 	if f.GetGasConfigsCalled != nil {
@@ -0,0 +1,227 @@
+package openapi
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/config"
+)
+
+const (
+	contentTypeJSON        = "application/json"
+	apiKeySecurityScheme   = "apiKey"
+	bearerSecurityScheme   = "bearerAuth"
+	successfulResponseCode = "200"
+	switchingProtocolsCode = "101"
+	defaultResponseCode    = "default"
+)
+
+// GroupEndpoints holds the endpoints registered by an API group
+type GroupEndpoints struct {
+	Name      string
+	Endpoints []*shared.EndpointHandlerData
+}
+
+// NewDocument generates the OpenAPI document describing the endpoints of the provided groups which are opened in the
+// routes configuration
+func NewDocument(info Info, groups []GroupEndpoints, apiConfig config.ApiRoutesConfig) *Document {
+	generator := newSchemaGenerator()
+	document := &Document{
+		OpenAPI: Version,
+		Info:    info,
+		Paths:   make(map[string]*PathItem),
+	}
+
+	errorResponse := &Response{
+		Description: "error",
+		Content:     jsonContent(generator.schemaForType(reflect.TypeOf(shared.GenericAPIResponse{}))),
+	}
+
+	sortedGroups := make([]GroupEndpoints, len(groups))
+	copy(sortedGroups, groups)
+	sort.Slice(sortedGroups, func(i, j int) bool {
+		return sortedGroups[i].Name < sortedGroups[j].Name
+	})
+
+	hasSecuredRoutes := false
+	for _, group := range sortedGroups {
+		for _, endpoint := range group.Endpoints {
+			route, found := getRouteConfig(apiConfig, group.Name, endpoint.Path)
+			if !found || !route.Open {
+				continue
+			}
+
+			operation := createOperation(generator, group.Name, endpoint)
+			operation.Responses[defaultResponseCode] = errorResponse
+			if route.Auth && apiConfig.Auth.Enabled {
+				operation.Security = []map[string][]string{
+					{apiKeySecurityScheme: {}},
+					{bearerSecurityScheme: {}},
+				}
+				hasSecuredRoutes = true
+			}
+
+			openAPIPath := toOpenAPIPath(group.Name, endpoint.Path)
+			pathItem, exists := document.Paths[openAPIPath]
+			if !exists {
+				pathItem = &PathItem{}
+				document.Paths[openAPIPath] = pathItem
+			}
+			(*pathItem)[strings.ToLower(endpoint.Method)] = operation
+		}
+	}
+
+	document.Components.Schemas = generator.components
+	if hasSecuredRoutes {
+		document.Components.SecuritySchemes = map[string]*SecurityScheme{
+			apiKeySecurityScheme: {Type: "apiKey", Name: middleware.ApiKeyHeader, In: "header"},
+			bearerSecurityScheme: {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+		}
+	}
+
+	return document
+}
+
+func createOperation(generator *schemaGenerator, groupName string, endpoint *shared.EndpointHandlerData) *Operation {
+	operation := &Operation{
+		Tags:        []string{groupName},
+		OperationID: createOperationID(endpoint.Method, groupName, endpoint.Path),
+		Parameters:  createPathParameters(endpoint.Path),
+		Responses:   make(map[string]*Response),
+	}
+
+	var responseData *Schema
+	responseContentType := ""
+	documentation := endpoint.Documentation
+	if documentation != nil && documentation.Websocket {
+		operation.Summary = documentation.Summary
+		operation.Responses[switchingProtocolsCode] = &Response{
+			Description: "switching to the websocket protocol",
+		}
+
+		return operation
+	}
+	if documentation != nil {
+		operation.Summary = documentation.Summary
+		for _, parameter := range documentation.QueryParameters {
+			operation.Parameters = append(operation.Parameters, &Parameter{
+				Name:   parameter.Name,
+				In:     "query",
+				Schema: createQueryParameterSchema(parameter.Type),
+			})
+		}
+		if documentation.Request != nil {
+			operation.RequestBody = &RequestBody{
+				Required: true,
+				Content:  jsonContent(generator.schemaForValue(documentation.Request)),
+			}
+		}
+		if documentation.Response != nil {
+			responseData = generator.schemaForValue(documentation.Response)
+		}
+		responseContentType = documentation.ResponseContentType
+	}
+	if responseData == nil {
+		responseData = &Schema{}
+	}
+
+	if len(responseContentType) > 0 {
+		operation.Responses[successfulResponseCode] = &Response{
+			Description: "successful",
+			Content: map[string]*MediaType{
+				responseContentType: {Schema: responseData},
+			},
+		}
+
+		return operation
+	}
+
+	operation.Responses[successfulResponseCode] = &Response{
+		Description: "successful",
+		Content: jsonContent(&Schema{
+			Type: "object",
+			Properties: map[string]*Schema{
+				"data":  responseData,
+				"error": {Type: "string"},
+				"code":  {Type: "string"},
+			},
+		}),
+	}
+
+	return operation
+}
+
+func createQueryParameterSchema(parameterType shared.QueryParameterType) *Schema {
+	switch parameterType {
+	case shared.IntegerQueryParameter:
+		return &Schema{Type: "integer", Format: "int64"}
+	case shared.BooleanQueryParameter:
+		return &Schema{Type: "boolean"}
+	default:
+		return &Schema{Type: "string"}
+	}
+}
+
+func createPathParameters(endpointPath string) []*Parameter {
+	parameters := make([]*Parameter, 0)
+	for _, segment := range strings.Split(endpointPath, "/") {
+		if !isPathParameter(segment) {
+			continue
+		}
+
+		parameters = append(parameters, &Parameter{
+			Name:     segment[1:],
+			In:       "path",
+			Required: true,
+			Schema:   &Schema{Type: "string"},
+		})
+	}
+
+	return parameters
+}
+
+// toOpenAPIPath converts a gin path, such as /address/:address, to the OpenAPI format, such as /address/{address}
+func toOpenAPIPath(groupName string, endpointPath string) string {
+	segments := strings.Split(endpointPath, "/")
+	for i, segment := range segments {
+		if isPathParameter(segment) {
+			segments[i] = "{" + segment[1:] + "}"
+		}
+	}
+
+	return "/" + groupName + strings.Join(segments, "/")
+}
+
+func createOperationID(method string, groupName string, endpointPath string) string {
+	replacer := strings.NewReplacer("/", "_", ":", "", "*", "", "-", "_", ".", "_")
+
+	return strings.ToLower(method) + "_" + replacer.Replace(groupName+endpointPath)
+}
+
+func isPathParameter(segment string) bool {
+	return len(segment) > 1 && (segment[0] == ':' || segment[0] == '*')
+}
+
+func jsonContent(schema *Schema) map[string]*MediaType {
+	return map[string]*MediaType{
+		contentTypeJSON: {Schema: schema},
+	}
+}
+
+func getRouteConfig(apiConfig config.ApiRoutesConfig, groupName string, endpointPath string) (config.RouteConfig, bool) {
+	group, ok := apiConfig.APIPackages[groupName]
+	if !ok {
+		return config.RouteConfig{}, false
+	}
+
+	for _, route := range group.Routes {
+		if route.Name == endpointPath {
+			return route, true
+		}
+	}
+
+	return config.RouteConfig{}, false
+}
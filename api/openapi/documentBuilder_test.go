@@ -0,0 +1,165 @@
+package openapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/api/middleware"
+	"github.com/ElrondNetwork/elrond-go/api/openapi"
+	"github.com/ElrondNetwork/elrond-go/api/shared"
+	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testRequest struct {
+	Sender string `json:"sender"`
+}
+
+type testResponse struct {
+	Balance string `json:"balance"`
+}
+
+func createTestGroups() []openapi.GroupEndpoints {
+	return []openapi.GroupEndpoints{
+		{
+			Name: "address",
+			Endpoints: []*shared.EndpointHandlerData{
+				{
+					Path:   "/:address/balance",
+					Method: http.MethodGet,
+					Documentation: &shared.EndpointDocumentation{
+						Summary: "balance",
+						QueryParameters: []shared.QueryParameter{
+							{Name: "onFinalBlock", Type: shared.BooleanQueryParameter},
+							{Name: "blockNonce", Type: shared.IntegerQueryParameter},
+							{Name: "blockHash", Type: shared.StringQueryParameter},
+						},
+						Response: gin.H{"balance": testResponse{}},
+					},
+				},
+				{
+					Path:   "/:address/stream",
+					Method: http.MethodGet,
+					Documentation: &shared.EndpointDocumentation{
+						Response:            testResponse{},
+						ResponseContentType: "application/x-ndjson",
+					},
+				},
+				{
+					Path:   "/ws",
+					Method: http.MethodGet,
+					Documentation: &shared.EndpointDocumentation{
+						Summary:   "websocket",
+						Websocket: true,
+					},
+				},
+				{
+					Path:   "/:address/closed",
+					Method: http.MethodGet,
+				},
+			},
+		},
+		{
+			Name: "transaction",
+			Endpoints: []*shared.EndpointHandlerData{
+				{
+					Path:   "/send",
+					Method: http.MethodPost,
+					Documentation: &shared.EndpointDocumentation{
+						Request: testRequest{},
+					},
+				},
+			},
+		},
+	}
+}
+
+func createTestRoutesConfig() config.ApiRoutesConfig {
+	return config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"address": {
+				Routes: []config.RouteConfig{
+					{Name: "/:address/balance", Open: true},
+					{Name: "/:address/stream", Open: true},
+					{Name: "/ws", Open: true},
+					{Name: "/:address/closed", Open: false},
+				},
+			},
+			"transaction": {
+				Routes: []config.RouteConfig{
+					{Name: "/send", Open: true, Auth: true},
+				},
+			},
+		},
+	}
+}
+
+func TestNewDocument(t *testing.T) {
+	t.Parallel()
+
+	info := openapi.Info{Title: "test", Version: "1.0.0"}
+	document := openapi.NewDocument(info, createTestGroups(), createTestRoutesConfig())
+
+	assert.Equal(t, openapi.Version, document.OpenAPI)
+	assert.Equal(t, info, document.Info)
+	require.Equal(t, 4, len(document.Paths))
+	assert.Nil(t, document.Paths["/address/{address}/closed"])
+
+	balanceOperation := (*document.Paths["/address/{address}/balance"])["get"]
+	require.NotNil(t, balanceOperation)
+	assert.Equal(t, "balance", balanceOperation.Summary)
+	assert.Equal(t, []string{"address"}, balanceOperation.Tags)
+	require.Equal(t, 4, len(balanceOperation.Parameters))
+	assert.Equal(t, &openapi.Parameter{Name: "address", In: "path", Required: true, Schema: &openapi.Schema{Type: "string"}}, balanceOperation.Parameters[0])
+	assert.Equal(t, &openapi.Parameter{Name: "onFinalBlock", In: "query", Schema: &openapi.Schema{Type: "boolean"}}, balanceOperation.Parameters[1])
+	assert.Equal(t, &openapi.Parameter{Name: "blockNonce", In: "query", Schema: &openapi.Schema{Type: "integer", Format: "int64"}}, balanceOperation.Parameters[2])
+	assert.Equal(t, &openapi.Parameter{Name: "blockHash", In: "query", Schema: &openapi.Schema{Type: "string"}}, balanceOperation.Parameters[3])
+	data := balanceOperation.Responses["200"].Content["application/json"].Schema.Properties["data"]
+	assert.Equal(t, "#/components/schemas/openapi_test.testResponse", data.Properties["balance"].Ref)
+	assert.NotNil(t, balanceOperation.Responses["default"])
+	assert.Nil(t, balanceOperation.Security)
+
+	streamOperation := (*document.Paths["/address/{address}/stream"])["get"]
+	require.NotNil(t, streamOperation)
+	streamContent := streamOperation.Responses["200"].Content
+	assert.Nil(t, streamContent["application/json"])
+	assert.Equal(t, "#/components/schemas/openapi_test.testResponse", streamContent["application/x-ndjson"].Schema.Ref)
+
+	wsOperation := (*document.Paths["/address/ws"])["get"]
+	require.NotNil(t, wsOperation)
+	assert.Nil(t, wsOperation.Responses["200"])
+	assert.NotNil(t, wsOperation.Responses["101"])
+	assert.NotNil(t, wsOperation.Responses["default"])
+
+	sendOperation := (*document.Paths["/transaction/send"])["post"]
+	require.NotNil(t, sendOperation)
+	assert.Equal(t, "post_transaction_send", sendOperation.OperationID)
+	require.NotNil(t, sendOperation.RequestBody)
+	assert.Equal(t, "#/components/schemas/openapi_test.testRequest", sendOperation.RequestBody.Content["application/json"].Schema.Ref)
+	assert.Nil(t, sendOperation.Security)
+	assert.Nil(t, document.Components.SecuritySchemes)
+
+	assert.NotNil(t, document.Components.Schemas["openapi_test.testRequest"])
+	assert.NotNil(t, document.Components.Schemas["openapi_test.testResponse"])
+	assert.NotNil(t, document.Components.Schemas["shared.GenericAPIResponse"])
+
+	_, err := json.Marshal(document)
+	assert.Nil(t, err)
+}
+
+func TestNewDocument_AuthEnabledShouldDocumentSecurity(t *testing.T) {
+	t.Parallel()
+
+	routesConfig := createTestRoutesConfig()
+	routesConfig.Auth.Enabled = true
+	document := openapi.NewDocument(openapi.Info{}, createTestGroups(), routesConfig)
+
+	sendOperation := (*document.Paths["/transaction/send"])["post"]
+	require.Equal(t, 2, len(sendOperation.Security))
+	assert.Nil(t, (*document.Paths["/address/{address}/balance"])["get"].Security)
+	require.Equal(t, 2, len(document.Components.SecuritySchemes))
+	assert.Equal(t, middleware.ApiKeyHeader, document.Components.SecuritySchemes["apiKey"].Name)
+}
@@ -0,0 +1,195 @@
+package openapi
+
+import (
+	"encoding/json"
+	"math/big"
+	"path"
+	"reflect"
+	"strings"
+	"time"
+)
+
+const componentsSchemasPrefix = "#/components/schemas/"
+
+var (
+	bigIntType     = reflect.TypeOf(big.Int{})
+	timeType       = reflect.TypeOf(time.Time{})
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+)
+
+// schemaGenerator derives the schemas from the Go types, following their JSON encoding. Named struct types are
+// registered as components and referenced, so recursive types are supported
+type schemaGenerator struct {
+	components map[string]*Schema
+}
+
+func newSchemaGenerator() *schemaGenerator {
+	return &schemaGenerator{
+		components: make(map[string]*Schema),
+	}
+}
+
+// schemaForValue returns the schema of the provided sample value. The entries of string-keyed maps holding interface
+// values, such as gin.H, are documented as properties
+func (sg *schemaGenerator) schemaForValue(value interface{}) *Schema {
+	if value == nil {
+		return &Schema{}
+	}
+
+	reflectValue := reflect.ValueOf(value)
+	isSampleMap := reflectValue.Kind() == reflect.Map &&
+		reflectValue.Type().Key().Kind() == reflect.String &&
+		reflectValue.Type().Elem().Kind() == reflect.Interface
+	if !isSampleMap {
+		return sg.schemaForType(reflectValue.Type())
+	}
+
+	schema := &Schema{
+		Type:       "object",
+		Properties: make(map[string]*Schema, reflectValue.Len()),
+	}
+	iterator := reflectValue.MapRange()
+	for iterator.Next() {
+		schema.Properties[iterator.Key().String()] = sg.schemaForValue(iterator.Value().Interface())
+	}
+
+	return schema
+}
+
+func (sg *schemaGenerator) schemaForType(t reflect.Type) *Schema {
+	switch t {
+	case bigIntType:
+		return &Schema{Type: "string", Format: "big-integer"}
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case rawMessageType:
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		schema := sg.schemaForType(t.Elem())
+		if len(schema.Ref) == 0 {
+			schema.Nullable = true
+		}
+		return schema
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer", Format: integerFormat(t)}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: sg.schemaForType(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: sg.schemaForType(t.Elem())}
+	case reflect.Struct:
+		return sg.schemaForStruct(t)
+	default:
+		return &Schema{}
+	}
+}
+
+func (sg *schemaGenerator) schemaForStruct(t reflect.Type) *Schema {
+	name := componentName(t)
+	if len(name) == 0 {
+		return sg.createStructSchema(t)
+	}
+
+	_, exists := sg.components[name]
+	if !exists {
+		// registered before being populated, so the recursive references stop here
+		sg.components[name] = &Schema{}
+		*sg.components[name] = *sg.createStructSchema(t)
+	}
+
+	return &Schema{Ref: componentsSchemasPrefix + name}
+}
+
+func (sg *schemaGenerator) createStructSchema(t reflect.Type) *Schema {
+	schema := &Schema{
+		Type:       "object",
+		Properties: make(map[string]*Schema),
+	}
+	sg.addStructFields(schema, t)
+
+	return schema
+}
+
+func (sg *schemaGenerator) addStructFields(schema *Schema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, asString, skip := parseJSONTag(field)
+		if skip {
+			continue
+		}
+
+		isEmbeddedStruct := field.Anonymous && len(name) == 0 && indirectType(field.Type).Kind() == reflect.Struct
+		if isEmbeddedStruct {
+			sg.addStructFields(schema, indirectType(field.Type))
+			continue
+		}
+		if len(field.PkgPath) > 0 {
+			// unexported field
+			continue
+		}
+		if len(name) == 0 {
+			name = field.Name
+		}
+
+		if asString {
+			schema.Properties[name] = &Schema{Type: "string"}
+			continue
+		}
+		schema.Properties[name] = sg.schemaForType(field.Type)
+	}
+}
+
+func parseJSONTag(field reflect.StructField) (string, bool, bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", false, true
+	}
+
+	parts := strings.Split(tag, ",")
+	asString := false
+	for _, option := range parts[1:] {
+		if option == "string" {
+			asString = true
+		}
+	}
+
+	return parts[0], asString, false
+}
+
+func indirectType(t reflect.Type) reflect.Type {
+	if t.Kind() == reflect.Ptr {
+		return t.Elem()
+	}
+
+	return t
+}
+
+func integerFormat(t reflect.Type) string {
+	switch t.Kind() {
+	case reflect.Int64, reflect.Uint64, reflect.Int, reflect.Uint:
+		return "int64"
+	default:
+		return "int32"
+	}
+}
+
+// componentName returns the name of the component holding the schema of a named type, as package.Type
+func componentName(t reflect.Type) string {
+	if len(t.Name()) == 0 {
+		return ""
+	}
+
+	return path.Base(t.PkgPath()) + "." + t.Name()
+}
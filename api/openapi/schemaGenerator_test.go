@@ -0,0 +1,63 @@
+package openapi
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type embeddedFields struct {
+	Embedded string `json:"embedded"`
+}
+
+type testNode struct {
+	embeddedFields
+	Name       string            `json:"name"`
+	Value      *big.Int          `json:"value"`
+	Counter    uint64            `json:"counter,string"`
+	Data       []byte            `json:"data,omitempty"`
+	Children   []*testNode       `json:"children"`
+	Labels     map[string]string `json:"labels"`
+	Raw        json.RawMessage   `json:"raw"`
+	Ignored    string            `json:"-"`
+	unexported string
+	NoTag      bool
+}
+
+func TestSchemaGenerator_SchemaForValue(t *testing.T) {
+	t.Parallel()
+
+	generator := newSchemaGenerator()
+	schema := generator.schemaForValue(map[string]interface{}{
+		"node":  testNode{},
+		"count": 0,
+	})
+
+	require.Equal(t, "object", schema.Type)
+	assert.Equal(t, &Schema{Type: "integer", Format: "int64"}, schema.Properties["count"])
+	assert.Equal(t, componentsSchemasPrefix+"openapi.testNode", schema.Properties["node"].Ref)
+
+	component := generator.components["openapi.testNode"]
+	require.NotNil(t, component)
+	assert.Equal(t, &Schema{Type: "string"}, component.Properties["embedded"])
+	assert.Equal(t, &Schema{Type: "string"}, component.Properties["name"])
+	assert.Equal(t, &Schema{Type: "string", Format: "big-integer", Nullable: true}, component.Properties["value"])
+	assert.Equal(t, &Schema{Type: "string"}, component.Properties["counter"])
+	assert.Equal(t, &Schema{Type: "string", Format: "byte"}, component.Properties["data"])
+	assert.Equal(t, &Schema{Type: "array", Items: &Schema{Ref: componentsSchemasPrefix + "openapi.testNode"}}, component.Properties["children"])
+	assert.Equal(t, &Schema{Type: "object", AdditionalProperties: &Schema{Type: "string"}}, component.Properties["labels"])
+	assert.Equal(t, &Schema{}, component.Properties["raw"])
+	assert.Equal(t, &Schema{Type: "boolean"}, component.Properties["NoTag"])
+	assert.Equal(t, 9, len(component.Properties))
+}
+
+func TestSchemaGenerator_NilValue(t *testing.T) {
+	t.Parallel()
+
+	generator := newSchemaGenerator()
+	assert.Equal(t, &Schema{}, generator.schemaForValue(nil))
+	assert.Equal(t, 0, len(generator.components))
+}
@@ -2,6 +2,7 @@ package shared
 
 import (
 	"math/big"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
@@ -13,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/debug"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/outport"
 	"github.com/ElrondNetwork/elrond-go/process"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/state"
@@ -51,6 +53,7 @@ type UpgradeableHttpServerHandler interface {
 // GroupHandler defines the actions needed to be performed by an gin API group
 type GroupHandler interface {
 	UpdateFacade(newFacade interface{}) error
+	GetEndpoints() []*EndpointHandlerData
 	RegisterRoutes(
 		ws *gin.RouterGroup,
 		apiConfig config.ApiRoutesConfig,
@@ -64,15 +67,18 @@ type FacadeHandler interface {
 	GetUsername(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
 	GetValueForKey(address string, key string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
 	GetAccount(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error)
+	GetAccounts(addresses []string, options api.AccountQueryOptions) (map[string]*api.AccountResponse, api.BlockInfo, error)
 	GetESDTData(address string, key string, nonce uint64, options api.AccountQueryOptions) (*esdt.ESDigitalToken, api.BlockInfo, error)
 	GetESDTsRoles(address string, options api.AccountQueryOptions) (map[string][]string, api.BlockInfo, error)
 	GetNFTTokenIDsRegisteredByAddress(address string, options api.AccountQueryOptions) ([]string, api.BlockInfo, error)
 	GetESDTsWithRole(address string, role string, options api.AccountQueryOptions) ([]string, api.BlockInfo, error)
 	GetAllESDTTokens(address string, options api.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, api.BlockInfo, error)
 	GetKeyValuePairs(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error)
+	GetKeyValuePairsPage(address string, options api.AccountQueryOptions, pageOptions common.KeyValuePairsQueryOptions) (*common.KeyValuePairsApiResponse, api.BlockInfo, error)
 	GetBlockByHash(hash string, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByNonce(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByRound(round uint64, options api.BlockQueryOptions) (*api.Block, error)
+	GetHyperblockByNonce(nonce uint64, options api.BlockQueryOptions) (*common.ApiHyperblock, error)
 	GetInternalShardBlockByNonce(format common.ApiOutputFormat, nonce uint64) (interface{}, error)
 	GetInternalShardBlockByHash(format common.ApiOutputFormat, hash string) (interface{}, error)
 	GetInternalShardBlockByRound(format common.ApiOutputFormat, round uint64) (interface{}, error)
@@ -88,6 +94,8 @@ type FacadeHandler interface {
 	GetDelegatorsList() ([]*api.Delegator, error)
 	StatusMetrics() external.StatusMetricsHandler
 	GetTokenSupply(token string) (*api.ESDTSupply, error)
+	GetTokenSupplyHistory(token string, fromEpoch uint32, toEpoch uint32) (*common.ESDTSupplyHistoryApiResponse, error)
+	GetESDTHolders(options common.ESDTHoldersQueryOptions) (*common.ESDTHoldersApiResponse, error)
 	GetAllIssuedESDTs(tokenType string) ([]string, error)
 	GetHeartbeats() ([]data.PubKeyHeartbeat, error)
 	GetQueryHandler(name string) (debug.QueryHandler, error)
@@ -102,10 +110,21 @@ type FacadeHandler interface {
 		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*transaction.Transaction, []byte, error)
 	ValidateTransaction(tx *transaction.Transaction) error
 	ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool) error
+	ValidateTransactionForScheduling(tx *transaction.Transaction) error
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
-	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ScheduleTransaction(tx *transaction.Transaction, condition string, conditionValue uint64) (string, error)
+	GetScheduledTransactions() ([]common.ScheduledTransactionApiResponse, error)
+	CancelScheduledTransaction(txHash string) error
+	SimulateTransactionExecution(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error)
+	SimulateTransactionExecutionWithStateOverrides(tx *transaction.Transaction, overrides map[string]*state.AccountOverride, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error)
+	GetTransactionTrace(hash string) (*txSimData.SimulationResults, error)
+	SimulateTransactionsBatchExecution(txs []*transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.BatchSimulationResults, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	GetTransactionStateDiff(hash string) ([]*txSimData.AccountStateDiff, error)
+	WaitForTransactionStatus(hash string, status string, timeout time.Duration) (*common.TransactionStatusWaitApiResponse, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	EstimateTransactionGas(tx *transaction.Transaction) (*txSimData.GasEstimation, error)
+	EstimateSmartContractResultGas(scr *transaction.ApiSmartContractResult) (*txSimData.GasEstimation, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
 	ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error)
 	ExecuteSCQuery(*process.SCQuery) (*vm.VMOutputApi, error)
@@ -116,9 +135,16 @@ type FacadeHandler interface {
 	GetGenesisNodesPubKeys() (map[uint32][]string, map[uint32][]string, error)
 	GetGenesisBalances() ([]*common.InitialAccountAPI, error)
 	GetGasConfigs() (map[string]map[string]uint64, error)
-	GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error)
+	GetTransactionsPool(options common.TransactionsPoolQueryOptions) (*common.TransactionsPoolAPIResponse, error)
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionsPoolReplacementsForSender(sender string) (*common.TransactionsPoolReplacementsForSenderApiResponse, error)
+	GetTransactionsPoolStatsForSender(sender string) (*common.TransactionsPoolSenderStatsApiResponse, error)
+	GetTransactionsPoolEvictions() (*common.TransactionsPoolEvictionsApiResponse, error)
+	GetTransactionsByAddress(address string, options common.AddressTransactionsQueryOptions) (*common.AddressTransactionsApiResponse, error)
+	GetLogEvents(options common.LogEventsQueryOptions) (*common.LogEventsApiResponse, error)
+	IsEventsSubscriptionEnabled() bool
+	ServeEventsSubscriber(conn outport.WSConnection) error
 	IsInterfaceNil() bool
 }
@@ -30,6 +30,40 @@ type EndpointHandlerData struct {
 	Method                string
 	Handler               gin.HandlerFunc
 	AdditionalMiddlewares []AdditionalMiddleware
+	Documentation         *EndpointDocumentation
+}
+
+// EndpointDocumentation describes an endpoint in the generated OpenAPI specification. The request and the response
+// are sample values whose types (or, for gin.H values, whose entries) define the documented schemas. The response is
+// documented inside the generic API response, unless a different response content type is provided. Websocket
+// endpoints are documented by the protocol switch only, their messages being described by the summary
+type EndpointDocumentation struct {
+	Summary             string
+	QueryParameters     []QueryParameter
+	Request             interface{}
+	Response            interface{}
+	ResponseContentType string
+	Websocket           bool
+}
+
+// QueryParameterType is the type under which a query parameter is documented
+type QueryParameterType string
+
+const (
+	// StringQueryParameter documents a query parameter holding free text, an address or a hex encoded value
+	StringQueryParameter QueryParameterType = "string"
+
+	// IntegerQueryParameter documents a query parameter holding a non-negative number
+	IntegerQueryParameter QueryParameterType = "integer"
+
+	// BooleanQueryParameter documents a query parameter holding true or false
+	BooleanQueryParameter QueryParameterType = "boolean"
+)
+
+// QueryParameter describes a query parameter accepted by an endpoint
+type QueryParameter struct {
+	Name string
+	Type QueryParameterType
 }
 
 // GenericAPIResponse defines the structure of all responses on API endpoints
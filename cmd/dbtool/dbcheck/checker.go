@@ -0,0 +1,216 @@
+package dbcheck
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters"
+	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/cmd/dbtool/operations"
+)
+
+// the identifiers of the storers, as set by the default FilePath values of the node's configuration
+const (
+	blockHeadersIdentifier            = "BlockHeaders"
+	metaBlockIdentifier               = "MetaBlock"
+	shardHdrNonceHashIdentifier       = "ShardHdrHashNonce"
+	metaHdrNonceHashIdentifier        = "MetaHdrHashNonce"
+	miniBlocksIdentifier              = "MiniBlocks"
+	transactionsIdentifier            = "Transactions"
+	unsignedTransactionsIdentifier    = "UnsignedTransactions"
+	rewardTransactionsIdentifier      = "RewardTransactions"
+	bootstrapDataIdentifier           = "BootstrapData"
+	scheduledSCRsIdentifier           = "ScheduledSCRs"
+	accountsTrieIdentifier            = "AccountsTrie"
+	accountsTrieCheckpointsIdentifier = "AccountsTrieCheckpoints"
+	peerAccountsTrieIdentifier        = "PeerAccountsTrie"
+	peerAccountsCheckpointsIdentifier = "PeerAccountsTrieCheckpoints"
+	epochByHashIdentifier             = "DbLookupExtensions_EpochByHash"
+	miniblockHashByTxHashIdentifier   = "DbLookupExtensions_MiniblockHashByTxHash"
+	miniblocksMetadataIdentifier      = "DbLookupExtensions/MiniblocksMetadata"
+)
+
+const defaultMaxReportedIssues = 1000
+
+var log = logger.GetOrCreate("dbtool/dbcheck")
+
+// ArgsCheckDatabase holds the arguments needed to check the integrity of the databases of a node
+type ArgsCheckDatabase struct {
+	DBPath            string
+	ShardID           string
+	StartEpoch        uint32
+	EndEpoch          core.OptionalUint32
+	RootHash          []byte
+	SkipState         bool
+	SkipDataTries     bool
+	MaxReportedIssues int
+	Marshalizer       marshal.Marshalizer
+	Hasher            hashing.Hasher
+}
+
+type databaseChecker struct {
+	storers          *storersHolder
+	selfShardID      uint32
+	startEpoch       uint32
+	endEpoch         uint32
+	rootHash         []byte
+	skipState        bool
+	skipDataTries    bool
+	marshalizer      marshal.Marshalizer
+	hasher           hashing.Hasher
+	uint64Converter  typeConverters.Uint64ByteSliceConverter
+	report           *Report
+	headers          map[string]*headerInfo
+	headersByShard   map[uint32][]*headerInfo
+	isLookupEnabled  bool
+	checkedMiniBlock map[string]struct{}
+}
+
+// CheckDatabase verifies, for the epochs in the provided range, that the headers in the block storers link to their
+// predecessors and are indexed by nonce, that the miniblocks and the transactions referenced by the headers of the
+// shard exist, that the dblookupext indexes point at existing data and that the state trie of the last committed
+// block is fully present. The node must be stopped while the check runs
+func CheckDatabase(args ArgsCheckDatabase) (*Report, error) {
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	allStorers, err := operations.FindStorers(args.DBPath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	shardID, err := selectShard(allStorers, args.ShardID)
+	if err != nil {
+		return nil, err
+	}
+	selfShardID, err := core.ConvertShardIDToUint32(shardID)
+	if err != nil {
+		return nil, err
+	}
+
+	shardStorers := make([]*operations.StorerInfo, 0, len(allStorers))
+	lastEpoch := uint32(0)
+	for _, storer := range allStorers {
+		if storer.ShardID != shardID {
+			continue
+		}
+
+		shardStorers = append(shardStorers, storer)
+		if !storer.IsStatic && storer.Epoch > lastEpoch {
+			lastEpoch = storer.Epoch
+		}
+	}
+
+	endEpoch := lastEpoch
+	if args.EndEpoch.HasValue {
+		endEpoch = args.EndEpoch.Value
+	}
+	if args.StartEpoch > endEpoch {
+		return nil, fmt.Errorf("%w: start %d, end %d", ErrInvalidEpochRange, args.StartEpoch, endEpoch)
+	}
+
+	maxIssues := args.MaxReportedIssues
+	if maxIssues <= 0 {
+		maxIssues = defaultMaxReportedIssues
+	}
+
+	dc := &databaseChecker{
+		storers:          newStorersHolder(args.DBPath, shardStorers),
+		selfShardID:      selfShardID,
+		startEpoch:       args.StartEpoch,
+		endEpoch:         endEpoch,
+		rootHash:         args.RootHash,
+		skipState:        args.SkipState,
+		skipDataTries:    args.SkipDataTries,
+		marshalizer:      args.Marshalizer,
+		hasher:           args.Hasher,
+		uint64Converter:  uint64ByteSlice.NewBigEndianConverter(),
+		report:           newReport(maxIssues),
+		headers:          make(map[string]*headerInfo),
+		headersByShard:   make(map[uint32][]*headerInfo),
+		checkedMiniBlock: make(map[string]struct{}),
+	}
+	dc.isLookupEnabled = dc.storers.has(epochByHashIdentifier)
+	dc.report.ShardID = shardID
+	dc.report.StartEpoch = args.StartEpoch
+	dc.report.EndEpoch = endEpoch
+	defer dc.storers.close()
+
+	err = dc.check()
+	if err != nil {
+		return nil, err
+	}
+
+	return dc.report, nil
+}
+
+func (dc *databaseChecker) check() error {
+	log.Info("checking headers, miniblocks and transactions",
+		"shard", dc.report.ShardID, "start epoch", dc.startEpoch, "end epoch", dc.endEpoch,
+		"dblookupext", dc.isLookupEnabled)
+
+	err := dc.checkHeaders()
+	if err != nil {
+		return err
+	}
+
+	if dc.isLookupEnabled {
+		log.Info("checking dblookupext miniblocks metadata")
+		err = dc.checkMiniBlocksMetadata()
+		if err != nil {
+			return err
+		}
+	}
+
+	if dc.skipState {
+		return nil
+	}
+
+	log.Info("checking state")
+
+	return dc.checkState()
+}
+
+func selectShard(storers []*operations.StorerInfo, shardID string) (string, error) {
+	shards := make(map[string]struct{})
+	for _, storer := range storers {
+		shards[storer.ShardID] = struct{}{}
+	}
+
+	if len(shardID) > 0 {
+		_, ok := shards[shardID]
+		if !ok {
+			return "", fmt.Errorf("%w %s", ErrShardNotFound, shardID)
+		}
+
+		return shardID, nil
+	}
+
+	if len(shards) == 1 {
+		for shard := range shards {
+			return shard, nil
+		}
+	}
+
+	foundShards := make([]string, 0, len(shards))
+	for shard := range shards {
+		foundShards = append(foundShards, shard)
+	}
+	sort.Strings(foundShards)
+
+	return "", fmt.Errorf("%w: %s", ErrAmbiguousShard, strings.Join(foundShards, ", "))
+}
+
+func (dc *databaseChecker) isEpochInRange(epoch uint32) bool {
+	return epoch >= dc.startEpoch && epoch <= dc.endEpoch
+}
@@ -0,0 +1,572 @@
+package dbcheck
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters/uint64ByteSlice"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/cmd/dbtool/operations"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage/compression"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	storageMock "github.com/ElrondNetwork/elrond-go/testscommon/storage"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	numTestHeaders        = 5
+	numTestAccounts       = 20
+	numTestTxsPerBlock    = 3
+	testEpoch             = 0
+	testLastHeaderRound   = 100
+	testMaxTrieLevelInMem = 5
+)
+
+var (
+	epochPath          = filepath.Join("Epoch_0", "Shard_0")
+	staticPath         = filepath.Join("Static", "Shard_0")
+	headersPath        = filepath.Join(epochPath, blockHeadersIdentifier)
+	miniBlocksPath     = filepath.Join(epochPath, miniBlocksIdentifier)
+	transactionsPath   = filepath.Join(epochPath, transactionsIdentifier)
+	bootstrapPath      = filepath.Join(epochPath, bootstrapDataIdentifier)
+	metadataPath       = filepath.Join(epochPath, miniblocksMetadataIdentifier)
+	noncesPath         = filepath.Join(staticPath, shardHdrNonceHashIdentifier+"0")
+	accountsTriePath   = filepath.Join(staticPath, accountsTrieIdentifier)
+	epochByHashPath    = filepath.Join(staticPath, epochByHashIdentifier)
+	txLookupPath       = filepath.Join(staticPath, miniblockHashByTxHashIdentifier)
+	testMarshalizer    = &marshal.GogoProtoMarshalizer{}
+	testHasher         = blake2b.NewBlake2b()
+	testNonceConverter = uint64ByteSlice.NewBigEndianConverter()
+)
+
+// testDatabase holds the entries of each storer, which are written on disk only when the test layout is created
+type testDatabase struct {
+	entries        map[string]map[string][]byte
+	headerHashes   [][]byte
+	miniBlocks     [][]byte
+	txHashes       [][]byte
+	rootHash       []byte
+	dataRootHash   []byte
+	trieNodeHashes [][]byte
+}
+
+func (td *testDatabase) put(relativePath string, key []byte, value []byte) {
+	storer, ok := td.entries[relativePath]
+	if !ok {
+		storer = make(map[string][]byte)
+		td.entries[relativePath] = storer
+	}
+	storer[string(key)] = value
+}
+
+func (td *testDatabase) remove(relativePath string, key []byte) {
+	delete(td.entries[relativePath], string(key))
+}
+
+func (td *testDatabase) write(t *testing.T) string {
+	dbPath := t.TempDir()
+	for relativePath, storer := range td.entries {
+		persister, err := operations.OpenPersister(filepath.Join(dbPath, relativePath), storageUnit.LvlDBSerial, 100, operations.RawValues)
+		require.Nil(t, err)
+
+		for key, value := range storer {
+			err = persister.Put([]byte(key), value)
+			require.Nil(t, err)
+		}
+
+		err = persister.Close()
+		require.Nil(t, err)
+	}
+
+	return dbPath
+}
+
+func createTestState(t *testing.T, td *testDatabase) {
+	args, options := storageMock.GetStorageManagerArgsAndOptions()
+	mainStorer := genericMocks.NewStorerMock()
+	args.MainStorer = mainStorer
+	args.Marshalizer = testMarshalizer
+	args.Hasher = testHasher
+	storageManager, err := trie.CreateTrieStorageManager(args, options)
+	require.Nil(t, err)
+
+	dataTrie, err := trie.NewTrie(storageManager, testMarshalizer, testHasher, testMaxTrieLevelInMem)
+	require.Nil(t, err)
+	for i := 0; i < numTestAccounts; i++ {
+		key := testHasher.Compute(fmt.Sprintf("data key %d", i))
+		require.Nil(t, dataTrie.Update(key, []byte(fmt.Sprintf("data value %d", i))))
+	}
+	require.Nil(t, dataTrie.Commit())
+	td.dataRootHash, err = dataTrie.RootHash()
+	require.Nil(t, err)
+
+	accountsTrie, err := trie.NewTrie(storageManager, testMarshalizer, testHasher, testMaxTrieLevelInMem)
+	require.Nil(t, err)
+	for i := 0; i < numTestAccounts; i++ {
+		address := testHasher.Compute(fmt.Sprintf("address %d", i))
+		account := &state.UserAccountData{
+			Nonce:   uint64(i),
+			Balance: big.NewInt(int64(i)),
+			Address: address,
+		}
+		// half of the accounts share the same data trie
+		if i%2 == 0 {
+			account.RootHash = td.dataRootHash
+		}
+
+		buff, errMarshal := testMarshalizer.Marshal(account)
+		require.Nil(t, errMarshal)
+		require.Nil(t, accountsTrie.Update(address, buff))
+	}
+	require.Nil(t, accountsTrie.Commit())
+	td.rootHash, err = accountsTrie.RootHash()
+	require.Nil(t, err)
+
+	mainStorer.RangeKeys(func(key []byte, value []byte) bool {
+		td.put(accountsTriePath, key, value)
+		td.trieNodeHashes = append(td.trieNodeHashes, key)
+		return true
+	})
+}
+
+func createTestDatabase(t *testing.T) *testDatabase {
+	td := &testDatabase{
+		entries: make(map[string]map[string][]byte),
+	}
+	createTestState(t, td)
+
+	prevHash := []byte("previous hash of the first header")
+	for nonce := uint64(1); nonce <= numTestHeaders; nonce++ {
+		miniBlock := &block.MiniBlock{
+			SenderShardID:   0,
+			ReceiverShardID: 0,
+			Type:            block.TxBlock,
+		}
+		for i := 0; i < numTestTxsPerBlock; i++ {
+			txHash := testHasher.Compute(fmt.Sprintf("tx %d %d", nonce, i))
+			miniBlock.TxHashes = append(miniBlock.TxHashes, txHash)
+			td.txHashes = append(td.txHashes, txHash)
+			td.put(transactionsPath, txHash, []byte("tx"))
+		}
+		miniBlockBuff, err := testMarshalizer.Marshal(miniBlock)
+		require.Nil(t, err)
+		miniBlockHash := testHasher.Compute(string(miniBlockBuff))
+		td.miniBlocks = append(td.miniBlocks, miniBlockHash)
+		td.put(miniBlocksPath, miniBlockHash, miniBlockBuff)
+
+		header := &block.Header{
+			Nonce:    nonce,
+			Round:    nonce,
+			Epoch:    testEpoch,
+			PrevHash: prevHash,
+			RootHash: td.rootHash,
+			MiniBlockHeaders: []block.MiniBlockHeader{
+				{
+					Hash:    miniBlockHash,
+					TxCount: numTestTxsPerBlock,
+					Type:    block.TxBlock,
+				},
+			},
+		}
+		headerBuff, err := testMarshalizer.Marshal(header)
+		require.Nil(t, err)
+		headerHash := testHasher.Compute(string(headerBuff))
+		td.headerHashes = append(td.headerHashes, headerHash)
+		td.put(headersPath, headerHash, headerBuff)
+		td.put(noncesPath, testNonceConverter.ToByteSlice(nonce), headerHash)
+
+		putLookupEntries(t, td, headerHash, nonce, miniBlockHash, miniBlock.TxHashes)
+		prevHash = headerHash
+	}
+
+	putBootstrapData(t, td, td.headerHashes[len(td.headerHashes)-1], numTestHeaders)
+
+	return td
+}
+
+func putLookupEntries(t *testing.T, td *testDatabase, headerHash []byte, nonce uint64, miniBlockHash []byte, txHashes [][]byte) {
+	epochByHash, err := testMarshalizer.Marshal(&dblookupext.EpochByHash{Epoch: testEpoch})
+	require.Nil(t, err)
+	td.put(epochByHashPath, headerHash, epochByHash)
+	td.put(epochByHashPath, miniBlockHash, epochByHash)
+
+	metadata, err := testMarshalizer.Marshal(&dblookupext.MiniblockMetadata{
+		Epoch:         testEpoch,
+		HeaderHash:    headerHash,
+		MiniblockHash: miniBlockHash,
+		HeaderNonce:   nonce,
+	})
+	require.Nil(t, err)
+	td.put(metadataPath, miniBlockHash, metadata)
+
+	for _, txHash := range txHashes {
+		td.put(txLookupPath, txHash, miniBlockHash)
+	}
+}
+
+func putBootstrapData(t *testing.T, td *testDatabase, lastHeaderHash []byte, lastHeaderNonce uint64) {
+	roundBuff, err := testMarshalizer.Marshal(&bootstrapStorage.RoundNum{Num: testLastHeaderRound})
+	require.Nil(t, err)
+	td.put(bootstrapPath, []byte(common.HighestRoundFromBootStorage), roundBuff)
+
+	bootstrapBuff, err := testMarshalizer.Marshal(&bootstrapStorage.BootstrapData{
+		LastHeader: bootstrapStorage.BootstrapHeaderInfo{
+			ShardId: 0,
+			Epoch:   testEpoch,
+			Nonce:   lastHeaderNonce,
+			Hash:    lastHeaderHash,
+		},
+	})
+	require.Nil(t, err)
+	td.put(bootstrapPath, []byte(strconv.Itoa(testLastHeaderRound)), bootstrapBuff)
+}
+
+// compressStorerValues replaces the entries of the storer with the ones written by a compressing persister
+func compressStorerValues(t *testing.T, td *testDatabase, relativePath string) {
+	db := memorydb.New()
+	persister, err := compression.NewPersister(compression.ArgsPersister{
+		Persister: db,
+		Type:      compression.Snappy,
+	})
+	require.Nil(t, err)
+
+	numCompressed := 0
+	for key, value := range td.entries[relativePath] {
+		require.Nil(t, persister.Put([]byte(key), value))
+		stored, errGet := db.Get([]byte(key))
+		require.Nil(t, errGet)
+		if len(stored) < len(value) {
+			numCompressed++
+		}
+	}
+	require.NotZero(t, numCompressed)
+
+	db.RangeKeys(func(key []byte, value []byte) bool {
+		td.put(relativePath, key, value)
+		return true
+	})
+}
+
+func createArgsCheckDatabase(dbPath string) ArgsCheckDatabase {
+	return ArgsCheckDatabase{
+		DBPath:            dbPath,
+		MaxReportedIssues: 100,
+		Marshalizer:       testMarshalizer,
+		Hasher:            testHasher,
+	}
+}
+
+func requireIssue(t *testing.T, report *Report, category string, storer string, key []byte, messagePart string) {
+	for _, issue := range report.Issues {
+		if issue.Category == category && issue.Storer == storer && string(issue.Key) == string(key) &&
+			strings.Contains(issue.Message, messagePart) {
+			return
+		}
+	}
+
+	issues := make([]string, 0, len(report.Issues))
+	for _, issue := range report.Issues {
+		issues = append(issues, issue.String())
+	}
+	require.Fail(t, "issue not reported", "expected [%s] %s on %s, got:\n%s",
+		category, messagePart, storer, strings.Join(issues, "\n"))
+}
+
+func TestCheckDatabase(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsCheckDatabase(t.TempDir())
+		args.Marshalizer = nil
+
+		report, err := CheckDatabase(args)
+		assert.Nil(t, report)
+		assert.Equal(t, ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsCheckDatabase(t.TempDir())
+		args.Hasher = nil
+
+		report, err := CheckDatabase(args)
+		assert.Nil(t, report)
+		assert.Equal(t, ErrNilHasher, err)
+	})
+	t.Run("empty database should error", func(t *testing.T) {
+		t.Parallel()
+
+		report, err := CheckDatabase(createArgsCheckDatabase(t.TempDir()))
+		assert.Nil(t, report)
+		assert.True(t, errors.Is(err, operations.ErrNoStorerFound))
+	})
+	t.Run("unknown shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsCheckDatabase(createTestDatabase(t).write(t))
+		args.ShardID = "1"
+
+		report, err := CheckDatabase(args)
+		assert.Nil(t, report)
+		assert.True(t, errors.Is(err, ErrShardNotFound))
+	})
+	t.Run("several shards without a selected one should error", func(t *testing.T) {
+		t.Parallel()
+
+		td := createTestDatabase(t)
+		td.put(filepath.Join("Epoch_0", "Shard_metachain", metaBlockIdentifier), []byte("key"), []byte("value"))
+
+		report, err := CheckDatabase(createArgsCheckDatabase(td.write(t)))
+		assert.Nil(t, report)
+		assert.True(t, errors.Is(err, ErrAmbiguousShard))
+	})
+	t.Run("invalid epoch range should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgsCheckDatabase(createTestDatabase(t).write(t))
+		args.StartEpoch = 1
+
+		report, err := CheckDatabase(args)
+		assert.Nil(t, report)
+		assert.True(t, errors.Is(err, ErrInvalidEpochRange))
+	})
+	t.Run("consistent database should not report issues", func(t *testing.T) {
+		t.Parallel()
+
+		td := createTestDatabase(t)
+
+		report, err := CheckDatabase(createArgsCheckDatabase(td.write(t)))
+		require.Nil(t, err)
+		assert.Equal(t, uint64(0), report.NumIssues, "%v", report.Issues)
+		assert.Equal(t, "0", report.ShardID)
+		assert.Equal(t, uint64(numTestHeaders), report.NumHeaders)
+		assert.Equal(t, uint64(numTestHeaders), report.NumMiniBlocks)
+		assert.Equal(t, uint64(numTestHeaders*numTestTxsPerBlock), report.NumTransactions)
+		assert.Equal(t, td.rootHash, report.StateRootHash)
+		assert.Equal(t, uint64(1), report.NumDataTries)
+		assert.Equal(t, uint64(len(td.trieNodeHashes)), report.NumTrieNodes)
+	})
+	t.Run("compressed trie nodes should be decompressed", func(t *testing.T) {
+		t.Parallel()
+
+		td := createTestDatabase(t)
+		compressStorerValues(t, td, accountsTriePath)
+
+		report, err := CheckDatabase(createArgsCheckDatabase(td.write(t)))
+		require.Nil(t, err)
+		assert.Equal(t, uint64(0), report.NumIssues, "%v", report.Issues)
+		assert.Equal(t, uint64(numTestHeaders), report.NumHeaders)
+		assert.Equal(t, uint64(len(td.trieNodeHashes)), report.NumTrieNodes)
+	})
+	t.Run("skip state should not check the tries", func(t *testing.T) {
+		t.Parallel()
+
+		td := createTestDatabase(t)
+		td.remove(accountsTriePath, td.rootHash)
+		args := createArgsCheckDatabase(td.write(t))
+		args.SkipState = true
+
+		report, err := CheckDatabase(args)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(0), report.NumIssues)
+		assert.Equal(t, uint64(0), report.NumTrieNodes)
+	})
+	t.Run("missing previous header should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		td := createTestDatabase(t)
+		td.remove(headersPath, td.headerHashes[2])
+
+		report, err := CheckDatabase(createArgsCheckDatabase(td.write(t)))
+		require.Nil(t, err)
+		requireIssue(t, report, categoryHeader, blockHeadersIdentifier, td.headerHashes[2], "previous header")
+		requireIssue(t, report, categoryLookup, miniblocksMetadataIdentifier, td.miniBlocks[2], "not found")
+	})
+	t.Run("corrupted header should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		td := createTestDatabase(t)
+		td.put(headersPath, td.headerHashes[1], []byte("corrupted"))
+
+		report, err := CheckDatabase(createArgsCheckDatabase(td.write(t)))
+		require.Nil(t, err)
+		requireIssue(t, report, categoryHeader, blockHeadersIdentifier, td.headerHashes[1], "does not match its hash")
+	})
+	t.Run("missing nonce index should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		td := createTestDatabase(t)
+		nonce := testNonceConverter.ToByteSlice(3)
+		td.remove(noncesPath, nonce)
+
+		report, err := CheckDatabase(createArgsCheckDatabase(td.write(t)))
+		require.Nil(t, err)
+		assert.Equal(t, uint64(1), report.NumIssues)
+		requireIssue(t, report, categoryHeader, shardHdrNonceHashIdentifier+"0", nonce, "not indexed")
+	})
+	t.Run("missing miniblock and transaction should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		td := createTestDatabase(t)
+		td.remove(miniBlocksPath, td.miniBlocks[0])
+		td.remove(transactionsPath, td.txHashes[numTestTxsPerBlock+1])
+
+		report, err := CheckDatabase(createArgsCheckDatabase(td.write(t)))
+		require.Nil(t, err)
+		assert.Equal(t, uint64(2), report.NumIssues)
+		requireIssue(t, report, categoryMiniBlock, miniBlocksIdentifier, td.miniBlocks[0], "not found")
+		requireIssue(t, report, categoryTransaction, transactionsIdentifier, td.txHashes[numTestTxsPerBlock+1], "not found")
+	})
+	t.Run("missing lookup entries should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		td := createTestDatabase(t)
+		td.remove(epochByHashPath, td.headerHashes[0])
+		td.remove(txLookupPath, td.txHashes[0])
+		td.remove(metadataPath, td.miniBlocks[1])
+
+		report, err := CheckDatabase(createArgsCheckDatabase(td.write(t)))
+		require.Nil(t, err)
+		assert.Equal(t, uint64(3), report.NumIssues)
+		requireIssue(t, report, categoryLookup, epochByHashIdentifier, td.headerHashes[0], "header not indexed")
+		requireIssue(t, report, categoryLookup, miniblockHashByTxHashIdentifier, td.txHashes[0], "not indexed")
+		requireIssue(t, report, categoryLookup, miniblocksMetadataIdentifier, td.miniBlocks[1], "metadata not found")
+	})
+	t.Run("lookup entries pointing at missing data should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		td := createTestDatabase(t)
+		missingHeaderHash := []byte("missing header hash")
+		metadata, err := testMarshalizer.Marshal(&dblookupext.MiniblockMetadata{
+			Epoch:         testEpoch,
+			HeaderHash:    missingHeaderHash,
+			MiniblockHash: []byte("missing miniblock"),
+		})
+		require.Nil(t, err)
+		td.put(metadataPath, []byte("missing miniblock"), metadata)
+		td.put(txLookupPath, td.txHashes[0], []byte("missing miniblock"))
+
+		report, err := CheckDatabase(createArgsCheckDatabase(td.write(t)))
+		require.Nil(t, err)
+		assert.Equal(t, uint64(2), report.NumIssues)
+		requireIssue(t, report, categoryLookup, miniblocksMetadataIdentifier, []byte("missing miniblock"), "was not found")
+		requireIssue(t, report, categoryLookup, miniblockHashByTxHashIdentifier, td.txHashes[0], "which is not indexed")
+	})
+	t.Run("missing trie nodes should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		td := createTestDatabase(t)
+		td.remove(accountsTriePath, td.dataRootHash)
+
+		report, err := CheckDatabase(createArgsCheckDatabase(td.write(t)))
+		require.Nil(t, err)
+		assert.Equal(t, uint64(1), report.NumIssues)
+		requireIssue(t, report, categoryState, accountsTrieIdentifier, td.dataRootHash, "data trie")
+	})
+	t.Run("missing root node should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		td := createTestDatabase(t)
+		td.remove(accountsTriePath, td.rootHash)
+
+		report, err := CheckDatabase(createArgsCheckDatabase(td.write(t)))
+		require.Nil(t, err)
+		assert.Equal(t, uint64(1), report.NumIssues)
+		requireIssue(t, report, categoryState, accountsTrieIdentifier, td.rootHash, "accounts trie")
+	})
+	t.Run("provided root hash should be checked", func(t *testing.T) {
+		t.Parallel()
+
+		td := createTestDatabase(t)
+		args := createArgsCheckDatabase(td.write(t))
+		args.RootHash = td.dataRootHash
+
+		report, err := CheckDatabase(args)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(0), report.NumIssues)
+		assert.Equal(t, td.dataRootHash, report.StateRootHash)
+		assert.Equal(t, uint64(0), report.NumDataTries)
+	})
+	t.Run("missing bootstrap data should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		td := createTestDatabase(t)
+		td.remove(bootstrapPath, []byte(strconv.Itoa(testLastHeaderRound)))
+
+		report, err := CheckDatabase(createArgsCheckDatabase(td.write(t)))
+		require.Nil(t, err)
+		assert.Equal(t, uint64(1), report.NumIssues)
+		requireIssue(t, report, categoryState, bootstrapDataIdentifier, []byte(strconv.Itoa(testLastHeaderRound)), "not found")
+	})
+	t.Run("reported issues should be capped", func(t *testing.T) {
+		t.Parallel()
+
+		td := createTestDatabase(t)
+		for _, txHash := range td.txHashes {
+			td.remove(transactionsPath, txHash)
+		}
+		args := createArgsCheckDatabase(td.write(t))
+		args.MaxReportedIssues = 2
+
+		report, err := CheckDatabase(args)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(len(td.txHashes)), report.NumIssues)
+		assert.Equal(t, 2, len(report.Issues))
+	})
+	t.Run("epoch range should limit the checked headers", func(t *testing.T) {
+		t.Parallel()
+
+		td := createTestDatabase(t)
+		td.remove(miniBlocksPath, td.miniBlocks[0])
+		td.put(filepath.Join("Epoch_1", "Shard_0", blockHeadersIdentifier), []byte("key"), []byte("value"))
+		args := createArgsCheckDatabase(td.write(t))
+		args.StartEpoch = 1
+		args.SkipState = true
+
+		report, err := CheckDatabase(args)
+		require.Nil(t, err)
+		assert.Equal(t, uint32(1), report.EndEpoch)
+		assert.Equal(t, uint64(1), report.NumHeaders)
+		assert.Equal(t, uint64(1), report.NumIssues)
+		requireIssue(t, report, categoryHeader, blockHeadersIdentifier, []byte("key"), "does not match its hash")
+	})
+}
+
+func TestSelectShard(t *testing.T) {
+	t.Parallel()
+
+	storers := []*operations.StorerInfo{
+		{ShardID: "0"},
+		{ShardID: core.GetShardIDString(core.MetachainShardId)},
+	}
+
+	shardID, err := selectShard(storers, "metachain")
+	assert.Nil(t, err)
+	assert.Equal(t, "metachain", shardID)
+
+	shardID, err = selectShard(storers[:1], "")
+	assert.Nil(t, err)
+	assert.Equal(t, "0", shardID)
+
+	_, err = selectShard(storers, "")
+	assert.True(t, errors.Is(err, ErrAmbiguousShard))
+	assert.True(t, strings.Contains(err.Error(), "0, metachain"))
+}
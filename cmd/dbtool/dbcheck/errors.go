@@ -0,0 +1,27 @@
+package dbcheck
+
+import "errors"
+
+// ErrNilMarshalizer signals that a nil marshalizer was provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher was provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrInvalidEpochRange signals that the start epoch is greater than the end epoch
+var ErrInvalidEpochRange = errors.New("invalid epoch range")
+
+// ErrShardNotFound signals that no storer was found for the provided shard
+var ErrShardNotFound = errors.New("no storer found for shard")
+
+// ErrAmbiguousShard signals that the databases directory holds storers of several shards and none was selected
+var ErrAmbiguousShard = errors.New("storers of several shards found, a shard must be selected")
+
+// ErrKeyNotFound signals that a key was not found in any epoch of a storer
+var ErrKeyNotFound = errors.New("key not found")
+
+// ErrStorerNotFound signals that a storer was not found in the databases directory
+var ErrStorerNotFound = errors.New("storer not found")
+
+// ErrIntegrityCheckFailed signals that the integrity check found issues
+var ErrIntegrityCheckFailed = errors.New("integrity check failed")
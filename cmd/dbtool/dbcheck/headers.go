@@ -0,0 +1,254 @@
+package dbcheck
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/process"
+)
+
+type headerInfo struct {
+	hash     []byte
+	shardID  uint32
+	nonce    uint64
+	epoch    uint32
+	prevHash []byte
+}
+
+func (dc *databaseChecker) checkHeaders() error {
+	for _, identifier := range []string{blockHeadersIdentifier, metaBlockIdentifier} {
+		for _, epoch := range dc.storers.epochs(identifier) {
+			if !dc.isEpochInRange(epoch) {
+				continue
+			}
+
+			log.Debug("checking headers", "storer", identifier, "epoch", epoch)
+			err := dc.storers.rangeEpoch(identifier, epoch, func(key []byte, value []byte) bool {
+				dc.checkHeader(identifier, epoch, key, value)
+				return true
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	dc.checkHeadersLinkage()
+	dc.checkNonceIndexes()
+
+	return nil
+}
+
+func (dc *databaseChecker) checkHeader(identifier string, epoch uint32, hash []byte, buff []byte) {
+	dc.report.NumHeaders++
+
+	if !bytes.Equal(dc.hasher.Compute(string(buff)), hash) {
+		dc.report.addIssue(categoryHeader, identifier, hash, "header content does not match its hash")
+		return
+	}
+
+	header, err := dc.unmarshalHeader(identifier, buff)
+	if err != nil {
+		dc.report.addIssue(categoryHeader, identifier, hash, "header cannot be decoded: %v", err)
+		return
+	}
+
+	info := &headerInfo{
+		hash:     hash,
+		shardID:  header.GetShardID(),
+		nonce:    header.GetNonce(),
+		epoch:    epoch,
+		prevHash: header.GetPrevHash(),
+	}
+	dc.headers[string(hash)] = info
+	dc.headersByShard[info.shardID] = append(dc.headersByShard[info.shardID], info)
+
+	if info.shardID != dc.selfShardID {
+		return
+	}
+
+	if dc.isLookupEnabled {
+		dc.checkEpochByHash(hash, header.GetEpoch(), "header")
+	}
+
+	isShardHeader := info.shardID != core.MetachainShardId
+	for _, miniBlockHeader := range header.GetMiniBlockHeaderHandlers() {
+		// the peer miniblocks are only stored by the metachain
+		if isShardHeader && block.Type(miniBlockHeader.GetTypeInt32()) == block.PeerBlock {
+			continue
+		}
+
+		dc.checkMiniBlock(miniBlockHeader, hash, header, epoch)
+	}
+}
+
+func (dc *databaseChecker) unmarshalHeader(identifier string, buff []byte) (data.HeaderHandler, error) {
+	if identifier == metaBlockIdentifier {
+		return process.UnmarshalMetaHeader(dc.marshalizer, buff)
+	}
+
+	return process.UnmarshalShardHeader(dc.marshalizer, buff)
+}
+
+func (dc *databaseChecker) checkMiniBlock(
+	miniBlockHeader data.MiniBlockHeaderHandler,
+	headerHash []byte,
+	header data.HeaderHandler,
+	epoch uint32,
+) {
+	dc.report.NumMiniBlocks++
+
+	miniBlockHash := miniBlockHeader.GetHash()
+	buff, err := dc.storers.get(miniBlocksIdentifier, epoch, miniBlockHash)
+	if err != nil {
+		dc.report.addIssue(categoryMiniBlock, miniBlocksIdentifier, miniBlockHash,
+			"miniblock referenced by header %s (nonce %d) not found: %v",
+			hex.EncodeToString(headerHash), header.GetNonce(), err)
+		return
+	}
+	if !bytes.Equal(dc.hasher.Compute(string(buff)), miniBlockHash) {
+		dc.report.addIssue(categoryMiniBlock, miniBlocksIdentifier, miniBlockHash,
+			"miniblock content does not match its hash")
+		return
+	}
+
+	miniBlock := &block.MiniBlock{}
+	err = dc.marshalizer.Unmarshal(miniBlock, buff)
+	if err != nil {
+		dc.report.addIssue(categoryMiniBlock, miniBlocksIdentifier, miniBlockHash,
+			"miniblock cannot be decoded: %v", err)
+		return
+	}
+
+	if dc.isLookupEnabled {
+		dc.checkMiniBlockLookup(miniBlockHash, header.GetEpoch())
+	}
+
+	txsIdentifier, ok := getTransactionsIdentifier(miniBlock.Type)
+	if !ok {
+		return
+	}
+
+	// a partially executed miniblock is referenced by several headers, each one processing a part of its transactions
+	first := int(miniBlockHeader.GetIndexOfFirstTxProcessed())
+	last := int(miniBlockHeader.GetIndexOfLastTxProcessed())
+	for i := first; i >= 0 && i <= last && i < len(miniBlock.TxHashes); i++ {
+		dc.checkTransaction(txsIdentifier, miniBlock.TxHashes[i], miniBlockHash, epoch)
+	}
+}
+
+func (dc *databaseChecker) checkTransaction(identifier string, txHash []byte, miniBlockHash []byte, epoch uint32) {
+	dc.report.NumTransactions++
+
+	_, err := dc.storers.get(identifier, epoch, txHash)
+	if err != nil {
+		dc.report.addIssue(categoryTransaction, identifier, txHash,
+			"transaction of miniblock %s not found: %v", hex.EncodeToString(miniBlockHash), err)
+	}
+
+	if dc.isLookupEnabled {
+		dc.checkTransactionLookup(txHash)
+	}
+}
+
+func getTransactionsIdentifier(miniBlockType block.Type) (string, bool) {
+	switch miniBlockType {
+	case block.TxBlock, block.InvalidBlock:
+		return transactionsIdentifier, true
+	case block.SmartContractResultBlock:
+		return unsignedTransactionsIdentifier, true
+	case block.RewardsBlock:
+		return rewardTransactionsIdentifier, true
+	default:
+		return "", false
+	}
+}
+
+func (dc *databaseChecker) checkHeadersLinkage() {
+	for _, shardID := range dc.sortedShardIDs() {
+		headers := dc.headersByShard[shardID]
+		sort.SliceStable(headers, func(i, j int) bool {
+			return headers[i].nonce < headers[j].nonce
+		})
+
+		identifier := getHeadersIdentifier(shardID)
+		firstNonce := headers[0].nonce
+		for _, header := range headers {
+			// the predecessors of the first headers are out of the checked range
+			if header.nonce == firstNonce {
+				continue
+			}
+
+			previous, ok := dc.headers[string(header.prevHash)]
+			if !ok {
+				dc.report.addIssue(categoryHeader, identifier, header.prevHash,
+					"previous header of header %s (nonce %d) not found",
+					hex.EncodeToString(header.hash), header.nonce)
+				continue
+			}
+			if previous.nonce+1 != header.nonce {
+				dc.report.addIssue(categoryHeader, identifier, header.hash,
+					"header with nonce %d links to a previous header with nonce %d", header.nonce, previous.nonce)
+			}
+		}
+	}
+}
+
+func (dc *databaseChecker) checkNonceIndexes() {
+	for _, shardID := range dc.sortedShardIDs() {
+		identifier := getNonceIndexIdentifier(shardID)
+		if !dc.storers.has(identifier) {
+			continue
+		}
+
+		for _, header := range dc.headersByShard[shardID] {
+			nonceBytes := dc.uint64Converter.ToByteSlice(header.nonce)
+			indexedHash, err := dc.storers.get(identifier, header.epoch, nonceBytes)
+			if err != nil {
+				dc.report.addIssue(categoryHeader, identifier, nonceBytes,
+					"nonce %d of header %s not indexed: %v", header.nonce, hex.EncodeToString(header.hash), err)
+				continue
+			}
+
+			// a different hash belongs to a fork of the same nonce, which must exist as well
+			_, isKnown := dc.headers[string(indexedHash)]
+			if !bytes.Equal(indexedHash, header.hash) && !isKnown {
+				dc.report.addIssue(categoryHeader, identifier, nonceBytes,
+					"nonce %d indexed to header %s, which was not found", header.nonce, hex.EncodeToString(indexedHash))
+			}
+		}
+	}
+}
+
+func (dc *databaseChecker) sortedShardIDs() []uint32 {
+	shardIDs := make([]uint32, 0, len(dc.headersByShard))
+	for shardID := range dc.headersByShard {
+		shardIDs = append(shardIDs, shardID)
+	}
+	sort.Slice(shardIDs, func(i, j int) bool {
+		return shardIDs[i] < shardIDs[j]
+	})
+
+	return shardIDs
+}
+
+func getHeadersIdentifier(shardID uint32) string {
+	if shardID == core.MetachainShardId {
+		return metaBlockIdentifier
+	}
+
+	return blockHeadersIdentifier
+}
+
+func getNonceIndexIdentifier(shardID uint32) string {
+	if shardID == core.MetachainShardId {
+		return metaHdrNonceHashIdentifier
+	}
+
+	return fmt.Sprintf("%s%d", shardHdrNonceHashIdentifier, shardID)
+}
@@ -0,0 +1,122 @@
+package dbcheck
+
+import (
+	"bytes"
+	"encoding/hex"
+
+	"github.com/ElrondNetwork/elrond-go/dblookupext"
+)
+
+func (dc *databaseChecker) getEpochByHash(hash []byte) (uint32, error) {
+	buff, err := dc.storers.get(epochByHashIdentifier, 0, hash)
+	if err != nil {
+		return 0, err
+	}
+
+	epochByHash := &dblookupext.EpochByHash{}
+	err = dc.marshalizer.Unmarshal(epochByHash, buff)
+	if err != nil {
+		return 0, err
+	}
+
+	return epochByHash.Epoch, nil
+}
+
+func (dc *databaseChecker) checkEpochByHash(hash []byte, expectedEpoch uint32, description string) bool {
+	dc.report.NumLookupEntries++
+
+	epoch, err := dc.getEpochByHash(hash)
+	if err != nil {
+		dc.report.addIssue(categoryLookup, epochByHashIdentifier, hash, "%s not indexed by hash: %v", description, err)
+		return false
+	}
+	if epoch != expectedEpoch {
+		dc.report.addIssue(categoryLookup, epochByHashIdentifier, hash,
+			"%s indexed in epoch %d instead of %d", description, epoch, expectedEpoch)
+		return false
+	}
+
+	return true
+}
+
+func (dc *databaseChecker) checkMiniBlockLookup(miniBlockHash []byte, epoch uint32) {
+	_, isChecked := dc.checkedMiniBlock[string(miniBlockHash)]
+	if isChecked {
+		return
+	}
+	dc.checkedMiniBlock[string(miniBlockHash)] = struct{}{}
+
+	if !dc.checkEpochByHash(miniBlockHash, epoch, "miniblock") {
+		return
+	}
+
+	dc.report.NumLookupEntries++
+	_, err := dc.storers.get(miniblocksMetadataIdentifier, epoch, miniBlockHash)
+	if err != nil {
+		dc.report.addIssue(categoryLookup, miniblocksMetadataIdentifier, miniBlockHash,
+			"miniblock metadata not found: %v", err)
+	}
+}
+
+func (dc *databaseChecker) checkTransactionLookup(txHash []byte) {
+	dc.report.NumLookupEntries++
+
+	miniBlockHash, err := dc.storers.get(miniblockHashByTxHashIdentifier, 0, txHash)
+	if err != nil {
+		dc.report.addIssue(categoryLookup, miniblockHashByTxHashIdentifier, txHash,
+			"transaction not indexed by hash: %v", err)
+		return
+	}
+
+	_, err = dc.getEpochByHash(miniBlockHash)
+	if err != nil {
+		dc.report.addIssue(categoryLookup, miniblockHashByTxHashIdentifier, txHash,
+			"transaction indexed to miniblock %s, which is not indexed: %v", hex.EncodeToString(miniBlockHash), err)
+	}
+}
+
+// checkMiniBlocksMetadata verifies that each miniblock metadata entry can be decoded and points at a known header
+func (dc *databaseChecker) checkMiniBlocksMetadata() error {
+	for _, epoch := range dc.storers.epochs(miniblocksMetadataIdentifier) {
+		if !dc.isEpochInRange(epoch) {
+			continue
+		}
+
+		err := dc.storers.rangeEpoch(miniblocksMetadataIdentifier, epoch, func(key []byte, value []byte) bool {
+			dc.checkMiniBlockMetadata(key, value)
+			return true
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (dc *databaseChecker) checkMiniBlockMetadata(miniBlockHash []byte, buff []byte) {
+	dc.report.NumLookupEntries++
+
+	metadata := &dblookupext.MiniblockMetadata{}
+	err := dc.marshalizer.Unmarshal(metadata, buff)
+	if err != nil {
+		dc.report.addIssue(categoryLookup, miniblocksMetadataIdentifier, miniBlockHash,
+			"miniblock metadata cannot be decoded: %v", err)
+		return
+	}
+	if !bytes.Equal(metadata.MiniblockHash, miniBlockHash) {
+		dc.report.addIssue(categoryLookup, miniblocksMetadataIdentifier, miniBlockHash,
+			"miniblock metadata holds miniblock hash %s", hex.EncodeToString(metadata.MiniblockHash))
+		return
+	}
+	if !dc.isEpochInRange(metadata.Epoch) {
+		return
+	}
+
+	_, ok := dc.headers[string(metadata.HeaderHash)]
+	if !ok {
+		dc.report.addIssue(categoryLookup, miniblocksMetadataIdentifier, miniBlockHash,
+			"miniblock metadata points at header %s (nonce %d), which was not found",
+			hex.EncodeToString(metadata.HeaderHash), metadata.HeaderNonce)
+	}
+}
@@ -0,0 +1,66 @@
+package dbcheck
+
+import (
+	"encoding/hex"
+	"fmt"
+)
+
+const (
+	categoryHeader      = "header"
+	categoryMiniBlock   = "miniblock"
+	categoryTransaction = "transaction"
+	categoryLookup      = "dblookupext"
+	categoryState       = "state"
+)
+
+// Issue describes a single integrity problem, pointing at the storer and the key involved
+type Issue struct {
+	Category string
+	Storer   string
+	Key      []byte
+	Message  string
+}
+
+// String returns a readable representation of the issue
+func (i *Issue) String() string {
+	return fmt.Sprintf("[%s] %s: storer %s, key %s", i.Category, i.Message, i.Storer, hex.EncodeToString(i.Key))
+}
+
+// Report holds the outcome of a database check. The issues list is capped, while NumIssues counts all of them
+type Report struct {
+	ShardID          string
+	StartEpoch       uint32
+	EndEpoch         uint32
+	NumHeaders       uint64
+	NumMiniBlocks    uint64
+	NumTransactions  uint64
+	NumLookupEntries uint64
+	NumTrieNodes     uint64
+	NumDataTries     uint64
+	StateRootHash    []byte
+	NumIssues        uint64
+	Issues           []*Issue
+
+	maxIssues int
+}
+
+func newReport(maxIssues int) *Report {
+	return &Report{
+		Issues:    make([]*Issue, 0),
+		maxIssues: maxIssues,
+	}
+}
+
+func (r *Report) addIssue(category string, storer string, key []byte, message string, args ...interface{}) {
+	r.NumIssues++
+	if len(r.Issues) >= r.maxIssues {
+		return
+	}
+
+	r.Issues = append(r.Issues, &Issue{
+		Category: category,
+		Storer:   storer,
+		Key:      key,
+		Message:  fmt.Sprintf(message, args...),
+	})
+}
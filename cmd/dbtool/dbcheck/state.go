@@ -0,0 +1,209 @@
+package dbcheck
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math"
+	"strconv"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/scheduled"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/trie"
+)
+
+type dataTrieInfo struct {
+	address  []byte
+	rootHash []byte
+}
+
+// checkState verifies that the accounts trie of the last committed block, including the data tries, and, on the
+// metachain, the validators trie are fully present
+func (dc *databaseChecker) checkState() error {
+	rootHash := dc.rootHash
+	var header data.HeaderHandler
+	if len(rootHash) == 0 {
+		var headerHash []byte
+		headerHash, header = dc.getLastCommittedHeader()
+		if check.IfNil(header) {
+			return nil
+		}
+
+		rootHash = dc.getCommittedRootHash(headerHash, header)
+	}
+	dc.report.StateRootHash = rootHash
+
+	dataTries := make([]*dataTrieInfo, 0)
+	leafHandler := func(key []byte, value []byte) {
+		if dc.skipDataTries {
+			return
+		}
+
+		account := &state.UserAccountData{}
+		err := dc.marshalizer.Unmarshal(account, value)
+		// the accounts trie holds the code of the smart contracts as well
+		if err != nil || !bytes.Equal(account.Address, key) || len(account.RootHash) == 0 {
+			return
+		}
+
+		dataTries = append(dataTries, &dataTrieInfo{
+			address:  key,
+			rootHash: account.RootHash,
+		})
+	}
+
+	log.Info("checking accounts trie", "root hash", rootHash)
+	dc.checkTrie(accountsTrieIdentifier, accountsTrieCheckpointsIdentifier, rootHash, leafHandler, "accounts trie")
+
+	checkedDataTries := make(map[string]struct{})
+	for _, dataTrie := range dataTries {
+		_, isChecked := checkedDataTries[string(dataTrie.rootHash)]
+		if isChecked {
+			continue
+		}
+		checkedDataTries[string(dataTrie.rootHash)] = struct{}{}
+
+		dc.report.NumDataTries++
+		dc.checkTrie(accountsTrieIdentifier, accountsTrieCheckpointsIdentifier, dataTrie.rootHash, nil,
+			"data trie of "+hex.EncodeToString(dataTrie.address))
+	}
+
+	metaHeader, ok := header.(data.MetaHeaderHandler)
+	if ok && len(metaHeader.GetValidatorStatsRootHash()) > 0 {
+		log.Info("checking validators trie", "root hash", metaHeader.GetValidatorStatsRootHash())
+		dc.checkTrie(peerAccountsTrieIdentifier, peerAccountsCheckpointsIdentifier,
+			metaHeader.GetValidatorStatsRootHash(), nil, "validators trie")
+	}
+
+	return nil
+}
+
+func (dc *databaseChecker) checkTrie(
+	identifier string,
+	checkpointsIdentifier string,
+	rootHash []byte,
+	leafHandler func(key []byte, value []byte),
+	description string,
+) {
+	if !dc.storers.has(identifier) {
+		dc.report.addIssue(categoryState, identifier, rootHash, "%s storer not found", description)
+		return
+	}
+
+	getNode := func(hash []byte) ([]byte, error) {
+		value, err := dc.storers.get(identifier, dc.endEpoch, hash)
+		if err == nil || !dc.storers.has(checkpointsIdentifier) {
+			return value, err
+		}
+
+		return dc.storers.get(checkpointsIdentifier, dc.endEpoch, hash)
+	}
+
+	result, err := trie.CheckCompleteness(trie.ArgsCheckCompleteness{
+		RootHash:    rootHash,
+		GetNode:     getNode,
+		Marshalizer: dc.marshalizer,
+		Hasher:      dc.hasher,
+		LeafHandler: leafHandler,
+	})
+	if err != nil {
+		dc.report.addIssue(categoryState, identifier, rootHash, "%s cannot be checked: %v", description, err)
+		return
+	}
+
+	dc.report.NumTrieNodes += result.NumNodes
+	for _, missingNode := range result.MissingNodes {
+		dc.report.addIssue(categoryState, identifier, missingNode.Hash,
+			"node of the %s referenced by node %s: %v",
+			description, hex.EncodeToString(missingNode.ParentHash), missingNode.Err)
+	}
+}
+
+// getLastCommittedHeader reads the last header from the bootstrap data, as the node does when starting
+func (dc *databaseChecker) getLastCommittedHeader() ([]byte, data.HeaderHandler) {
+	if !dc.storers.has(bootstrapDataIdentifier) {
+		dc.report.addIssue(categoryState, bootstrapDataIdentifier, nil,
+			"bootstrap storer not found, cannot find the last committed root hash")
+		return nil, nil
+	}
+
+	highestRoundKey := []byte(common.HighestRoundFromBootStorage)
+	// the highest round is saved in the most recent epoch, which is searched first
+	roundBuff, err := dc.storers.get(bootstrapDataIdentifier, math.MaxUint32, highestRoundKey)
+	if err != nil {
+		dc.report.addIssue(categoryState, bootstrapDataIdentifier, highestRoundKey,
+			"highest round not found: %v", err)
+		return nil, nil
+	}
+
+	round := &bootstrapStorage.RoundNum{}
+	err = dc.marshalizer.Unmarshal(round, roundBuff)
+	if err != nil {
+		dc.report.addIssue(categoryState, bootstrapDataIdentifier, highestRoundKey,
+			"highest round cannot be decoded: %v", err)
+		return nil, nil
+	}
+
+	roundKey := []byte(strconv.FormatInt(round.Num, 10))
+	bootstrapBuff, err := dc.storers.get(bootstrapDataIdentifier, math.MaxUint32, roundKey)
+	if err != nil {
+		dc.report.addIssue(categoryState, bootstrapDataIdentifier, roundKey,
+			"bootstrap data of round %d not found: %v", round.Num, err)
+		return nil, nil
+	}
+
+	bootstrapData := &bootstrapStorage.BootstrapData{}
+	err = dc.marshalizer.Unmarshal(bootstrapData, bootstrapBuff)
+	if err != nil {
+		dc.report.addIssue(categoryState, bootstrapDataIdentifier, roundKey,
+			"bootstrap data of round %d cannot be decoded: %v", round.Num, err)
+		return nil, nil
+	}
+
+	lastHeader := bootstrapData.LastHeader
+	identifier := getHeadersIdentifier(lastHeader.ShardId)
+	headerBuff, err := dc.storers.get(identifier, lastHeader.Epoch, lastHeader.Hash)
+	if err != nil {
+		dc.report.addIssue(categoryState, identifier, lastHeader.Hash,
+			"last committed header (nonce %d) not found: %v", lastHeader.Nonce, err)
+		return nil, nil
+	}
+
+	header, err := dc.unmarshalHeader(identifier, headerBuff)
+	if err != nil {
+		dc.report.addIssue(categoryState, identifier, lastHeader.Hash,
+			"last committed header (nonce %d) cannot be decoded: %v", lastHeader.Nonce, err)
+		return nil, nil
+	}
+
+	log.Info("found last committed header",
+		"shard", core.GetShardIDString(lastHeader.ShardId), "epoch", lastHeader.Epoch,
+		"nonce", lastHeader.Nonce, "hash", lastHeader.Hash)
+
+	return lastHeader.Hash, header
+}
+
+// getCommittedRootHash returns the scheduled root hash of the header, if any, as it is the state the node commits
+// when scheduled transactions are executed after the header
+func (dc *databaseChecker) getCommittedRootHash(headerHash []byte, header data.HeaderHandler) []byte {
+	if !dc.storers.has(scheduledSCRsIdentifier) {
+		return header.GetRootHash()
+	}
+
+	buff, err := dc.storers.get(scheduledSCRsIdentifier, header.GetEpoch(), headerHash)
+	if err != nil {
+		return header.GetRootHash()
+	}
+
+	scheduledSCRs := &scheduled.ScheduledSCRs{}
+	err = dc.marshalizer.Unmarshal(scheduledSCRs, buff)
+	if err != nil || len(scheduledSCRs.RootHash) == 0 {
+		return header.GetRootHash()
+	}
+
+	return scheduledSCRs.RootHash
+}
@@ -0,0 +1,166 @@
+package dbcheck
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/cmd/dbtool/operations"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// storersHolder opens the storers of a shard on demand and reads keys from them, either from a given epoch or
+// from any epoch, as a pruning storer would. The values of the storers holding protobuf messages are decompressed,
+// if written by a compressing persister
+type storersHolder struct {
+	dbPath     string
+	static     map[string]*operations.StorerInfo
+	pruning    map[string]map[uint32]*operations.StorerInfo
+	persisters map[string]storage.Persister
+}
+
+func newStorersHolder(dbPath string, storers []*operations.StorerInfo) *storersHolder {
+	sh := &storersHolder{
+		dbPath:     dbPath,
+		static:     make(map[string]*operations.StorerInfo),
+		pruning:    make(map[string]map[uint32]*operations.StorerInfo),
+		persisters: make(map[string]storage.Persister),
+	}
+
+	for _, storer := range storers {
+		if storer.IsStatic {
+			sh.static[storer.Identifier] = storer
+			continue
+		}
+
+		epochs, ok := sh.pruning[storer.Identifier]
+		if !ok {
+			epochs = make(map[uint32]*operations.StorerInfo)
+			sh.pruning[storer.Identifier] = epochs
+		}
+		epochs[storer.Epoch] = storer
+	}
+
+	return sh
+}
+
+func (sh *storersHolder) has(identifier string) bool {
+	_, isStatic := sh.static[identifier]
+	_, isPruning := sh.pruning[identifier]
+
+	return isStatic || isPruning
+}
+
+// epochs returns the epochs the storer has a database for, in ascending order
+func (sh *storersHolder) epochs(identifier string) []uint32 {
+	epochs := make([]uint32, 0, len(sh.pruning[identifier]))
+	for epoch := range sh.pruning[identifier] {
+		epochs = append(epochs, epoch)
+	}
+	sort.Slice(epochs, func(i, j int) bool {
+		return epochs[i] < epochs[j]
+	})
+
+	return epochs
+}
+
+func (sh *storersHolder) getPersister(storer *operations.StorerInfo) (storage.Persister, error) {
+	persister, ok := sh.persisters[storer.RelativePath]
+	if ok {
+		return persister, nil
+	}
+
+	mode := operations.DecompressedValues
+	if holdsRawValues(storer.Identifier) {
+		mode = operations.RawValues
+	}
+
+	persister, err := operations.OpenPersister(filepath.Join(sh.dbPath, storer.RelativePath), storer.DBType, 1, mode)
+	if err != nil {
+		return nil, fmt.Errorf("%w while opening %s", err, storer.String())
+	}
+	sh.persisters[storer.RelativePath] = persister
+
+	return persister, nil
+}
+
+// holdsRawValues returns true for the storers whose values are hashes instead of protobuf messages, which can not be
+// told apart from the compressed values, so they are never compressed
+func holdsRawValues(identifier string) bool {
+	return strings.HasPrefix(identifier, shardHdrNonceHashIdentifier) ||
+		identifier == metaHdrNonceHashIdentifier ||
+		identifier == miniblockHashByTxHashIdentifier
+}
+
+func (sh *storersHolder) getFromStorer(storer *operations.StorerInfo, key []byte) ([]byte, error) {
+	persister, err := sh.getPersister(storer)
+	if err != nil {
+		return nil, err
+	}
+
+	return persister.Get(key)
+}
+
+// get searches the key in the static storer, then in the preferred epoch and then in all the other epochs,
+// starting with the most recent one
+func (sh *storersHolder) get(identifier string, preferredEpoch uint32, key []byte) ([]byte, error) {
+	if !sh.has(identifier) {
+		return nil, fmt.Errorf("%w: %s", ErrStorerNotFound, identifier)
+	}
+
+	staticStorer, ok := sh.static[identifier]
+	if ok {
+		value, err := sh.getFromStorer(staticStorer, key)
+		if err == nil {
+			return value, nil
+		}
+	}
+
+	preferredStorer, ok := sh.pruning[identifier][preferredEpoch]
+	if ok {
+		value, err := sh.getFromStorer(preferredStorer, key)
+		if err == nil {
+			return value, nil
+		}
+	}
+
+	epochs := sh.epochs(identifier)
+	for i := len(epochs) - 1; i >= 0; i-- {
+		if epochs[i] == preferredEpoch {
+			continue
+		}
+
+		value, err := sh.getFromStorer(sh.pruning[identifier][epochs[i]], key)
+		if err == nil {
+			return value, nil
+		}
+	}
+
+	return nil, ErrKeyNotFound
+}
+
+// rangeEpoch iterates over all the entries the storer holds in the provided epoch, if any
+func (sh *storersHolder) rangeEpoch(identifier string, epoch uint32, handler func(key []byte, value []byte) bool) error {
+	storer, ok := sh.pruning[identifier][epoch]
+	if !ok {
+		return nil
+	}
+
+	persister, err := sh.getPersister(storer)
+	if err != nil {
+		return err
+	}
+
+	return operations.RangeEntries(persister, handler)
+}
+
+func (sh *storersHolder) close() {
+	for path, persister := range sh.persisters {
+		err := persister.Close()
+		if err != nil {
+			log.Warn("cannot close persister", "path", path, "error", err)
+		}
+	}
+	sh.persisters = make(map[string]storage.Persister)
+}
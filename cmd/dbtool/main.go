@@ -0,0 +1,375 @@
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/cmd/dbtool/dbcheck"
+	"github.com/ElrondNetwork/elrond-go/cmd/dbtool/operations"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/urfave/cli"
+)
+
+const (
+	dbPathPlaceholder = "[path]"
+	defaultBatchSize  = 10000
+)
+
+var (
+	dbToolHelpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}} command [command options]
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+COMMANDS:
+   {{range .Commands}}{{join .Names ", "}}{{ "\t" }}{{.Usage}}
+   {{end}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+
+	// dbPath defines a flag for the databases directory of the node
+	dbPath = cli.StringFlag{
+		Name: "db-path",
+		Usage: "The `" + dbPathPlaceholder + "` to the databases directory of the node, including the chain ID, " +
+			"such as ./db/1. The node must be stopped while the tool runs",
+	}
+	// storers defines a flag for selecting the storers to be processed
+	storers = cli.StringFlag{
+		Name: "storers",
+		Usage: "Comma-separated identifiers of the storers to be processed, such as AccountsTrie,MiniBlocks. " +
+			"If not set, all the storers are processed",
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogInfo.String(),
+	}
+	// destinationPath defines a flag for the databases directory the storers are migrated to
+	destinationPath = cli.StringFlag{
+		Name: "destination-path",
+		Usage: "The `" + dbPathPlaceholder + "` to the databases directory the storers are copied to, keeping the " +
+			"same layout. Once migrated, it can replace the source directory",
+	}
+	// destinationType defines a flag for the database type the storers are migrated to
+	destinationType = cli.StringFlag{
+		Name:  "destination-type",
+		Usage: fmt.Sprintf("The database type the storers are copied to: %s, %s or %s", storageUnit.LvlDB, storageUnit.LvlDBSerial, storageUnit.BadgerDB),
+		Value: string(storageUnit.BadgerDB),
+	}
+	// batchSize defines a flag for the number of entries written at once in the destination databases
+	batchSize = cli.IntFlag{
+		Name:  "batch-size",
+		Usage: "The number of entries written at once in the destination databases",
+		Value: defaultBatchSize,
+	}
+	// noVerify defines a flag for skipping the verification of the migrated storers
+	noVerify = cli.BoolFlag{
+		Name:  "no-verify",
+		Usage: "Boolean option for skipping the key count and checksum verification of each migrated storer",
+	}
+	// comparePath defines a flag for the databases directory the storers are compared with
+	comparePath = cli.StringFlag{
+		Name: "compare-path",
+		Usage: "The `" + dbPathPlaceholder + "` to another databases directory holding the same storers, such as the " +
+			"destination of a migration. If set, the key counts and the checksums of the storers are compared",
+	}
+
+	// shard defines a flag for the shard whose storers are checked
+	shard = cli.StringFlag{
+		Name:  "shard",
+		Usage: "The shard whose storers are checked, such as 0 or metachain. Required only if several shards are found",
+	}
+	// startEpoch defines a flag for the first checked epoch
+	startEpoch = cli.UintFlag{
+		Name:  "start-epoch",
+		Usage: "The first epoch whose headers, miniblocks, transactions and dblookupext entries are checked",
+		Value: 0,
+	}
+	// endEpoch defines a flag for the last checked epoch
+	endEpoch = cli.IntFlag{
+		Name:  "end-epoch",
+		Usage: "The last epoch whose headers, miniblocks, transactions and dblookupext entries are checked. If negative, the last epoch found is used",
+		Value: -1,
+	}
+	// rootHash defines a flag for the state root hash to be checked
+	rootHash = cli.StringFlag{
+		Name:  "root-hash",
+		Usage: "The hex encoded root hash of the accounts trie to be checked. If not set, the root hash of the last committed block, as found in the bootstrap data, is used",
+	}
+	// skipState defines a flag for skipping the state check
+	skipState = cli.BoolFlag{
+		Name:  "skip-state",
+		Usage: "Boolean option for skipping the check of the state tries",
+	}
+	// skipDataTries defines a flag for skipping the check of the accounts data tries
+	skipDataTries = cli.BoolFlag{
+		Name:  "skip-data-tries",
+		Usage: "Boolean option for checking only the accounts trie, without the data tries of the accounts",
+	}
+	// maxReportedIssues defines a flag for the maximum number of printed issues
+	maxReportedIssues = cli.IntFlag{
+		Name:  "max-reported-issues",
+		Usage: "The maximum number of issues printed. All the issues are counted",
+		Value: 1000,
+	}
+
+	log = logger.GetOrCreate("dbtool")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = dbToolHelpTemplate
+	app.Name = "Elrond Database Tool"
+	app.Version = "v1.0.0"
+	app.Usage = "This binary migrates, compacts, verifies and checks the integrity of the databases of a stopped node"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The Elrond Team",
+			Email: "contact@elrond.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		dbPath,
+		storers,
+		logLevel,
+	}
+	app.Commands = []cli.Command{
+		{
+			Name:   "migrate",
+			Usage:  "copies each storer to a database of another type. The values of the compressed storers are copied as they are stored",
+			Flags:  []cli.Flag{destinationPath, destinationType, batchSize, noVerify},
+			Action: migrate,
+		},
+		{
+			Name:   "compact",
+			Usage:  "runs a full compaction of each storer, reclaiming the space of the removed entries",
+			Action: compact,
+		},
+		{
+			Name: "check",
+			Usage: "checks the integrity of the headers, miniblocks, transactions, dblookupext indexes and state of a shard. " +
+				"The --storers flag is ignored",
+			Flags:  []cli.Flag{shard, startEpoch, endEpoch, rootHash, skipState, skipDataTries, maxReportedIssues},
+			Action: checkDatabase,
+		},
+		{
+			Name:   "verify",
+			Usage:  "reads each storer entirely, printing its key count and checksum",
+			Flags:  []cli.Flag{comparePath},
+			Action: verify,
+		},
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error(err.Error())
+		os.Exit(1)
+	}
+}
+
+func findStorers(ctx *cli.Context) (string, []*operations.StorerInfo, error) {
+	err := logger.SetLogLevel(ctx.GlobalString(logLevel.Name))
+	if err != nil {
+		return "", nil, err
+	}
+
+	path := ctx.GlobalString(dbPath.Name)
+	if len(path) == 0 {
+		return "", nil, fmt.Errorf("the --%s flag is required", dbPath.Name)
+	}
+
+	identifiers := make([]string, 0)
+	for _, identifier := range strings.Split(ctx.GlobalString(storers.Name), ",") {
+		identifier = strings.TrimSpace(identifier)
+		if len(identifier) > 0 {
+			identifiers = append(identifiers, identifier)
+		}
+	}
+
+	foundStorers, err := operations.FindStorers(path, identifiers)
+	if err != nil {
+		return "", nil, err
+	}
+
+	log.Info("found storers", "path", path, "num", len(foundStorers))
+
+	return path, foundStorers, nil
+}
+
+func migrate(ctx *cli.Context) error {
+	sourcePath, foundStorers, err := findStorers(ctx)
+	if err != nil {
+		return err
+	}
+
+	destination := ctx.String(destinationPath.Name)
+	if len(destination) == 0 {
+		return fmt.Errorf("the --%s flag is required", destinationPath.Name)
+	}
+
+	dbType := storageUnit.DBType(ctx.String(destinationType.Name))
+	for _, storer := range foundStorers {
+		result, errMigrate := operations.MigrateStorer(operations.ArgsMigrateStorer{
+			Storer:            storer,
+			SourceDBPath:      sourcePath,
+			DestinationDBPath: destination,
+			DestinationDBType: dbType,
+			BatchSize:         ctx.Int(batchSize.Name),
+			Verify:            !ctx.Bool(noVerify.Name),
+		})
+		if errMigrate != nil {
+			return fmt.Errorf("%w while migrating %s", errMigrate, storer.String())
+		}
+
+		log.Info("migrated storer",
+			"storer", storer.String(),
+			"from", storer.DBType,
+			"to", dbType,
+			"keys", result.SourceStats.NumKeys,
+			"verified", result.DestinationStats != nil,
+		)
+	}
+
+	log.Info("migration done", "destination", destination)
+
+	return nil
+}
+
+func compact(ctx *cli.Context) error {
+	path, foundStorers, err := findStorers(ctx)
+	if err != nil {
+		return err
+	}
+
+	totalBefore, totalAfter := int64(0), int64(0)
+	for _, storer := range foundStorers {
+		sizeBefore, sizeAfter, errCompact := operations.CompactStorer(storer, path)
+		if errCompact != nil {
+			return fmt.Errorf("%w while compacting %s", errCompact, storer.String())
+		}
+
+		totalBefore += sizeBefore
+		totalAfter += sizeAfter
+		log.Info("compacted storer",
+			"storer", storer.String(),
+			"type", storer.DBType,
+			"size before", sizeBefore,
+			"size after", sizeAfter,
+		)
+	}
+
+	log.Info("compaction done", "size before", totalBefore, "size after", totalAfter)
+
+	return nil
+}
+
+func verify(ctx *cli.Context) error {
+	path, foundStorers, err := findStorers(ctx)
+	if err != nil {
+		return err
+	}
+
+	compared := ctx.String(comparePath.Name)
+	numMismatches := 0
+	for _, storer := range foundStorers {
+		stats, errVerify := operations.VerifyStorer(storer, path, compared)
+		if errors.Is(errVerify, operations.ErrStatsMismatch) {
+			numMismatches++
+			log.Error("storer mismatch", "error", errVerify)
+			continue
+		}
+		if errVerify != nil {
+			return fmt.Errorf("%w while verifying %s", errVerify, storer.String())
+		}
+
+		log.Info("verified storer", "storer", storer.String(), "type", storer.DBType, "stats", stats.String())
+	}
+
+	if numMismatches > 0 {
+		return fmt.Errorf("%w: %d storer(s)", operations.ErrStatsMismatch, numMismatches)
+	}
+
+	log.Info("verification done", "num storers", len(foundStorers))
+
+	return nil
+}
+
+func checkDatabase(ctx *cli.Context) error {
+	err := logger.SetLogLevel(ctx.GlobalString(logLevel.Name))
+	if err != nil {
+		return err
+	}
+
+	path := ctx.GlobalString(dbPath.Name)
+	if len(path) == 0 {
+		return fmt.Errorf("the --%s flag is required", dbPath.Name)
+	}
+
+	stateRootHash, err := hex.DecodeString(ctx.String(rootHash.Name))
+	if err != nil {
+		return fmt.Errorf("%w while decoding the --%s flag", err, rootHash.Name)
+	}
+
+	args := dbcheck.ArgsCheckDatabase{
+		DBPath:            path,
+		ShardID:           ctx.String(shard.Name),
+		StartEpoch:        uint32(ctx.Uint(startEpoch.Name)),
+		RootHash:          stateRootHash,
+		SkipState:         ctx.Bool(skipState.Name),
+		SkipDataTries:     ctx.Bool(skipDataTries.Name),
+		MaxReportedIssues: ctx.Int(maxReportedIssues.Name),
+		Marshalizer:       &marshal.GogoProtoMarshalizer{},
+		Hasher:            blake2b.NewBlake2b(),
+	}
+	if ctx.Int(endEpoch.Name) >= 0 {
+		args.EndEpoch = core.OptionalUint32{Value: uint32(ctx.Int(endEpoch.Name)), HasValue: true}
+	}
+
+	report, err := dbcheck.CheckDatabase(args)
+	if err != nil {
+		return err
+	}
+
+	for _, issue := range report.Issues {
+		log.Warn(issue.String())
+	}
+
+	log.Info("check done",
+		"shard", report.ShardID,
+		"start epoch", report.StartEpoch,
+		"end epoch", report.EndEpoch,
+		"headers", report.NumHeaders,
+		"miniblocks", report.NumMiniBlocks,
+		"transactions", report.NumTransactions,
+		"dblookupext entries", report.NumLookupEntries,
+		"state root hash", report.StateRootHash,
+		"trie nodes", report.NumTrieNodes,
+		"data tries", report.NumDataTries,
+		"issues", report.NumIssues,
+	)
+
+	if report.NumIssues > 0 {
+		return fmt.Errorf("%w: %d issue(s) found", dbcheck.ErrIntegrityCheckFailed, report.NumIssues)
+	}
+
+	return nil
+}
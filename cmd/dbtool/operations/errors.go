@@ -0,0 +1,25 @@
+package operations
+
+import "errors"
+
+// ErrUnknownDBType signals that the type of a database could not be detected from its files
+var ErrUnknownDBType = errors.New("unknown database type")
+
+// ErrCompactionNotSupported signals that the persister does not support a full compaction
+var ErrCompactionNotSupported = errors.New("compaction not supported by the persister")
+
+// ErrDestinationNotEmpty signals that the destination of a migration already holds a database
+var ErrDestinationNotEmpty = errors.New("destination is not empty")
+
+// ErrUnsupportedDBType signals that a storer cannot be migrated to the provided database type
+var ErrUnsupportedDBType = errors.New("unsupported database type")
+
+// ErrStatsMismatch signals that two copies of a storer hold different data
+var ErrStatsMismatch = errors.New("storer stats mismatch")
+
+// ErrNoStorerFound signals that no storer was found in the provided path
+var ErrNoStorerFound = errors.New("no storer found")
+
+// ErrIterationErrorsNotReported signals that the persister does not report the errors met while iterating over its
+// entries, so a complete iteration can not be told apart from one ended by an error
+var ErrIterationErrorsNotReported = errors.New("the persister does not report its iteration errors")
@@ -0,0 +1,12 @@
+package operations
+
+// compacter is implemented by the persisters able to run a full compaction of their database
+type compacter interface {
+	Compact() error
+}
+
+// errorReportingRanger is implemented by the persisters reporting the errors which end their iteration prematurely,
+// such as the ones met while reading a corrupted database
+type errorReportingRanger interface {
+	RangeKeysWithError(handler func(key []byte, value []byte) bool) error
+}
@@ -0,0 +1,237 @@
+package operations
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+)
+
+// files created by each engine when opening a database
+const levelDBMarkerFile = "CURRENT"
+const badgerDBMarkerFile = "KEYREGISTRY"
+
+// StorerInfo describes a storer database found in the databases directory of a node
+type StorerInfo struct {
+	IsStatic     bool
+	Epoch        uint32
+	ShardID      string
+	Identifier   string
+	RelativePath string
+	DBType       storageUnit.DBType
+}
+
+// String returns a readable representation of the storer's location
+func (si *StorerInfo) String() string {
+	if si.IsStatic {
+		return fmt.Sprintf("static/shard %s/%s", si.ShardID, si.Identifier)
+	}
+
+	return fmt.Sprintf("epoch %d/shard %s/%s", si.Epoch, si.ShardID, si.Identifier)
+}
+
+// FindStorers walks the layout created by the storage path manager under the provided path (the databases directory,
+// including the chain ID): Epoch_[E]/Shard_[S]/[I] for the pruning storers and Static/Shard_[S]/[I] for the static
+// ones. The storers are returned ordered by epoch, the static ones first. If identifiers are provided, only the
+// storers having one of them are returned
+func FindStorers(dbPath string, identifiers []string) ([]*StorerInfo, error) {
+	rootDirs, err := listDirectories(dbPath)
+	if err != nil {
+		return nil, err
+	}
+
+	storers := make([]*StorerInfo, 0)
+	for _, rootDir := range rootDirs {
+		isStatic, epoch, ok := parseRootDirectory(rootDir)
+		if !ok {
+			log.Debug("skipping directory", "path", filepath.Join(dbPath, rootDir))
+			continue
+		}
+
+		shardDirs, errList := listDirectories(filepath.Join(dbPath, rootDir))
+		if errList != nil {
+			return nil, errList
+		}
+
+		for _, shardDir := range shardDirs {
+			shardID, ok := parseShardDirectory(shardDir)
+			if !ok {
+				log.Debug("skipping directory", "path", filepath.Join(dbPath, rootDir, shardDir))
+				continue
+			}
+
+			storersInShard, errFind := findStorersInDirectory(dbPath, filepath.Join(rootDir, shardDir), "", identifiers)
+			if errFind != nil {
+				return nil, errFind
+			}
+
+			for _, storer := range storersInShard {
+				storer.IsStatic = isStatic
+				storer.Epoch = epoch
+				storer.ShardID = shardID
+			}
+			storers = append(storers, storersInShard...)
+		}
+	}
+
+	if len(storers) == 0 {
+		return nil, fmt.Errorf("%w in %s", ErrNoStorerFound, dbPath)
+	}
+
+	sort.SliceStable(storers, func(i, j int) bool {
+		if storers[i].IsStatic != storers[j].IsStatic {
+			return storers[i].IsStatic
+		}
+		if storers[i].Epoch != storers[j].Epoch {
+			return storers[i].Epoch < storers[j].Epoch
+		}
+
+		return storers[i].RelativePath < storers[j].RelativePath
+	})
+
+	return storers, nil
+}
+
+// findStorersInDirectory also descends into the directories which do not hold a database, as some storers are
+// configured with nested file paths, such as DbLookupExtensions/MiniblocksMetadata
+func findStorersInDirectory(dbPath string, shardRelativePath string, parentIdentifier string, identifiers []string) ([]*StorerInfo, error) {
+	storerDirs, err := listDirectories(filepath.Join(dbPath, shardRelativePath, parentIdentifier))
+	if err != nil {
+		return nil, err
+	}
+
+	storers := make([]*StorerInfo, 0, len(storerDirs))
+	for _, storerDir := range storerDirs {
+		identifier := path.Join(parentIdentifier, storerDir)
+		relativePath := filepath.Join(shardRelativePath, filepath.FromSlash(identifier))
+		dbType, errDetect := DetectDBType(filepath.Join(dbPath, relativePath))
+		if errDetect != nil {
+			nestedStorers, errFind := findStorersInDirectory(dbPath, shardRelativePath, identifier, identifiers)
+			if errFind != nil {
+				return nil, errFind
+			}
+			if len(nestedStorers) == 0 {
+				log.Debug("skipping directory", "path", relativePath, "error", errDetect)
+			}
+
+			storers = append(storers, nestedStorers...)
+			continue
+		}
+		if !isIdentifierSelected(identifier, identifiers) {
+			continue
+		}
+
+		storers = append(storers, &StorerInfo{
+			Identifier:   identifier,
+			RelativePath: relativePath,
+			DBType:       dbType,
+		})
+	}
+
+	return storers, nil
+}
+
+// DetectDBType returns the type of the database found in the provided directory, based on the files each engine
+// creates. The LevelDB databases are reported as LvlDB, as LvlDBSerial shares the same format
+func DetectDBType(path string) (storageUnit.DBType, error) {
+	if fileExists(filepath.Join(path, levelDBMarkerFile)) {
+		return storageUnit.LvlDB, nil
+	}
+	if fileExists(filepath.Join(path, badgerDBMarkerFile)) {
+		return storageUnit.BadgerDB, nil
+	}
+
+	return "", fmt.Errorf("%w in %s", ErrUnknownDBType, path)
+}
+
+func parseRootDirectory(name string) (bool, uint32, bool) {
+	if name == common.DefaultStaticDbString {
+		return true, 0, true
+	}
+
+	epochPrefix := common.DefaultEpochString + "_"
+	if !strings.HasPrefix(name, epochPrefix) {
+		return false, 0, false
+	}
+
+	epoch, err := strconv.ParseUint(strings.TrimPrefix(name, epochPrefix), 10, 32)
+	if err != nil {
+		return false, 0, false
+	}
+
+	return false, uint32(epoch), true
+}
+
+func parseShardDirectory(name string) (string, bool) {
+	shardPrefix := common.DefaultShardString + "_"
+	if !strings.HasPrefix(name, shardPrefix) {
+		return "", false
+	}
+
+	shardID := strings.TrimPrefix(name, shardPrefix)
+
+	return shardID, len(shardID) > 0
+}
+
+func isIdentifierSelected(identifier string, identifiers []string) bool {
+	if len(identifiers) == 0 {
+		return true
+	}
+
+	for _, selected := range identifiers {
+		if selected == identifier {
+			return true
+		}
+	}
+
+	return false
+}
+
+func listDirectories(path string) ([]string, error) {
+	entries, err := ioutil.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	directories := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			directories = append(directories, entry.Name())
+		}
+	}
+
+	return directories, nil
+}
+
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+
+	return !info.IsDir()
+}
+
+// DirectorySize returns the total size of the files found under the provided path
+func DirectorySize(path string) (int64, error) {
+	size := int64(0)
+	err := filepath.Walk(path, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			size += info.Size()
+		}
+
+		return nil
+	})
+
+	return size, err
+}
@@ -0,0 +1,262 @@
+package operations
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/compression"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+)
+
+const batchDelaySeconds = 2
+const maxOpenFiles = 10
+
+var log = logger.GetOrCreate("dbtool/operations")
+
+// ArgsMigrateStorer holds the arguments needed to copy a storer to another database type
+type ArgsMigrateStorer struct {
+	Storer            *StorerInfo
+	SourceDBPath      string
+	DestinationDBPath string
+	DestinationDBType storageUnit.DBType
+	BatchSize         int
+	Verify            bool
+}
+
+// MigrationResult holds the outcome of a storer migration. The destination stats are set only if verified
+type MigrationResult struct {
+	SourceStats      *StorerStats
+	DestinationStats *StorerStats
+}
+
+// ValuesMode tells how the values of a database written by a compressing persister are read
+type ValuesMode int
+
+const (
+	// RawValues reads and writes the values as they are stored, so that a compressed database is copied as it is
+	RawValues ValuesMode = iota
+	// DecompressedValues decompresses the values of a compressed database. The values of the other databases are read
+	// as they are stored
+	DecompressedValues
+)
+
+// OpenPersister opens the database of the provided type found at the provided path
+func OpenPersister(path string, dbType storageUnit.DBType, batchSize int, mode ValuesMode) (storage.Persister, error) {
+	persister, err := storageUnit.NewRawDB(storageUnit.ArgDB{
+		DBType:            dbType,
+		Path:              path,
+		BatchDelaySeconds: batchDelaySeconds,
+		MaxBatchSize:      batchSize,
+		MaxOpenFiles:      maxOpenFiles,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if mode == RawValues || !compression.IsCompressedDatabase(persister) {
+		return persister, nil
+	}
+
+	return compression.NewPersisterIfEnabled(compression.ArgsPersister{
+		Persister: persister,
+		Type:      compression.None,
+	})
+}
+
+// MigrateStorer copies all the entries of the storer to a new database of the destination type, found at the same
+// relative path under the destination databases directory. The source is left untouched
+func MigrateStorer(args ArgsMigrateStorer) (*MigrationResult, error) {
+	switch args.DestinationDBType {
+	case storageUnit.LvlDB, storageUnit.LvlDBSerial, storageUnit.BadgerDB:
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedDBType, args.DestinationDBType)
+	}
+
+	sourcePath := filepath.Join(args.SourceDBPath, args.Storer.RelativePath)
+	destinationPath := filepath.Join(args.DestinationDBPath, args.Storer.RelativePath)
+
+	isEmpty, err := isMissingOrEmptyDirectory(destinationPath)
+	if err != nil {
+		return nil, err
+	}
+	if !isEmpty {
+		return nil, fmt.Errorf("%w: %s", ErrDestinationNotEmpty, destinationPath)
+	}
+
+	source, err := OpenPersister(sourcePath, args.Storer.DBType, args.BatchSize, RawValues)
+	if err != nil {
+		return nil, err
+	}
+	defer closePersister(source, sourcePath)
+
+	destination, err := OpenPersister(destinationPath, args.DestinationDBType, args.BatchSize, RawValues)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceStats, err := copyEntries(source, destination)
+	if err != nil {
+		_ = destination.Close()
+		return nil, err
+	}
+
+	// closing writes the last batch
+	err = destination.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &MigrationResult{
+		SourceStats: sourceStats,
+	}
+	if !args.Verify {
+		return result, nil
+	}
+
+	result.DestinationStats, err = computeStatsForPath(destinationPath, args.DestinationDBType)
+	if err != nil {
+		return nil, err
+	}
+	if !sourceStats.Equal(result.DestinationStats) {
+		return nil, fmt.Errorf("%w for %s: source %s, destination %s",
+			ErrStatsMismatch, args.Storer.String(), sourceStats.String(), result.DestinationStats.String())
+	}
+
+	return result, nil
+}
+
+func copyEntries(source storage.Persister, destination storage.Persister) (*StorerStats, error) {
+	accumulator := newStatsAccumulator()
+
+	var errPut error
+	err := RangeEntries(source, func(key []byte, value []byte) bool {
+		errPut = destination.Put(key, value)
+		if errPut != nil {
+			return false
+		}
+
+		accumulator.add(key, value)
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w while reading the source", err)
+	}
+	if errPut != nil {
+		return nil, errPut
+	}
+
+	return accumulator.stats, nil
+}
+
+// RangeEntries calls the handler for each entry of the persister. Unlike RangeKeys, it returns the error which ended
+// the iteration prematurely, so that a corrupted database is not mistaken for a shorter one
+func RangeEntries(persister storage.Persister, handler func(key []byte, value []byte) bool) error {
+	ranger, ok := persister.(errorReportingRanger)
+	if !ok {
+		return fmt.Errorf("%w: %T", ErrIterationErrorsNotReported, persister)
+	}
+
+	return ranger.RangeKeysWithError(handler)
+}
+
+// CompactStorer runs a full compaction of the storer's database and returns its size before and after
+func CompactStorer(storer *StorerInfo, dbPath string) (int64, int64, error) {
+	path := filepath.Join(dbPath, storer.RelativePath)
+	sizeBefore, err := DirectorySize(path)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	persister, err := OpenPersister(path, storer.DBType, 1, RawValues)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	compactablePersister, ok := persister.(compacter)
+	if !ok {
+		closePersister(persister, path)
+		return 0, 0, fmt.Errorf("%w: %s", ErrCompactionNotSupported, storer.DBType)
+	}
+
+	err = compactablePersister.Compact()
+	closePersister(persister, path)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	sizeAfter, err := DirectorySize(path)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return sizeBefore, sizeAfter, nil
+}
+
+// VerifyStorer opens the storer's database, iterating over all its entries, and returns its stats. If a path to
+// another databases directory is provided, the same storer is read from there as well and the stats are compared
+func VerifyStorer(storer *StorerInfo, dbPath string, comparedDBPath string) (*StorerStats, error) {
+	stats, err := computeStatsForPath(filepath.Join(dbPath, storer.RelativePath), storer.DBType)
+	if err != nil {
+		return nil, err
+	}
+	if len(comparedDBPath) == 0 {
+		return stats, nil
+	}
+
+	comparedPath := filepath.Join(comparedDBPath, storer.RelativePath)
+	comparedDBType, err := DetectDBType(comparedPath)
+	if err != nil {
+		return nil, err
+	}
+
+	comparedStats, err := computeStatsForPath(comparedPath, comparedDBType)
+	if err != nil {
+		return nil, err
+	}
+	if !stats.Equal(comparedStats) {
+		return nil, fmt.Errorf("%w for %s: %s, compared %s",
+			ErrStatsMismatch, storer.String(), stats.String(), comparedStats.String())
+	}
+
+	return stats, nil
+}
+
+func computeStatsForPath(path string, dbType storageUnit.DBType) (*StorerStats, error) {
+	persister, err := OpenPersister(path, dbType, 1, RawValues)
+	if err != nil {
+		return nil, err
+	}
+	defer closePersister(persister, path)
+
+	return ComputeStats(persister)
+}
+
+func closePersister(persister storage.Persister, path string) {
+	err := persister.Close()
+	if err != nil {
+		log.Warn("cannot close persister", "path", path, "error", err)
+	}
+}
+
+func isMissingOrEmptyDirectory(path string) (bool, error) {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	_, err = file.Readdirnames(1)
+	if err == io.EOF {
+		return true, nil
+	}
+
+	return false, err
+}
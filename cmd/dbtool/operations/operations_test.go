@@ -0,0 +1,396 @@
+package operations
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/storage/compression"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const numTestEntries = 100
+
+func createStorer(t *testing.T, dbPath string, relativePath string, dbType storageUnit.DBType, numEntries int) {
+	persister, err := OpenPersister(filepath.Join(dbPath, relativePath), dbType, 10, RawValues)
+	require.Nil(t, err)
+
+	for i := 0; i < numEntries; i++ {
+		err = persister.Put([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+		require.Nil(t, err)
+	}
+
+	err = persister.Close()
+	require.Nil(t, err)
+}
+
+func createCompressedStorer(t *testing.T, dbPath string, relativePath string) {
+	persister, err := storageUnit.NewDB(storageUnit.ArgDB{
+		DBType:            storageUnit.LvlDBSerial,
+		Path:              filepath.Join(dbPath, relativePath),
+		BatchDelaySeconds: batchDelaySeconds,
+		MaxBatchSize:      10,
+		MaxOpenFiles:      maxOpenFiles,
+		Compression:       compression.Zstd,
+	})
+	require.Nil(t, err)
+
+	for i := 0; i < numTestEntries; i++ {
+		err = persister.Put([]byte(fmt.Sprintf("key%d", i)), createCompressibleValue(i))
+		require.Nil(t, err)
+	}
+
+	err = persister.Close()
+	require.Nil(t, err)
+}
+
+func createCompressibleValue(index int) []byte {
+	return []byte(strings.Repeat(fmt.Sprintf("value%d", index), 20))
+}
+
+// corruptLevelDBTables overwrites the middle of each table file of the database, so that reading its entries fails
+func corruptLevelDBTables(t *testing.T, path string) {
+	tableFiles, err := filepath.Glob(filepath.Join(path, "*.ldb"))
+	require.Nil(t, err)
+	require.NotEmpty(t, tableFiles)
+
+	for _, tableFile := range tableFiles {
+		content, errRead := os.ReadFile(tableFile)
+		require.Nil(t, errRead)
+		for i := len(content) / 4; i < len(content)/2; i++ {
+			content[i] ^= 0xFF
+		}
+		require.Nil(t, os.WriteFile(tableFile, content, 0600))
+	}
+}
+
+func createTestLayout(t *testing.T, dbType storageUnit.DBType) string {
+	dbPath := t.TempDir()
+	createStorer(t, dbPath, filepath.Join("Epoch_1", "Shard_0", "MiniBlocks"), dbType, numTestEntries)
+	createStorer(t, dbPath, filepath.Join("Epoch_0", "Shard_0", "MiniBlocks"), dbType, numTestEntries)
+	createStorer(t, dbPath, filepath.Join("Static", "Shard_0", "AccountsTrie"), dbType, numTestEntries)
+	createStorer(t, dbPath, filepath.Join("Epoch_0", "Shard_metachain", "MetaBlock"), dbType, numTestEntries)
+	createStorer(t, dbPath, filepath.Join("Epoch_1", "Shard_0", "DbLookupExtensions", "MiniblocksMetadata"), dbType, numTestEntries)
+
+	// not part of the layout
+	require.Nil(t, os.MkdirAll(filepath.Join(dbPath, "Epoch_x", "Shard_0", "MiniBlocks"), os.ModePerm))
+	require.Nil(t, os.MkdirAll(filepath.Join(dbPath, "Epoch_2", "Shard_0", "EmptyDir"), os.ModePerm))
+
+	return dbPath
+}
+
+func TestFindStorers(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing path should error", func(t *testing.T) {
+		t.Parallel()
+
+		storers, err := FindStorers(filepath.Join(t.TempDir(), "missing"), nil)
+		assert.Nil(t, storers)
+		assert.NotNil(t, err)
+	})
+	t.Run("empty path should error", func(t *testing.T) {
+		t.Parallel()
+
+		storers, err := FindStorers(t.TempDir(), nil)
+		assert.Nil(t, storers)
+		assert.True(t, errors.Is(err, ErrNoStorerFound))
+	})
+	t.Run("should find all storers, sorted", func(t *testing.T) {
+		t.Parallel()
+
+		dbPath := createTestLayout(t, storageUnit.LvlDBSerial)
+
+		storers, err := FindStorers(dbPath, nil)
+		require.Nil(t, err)
+		require.Equal(t, 5, len(storers))
+
+		assert.Equal(t, &StorerInfo{
+			IsStatic:     true,
+			ShardID:      "0",
+			Identifier:   "AccountsTrie",
+			RelativePath: filepath.Join("Static", "Shard_0", "AccountsTrie"),
+			DBType:       storageUnit.LvlDB,
+		}, storers[0])
+		assert.Equal(t, filepath.Join("Epoch_0", "Shard_0", "MiniBlocks"), storers[1].RelativePath)
+		assert.Equal(t, filepath.Join("Epoch_0", "Shard_metachain", "MetaBlock"), storers[2].RelativePath)
+		assert.Equal(t, "metachain", storers[2].ShardID)
+		assert.Equal(t, uint32(1), storers[3].Epoch)
+		assert.Equal(t, "DbLookupExtensions/MiniblocksMetadata", storers[3].Identifier)
+		assert.Equal(t, "epoch 1/shard 0/MiniBlocks", storers[4].String())
+	})
+	t.Run("should filter by identifier", func(t *testing.T) {
+		t.Parallel()
+
+		dbPath := createTestLayout(t, storageUnit.BadgerDB)
+
+		storers, err := FindStorers(dbPath, []string{"MiniBlocks"})
+		require.Nil(t, err)
+		require.Equal(t, 2, len(storers))
+		for _, storer := range storers {
+			assert.Equal(t, "MiniBlocks", storer.Identifier)
+			assert.Equal(t, storageUnit.BadgerDB, storer.DBType)
+		}
+	})
+}
+
+func TestDetectDBType(t *testing.T) {
+	t.Parallel()
+
+	dbType, err := DetectDBType(t.TempDir())
+	assert.Empty(t, dbType)
+	assert.True(t, errors.Is(err, ErrUnknownDBType))
+}
+
+func TestMigrateStorer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unsupported destination type should error", func(t *testing.T) {
+		t.Parallel()
+
+		dbPath := createTestLayout(t, storageUnit.LvlDBSerial)
+		storers, err := FindStorers(dbPath, nil)
+		require.Nil(t, err)
+
+		result, err := MigrateStorer(ArgsMigrateStorer{
+			Storer:            storers[0],
+			SourceDBPath:      dbPath,
+			DestinationDBPath: t.TempDir(),
+			DestinationDBType: storageUnit.MemoryDB,
+			BatchSize:         10,
+		})
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, ErrUnsupportedDBType))
+	})
+	t.Run("not empty destination should error", func(t *testing.T) {
+		t.Parallel()
+
+		dbPath := createTestLayout(t, storageUnit.LvlDBSerial)
+		storers, err := FindStorers(dbPath, nil)
+		require.Nil(t, err)
+
+		result, err := MigrateStorer(ArgsMigrateStorer{
+			Storer:            storers[0],
+			SourceDBPath:      dbPath,
+			DestinationDBPath: dbPath,
+			DestinationDBType: storageUnit.BadgerDB,
+			BatchSize:         10,
+		})
+		assert.Nil(t, result)
+		assert.True(t, errors.Is(err, ErrDestinationNotEmpty))
+	})
+	t.Run("corrupted source should error", func(t *testing.T) {
+		t.Parallel()
+
+		dbPath := createTestLayout(t, storageUnit.LvlDBSerial)
+		storers, err := FindStorers(dbPath, []string{"AccountsTrie"})
+		require.Nil(t, err)
+		// the compaction moves the entries from the journal to the table files
+		_, _, err = CompactStorer(storers[0], dbPath)
+		require.Nil(t, err)
+		corruptLevelDBTables(t, filepath.Join(dbPath, storers[0].RelativePath))
+
+		result, err := MigrateStorer(ArgsMigrateStorer{
+			Storer:            storers[0],
+			SourceDBPath:      dbPath,
+			DestinationDBPath: t.TempDir(),
+			DestinationDBType: storageUnit.BadgerDB,
+			BatchSize:         10,
+			Verify:            true,
+		})
+		assert.Nil(t, result)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "while reading the source")
+
+		stats, err := VerifyStorer(storers[0], dbPath, "")
+		assert.Nil(t, stats)
+		assert.NotNil(t, err)
+	})
+	t.Run("compressed storer should be copied as it is", func(t *testing.T) {
+		t.Parallel()
+
+		dbPath := t.TempDir()
+		createCompressedStorer(t, dbPath, filepath.Join("Static", "Shard_0", "AccountsTrie"))
+		destinationPath := t.TempDir()
+
+		storers, err := FindStorers(dbPath, nil)
+		require.Nil(t, err)
+		result, err := MigrateStorer(ArgsMigrateStorer{
+			Storer:            storers[0],
+			SourceDBPath:      dbPath,
+			DestinationDBPath: destinationPath,
+			DestinationDBType: storageUnit.BadgerDB,
+			BatchSize:         7,
+			Verify:            true,
+		})
+		require.Nil(t, err)
+		// the marker of the compressed database is copied along with the entries
+		assert.Equal(t, uint64(numTestEntries+1), result.SourceStats.NumKeys)
+		assert.Equal(t, result.SourceStats, result.DestinationStats)
+
+		destinationStorers, err := FindStorers(destinationPath, nil)
+		require.Nil(t, err)
+		_, _, err = CompactStorer(destinationStorers[0], destinationPath)
+		require.Nil(t, err)
+		_, err = VerifyStorer(destinationStorers[0], destinationPath, dbPath)
+		require.Nil(t, err)
+
+		persister, err := OpenPersister(filepath.Join(destinationPath, destinationStorers[0].RelativePath), storageUnit.BadgerDB, 1, DecompressedValues)
+		require.Nil(t, err)
+		defer closePersister(persister, destinationPath)
+		for i := 0; i < numTestEntries; i++ {
+			value, errGet := persister.Get([]byte(fmt.Sprintf("key%d", i)))
+			require.Nil(t, errGet)
+			assert.Equal(t, createCompressibleValue(i), value)
+		}
+	})
+	t.Run("leveldb to badger and back should work", func(t *testing.T) {
+		t.Parallel()
+
+		dbPath := createTestLayout(t, storageUnit.LvlDBSerial)
+		badgerPath := t.TempDir()
+		levelDBPath := t.TempDir()
+
+		storers, err := FindStorers(dbPath, nil)
+		require.Nil(t, err)
+		for _, storer := range storers {
+			result, errMigrate := MigrateStorer(ArgsMigrateStorer{
+				Storer:            storer,
+				SourceDBPath:      dbPath,
+				DestinationDBPath: badgerPath,
+				DestinationDBType: storageUnit.BadgerDB,
+				BatchSize:         7,
+				Verify:            true,
+			})
+			require.Nil(t, errMigrate)
+			assert.Equal(t, uint64(numTestEntries), result.SourceStats.NumKeys)
+			assert.Equal(t, result.SourceStats, result.DestinationStats)
+		}
+
+		badgerStorers, err := FindStorers(badgerPath, nil)
+		require.Nil(t, err)
+		require.Equal(t, len(storers), len(badgerStorers))
+		for _, storer := range badgerStorers {
+			assert.Equal(t, storageUnit.BadgerDB, storer.DBType)
+
+			result, errMigrate := MigrateStorer(ArgsMigrateStorer{
+				Storer:            storer,
+				SourceDBPath:      badgerPath,
+				DestinationDBPath: levelDBPath,
+				DestinationDBType: storageUnit.LvlDBSerial,
+				BatchSize:         7,
+			})
+			require.Nil(t, errMigrate)
+			assert.Nil(t, result.DestinationStats)
+		}
+
+		levelDBStorers, err := FindStorers(levelDBPath, nil)
+		require.Nil(t, err)
+		require.Equal(t, len(storers), len(levelDBStorers))
+		for _, storer := range levelDBStorers {
+			_, errVerify := VerifyStorer(storer, levelDBPath, dbPath)
+			assert.Nil(t, errVerify)
+		}
+	})
+}
+
+func TestCompactStorer(t *testing.T) {
+	t.Parallel()
+
+	for _, dbType := range []storageUnit.DBType{storageUnit.LvlDBSerial, storageUnit.BadgerDB} {
+		dbType := dbType
+		t.Run(string(dbType), func(t *testing.T) {
+			t.Parallel()
+
+			dbPath := createTestLayout(t, dbType)
+			storers, err := FindStorers(dbPath, []string{"AccountsTrie"})
+			require.Nil(t, err)
+
+			statsBefore, err := VerifyStorer(storers[0], dbPath, "")
+			require.Nil(t, err)
+
+			sizeBefore, sizeAfter, err := CompactStorer(storers[0], dbPath)
+			require.Nil(t, err)
+			assert.True(t, sizeBefore > 0)
+			assert.True(t, sizeAfter > 0)
+
+			statsAfter, err := VerifyStorer(storers[0], dbPath, "")
+			require.Nil(t, err)
+			assert.Equal(t, statsBefore, statsAfter)
+		})
+	}
+}
+
+func TestVerifyStorer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing compared storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		dbPath := createTestLayout(t, storageUnit.LvlDBSerial)
+		storers, err := FindStorers(dbPath, nil)
+		require.Nil(t, err)
+
+		stats, err := VerifyStorer(storers[0], dbPath, t.TempDir())
+		assert.Nil(t, stats)
+		assert.True(t, errors.Is(err, ErrUnknownDBType))
+	})
+	t.Run("different content should error", func(t *testing.T) {
+		t.Parallel()
+
+		dbPath := createTestLayout(t, storageUnit.LvlDBSerial)
+		comparedPath := t.TempDir()
+		storers, err := FindStorers(dbPath, []string{"AccountsTrie"})
+		require.Nil(t, err)
+		createStorer(t, comparedPath, storers[0].RelativePath, storageUnit.BadgerDB, numTestEntries-1)
+
+		stats, err := VerifyStorer(storers[0], dbPath, comparedPath)
+		assert.Nil(t, stats)
+		assert.True(t, errors.Is(err, ErrStatsMismatch))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		dbPath := createTestLayout(t, storageUnit.LvlDBSerial)
+		storers, err := FindStorers(dbPath, nil)
+		require.Nil(t, err)
+
+		stats, err := VerifyStorer(storers[0], dbPath, "")
+		require.Nil(t, err)
+		assert.Equal(t, uint64(numTestEntries), stats.NumKeys)
+		assert.Equal(t, checksumSize, len(stats.Checksum))
+	})
+}
+
+func TestRangeEntries_PersisterNotReportingErrorsShouldError(t *testing.T) {
+	t.Parallel()
+
+	err := RangeEntries(memorydb.New(), func(key []byte, value []byte) bool {
+		return true
+	})
+	assert.True(t, errors.Is(err, ErrIterationErrorsNotReported))
+}
+
+func TestComputeStats_ShouldNotDependOnOrder(t *testing.T) {
+	t.Parallel()
+
+	first := newStatsAccumulator()
+	first.add([]byte("a"), []byte("1"))
+	first.add([]byte("b"), []byte("2"))
+
+	second := newStatsAccumulator()
+	second.add([]byte("b"), []byte("2"))
+	second.add([]byte("a"), []byte("1"))
+	assert.True(t, first.stats.Equal(second.stats))
+
+	moved := newStatsAccumulator()
+	moved.add([]byte("a1"), []byte(""))
+	moved.add([]byte("b"), []byte("2"))
+	assert.False(t, first.stats.Equal(moved.stats))
+}
@@ -0,0 +1,79 @@
+package operations
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const checksumSize = 32
+
+// StorerStats holds the number of entries of a storer and a checksum of its content
+type StorerStats struct {
+	NumKeys  uint64
+	NumBytes uint64
+	Checksum []byte
+}
+
+// String returns a readable representation of the stats
+func (ss *StorerStats) String() string {
+	return fmt.Sprintf("keys: %d, bytes: %d, checksum: %s", ss.NumKeys, ss.NumBytes, hex.EncodeToString(ss.Checksum))
+}
+
+// Equal returns true if both stats describe the same content
+func (ss *StorerStats) Equal(other *StorerStats) bool {
+	return ss.NumKeys == other.NumKeys &&
+		ss.NumBytes == other.NumBytes &&
+		bytes.Equal(ss.Checksum, other.Checksum)
+}
+
+// statsAccumulator computes the stats of a storer entry by entry. The checksum is the XOR of the hashes of all the
+// (key, value) pairs, so it does not depend on the iteration order of the engine
+type statsAccumulator struct {
+	hasher hashing.Hasher
+	stats  *StorerStats
+}
+
+func newStatsAccumulator() *statsAccumulator {
+	return &statsAccumulator{
+		hasher: blake2b.NewBlake2b(),
+		stats: &StorerStats{
+			Checksum: make([]byte, checksumSize),
+		},
+	}
+}
+
+func (sa *statsAccumulator) add(key []byte, value []byte) {
+	// the key length is prepended so that moving bytes between the key and the value changes the hash
+	buff := make([]byte, 4, 4+len(key)+len(value))
+	binary.BigEndian.PutUint32(buff, uint32(len(key)))
+	buff = append(buff, key...)
+	buff = append(buff, value...)
+
+	entryHash := sa.hasher.Compute(string(buff))
+	for i := 0; i < checksumSize && i < len(entryHash); i++ {
+		sa.stats.Checksum[i] ^= entryHash[i]
+	}
+
+	sa.stats.NumKeys++
+	sa.stats.NumBytes += uint64(len(key) + len(value))
+}
+
+// ComputeStats iterates over all the entries of the persister and returns its stats
+func ComputeStats(persister storage.Persister) (*StorerStats, error) {
+	accumulator := newStatsAccumulator()
+	err := RangeEntries(persister, func(key []byte, value []byte) bool {
+		accumulator.add(key, value)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return accumulator.stats, nil
+}
@@ -841,3 +841,30 @@ const (
 
 // MaxIndexOfTxInMiniBlock defines the maximum index of a tx inside one mini block
 const MaxIndexOfTxInMiniBlock = int32(29999)
+
+// TransactionStatusExecuted is the status to be waited for when the final outcome of a transaction is needed, including
+// the completion of its cross-shard smart contract results
+const TransactionStatusExecuted = "executed"
+
+// TransactionsPoolOrderByGasPrice orders the transactions from pool descending by their gas price
+const TransactionsPoolOrderByGasPrice = "gasprice"
+
+// TransactionsPoolOrderByScore orders the transactions from pool descending by the score of their senders, as used by
+// the transactions selection
+const TransactionsPoolOrderByScore = "score"
+
+// ScheduledTxConditionRound releases a scheduled transaction once the given round is reached
+const ScheduledTxConditionRound = "round"
+
+// ScheduledTxConditionEpoch releases a scheduled transaction once the given epoch is reached
+const ScheduledTxConditionEpoch = "epoch"
+
+// ScheduledTxConditionNonce releases a scheduled transaction once the nonce of its sender's account reaches the given value
+const ScheduledTxConditionNonce = "nonce"
+
+// ScheduledTxStatusPending is the status of a scheduled transaction waiting to be sent
+const ScheduledTxStatusPending = "pending"
+
+// ScheduledTxStatusDiscarded is the status of a scheduled transaction which was not sent because it can never become
+// valid, kept in the outbox until it is cancelled
+const ScheduledTxStatusDiscarded = "discarded"
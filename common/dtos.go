@@ -1,5 +1,10 @@
 package common
 
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+)
+
 // GetProofResponse is a struct that stores the response of a GetProof API request
 type GetProofResponse struct {
 	Proof    [][]byte
@@ -14,6 +19,14 @@ type TransactionsPoolAPIResponse struct {
 	Rewards              []Transaction `json:"rewards"`
 }
 
+// TransactionsPoolQueryOptions holds the options used when fetching the transactions from pool
+type TransactionsPoolQueryOptions struct {
+	Fields     string
+	OrderBy    string
+	Receiver   string
+	DataPrefix string
+}
+
 // Transaction is a struct that holds transaction fields to be returned when getting the transactions from pool
 type Transaction struct {
 	TxFields map[string]interface{} `json:"txFields"`
@@ -38,6 +51,54 @@ type TransactionsPoolNonceGapsForSenderApiResponse struct {
 	Gaps   []NonceGapApiResponse `json:"gaps"`
 }
 
+// TxReplacementApiResponse is a struct that holds the details of a transaction replaced by fee
+type TxReplacementApiResponse struct {
+	Nonce               uint64 `json:"nonce"`
+	ReplacedTxHash      string `json:"replacedTxHash"`
+	ReplacedGasPrice    uint64 `json:"replacedGasPrice"`
+	ReplacementTxHash   string `json:"replacementTxHash"`
+	ReplacementGasPrice uint64 `json:"replacementGasPrice"`
+}
+
+// TransactionsPoolReplacementsForSenderApiResponse is a struct that holds the data to be returned when getting the recent replacements by fee from transactions pool for a sender from an API call
+type TransactionsPoolReplacementsForSenderApiResponse struct {
+	Sender       string                     `json:"sender"`
+	Replacements []TxReplacementApiResponse `json:"replacements"`
+}
+
+// TransactionsPoolSenderStatsApiResponse is a struct that holds the data to be returned when getting the statistics of a sender from transactions pool from an API call
+type TransactionsPoolSenderStatsApiResponse struct {
+	Sender              string                `json:"sender"`
+	Score               uint32                `json:"score"`
+	NumTxs              uint64                `json:"numTxs"`
+	NumBytes            uint64                `json:"numBytes"`
+	TotalGas            uint64                `json:"totalGas"`
+	AccountNonce        uint64                `json:"accountNonce"`
+	AccountNonceKnown   bool                  `json:"accountNonceKnown"`
+	NumFailedSelections uint64                `json:"numFailedSelections"`
+	IsInGracePeriod     bool                  `json:"isInGracePeriod"`
+	IsSweepable         bool                  `json:"isSweepable"`
+	NonceGaps           []NonceGapApiResponse `json:"nonceGaps"`
+}
+
+// EvictionRoundApiResponse is a struct that holds the details of an eviction round of the transactions pool
+type EvictionRoundApiResponse struct {
+	Timestamp         int64    `json:"timestamp"`
+	DurationInMillis  int64    `json:"durationInMillis"`
+	NumTxsBefore      uint64   `json:"numTxsBefore"`
+	NumSendersBefore  uint64   `json:"numSendersBefore"`
+	NumBytesBefore    int      `json:"numBytesBefore"`
+	NumTxsEvicted     uint32   `json:"numTxsEvicted"`
+	NumSendersEvicted uint32   `json:"numSendersEvicted"`
+	NumSteps          uint32   `json:"numSteps"`
+	EvictedSenders    []string `json:"evictedSenders"`
+}
+
+// TransactionsPoolEvictionsApiResponse is a struct that holds the data to be returned when getting the recent eviction rounds of the transactions pool from an API call
+type TransactionsPoolEvictionsApiResponse struct {
+	Rounds []EvictionRoundApiResponse `json:"rounds"`
+}
+
 // DelegationDataAPI will be used when requesting the genesis balances from API
 type DelegationDataAPI struct {
 	Address string `json:"address"`
@@ -66,3 +127,137 @@ type EpochStartDataAPI struct {
 	AccumulatedFees   string `json:"accumulatedFees,omitempty"`
 	DeveloperFees     string `json:"developerFees,omitempty"`
 }
+
+// AddressTransactionsQueryOptions holds the pagination options used when fetching the transactions of an address
+type AddressTransactionsQueryOptions struct {
+	From      uint32
+	Size      uint32
+	Ascending bool
+}
+
+// AddressTransactionApiResponse is a struct that holds an entry of the transactions by address index
+type AddressTransactionApiResponse struct {
+	Hash          string `json:"hash"`
+	MiniblockType string `json:"miniblockType"`
+	Direction     string `json:"direction"`
+	BlockNonce    uint64 `json:"blockNonce"`
+	BlockHash     string `json:"blockHash"`
+	Round         uint64 `json:"round"`
+	Epoch         uint32 `json:"epoch"`
+}
+
+// AddressTransactionsApiResponse is a struct that holds the data to be returned when getting the transactions of an address from an API call
+type AddressTransactionsApiResponse struct {
+	Address      string                          `json:"address"`
+	Transactions []AddressTransactionApiResponse `json:"transactions"`
+}
+
+// KeyValuePairsQueryOptions holds the pagination and filtering options used when fetching the key-value pairs of an address
+type KeyValuePairsQueryOptions struct {
+	Prefix            []byte
+	Limit             uint32
+	ContinuationToken string
+}
+
+// KeyValuePairsApiResponse is a struct that holds a page of key-value pairs of an address. An empty continuation token
+// signals that there are no more pairs to be fetched
+type KeyValuePairsApiResponse struct {
+	Pairs             map[string]string `json:"pairs"`
+	ContinuationToken string            `json:"continuationToken,omitempty"`
+}
+
+// ESDTSupplyInEpochApiResponse holds the changes of an ESDT token supply which occurred during an epoch
+type ESDTSupplyInEpochApiResponse struct {
+	Epoch       uint32 `json:"epoch"`
+	SupplyDelta string `json:"supplyDelta"`
+	Minted      string `json:"minted"`
+	Burned      string `json:"burned"`
+}
+
+// ESDTSupplyHistoryApiResponse is a struct that holds the supply changes of an ESDT token, for each epoch in which the
+// supply changed
+type ESDTSupplyHistoryApiResponse struct {
+	Token  string                          `json:"token"`
+	Epochs []*ESDTSupplyInEpochApiResponse `json:"epochs"`
+}
+
+// ESDTHoldersQueryOptions holds the options used when fetching the holders of an ESDT token. An empty root hash
+// selects the current state
+type ESDTHoldersQueryOptions struct {
+	Token    string
+	Nonce    uint64
+	RootHash []byte
+}
+
+// ESDTHolderApiResponse holds the balance of an ESDT token holder
+type ESDTHolderApiResponse struct {
+	Address string `json:"address"`
+	Balance string `json:"balance"`
+}
+
+// ESDTHoldersApiResponse is a struct that holds the holders of an ESDT token at a given state root hash
+type ESDTHoldersApiResponse struct {
+	Token    string                   `json:"token"`
+	Nonce    uint64                   `json:"nonce"`
+	RootHash string                   `json:"rootHash"`
+	Holders  []*ESDTHolderApiResponse `json:"holders"`
+}
+
+// LogEventsQueryOptions holds the filters used when querying the log events index. Empty filters match any event
+type LogEventsQueryOptions struct {
+	Address    string
+	Identifier string
+	Topic      []byte
+	FromNonce  uint64
+	ToNonce    uint64
+}
+
+// LogEventApiResponse is a struct that holds a log event matching a log events query
+type LogEventApiResponse struct {
+	TxHash     string   `json:"txHash"`
+	BlockNonce uint64   `json:"blockNonce"`
+	BlockHash  string   `json:"blockHash"`
+	Round      uint64   `json:"round"`
+	Epoch      uint32   `json:"epoch"`
+	Order      uint32   `json:"order"`
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     [][]byte `json:"topics"`
+	Data       []byte   `json:"data"`
+}
+
+// LogEventsApiResponse is a struct that holds the data to be returned when querying the log events from an API call
+type LogEventsApiResponse struct {
+	FromNonce uint64                 `json:"fromNonce"`
+	ToNonce   uint64                 `json:"toNonce"`
+	Events    []*LogEventApiResponse `json:"events"`
+}
+
+// ApiHyperblock is a struct that holds a metachain block, the shard blocks notarized by it and their transactions
+type ApiHyperblock struct {
+	MetaBlock    *api.Block                          `json:"metaBlock"`
+	ShardBlocks  []*api.Block                        `json:"shardBlocks"`
+	Transactions []*transaction.ApiTransactionResult `json:"transactions"`
+	// IncompleteMiniBlocks holds the hashes of the shard miniblocks whose bodies are not available in the local storage
+	IncompleteMiniBlocks []string `json:"incompleteMiniBlocks,omitempty"`
+}
+
+// TransactionStatusWaitApiResponse is a struct that holds the data to be returned when waiting for a transaction status
+// from an API call. The status is not reached if the wait timed out
+type TransactionStatusWaitApiResponse struct {
+	Transaction   *transaction.ApiTransactionResult `json:"transaction"`
+	StatusReached bool                              `json:"statusReached"`
+}
+
+// ScheduledTransactionApiResponse is a struct that holds the data to be returned when listing the scheduled transactions from an API call
+type ScheduledTransactionApiResponse struct {
+	TxHash         string `json:"txHash"`
+	Sender         string `json:"sender"`
+	Receiver       string `json:"receiver"`
+	Nonce          uint64 `json:"nonce"`
+	Condition      string `json:"condition"`
+	ConditionValue uint64 `json:"conditionValue"`
+	ScheduledAt    int64  `json:"scheduledAt"`
+	Status         string `json:"status"`
+	DiscardReason  string `json:"discardReason,omitempty"`
+}
@@ -35,6 +35,7 @@ type Trie interface {
 	GetSerializedNode([]byte) ([]byte, error)
 	GetNumNodes() NumNodesDTO
 	GetAllLeavesOnChannel(leavesChannel chan core.KeyValueHolder, ctx context.Context, rootHash []byte) error
+	GetAllLeavesFromKeyOnChannel(leavesChannel chan core.KeyValueHolder, ctx context.Context, rootHash []byte, startKey []byte) error
 	GetAllHashes() ([][]byte, error)
 	GetProof(key []byte) ([][]byte, []byte, error)
 	VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error)
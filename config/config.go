@@ -2,13 +2,14 @@ package config
 
 // CacheConfig will map the cache configuration
 type CacheConfig struct {
-	Name                 string
-	Type                 string
-	Capacity             uint32
-	SizePerSender        uint32
-	SizeInBytes          uint64
-	SizeInBytesPerSender uint32
-	Shards               uint32
+	Name                               string
+	Type                               string
+	Capacity                           uint32
+	SizePerSender                      uint32
+	SizeInBytes                        uint64
+	SizeInBytesPerSender               uint32
+	Shards                             uint32
+	ReplaceByFeeMinGasPriceBumpPercent uint32
 }
 
 // HeadersPoolConfig will map the headers cache configuration
@@ -25,6 +26,7 @@ type DBConfig struct {
 	MaxBatchSize      int
 	MaxOpenFiles      int
 	UseTmpAsFilePath  bool
+	Compression       string
 }
 
 // StorageConfig will map the storage unit configuration
@@ -41,6 +43,22 @@ type TrieSyncStorageConfig struct {
 	DB          DBConfig
 }
 
+// TxPoolJournalConfig will map the transactions pool journal configuration
+type TxPoolJournalConfig struct {
+	Enabled                     bool
+	FlushIntervalInMilliseconds uint32
+	DB                          DBConfig
+}
+
+// ScheduledTxsOutboxConfig will map the configuration of the node-local outbox holding the transactions to be sent once
+// a round, an epoch or an account nonce is reached
+type ScheduledTxsOutboxConfig struct {
+	Enabled                     bool
+	MaxNumTransactions          uint32
+	CheckIntervalInMilliseconds uint32
+	DB                          DBConfig
+}
+
 // PubkeyConfig will map the public key configuration
 type PubkeyConfig struct {
 	Length          int
@@ -159,6 +177,8 @@ type Config struct {
 	TxBlockBodyDataPool         CacheConfig
 	PeerBlockBodyDataPool       CacheConfig
 	TxDataPool                  CacheConfig
+	TxPoolJournal               TxPoolJournalConfig
+	ScheduledTxsOutbox          ScheduledTxsOutboxConfig
 	UnsignedTransactionDataPool CacheConfig
 	RewardTransactionDataPool   CacheConfig
 	TrieNodesChunksDataPool     CacheConfig
@@ -209,10 +229,23 @@ type Config struct {
 	TrieSync              TrieSyncConfig
 	Resolvers             ResolverConfig
 	VMOutputCacher        CacheConfig
+	GasEstimation         GasEstimationConfig
 
 	PeersRatingConfig PeersRatingConfig
 }
 
+// GasEstimationConfig will hold the settings used when estimating the gas of the transactions executed in more shards
+type GasEstimationConfig struct {
+	RequestTimeoutInSec int
+	ShardsObservers     []ShardObserverConfig
+}
+
+// ShardObserverConfig will hold the REST API address of an observer of a shard
+type ShardObserverConfig struct {
+	ShardID string
+	URL     string
+}
+
 // PeersRatingConfig will hold settings related to peers rating
 type PeersRatingConfig struct {
 	TopRatedCacheCapacity int
@@ -457,6 +490,13 @@ type DbLookupExtensionsConfig struct {
 	ResultsHashesByTxHashStorageConfig StorageConfig
 	ESDTSuppliesStorageConfig          StorageConfig
 	RoundHashStorageConfig             StorageConfig
+	TxHashesByAddressEnabled           bool
+	TxHashesByAddressStorageConfig     StorageConfig
+	EpochByAddressStorageConfig        StorageConfig
+	LogEventsIndexEnabled              bool
+	LogEventsStorageConfig             StorageConfig
+	StateDiffsIndexEnabled             bool
+	StateDiffsStorageConfig            StorageConfig
 }
 
 // DebugConfig will hold debugging configuration
@@ -511,6 +551,8 @@ type EpochStartDebugConfig struct {
 // ApiRoutesConfig holds the configuration related to Rest API routes
 type ApiRoutesConfig struct {
 	Logging     ApiLoggingConfig
+	TLS         ApiTLSConfig
+	Auth        ApiAuthConfig
 	APIPackages map[string]APIPackageConfig
 }
 
@@ -520,6 +562,32 @@ type ApiLoggingConfig struct {
 	ThresholdInMicroSeconds int
 }
 
+// ApiTLSConfig holds the configuration related to serving the REST API over TLS
+type ApiTLSConfig struct {
+	Enabled                  bool
+	CertificateFile          string
+	KeyFile                  string
+	ReloadIntervalInSec      uint32
+	ClientCAFile             string
+	RequireClientCertificate bool
+}
+
+// ApiAuthConfig holds the configuration related to the API clients authentication
+type ApiAuthConfig struct {
+	Enabled                 bool
+	JWTSecret               string
+	QuotaResetIntervalInSec uint32
+	Clients                 []ApiClientConfig
+}
+
+// ApiClientConfig holds the credentials, the quota and the allowed routes of an API client
+type ApiClientConfig struct {
+	Name                   string
+	APIKey                 string
+	MaxRequestsPerInterval uint32
+	AllowedRoutes          []string
+}
+
 // APIPackageConfig holds the configuration for the routes of each package
 type APIPackageConfig struct {
 	Routes []RouteConfig
@@ -529,6 +597,7 @@ type APIPackageConfig struct {
 type RouteConfig struct {
 	Name string
 	Open bool
+	Auth bool
 }
 
 // VersionByEpochs represents a version entry that will be applied between the provided epochs
@@ -2,9 +2,10 @@ package config
 
 // ExternalConfig will hold the configurations for external tools, such as Explorer or Elastic Search
 type ExternalConfig struct {
-	ElasticSearchConnector ElasticSearchConfig
-	EventNotifierConnector EventNotifierConfig
-	CovalentConnector      CovalentConfig
+	ElasticSearchConnector   ElasticSearchConfig
+	EventNotifierConnector   EventNotifierConfig
+	CovalentConnector        CovalentConfig
+	WebSocketEventsConnector WebSocketEventsConfig
 }
 
 // ElasticSearchConfig will hold the configuration for the elastic search
@@ -28,6 +29,16 @@ type EventNotifierConfig struct {
 	Password         string
 }
 
+// WebSocketEventsConfig will hold the configuration for the websocket events driver
+type WebSocketEventsConfig struct {
+	Enabled                  bool
+	MaxSubscribers           int
+	SendBufferSize           int
+	SubscriptionTimeoutInSec int
+	WriteTimeoutInSec        int
+	PongTimeoutInSec         int
+}
+
 // CovalentConfig will hold the configurations for covalent indexer
 type CovalentConfig struct {
 	Enabled              bool
@@ -383,11 +383,24 @@ func TestAPIRoutesToml(t *testing.T) {
 			LoggingEnabled:          true,
 			ThresholdInMicroSeconds: loggingThreshold,
 		},
+		Auth: ApiAuthConfig{
+			Enabled:                 true,
+			JWTSecret:               "secret",
+			QuotaResetIntervalInSec: 60,
+			Clients: []ApiClientConfig{
+				{
+					Name:                   "client0",
+					APIKey:                 "key0",
+					MaxRequestsPerInterval: 100,
+					AllowedRoutes:          []string{"/" + package0 + "/" + route1},
+				},
+			},
+		},
 		APIPackages: map[string]APIPackageConfig{
 			package0: {
 				Routes: []RouteConfig{
 					{Name: route0, Open: true},
-					{Name: route1, Open: true},
+					{Name: route1, Open: true, Auth: true},
 				},
 			},
 			package1: {
@@ -403,6 +416,14 @@ func TestAPIRoutesToml(t *testing.T) {
     LoggingEnabled = true
     ThresholdInMicroSeconds = 10
 
+[Auth]
+    Enabled = true
+    JWTSecret = "secret"
+    QuotaResetIntervalInSec = 60
+    Clients = [
+        { Name = "client0", APIKey = "key0", MaxRequestsPerInterval = 100, AllowedRoutes = ["/` + package0 + `/` + route1 + `"] },
+    ]
+
      # API routes configuration
 [APIPackages]
 
@@ -412,7 +433,7 @@ func TestAPIRoutesToml(t *testing.T) {
         { Name = "` + route0 + `", Open = true },
 
         # test comment
-        { Name = "` + route1 + `", Open = true },
+        { Name = "` + route1 + `", Open = true, Auth = true },
 	]
 
 [APIPackages.` + package1 + `]
@@ -1,6 +1,8 @@
 package dataPool
 
 import (
+	"io"
+
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
@@ -160,6 +162,16 @@ func (dp *dataPool) Heartbeats() storage.Cacher {
 // Close closes all the components
 func (dp *dataPool) Close() error {
 	var lastError error
+	txPoolCloser, ok := dp.transactions.(io.Closer)
+	if ok {
+		log.Debug("closing transactions data pool....")
+		err := txPoolCloser.Close()
+		if err != nil {
+			log.Error("failed to close transactions data pool", "error", err.Error())
+			lastError = err
+		}
+	}
+
 	if !check.IfNil(dp.trieNodes) {
 		log.Debug("closing trie nodes data pool....")
 		err := dp.trieNodes.Close()
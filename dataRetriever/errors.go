@@ -247,3 +247,15 @@ var ErrNilPayloadValidator = errors.New("nil payload validator")
 
 // ErrWrongTypeAssertion signals that an type assertion failed
 var ErrWrongTypeAssertion = errors.New("wrong type assertion")
+
+// ErrNilPersister signals that a nil persister was provided
+var ErrNilPersister = errors.New("nil persister")
+
+// ErrNilTxPoolJournal signals that a nil transactions pool journal was provided
+var ErrNilTxPoolJournal = errors.New("nil transactions pool journal")
+
+// ErrInvalidTxPoolJournalFlushInterval signals that an invalid flush interval was provided for the transactions pool journal
+var ErrInvalidTxPoolJournalFlushInterval = errors.New("invalid transactions pool journal flush interval")
+
+// ErrNilAccountsAdapter signals that a nil accounts adapter was provided
+var ErrNilAccountsAdapter = errors.New("nil accounts adapter")
@@ -62,11 +62,17 @@ func NewDataPoolFromConfig(args ArgsDataPool) (dataRetriever.PoolsHolder, error)
 
 	mainConfig := args.Config
 
+	txPoolJournal, err := createTxPoolJournal(args)
+	if err != nil {
+		return nil, err
+	}
+
 	txPool, err := txpool.NewShardedTxPool(txpool.ArgShardedTxPool{
 		Config:         factory.GetCacherFromConfig(mainConfig.TxDataPool),
 		NumberOfShards: args.ShardCoordinator.NumberOfShards(),
 		SelfShardID:    args.ShardCoordinator.SelfId(),
 		TxGasHandler:   args.EconomicsData,
+		Journal:        txPoolJournal,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("%w while creating the cache for the transactions", err)
@@ -162,6 +168,39 @@ func NewDataPoolFromConfig(args ArgsDataPool) (dataRetriever.PoolsHolder, error)
 	return dataPool.NewDataPool(dataPoolArgs)
 }
 
+func createTxPoolJournal(args ArgsDataPool) (txpool.TxPoolJournal, error) {
+	journalConfig := args.Config.TxPoolJournal
+	if !journalConfig.Enabled {
+		log.Debug("no journal for the transactions pool")
+		return txpool.NewDisabledTxPoolJournal(), nil
+	}
+
+	dbCfg := factory.GetDBFromConfig(journalConfig.DB)
+	shardId := core.GetShardIDString(args.ShardCoordinator.SelfId())
+	db, err := storageUnit.NewDB(storageUnit.ArgDB{
+		DBType:            dbCfg.Type,
+		Path:              args.PathManager.PathForStatic(shardId, journalConfig.DB.FilePath),
+		BatchDelaySeconds: dbCfg.BatchDelaySeconds,
+		MaxBatchSize:      dbCfg.MaxBatchSize,
+		MaxOpenFiles:      dbCfg.MaxOpenFiles,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating the db for the transactions pool journal", err)
+	}
+
+	journal, err := txpool.NewTxPoolJournal(txpool.ArgsTxPoolJournal{
+		Persister:     db,
+		Marshalizer:   args.Marshalizer,
+		FlushInterval: time.Duration(journalConfig.FlushIntervalInMilliseconds) * time.Millisecond,
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("%w while creating the transactions pool journal", err)
+	}
+
+	return journal, nil
+}
+
 func createTrieSyncDB(args ArgsDataPool) (storage.Persister, error) {
 	mainConfig := args.Config
 
@@ -6,9 +6,11 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/dataPool/headersCache"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever/mock"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/txpool"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/ElrondNetwork/elrond-go/testscommon/economicsmocks"
@@ -22,6 +24,22 @@ func TestNewDataPoolFromConfig(t *testing.T) {
 	require.NotNil(t, holder)
 }
 
+func TestNewDataPoolFromConfig_TxPoolJournalEnabled(t *testing.T) {
+	args := getGoodArgs()
+	args.Config.TxPoolJournal = config.TxPoolJournalConfig{
+		Enabled:                     true,
+		FlushIntervalInMilliseconds: 1000,
+		DB: config.DBConfig{
+			Type: "MemoryDB",
+		},
+	}
+	holder, err := NewDataPoolFromConfig(args)
+	require.Nil(t, err)
+	require.NotNil(t, holder)
+	require.Implements(t, (*txpool.JournalReplayer)(nil), holder.Transactions())
+	require.Nil(t, holder.Close())
+}
+
 func TestNewDataPoolFromConfig_MissingDependencyShouldErr(t *testing.T) {
 	args := getGoodArgs()
 	args.Config = nil
@@ -113,6 +131,24 @@ func TestNewDataPoolFromConfig_BadConfigShouldErr(t *testing.T) {
 	require.True(t, errors.Is(err, storage.ErrNotSupportedDBType))
 	require.True(t, strings.Contains(err.Error(), "the db for the trie nodes"))
 
+	args = getGoodArgs()
+	args.Config.TxPoolJournal.Enabled = true
+	args.Config.TxPoolJournal.DB.Type = "invalid DB type"
+	holder, err = NewDataPoolFromConfig(args)
+	require.Nil(t, holder)
+	fmt.Println(err)
+	require.True(t, errors.Is(err, storage.ErrNotSupportedDBType))
+	require.True(t, strings.Contains(err.Error(), "the db for the transactions pool journal"))
+
+	args = getGoodArgs()
+	args.Config.TxPoolJournal.Enabled = true
+	args.Config.TxPoolJournal.DB.Type = "MemoryDB"
+	args.Config.TxPoolJournal.FlushIntervalInMilliseconds = 0
+	holder, err = NewDataPoolFromConfig(args)
+	require.Nil(t, holder)
+	fmt.Println(err)
+	require.True(t, errors.Is(err, dataRetriever.ErrInvalidTxPoolJournalFlushInterval))
+
 	args = getGoodArgs()
 	args.Config.TrieNodesChunksDataPool.Type = "invalid cache type"
 	holder, err = NewDataPoolFromConfig(args)
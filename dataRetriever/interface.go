@@ -105,6 +105,14 @@ const (
 	PeerAccountsCheckpointsUnit UnitType = 23
 	// ScheduledSCRsUnit is the scheduled SCRs storage unit identifier
 	ScheduledSCRsUnit UnitType = 24
+	// TxHashesByAddressUnit is the transactions hashes by address storage unit identifier
+	TxHashesByAddressUnit UnitType = 25
+	// LogEventsUnit is the log events by block nonce storage unit identifier
+	LogEventsUnit UnitType = 26
+	// StateDiffsUnit is the state diffs by transaction hash storage unit identifier
+	StateDiffsUnit UnitType = 27
+	// EpochByAddressUnit is the latest epoch by address storage unit identifier
+	EpochByAddressUnit UnitType = 28
 
 	// ShardHdrNonceHashDataUnit is the header nonce-hash pair data unit identifier
 	// TODO: Add only unit types lower than 100
@@ -16,6 +16,7 @@ type ArgShardedTxPool struct {
 	TxGasHandler   txcache.TxGasHandler
 	NumberOfShards uint32
 	SelfShardID    uint32
+	Journal        TxPoolJournal
 }
 
 // TODO: Upon further analysis and brainstorming, add some sensible minimum accepted values for the appropriate fields.
@@ -46,6 +47,9 @@ func (args *ArgShardedTxPool) verify() error {
 	if args.NumberOfShards == 0 {
 		return fmt.Errorf("%w: NumberOfShards is not valid", dataRetriever.ErrCacheConfigInvalidSharding)
 	}
+	if check.IfNil(args.Journal) {
+		return fmt.Errorf("%w: Journal is not valid", dataRetriever.ErrNilTxPoolJournal)
+	}
 
 	return nil
 }
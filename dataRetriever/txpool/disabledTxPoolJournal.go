@@ -0,0 +1,38 @@
+package txpool
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+)
+
+var _ TxPoolJournal = (*disabledTxPoolJournal)(nil)
+
+type disabledTxPoolJournal struct {
+}
+
+// NewDisabledTxPoolJournal creates a transactions pool journal which does not record anything
+func NewDisabledTxPoolJournal() *disabledTxPoolJournal {
+	return &disabledTxPoolJournal{}
+}
+
+// RecordAdded does nothing
+func (journal *disabledTxPoolJournal) RecordAdded(_ []byte, _ data.TransactionHandler, _ string) {
+}
+
+// RecordRemoved does nothing
+func (journal *disabledTxPoolJournal) RecordRemoved(_ []byte) {
+}
+
+// ForEachEntry does nothing
+func (journal *disabledTxPoolJournal) ForEachEntry(_ func(txHash []byte, tx *transaction.Transaction, sizeInBytes int, cacheID string)) {
+}
+
+// Close returns nil
+func (journal *disabledTxPoolJournal) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (journal *disabledTxPoolJournal) IsInterfaceNil() bool {
+	return journal == nil
+}
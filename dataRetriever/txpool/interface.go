@@ -1,6 +1,9 @@
 package txpool
 
 import (
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 )
@@ -17,3 +20,18 @@ type txCache interface {
 	Diagnose(deep bool)
 	GetTransactionsPoolForSender(sender string) []*txcache.WrappedTransaction
 }
+
+// TxPoolJournal defines the behavior of a journal recording the transactions admitted in the pool
+type TxPoolJournal interface {
+	RecordAdded(txHash []byte, tx data.TransactionHandler, cacheID string)
+	RecordRemoved(txHash []byte)
+	ForEachEntry(handler func(txHash []byte, tx *transaction.Transaction, sizeInBytes int, cacheID string))
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// JournalReplayer defines the behavior of a transactions pool able to replay its journal
+type JournalReplayer interface {
+	ReplayJournal(accounts state.AccountsAdapter) error
+	IsInterfaceNil() bool
+}
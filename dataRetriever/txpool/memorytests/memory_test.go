@@ -118,6 +118,7 @@ func newPool() dataRetriever.ShardedDataCacherNotifier {
 		},
 		NumberOfShards: 2,
 		SelfShardID:    0,
+		Journal:        txpool.NewDisabledTxPoolJournal(),
 	}
 	pool, err := txpool.NewShardedTxPool(args)
 	if err != nil {
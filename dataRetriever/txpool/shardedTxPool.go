@@ -5,16 +5,20 @@ import (
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/core/counting"
 	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 )
 
 var _ dataRetriever.ShardedDataCacherNotifier = (*shardedTxPool)(nil)
+var _ JournalReplayer = (*shardedTxPool)(nil)
 
 var log = logger.GetOrCreate("txpool")
 
@@ -28,6 +32,7 @@ type shardedTxPool struct {
 	configPrototypeSourceMe      txcache.ConfigSourceMe
 	selfShardID                  uint32
 	txGasHandler                 txcache.TxGasHandler
+	journal                      TxPoolJournal
 }
 
 type txPoolShard struct {
@@ -49,13 +54,14 @@ func NewShardedTxPool(args ArgShardedTxPool) (*shardedTxPool, error) {
 	halfOfCapacity := args.Config.Capacity / 2
 
 	configPrototypeSourceMe := txcache.ConfigSourceMe{
-		NumChunks:                     args.Config.Shards,
-		EvictionEnabled:               true,
-		NumBytesThreshold:             uint32(halfOfSizeInBytes),
-		CountThreshold:                halfOfCapacity,
-		NumBytesPerSenderThreshold:    args.Config.SizeInBytesPerSender,
-		CountPerSenderThreshold:       args.Config.SizePerSender,
-		NumSendersToPreemptivelyEvict: dataRetriever.TxPoolNumSendersToPreemptivelyEvict,
+		NumChunks:                          args.Config.Shards,
+		EvictionEnabled:                    true,
+		NumBytesThreshold:                  uint32(halfOfSizeInBytes),
+		CountThreshold:                     halfOfCapacity,
+		NumBytesPerSenderThreshold:         args.Config.SizeInBytesPerSender,
+		CountPerSenderThreshold:            args.Config.SizePerSender,
+		NumSendersToPreemptivelyEvict:      dataRetriever.TxPoolNumSendersToPreemptivelyEvict,
+		ReplaceByFeeMinGasPriceBumpPercent: args.Config.ReplaceByFeeMinGasPriceBumpPercent,
 	}
 
 	// We do not reserve cross tx cache capacity for [metachain] -> [me] (no transactions), [me] -> me (already reserved above).
@@ -78,6 +84,7 @@ func NewShardedTxPool(args ArgShardedTxPool) (*shardedTxPool, error) {
 		configPrototypeSourceMe:      configPrototypeSourceMe,
 		selfShardID:                  args.SelfShardID,
 		txGasHandler:                 args.TxGasHandler,
+		journal:                      args.Journal,
 	}
 
 	return shardedTxPoolObject, nil
@@ -140,6 +147,8 @@ func (txPool *shardedTxPool) createTxCache(cacheID string) txCache {
 			return txcache.NewDisabledCache()
 		}
 
+		cache.RegisterOnTxReplaced(txPool.onTxReplaced)
+		cache.RegisterOnTxsEvicted(txPool.onTxsEvicted)
 		return cache
 	}
 
@@ -185,15 +194,33 @@ func (txPool *shardedTxPool) AddData(key []byte, value interface{}, sizeInBytes
 }
 
 // addTx adds the transaction to the cache
+// All the transactions sent from the self shard are held by the same cache, whatever their destination shard (see
+// routeToCacheUnions), so the replacement by fee also applies between transactions having different destination
+// shards, such as a self transfer cancelling a pending cross shard transfer
 func (txPool *shardedTxPool) addTx(tx *txcache.WrappedTransaction, cacheID string) {
 	shard := txPool.getOrCreateShard(cacheID)
 	cache := shard.Cache
 	_, added := cache.AddTx(tx)
 	if added {
+		txPool.recordAddedInJournal(tx, cacheID, cache)
 		txPool.onAdded(tx.TxHash, tx)
 	}
 }
 
+// recordAddedInJournal records the transactions sent from the self shard, as they are the ones which can be validated
+// against the accounts nonces when replaying the journal
+func (txPool *shardedTxPool) recordAddedInJournal(tx *txcache.WrappedTransaction, cacheID string, cache txCache) {
+	if tx.SenderShardID != txPool.selfShardID {
+		return
+	}
+	// the transaction might have been evicted right away, due to the sender constraints of the cache
+	if !cache.Has(tx.TxHash) {
+		return
+	}
+
+	txPool.journal.RecordAdded(tx.TxHash, tx.Tx, cacheID)
+}
+
 func (txPool *shardedTxPool) onAdded(key []byte, value interface{}) {
 	txPool.mutexAddCallbacks.RLock()
 	defer txPool.mutexAddCallbacks.RUnlock()
@@ -203,6 +230,17 @@ func (txPool *shardedTxPool) onAdded(key []byte, value interface{}) {
 	}
 }
 
+// onTxReplaced removes the transaction replaced by fee from all the shards of the pool
+func (txPool *shardedTxPool) onTxReplaced(replacement *txcache.TxReplacement) {
+	txPool.removeTxFromAllShards(replacement.ReplacedTxHash)
+}
+
+func (txPool *shardedTxPool) onTxsEvicted(txHashes [][]byte) {
+	for _, txHash := range txHashes {
+		txPool.journal.RecordRemoved(txHash)
+	}
+}
+
 // SearchFirstData searches the transaction against all shard data store, retrieving the first found
 func (txPool *shardedTxPool) SearchFirstData(key []byte) (interface{}, bool) {
 	tx, ok := txPool.searchFirstTx(key)
@@ -234,7 +272,12 @@ func (txPool *shardedTxPool) RemoveData(key []byte, cacheID string) {
 // removeTx removes the transaction from the pool
 func (txPool *shardedTxPool) removeTx(txHash []byte, cacheID string) bool {
 	shard := txPool.getOrCreateShard(cacheID)
-	return shard.Cache.RemoveTxByHash(txHash)
+	removed := shard.Cache.RemoveTxByHash(txHash)
+	if removed {
+		txPool.journal.RecordRemoved(txHash)
+	}
+
+	return removed
 }
 
 // RemoveSetOfDataFromPool removes a bunch of transactions from the pool
@@ -268,6 +311,8 @@ func (txPool *shardedTxPool) removeTxFromAllShards(txHash []byte) {
 		cache := shard.Cache
 		_ = cache.RemoveTxByHash(txHash)
 	}
+
+	txPool.journal.RecordRemoved(txHash)
 }
 
 // MergeShardStores merges two shards of the pool
@@ -290,6 +335,9 @@ func (txPool *shardedTxPool) MergeShardStores(sourceCacheID, destCacheID string)
 // Clear clears everything in the pool
 func (txPool *shardedTxPool) Clear() {
 	txPool.mutexBackingMap.Lock()
+	for _, shard := range txPool.backingMap {
+		txPool.onTxsEvicted(shard.Cache.Keys())
+	}
 	txPool.backingMap = make(map[string]*txPoolShard)
 	txPool.mutexBackingMap.Unlock()
 }
@@ -297,6 +345,7 @@ func (txPool *shardedTxPool) Clear() {
 // ClearShardStore clears a specific cache
 func (txPool *shardedTxPool) ClearShardStore(cacheID string) {
 	shard := txPool.getOrCreateShard(cacheID)
+	txPool.onTxsEvicted(shard.Cache.Keys())
 	shard.Cache.Clear()
 }
 
@@ -353,6 +402,61 @@ func (txPool *shardedTxPool) Diagnose(deep bool) {
 	}
 }
 
+// ReplayJournal adds back in the pool the journaled transactions which are still valid against the accounts nonces. The
+// provided accounts should reflect the last committed block, so the journal must be replayed after the bootstrapper has
+// loaded the state from the storage. The other transactions are removed from the journal. The journal can be replayed
+// while transactions are added and removed by the interceptors and by the processed blocks, as the replayed
+// transactions are added through AddData, like the intercepted ones
+func (txPool *shardedTxPool) ReplayJournal(accounts state.AccountsAdapter) error {
+	if check.IfNil(accounts) {
+		return dataRetriever.ErrNilAccountsAdapter
+	}
+
+	numReplayed := 0
+	numDropped := 0
+	txPool.journal.ForEachEntry(func(txHash []byte, tx *transaction.Transaction, sizeInBytes int, cacheID string) {
+		if !txPool.isJournaledTxStillValid(accounts, tx, cacheID) {
+			txPool.journal.RecordRemoved(txHash)
+			numDropped++
+			return
+		}
+
+		txPool.AddData(txHash, tx, sizeInBytes, cacheID)
+		_, added := txPool.searchFirstTx(txHash)
+		if !added {
+			// e.g. outbid by an intercepted transaction having the same sender and nonce
+			txPool.journal.RecordRemoved(txHash)
+			numDropped++
+			return
+		}
+
+		numReplayed++
+	})
+
+	log.Debug("shardedTxPool.ReplayJournal()", "num replayed", numReplayed, "num dropped", numDropped)
+
+	return nil
+}
+
+func (txPool *shardedTxPool) isJournaledTxStillValid(accounts state.AccountsAdapter, tx *transaction.Transaction, cacheID string) bool {
+	sourceShardID, _, err := process.ParseShardCacherIdentifier(cacheID)
+	if err != nil || sourceShardID != txPool.selfShardID {
+		return false
+	}
+
+	account, err := accounts.GetExistingAccount(tx.SndAddr)
+	if err != nil {
+		return false
+	}
+
+	return tx.Nonce >= account.GetNonce()
+}
+
+// Close flushes and closes the journal of the pool
+func (txPool *shardedTxPool) Close() error {
+	return txPool.journal.Close()
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (txPool *shardedTxPool) IsInterfaceNil() bool {
 	return txPool == nil
@@ -1,6 +1,7 @@
 package txpool
 
 import (
+	"errors"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -12,7 +13,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
 	"github.com/ElrondNetwork/elrond-go/testscommon/txcachemocks"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/stretchr/testify/require"
 )
 
@@ -39,6 +42,7 @@ func Test_NewShardedTxPool_WhenBadConfig(t *testing.T) {
 			GasProcessingDivisor: 100,
 		},
 		NumberOfShards: 1,
+		Journal:        NewDisabledTxPoolJournal(),
 	}
 
 	args := goodArgs
@@ -96,7 +100,7 @@ func Test_NewShardedTxPool_WhenBadConfig(t *testing.T) {
 }
 
 func Test_NewShardedTxPool_ComputesCacheConfig(t *testing.T) {
-	config := storageUnit.CacheConfig{SizeInBytes: 419430400, SizeInBytesPerSender: 614400, Capacity: 600000, SizePerSender: 1000, Shards: 1}
+	config := storageUnit.CacheConfig{SizeInBytes: 419430400, SizeInBytesPerSender: 614400, Capacity: 600000, SizePerSender: 1000, Shards: 1, ReplaceByFeeMinGasPriceBumpPercent: 10}
 	args := ArgShardedTxPool{
 		Config: config,
 		TxGasHandler: &txcachemocks.TxGasHandlerMock{
@@ -105,6 +109,7 @@ func Test_NewShardedTxPool_ComputesCacheConfig(t *testing.T) {
 			GasProcessingDivisor: 1,
 		},
 		NumberOfShards: 2,
+		Journal:        NewDisabledTxPoolJournal(),
 	}
 
 	pool, err := NewShardedTxPool(args)
@@ -116,6 +121,7 @@ func Test_NewShardedTxPool_ComputesCacheConfig(t *testing.T) {
 	require.Equal(t, 1000, int(pool.configPrototypeSourceMe.CountPerSenderThreshold))
 	require.Equal(t, 100, int(pool.configPrototypeSourceMe.NumSendersToPreemptivelyEvict))
 	require.Equal(t, 300000, int(pool.configPrototypeSourceMe.CountThreshold))
+	require.Equal(t, 10, int(pool.configPrototypeSourceMe.ReplaceByFeeMinGasPriceBumpPercent))
 
 	require.Equal(t, 300000, int(pool.configPrototypeDestinationMe.MaxNumItems))
 	require.Equal(t, 209715200, int(pool.configPrototypeDestinationMe.MaxNumBytes))
@@ -270,6 +276,45 @@ func Test_RemoveDataFromAllShards(t *testing.T) {
 	require.Zero(t, pool.getTxCache("1").Len())
 }
 
+func Test_AddData_ReplaceByFeeRemovesFromAllShards(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+	pool.configPrototypeSourceMe.ReplaceByFeeMinGasPriceBumpPercent = 10
+
+	pool.AddData([]byte("hash-x"), createTxWithGasPrice("alice", 42, 200000000000), 0, "0")
+	pool.AddData([]byte("hash-x"), createTxWithGasPrice("alice", 42, 200000000000), 0, "1")
+	require.Equal(t, 1, pool.getTxCache("1").Len())
+
+	pool.AddData([]byte("hash-y"), createTxWithGasPrice("alice", 42, 220000000000), 0, "0")
+
+	_, ok := pool.getTxCache("0").GetByTxHash([]byte("hash-y"))
+	require.True(t, ok)
+	_, ok = pool.SearchFirstData([]byte("hash-x"))
+	require.False(t, ok)
+	require.Zero(t, pool.getTxCache("1").Len())
+}
+
+func Test_AddData_ReplaceByFeeCancelsCrossShardTransfer(t *testing.T) {
+	poolAsInterface, _ := newTxPoolToTest()
+	pool := poolAsInterface.(*shardedTxPool)
+	pool.configPrototypeSourceMe.ReplaceByFeeMinGasPriceBumpPercent = 10
+
+	pool.AddData([]byte("hash-transfer"), createTxWithGasPrice("alice", 42, 200000000000), 0, "0_1")
+
+	pool.AddData([]byte("hash-low"), createTxWithGasPrice("alice", 42, 210000000000), 0, "0")
+	_, ok := pool.SearchFirstData([]byte("hash-low"))
+	require.False(t, ok)
+	_, ok = pool.SearchFirstData([]byte("hash-transfer"))
+	require.True(t, ok)
+
+	pool.AddData([]byte("hash-cancel"), createTxWithGasPrice("alice", 42, 220000000000), 0, "0")
+
+	_, ok = pool.SearchFirstData([]byte("hash-transfer"))
+	require.False(t, ok)
+	_, ok = pool.SearchFirstData([]byte("hash-cancel"))
+	require.True(t, ok)
+}
+
 func Test_MergeShardStores(t *testing.T) {
 	poolAsInterface, _ := newTxPoolToTest()
 	pool := poolAsInterface.(*shardedTxPool)
@@ -375,6 +420,7 @@ func Test_routeToCacheUnions(t *testing.T) {
 		},
 		NumberOfShards: 4,
 		SelfShardID:    42,
+		Journal:        NewDisabledTxPoolJournal(),
 	}
 	pool, _ := NewShardedTxPool(args)
 
@@ -387,6 +433,196 @@ func Test_routeToCacheUnions(t *testing.T) {
 	require.Equal(t, "foobar", pool.routeToCacheUnions("foobar"))
 }
 
+func Test_Journal_RecordsTxsFromSelfShard(t *testing.T) {
+	args := createMockArgsTxPoolJournal()
+	journal, _ := NewTxPoolJournal(args)
+	poolAsInterface, _ := newTxPoolToTestWithJournal(journal)
+	pool := poolAsInterface.(*shardedTxPool)
+
+	pool.AddData([]byte("hash-intra"), createTx("alice", 1), 0, "0")
+	pool.AddData([]byte("hash-cross-from-me"), createTx("alice", 2), 0, "0_1")
+	pool.AddData([]byte("hash-cross-to-me"), createTx("bob", 1), 0, "1_0")
+	pool.AddData([]byte("hash-removed"), createTx("alice", 3), 0, "0")
+	pool.RemoveData([]byte("hash-removed"), "0")
+
+	journaled := make([]string, 0)
+	journal.ForEachEntry(func(txHash []byte, _ *transaction.Transaction, _ int, _ string) {
+		journaled = append(journaled, string(txHash))
+	})
+	require.ElementsMatch(t, []string{"hash-intra", "hash-cross-from-me"}, journaled)
+
+	pool.RemoveDataFromAllShards([]byte("hash-cross-from-me"))
+	journaled = make([]string, 0)
+	journal.ForEachEntry(func(txHash []byte, _ *transaction.Transaction, _ int, _ string) {
+		journaled = append(journaled, string(txHash))
+	})
+	require.Equal(t, []string{"hash-intra"}, journaled)
+
+	require.Nil(t, pool.Close())
+}
+
+func Test_Journal_RecordsTxsRemovedByTheCache(t *testing.T) {
+	args := createMockArgsTxPoolJournal()
+	journal, _ := NewTxPoolJournal(args)
+	poolAsInterface, _ := newTxPoolToTestWithJournal(journal)
+	pool := poolAsInterface.(*shardedTxPool)
+
+	getJournaled := func() []string {
+		journaled := make([]string, 0)
+		journal.ForEachEntry(func(txHash []byte, _ *transaction.Transaction, _ int, _ string) {
+			journaled = append(journaled, string(txHash))
+		})
+
+		return journaled
+	}
+
+	// the per sender limit of the cache is 10 transactions, so the ones with the highest nonces are evicted
+	for nonce := uint64(1); nonce <= 12; nonce++ {
+		pool.AddData([]byte(fmt.Sprintf("hash-alice-%d", nonce)), createTx("alice", nonce), 0, "0")
+	}
+	journaled := getJournaled()
+	require.Len(t, journaled, 10)
+	require.NotContains(t, journaled, "hash-alice-11")
+	require.NotContains(t, journaled, "hash-alice-12")
+
+	pool.ClearShardStore("0")
+	require.Empty(t, getJournaled())
+
+	pool.AddData([]byte("hash-bob"), createTx("bob", 1), 0, "0")
+	pool.Clear()
+	require.Empty(t, getJournaled())
+
+	require.Nil(t, pool.Close())
+}
+
+func Test_ReplayJournal(t *testing.T) {
+	args := createMockArgsTxPoolJournal()
+	journal, _ := NewTxPoolJournal(args)
+	journal.RecordAdded([]byte("hash-stale"), createTx("alice", 4), "0")
+	journal.RecordAdded([]byte("hash-valid"), createTx("alice", 5), "0")
+	journal.RecordAdded([]byte("hash-cross"), createTx("alice", 6), "0_1")
+	journal.RecordAdded([]byte("hash-unknown-sender"), createTx("bob", 1), "0")
+	journal.RecordAdded([]byte("hash-other-shard"), createTx("carol", 1), "1")
+
+	poolAsInterface, _ := newTxPoolToTestWithJournal(journal)
+	pool := poolAsInterface.(*shardedTxPool)
+
+	err := pool.ReplayJournal(nil)
+	require.Equal(t, dataRetriever.ErrNilAccountsAdapter, err)
+
+	accounts := &stateMock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			if string(address) != "alice" {
+				return nil, errors.New("account not found")
+			}
+
+			account := stateMock.NewAccountWrapMock(address)
+			account.IncreaseNonce(5)
+			return account, nil
+		},
+	}
+	err = pool.ReplayJournal(accounts)
+	require.Nil(t, err)
+
+	require.Equal(t, int64(2), pool.GetCounts().GetTotal())
+	_, ok := pool.SearchFirstData([]byte("hash-valid"))
+	require.True(t, ok)
+	_, ok = pool.SearchFirstData([]byte("hash-cross"))
+	require.True(t, ok)
+
+	journaled := make([]string, 0)
+	journal.ForEachEntry(func(txHash []byte, _ *transaction.Transaction, _ int, _ string) {
+		journaled = append(journaled, string(txHash))
+	})
+	require.ElementsMatch(t, []string{"hash-valid", "hash-cross"}, journaled)
+
+	require.Nil(t, pool.Close())
+}
+
+func Test_ReplayJournal_AfterInterceptedTxs(t *testing.T) {
+	args := createMockArgsTxPoolJournal()
+	journal, _ := NewTxPoolJournal(args)
+	journal.RecordAdded([]byte("hash-received"), createTxWithGasPrice("alice", 5, 200000000000), "0")
+	journal.RecordAdded([]byte("hash-outbid"), createTxWithGasPrice("alice", 6, 200000000000), "0")
+	journal.RecordAdded([]byte("hash-replayed"), createTxWithGasPrice("alice", 7, 200000000000), "0")
+
+	poolAsInterface, _ := newTxPoolToTestWithJournal(journal)
+	pool := poolAsInterface.(*shardedTxPool)
+	pool.configPrototypeSourceMe.ReplaceByFeeMinGasPriceBumpPercent = 10
+
+	// the interceptors are running before the journal is replayed
+	pool.AddData([]byte("hash-received"), createTxWithGasPrice("alice", 5, 200000000000), 0, "0")
+	pool.AddData([]byte("hash-bid"), createTxWithGasPrice("alice", 6, 220000000000), 0, "0")
+
+	accounts := &stateMock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return stateMock.NewAccountWrapMock(address), nil
+		},
+	}
+	err := pool.ReplayJournal(accounts)
+	require.Nil(t, err)
+
+	require.Equal(t, int64(3), pool.GetCounts().GetTotal())
+	for _, txHash := range []string{"hash-received", "hash-bid", "hash-replayed"} {
+		_, ok := pool.SearchFirstData([]byte(txHash))
+		require.True(t, ok, txHash)
+	}
+
+	journaled := make([]string, 0)
+	journal.ForEachEntry(func(txHash []byte, _ *transaction.Transaction, _ int, _ string) {
+		journaled = append(journaled, string(txHash))
+	})
+	require.ElementsMatch(t, []string{"hash-received", "hash-bid", "hash-replayed"}, journaled)
+
+	require.Nil(t, pool.Close())
+}
+
+func Test_ReplayJournal_ConcurrentWithInterceptorsAndProcessing(t *testing.T) {
+	args := createMockArgsTxPoolJournal()
+	journal, _ := NewTxPoolJournal(args)
+	numTxs := 20
+	for i := 0; i < numTxs; i++ {
+		journal.RecordAdded([]byte(fmt.Sprintf("journaled-%d", i)), createTx(fmt.Sprintf("alice-%d", i), 1), "0")
+	}
+
+	poolAsInterface, _ := newTxPoolToTestWithJournal(journal)
+	pool := poolAsInterface.(*shardedTxPool)
+	accounts := &stateMock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return stateMock.NewAccountWrapMock(address), nil
+		},
+	}
+
+	wg := sync.WaitGroup{}
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		err := pool.ReplayJournal(accounts)
+		require.Nil(t, err)
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < numTxs; i++ {
+			pool.AddData([]byte(fmt.Sprintf("intercepted-%d", i)), createTx(fmt.Sprintf("bob-%d", i), 1), 0, "0")
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		// the processed blocks remove the executed transactions
+		for i := 0; i < numTxs; i += 2 {
+			pool.RemoveData([]byte(fmt.Sprintf("intercepted-%d", i)), "0")
+		}
+	}()
+	wg.Wait()
+
+	for i := 0; i < numTxs; i++ {
+		_, ok := pool.SearchFirstData([]byte(fmt.Sprintf("journaled-%d", i)))
+		require.True(t, ok)
+	}
+
+	require.Nil(t, pool.Close())
+}
+
 func createTx(sender string, nonce uint64) data.TransactionHandler {
 	return &transaction.Transaction{
 		SndAddr: []byte(sender),
@@ -394,6 +630,14 @@ func createTx(sender string, nonce uint64) data.TransactionHandler {
 	}
 }
 
+func createTxWithGasPrice(sender string, nonce uint64, gasPrice uint64) data.TransactionHandler {
+	return &transaction.Transaction{
+		SndAddr:  []byte(sender),
+		Nonce:    nonce,
+		GasPrice: gasPrice,
+	}
+}
+
 func waitABit() {
 	time.Sleep(10 * time.Millisecond)
 }
@@ -402,6 +646,10 @@ type thisIsNotATransaction struct {
 }
 
 func newTxPoolToTest() (dataRetriever.ShardedDataCacherNotifier, error) {
+	return newTxPoolToTestWithJournal(NewDisabledTxPoolJournal())
+}
+
+func newTxPoolToTestWithJournal(journal TxPoolJournal) (dataRetriever.ShardedDataCacherNotifier, error) {
 	config := storageUnit.CacheConfig{
 		Capacity:             100,
 		SizePerSender:        10,
@@ -418,6 +666,7 @@ func newTxPoolToTest() (dataRetriever.ShardedDataCacherNotifier, error) {
 		},
 		NumberOfShards: 4,
 		SelfShardID:    0,
+		Journal:        journal,
 	}
 	return NewShardedTxPool(args)
 }
@@ -0,0 +1,185 @@
+package txpool
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+var _ TxPoolJournal = (*txPoolJournal)(nil)
+
+// ArgsTxPoolJournal holds the arguments needed to create a transactions pool journal
+type ArgsTxPoolJournal struct {
+	Persister     storage.Persister
+	Marshalizer   marshal.Marshalizer
+	FlushInterval time.Duration
+}
+
+// journalEntry is the record persisted for each journaled transaction
+type journalEntry struct {
+	CacheID string `json:"cacheID"`
+	Tx      []byte `json:"tx"`
+}
+
+// txPoolJournal is a write-behind journal of the transactions admitted in the pool: the changes are buffered in
+// memory and periodically flushed to the persister
+type txPoolJournal struct {
+	persister     storage.Persister
+	marshalizer   marshal.Marshalizer
+	flushInterval time.Duration
+	cancelFunc    func()
+
+	mutPending sync.Mutex
+	// a nil value marks a removal
+	pending map[string][]byte
+
+	mutFlush sync.Mutex
+}
+
+// NewTxPoolJournal creates a new transactions pool journal
+func NewTxPoolJournal(args ArgsTxPoolJournal) (*txPoolJournal, error) {
+	if check.IfNil(args.Persister) {
+		return nil, dataRetriever.ErrNilPersister
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, dataRetriever.ErrNilMarshalizer
+	}
+	if args.FlushInterval <= 0 {
+		return nil, dataRetriever.ErrInvalidTxPoolJournalFlushInterval
+	}
+
+	journal := &txPoolJournal{
+		persister:     args.Persister,
+		marshalizer:   args.Marshalizer,
+		flushInterval: args.FlushInterval,
+		pending:       make(map[string][]byte),
+	}
+
+	var ctx context.Context
+	ctx, journal.cancelFunc = context.WithCancel(context.Background())
+	go journal.startFlushing(ctx)
+
+	return journal, nil
+}
+
+func (journal *txPoolJournal) startFlushing(ctx context.Context) {
+	timer := time.NewTimer(journal.flushInterval)
+	defer timer.Stop()
+
+	for {
+		timer.Reset(journal.flushInterval)
+
+		select {
+		case <-timer.C:
+			journal.flush()
+		case <-ctx.Done():
+			log.Debug("closing txPoolJournal's flush go routine...")
+			return
+		}
+	}
+}
+
+// RecordAdded records a transaction admitted in the pool
+func (journal *txPoolJournal) RecordAdded(txHash []byte, tx data.TransactionHandler, cacheID string) {
+	txBytes, err := journal.marshalizer.Marshal(tx)
+	if err != nil {
+		log.Debug("txPoolJournal.RecordAdded: marshal transaction", "txHash", txHash, "error", err)
+		return
+	}
+
+	entryBytes, err := json.Marshal(&journalEntry{
+		CacheID: cacheID,
+		Tx:      txBytes,
+	})
+	if err != nil {
+		log.Debug("txPoolJournal.RecordAdded: marshal entry", "txHash", txHash, "error", err)
+		return
+	}
+
+	journal.mutPending.Lock()
+	journal.pending[string(txHash)] = entryBytes
+	journal.mutPending.Unlock()
+}
+
+// RecordRemoved records the removal of a transaction from the pool
+func (journal *txPoolJournal) RecordRemoved(txHash []byte) {
+	journal.mutPending.Lock()
+	journal.pending[string(txHash)] = nil
+	journal.mutPending.Unlock()
+}
+
+// ForEachEntry flushes the pending changes and calls the handler for each journaled transaction
+func (journal *txPoolJournal) ForEachEntry(handler func(txHash []byte, tx *transaction.Transaction, sizeInBytes int, cacheID string)) {
+	if handler == nil {
+		return
+	}
+
+	journal.flush()
+	journal.persister.RangeKeys(func(key []byte, val []byte) bool {
+		entry := &journalEntry{}
+		err := json.Unmarshal(val, entry)
+		if err != nil {
+			log.Debug("txPoolJournal.ForEachEntry: unmarshal entry", "txHash", key, "error", err)
+			return true
+		}
+
+		tx := &transaction.Transaction{}
+		err = journal.marshalizer.Unmarshal(tx, entry.Tx)
+		if err != nil {
+			log.Debug("txPoolJournal.ForEachEntry: unmarshal transaction", "txHash", key, "error", err)
+			return true
+		}
+
+		txHash := make([]byte, len(key))
+		copy(txHash, key)
+		handler(txHash, tx, len(entry.Tx), entry.CacheID)
+
+		return true
+	})
+}
+
+func (journal *txPoolJournal) flush() {
+	journal.mutFlush.Lock()
+	defer journal.mutFlush.Unlock()
+
+	journal.mutPending.Lock()
+	pending := journal.pending
+	journal.pending = make(map[string][]byte)
+	journal.mutPending.Unlock()
+
+	for key, val := range pending {
+		var err error
+		if val == nil {
+			err = journal.persister.Remove([]byte(key))
+		} else {
+			err = journal.persister.Put([]byte(key), val)
+		}
+		if err != nil {
+			log.Debug("txPoolJournal.flush", "txHash", []byte(key), "error", err)
+		}
+	}
+}
+
+// Close flushes the pending changes and closes the underlying persister
+func (journal *txPoolJournal) Close() error {
+	if journal.cancelFunc != nil {
+		journal.cancelFunc()
+	}
+
+	journal.flush()
+
+	return journal.persister.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (journal *txPoolJournal) IsInterfaceNil() bool {
+	return journal == nil
+}
@@ -0,0 +1,120 @@
+package txpool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsTxPoolJournal() ArgsTxPoolJournal {
+	return ArgsTxPoolJournal{
+		Persister:     memorydb.New(),
+		Marshalizer:   &marshal.GogoProtoMarshalizer{},
+		FlushInterval: time.Hour,
+	}
+}
+
+func TestNewTxPoolJournal(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil persister should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTxPoolJournal()
+		args.Persister = nil
+		journal, err := NewTxPoolJournal(args)
+		require.Equal(t, dataRetriever.ErrNilPersister, err)
+		require.True(t, check.IfNil(journal))
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTxPoolJournal()
+		args.Marshalizer = nil
+		journal, err := NewTxPoolJournal(args)
+		require.Equal(t, dataRetriever.ErrNilMarshalizer, err)
+		require.True(t, check.IfNil(journal))
+	})
+	t.Run("invalid flush interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTxPoolJournal()
+		args.FlushInterval = 0
+		journal, err := NewTxPoolJournal(args)
+		require.Equal(t, dataRetriever.ErrInvalidTxPoolJournalFlushInterval, err)
+		require.True(t, check.IfNil(journal))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		journal, err := NewTxPoolJournal(createMockArgsTxPoolJournal())
+		require.Nil(t, err)
+		require.False(t, check.IfNil(journal))
+		require.Nil(t, journal.Close())
+	})
+}
+
+func TestTxPoolJournal_WritesBehind(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsTxPoolJournal()
+	args.FlushInterval = 10 * time.Millisecond
+	persister := args.Persister
+	journal, _ := NewTxPoolJournal(args)
+	defer func() {
+		_ = journal.Close()
+	}()
+
+	journal.RecordAdded([]byte("hash-1"), createTx("alice", 1), "0")
+	require.NotNil(t, persister.Has([]byte("hash-1")))
+
+	time.Sleep(100 * time.Millisecond)
+	require.Nil(t, persister.Has([]byte("hash-1")))
+
+	journal.RecordRemoved([]byte("hash-1"))
+	time.Sleep(100 * time.Millisecond)
+	require.NotNil(t, persister.Has([]byte("hash-1")))
+}
+
+func TestTxPoolJournal_ForEachEntry(t *testing.T) {
+	t.Parallel()
+
+	journal, _ := NewTxPoolJournal(createMockArgsTxPoolJournal())
+	defer func() {
+		_ = journal.Close()
+	}()
+
+	journal.ForEachEntry(nil)
+
+	journal.RecordAdded([]byte("hash-1"), createTx("alice", 1), "0")
+	journal.RecordAdded([]byte("hash-2"), createTx("bob", 7), "0_1")
+	journal.RecordAdded([]byte("hash-3"), createTx("carol", 3), "0")
+	journal.RecordRemoved([]byte("hash-3"))
+
+	entries := make(map[string]string)
+	journal.ForEachEntry(func(txHash []byte, tx *transaction.Transaction, sizeInBytes int, cacheID string) {
+		require.True(t, sizeInBytes > 0)
+		entries[string(txHash)] = string(tx.SndAddr) + "@" + cacheID
+	})
+
+	require.Equal(t, map[string]string{"hash-1": "alice@0", "hash-2": "bob@0_1"}, entries)
+}
+
+func TestTxPoolJournal_CloseFlushesPendingChanges(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsTxPoolJournal()
+	persister := args.Persister
+	journal, _ := NewTxPoolJournal(args)
+
+	journal.RecordAdded([]byte("hash-1"), createTx("alice", 1), "0")
+	_ = journal.Close()
+
+	require.Nil(t, persister.Has([]byte("hash-1")))
+}
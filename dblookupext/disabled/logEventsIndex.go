@@ -0,0 +1,38 @@
+package disabled
+
+import (
+	"errors"
+
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/dblookupext"
+)
+
+var errorDisabledLogEventsIndex = errors.New("log events index is disabled")
+
+type logEventsIndex struct {
+}
+
+// NewLogEventsIndex returns a disabled log events index
+func NewLogEventsIndex() *logEventsIndex {
+	return &logEventsIndex{}
+}
+
+// RecordBlock does nothing
+func (lei *logEventsIndex) RecordBlock(_ []byte, _ data.HeaderHandler, _ []*data.LogData) error {
+	return nil
+}
+
+// RevertBlock does nothing
+func (lei *logEventsIndex) RevertBlock(_ []byte, _ data.HeaderHandler) error {
+	return nil
+}
+
+// GetLogEventsByBlockNonce returns a not implemented error
+func (lei *logEventsIndex) GetLogEventsByBlockNonce(_ uint64) (*dblookupext.LogEventsByBlock, error) {
+	return nil, errorDisabledLogEventsIndex
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (lei *logEventsIndex) IsInterfaceNil() bool {
+	return lei == nil
+}
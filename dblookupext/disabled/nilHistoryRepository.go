@@ -7,6 +7,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
 	"github.com/ElrondNetwork/elrond-go/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
+	"github.com/ElrondNetwork/elrond-go/state"
 )
 
 var errorDisabledHistoryRepository = errors.New("history repository is disabled")
@@ -20,7 +21,7 @@ func NewNilHistoryRepository() (*nilHistoryRepository, error) {
 }
 
 // RecordBlock returns a not implemented error
-func (nhr *nilHistoryRepository) RecordBlock(_ []byte, _ data.HeaderHandler, _ data.BodyHandler, _, _ map[string]data.TransactionHandler, _ []*block.MiniBlock, _ []*data.LogData) error {
+func (nhr *nilHistoryRepository) RecordBlock(_ []byte, _ data.HeaderHandler, _ data.BodyHandler, _, _, _ map[string]data.TransactionHandler, _ []*block.MiniBlock, _ []*data.LogData) error {
 	return nil
 }
 
@@ -28,6 +29,10 @@ func (nhr *nilHistoryRepository) RecordBlock(_ []byte, _ data.HeaderHandler, _ d
 func (nhr *nilHistoryRepository) OnNotarizedBlocks(_ uint32, _ []data.HeaderHandler, _ [][]byte) {
 }
 
+// RegisterRecordedBlocksHandler does nothing
+func (nhr *nilHistoryRepository) RegisterRecordedBlocksHandler(_ func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)) {
+}
+
 // GetMiniblockMetadataByTxHash does nothing
 func (nhr *nilHistoryRepository) GetMiniblockMetadataByTxHash(_ []byte) (*dblookupext.MiniblockMetadata, error) {
 	return nil, nil
@@ -53,11 +58,35 @@ func (nhr *nilHistoryRepository) GetESDTSupply(_ string) (*esdtSupply.SupplyESDT
 	return nil, errorDisabledHistoryRepository
 }
 
+// GetESDTSupplyHistory -
+func (nhr *nilHistoryRepository) GetESDTSupplyHistory(_ string, _ uint32, _ uint32) ([]*esdtSupply.SupplyESDTInEpoch, error) {
+	return nil, errorDisabledHistoryRepository
+}
+
 // GetResultsHashesByTxHash -
 func (nhr *nilHistoryRepository) GetResultsHashesByTxHash(_ []byte, _ uint32) (*dblookupext.ResultsHashesByTxHash, error) {
 	return nil, nil
 }
 
+// GetTxHashesByAddress returns a not implemented error
+func (nhr *nilHistoryRepository) GetTxHashesByAddress(_ []byte, _ uint32, _ uint32, _ bool) ([]*dblookupext.TxHashByAddress, error) {
+	return nil, errorDisabledHistoryRepository
+}
+
+// GetLogEventsByBlockNonce returns a not implemented error
+func (nhr *nilHistoryRepository) GetLogEventsByBlockNonce(_ uint64) (*dblookupext.LogEventsByBlock, error) {
+	return nil, errorDisabledHistoryRepository
+}
+
+// AddStateChanges does nothing
+func (nhr *nilHistoryRepository) AddStateChanges(_ []byte, _ []*state.AccountStateChanges) {
+}
+
+// GetStateDiffByTxHash returns a not implemented error
+func (nhr *nilHistoryRepository) GetStateDiffByTxHash(_ []byte, _ uint32) ([]*state.AccountStateChanges, error) {
+	return nil, errorDisabledHistoryRepository
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (nhr *nilHistoryRepository) IsInterfaceNil() bool {
 	return nhr == nil
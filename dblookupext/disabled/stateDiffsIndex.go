@@ -0,0 +1,43 @@
+package disabled
+
+import (
+	"errors"
+
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+var errorDisabledStateDiffsIndex = errors.New("state diffs index is disabled")
+
+type stateDiffsIndex struct {
+}
+
+// NewStateDiffsIndex returns a disabled state diffs index
+func NewStateDiffsIndex() *stateDiffsIndex {
+	return &stateDiffsIndex{}
+}
+
+// AddStateChanges does nothing
+func (sdi *stateDiffsIndex) AddStateChanges(_ []byte, _ []*state.AccountStateChanges) {
+}
+
+// RecordBlock does nothing
+func (sdi *stateDiffsIndex) RecordBlock(_ []byte, _ data.HeaderHandler, _ []*block.MiniBlock) error {
+	return nil
+}
+
+// RevertBlock does nothing
+func (sdi *stateDiffsIndex) RevertBlock(_ []byte, _ data.HeaderHandler, _ []*block.MiniBlock) error {
+	return nil
+}
+
+// GetStateDiff returns a not implemented error
+func (sdi *stateDiffsIndex) GetStateDiff(_ []byte, _ uint32) ([]*state.AccountStateChanges, error) {
+	return nil, errorDisabledStateDiffsIndex
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sdi *stateDiffsIndex) IsInterfaceNil() bool {
+	return sdi == nil
+}
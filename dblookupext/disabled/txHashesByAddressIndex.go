@@ -0,0 +1,39 @@
+package disabled
+
+import (
+	"errors"
+
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/dblookupext"
+)
+
+var errorDisabledTxHashesByAddressIndex = errors.New("transactions hashes by address index is disabled")
+
+type txHashesByAddressIndex struct {
+}
+
+// NewTxHashesByAddressIndex returns a disabled transactions hashes by address index
+func NewTxHashesByAddressIndex() *txHashesByAddressIndex {
+	return &txHashesByAddressIndex{}
+}
+
+// RecordBlock does nothing
+func (thi *txHashesByAddressIndex) RecordBlock(_ []byte, _ data.HeaderHandler, _ []*block.MiniBlock, _ map[string]data.TransactionHandler) error {
+	return nil
+}
+
+// RevertBlock does nothing
+func (thi *txHashesByAddressIndex) RevertBlock(_ []byte, _ data.HeaderHandler) error {
+	return nil
+}
+
+// GetTxHashesByAddress returns a not implemented error
+func (thi *txHashesByAddressIndex) GetTxHashesByAddress(_ []byte, _ uint32, _ uint32, _ bool) ([]*dblookupext.TxHashByAddress, error) {
+	return nil, errorDisabledTxHashesByAddressIndex
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (thi *txHashesByAddressIndex) IsInterfaceNil() bool {
+	return thi == nil
+}
@@ -20,3 +20,17 @@ func newErrCannotSaveEpochByHash(what string, hash []byte, originalErr error) er
 func newErrCannotSaveMiniblockMetadata(hash []byte, originalErr error) error {
 	return fmt.Errorf("cannot save miniblock metadata, hash [%s]: %w", hex.EncodeToString(hash), originalErr)
 }
+
+var errNilBlockHeader = errors.New("nil block header")
+
+var errWrongTypeAssertion = errors.New("wrong type assertion")
+
+var errEmptyAddress = errors.New("empty address")
+
+var errInconsistentTxHashesByAddressRecord = errors.New("inconsistent transactions hashes by address record")
+
+var errNilTxHashesByAddressHandler = errors.New("nil transactions hashes by address handler")
+
+var errNilLogEventsHandler = errors.New("nil log events handler")
+
+var errNilStateDiffsHandler = errors.New("nil state diffs handler")
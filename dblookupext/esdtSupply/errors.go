@@ -3,3 +3,6 @@ package esdtSupply
 import "errors"
 
 var errCannotCastToBlockBody = errors.New("cannot cast to block body")
+
+// ErrInvalidEpochsRange signals that an invalid range of epochs was provided
+var ErrInvalidEpochsRange = errors.New("invalid epochs range")
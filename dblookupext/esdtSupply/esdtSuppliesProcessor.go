@@ -15,6 +15,19 @@ import (
 
 var log = logger.GetOrCreate("dblookupext/esdtSupply")
 
+// SupplyESDTInEpoch holds the changes of an ESDT token supply which occurred during an epoch. The Supply field of the
+// changes holds the net supply delta
+type SupplyESDTInEpoch struct {
+	Epoch  uint32
+	Supply *SupplyESDT
+}
+
+type suppliesChanges struct {
+	epoch         uint32
+	supplies      map[string]*SupplyESDT
+	epochSupplies map[string]*SupplyESDT
+}
+
 type suppliesProcessor struct {
 	logsProc *logsProcessor
 	logsGet  *logsGetter
@@ -46,8 +59,8 @@ func NewSuppliesProcessor(
 	}, nil
 }
 
-// ProcessLogs will process the provided logs
-func (sp *suppliesProcessor) ProcessLogs(blockNonce uint64, logs []*data.LogData) error {
+// ProcessLogs will process the provided logs of a block from the given epoch
+func (sp *suppliesProcessor) ProcessLogs(blockNonce uint64, epoch uint32, logs []*data.LogData) error {
 	sp.mutex.Lock()
 	defer sp.mutex.Unlock()
 
@@ -58,7 +71,7 @@ func (sp *suppliesProcessor) ProcessLogs(blockNonce uint64, logs []*data.LogData
 		}
 	}
 
-	return sp.logsProc.processLogs(blockNonce, logsMap, false)
+	return sp.logsProc.processLogs(blockNonce, epoch, logsMap, false)
 }
 
 // RevertChanges will revert supplies changes based on the provided block body
@@ -75,7 +88,7 @@ func (sp *suppliesProcessor) RevertChanges(header data.HeaderHandler, body data.
 		return err
 	}
 
-	return sp.logsProc.processLogs(header.GetNonce(), logsFromDB, true)
+	return sp.logsProc.processLogs(header.GetNonce(), header.GetEpoch(), logsFromDB, true)
 }
 
 // GetESDTSupply will return the supply from the storage for the given token
@@ -83,6 +96,16 @@ func (sp *suppliesProcessor) GetESDTSupply(token string) (*SupplyESDT, error) {
 	return sp.logsProc.getESDTSupply([]byte(token))
 }
 
+// GetESDTSupplyHistory will return the supply changes of the given token, for each epoch in the provided range in which
+// the supply changed
+func (sp *suppliesProcessor) GetESDTSupplyHistory(token string, fromEpoch uint32, toEpoch uint32) ([]*SupplyESDTInEpoch, error) {
+	if fromEpoch > toEpoch {
+		return nil, ErrInvalidEpochsRange
+	}
+
+	return sp.logsProc.getESDTSupplyHistory([]byte(token), fromEpoch, toEpoch)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (sp *suppliesProcessor) IsInterfaceNil() bool {
 	return sp == nil
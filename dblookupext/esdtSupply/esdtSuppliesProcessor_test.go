@@ -101,7 +101,8 @@ func TestProcessLogsSaveSupply(t *testing.T) {
 			}
 
 			supplyKey := string(token) + "-" + hex.EncodeToString(big.NewInt(2).Bytes())
-			require.Equal(t, supplyKey, string(key))
+			epochKey := string(epochSupplyKey([]byte(supplyKey), 3))
+			require.Contains(t, []string{supplyKey, epochKey}, string(key))
 
 			var supplyESDT SupplyESDT
 			_ = marshalizer.Unmarshal(&supplyESDT, data)
@@ -116,7 +117,7 @@ func TestProcessLogsSaveSupply(t *testing.T) {
 	suppliesProc, err := NewSuppliesProcessor(marshalizer, suppliesStorer, &storageStubs.StorerStub{})
 	require.Nil(t, err)
 
-	err = suppliesProc.ProcessLogs(6, logs)
+	err = suppliesProc.ProcessLogs(6, 3, logs)
 	require.Nil(t, err)
 
 	require.True(t, wasPutCalled)
@@ -245,13 +246,13 @@ func TestProcessLogsSaveSupplyShouldUpdateSupplyMintedAndBurned(t *testing.T) {
 	suppliesProc, err := NewSuppliesProcessor(marshalizer, suppliesStorer, &storageStubs.StorerStub{})
 	require.Nil(t, err)
 
-	err = suppliesProc.ProcessLogs(6, logsCreate)
+	err = suppliesProc.ProcessLogs(6, 0, logsCreate)
 	require.Nil(t, err)
 
-	err = suppliesProc.ProcessLogs(7, logsAddQuantity)
+	err = suppliesProc.ProcessLogs(7, 0, logsAddQuantity)
 	require.Nil(t, err)
 
-	err = suppliesProc.ProcessLogs(8, logsBurn)
+	err = suppliesProc.ProcessLogs(8, 0, logsBurn)
 	require.Nil(t, err)
 
 	require.Equal(t, 3, numTimesCalled)
@@ -321,11 +322,11 @@ func TestProcessLogs_RevertChangesShouldWorkForRevertingMinting(t *testing.T) {
 	suppliesProc, err := NewSuppliesProcessor(marshalizer, suppliesStorer, logsStorer)
 	require.Nil(t, err)
 
-	err = suppliesProc.ProcessLogs(6, logsMintNoRevert)
+	err = suppliesProc.ProcessLogs(6, 0, logsMintNoRevert)
 	require.Nil(t, err)
 	checkStoredValues(t, suppliesStorer, token, marshalizer, testFungibleTokenMint*2, testFungibleTokenMint*2, 0)
 
-	err = suppliesProc.ProcessLogs(7, logsMintRevert)
+	err = suppliesProc.ProcessLogs(7, 0, logsMintRevert)
 	require.Nil(t, err)
 	checkStoredValues(t, suppliesStorer, token, marshalizer,
 		testFungibleTokenMint*2+testFungibleTokenMint2,
@@ -412,11 +413,11 @@ func TestProcessLogs_RevertChangesShouldWorkForRevertingBurning(t *testing.T) {
 	suppliesProc, err := NewSuppliesProcessor(marshalizer, suppliesStorer, logsStorer)
 	require.Nil(t, err)
 
-	err = suppliesProc.ProcessLogs(6, logsMintNoRevert)
+	err = suppliesProc.ProcessLogs(6, 0, logsMintNoRevert)
 	require.Nil(t, err)
 	checkStoredValues(t, suppliesStorer, token, marshalizer, testFungibleTokenMint*2, testFungibleTokenMint*2, 0)
 
-	err = suppliesProc.ProcessLogs(7, logsMintRevert)
+	err = suppliesProc.ProcessLogs(7, 0, logsMintRevert)
 	require.Nil(t, err)
 	checkStoredValues(t,
 		suppliesStorer,
@@ -493,3 +494,87 @@ func TestSupplyESDT_GetSupply(t *testing.T) {
 
 	require.Equal(t, expectedESDTSupply, res)
 }
+
+func createFungibleTokenLogs(token []byte, txHash string, identifier string, value int64) []*data.LogData {
+	return []*data.LogData{
+		{
+			TxHash: txHash,
+			LogHandler: &transaction.Log{
+				Events: []*transaction.Event{
+					{
+						Identifier: []byte(identifier),
+						Topics: [][]byte{
+							token, nil, big.NewInt(value).Bytes(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestSupplyProcessor_GetESDTSupplyHistory(t *testing.T) {
+	t.Parallel()
+
+	token := []byte("TKN-abcdef")
+	marshalizer := testscommon.MarshalizerMock{}
+
+	mintLogToBeReverted := createFungibleTokenLogs(token, "txHash4", core.BuiltInFunctionESDTLocalMint, testFungibleTokenMint2)
+	logsStorer := genericMocks.NewStorerMockWithErrKeyNotFound(0)
+	mintLogToBeRevertedBytes, err := marshalizer.Marshal(mintLogToBeReverted[0].LogHandler)
+	require.NoError(t, err)
+	err = logsStorer.Put([]byte("txHash4"), mintLogToBeRevertedBytes)
+	require.NoError(t, err)
+
+	suppliesStorer := genericMocks.NewStorerMockWithErrKeyNotFound(0)
+	suppliesProc, err := NewSuppliesProcessor(marshalizer, suppliesStorer, logsStorer)
+	require.Nil(t, err)
+
+	err = suppliesProc.ProcessLogs(6, 1, createFungibleTokenLogs(token, "txHash1", core.BuiltInFunctionESDTLocalMint, testFungibleTokenMint))
+	require.Nil(t, err)
+	err = suppliesProc.ProcessLogs(7, 1, createFungibleTokenLogs(token, "txHash2", core.BuiltInFunctionESDTLocalBurn, testFungibleTokenBurn))
+	require.Nil(t, err)
+	err = suppliesProc.ProcessLogs(8, 3, createFungibleTokenLogs(token, "txHash3", core.BuiltInFunctionESDTWipe, testFungibleTokenBurn))
+	require.Nil(t, err)
+	err = suppliesProc.ProcessLogs(9, 3, mintLogToBeReverted)
+	require.Nil(t, err)
+
+	revertedHeader := block.Header{Nonce: 9, Epoch: 3}
+	blockBody := block.Body{
+		MiniBlocks: []*block.MiniBlock{
+			{
+				TxHashes: [][]byte{[]byte("txHash4")},
+			},
+		},
+	}
+	err = suppliesProc.RevertChanges(&revertedHeader, &blockBody)
+	require.Nil(t, err)
+
+	history, err := suppliesProc.GetESDTSupplyHistory(string(token), 0, 10)
+	require.Nil(t, err)
+	require.Equal(t, []*SupplyESDTInEpoch{
+		{
+			Epoch: 1,
+			Supply: &SupplyESDT{
+				Supply: big.NewInt(testFungibleTokenMint - testFungibleTokenBurn),
+				Minted: big.NewInt(testFungibleTokenMint),
+				Burned: big.NewInt(testFungibleTokenBurn),
+			},
+		},
+		{
+			Epoch: 3,
+			Supply: &SupplyESDT{
+				Supply: big.NewInt(-testFungibleTokenBurn),
+				Minted: big.NewInt(0),
+				Burned: big.NewInt(testFungibleTokenBurn),
+			},
+		},
+	}, history)
+
+	history, err = suppliesProc.GetESDTSupplyHistory(string(token), 2, 2)
+	require.Nil(t, err)
+	require.Empty(t, history)
+
+	_, err = suppliesProc.GetESDTSupplyHistory(string(token), 3, 2)
+	require.Equal(t, ErrInvalidEpochsRange, err)
+}
@@ -5,6 +5,7 @@ package esdtSupply
 import (
 	"bytes"
 	"encoding/hex"
+	"fmt"
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
@@ -15,6 +16,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
+// epochSupplyKeySeparator separates the token identifier from the epoch in the keys of the supply changes in an epoch
+const epochSupplyKeySeparator = "_epoch_"
+
 type logsProcessor struct {
 	marshalizer        marshal.Marshalizer
 	suppliesStorer     storage.Storer
@@ -43,7 +47,7 @@ func newLogsProcessor(
 	}
 }
 
-func (lp *logsProcessor) processLogs(blockNonce uint64, logs map[string]*data.LogData, isRevert bool) error {
+func (lp *logsProcessor) processLogs(blockNonce uint64, epoch uint32, logs map[string]*data.LogData, isRevert bool) error {
 	shouldProcess, err := lp.nonceProc.shouldProcessLog(blockNonce, isRevert)
 	if err != nil {
 		return err
@@ -52,7 +56,11 @@ func (lp *logsProcessor) processLogs(blockNonce uint64, logs map[string]*data.Lo
 		return nil
 	}
 
-	supplies := make(map[string]*SupplyESDT)
+	supplies := &suppliesChanges{
+		epoch:         epoch,
+		supplies:      make(map[string]*SupplyESDT),
+		epochSupplies: make(map[string]*SupplyESDT),
+	}
 	for _, logHandler := range logs {
 		if logHandler == nil || check.IfNil(logHandler.LogHandler) {
 			continue
@@ -64,7 +72,12 @@ func (lp *logsProcessor) processLogs(blockNonce uint64, logs map[string]*data.Lo
 		}
 	}
 
-	err = lp.saveSupplies(supplies)
+	err = lp.saveSupplies(supplies.supplies)
+	if err != nil {
+		return err
+	}
+
+	err = lp.saveSupplies(supplies.epochSupplies)
 	if err != nil {
 		return err
 	}
@@ -72,7 +85,7 @@ func (lp *logsProcessor) processLogs(blockNonce uint64, logs map[string]*data.Lo
 	return lp.nonceProc.saveNonceInStorage(blockNonce)
 }
 
-func (lp *logsProcessor) processLog(txLog data.LogHandler, supplies map[string]*SupplyESDT, isRevert bool) error {
+func (lp *logsProcessor) processLog(txLog data.LogHandler, supplies *suppliesChanges, isRevert bool) error {
 	for _, entryHandler := range txLog.GetLogEvents() {
 		if check.IfNil(entryHandler) {
 			continue
@@ -97,13 +110,13 @@ func (lp *logsProcessor) processLog(txLog data.LogHandler, supplies map[string]*
 }
 
 func (lp *logsProcessor) saveSupplies(supplies map[string]*SupplyESDT) error {
-	for identifier, supplyESDT := range supplies {
+	for key, supplyESDT := range supplies {
 		supplyESDTBytes, err := lp.marshalizer.Marshal(supplyESDT)
 		if err != nil {
 			return err
 		}
 
-		err = lp.suppliesStorer.Put([]byte(identifier), supplyESDTBytes)
+		err = lp.suppliesStorer.Put([]byte(key), supplyESDTBytes)
 		if err != nil {
 			return err
 		}
@@ -112,7 +125,7 @@ func (lp *logsProcessor) saveSupplies(supplies map[string]*SupplyESDT) error {
 	return nil
 }
 
-func (lp *logsProcessor) processEvent(txLog *transaction.Event, supplies map[string]*SupplyESDT, isRevert bool) error {
+func (lp *logsProcessor) processEvent(txLog *transaction.Event, supplies *suppliesChanges, isRevert bool) error {
 	if len(txLog.Topics) < 3 {
 		return nil
 	}
@@ -127,24 +140,37 @@ func (lp *logsProcessor) processEvent(txLog *transaction.Event, supplies map[str
 
 	valueFromEvent := big.NewInt(0).SetBytes(txLog.Topics[2])
 
-	tokenIDStr := string(tokenIdentifier)
-	tokenSupply, found := supplies[tokenIDStr]
-	if found {
-		lp.updateTokenSupply(tokenSupply, valueFromEvent, string(txLog.Identifier), isRevert)
-		return nil
+	tokenSupply, err := lp.getCachedSupply(supplies.supplies, tokenIdentifier)
+	if err != nil {
+		return err
 	}
+	lp.updateTokenSupply(tokenSupply, valueFromEvent, string(txLog.Identifier), isRevert)
 
-	supply, err := lp.getESDTSupply(tokenIdentifier)
+	// the changes which occurred during the epoch are recorded the same way, starting from a zero supply
+	tokenEpochSupply, err := lp.getCachedSupply(supplies.epochSupplies, epochSupplyKey(tokenIdentifier, supplies.epoch))
 	if err != nil {
 		return err
 	}
-
-	supplies[tokenIDStr] = supply
-	lp.updateTokenSupply(supplies[tokenIDStr], valueFromEvent, string(txLog.Identifier), isRevert)
+	lp.updateTokenSupply(tokenEpochSupply, valueFromEvent, string(txLog.Identifier), isRevert)
 
 	return nil
 }
 
+func (lp *logsProcessor) getCachedSupply(supplies map[string]*SupplyESDT, key []byte) (*SupplyESDT, error) {
+	supply, found := supplies[string(key)]
+	if found {
+		return supply, nil
+	}
+
+	supply, err := lp.getESDTSupply(key)
+	if err != nil {
+		return nil, err
+	}
+
+	supplies[string(key)] = supply
+	return supply, nil
+}
+
 func (lp *logsProcessor) updateTokenSupply(tokenSupply *SupplyESDT, valueFromEvent *big.Int, eventIdentifier string, isRevert bool) {
 	isBurnOp := eventIdentifier == core.BuiltInFunctionESDTLocalBurn || eventIdentifier == core.BuiltInFunctionESDTNFTBurn ||
 		eventIdentifier == core.BuiltInFunctionESDTWipe
@@ -193,6 +219,38 @@ func (lp *logsProcessor) getESDTSupply(tokenIdentifier []byte) (*SupplyESDT, err
 	return supplyFromStorage, nil
 }
 
+func (lp *logsProcessor) getESDTSupplyHistory(tokenIdentifier []byte, fromEpoch uint32, toEpoch uint32) ([]*SupplyESDTInEpoch, error) {
+	history := make([]*SupplyESDTInEpoch, 0)
+	// iterating on uint64 avoids the overflow when toEpoch is the maximum uint32 value
+	for epoch := uint64(fromEpoch); epoch <= uint64(toEpoch); epoch++ {
+		supplyBytes, err := lp.suppliesStorer.Get(epochSupplyKey(tokenIdentifier, uint32(epoch)))
+		if err == storage.ErrKeyNotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		supply := &SupplyESDT{}
+		err = lp.marshalizer.Unmarshal(supply, supplyBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		makePropertiesNotNil(supply)
+		history = append(history, &SupplyESDTInEpoch{
+			Epoch:  uint32(epoch),
+			Supply: supply,
+		})
+	}
+
+	return history, nil
+}
+
+func epochSupplyKey(tokenIdentifier []byte, epoch uint32) []byte {
+	return []byte(fmt.Sprintf("%s%s%d", tokenIdentifier, epochSupplyKeySeparator, epoch))
+}
+
 func (lp *logsProcessor) shouldIgnoreEvent(event *transaction.Event) bool {
 	_, found := lp.fungibleOperations[string(event.Identifier)]
 
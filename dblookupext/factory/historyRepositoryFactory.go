@@ -74,6 +74,21 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		return nil, err
 	}
 
+	txHashesByAddressHandler, err := hpf.createTxHashesByAddressHandler()
+	if err != nil {
+		return nil, err
+	}
+
+	logEventsHandler, err := hpf.createLogEventsHandler()
+	if err != nil {
+		return nil, err
+	}
+
+	stateDiffsHandler, err := hpf.createStateDiffsHandler()
+	if err != nil {
+		return nil, err
+	}
+
 	historyRepArgs := dblookupext.HistoryRepositoryArguments{
 		SelfShardID:                 hpf.selfShardID,
 		Hasher:                      hpf.hasher,
@@ -85,10 +100,47 @@ func (hpf *historyRepositoryFactory) Create() (dblookupext.HistoryRepository, er
 		MiniblockHashByTxHashStorer: hpf.store.GetStorer(dataRetriever.MiniblockHashByTxHashUnit),
 		EventsHashesByTxHashStorer:  hpf.store.GetStorer(dataRetriever.ResultsHashesByTxHashUnit),
 		ESDTSuppliesHandler:         esdtSuppliesHandler,
+		TxHashesByAddressHandler:    txHashesByAddressHandler,
+		LogEventsHandler:            logEventsHandler,
+		StateDiffsHandler:           stateDiffsHandler,
 	}
 	return dblookupext.NewHistoryRepository(historyRepArgs)
 }
 
+func (hpf *historyRepositoryFactory) createTxHashesByAddressHandler() (dblookupext.TxHashesByAddressHandler, error) {
+	if !hpf.dbLookupExtensionsConfig.TxHashesByAddressEnabled {
+		return disabled.NewTxHashesByAddressIndex(), nil
+	}
+
+	return dblookupext.NewTxHashesByAddressIndex(dblookupext.ArgsTxHashesByAddressIndex{
+		TxHashesByAddressStorer: hpf.store.GetStorer(dataRetriever.TxHashesByAddressUnit),
+		EpochByAddressStorer:    hpf.store.GetStorer(dataRetriever.EpochByAddressUnit),
+		Marshalizer:             hpf.marshalizer,
+	})
+}
+
+func (hpf *historyRepositoryFactory) createLogEventsHandler() (dblookupext.LogEventsHandler, error) {
+	if !hpf.dbLookupExtensionsConfig.LogEventsIndexEnabled {
+		return disabled.NewLogEventsIndex(), nil
+	}
+
+	return dblookupext.NewLogEventsIndex(dblookupext.ArgsLogEventsIndex{
+		LogEventsStorer: hpf.store.GetStorer(dataRetriever.LogEventsUnit),
+		Marshalizer:     hpf.marshalizer,
+	})
+}
+
+func (hpf *historyRepositoryFactory) createStateDiffsHandler() (dblookupext.StateDiffsHandler, error) {
+	if !hpf.dbLookupExtensionsConfig.StateDiffsIndexEnabled {
+		return disabled.NewStateDiffsIndex(), nil
+	}
+
+	return dblookupext.NewStateDiffsIndex(dblookupext.ArgsStateDiffsIndex{
+		StateDiffsStorer: hpf.store.GetStorer(dataRetriever.StateDiffsUnit),
+		Marshalizer:      hpf.marshalizer,
+	})
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (hpf *historyRepositoryFactory) IsInterfaceNil() bool {
 	return hpf == nil
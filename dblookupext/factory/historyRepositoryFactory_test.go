@@ -75,6 +75,47 @@ func TestHistoryRepositoryFactory_CreateShouldCreateRegularRepository(t *testing
 	require.True(t, repository.IsEnabled())
 }
 
+func TestHistoryRepositoryFactory_CreateWithTxHashesByAddressShouldWork(t *testing.T) {
+	args := getArgs()
+	args.Config.Enabled = true
+	args.Config.TxHashesByAddressEnabled = true
+	requestedUnits := make(map[dataRetriever.UnitType]struct{})
+	args.Store = &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			requestedUnits[unitType] = struct{}{}
+			return &storageStubs.StorerStub{}
+		},
+	}
+
+	hrf, _ := factory.NewHistoryRepositoryFactory(args)
+
+	repository, err := hrf.Create()
+	require.NoError(t, err)
+	require.True(t, repository.IsEnabled())
+	require.Contains(t, requestedUnits, dataRetriever.TxHashesByAddressUnit)
+	require.Contains(t, requestedUnits, dataRetriever.EpochByAddressUnit)
+}
+
+func TestHistoryRepositoryFactory_CreateWithLogEventsIndexShouldWork(t *testing.T) {
+	args := getArgs()
+	args.Config.Enabled = true
+	args.Config.LogEventsIndexEnabled = true
+	requestedUnits := make(map[dataRetriever.UnitType]struct{})
+	args.Store = &mock.ChainStorerMock{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) storage.Storer {
+			requestedUnits[unitType] = struct{}{}
+			return &storageStubs.StorerStub{}
+		},
+	}
+
+	hrf, _ := factory.NewHistoryRepositoryFactory(args)
+
+	repository, err := hrf.Create()
+	require.NoError(t, err)
+	require.True(t, repository.IsEnabled())
+	require.Contains(t, requestedUnits, dataRetriever.LogEventsUnit)
+}
+
 func getArgs() *factory.ArgsHistoryRepositoryFactory {
 	return &factory.ArgsHistoryRepositoryFactory{
 		SelfShardID:              0,
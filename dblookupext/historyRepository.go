@@ -18,6 +18,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/common/logging"
 	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
 )
@@ -38,6 +39,9 @@ type HistoryRepositoryArguments struct {
 	Marshalizer                 marshal.Marshalizer
 	Hasher                      hashing.Hasher
 	ESDTSuppliesHandler         SuppliesHandler
+	TxHashesByAddressHandler    TxHashesByAddressHandler
+	LogEventsHandler            LogEventsHandler
+	StateDiffsHandler           StateDiffsHandler
 }
 
 type historyRepository struct {
@@ -51,6 +55,9 @@ type historyRepository struct {
 	marshalizer                marshal.Marshalizer
 	hasher                     hashing.Hasher
 	esdtSuppliesHandler        SuppliesHandler
+	txHashesByAddressHandler   TxHashesByAddressHandler
+	logEventsHandler           LogEventsHandler
+	stateDiffsHandler          StateDiffsHandler
 
 	// These maps temporarily hold notifications of "notarized at source or destination", to deal with unwanted concurrency effects
 	// The unwanted concurrency effects could be accentuated by the fast db-replay-validate mechanism.
@@ -64,6 +71,9 @@ type historyRepository struct {
 
 	recordBlockMutex                 sync.Mutex
 	consumePendingNotificationsMutex sync.Mutex
+
+	mutRecordedBlocksHandlers sync.RWMutex
+	recordedBlocksHandlers    []func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)
 }
 
 type notarizedNotification struct {
@@ -97,6 +107,15 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 	if check.IfNil(arguments.Uint64ByteSliceConverter) {
 		return nil, process.ErrNilUint64Converter
 	}
+	if check.IfNil(arguments.TxHashesByAddressHandler) {
+		return nil, errNilTxHashesByAddressHandler
+	}
+	if check.IfNil(arguments.LogEventsHandler) {
+		return nil, errNilLogEventsHandler
+	}
+	if check.IfNil(arguments.StateDiffsHandler) {
+		return nil, errNilStateDiffsHandler
+	}
 
 	hashToEpochIndex := newHashToEpochIndex(arguments.EpochByHashStorer, arguments.Marshalizer)
 	deduplicationCacheForInsertMiniblockMetadata, _ := lrucache.NewCache(sizeOfDeduplicationCache)
@@ -118,6 +137,10 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 		eventsHashesByTxHashIndex:                    eventsHashesToTxHashIndex,
 		esdtSuppliesHandler:                          arguments.ESDTSuppliesHandler,
 		uint64ByteSliceConverter:                     arguments.Uint64ByteSliceConverter,
+		txHashesByAddressHandler:                     arguments.TxHashesByAddressHandler,
+		logEventsHandler:                             arguments.LogEventsHandler,
+		stateDiffsHandler:                            arguments.StateDiffsHandler,
+		recordedBlocksHandlers:                       make([]func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte), 0),
 	}, nil
 }
 
@@ -126,6 +149,7 @@ func NewHistoryRepository(arguments HistoryRepositoryArguments) (*historyReposit
 func (hr *historyRepository) RecordBlock(blockHeaderHash []byte,
 	blockHeader data.HeaderHandler,
 	blockBody data.BodyHandler,
+	transactionsFromPool map[string]data.TransactionHandler,
 	scrResultsFromPool map[string]data.TransactionHandler,
 	receiptsFromPool map[string]data.TransactionHandler,
 	createdIntraShardMiniBlocks []*block.MiniBlock,
@@ -173,7 +197,22 @@ func (hr *historyRepository) RecordBlock(blockHeaderHash []byte,
 		return err
 	}
 
-	err = hr.esdtSuppliesHandler.ProcessLogs(blockHeader.GetNonce(), logs)
+	err = hr.esdtSuppliesHandler.ProcessLogs(blockHeader.GetNonce(), blockHeader.GetEpoch(), logs)
+	if err != nil {
+		return err
+	}
+
+	err = hr.recordTxHashesByAddress(blockHeaderHash, blockHeader, body, transactionsFromPool, scrResultsFromPool, createdIntraShardMiniBlocks)
+	if err != nil {
+		return err
+	}
+
+	err = hr.logEventsHandler.RecordBlock(blockHeaderHash, blockHeader, logs)
+	if err != nil {
+		return err
+	}
+
+	err = hr.stateDiffsHandler.RecordBlock(blockHeaderHash, blockHeader, body.MiniBlocks)
 	if err != nil {
 		return err
 	}
@@ -183,9 +222,34 @@ func (hr *historyRepository) RecordBlock(blockHeaderHash []byte,
 		return err
 	}
 
+	hr.notifyRecordedBlocksHandlers(hr.selfShardID, []data.HeaderHandler{blockHeader}, [][]byte{blockHeaderHash})
+
 	return nil
 }
 
+func (hr *historyRepository) recordTxHashesByAddress(
+	blockHeaderHash []byte,
+	blockHeader data.HeaderHandler,
+	body *block.Body,
+	transactionsFromPool map[string]data.TransactionHandler,
+	scrResultsFromPool map[string]data.TransactionHandler,
+	createdIntraShardMiniBlocks []*block.MiniBlock,
+) error {
+	transactions := make(map[string]data.TransactionHandler, len(transactionsFromPool)+len(scrResultsFromPool))
+	for hash, tx := range transactionsFromPool {
+		transactions[hash] = tx
+	}
+	for hash, scr := range scrResultsFromPool {
+		transactions[hash] = scr
+	}
+
+	miniBlocks := make([]*block.MiniBlock, 0, len(body.MiniBlocks)+len(createdIntraShardMiniBlocks))
+	miniBlocks = append(miniBlocks, body.MiniBlocks...)
+	miniBlocks = append(miniBlocks, createdIntraShardMiniBlocks...)
+
+	return hr.txHashesByAddressHandler.RecordBlock(blockHeaderHash, blockHeader, miniBlocks, transactions)
+}
+
 func (hr *historyRepository) putHashByRound(blockHeaderHash []byte, header data.HeaderHandler) error {
 	roundToByteSlice := hr.uint64ByteSliceConverter.ToByteSlice(header.GetRound())
 	return hr.blockHashByRound.Put(roundToByteSlice, blockHeaderHash)
@@ -332,6 +396,7 @@ func (hr *historyRepository) OnNotarizedBlocks(shardID uint32, headers []data.He
 	}
 
 	hr.consumePendingNotificationsWithLock()
+	hr.notifyRecordedBlocksHandlers(shardID, headers, headersHashes)
 }
 
 func (hr *historyRepository) onNotarizedInMetaBlock(metaBlockNonce uint64, metaBlockHash []byte, shardData *block.ShardData) {
@@ -468,6 +533,29 @@ func (hr *historyRepository) consumePendingNotificationsNoLock(pendingMap *conta
 	}
 }
 
+// RegisterRecordedBlocksHandler registers a handler to be called after the repository has recorded a committed block
+// of the self shard, or the notarization information brought by notarized blocks. The handlers are called synchronously,
+// so they should not block
+func (hr *historyRepository) RegisterRecordedBlocksHandler(handler func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)) {
+	if handler == nil {
+		log.Warn("attempt to register a nil handler to the history repository")
+		return
+	}
+
+	hr.mutRecordedBlocksHandlers.Lock()
+	hr.recordedBlocksHandlers = append(hr.recordedBlocksHandlers, handler)
+	hr.mutRecordedBlocksHandlers.Unlock()
+}
+
+func (hr *historyRepository) notifyRecordedBlocksHandlers(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte) {
+	hr.mutRecordedBlocksHandlers.RLock()
+	defer hr.mutRecordedBlocksHandlers.RUnlock()
+
+	for _, handler := range hr.recordedBlocksHandlers {
+		handler(shardID, headers, headersHashes)
+	}
+}
+
 // GetResultsHashesByTxHash will return results hashes by transaction hash
 func (hr *historyRepository) GetResultsHashesByTxHash(txHash []byte, epoch uint32) (*ResultsHashesByTxHash, error) {
 	return hr.eventsHashesByTxHashIndex.getEventsHashesByTxHash(txHash, epoch)
@@ -480,7 +568,36 @@ func (hr *historyRepository) IsEnabled() bool {
 
 // RevertBlock will return the modification for the current block header
 func (hr *historyRepository) RevertBlock(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error {
-	return hr.esdtSuppliesHandler.RevertChanges(blockHeader, blockBody)
+	err := hr.esdtSuppliesHandler.RevertChanges(blockHeader, blockBody)
+	if err != nil {
+		return err
+	}
+
+	if check.IfNil(blockHeader) {
+		return nil
+	}
+
+	blockHeaderHash, err := core.CalculateHash(hr.marshalizer, hr.hasher, blockHeader)
+	if err != nil {
+		return err
+	}
+
+	err = hr.txHashesByAddressHandler.RevertBlock(blockHeaderHash, blockHeader)
+	if err != nil {
+		return err
+	}
+
+	err = hr.logEventsHandler.RevertBlock(blockHeaderHash, blockHeader)
+	if err != nil {
+		return err
+	}
+
+	body, ok := blockBody.(*block.Body)
+	if !ok {
+		return nil
+	}
+
+	return hr.stateDiffsHandler.RevertBlock(blockHeaderHash, blockHeader, body.MiniBlocks)
 }
 
 // GetESDTSupply will return the supply from the storage for the given token
@@ -488,6 +605,31 @@ func (hr *historyRepository) GetESDTSupply(token string) (*esdtSupply.SupplyESDT
 	return hr.esdtSuppliesHandler.GetESDTSupply(token)
 }
 
+// GetESDTSupplyHistory will return the supply changes of the given token, for each epoch in the provided range
+func (hr *historyRepository) GetESDTSupplyHistory(token string, fromEpoch uint32, toEpoch uint32) ([]*esdtSupply.SupplyESDTInEpoch, error) {
+	return hr.esdtSuppliesHandler.GetESDTSupplyHistory(token, fromEpoch, toEpoch)
+}
+
+// GetTxHashesByAddress will return a page of the transactions hashes recorded for the given address
+func (hr *historyRepository) GetTxHashesByAddress(address []byte, from uint32, size uint32, ascending bool) ([]*TxHashByAddress, error) {
+	return hr.txHashesByAddressHandler.GetTxHashesByAddress(address, from, size, ascending)
+}
+
+// GetLogEventsByBlockNonce will return the log events recorded for the block with the given nonce
+func (hr *historyRepository) GetLogEventsByBlockNonce(blockNonce uint64) (*LogEventsByBlock, error) {
+	return hr.logEventsHandler.GetLogEventsByBlockNonce(blockNonce)
+}
+
+// AddStateChanges will keep the state changes of a processed transaction, so they can be recorded along with its block
+func (hr *historyRepository) AddStateChanges(txHash []byte, stateChanges []*state.AccountStateChanges) {
+	hr.stateDiffsHandler.AddStateChanges(txHash, stateChanges)
+}
+
+// GetStateDiffByTxHash will return the state diff recorded, in the provided epoch, for the given transaction
+func (hr *historyRepository) GetStateDiffByTxHash(txHash []byte, epoch uint32) ([]*state.AccountStateChanges, error) {
+	return hr.stateDiffsHandler.GetStateDiff(txHash, epoch)
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (hr *historyRepository) IsInterfaceNil() bool {
 	return hr == nil
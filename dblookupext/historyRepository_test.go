@@ -2,16 +2,19 @@ package dblookupext
 
 import (
 	"errors"
+	"math/big"
 	"sync"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/common/mock"
 	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
 	epochStartMocks "github.com/ElrondNetwork/elrond-go/epochStart/mock"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
 	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
@@ -26,6 +29,19 @@ func createMockHistoryRepoArgs(epoch uint32) HistoryRepositoryArguments {
 			return nil, storage.ErrKeyNotFound
 		},
 	}, &storageStubs.StorerStub{})
+	txHashesByAddressIndex, _ := NewTxHashesByAddressIndex(ArgsTxHashesByAddressIndex{
+		TxHashesByAddressStorer: genericMocks.NewStorerMockWithEpoch(epoch),
+		EpochByAddressStorer:    genericMocks.NewStorerMockWithEpoch(epoch),
+		Marshalizer:             &mock.MarshalizerMock{},
+	})
+	logEventsIndex, _ := NewLogEventsIndex(ArgsLogEventsIndex{
+		LogEventsStorer: genericMocks.NewStorerMockWithEpoch(epoch),
+		Marshalizer:     &mock.MarshalizerMock{},
+	})
+	stateDiffsIndex, _ := NewStateDiffsIndex(ArgsStateDiffsIndex{
+		StateDiffsStorer: genericMocks.NewStorerMockWithEpoch(epoch),
+		Marshalizer:      &mock.MarshalizerMock{},
+	})
 
 	args := HistoryRepositoryArguments{
 		SelfShardID:                 0,
@@ -38,6 +54,9 @@ func createMockHistoryRepoArgs(epoch uint32) HistoryRepositoryArguments {
 		Hasher:                      &hashingMocks.HasherMock{},
 		ESDTSuppliesHandler:         sp,
 		Uint64ByteSliceConverter:    &epochStartMocks.Uint64ByteSliceConverterMock{},
+		TxHashesByAddressHandler:    txHashesByAddressIndex,
+		LogEventsHandler:            logEventsIndex,
+		StateDiffsHandler:           stateDiffsIndex,
 	}
 
 	return args
@@ -88,6 +107,24 @@ func TestNewHistoryRepository(t *testing.T) {
 	require.Nil(t, repo)
 	require.Equal(t, process.ErrNilUint64Converter, err)
 
+	args = createMockHistoryRepoArgs(0)
+	args.TxHashesByAddressHandler = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, errNilTxHashesByAddressHandler, err)
+
+	args = createMockHistoryRepoArgs(0)
+	args.LogEventsHandler = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, errNilLogEventsHandler, err)
+
+	args = createMockHistoryRepoArgs(0)
+	args.StateDiffsHandler = nil
+	repo, err = NewHistoryRepository(args)
+	require.Nil(t, repo)
+	require.Equal(t, errNilStateDiffsHandler, err)
+
 	args = createMockHistoryRepoArgs(0)
 	repo, err = NewHistoryRepository(args)
 	require.Nil(t, err)
@@ -108,7 +145,7 @@ func TestHistoryRepository_RecordBlockInvalidBlockRoundByHashStorerExpectError(t
 	repo, err := NewHistoryRepository(args)
 	require.Nil(t, err)
 
-	err = repo.RecordBlock([]byte("headerHash"), &block.Header{}, &block.Body{}, nil, nil, nil, nil)
+	err = repo.RecordBlock([]byte("headerHash"), &block.Header{}, &block.Body{}, nil, nil, nil, nil, nil)
 	require.Equal(t, err, errPut)
 }
 
@@ -140,7 +177,7 @@ func TestHistoryRepository_RecordBlock(t *testing.T) {
 		},
 	}
 
-	err = repo.RecordBlock(headerHash, blockHeader, blockBody, nil, nil, nil, nil)
+	err = repo.RecordBlock(headerHash, blockHeader, blockBody, nil, nil, nil, nil, nil)
 	require.Nil(t, err)
 	// Two miniblocks
 	require.Equal(t, 2, repo.miniblocksMetadataStorer.(*genericMocks.StorerMock).GetCurrentEpochData().Len())
@@ -152,6 +189,97 @@ func TestHistoryRepository_RecordBlock(t *testing.T) {
 	require.Equal(t, 1, repo.blockHashByRound.(*genericMocks.StorerMock).GetCurrentEpochData().Len())
 }
 
+func TestHistoryRepository_RecordBlockShouldRecordLogEvents(t *testing.T) {
+	t.Parallel()
+
+	args := createMockHistoryRepoArgs(0)
+	repo, err := NewHistoryRepository(args)
+	require.Nil(t, err)
+
+	logs := []*data.LogData{
+		{
+			TxHash: "txA",
+			LogHandler: &transaction.Log{
+				Events: []*transaction.Event{{Address: []byte("contract"), Identifier: []byte("transfer")}},
+			},
+		},
+	}
+
+	err = repo.RecordBlock([]byte("headerHash"), &block.Header{Nonce: 4}, &block.Body{}, nil, nil, nil, nil, logs)
+	require.Nil(t, err)
+
+	record, err := repo.GetLogEventsByBlockNonce(4)
+	require.Nil(t, err)
+	require.Equal(t, []byte("headerHash"), record.BlockHash)
+	require.Len(t, record.Events, 1)
+	require.Equal(t, []byte("txA"), record.Events[0].TxHash)
+}
+
+func TestHistoryRepository_RecordBlockShouldRecordStateDiffs(t *testing.T) {
+	t.Parallel()
+
+	args := createMockHistoryRepoArgs(3)
+	repo, err := NewHistoryRepository(args)
+	require.Nil(t, err)
+
+	repo.AddStateChanges([]byte("txA"), []*state.AccountStateChanges{
+		{
+			Address:       []byte("alice"),
+			BalanceBefore: big.NewInt(100),
+			BalanceAfter:  big.NewInt(90),
+			NonceBefore:   1,
+			NonceAfter:    2,
+		},
+	})
+
+	body := &block.Body{
+		MiniBlocks: []*block.MiniBlock{{Type: block.TxBlock, TxHashes: [][]byte{[]byte("txA")}}},
+	}
+	err = repo.RecordBlock([]byte("headerHash"), &block.Header{Epoch: 3}, body, nil, nil, nil, nil, nil)
+	require.Nil(t, err)
+
+	stateDiff, err := repo.GetStateDiffByTxHash([]byte("txA"), 3)
+	require.Nil(t, err)
+	require.Len(t, stateDiff, 1)
+	require.Equal(t, []byte("alice"), stateDiff[0].Address)
+	require.Equal(t, big.NewInt(90), stateDiff[0].BalanceAfter)
+}
+
+func TestHistoryRepository_RegisterRecordedBlocksHandler(t *testing.T) {
+	t.Parallel()
+
+	args := createMockHistoryRepoArgs(0)
+	repo, err := NewHistoryRepository(args)
+	require.Nil(t, err)
+
+	repo.RegisterRecordedBlocksHandler(nil)
+
+	type notification struct {
+		shardID       uint32
+		headersHashes [][]byte
+	}
+	notifications := make([]notification, 0)
+	repo.RegisterRecordedBlocksHandler(func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte) {
+		require.Equal(t, len(headersHashes), len(headers))
+		notifications = append(notifications, notification{shardID: shardID, headersHashes: headersHashes})
+	})
+
+	err = repo.RecordBlock([]byte("headerHash"), &block.Header{Nonce: 4}, &block.Body{}, nil, nil, nil, nil, nil)
+	require.Nil(t, err)
+
+	// failed recordings are not notified
+	err = repo.RecordBlock([]byte("headerHash"), &block.Header{Nonce: 5}, nil, nil, nil, nil, nil, nil)
+	require.Equal(t, errCannotCastToBlockBody, err)
+
+	repo.OnNotarizedBlocks(core.MetachainShardId, []data.HeaderHandler{&block.MetaBlock{Nonce: 7}}, [][]byte{[]byte("metaHash")})
+
+	expectedNotifications := []notification{
+		{shardID: 0, headersHashes: [][]byte{[]byte("headerHash")}},
+		{shardID: core.MetachainShardId, headersHashes: [][]byte{[]byte("metaHash")}},
+	}
+	require.Equal(t, expectedNotifications, notifications)
+}
+
 func TestHistoryRepository_GetMiniblockMetadata(t *testing.T) {
 	t.Parallel()
 
@@ -176,7 +304,7 @@ func TestHistoryRepository_GetMiniblockMetadata(t *testing.T) {
 				miniblockB,
 			},
 		},
-		nil, nil, nil, nil,
+		nil, nil, nil, nil, nil,
 	)
 
 	metadata, err := repo.GetMiniblockMetadataByTxHash([]byte("txA"))
@@ -214,7 +342,7 @@ func TestHistoryRepository_GetEpochForHash(t *testing.T) {
 			miniblockA,
 			miniblockB,
 		},
-	}, nil, nil, nil, nil)
+	}, nil, nil, nil, nil, nil)
 
 	// Get epoch by block hash
 	epoch, err := repo.GetEpochByHash([]byte("fooblock"))
@@ -272,7 +400,7 @@ func TestHistoryRepository_OnNotarizedBlocks(t *testing.T) {
 				miniblockB,
 				miniblockC,
 			},
-		}, nil, nil, nil, nil,
+		}, nil, nil, nil, nil, nil,
 	)
 
 	// Check "notarization coordinates"
@@ -472,7 +600,7 @@ func TestHistoryRepository_OnNotarizedBlocksAtSourceBeforeCommittingAtDestinatio
 			MiniBlocks: []*block.MiniBlock{
 				miniblockA,
 			},
-		}, nil, nil, nil, nil,
+		}, nil, nil, nil, nil, nil,
 	)
 	_ = repo.RecordBlock([]byte("barBlock"),
 		&block.Header{Epoch: 42, Round: 4322},
@@ -480,7 +608,7 @@ func TestHistoryRepository_OnNotarizedBlocksAtSourceBeforeCommittingAtDestinatio
 			MiniBlocks: []*block.MiniBlock{
 				miniblockB,
 			},
-		}, nil, nil, nil, nil,
+		}, nil, nil, nil, nil, nil,
 	)
 
 	// Notifications have not been cleared after record block
@@ -527,7 +655,7 @@ func TestHistoryRepository_OnNotarizedBlocksCrossEpoch(t *testing.T) {
 			MiniBlocks: []*block.MiniBlock{
 				miniblockA,
 			},
-		}, nil, nil, nil, nil,
+		}, nil, nil, nil, nil, nil,
 	)
 
 	// Now let's receive a metablock and the "notarized" notification, in the next epoch
@@ -584,7 +712,7 @@ func TestHistoryRepository_CommitOnForkThenNewEpochThenCommit(t *testing.T) {
 			MiniBlocks: []*block.MiniBlock{
 				miniblock,
 			},
-		}, nil, nil, nil, nil,
+		}, nil, nil, nil, nil, nil,
 	)
 
 	// Let's go to next epoch
@@ -597,7 +725,7 @@ func TestHistoryRepository_CommitOnForkThenNewEpochThenCommit(t *testing.T) {
 			MiniBlocks: []*block.MiniBlock{
 				miniblock,
 			},
-		}, nil, nil, nil, nil,
+		}, nil, nil, nil, nil, nil,
 	)
 
 	// Now let's receive a metablock and the "notarized" notification
@@ -711,7 +839,7 @@ func TestHistoryRepository_ConcurrentlyRecordAndNotarizeSameBlockMultipleTimes(t
 					MiniBlocks: []*block.MiniBlock{
 						miniblock,
 					},
-				}, nil, nil, nil, nil,
+				}, nil, nil, nil, nil, nil,
 			)
 		}
 
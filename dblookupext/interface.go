@@ -4,6 +4,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
 	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
+	"github.com/ElrondNetwork/elrond-go/state"
 )
 
 // HistoryRepositoryFactory can create new instances of HistoryRepository
@@ -17,16 +18,23 @@ type HistoryRepository interface {
 	RecordBlock(blockHeaderHash []byte,
 		blockHeader data.HeaderHandler,
 		blockBody data.BodyHandler,
+		transactionsFromPool map[string]data.TransactionHandler,
 		scrResultsFromPool map[string]data.TransactionHandler,
 		receiptsFromPool map[string]data.TransactionHandler,
 		createdIntraShardMiniBlocks []*block.MiniBlock,
 		logs []*data.LogData) error
 	OnNotarizedBlocks(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)
+	RegisterRecordedBlocksHandler(handler func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte))
 	GetMiniblockMetadataByTxHash(hash []byte) (*MiniblockMetadata, error)
 	GetEpochByHash(hash []byte) (uint32, error)
 	GetResultsHashesByTxHash(txHash []byte, epoch uint32) (*ResultsHashesByTxHash, error)
 	RevertBlock(blockHeader data.HeaderHandler, blockBody data.BodyHandler) error
 	GetESDTSupply(token string) (*esdtSupply.SupplyESDT, error)
+	GetESDTSupplyHistory(token string, fromEpoch uint32, toEpoch uint32) ([]*esdtSupply.SupplyESDTInEpoch, error)
+	GetTxHashesByAddress(address []byte, from uint32, size uint32, ascending bool) ([]*TxHashByAddress, error)
+	GetLogEventsByBlockNonce(blockNonce uint64) (*LogEventsByBlock, error)
+	AddStateChanges(txHash []byte, stateChanges []*state.AccountStateChanges)
+	GetStateDiffByTxHash(txHash []byte, epoch uint32) ([]*state.AccountStateChanges, error)
 	IsEnabled() bool
 	IsInterfaceNil() bool
 }
@@ -42,8 +50,34 @@ type BlockTracker interface {
 
 // SuppliesHandler defines the interface of a supplies processor
 type SuppliesHandler interface {
-	ProcessLogs(blockNonce uint64, logs []*data.LogData) error
+	ProcessLogs(blockNonce uint64, epoch uint32, logs []*data.LogData) error
 	RevertChanges(header data.HeaderHandler, body data.BodyHandler) error
 	GetESDTSupply(token string) (*esdtSupply.SupplyESDT, error)
+	GetESDTSupplyHistory(token string, fromEpoch uint32, toEpoch uint32) ([]*esdtSupply.SupplyESDTInEpoch, error)
+	IsInterfaceNil() bool
+}
+
+// TxHashesByAddressHandler defines the interface of an index holding the transactions hashes by address
+type TxHashesByAddressHandler interface {
+	RecordBlock(blockHeaderHash []byte, blockHeader data.HeaderHandler, miniBlocks []*block.MiniBlock, transactions map[string]data.TransactionHandler) error
+	RevertBlock(blockHeaderHash []byte, blockHeader data.HeaderHandler) error
+	GetTxHashesByAddress(address []byte, from uint32, size uint32, ascending bool) ([]*TxHashByAddress, error)
+	IsInterfaceNil() bool
+}
+
+// LogEventsHandler defines the interface of an index holding the log events by block nonce
+type LogEventsHandler interface {
+	RecordBlock(blockHeaderHash []byte, blockHeader data.HeaderHandler, logs []*data.LogData) error
+	RevertBlock(blockHeaderHash []byte, blockHeader data.HeaderHandler) error
+	GetLogEventsByBlockNonce(blockNonce uint64) (*LogEventsByBlock, error)
+	IsInterfaceNil() bool
+}
+
+// StateDiffsHandler defines the interface of an index holding the state diffs of the processed transactions
+type StateDiffsHandler interface {
+	AddStateChanges(txHash []byte, stateChanges []*state.AccountStateChanges)
+	RecordBlock(blockHeaderHash []byte, blockHeader data.HeaderHandler, miniBlocks []*block.MiniBlock) error
+	RevertBlock(blockHeaderHash []byte, blockHeader data.HeaderHandler, miniBlocks []*block.MiniBlock) error
+	GetStateDiff(txHash []byte, epoch uint32) ([]*state.AccountStateChanges, error)
 	IsInterfaceNil() bool
 }
@@ -0,0 +1,135 @@
+//go:generate protoc -I=. -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. logEventsByBlock.proto
+
+package dblookupext
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+const uint64Size = 8
+
+// ArgsLogEventsIndex holds the arguments needed to create a new log events index
+type ArgsLogEventsIndex struct {
+	LogEventsStorer storage.Storer
+	Marshalizer     marshal.Marshalizer
+}
+
+// logEventsIndex records the log events generated within each block, keyed by the block nonce. The records are held
+// in the epoch of the block, thus only the blocks of the active epochs can be queried.
+type logEventsIndex struct {
+	storer      storage.Storer
+	marshalizer marshal.Marshalizer
+}
+
+// NewLogEventsIndex creates a new instance of logEventsIndex
+func NewLogEventsIndex(args ArgsLogEventsIndex) (*logEventsIndex, error) {
+	if check.IfNil(args.LogEventsStorer) {
+		return nil, core.ErrNilStore
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, core.ErrNilMarshalizer
+	}
+
+	return &logEventsIndex{
+		storer:      args.LogEventsStorer,
+		marshalizer: args.Marshalizer,
+	}, nil
+}
+
+// RecordBlock records the log events of the provided block, in execution order. Blocks without events are recorded as well,
+// so that the record of a previously recorded block with the same nonce (e.g. on a fork) is always overwritten.
+func (lei *logEventsIndex) RecordBlock(blockHeaderHash []byte, blockHeader data.HeaderHandler, logs []*data.LogData) error {
+	if check.IfNil(blockHeader) {
+		return errNilBlockHeader
+	}
+
+	record := &LogEventsByBlock{
+		BlockHash:  blockHeaderHash,
+		BlockNonce: blockHeader.GetNonce(),
+		Round:      blockHeader.GetRound(),
+		Epoch:      blockHeader.GetEpoch(),
+		Events:     make([]*IndexedLogEvent, 0),
+	}
+
+	for _, logData := range logs {
+		if logData == nil || check.IfNil(logData.LogHandler) {
+			continue
+		}
+
+		for _, event := range logData.GetLogEvents() {
+			if check.IfNil(event) {
+				continue
+			}
+
+			record.Events = append(record.Events, &IndexedLogEvent{
+				TxHash:     []byte(logData.TxHash),
+				Address:    event.GetAddress(),
+				Identifier: event.GetIdentifier(),
+				Topics:     event.GetTopics(),
+				Data:       event.GetData(),
+				Order:      uint32(len(record.Events)),
+			})
+		}
+	}
+
+	rawBytes, err := lei.marshalizer.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	return lei.storer.PutInEpoch(keyOfLogEventsByBlock(record.BlockNonce), rawBytes, record.Epoch)
+}
+
+// RevertBlock removes the log events recorded for the provided block, if any
+func (lei *logEventsIndex) RevertBlock(blockHeaderHash []byte, blockHeader data.HeaderHandler) error {
+	if check.IfNil(blockHeader) {
+		return errNilBlockHeader
+	}
+
+	record, err := lei.GetLogEventsByBlockNonce(blockHeader.GetNonce())
+	if err != nil {
+		return nil
+	}
+	if !bytes.Equal(record.BlockHash, blockHeaderHash) {
+		log.Debug("logEventsIndex.RevertBlock(): block not recorded, nothing to revert",
+			"nonce", blockHeader.GetNonce(), "hash", blockHeaderHash)
+		return nil
+	}
+
+	return lei.storer.Remove(keyOfLogEventsByBlock(blockHeader.GetNonce()))
+}
+
+// GetLogEventsByBlockNonce returns the log events recorded for the block with the given nonce
+func (lei *logEventsIndex) GetLogEventsByBlockNonce(blockNonce uint64) (*LogEventsByBlock, error) {
+	rawBytes, err := lei.storer.SearchFirst(keyOfLogEventsByBlock(blockNonce))
+	if err != nil {
+		return nil, err
+	}
+
+	record := &LogEventsByBlock{}
+	err = lei.marshalizer.Unmarshal(record, rawBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+func keyOfLogEventsByBlock(blockNonce uint64) []byte {
+	key := make([]byte, uint64Size)
+	binary.BigEndian.PutUint64(key, blockNonce)
+
+	return key
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (lei *logEventsIndex) IsInterfaceNil() bool {
+	return lei == nil
+}
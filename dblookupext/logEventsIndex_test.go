@@ -0,0 +1,146 @@
+package dblookupext
+
+import (
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsLogEventsIndex() ArgsLogEventsIndex {
+	return ArgsLogEventsIndex{
+		LogEventsStorer: genericMocks.NewStorerMockWithEpoch(0),
+		Marshalizer:     &mock.MarshalizerMock{},
+	}
+}
+
+func createLogData(txHash string, identifiers ...string) *data.LogData {
+	txLog := &transaction.Log{Address: []byte("contract")}
+	for _, identifier := range identifiers {
+		txLog.Events = append(txLog.Events, &transaction.Event{
+			Address:    []byte("contract"),
+			Identifier: []byte(identifier),
+			Topics:     [][]byte{[]byte("topic")},
+			Data:       []byte("data"),
+		})
+	}
+
+	return &data.LogData{
+		LogHandler: txLog,
+		TxHash:     txHash,
+	}
+}
+
+func TestNewLogEventsIndex(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsLogEventsIndex()
+	args.LogEventsStorer = nil
+	index, err := NewLogEventsIndex(args)
+	require.True(t, check.IfNil(index))
+	require.Equal(t, core.ErrNilStore, err)
+
+	args = createMockArgsLogEventsIndex()
+	args.Marshalizer = nil
+	index, err = NewLogEventsIndex(args)
+	require.True(t, check.IfNil(index))
+	require.Equal(t, core.ErrNilMarshalizer, err)
+
+	args = createMockArgsLogEventsIndex()
+	index, err = NewLogEventsIndex(args)
+	require.False(t, check.IfNil(index))
+	require.Nil(t, err)
+}
+
+func TestLogEventsIndex_RecordBlockShouldRecordEventsInOrder(t *testing.T) {
+	t.Parallel()
+
+	index, _ := NewLogEventsIndex(createMockArgsLogEventsIndex())
+
+	header := &block.Header{Nonce: 7, Round: 8}
+	logs := []*data.LogData{
+		createLogData("txA", "ESDTTransfer", "writeLog"),
+		nil,
+		{TxHash: "nilLog"},
+		createLogData("txB", "completedTxEvent"),
+	}
+
+	err := index.RecordBlock([]byte("hash7"), header, logs)
+	require.Nil(t, err)
+
+	record, err := index.GetLogEventsByBlockNonce(7)
+	require.Nil(t, err)
+	require.Equal(t, []byte("hash7"), record.BlockHash)
+	require.Equal(t, uint64(7), record.BlockNonce)
+	require.Equal(t, uint64(8), record.Round)
+	require.Len(t, record.Events, 3)
+
+	expectedTxHashes := []string{"txA", "txA", "txB"}
+	expectedIdentifiers := []string{"ESDTTransfer", "writeLog", "completedTxEvent"}
+	for i, event := range record.Events {
+		require.Equal(t, expectedTxHashes[i], string(event.TxHash))
+		require.Equal(t, expectedIdentifiers[i], string(event.Identifier))
+		require.Equal(t, uint32(i), event.Order)
+		require.Equal(t, []byte("contract"), event.Address)
+		require.Equal(t, [][]byte{[]byte("topic")}, event.Topics)
+		require.Equal(t, []byte("data"), event.Data)
+	}
+
+	_, err = index.GetLogEventsByBlockNonce(8)
+	require.NotNil(t, err)
+
+	err = index.RecordBlock([]byte("hash"), nil, logs)
+	require.Equal(t, errNilBlockHeader, err)
+}
+
+func TestLogEventsIndex_RecordBlockOnForkShouldOverwrite(t *testing.T) {
+	t.Parallel()
+
+	index, _ := NewLogEventsIndex(createMockArgsLogEventsIndex())
+
+	header := &block.Header{Nonce: 7}
+	err := index.RecordBlock([]byte("hash7-fork"), header, []*data.LogData{createLogData("txFork", "ESDTTransfer")})
+	require.Nil(t, err)
+
+	err = index.RecordBlock([]byte("hash7"), header, nil)
+	require.Nil(t, err)
+
+	record, err := index.GetLogEventsByBlockNonce(7)
+	require.Nil(t, err)
+	require.Equal(t, []byte("hash7"), record.BlockHash)
+	require.Empty(t, record.Events)
+}
+
+func TestLogEventsIndex_RevertBlock(t *testing.T) {
+	t.Parallel()
+
+	index, _ := NewLogEventsIndex(createMockArgsLogEventsIndex())
+
+	header := &block.Header{Nonce: 7}
+	err := index.RecordBlock([]byte("hash7"), header, []*data.LogData{createLogData("txA", "ESDTTransfer")})
+	require.Nil(t, err)
+
+	// other blocks with the same nonce are ignored
+	err = index.RevertBlock([]byte("hash7-other"), header)
+	require.Nil(t, err)
+	_, err = index.GetLogEventsByBlockNonce(7)
+	require.Nil(t, err)
+
+	err = index.RevertBlock([]byte("hash7"), header)
+	require.Nil(t, err)
+	_, err = index.GetLogEventsByBlockNonce(7)
+	require.NotNil(t, err)
+
+	// unknown blocks are ignored
+	err = index.RevertBlock([]byte("hash8"), &block.Header{Nonce: 8})
+	require.Nil(t, err)
+
+	err = index.RevertBlock([]byte("hash7"), nil)
+	require.Equal(t, errNilBlockHeader, err)
+}
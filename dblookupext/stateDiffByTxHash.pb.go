@@ -0,0 +1,1351 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: stateDiffByTxHash.proto
+
+package dblookupext
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DataTrieValueDiff holds the values of a data trie key, before and after the execution of a transaction
+type DataTrieValueDiff struct {
+	Key    []byte `protobuf:"bytes,1,opt,name=Key,proto3" json:"Key,omitempty"`
+	Before []byte `protobuf:"bytes,2,opt,name=Before,proto3" json:"Before,omitempty"`
+	After  []byte `protobuf:"bytes,3,opt,name=After,proto3" json:"After,omitempty"`
+}
+
+func (m *DataTrieValueDiff) Reset()      { *m = DataTrieValueDiff{} }
+func (*DataTrieValueDiff) ProtoMessage() {}
+func (*DataTrieValueDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4e4eea817efed3e, []int{0}
+}
+func (m *DataTrieValueDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataTrieValueDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DataTrieValueDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataTrieValueDiff.Merge(m, src)
+}
+func (m *DataTrieValueDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataTrieValueDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataTrieValueDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataTrieValueDiff proto.InternalMessageInfo
+
+func (m *DataTrieValueDiff) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *DataTrieValueDiff) GetBefore() []byte {
+	if m != nil {
+		return m.Before
+	}
+	return nil
+}
+
+func (m *DataTrieValueDiff) GetAfter() []byte {
+	if m != nil {
+		return m.After
+	}
+	return nil
+}
+
+// AccountStateDiff holds the changes made on an account by the execution of a transaction
+type AccountStateDiff struct {
+	Address       []byte               `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	BalanceBefore []byte               `protobuf:"bytes,2,opt,name=BalanceBefore,proto3" json:"BalanceBefore,omitempty"`
+	BalanceAfter  []byte               `protobuf:"bytes,3,opt,name=BalanceAfter,proto3" json:"BalanceAfter,omitempty"`
+	NonceBefore   uint64               `protobuf:"varint,4,opt,name=NonceBefore,proto3" json:"NonceBefore,omitempty"`
+	NonceAfter    uint64               `protobuf:"varint,5,opt,name=NonceAfter,proto3" json:"NonceAfter,omitempty"`
+	DataTrie      []*DataTrieValueDiff `protobuf:"bytes,6,rep,name=DataTrie,proto3" json:"DataTrie,omitempty"`
+}
+
+func (m *AccountStateDiff) Reset()      { *m = AccountStateDiff{} }
+func (*AccountStateDiff) ProtoMessage() {}
+func (*AccountStateDiff) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4e4eea817efed3e, []int{1}
+}
+func (m *AccountStateDiff) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountStateDiff) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AccountStateDiff) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountStateDiff.Merge(m, src)
+}
+func (m *AccountStateDiff) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountStateDiff) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountStateDiff.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountStateDiff proto.InternalMessageInfo
+
+func (m *AccountStateDiff) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *AccountStateDiff) GetBalanceBefore() []byte {
+	if m != nil {
+		return m.BalanceBefore
+	}
+	return nil
+}
+
+func (m *AccountStateDiff) GetBalanceAfter() []byte {
+	if m != nil {
+		return m.BalanceAfter
+	}
+	return nil
+}
+
+func (m *AccountStateDiff) GetNonceBefore() uint64 {
+	if m != nil {
+		return m.NonceBefore
+	}
+	return 0
+}
+
+func (m *AccountStateDiff) GetNonceAfter() uint64 {
+	if m != nil {
+		return m.NonceAfter
+	}
+	return 0
+}
+
+func (m *AccountStateDiff) GetDataTrie() []*DataTrieValueDiff {
+	if m != nil {
+		return m.DataTrie
+	}
+	return nil
+}
+
+// StateDiffByTxHash holds the changes made on the accounts by the execution of a transaction
+type StateDiffByTxHash struct {
+	TxHash    []byte              `protobuf:"bytes,1,opt,name=TxHash,proto3" json:"TxHash,omitempty"`
+	BlockHash []byte              `protobuf:"bytes,2,opt,name=BlockHash,proto3" json:"BlockHash,omitempty"`
+	Accounts  []*AccountStateDiff `protobuf:"bytes,3,rep,name=Accounts,proto3" json:"Accounts,omitempty"`
+}
+
+func (m *StateDiffByTxHash) Reset()      { *m = StateDiffByTxHash{} }
+func (*StateDiffByTxHash) ProtoMessage() {}
+func (*StateDiffByTxHash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e4e4eea817efed3e, []int{2}
+}
+func (m *StateDiffByTxHash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StateDiffByTxHash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StateDiffByTxHash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StateDiffByTxHash.Merge(m, src)
+}
+func (m *StateDiffByTxHash) XXX_Size() int {
+	return m.Size()
+}
+func (m *StateDiffByTxHash) XXX_DiscardUnknown() {
+	xxx_messageInfo_StateDiffByTxHash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StateDiffByTxHash proto.InternalMessageInfo
+
+func (m *StateDiffByTxHash) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *StateDiffByTxHash) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *StateDiffByTxHash) GetAccounts() []*AccountStateDiff {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DataTrieValueDiff)(nil), "proto.DataTrieValueDiff")
+	proto.RegisterType((*AccountStateDiff)(nil), "proto.AccountStateDiff")
+	proto.RegisterType((*StateDiffByTxHash)(nil), "proto.StateDiffByTxHash")
+}
+
+func init() { proto.RegisterFile("stateDiffByTxHash.proto", fileDescriptor_e4e4eea817efed3e) }
+
+var fileDescriptor_e4e4eea817efed3e = []byte{
+	// 375 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0x4f, 0x6b, 0xe2, 0x40,
+	0x18, 0xc6, 0x33, 0x1b, 0x75, 0xdd, 0x57, 0x17, 0x74, 0x58, 0xd6, 0x61, 0x59, 0x86, 0x10, 0x7a,
+	0xf0, 0x52, 0x85, 0xda, 0x2f, 0x60, 0xb0, 0x50, 0x28, 0x78, 0x88, 0xd2, 0x43, 0x6f, 0x49, 0x9c,
+	0xa8, 0x98, 0x3a, 0x92, 0x4c, 0x40, 0x2f, 0xa5, 0x1f, 0xa1, 0x1f, 0xa3, 0x1f, 0xa5, 0x47, 0x8f,
+	0x1e, 0xeb, 0x78, 0xe9, 0xa9, 0xf8, 0x11, 0x8a, 0x93, 0xc4, 0x7f, 0x3d, 0xe5, 0x7d, 0x7e, 0x79,
+	0xf2, 0xfe, 0x79, 0x02, 0xb5, 0x48, 0x38, 0x82, 0x75, 0xc6, 0xbe, 0x6f, 0x2d, 0xfa, 0xf3, 0x5b,
+	0x27, 0x1a, 0x35, 0x66, 0x21, 0x17, 0x1c, 0xe7, 0xd5, 0xe3, 0xdf, 0xe5, 0x70, 0x2c, 0x46, 0xb1,
+	0xdb, 0xf0, 0xf8, 0x63, 0x73, 0xc8, 0x87, 0xbc, 0xa9, 0xb0, 0x1b, 0xfb, 0x4a, 0x29, 0xa1, 0xaa,
+	0xe4, 0x2b, 0xb3, 0x07, 0xd5, 0x8e, 0x23, 0x9c, 0x7e, 0x38, 0x66, 0xf7, 0x4e, 0x10, 0xab, 0xc6,
+	0xb8, 0x02, 0xfa, 0x1d, 0x5b, 0x10, 0x64, 0xa0, 0x7a, 0xd9, 0xde, 0x95, 0xf8, 0x2f, 0x14, 0x2c,
+	0xe6, 0xf3, 0x90, 0x91, 0x1f, 0x0a, 0xa6, 0x0a, 0xff, 0x81, 0x7c, 0xdb, 0x17, 0x2c, 0x24, 0xba,
+	0xc2, 0x89, 0x30, 0x3f, 0x11, 0x54, 0xda, 0x9e, 0xc7, 0xe3, 0xa9, 0xe8, 0x65, 0xdb, 0x62, 0x02,
+	0x3f, 0xdb, 0x83, 0x41, 0xc8, 0xa2, 0x28, 0x6d, 0x9c, 0x49, 0x7c, 0x01, 0xbf, 0x2d, 0x27, 0x70,
+	0xa6, 0x1e, 0x3b, 0x99, 0x71, 0x0a, 0xb1, 0x09, 0xe5, 0x14, 0x1c, 0x4f, 0x3c, 0x61, 0xd8, 0x80,
+	0x52, 0x97, 0x1f, 0xfa, 0xe4, 0x0c, 0x54, 0xcf, 0xd9, 0xc7, 0x08, 0x53, 0x80, 0x2e, 0xcf, 0xfc,
+	0x24, 0xaf, 0x0c, 0x47, 0x04, 0x5f, 0x43, 0x31, 0xcb, 0x83, 0x14, 0x0c, 0xbd, 0x5e, 0xba, 0x22,
+	0x49, 0x52, 0x8d, 0x6f, 0x31, 0xd9, 0x7b, 0xa7, 0xf9, 0x04, 0xd5, 0xde, 0xf9, 0x6f, 0xd9, 0x65,
+	0x96, 0x54, 0xe9, 0xbd, 0xa9, 0xc2, 0xff, 0xe1, 0x97, 0x15, 0x70, 0x6f, 0xa2, 0x5e, 0x25, 0xa7,
+	0x1e, 0x00, 0x6e, 0x41, 0x31, 0x8d, 0x2e, 0x22, 0xba, 0x5a, 0xa0, 0x96, 0x2e, 0x70, 0x9e, 0xa8,
+	0xbd, 0x37, 0x5a, 0x37, 0xcb, 0x35, 0xd5, 0x56, 0x6b, 0xaa, 0x6d, 0xd7, 0x14, 0x3d, 0x4b, 0x8a,
+	0x5e, 0x25, 0x45, 0x6f, 0x92, 0xa2, 0xa5, 0xa4, 0x68, 0x25, 0x29, 0x7a, 0x97, 0x14, 0x7d, 0x48,
+	0xaa, 0x6d, 0x25, 0x45, 0x2f, 0x1b, 0xaa, 0x2d, 0x37, 0x54, 0x5b, 0x6d, 0xa8, 0xf6, 0x50, 0x1a,
+	0xb8, 0x01, 0xe7, 0x93, 0x78, 0xc6, 0xe6, 0xc2, 0x2d, 0xa8, 0x41, 0xad, 0xaf, 0x01, 0x00, 0x53,
+	0x67, 0x22, 0xff, 0x64, 0x02, 0x00, 0x00,
+}
+
+func (this *DataTrieValueDiff) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DataTrieValueDiff)
+	if !ok {
+		that2, ok := that.(DataTrieValueDiff)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Key, that1.Key) {
+		return false
+	}
+	if !bytes.Equal(this.Before, that1.Before) {
+		return false
+	}
+	if !bytes.Equal(this.After, that1.After) {
+		return false
+	}
+	return true
+}
+func (this *AccountStateDiff) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AccountStateDiff)
+	if !ok {
+		that2, ok := that.(AccountStateDiff)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Address, that1.Address) {
+		return false
+	}
+	if !bytes.Equal(this.BalanceBefore, that1.BalanceBefore) {
+		return false
+	}
+	if !bytes.Equal(this.BalanceAfter, that1.BalanceAfter) {
+		return false
+	}
+	if this.NonceBefore != that1.NonceBefore {
+		return false
+	}
+	if this.NonceAfter != that1.NonceAfter {
+		return false
+	}
+	if len(this.DataTrie) != len(that1.DataTrie) {
+		return false
+	}
+	for i := range this.DataTrie {
+		if !this.DataTrie[i].Equal(that1.DataTrie[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *StateDiffByTxHash) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StateDiffByTxHash)
+	if !ok {
+		that2, ok := that.(StateDiffByTxHash)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.TxHash, that1.TxHash) {
+		return false
+	}
+	if !bytes.Equal(this.BlockHash, that1.BlockHash) {
+		return false
+	}
+	if len(this.Accounts) != len(that1.Accounts) {
+		return false
+	}
+	for i := range this.Accounts {
+		if !this.Accounts[i].Equal(that1.Accounts[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DataTrieValueDiff) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&dblookupext.DataTrieValueDiff{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Before: "+fmt.Sprintf("%#v", this.Before)+",\n")
+	s = append(s, "After: "+fmt.Sprintf("%#v", this.After)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AccountStateDiff) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&dblookupext.AccountStateDiff{")
+	s = append(s, "Address: "+fmt.Sprintf("%#v", this.Address)+",\n")
+	s = append(s, "BalanceBefore: "+fmt.Sprintf("%#v", this.BalanceBefore)+",\n")
+	s = append(s, "BalanceAfter: "+fmt.Sprintf("%#v", this.BalanceAfter)+",\n")
+	s = append(s, "NonceBefore: "+fmt.Sprintf("%#v", this.NonceBefore)+",\n")
+	s = append(s, "NonceAfter: "+fmt.Sprintf("%#v", this.NonceAfter)+",\n")
+	if this.DataTrie != nil {
+		s = append(s, "DataTrie: "+fmt.Sprintf("%#v", this.DataTrie)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *StateDiffByTxHash) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&dblookupext.StateDiffByTxHash{")
+	s = append(s, "TxHash: "+fmt.Sprintf("%#v", this.TxHash)+",\n")
+	s = append(s, "BlockHash: "+fmt.Sprintf("%#v", this.BlockHash)+",\n")
+	if this.Accounts != nil {
+		s = append(s, "Accounts: "+fmt.Sprintf("%#v", this.Accounts)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringStateDiffByTxHash(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *DataTrieValueDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataTrieValueDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataTrieValueDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.After) > 0 {
+		i -= len(m.After)
+		copy(dAtA[i:], m.After)
+		i = encodeVarintStateDiffByTxHash(dAtA, i, uint64(len(m.After)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Before) > 0 {
+		i -= len(m.Before)
+		copy(dAtA[i:], m.Before)
+		i = encodeVarintStateDiffByTxHash(dAtA, i, uint64(len(m.Before)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintStateDiffByTxHash(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountStateDiff) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountStateDiff) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountStateDiff) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DataTrie) > 0 {
+		for iNdEx := len(m.DataTrie) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DataTrie[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStateDiffByTxHash(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.NonceAfter != 0 {
+		i = encodeVarintStateDiffByTxHash(dAtA, i, uint64(m.NonceAfter))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NonceBefore != 0 {
+		i = encodeVarintStateDiffByTxHash(dAtA, i, uint64(m.NonceBefore))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.BalanceAfter) > 0 {
+		i -= len(m.BalanceAfter)
+		copy(dAtA[i:], m.BalanceAfter)
+		i = encodeVarintStateDiffByTxHash(dAtA, i, uint64(len(m.BalanceAfter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BalanceBefore) > 0 {
+		i -= len(m.BalanceBefore)
+		copy(dAtA[i:], m.BalanceBefore)
+		i = encodeVarintStateDiffByTxHash(dAtA, i, uint64(len(m.BalanceBefore)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintStateDiffByTxHash(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StateDiffByTxHash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateDiffByTxHash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateDiffByTxHash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStateDiffByTxHash(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintStateDiffByTxHash(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintStateDiffByTxHash(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStateDiffByTxHash(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStateDiffByTxHash(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DataTrieValueDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovStateDiffByTxHash(uint64(l))
+	}
+	l = len(m.Before)
+	if l > 0 {
+		n += 1 + l + sovStateDiffByTxHash(uint64(l))
+	}
+	l = len(m.After)
+	if l > 0 {
+		n += 1 + l + sovStateDiffByTxHash(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountStateDiff) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovStateDiffByTxHash(uint64(l))
+	}
+	l = len(m.BalanceBefore)
+	if l > 0 {
+		n += 1 + l + sovStateDiffByTxHash(uint64(l))
+	}
+	l = len(m.BalanceAfter)
+	if l > 0 {
+		n += 1 + l + sovStateDiffByTxHash(uint64(l))
+	}
+	if m.NonceBefore != 0 {
+		n += 1 + sovStateDiffByTxHash(uint64(m.NonceBefore))
+	}
+	if m.NonceAfter != 0 {
+		n += 1 + sovStateDiffByTxHash(uint64(m.NonceAfter))
+	}
+	if len(m.DataTrie) > 0 {
+		for _, e := range m.DataTrie {
+			l = e.Size()
+			n += 1 + l + sovStateDiffByTxHash(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StateDiffByTxHash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovStateDiffByTxHash(uint64(l))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovStateDiffByTxHash(uint64(l))
+	}
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovStateDiffByTxHash(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovStateDiffByTxHash(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStateDiffByTxHash(x uint64) (n int) {
+	return sovStateDiffByTxHash(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *DataTrieValueDiff) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DataTrieValueDiff{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Before:` + fmt.Sprintf("%v", this.Before) + `,`,
+		`After:` + fmt.Sprintf("%v", this.After) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AccountStateDiff) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForDataTrie := "[]*DataTrieValueDiff{"
+	for _, f := range this.DataTrie {
+		repeatedStringForDataTrie += strings.Replace(f.String(), "DataTrieValueDiff", "DataTrieValueDiff", 1) + ","
+	}
+	repeatedStringForDataTrie += "}"
+	s := strings.Join([]string{`&AccountStateDiff{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`BalanceBefore:` + fmt.Sprintf("%v", this.BalanceBefore) + `,`,
+		`BalanceAfter:` + fmt.Sprintf("%v", this.BalanceAfter) + `,`,
+		`NonceBefore:` + fmt.Sprintf("%v", this.NonceBefore) + `,`,
+		`NonceAfter:` + fmt.Sprintf("%v", this.NonceAfter) + `,`,
+		`DataTrie:` + repeatedStringForDataTrie + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StateDiffByTxHash) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForAccounts := "[]*AccountStateDiff{"
+	for _, f := range this.Accounts {
+		repeatedStringForAccounts += strings.Replace(f.String(), "AccountStateDiff", "AccountStateDiff", 1) + ","
+	}
+	repeatedStringForAccounts += "}"
+	s := strings.Join([]string{`&StateDiffByTxHash{`,
+		`TxHash:` + fmt.Sprintf("%v", this.TxHash) + `,`,
+		`BlockHash:` + fmt.Sprintf("%v", this.BlockHash) + `,`,
+		`Accounts:` + repeatedStringForAccounts + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringStateDiffByTxHash(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *DataTrieValueDiff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStateDiffByTxHash
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataTrieValueDiff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataTrieValueDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiffByTxHash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStateDiffByTxHash
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateDiffByTxHash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiffByTxHash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStateDiffByTxHash
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateDiffByTxHash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Before = append(m.Before[:0], dAtA[iNdEx:postIndex]...)
+			if m.Before == nil {
+				m.Before = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiffByTxHash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStateDiffByTxHash
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateDiffByTxHash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.After = append(m.After[:0], dAtA[iNdEx:postIndex]...)
+			if m.After == nil {
+				m.After = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStateDiffByTxHash(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStateDiffByTxHash
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStateDiffByTxHash
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountStateDiff) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStateDiffByTxHash
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountStateDiff: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountStateDiff: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiffByTxHash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStateDiffByTxHash
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateDiffByTxHash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceBefore", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiffByTxHash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStateDiffByTxHash
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateDiffByTxHash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BalanceBefore = append(m.BalanceBefore[:0], dAtA[iNdEx:postIndex]...)
+			if m.BalanceBefore == nil {
+				m.BalanceBefore = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BalanceAfter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiffByTxHash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStateDiffByTxHash
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateDiffByTxHash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BalanceAfter = append(m.BalanceAfter[:0], dAtA[iNdEx:postIndex]...)
+			if m.BalanceAfter == nil {
+				m.BalanceAfter = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonceBefore", wireType)
+			}
+			m.NonceBefore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiffByTxHash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NonceBefore |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonceAfter", wireType)
+			}
+			m.NonceAfter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiffByTxHash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NonceAfter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataTrie", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiffByTxHash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStateDiffByTxHash
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateDiffByTxHash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataTrie = append(m.DataTrie, &DataTrieValueDiff{})
+			if err := m.DataTrie[len(m.DataTrie)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStateDiffByTxHash(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStateDiffByTxHash
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStateDiffByTxHash
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StateDiffByTxHash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStateDiffByTxHash
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateDiffByTxHash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateDiffByTxHash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiffByTxHash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStateDiffByTxHash
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateDiffByTxHash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiffByTxHash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStateDiffByTxHash
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateDiffByTxHash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateDiffByTxHash
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStateDiffByTxHash
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateDiffByTxHash
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, &AccountStateDiff{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStateDiffByTxHash(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthStateDiffByTxHash
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthStateDiffByTxHash
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStateDiffByTxHash(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStateDiffByTxHash
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStateDiffByTxHash
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStateDiffByTxHash
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStateDiffByTxHash
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStateDiffByTxHash
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStateDiffByTxHash
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStateDiffByTxHash        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStateDiffByTxHash          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStateDiffByTxHash = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,236 @@
+//go:generate protoc -I=. -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. stateDiffByTxHash.proto
+
+package dblookupext
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+)
+
+// sizeOfPendingStateDiffsCache is the maximum number of state diffs of processed, but not yet committed, transactions
+const sizeOfPendingStateDiffsCache = 50000
+
+// ArgsStateDiffsIndex holds the arguments needed to create a new state diffs index
+type ArgsStateDiffsIndex struct {
+	StateDiffsStorer storage.Storer
+	Marshalizer      marshal.Marshalizer
+}
+
+// stateDiffsIndex records the state diffs of the processed transactions, keyed by the transaction hash. The state diffs
+// are kept in memory while processing, and are recorded in the epoch of the block only after the block gets committed.
+type stateDiffsIndex struct {
+	storer            storage.Storer
+	marshalizer       marshal.Marshalizer
+	pendingStateDiffs storage.Cacher
+}
+
+// NewStateDiffsIndex creates a new instance of stateDiffsIndex
+func NewStateDiffsIndex(args ArgsStateDiffsIndex) (*stateDiffsIndex, error) {
+	if check.IfNil(args.StateDiffsStorer) {
+		return nil, core.ErrNilStore
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, core.ErrNilMarshalizer
+	}
+
+	pendingStateDiffs, err := lrucache.NewCache(sizeOfPendingStateDiffsCache)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stateDiffsIndex{
+		storer:            args.StateDiffsStorer,
+		marshalizer:       args.Marshalizer,
+		pendingStateDiffs: pendingStateDiffs,
+	}, nil
+}
+
+// AddStateChanges keeps in memory the state changes of a processed transaction, until its block gets committed. The
+// state changes of a transaction processed again (e.g. on a fork) replace the previous ones
+func (sdi *stateDiffsIndex) AddStateChanges(txHash []byte, stateChanges []*state.AccountStateChanges) {
+	record := &StateDiffByTxHash{
+		TxHash:   txHash,
+		Accounts: make([]*AccountStateDiff, 0, len(stateChanges)),
+	}
+	for _, accountChanges := range stateChanges {
+		record.Accounts = append(record.Accounts, newAccountStateDiff(accountChanges))
+	}
+
+	_ = sdi.pendingStateDiffs.Put(txHash, record, 0)
+}
+
+func newAccountStateDiff(accountChanges *state.AccountStateChanges) *AccountStateDiff {
+	accountDiff := &AccountStateDiff{
+		Address:       accountChanges.Address,
+		BalanceBefore: bigIntToBytes(accountChanges.BalanceBefore),
+		BalanceAfter:  bigIntToBytes(accountChanges.BalanceAfter),
+		NonceBefore:   accountChanges.NonceBefore,
+		NonceAfter:    accountChanges.NonceAfter,
+		DataTrie:      make([]*DataTrieValueDiff, 0, len(accountChanges.DataTrieChanges)),
+	}
+	for _, dataTrieChange := range accountChanges.DataTrieChanges {
+		accountDiff.DataTrie = append(accountDiff.DataTrie, &DataTrieValueDiff{
+			Key:    dataTrieChange.Key,
+			Before: dataTrieChange.Before,
+			After:  dataTrieChange.After,
+		})
+	}
+
+	return accountDiff
+}
+
+func bigIntToBytes(value *big.Int) []byte {
+	if value == nil {
+		return nil
+	}
+
+	return value.Bytes()
+}
+
+// RecordBlock records the state diffs of the transactions and of the smart contract results of the provided block,
+// which were kept in memory while processing the block
+func (sdi *stateDiffsIndex) RecordBlock(blockHeaderHash []byte, blockHeader data.HeaderHandler, miniBlocks []*block.MiniBlock) error {
+	if check.IfNil(blockHeader) {
+		return errNilBlockHeader
+	}
+
+	for _, miniBlock := range miniBlocks {
+		if !isMiniBlockWithStateDiffs(miniBlock) {
+			continue
+		}
+
+		for _, txHash := range miniBlock.TxHashes {
+			err := sdi.recordStateDiff(txHash, blockHeaderHash, blockHeader.GetEpoch())
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func isMiniBlockWithStateDiffs(miniBlock *block.MiniBlock) bool {
+	if miniBlock == nil {
+		return false
+	}
+
+	switch miniBlock.Type {
+	case block.TxBlock, block.SmartContractResultBlock, block.InvalidBlock:
+		return true
+	default:
+		return false
+	}
+}
+
+func (sdi *stateDiffsIndex) recordStateDiff(txHash []byte, blockHeaderHash []byte, epoch uint32) error {
+	value, found := sdi.pendingStateDiffs.Get(txHash)
+	if !found {
+		return nil
+	}
+	sdi.pendingStateDiffs.Remove(txHash)
+
+	pendingRecord, ok := value.(*StateDiffByTxHash)
+	if !ok {
+		return errWrongTypeAssertion
+	}
+
+	record := &StateDiffByTxHash{
+		TxHash:    pendingRecord.TxHash,
+		BlockHash: blockHeaderHash,
+		Accounts:  pendingRecord.Accounts,
+	}
+	rawBytes, err := sdi.marshalizer.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	return sdi.storer.PutInEpoch(txHash, rawBytes, epoch)
+}
+
+// RevertBlock removes the state diffs recorded for the transactions of the provided block, if any
+func (sdi *stateDiffsIndex) RevertBlock(blockHeaderHash []byte, blockHeader data.HeaderHandler, miniBlocks []*block.MiniBlock) error {
+	if check.IfNil(blockHeader) {
+		return errNilBlockHeader
+	}
+
+	for _, miniBlock := range miniBlocks {
+		if !isMiniBlockWithStateDiffs(miniBlock) {
+			continue
+		}
+
+		for _, txHash := range miniBlock.TxHashes {
+			record, err := sdi.getStateDiffRecord(txHash, blockHeader.GetEpoch())
+			if err != nil || !bytes.Equal(record.BlockHash, blockHeaderHash) {
+				continue
+			}
+
+			err = sdi.storer.Remove(txHash)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetStateDiff returns the state diff recorded, in the provided epoch, for the transaction with the given hash
+func (sdi *stateDiffsIndex) GetStateDiff(txHash []byte, epoch uint32) ([]*state.AccountStateChanges, error) {
+	record, err := sdi.getStateDiffRecord(txHash, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	stateChanges := make([]*state.AccountStateChanges, 0, len(record.Accounts))
+	for _, accountDiff := range record.Accounts {
+		accountChanges := &state.AccountStateChanges{
+			Address:         accountDiff.Address,
+			BalanceBefore:   big.NewInt(0).SetBytes(accountDiff.BalanceBefore),
+			BalanceAfter:    big.NewInt(0).SetBytes(accountDiff.BalanceAfter),
+			NonceBefore:     accountDiff.NonceBefore,
+			NonceAfter:      accountDiff.NonceAfter,
+			DataTrieChanges: make([]*state.DataTrieChange, 0, len(accountDiff.DataTrie)),
+		}
+		for _, valueDiff := range accountDiff.DataTrie {
+			accountChanges.DataTrieChanges = append(accountChanges.DataTrieChanges, &state.DataTrieChange{
+				Key:    valueDiff.Key,
+				Before: valueDiff.Before,
+				After:  valueDiff.After,
+			})
+		}
+
+		stateChanges = append(stateChanges, accountChanges)
+	}
+
+	return stateChanges, nil
+}
+
+func (sdi *stateDiffsIndex) getStateDiffRecord(txHash []byte, epoch uint32) (*StateDiffByTxHash, error) {
+	rawBytes, err := sdi.storer.GetFromEpoch(txHash, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	record := &StateDiffByTxHash{}
+	err = sdi.marshalizer.Unmarshal(record, rawBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return record, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sdi *stateDiffsIndex) IsInterfaceNil() bool {
+	return sdi == nil
+}
@@ -0,0 +1,135 @@
+package dblookupext
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go/common/mock"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsStateDiffsIndex() ArgsStateDiffsIndex {
+	return ArgsStateDiffsIndex{
+		StateDiffsStorer: genericMocks.NewStorerMockWithEpoch(0),
+		Marshalizer:      &mock.MarshalizerMock{},
+	}
+}
+
+func createAccountStateChanges(address string, balanceBefore int64, balanceAfter int64) *state.AccountStateChanges {
+	return &state.AccountStateChanges{
+		Address:       []byte(address),
+		BalanceBefore: big.NewInt(balanceBefore),
+		BalanceAfter:  big.NewInt(balanceAfter),
+		NonceBefore:   5,
+		NonceAfter:    6,
+		DataTrieChanges: []*state.DataTrieChange{
+			{Key: []byte("key"), Before: []byte("before"), After: []byte("after")},
+		},
+	}
+}
+
+func TestNewStateDiffsIndex(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsStateDiffsIndex()
+	args.StateDiffsStorer = nil
+	index, err := NewStateDiffsIndex(args)
+	require.True(t, check.IfNil(index))
+	require.Equal(t, core.ErrNilStore, err)
+
+	args = createMockArgsStateDiffsIndex()
+	args.Marshalizer = nil
+	index, err = NewStateDiffsIndex(args)
+	require.True(t, check.IfNil(index))
+	require.Equal(t, core.ErrNilMarshalizer, err)
+
+	args = createMockArgsStateDiffsIndex()
+	index, err = NewStateDiffsIndex(args)
+	require.False(t, check.IfNil(index))
+	require.Nil(t, err)
+}
+
+func TestStateDiffsIndex_RecordBlockShouldRecordOnlyTheTransactionsOfTheBlock(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsStateDiffsIndex()
+	args.StateDiffsStorer = genericMocks.NewStorerMockWithEpoch(2)
+	index, _ := NewStateDiffsIndex(args)
+
+	index.AddStateChanges([]byte("txA"), []*state.AccountStateChanges{createAccountStateChanges("alice", 0, 1)})
+	// processed again, e.g. on a fork
+	index.AddStateChanges([]byte("txA"), []*state.AccountStateChanges{createAccountStateChanges("alice", 100, 90)})
+	index.AddStateChanges([]byte("txB"), []*state.AccountStateChanges{createAccountStateChanges("bob", 7, 8)})
+	index.AddStateChanges([]byte("scr"), []*state.AccountStateChanges{createAccountStateChanges("carol", 1, 2)})
+
+	miniBlocks := []*block.MiniBlock{
+		{Type: block.TxBlock, TxHashes: [][]byte{[]byte("txA"), []byte("txWithoutStateDiff")}},
+		{Type: block.SmartContractResultBlock, TxHashes: [][]byte{[]byte("scr")}},
+		nil,
+	}
+	err := index.RecordBlock([]byte("hash"), &block.Header{Epoch: 2}, miniBlocks)
+	require.Nil(t, err)
+
+	stateDiff, err := index.GetStateDiff([]byte("txA"), 2)
+	require.Nil(t, err)
+	require.Equal(t, []*state.AccountStateChanges{createAccountStateChanges("alice", 100, 90)}, stateDiff)
+
+	stateDiff, err = index.GetStateDiff([]byte("scr"), 2)
+	require.Nil(t, err)
+	require.Equal(t, []*state.AccountStateChanges{createAccountStateChanges("carol", 1, 2)}, stateDiff)
+
+	_, err = index.GetStateDiff([]byte("txB"), 2)
+	require.NotNil(t, err)
+	_, err = index.GetStateDiff([]byte("txWithoutStateDiff"), 2)
+	require.NotNil(t, err)
+
+	// the state diff of txB is still pending
+	err = index.RecordBlock([]byte("hash next"), &block.Header{Epoch: 2}, []*block.MiniBlock{
+		{Type: block.InvalidBlock, TxHashes: [][]byte{[]byte("txB")}},
+	})
+	require.Nil(t, err)
+	_, err = index.GetStateDiff([]byte("txB"), 2)
+	require.Nil(t, err)
+
+	err = index.RecordBlock([]byte("hash"), nil, miniBlocks)
+	require.Equal(t, errNilBlockHeader, err)
+}
+
+func TestStateDiffsIndex_RevertBlock(t *testing.T) {
+	t.Parallel()
+
+	index, _ := NewStateDiffsIndex(createMockArgsStateDiffsIndex())
+
+	header := &block.Header{}
+	miniBlocks := []*block.MiniBlock{
+		{Type: block.TxBlock, TxHashes: [][]byte{[]byte("txA")}},
+		{Type: block.SmartContractResultBlock, TxHashes: [][]byte{[]byte("scr")}},
+	}
+	index.AddStateChanges([]byte("txA"), []*state.AccountStateChanges{createAccountStateChanges("alice", 100, 90)})
+	index.AddStateChanges([]byte("scr"), []*state.AccountStateChanges{createAccountStateChanges("carol", 1, 2)})
+	err := index.RecordBlock([]byte("hash"), header, miniBlocks)
+	require.Nil(t, err)
+
+	// other blocks containing the same transactions are ignored
+	err = index.RevertBlock([]byte("hash-other"), header, miniBlocks)
+	require.Nil(t, err)
+	_, err = index.GetStateDiff([]byte("txA"), 0)
+	require.Nil(t, err)
+	_, err = index.GetStateDiff([]byte("scr"), 0)
+	require.Nil(t, err)
+
+	err = index.RevertBlock([]byte("hash"), header, miniBlocks)
+	require.Nil(t, err)
+	_, err = index.GetStateDiff([]byte("txA"), 0)
+	require.NotNil(t, err)
+	_, err = index.GetStateDiff([]byte("scr"), 0)
+	require.NotNil(t, err)
+
+	err = index.RevertBlock([]byte("hash"), nil, miniBlocks)
+	require.Equal(t, errNilBlockHeader, err)
+}
@@ -0,0 +1,1224 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: txHashesByAddress.proto
+
+package dblookupext
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TxHashByAddress holds the coordinates of a transaction (or smart contract result) that involves a given address
+type TxHashByAddress struct {
+	TxHash        []byte `protobuf:"bytes,1,opt,name=TxHash,proto3" json:"TxHash,omitempty"`
+	BlockHash     []byte `protobuf:"bytes,2,opt,name=BlockHash,proto3" json:"BlockHash,omitempty"`
+	BlockNonce    uint64 `protobuf:"varint,3,opt,name=BlockNonce,proto3" json:"BlockNonce,omitempty"`
+	Round         uint64 `protobuf:"varint,4,opt,name=Round,proto3" json:"Round,omitempty"`
+	Epoch         uint32 `protobuf:"varint,5,opt,name=Epoch,proto3" json:"Epoch,omitempty"`
+	MiniblockType int32  `protobuf:"varint,6,opt,name=MiniblockType,proto3" json:"MiniblockType,omitempty"`
+	IsSender      bool   `protobuf:"varint,7,opt,name=IsSender,proto3" json:"IsSender,omitempty"`
+	IsReceiver    bool   `protobuf:"varint,8,opt,name=IsReceiver,proto3" json:"IsReceiver,omitempty"`
+}
+
+func (m *TxHashByAddress) Reset()      { *m = TxHashByAddress{} }
+func (*TxHashByAddress) ProtoMessage() {}
+func (*TxHashByAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_019b3cf301e7b86e, []int{0}
+}
+func (m *TxHashByAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxHashByAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TxHashByAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxHashByAddress.Merge(m, src)
+}
+func (m *TxHashByAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxHashByAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxHashByAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxHashByAddress proto.InternalMessageInfo
+
+func (m *TxHashByAddress) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *TxHashByAddress) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *TxHashByAddress) GetBlockNonce() uint64 {
+	if m != nil {
+		return m.BlockNonce
+	}
+	return 0
+}
+
+func (m *TxHashByAddress) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *TxHashByAddress) GetEpoch() uint32 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *TxHashByAddress) GetMiniblockType() int32 {
+	if m != nil {
+		return m.MiniblockType
+	}
+	return 0
+}
+
+func (m *TxHashByAddress) GetIsSender() bool {
+	if m != nil {
+		return m.IsSender
+	}
+	return false
+}
+
+func (m *TxHashByAddress) GetIsReceiver() bool {
+	if m != nil {
+		return m.IsReceiver
+	}
+	return false
+}
+
+// TxHashesByAddressPage holds a bounded chunk of the transactions hashes of an address, within an epoch
+type TxHashesByAddressPage struct {
+	Entries []*TxHashByAddress `protobuf:"bytes,1,rep,name=Entries,proto3" json:"Entries,omitempty"`
+}
+
+func (m *TxHashesByAddressPage) Reset()      { *m = TxHashesByAddressPage{} }
+func (*TxHashesByAddressPage) ProtoMessage() {}
+func (*TxHashesByAddressPage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_019b3cf301e7b86e, []int{1}
+}
+func (m *TxHashesByAddressPage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxHashesByAddressPage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TxHashesByAddressPage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxHashesByAddressPage.Merge(m, src)
+}
+func (m *TxHashesByAddressPage) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxHashesByAddressPage) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxHashesByAddressPage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxHashesByAddressPage proto.InternalMessageInfo
+
+func (m *TxHashesByAddressPage) GetEntries() []*TxHashByAddress {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+// TxHashesByAddressMetadata holds the number of transactions hashes of an address, within an epoch,
+// along with a link towards the previous epoch having records for the same address
+type TxHashesByAddressMetadata struct {
+	NumEntries       uint64 `protobuf:"varint,1,opt,name=NumEntries,proto3" json:"NumEntries,omitempty"`
+	PreviousEpoch    uint32 `protobuf:"varint,2,opt,name=PreviousEpoch,proto3" json:"PreviousEpoch,omitempty"`
+	HasPreviousEpoch bool   `protobuf:"varint,3,opt,name=HasPreviousEpoch,proto3" json:"HasPreviousEpoch,omitempty"`
+}
+
+func (m *TxHashesByAddressMetadata) Reset()      { *m = TxHashesByAddressMetadata{} }
+func (*TxHashesByAddressMetadata) ProtoMessage() {}
+func (*TxHashesByAddressMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_019b3cf301e7b86e, []int{2}
+}
+func (m *TxHashesByAddressMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxHashesByAddressMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *TxHashesByAddressMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxHashesByAddressMetadata.Merge(m, src)
+}
+func (m *TxHashesByAddressMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxHashesByAddressMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxHashesByAddressMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxHashesByAddressMetadata proto.InternalMessageInfo
+
+func (m *TxHashesByAddressMetadata) GetNumEntries() uint64 {
+	if m != nil {
+		return m.NumEntries
+	}
+	return 0
+}
+
+func (m *TxHashesByAddressMetadata) GetPreviousEpoch() uint32 {
+	if m != nil {
+		return m.PreviousEpoch
+	}
+	return 0
+}
+
+func (m *TxHashesByAddressMetadata) GetHasPreviousEpoch() bool {
+	if m != nil {
+		return m.HasPreviousEpoch
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*TxHashByAddress)(nil), "proto.TxHashByAddress")
+	proto.RegisterType((*TxHashesByAddressPage)(nil), "proto.TxHashesByAddressPage")
+	proto.RegisterType((*TxHashesByAddressMetadata)(nil), "proto.TxHashesByAddressMetadata")
+}
+
+func init() { proto.RegisterFile("txHashesByAddress.proto", fileDescriptor_019b3cf301e7b86e) }
+
+var fileDescriptor_019b3cf301e7b86e = []byte{
+	// 393 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xcd, 0xee, 0xd2, 0x40,
+	0x14, 0xc5, 0x7b, 0xff, 0x50, 0xc0, 0x41, 0xa2, 0x99, 0x28, 0x8e, 0xc4, 0x4c, 0x1a, 0xe2, 0xa2,
+	0x31, 0x11, 0x8c, 0x3e, 0x81, 0x24, 0x24, 0xb0, 0x80, 0x90, 0x91, 0x95, 0xbb, 0x7e, 0x8c, 0xa5,
+	0x01, 0x3a, 0x4d, 0xa7, 0x25, 0xb0, 0xf3, 0x05, 0x4c, 0x7c, 0x0c, 0x1f, 0xc5, 0x25, 0x4b, 0x96,
+	0x32, 0x6c, 0x5c, 0xb2, 0x71, 0x6f, 0x3a, 0x55, 0x3e, 0x57, 0xed, 0xf9, 0x9d, 0xb9, 0x27, 0xf7,
+	0x1e, 0xf4, 0x22, 0x5d, 0x0f, 0x1c, 0x39, 0xe3, 0xb2, 0xb7, 0xf9, 0xe8, 0xfb, 0x09, 0x97, 0xb2,
+	0x13, 0x27, 0x22, 0x15, 0xd8, 0xd4, 0x9f, 0xd6, 0xdb, 0x20, 0x4c, 0x67, 0x99, 0xdb, 0xf1, 0xc4,
+	0xb2, 0x1b, 0x88, 0x40, 0x74, 0x35, 0x76, 0xb3, 0x2f, 0x5a, 0x69, 0xa1, 0xff, 0x8a, 0xa9, 0xf6,
+	0x1f, 0x40, 0x4f, 0xa6, 0x3a, 0xf1, 0x94, 0x87, 0x9b, 0xa8, 0x52, 0x20, 0x02, 0x16, 0xd8, 0x8f,
+	0xd9, 0x3f, 0x85, 0x5f, 0xa1, 0x47, 0xbd, 0x85, 0xf0, 0xe6, 0xda, 0x7a, 0xd0, 0xd6, 0x19, 0x60,
+	0x8a, 0x90, 0x16, 0x63, 0x11, 0x79, 0x9c, 0x94, 0x2c, 0xb0, 0xcb, 0xec, 0x82, 0xe0, 0x67, 0xc8,
+	0x64, 0x22, 0x8b, 0x7c, 0x52, 0xd6, 0x56, 0x21, 0x72, 0xda, 0x8f, 0x85, 0x37, 0x23, 0xa6, 0x05,
+	0x76, 0x83, 0x15, 0x02, 0xbf, 0x46, 0x8d, 0x51, 0x18, 0x85, 0x6e, 0x3e, 0x3d, 0xdd, 0xc4, 0x9c,
+	0x54, 0x2c, 0xb0, 0x4d, 0x76, 0x0d, 0x71, 0x0b, 0xd5, 0x86, 0xf2, 0x13, 0x8f, 0x7c, 0x9e, 0x90,
+	0xaa, 0x05, 0x76, 0x8d, 0x9d, 0x74, 0xbe, 0xcd, 0x50, 0x32, 0xee, 0xf1, 0x70, 0xc5, 0x13, 0x52,
+	0xd3, 0xee, 0x05, 0x69, 0x0f, 0xd1, 0xf3, 0xe9, 0x6d, 0x91, 0x13, 0x27, 0xe0, 0xf8, 0x1d, 0xaa,
+	0xf6, 0xa3, 0x34, 0x09, 0xb9, 0x24, 0x60, 0x95, 0xec, 0xfa, 0xfb, 0x66, 0xd1, 0x54, 0xe7, 0xa6,
+	0x25, 0xf6, 0xff, 0x59, 0xfb, 0x1b, 0xa0, 0x97, 0x77, 0x59, 0x23, 0x9e, 0x3a, 0xbe, 0x93, 0x3a,
+	0xf9, 0x22, 0xe3, 0x6c, 0x79, 0x8e, 0xd4, 0xb5, 0x9c, 0x49, 0x7e, 0xea, 0x24, 0xe1, 0xab, 0x50,
+	0x64, 0xb2, 0x28, 0xe2, 0x41, 0x17, 0x71, 0x0d, 0xf1, 0x1b, 0xf4, 0x74, 0xe0, 0xc8, 0xeb, 0x87,
+	0x25, 0x7d, 0xd4, 0x1d, 0xef, 0xf5, 0xb7, 0x7b, 0x6a, 0xec, 0xf6, 0xd4, 0x38, 0xee, 0x29, 0x7c,
+	0x55, 0x14, 0x7e, 0x28, 0x0a, 0x3f, 0x15, 0x85, 0xad, 0xa2, 0xb0, 0x53, 0x14, 0x7e, 0x29, 0x0a,
+	0xbf, 0x15, 0x35, 0x8e, 0x8a, 0xc2, 0xf7, 0x03, 0x35, 0xb6, 0x07, 0x6a, 0xec, 0x0e, 0xd4, 0xf8,
+	0x5c, 0xf7, 0xdd, 0x85, 0x10, 0xf3, 0x2c, 0xe6, 0xeb, 0xd4, 0xad, 0xe8, 0xb3, 0x3f, 0xfc, 0x1d,
+	0x00, 0x1e, 0xfd, 0x62, 0x1e, 0x71, 0x02, 0x00, 0x00,
+}
+
+func (this *TxHashByAddress) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TxHashByAddress)
+	if !ok {
+		that2, ok := that.(TxHashByAddress)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.TxHash, that1.TxHash) {
+		return false
+	}
+	if !bytes.Equal(this.BlockHash, that1.BlockHash) {
+		return false
+	}
+	if this.BlockNonce != that1.BlockNonce {
+		return false
+	}
+	if this.Round != that1.Round {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if this.MiniblockType != that1.MiniblockType {
+		return false
+	}
+	if this.IsSender != that1.IsSender {
+		return false
+	}
+	if this.IsReceiver != that1.IsReceiver {
+		return false
+	}
+	return true
+}
+func (this *TxHashesByAddressPage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TxHashesByAddressPage)
+	if !ok {
+		that2, ok := that.(TxHashesByAddressPage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Entries) != len(that1.Entries) {
+		return false
+	}
+	for i := range this.Entries {
+		if !this.Entries[i].Equal(that1.Entries[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *TxHashesByAddressMetadata) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TxHashesByAddressMetadata)
+	if !ok {
+		that2, ok := that.(TxHashesByAddressMetadata)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.NumEntries != that1.NumEntries {
+		return false
+	}
+	if this.PreviousEpoch != that1.PreviousEpoch {
+		return false
+	}
+	if this.HasPreviousEpoch != that1.HasPreviousEpoch {
+		return false
+	}
+	return true
+}
+func (this *TxHashByAddress) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&dblookupext.TxHashByAddress{")
+	s = append(s, "TxHash: "+fmt.Sprintf("%#v", this.TxHash)+",\n")
+	s = append(s, "BlockHash: "+fmt.Sprintf("%#v", this.BlockHash)+",\n")
+	s = append(s, "BlockNonce: "+fmt.Sprintf("%#v", this.BlockNonce)+",\n")
+	s = append(s, "Round: "+fmt.Sprintf("%#v", this.Round)+",\n")
+	s = append(s, "Epoch: "+fmt.Sprintf("%#v", this.Epoch)+",\n")
+	s = append(s, "MiniblockType: "+fmt.Sprintf("%#v", this.MiniblockType)+",\n")
+	s = append(s, "IsSender: "+fmt.Sprintf("%#v", this.IsSender)+",\n")
+	s = append(s, "IsReceiver: "+fmt.Sprintf("%#v", this.IsReceiver)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TxHashesByAddressPage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&dblookupext.TxHashesByAddressPage{")
+	if this.Entries != nil {
+		s = append(s, "Entries: "+fmt.Sprintf("%#v", this.Entries)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TxHashesByAddressMetadata) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&dblookupext.TxHashesByAddressMetadata{")
+	s = append(s, "NumEntries: "+fmt.Sprintf("%#v", this.NumEntries)+",\n")
+	s = append(s, "PreviousEpoch: "+fmt.Sprintf("%#v", this.PreviousEpoch)+",\n")
+	s = append(s, "HasPreviousEpoch: "+fmt.Sprintf("%#v", this.HasPreviousEpoch)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringTxHashesByAddress(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *TxHashByAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxHashByAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxHashByAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsReceiver {
+		i--
+		if m.IsReceiver {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.IsSender {
+		i--
+		if m.IsSender {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MiniblockType != 0 {
+		i = encodeVarintTxHashesByAddress(dAtA, i, uint64(m.MiniblockType))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintTxHashesByAddress(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Round != 0 {
+		i = encodeVarintTxHashesByAddress(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlockNonce != 0 {
+		i = encodeVarintTxHashesByAddress(dAtA, i, uint64(m.BlockNonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BlockHash) > 0 {
+		i -= len(m.BlockHash)
+		copy(dAtA[i:], m.BlockHash)
+		i = encodeVarintTxHashesByAddress(dAtA, i, uint64(len(m.BlockHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintTxHashesByAddress(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxHashesByAddressPage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxHashesByAddressPage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxHashesByAddressPage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTxHashesByAddress(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxHashesByAddressMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxHashesByAddressMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxHashesByAddressMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HasPreviousEpoch {
+		i--
+		if m.HasPreviousEpoch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PreviousEpoch != 0 {
+		i = encodeVarintTxHashesByAddress(dAtA, i, uint64(m.PreviousEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NumEntries != 0 {
+		i = encodeVarintTxHashesByAddress(dAtA, i, uint64(m.NumEntries))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTxHashesByAddress(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTxHashesByAddress(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TxHashByAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovTxHashesByAddress(uint64(l))
+	}
+	l = len(m.BlockHash)
+	if l > 0 {
+		n += 1 + l + sovTxHashesByAddress(uint64(l))
+	}
+	if m.BlockNonce != 0 {
+		n += 1 + sovTxHashesByAddress(uint64(m.BlockNonce))
+	}
+	if m.Round != 0 {
+		n += 1 + sovTxHashesByAddress(uint64(m.Round))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovTxHashesByAddress(uint64(m.Epoch))
+	}
+	if m.MiniblockType != 0 {
+		n += 1 + sovTxHashesByAddress(uint64(m.MiniblockType))
+	}
+	if m.IsSender {
+		n += 2
+	}
+	if m.IsReceiver {
+		n += 2
+	}
+	return n
+}
+
+func (m *TxHashesByAddressPage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovTxHashesByAddress(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TxHashesByAddressMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumEntries != 0 {
+		n += 1 + sovTxHashesByAddress(uint64(m.NumEntries))
+	}
+	if m.PreviousEpoch != 0 {
+		n += 1 + sovTxHashesByAddress(uint64(m.PreviousEpoch))
+	}
+	if m.HasPreviousEpoch {
+		n += 2
+	}
+	return n
+}
+
+func sovTxHashesByAddress(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTxHashesByAddress(x uint64) (n int) {
+	return sovTxHashesByAddress(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *TxHashByAddress) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TxHashByAddress{`,
+		`TxHash:` + fmt.Sprintf("%v", this.TxHash) + `,`,
+		`BlockHash:` + fmt.Sprintf("%v", this.BlockHash) + `,`,
+		`BlockNonce:` + fmt.Sprintf("%v", this.BlockNonce) + `,`,
+		`Round:` + fmt.Sprintf("%v", this.Round) + `,`,
+		`Epoch:` + fmt.Sprintf("%v", this.Epoch) + `,`,
+		`MiniblockType:` + fmt.Sprintf("%v", this.MiniblockType) + `,`,
+		`IsSender:` + fmt.Sprintf("%v", this.IsSender) + `,`,
+		`IsReceiver:` + fmt.Sprintf("%v", this.IsReceiver) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TxHashesByAddressPage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForEntries := "[]*TxHashByAddress{"
+	for _, f := range this.Entries {
+		repeatedStringForEntries += strings.Replace(f.String(), "TxHashByAddress", "TxHashByAddress", 1) + ","
+	}
+	repeatedStringForEntries += "}"
+	s := strings.Join([]string{`&TxHashesByAddressPage{`,
+		`Entries:` + repeatedStringForEntries + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TxHashesByAddressMetadata) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TxHashesByAddressMetadata{`,
+		`NumEntries:` + fmt.Sprintf("%v", this.NumEntries) + `,`,
+		`PreviousEpoch:` + fmt.Sprintf("%v", this.PreviousEpoch) + `,`,
+		`HasPreviousEpoch:` + fmt.Sprintf("%v", this.HasPreviousEpoch) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringTxHashesByAddress(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *TxHashByAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxHashesByAddress
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxHashByAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxHashByAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHashesByAddress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTxHashesByAddress
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxHashesByAddress
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHashesByAddress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTxHashesByAddress
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxHashesByAddress
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = append(m.BlockHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockHash == nil {
+				m.BlockHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNonce", wireType)
+			}
+			m.BlockNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHashesByAddress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHashesByAddress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHashesByAddress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MiniblockType", wireType)
+			}
+			m.MiniblockType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHashesByAddress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MiniblockType |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsSender", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHashesByAddress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsSender = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsReceiver", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHashesByAddress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsReceiver = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxHashesByAddress(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTxHashesByAddress
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTxHashesByAddress
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxHashesByAddressPage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxHashesByAddress
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxHashesByAddressPage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxHashesByAddressPage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHashesByAddress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxHashesByAddress
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxHashesByAddress
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &TxHashByAddress{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxHashesByAddress(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTxHashesByAddress
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTxHashesByAddress
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxHashesByAddressMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxHashesByAddress
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxHashesByAddressMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxHashesByAddressMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEntries", wireType)
+			}
+			m.NumEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHashesByAddress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumEntries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousEpoch", wireType)
+			}
+			m.PreviousEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHashesByAddress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousEpoch |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasPreviousEpoch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxHashesByAddress
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasPreviousEpoch = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxHashesByAddress(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTxHashesByAddress
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTxHashesByAddress
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTxHashesByAddress(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTxHashesByAddress
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTxHashesByAddress
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTxHashesByAddress
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTxHashesByAddress
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTxHashesByAddress
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTxHashesByAddress
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTxHashesByAddress        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTxHashesByAddress          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTxHashesByAddress = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,525 @@
+//go:generate protoc -I=. -I=$GOPATH/src -I=$GOPATH/src/github.com/ElrondNetwork/protobuf/protobuf  --gogoslick_out=. txHashesByAddress.proto
+
+package dblookupext
+
+import (
+	"encoding/binary"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common/logging"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
+)
+
+const (
+	numEntriesPerTxHashesByAddressPage = 100
+	sizeOfRecentlyRecordedBlocksCache  = 100
+	uint32Size                         = 4
+)
+
+// ArgsTxHashesByAddressIndex holds the arguments needed to create a new transactions hashes by address index
+type ArgsTxHashesByAddressIndex struct {
+	TxHashesByAddressStorer storage.Storer
+	EpochByAddressStorer    storage.Storer
+	Marshalizer             marshal.Marshalizer
+}
+
+// txHashesByAddressIndex records the hashes of the transactions (and smart contract results) sent or received by an address.
+// The records are partitioned by epoch and, within an epoch, split in pages of bounded size. The records of an address,
+// for a given epoch, link towards the previous epoch holding records for the same address, while the latest epoch is held
+// in a static storer.
+type txHashesByAddressIndex struct {
+	storer         storage.Storer
+	epochByAddress *epochByHashIndex
+	marshalizer    marshal.Marshalizer
+	mutIndex       sync.RWMutex
+	recordedBlocks storage.Cacher
+}
+
+type txHashesByAddressRecord struct {
+	epoch    uint32
+	metadata *TxHashesByAddressMetadata
+}
+
+// NewTxHashesByAddressIndex creates a new instance of txHashesByAddressIndex
+func NewTxHashesByAddressIndex(args ArgsTxHashesByAddressIndex) (*txHashesByAddressIndex, error) {
+	if check.IfNil(args.TxHashesByAddressStorer) {
+		return nil, core.ErrNilStore
+	}
+	if check.IfNil(args.EpochByAddressStorer) {
+		return nil, core.ErrNilStore
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, core.ErrNilMarshalizer
+	}
+
+	recordedBlocks, err := lrucache.NewCache(sizeOfRecentlyRecordedBlocksCache)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txHashesByAddressIndex{
+		storer:         args.TxHashesByAddressStorer,
+		epochByAddress: newHashToEpochIndex(args.EpochByAddressStorer, args.Marshalizer),
+		marshalizer:    args.Marshalizer,
+		recordedBlocks: recordedBlocks,
+	}, nil
+}
+
+// RecordBlock records the hashes of the transactions found in the provided miniblocks, for both senders and receivers
+func (thi *txHashesByAddressIndex) RecordBlock(
+	blockHeaderHash []byte,
+	blockHeader data.HeaderHandler,
+	miniBlocks []*block.MiniBlock,
+	transactions map[string]data.TransactionHandler,
+) error {
+	if check.IfNil(blockHeader) {
+		return errNilBlockHeader
+	}
+
+	entriesByAddress, addresses := thi.groupEntriesByAddress(blockHeaderHash, blockHeader, miniBlocks, transactions)
+	if len(addresses) == 0 {
+		return nil
+	}
+
+	thi.mutIndex.Lock()
+	defer thi.mutIndex.Unlock()
+
+	for _, address := range addresses {
+		err := thi.appendEntries([]byte(address), blockHeader.GetEpoch(), blockHeader.GetNonce(), entriesByAddress[address])
+		if err != nil {
+			logging.LogErrAsWarnExceptAsDebugIfClosingError(log, err, "txHashesByAddressIndex.appendEntries()",
+				"address", []byte(address), "err", err)
+			continue
+		}
+	}
+
+	_ = thi.recordedBlocks.Put(blockHeaderHash, addresses, len(addresses))
+
+	return nil
+}
+
+func (thi *txHashesByAddressIndex) groupEntriesByAddress(
+	blockHeaderHash []byte,
+	blockHeader data.HeaderHandler,
+	miniBlocks []*block.MiniBlock,
+	transactions map[string]data.TransactionHandler,
+) (map[string][]*TxHashByAddress, []string) {
+	entriesByAddress := make(map[string][]*TxHashByAddress)
+	addresses := make([]string, 0)
+	alreadyAdded := make(map[string]*TxHashByAddress)
+
+	addEntry := func(address []byte, txHash []byte, miniblockType block.Type, isSender bool) {
+		if len(address) == 0 {
+			return
+		}
+
+		key := string(address) + string(txHash)
+		entry, exists := alreadyAdded[key]
+		if !exists {
+			entry = &TxHashByAddress{
+				TxHash:        txHash,
+				BlockHash:     blockHeaderHash,
+				BlockNonce:    blockHeader.GetNonce(),
+				Round:         blockHeader.GetRound(),
+				Epoch:         blockHeader.GetEpoch(),
+				MiniblockType: int32(miniblockType),
+			}
+			alreadyAdded[key] = entry
+
+			_, addressExists := entriesByAddress[string(address)]
+			if !addressExists {
+				addresses = append(addresses, string(address))
+			}
+			entriesByAddress[string(address)] = append(entriesByAddress[string(address)], entry)
+		}
+
+		entry.IsSender = entry.IsSender || isSender
+		entry.IsReceiver = entry.IsReceiver || !isSender
+	}
+
+	for _, miniBlock := range miniBlocks {
+		if !shouldIndexMiniblockByAddress(miniBlock) {
+			continue
+		}
+
+		for _, txHash := range miniBlock.TxHashes {
+			tx, found := transactions[string(txHash)]
+			if !found || check.IfNil(tx) {
+				log.Trace("txHashesByAddressIndex: transaction not found", "txHash", txHash)
+				continue
+			}
+
+			addEntry(tx.GetSndAddr(), txHash, miniBlock.Type, true)
+			addEntry(tx.GetRcvAddr(), txHash, miniBlock.Type, false)
+		}
+	}
+
+	return entriesByAddress, addresses
+}
+
+func shouldIndexMiniblockByAddress(miniBlock *block.MiniBlock) bool {
+	if miniBlock == nil {
+		return false
+	}
+
+	switch miniBlock.Type {
+	case block.TxBlock, block.SmartContractResultBlock, block.RewardsBlock, block.InvalidBlock:
+		return true
+	default:
+		return false
+	}
+}
+
+func (thi *txHashesByAddressIndex) appendEntries(address []byte, epoch uint32, blockNonce uint64, entries []*TxHashByAddress) error {
+	metadata := thi.getOrCreateMetadata(address, epoch)
+
+	// Entries from a previously recorded block with the same (or a higher) nonce belong to a fork, thus they are dropped
+	err := thi.truncateEntries(address, epoch, metadata, blockNonce)
+	if err != nil {
+		return err
+	}
+
+	pageIndex := metadata.NumEntries / numEntriesPerTxHashesByAddressPage
+	page := &TxHashesByAddressPage{}
+	if metadata.NumEntries%numEntriesPerTxHashesByAddressPage != 0 {
+		page, err = thi.getPage(address, epoch, pageIndex)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, entry := range entries {
+		page.Entries = append(page.Entries, entry)
+		metadata.NumEntries++
+
+		if len(page.Entries) < numEntriesPerTxHashesByAddressPage {
+			continue
+		}
+
+		err = thi.putPage(address, epoch, pageIndex, page)
+		if err != nil {
+			return err
+		}
+
+		pageIndex++
+		page = &TxHashesByAddressPage{}
+	}
+
+	if len(page.Entries) > 0 {
+		err = thi.putPage(address, epoch, pageIndex, page)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = thi.putMetadata(address, epoch, metadata)
+	if err != nil {
+		return err
+	}
+
+	return thi.epochByAddress.saveEpochByHash(address, epoch)
+}
+
+func (thi *txHashesByAddressIndex) getOrCreateMetadata(address []byte, epoch uint32) *TxHashesByAddressMetadata {
+	metadata, err := thi.getMetadata(address, epoch)
+	if err == nil {
+		return metadata
+	}
+
+	metadata = &TxHashesByAddressMetadata{}
+
+	latestEpoch, err := thi.epochByAddress.getEpochByHash(address)
+	if err != nil {
+		// first record for this address
+		return metadata
+	}
+
+	// records of newer epochs (if any) are left behind by reverted blocks and are skipped
+	for latestEpoch >= epoch {
+		latestMetadata, errGet := thi.getMetadata(address, latestEpoch)
+		if errGet != nil || !latestMetadata.HasPreviousEpoch {
+			return metadata
+		}
+
+		latestEpoch = latestMetadata.PreviousEpoch
+	}
+
+	metadata.PreviousEpoch = latestEpoch
+	metadata.HasPreviousEpoch = true
+
+	return metadata
+}
+
+func (thi *txHashesByAddressIndex) truncateEntries(address []byte, epoch uint32, metadata *TxHashesByAddressMetadata, fromBlockNonce uint64) error {
+	for metadata.NumEntries > 0 {
+		pageIndex := (metadata.NumEntries - 1) / numEntriesPerTxHashesByAddressPage
+		page, err := thi.getPage(address, epoch, pageIndex)
+		if err != nil {
+			return err
+		}
+
+		numKept := len(page.Entries)
+		for numKept > 0 && page.Entries[numKept-1].BlockNonce >= fromBlockNonce {
+			numKept--
+		}
+
+		numRemoved := len(page.Entries) - numKept
+		if numRemoved == 0 {
+			return nil
+		}
+
+		metadata.NumEntries -= uint64(numRemoved)
+		if numKept == 0 {
+			continue
+		}
+
+		page.Entries = page.Entries[:numKept]
+
+		return thi.putPage(address, epoch, pageIndex, page)
+	}
+
+	return nil
+}
+
+// RevertBlock removes the records previously added for the provided block, if the block has been recently recorded
+func (thi *txHashesByAddressIndex) RevertBlock(blockHeaderHash []byte, blockHeader data.HeaderHandler) error {
+	if check.IfNil(blockHeader) {
+		return errNilBlockHeader
+	}
+
+	cachedAddresses, found := thi.recordedBlocks.Get(blockHeaderHash)
+	if !found {
+		log.Debug("txHashesByAddressIndex.RevertBlock(): block not recently recorded, nothing to revert",
+			"nonce", blockHeader.GetNonce(), "hash", blockHeaderHash)
+		return nil
+	}
+
+	addresses, ok := cachedAddresses.([]string)
+	if !ok {
+		return errWrongTypeAssertion
+	}
+
+	thi.mutIndex.Lock()
+	defer thi.mutIndex.Unlock()
+
+	epoch := blockHeader.GetEpoch()
+	for _, address := range addresses {
+		metadata, err := thi.getMetadata([]byte(address), epoch)
+		if err != nil {
+			continue
+		}
+
+		err = thi.truncateEntries([]byte(address), epoch, metadata, blockHeader.GetNonce())
+		if err != nil {
+			return err
+		}
+
+		err = thi.putMetadata([]byte(address), epoch, metadata)
+		if err != nil {
+			return err
+		}
+	}
+
+	thi.recordedBlocks.Remove(blockHeaderHash)
+
+	return nil
+}
+
+// GetTxHashesByAddress returns a page of the transactions hashes recorded for the given address, in the requested order.
+// The newest records are returned first, unless ascending order is requested.
+func (thi *txHashesByAddressIndex) GetTxHashesByAddress(address []byte, from uint32, size uint32, ascending bool) ([]*TxHashByAddress, error) {
+	if len(address) == 0 {
+		return nil, errEmptyAddress
+	}
+
+	thi.mutIndex.RLock()
+	defer thi.mutIndex.RUnlock()
+
+	results := make([]*TxHashByAddress, 0, size)
+	if size == 0 {
+		return results, nil
+	}
+
+	toSkip := uint64(from)
+	var err error
+	collectEntries := func(record *txHashesByAddressRecord) bool {
+		numEntries := record.metadata.NumEntries
+		if toSkip >= numEntries {
+			toSkip -= numEntries
+			return true
+		}
+
+		numToCollect := uint64(int(size) - len(results))
+		results, err = thi.appendRecordEntries(results, address, record, toSkip, numToCollect, ascending)
+		toSkip = 0
+
+		return err == nil && len(results) < int(size)
+	}
+
+	if !ascending {
+		thi.walkRecordsNewestFirst(address, collectEntries)
+		return results, err
+	}
+
+	records := make([]*txHashesByAddressRecord, 0)
+	thi.walkRecordsNewestFirst(address, func(record *txHashesByAddressRecord) bool {
+		records = append(records, record)
+		return true
+	})
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].epoch < records[j].epoch
+	})
+
+	for _, record := range records {
+		if !collectEntries(record) {
+			break
+		}
+	}
+
+	return results, err
+}
+
+// walkRecordsNewestFirst calls the handler for each epoch record of the given address, starting with the newest one.
+// The walk stops when the handler returns false or when older records are not available anymore (e.g. pruned epochs).
+func (thi *txHashesByAddressIndex) walkRecordsNewestFirst(address []byte, handler func(record *txHashesByAddressRecord) bool) {
+	epoch, err := thi.epochByAddress.getEpochByHash(address)
+	if err != nil {
+		return
+	}
+
+	for {
+		metadata, errGet := thi.getMetadata(address, epoch)
+		if errGet != nil {
+			return
+		}
+
+		shouldContinue := handler(&txHashesByAddressRecord{
+			epoch:    epoch,
+			metadata: metadata,
+		})
+		if !shouldContinue || !metadata.HasPreviousEpoch {
+			return
+		}
+
+		epoch = metadata.PreviousEpoch
+	}
+}
+
+func (thi *txHashesByAddressIndex) appendRecordEntries(
+	results []*TxHashByAddress,
+	address []byte,
+	record *txHashesByAddressRecord,
+	toSkip uint64,
+	numToCollect uint64,
+	ascending bool,
+) ([]*TxHashByAddress, error) {
+	pages := make(map[uint64]*TxHashesByAddressPage)
+	numEntries := record.metadata.NumEntries
+
+	for i := toSkip; i < numEntries && numToCollect > 0; i++ {
+		position := i
+		if !ascending {
+			position = numEntries - 1 - i
+		}
+
+		pageIndex := position / numEntriesPerTxHashesByAddressPage
+		page, found := pages[pageIndex]
+		if !found {
+			var err error
+			page, err = thi.getPage(address, record.epoch, pageIndex)
+			if err != nil {
+				return results, err
+			}
+
+			pages[pageIndex] = page
+		}
+
+		positionInPage := int(position % numEntriesPerTxHashesByAddressPage)
+		if positionInPage >= len(page.Entries) {
+			return results, errInconsistentTxHashesByAddressRecord
+		}
+
+		results = append(results, page.Entries[positionInPage])
+		numToCollect--
+	}
+
+	return results, nil
+}
+
+func (thi *txHashesByAddressIndex) getMetadata(address []byte, epoch uint32) (*TxHashesByAddressMetadata, error) {
+	rawBytes, err := thi.storer.GetFromEpoch(metadataKeyOfTxHashesByAddress(address, epoch), epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := &TxHashesByAddressMetadata{}
+	err = thi.marshalizer.Unmarshal(metadata, rawBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return metadata, nil
+}
+
+func (thi *txHashesByAddressIndex) putMetadata(address []byte, epoch uint32, metadata *TxHashesByAddressMetadata) error {
+	rawBytes, err := thi.marshalizer.Marshal(metadata)
+	if err != nil {
+		return err
+	}
+
+	return thi.storer.PutInEpoch(metadataKeyOfTxHashesByAddress(address, epoch), rawBytes, epoch)
+}
+
+func (thi *txHashesByAddressIndex) getPage(address []byte, epoch uint32, pageIndex uint64) (*TxHashesByAddressPage, error) {
+	rawBytes, err := thi.storer.GetFromEpoch(pageKeyOfTxHashesByAddress(address, epoch, pageIndex), epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &TxHashesByAddressPage{}
+	err = thi.marshalizer.Unmarshal(page, rawBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+func (thi *txHashesByAddressIndex) putPage(address []byte, epoch uint32, pageIndex uint64, page *TxHashesByAddressPage) error {
+	rawBytes, err := thi.marshalizer.Marshal(page)
+	if err != nil {
+		return err
+	}
+
+	return thi.storer.PutInEpoch(pageKeyOfTxHashesByAddress(address, epoch, pageIndex), rawBytes, epoch)
+}
+
+// The epoch is part of the keys as well, since the pruning storer caches values by key only
+func metadataKeyOfTxHashesByAddress(address []byte, epoch uint32) []byte {
+	key := make([]byte, len(address)+uint32Size)
+	copy(key, address)
+	binary.BigEndian.PutUint32(key[len(address):], epoch)
+
+	return key
+}
+
+func pageKeyOfTxHashesByAddress(address []byte, epoch uint32, pageIndex uint64) []byte {
+	key := make([]byte, len(address)+2*uint32Size)
+	copy(key, address)
+	binary.BigEndian.PutUint32(key[len(address):], epoch)
+	binary.BigEndian.PutUint32(key[len(address)+uint32Size:], uint32(pageIndex))
+
+	return key
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (thi *txHashesByAddressIndex) IsInterfaceNil() bool {
+	return thi == nil
+}
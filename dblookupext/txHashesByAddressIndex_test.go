@@ -0,0 +1,232 @@
+package dblookupext
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsTxHashesByAddressIndex() ArgsTxHashesByAddressIndex {
+	return ArgsTxHashesByAddressIndex{
+		TxHashesByAddressStorer: genericMocks.NewStorerMockWithEpoch(0),
+		EpochByAddressStorer:    genericMocks.NewStorerMockWithEpoch(0),
+		Marshalizer:             &mock.MarshalizerMock{},
+	}
+}
+
+func recordTransfers(t *testing.T, index *txHashesByAddressIndex, header *block.Header, headerHash string, transfers map[string][2]string) {
+	miniBlock := &block.MiniBlock{Type: block.TxBlock}
+	transactions := make(map[string]data.TransactionHandler)
+	for txHash, transfer := range transfers {
+		miniBlock.TxHashes = append(miniBlock.TxHashes, []byte(txHash))
+		transactions[txHash] = &transaction.Transaction{
+			SndAddr: []byte(transfer[0]),
+			RcvAddr: []byte(transfer[1]),
+		}
+	}
+
+	err := index.RecordBlock([]byte(headerHash), header, []*block.MiniBlock{miniBlock}, transactions)
+	require.Nil(t, err)
+}
+
+func txHashesOf(entries []*TxHashByAddress) []string {
+	hashes := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		hashes = append(hashes, string(entry.TxHash))
+	}
+
+	return hashes
+}
+
+func TestNewTxHashesByAddressIndex(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsTxHashesByAddressIndex()
+	args.TxHashesByAddressStorer = nil
+	index, err := NewTxHashesByAddressIndex(args)
+	require.True(t, check.IfNil(index))
+	require.Equal(t, core.ErrNilStore, err)
+
+	args = createMockArgsTxHashesByAddressIndex()
+	args.EpochByAddressStorer = nil
+	index, err = NewTxHashesByAddressIndex(args)
+	require.True(t, check.IfNil(index))
+	require.Equal(t, core.ErrNilStore, err)
+
+	args = createMockArgsTxHashesByAddressIndex()
+	args.Marshalizer = nil
+	index, err = NewTxHashesByAddressIndex(args)
+	require.True(t, check.IfNil(index))
+	require.Equal(t, core.ErrNilMarshalizer, err)
+
+	args = createMockArgsTxHashesByAddressIndex()
+	index, err = NewTxHashesByAddressIndex(args)
+	require.False(t, check.IfNil(index))
+	require.Nil(t, err)
+}
+
+func TestTxHashesByAddressIndex_RecordBlockShouldRecordSendersAndReceivers(t *testing.T) {
+	t.Parallel()
+
+	index, _ := NewTxHashesByAddressIndex(createMockArgsTxHashesByAddressIndex())
+
+	header := &block.Header{Nonce: 10, Round: 11, Epoch: 0}
+	miniBlocks := []*block.MiniBlock{
+		{Type: block.TxBlock, TxHashes: [][]byte{[]byte("txA"), []byte("txSelf"), []byte("missing")}},
+		{Type: block.SmartContractResultBlock, TxHashes: [][]byte{[]byte("scrA")}},
+		{Type: block.RewardsBlock, TxHashes: [][]byte{[]byte("rewardA")}},
+		{Type: block.PeerBlock, TxHashes: [][]byte{[]byte("peerA")}},
+	}
+	transactions := map[string]data.TransactionHandler{
+		"txA":     &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+		"txSelf":  &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("alice")},
+		"scrA":    &smartContractResult.SmartContractResult{SndAddr: []byte("contract"), RcvAddr: []byte("bob")},
+		"rewardA": &rewardTx.RewardTx{RcvAddr: []byte("alice")},
+		"peerA":   &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")},
+	}
+
+	err := index.RecordBlock([]byte("blockHash"), header, miniBlocks, transactions)
+	require.Nil(t, err)
+
+	entries, err := index.GetTxHashesByAddress([]byte("alice"), 0, 10, true)
+	require.Nil(t, err)
+	require.Equal(t, []string{"txA", "txSelf", "rewardA"}, txHashesOf(entries))
+	require.True(t, entries[0].IsSender)
+	require.False(t, entries[0].IsReceiver)
+	require.True(t, entries[1].IsSender)
+	require.True(t, entries[1].IsReceiver)
+	require.Equal(t, int32(block.RewardsBlock), entries[2].MiniblockType)
+	require.Equal(t, uint64(10), entries[0].BlockNonce)
+	require.Equal(t, uint64(11), entries[0].Round)
+	require.Equal(t, []byte("blockHash"), entries[0].BlockHash)
+
+	entries, err = index.GetTxHashesByAddress([]byte("bob"), 0, 10, true)
+	require.Nil(t, err)
+	require.Equal(t, []string{"txA", "scrA"}, txHashesOf(entries))
+	require.True(t, entries[1].IsReceiver)
+
+	entries, err = index.GetTxHashesByAddress([]byte("unknown"), 0, 10, true)
+	require.Nil(t, err)
+	require.Empty(t, entries)
+}
+
+func TestTxHashesByAddressIndex_GetTxHashesByAddressShouldPaginateAcrossPagesAndEpochs(t *testing.T) {
+	t.Parallel()
+
+	index, _ := NewTxHashesByAddressIndex(createMockArgsTxHashesByAddressIndex())
+
+	numBlocksPerEpoch := 3
+	numTxsPerBlock := numEntriesPerTxHashesByAddressPage/2 + 1
+	expectedAscending := make([]string, 0)
+	nonce := uint64(1)
+	for epoch := uint32(0); epoch < 3; epoch++ {
+		if epoch == 1 {
+			// no activity in epoch 2
+			epoch++
+		}
+
+		for i := 0; i < numBlocksPerEpoch; i++ {
+			miniBlock := &block.MiniBlock{Type: block.TxBlock}
+			transactions := make(map[string]data.TransactionHandler)
+			for j := 0; j < numTxsPerBlock; j++ {
+				txHash := fmt.Sprintf("tx-%d-%d", nonce, j)
+				miniBlock.TxHashes = append(miniBlock.TxHashes, []byte(txHash))
+				transactions[txHash] = &transaction.Transaction{SndAddr: []byte("alice"), RcvAddr: []byte("bob")}
+				expectedAscending = append(expectedAscending, txHash)
+			}
+
+			header := &block.Header{Nonce: nonce, Epoch: epoch}
+			err := index.RecordBlock([]byte(fmt.Sprintf("hash-%d", nonce)), header, []*block.MiniBlock{miniBlock}, transactions)
+			require.Nil(t, err)
+			nonce++
+		}
+	}
+
+	expectedDescending := make([]string, 0, len(expectedAscending))
+	for i := len(expectedAscending) - 1; i >= 0; i-- {
+		expectedDescending = append(expectedDescending, expectedAscending[i])
+	}
+
+	entries, err := index.GetTxHashesByAddress([]byte("alice"), 0, uint32(len(expectedAscending)+10), true)
+	require.Nil(t, err)
+	require.Equal(t, expectedAscending, txHashesOf(entries))
+
+	entries, err = index.GetTxHashesByAddress([]byte("bob"), 0, uint32(len(expectedAscending)), false)
+	require.Nil(t, err)
+	require.Equal(t, expectedDescending, txHashesOf(entries))
+
+	from := numBlocksPerEpoch*numTxsPerBlock - 7
+	size := 20
+	entries, err = index.GetTxHashesByAddress([]byte("alice"), uint32(from), uint32(size), true)
+	require.Nil(t, err)
+	require.Equal(t, expectedAscending[from:from+size], txHashesOf(entries))
+
+	entries, err = index.GetTxHashesByAddress([]byte("alice"), uint32(from), uint32(size), false)
+	require.Nil(t, err)
+	require.Equal(t, expectedDescending[from:from+size], txHashesOf(entries))
+
+	entries, err = index.GetTxHashesByAddress([]byte("alice"), uint32(len(expectedAscending)), uint32(size), false)
+	require.Nil(t, err)
+	require.Empty(t, entries)
+
+	entries, err = index.GetTxHashesByAddress([]byte("alice"), 0, 0, false)
+	require.Nil(t, err)
+	require.Empty(t, entries)
+
+	entries, err = index.GetTxHashesByAddress(nil, 0, 10, false)
+	require.Nil(t, entries)
+	require.Equal(t, errEmptyAddress, err)
+}
+
+func TestTxHashesByAddressIndex_RecordBlockOnForkShouldDropEntriesOfHigherNonces(t *testing.T) {
+	t.Parallel()
+
+	index, _ := NewTxHashesByAddressIndex(createMockArgsTxHashesByAddressIndex())
+
+	recordTransfers(t, index, &block.Header{Nonce: 1}, "hash1", map[string][2]string{"tx1": {"alice", "bob"}})
+	recordTransfers(t, index, &block.Header{Nonce: 2}, "hash2-fork", map[string][2]string{"tx2-fork": {"alice", "bob"}})
+	recordTransfers(t, index, &block.Header{Nonce: 2}, "hash2", map[string][2]string{"tx2": {"alice", "carol"}})
+
+	entries, err := index.GetTxHashesByAddress([]byte("alice"), 0, 10, true)
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx1", "tx2"}, txHashesOf(entries))
+}
+
+func TestTxHashesByAddressIndex_RevertBlock(t *testing.T) {
+	t.Parallel()
+
+	index, _ := NewTxHashesByAddressIndex(createMockArgsTxHashesByAddressIndex())
+
+	header1 := &block.Header{Nonce: 1}
+	header2 := &block.Header{Nonce: 2}
+	recordTransfers(t, index, header1, "hash1", map[string][2]string{"tx1": {"alice", "bob"}})
+	recordTransfers(t, index, header2, "hash2", map[string][2]string{"tx2": {"alice", "bob"}})
+
+	err := index.RevertBlock([]byte("hash2"), header2)
+	require.Nil(t, err)
+
+	entries, err := index.GetTxHashesByAddress([]byte("bob"), 0, 10, false)
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx1"}, txHashesOf(entries))
+
+	// unknown blocks are ignored
+	err = index.RevertBlock([]byte("hash1-unknown"), header1)
+	require.Nil(t, err)
+
+	err = index.RevertBlock([]byte("hash1"), nil)
+	require.Equal(t, errNilBlockHeader, err)
+
+	entries, err = index.GetTxHashesByAddress([]byte("alice"), 0, 10, false)
+	require.Nil(t, err)
+	require.Equal(t, []string{"tx1"}, txHashesOf(entries))
+}
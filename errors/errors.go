@@ -155,6 +155,9 @@ var ErrNilEconomicsHandler = errors.New("nil economics handler")
 // ErrNilOutportHandler signals that a nil outport handler has been provided
 var ErrNilOutportHandler = errors.New("nil outport handler")
 
+// ErrNilEventsSubscriptionHandler signals that a nil events subscription handler has been provided
+var ErrNilEventsSubscriptionHandler = errors.New("nil events subscription handler")
+
 // ErrNilEpochNotifier signals that a nil epoch notifier has been provided
 var ErrNilEpochNotifier = errors.New("nil epoch notifier")
 
@@ -473,6 +476,9 @@ var ErrContextClosing = errors.New("context closing")
 // ErrNilTxsSender signals that a nil transactions sender has been provided
 var ErrNilTxsSender = errors.New("nil transactions sender has been provided")
 
+// ErrNilScheduledTxsOutbox signals that a nil outbox of scheduled transactions has been provided
+var ErrNilScheduledTxsOutbox = errors.New("nil scheduled transactions outbox has been provided")
+
 // ErrNilProcessStatusHandler signals that a nil process status handler was provided
 var ErrNilProcessStatusHandler = errors.New("nil process status handler")
 
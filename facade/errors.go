@@ -29,6 +29,9 @@ var ErrNilTransactionSimulatorProcessor = errors.New("nil transaction simulator
 // ErrNilBlockchain signals that a nil blockchain has been provided
 var ErrNilBlockchain = errors.New("nil blockchain")
 
+// ErrNilEventsSubscriptionHandler signals that a nil events subscription handler has been provided
+var ErrNilEventsSubscriptionHandler = errors.New("nil events subscription handler")
+
 // ErrEmptyRootHash signals that the current root hash is empty
 var ErrEmptyRootHash = errors.New("empty current root hash")
 
@@ -3,6 +3,7 @@ package initial
 import (
 	"errors"
 	"math/big"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
@@ -14,6 +15,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/ntp"
+	"github.com/ElrondNetwork/elrond-go/outport"
 	"github.com/ElrondNetwork/elrond-go/process"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/state"
@@ -136,6 +138,11 @@ func (inf *initialNodeFacade) ValidateTransactionForSimulation(_ *transaction.Tr
 	return errNodeStarting
 }
 
+// ValidateTransactionForScheduling returns error
+func (inf *initialNodeFacade) ValidateTransactionForScheduling(_ *transaction.Transaction) error {
+	return errNodeStarting
+}
+
 // ValidatorStatisticsApi returns nil and error
 func (inf *initialNodeFacade) ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error) {
 	return nil, errNodeStarting
@@ -146,8 +153,38 @@ func (inf *initialNodeFacade) SendBulkTransactions(_ []*transaction.Transaction)
 	return uint64(0), errNodeStarting
 }
 
+// ScheduleTransaction returns empty string and error
+func (inf *initialNodeFacade) ScheduleTransaction(_ *transaction.Transaction, _ string, _ uint64) (string, error) {
+	return "", errNodeStarting
+}
+
+// GetScheduledTransactions returns nil and error
+func (inf *initialNodeFacade) GetScheduledTransactions() ([]common.ScheduledTransactionApiResponse, error) {
+	return nil, errNodeStarting
+}
+
+// CancelScheduledTransaction returns error
+func (inf *initialNodeFacade) CancelScheduledTransaction(_ string) error {
+	return errNodeStarting
+}
+
 // SimulateTransactionExecution returns nil and error
-func (inf *initialNodeFacade) SimulateTransactionExecution(_ *transaction.Transaction) (*txSimData.SimulationResults, error) {
+func (inf *initialNodeFacade) SimulateTransactionExecution(_ *transaction.Transaction, _ txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+	return nil, errNodeStarting
+}
+
+// SimulateTransactionExecutionWithStateOverrides returns nil and error
+func (inf *initialNodeFacade) SimulateTransactionExecutionWithStateOverrides(_ *transaction.Transaction, _ map[string]*state.AccountOverride, _ txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+	return nil, errNodeStarting
+}
+
+// GetTransactionTrace returns nil and error
+func (inf *initialNodeFacade) GetTransactionTrace(_ string) (*txSimData.SimulationResults, error) {
+	return nil, errNodeStarting
+}
+
+// SimulateTransactionsBatchExecution returns nil and error
+func (inf *initialNodeFacade) SimulateTransactionsBatchExecution(_ []*transaction.Transaction, _ txSimData.SimulationOptions) (*txSimData.BatchSimulationResults, error) {
 	return nil, errNodeStarting
 }
 
@@ -156,16 +193,41 @@ func (inf *initialNodeFacade) GetTransaction(_ string, _ bool) (*transaction.Api
 	return nil, errNodeStarting
 }
 
+// GetTransactionStateDiff returns nil and error
+func (inf *initialNodeFacade) GetTransactionStateDiff(_ string) ([]*txSimData.AccountStateDiff, error) {
+	return nil, errNodeStarting
+}
+
+// WaitForTransactionStatus returns nil and error
+func (inf *initialNodeFacade) WaitForTransactionStatus(_ string, _ string, _ time.Duration) (*common.TransactionStatusWaitApiResponse, error) {
+	return nil, errNodeStarting
+}
+
 // ComputeTransactionGasLimit returns 0 and error
 func (inf *initialNodeFacade) ComputeTransactionGasLimit(_ *transaction.Transaction) (*transaction.CostResponse, error) {
 	return nil, errNodeStarting
 }
 
+// EstimateTransactionGas returns nil and error
+func (inf *initialNodeFacade) EstimateTransactionGas(_ *transaction.Transaction) (*txSimData.GasEstimation, error) {
+	return nil, errNodeStarting
+}
+
+// EstimateSmartContractResultGas returns nil and error
+func (inf *initialNodeFacade) EstimateSmartContractResultGas(_ *transaction.ApiSmartContractResult) (*txSimData.GasEstimation, error) {
+	return nil, errNodeStarting
+}
+
 // GetAccount returns nil and error
 func (inf *initialNodeFacade) GetAccount(_ string, _ api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error) {
 	return api.AccountResponse{}, api.BlockInfo{}, errNodeStarting
 }
 
+// GetAccounts returns nil and error
+func (inf *initialNodeFacade) GetAccounts(_ []string, _ api.AccountQueryOptions) (map[string]*api.AccountResponse, api.BlockInfo, error) {
+	return nil, api.BlockInfo{}, errNodeStarting
+}
+
 // GetCode returns nil and error
 func (inf *initialNodeFacade) GetCode(_ []byte, _ api.AccountQueryOptions) []byte {
 	return nil
@@ -256,6 +318,11 @@ func (inf *initialNodeFacade) GetBlockByRound(_ uint64, _ api.BlockQueryOptions)
 	return nil, errNodeStarting
 }
 
+// GetHyperblockByNonce returns nil and error
+func (inf *initialNodeFacade) GetHyperblockByNonce(_ uint64, _ api.BlockQueryOptions) (*common.ApiHyperblock, error) {
+	return nil, errNodeStarting
+}
+
 // GetInternalMetaBlockByHash return nil and error
 func (inf *initialNodeFacade) GetInternalMetaBlockByHash(_ common.ApiOutputFormat, _ string) (interface{}, error) {
 	return nil, errNodeStarting
@@ -306,6 +373,11 @@ func (inf *initialNodeFacade) GetKeyValuePairs(_ string, _ api.AccountQueryOptio
 	return nil, api.BlockInfo{}, errNodeStarting
 }
 
+// GetKeyValuePairsPage returns nil and error
+func (inf *initialNodeFacade) GetKeyValuePairsPage(_ string, _ api.AccountQueryOptions, _ common.KeyValuePairsQueryOptions) (*common.KeyValuePairsApiResponse, api.BlockInfo, error) {
+	return nil, api.BlockInfo{}, errNodeStarting
+}
+
 // GetDirectStakedList returns empty slice
 func (inf *initialNodeFacade) GetDirectStakedList() ([]*api.DirectStakedValue, error) {
 	return nil, errNodeStarting
@@ -336,6 +408,16 @@ func (inf *initialNodeFacade) GetTokenSupply(_ string) (*api.ESDTSupply, error)
 	return nil, errNodeStarting
 }
 
+// GetTokenSupplyHistory returns nil and error
+func (inf *initialNodeFacade) GetTokenSupplyHistory(_ string, _ uint32, _ uint32) (*common.ESDTSupplyHistoryApiResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetESDTHolders returns nil and error
+func (inf *initialNodeFacade) GetESDTHolders(_ common.ESDTHoldersQueryOptions) (*common.ESDTHoldersApiResponse, error) {
+	return nil, errNodeStarting
+}
+
 // GetGenesisNodesPubKeys returns nil and error
 func (inf *initialNodeFacade) GetGenesisNodesPubKeys() (map[uint32][]string, map[uint32][]string, error) {
 	return nil, nil, errNodeStarting
@@ -347,7 +429,7 @@ func (inf *initialNodeFacade) GetGenesisBalances() ([]*common.InitialAccountAPI,
 }
 
 // GetTransactionsPool returns a nil structure and error
-func (inf *initialNodeFacade) GetTransactionsPool(_ string) (*common.TransactionsPoolAPIResponse, error) {
+func (inf *initialNodeFacade) GetTransactionsPool(_ common.TransactionsPoolQueryOptions) (*common.TransactionsPoolAPIResponse, error) {
 	return nil, errNodeStarting
 }
 
@@ -361,6 +443,41 @@ func (inf *initialNodeFacade) GetTransactionsPoolNonceGapsForSender(_ string) (*
 	return nil, errNodeStarting
 }
 
+// GetTransactionsPoolStatsForSender returns a nil structure and error
+func (inf *initialNodeFacade) GetTransactionsPoolStatsForSender(_ string) (*common.TransactionsPoolSenderStatsApiResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetTransactionsPoolEvictions returns a nil structure and error
+func (inf *initialNodeFacade) GetTransactionsPoolEvictions() (*common.TransactionsPoolEvictionsApiResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetTransactionsPoolReplacementsForSender returns a nil structure and error
+func (inf *initialNodeFacade) GetTransactionsPoolReplacementsForSender(_ string) (*common.TransactionsPoolReplacementsForSenderApiResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetTransactionsByAddress returns a nil structure and error
+func (inf *initialNodeFacade) GetTransactionsByAddress(_ string, _ common.AddressTransactionsQueryOptions) (*common.AddressTransactionsApiResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetLogEvents returns a nil structure and error
+func (inf *initialNodeFacade) GetLogEvents(_ common.LogEventsQueryOptions) (*common.LogEventsApiResponse, error) {
+	return nil, errNodeStarting
+}
+
+// IsEventsSubscriptionEnabled returns false
+func (inf *initialNodeFacade) IsEventsSubscriptionEnabled() bool {
+	return false
+}
+
+// ServeEventsSubscriber returns error
+func (inf *initialNodeFacade) ServeEventsSubscriber(_ outport.WSConnection) error {
+	return errNodeStarting
+}
+
 // GetTransactionsPoolForSender returns a nil structure and error
 func (inf *initialNodeFacade) GetTransactionsPoolForSender(_, _ string) (*common.TransactionsPoolForSenderApiResponse, error) {
 	return nil, errNodeStarting
@@ -6,6 +6,8 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go/common"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -65,7 +67,7 @@ func TestDisabledNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Equal(t, uint64(0), u1)
 	assert.Equal(t, errNodeStarting, err)
 
-	u2, err := inf.SimulateTransactionExecution(nil)
+	u2, err := inf.SimulateTransactionExecution(nil, txSimData.SimulationOptions{})
 	assert.Nil(t, u2)
 	assert.Equal(t, errNodeStarting, err)
 
@@ -77,6 +79,14 @@ func TestDisabledNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, resp)
 	assert.Equal(t, errNodeStarting, err)
 
+	estimation, err := inf.EstimateTransactionGas(nil)
+	assert.Nil(t, estimation)
+	assert.Equal(t, errNodeStarting, err)
+
+	estimation, err = inf.EstimateSmartContractResultGas(nil)
+	assert.Nil(t, estimation)
+	assert.Equal(t, errNodeStarting, err)
+
 	uac, _, err := inf.GetAccount("", api.AccountQueryOptions{})
 	assert.Equal(t, api.AccountResponse{}, uac)
 	assert.Equal(t, errNodeStarting, err)
@@ -186,7 +196,7 @@ func TestDisabledNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Nil(t, supply)
 	assert.Equal(t, errNodeStarting, err)
 
-	txPool, err := inf.GetTransactionsPool("")
+	txPool, err := inf.GetTransactionsPool(common.TransactionsPoolQueryOptions{})
 	assert.Nil(t, txPool)
 	assert.Equal(t, errNodeStarting, err)
 
@@ -207,5 +217,24 @@ func TestDisabledNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Equal(t, uint64(0), nonce)
 	assert.Equal(t, errNodeStarting, err)
 
+	stats, err := inf.GetTransactionsPoolStatsForSender("")
+	assert.Nil(t, stats)
+	assert.Equal(t, errNodeStarting, err)
+
+	evictions, err := inf.GetTransactionsPoolEvictions()
+	assert.Nil(t, evictions)
+	assert.Equal(t, errNodeStarting, err)
+
+	scheduledTxHash, err := inf.ScheduleTransaction(nil, "", 0)
+	assert.Empty(t, scheduledTxHash)
+	assert.Equal(t, errNodeStarting, err)
+
+	scheduledTxs, err := inf.GetScheduledTransactions()
+	assert.Nil(t, scheduledTxs)
+	assert.Equal(t, errNodeStarting, err)
+
+	err = inf.CancelScheduledTransaction("")
+	assert.Equal(t, errNodeStarting, err)
+
 	assert.False(t, check.IfNil(inf))
 }
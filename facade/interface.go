@@ -3,10 +3,12 @@ package facade
 import (
 	"context"
 	"math/big"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
 	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/debug"
@@ -32,6 +34,9 @@ type NodeHandler interface {
 	// GetKeyValuePairs returns the key-value pairs under a given address
 	GetKeyValuePairs(address string, options api.AccountQueryOptions, ctx context.Context) (map[string]string, api.BlockInfo, error)
 
+	// GetKeyValuePairsPage returns a page of the key-value pairs under a given address
+	GetKeyValuePairsPage(address string, options api.AccountQueryOptions, pageOptions common.KeyValuePairsQueryOptions, ctx context.Context) (*common.KeyValuePairsApiResponse, api.BlockInfo, error)
+
 	// GetAllIssuedESDTs returns all the issued esdt tokens from esdt system smart contract
 	GetAllIssuedESDTs(tokenType string, ctx context.Context) ([]string, error)
 
@@ -53,6 +58,9 @@ type NodeHandler interface {
 	// GetTokenSupply returns the provided token supply from current shard
 	GetTokenSupply(token string) (*api.ESDTSupply, error)
 
+	// GetTokenSupplyHistory returns the supply changes of the provided token, for each epoch of the given range
+	GetTokenSupplyHistory(token string, fromEpoch uint32, toEpoch uint32) (*common.ESDTSupplyHistoryApiResponse, error)
+
 	// CreateTransaction will return a transaction from all needed fields
 	CreateTransaction(nonce uint64, value string, receiver string, receiverUsername []byte, sender string, senderUsername []byte, gasPrice uint64,
 		gasLimit uint64, data []byte, signatureHex string, chainID string, version uint32, options uint32) (*transaction.Transaction, []byte, error)
@@ -60,13 +68,22 @@ type NodeHandler interface {
 	// ValidateTransaction will validate a transaction
 	ValidateTransaction(tx *transaction.Transaction) error
 	ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool) error
+	ValidateTransactionForScheduling(tx *transaction.Transaction) error
 
 	// SendBulkTransactions will send a bulk of transactions on the 'send transactions pipe' channel
 	SendBulkTransactions(txs []*transaction.Transaction) (uint64, error)
 
+	// ScheduleTransaction will add a transaction to the outbox, to be sent once a round, an epoch or an account nonce is reached
+	ScheduleTransaction(tx *transaction.Transaction, condition string, conditionValue uint64) (string, error)
+	// GetScheduledTransactions will return the transactions waiting in the outbox
+	GetScheduledTransactions() ([]common.ScheduledTransactionApiResponse, error)
+	// CancelScheduledTransaction will remove a transaction from the outbox
+	CancelScheduledTransaction(txHash string) error
+
 	// GetAccount returns an accountResponse containing information
 	//  about the account correlated with provided address
 	GetAccount(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error)
+	GetAccounts(addresses []string, options api.AccountQueryOptions) (map[string]*api.AccountResponse, api.BlockInfo, error)
 
 	// GetCode returns the code for the given code hash
 	GetCode(codeHash []byte, options api.AccountQueryOptions) ([]byte, api.BlockInfo)
@@ -97,7 +114,10 @@ type NodeHandler interface {
 
 // TransactionSimulatorProcessor defines the actions which a transaction simulator processor has to implement
 type TransactionSimulatorProcessor interface {
-	ProcessTx(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ProcessTx(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error)
+	ProcessTxWithStateOverrides(tx *transaction.Transaction, overrides map[string]*state.AccountOverride, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error)
+	ProcessTxsBatch(txs []*transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.BatchSimulationResults, error)
+	ProcessSmartContractResult(scr *smartContractResult.SmartContractResult) (*txSimData.SimulationResults, error)
 	IsInterfaceNil() bool
 }
 
@@ -105,18 +125,30 @@ type TransactionSimulatorProcessor interface {
 type ApiResolver interface {
 	ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VMOutput, error)
 	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	EstimateTransactionGas(tx *transaction.Transaction) (*txSimData.GasEstimation, error)
+	EstimateSmartContractResultGas(scr *transaction.ApiSmartContractResult) (*txSimData.GasEstimation, error)
 	StatusMetrics() external.StatusMetricsHandler
 	GetTotalStakedValue(ctx context.Context) (*api.StakeValues, error)
 	GetDirectStakedList(ctx context.Context) ([]*api.DirectStakedValue, error)
 	GetDelegatorsList(ctx context.Context) ([]*api.Delegator, error)
+	GetESDTHolders(options common.ESDTHoldersQueryOptions, ctx context.Context) (*common.ESDTHoldersApiResponse, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
-	GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error)
+	GetTransactionStateDiff(hash string) ([]*txSimData.AccountStateDiff, error)
+	GetTransactionTrace(hash string) (*txSimData.SimulationResults, error)
+	WaitForTransactionStatus(hash string, status string, timeout time.Duration) (*common.TransactionStatusWaitApiResponse, error)
+	GetTransactionsPool(options common.TransactionsPoolQueryOptions) (*common.TransactionsPoolAPIResponse, error)
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionsPoolReplacementsForSender(sender string) (*common.TransactionsPoolReplacementsForSenderApiResponse, error)
+	GetTransactionsPoolStatsForSender(sender string) (*common.TransactionsPoolSenderStatsApiResponse, error)
+	GetTransactionsPoolEvictions() (*common.TransactionsPoolEvictionsApiResponse, error)
+	GetTransactionsByAddress(address string, options common.AddressTransactionsQueryOptions) (*common.AddressTransactionsApiResponse, error)
+	GetLogEvents(options common.LogEventsQueryOptions) (*common.LogEventsApiResponse, error)
 	GetBlockByHash(hash string, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByNonce(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByRound(round uint64, options api.BlockQueryOptions) (*api.Block, error)
+	GetHyperblockByNonce(nonce uint64, options api.BlockQueryOptions) (*common.ApiHyperblock, error)
 	GetInternalShardBlockByNonce(format common.ApiOutputFormat, nonce uint64) (interface{}, error)
 	GetInternalShardBlockByHash(format common.ApiOutputFormat, hash string) (interface{}, error)
 	GetInternalShardBlockByRound(format common.ApiOutputFormat, round uint64) (interface{}, error)
@@ -2,42 +2,56 @@ package mock
 
 import (
 	"context"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/process"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
 // ApiResolverStub -
 type ApiResolverStub struct {
-	ExecuteSCQueryHandler                       func(query *process.SCQuery) (*vmcommon.VMOutput, error)
-	StatusMetricsHandler                        func() external.StatusMetricsHandler
-	ComputeTransactionGasLimitHandler           func(tx *transaction.Transaction) (*transaction.CostResponse, error)
-	GetTotalStakedValueHandler                  func(ctx context.Context) (*api.StakeValues, error)
-	GetDirectStakedListHandler                  func(ctx context.Context) ([]*api.DirectStakedValue, error)
-	GetDelegatorsListHandler                    func(ctx context.Context) ([]*api.Delegator, error)
-	GetBlockByHashCalled                        func(hash string, options api.BlockQueryOptions) (*api.Block, error)
-	GetBlockByNonceCalled                       func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
-	GetBlockByRoundCalled                       func(round uint64, options api.BlockQueryOptions) (*api.Block, error)
-	GetTransactionHandler                       func(hash string, withEvents bool) (*transaction.ApiTransactionResult, error)
-	GetInternalShardBlockByNonceCalled          func(format common.ApiOutputFormat, nonce uint64) (interface{}, error)
-	GetInternalShardBlockByHashCalled           func(format common.ApiOutputFormat, hash string) (interface{}, error)
-	GetInternalShardBlockByRoundCalled          func(format common.ApiOutputFormat, round uint64) (interface{}, error)
-	GetInternalMetaBlockByNonceCalled           func(format common.ApiOutputFormat, nonce uint64) (interface{}, error)
-	GetInternalMetaBlockByHashCalled            func(format common.ApiOutputFormat, hash string) (interface{}, error)
-	GetInternalMetaBlockByRoundCalled           func(format common.ApiOutputFormat, round uint64) (interface{}, error)
-	GetInternalMiniBlockCalled                  func(format common.ApiOutputFormat, hash string, epoch uint32) (interface{}, error)
-	GetInternalStartOfEpochMetaBlockCalled      func(format common.ApiOutputFormat, epoch uint32) (interface{}, error)
-	GetGenesisNodesPubKeysCalled                func() (map[uint32][]string, map[uint32][]string)
-	GetTransactionsPoolCalled                   func(fields string) (*common.TransactionsPoolAPIResponse, error)
-	GetGenesisBalancesCalled                    func() ([]*common.InitialAccountAPI, error)
-	GetTransactionsPoolForSenderCalled          func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
-	GetLastPoolNonceForSenderCalled             func(sender string) (uint64, error)
-	GetTransactionsPoolNonceGapsForSenderCalled func(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
-	GetGasConfigsCalled                         func() map[string]map[string]uint64
+	ExecuteSCQueryHandler                          func(query *process.SCQuery) (*vmcommon.VMOutput, error)
+	StatusMetricsHandler                           func() external.StatusMetricsHandler
+	ComputeTransactionGasLimitHandler              func(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	EstimateTransactionGasHandler                  func(tx *transaction.Transaction) (*txSimData.GasEstimation, error)
+	EstimateSmartContractResultGasHandler          func(scr *transaction.ApiSmartContractResult) (*txSimData.GasEstimation, error)
+	GetTotalStakedValueHandler                     func(ctx context.Context) (*api.StakeValues, error)
+	GetDirectStakedListHandler                     func(ctx context.Context) ([]*api.DirectStakedValue, error)
+	GetDelegatorsListHandler                       func(ctx context.Context) ([]*api.Delegator, error)
+	GetESDTHoldersCalled                           func(options common.ESDTHoldersQueryOptions, ctx context.Context) (*common.ESDTHoldersApiResponse, error)
+	GetBlockByHashCalled                           func(hash string, options api.BlockQueryOptions) (*api.Block, error)
+	GetBlockByNonceCalled                          func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
+	GetBlockByRoundCalled                          func(round uint64, options api.BlockQueryOptions) (*api.Block, error)
+	GetHyperblockByNonceCalled                     func(nonce uint64, options api.BlockQueryOptions) (*common.ApiHyperblock, error)
+	GetTransactionHandler                          func(hash string, withEvents bool) (*transaction.ApiTransactionResult, error)
+	GetTransactionStateDiffCalled                  func(hash string) ([]*txSimData.AccountStateDiff, error)
+	GetTransactionTraceCalled                      func(hash string) (*txSimData.SimulationResults, error)
+	WaitForTransactionStatusCalled                 func(hash string, status string, timeout time.Duration) (*common.TransactionStatusWaitApiResponse, error)
+	GetInternalShardBlockByNonceCalled             func(format common.ApiOutputFormat, nonce uint64) (interface{}, error)
+	GetInternalShardBlockByHashCalled              func(format common.ApiOutputFormat, hash string) (interface{}, error)
+	GetInternalShardBlockByRoundCalled             func(format common.ApiOutputFormat, round uint64) (interface{}, error)
+	GetInternalMetaBlockByNonceCalled              func(format common.ApiOutputFormat, nonce uint64) (interface{}, error)
+	GetInternalMetaBlockByHashCalled               func(format common.ApiOutputFormat, hash string) (interface{}, error)
+	GetInternalMetaBlockByRoundCalled              func(format common.ApiOutputFormat, round uint64) (interface{}, error)
+	GetInternalMiniBlockCalled                     func(format common.ApiOutputFormat, hash string, epoch uint32) (interface{}, error)
+	GetInternalStartOfEpochMetaBlockCalled         func(format common.ApiOutputFormat, epoch uint32) (interface{}, error)
+	GetGenesisNodesPubKeysCalled                   func() (map[uint32][]string, map[uint32][]string)
+	GetTransactionsPoolCalled                      func(options common.TransactionsPoolQueryOptions) (*common.TransactionsPoolAPIResponse, error)
+	GetGenesisBalancesCalled                       func() ([]*common.InitialAccountAPI, error)
+	GetTransactionsPoolForSenderCalled             func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
+	GetLastPoolNonceForSenderCalled                func(sender string) (uint64, error)
+	GetTransactionsPoolNonceGapsForSenderCalled    func(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionsPoolReplacementsForSenderCalled func(sender string) (*common.TransactionsPoolReplacementsForSenderApiResponse, error)
+	GetTransactionsPoolStatsForSenderCalled        func(sender string) (*common.TransactionsPoolSenderStatsApiResponse, error)
+	GetTransactionsPoolEvictionsCalled             func() (*common.TransactionsPoolEvictionsApiResponse, error)
+	GetTransactionsByAddressCalled                 func(address string, options common.AddressTransactionsQueryOptions) (*common.AddressTransactionsApiResponse, error)
+	GetGasConfigsCalled                            func() map[string]map[string]uint64
+	GetLogEventsCalled                             func(options common.LogEventsQueryOptions) (*common.LogEventsApiResponse, error)
 }
 
 // GetTransaction -
@@ -49,6 +63,33 @@ func (ars *ApiResolverStub) GetTransaction(hash string, withEvents bool) (*trans
 	return nil, nil
 }
 
+// GetTransactionStateDiff -
+func (ars *ApiResolverStub) GetTransactionStateDiff(hash string) ([]*txSimData.AccountStateDiff, error) {
+	if ars.GetTransactionStateDiffCalled != nil {
+		return ars.GetTransactionStateDiffCalled(hash)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionTrace -
+func (ars *ApiResolverStub) GetTransactionTrace(hash string) (*txSimData.SimulationResults, error) {
+	if ars.GetTransactionTraceCalled != nil {
+		return ars.GetTransactionTraceCalled(hash)
+	}
+
+	return nil, nil
+}
+
+// WaitForTransactionStatus -
+func (ars *ApiResolverStub) WaitForTransactionStatus(hash string, status string, timeout time.Duration) (*common.TransactionStatusWaitApiResponse, error) {
+	if ars.WaitForTransactionStatusCalled != nil {
+		return ars.WaitForTransactionStatusCalled(hash, status, timeout)
+	}
+
+	return nil, nil
+}
+
 // GetBlockByHash -
 func (ars *ApiResolverStub) GetBlockByHash(hash string, options api.BlockQueryOptions) (*api.Block, error) {
 	if ars.GetBlockByHashCalled != nil {
@@ -76,6 +117,15 @@ func (ars *ApiResolverStub) GetBlockByRound(round uint64, options api.BlockQuery
 	return nil, nil
 }
 
+// GetHyperblockByNonce -
+func (ars *ApiResolverStub) GetHyperblockByNonce(nonce uint64, options api.BlockQueryOptions) (*common.ApiHyperblock, error) {
+	if ars.GetHyperblockByNonceCalled != nil {
+		return ars.GetHyperblockByNonceCalled(nonce, options)
+	}
+
+	return nil, nil
+}
+
 // ExecuteSCQuery -
 func (ars *ApiResolverStub) ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VMOutput, error) {
 	if ars.ExecuteSCQueryHandler != nil {
@@ -103,6 +153,24 @@ func (ars *ApiResolverStub) ComputeTransactionGasLimit(tx *transaction.Transacti
 	return nil, nil
 }
 
+// EstimateTransactionGas -
+func (ars *ApiResolverStub) EstimateTransactionGas(tx *transaction.Transaction) (*txSimData.GasEstimation, error) {
+	if ars.EstimateTransactionGasHandler != nil {
+		return ars.EstimateTransactionGasHandler(tx)
+	}
+
+	return nil, nil
+}
+
+// EstimateSmartContractResultGas -
+func (ars *ApiResolverStub) EstimateSmartContractResultGas(scr *transaction.ApiSmartContractResult) (*txSimData.GasEstimation, error) {
+	if ars.EstimateSmartContractResultGasHandler != nil {
+		return ars.EstimateSmartContractResultGasHandler(scr)
+	}
+
+	return nil, nil
+}
+
 // GetTotalStakedValue -
 func (ars *ApiResolverStub) GetTotalStakedValue(ctx context.Context) (*api.StakeValues, error) {
 	if ars.GetTotalStakedValueHandler != nil {
@@ -121,6 +189,15 @@ func (ars *ApiResolverStub) GetDirectStakedList(ctx context.Context) ([]*api.Dir
 	return nil, nil
 }
 
+// GetESDTHolders -
+func (ars *ApiResolverStub) GetESDTHolders(options common.ESDTHoldersQueryOptions, ctx context.Context) (*common.ESDTHoldersApiResponse, error) {
+	if ars.GetESDTHoldersCalled != nil {
+		return ars.GetESDTHoldersCalled(options, ctx)
+	}
+
+	return nil, nil
+}
+
 // GetDelegatorsList -
 func (ars *ApiResolverStub) GetDelegatorsList(ctx context.Context) ([]*api.Delegator, error) {
 	if ars.GetDelegatorsListHandler != nil {
@@ -163,9 +240,9 @@ func (ars *ApiResolverStub) GetInternalMetaBlockByNonce(format common.ApiOutputF
 }
 
 // GetTransactionsPool -
-func (ars *ApiResolverStub) GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error) {
+func (ars *ApiResolverStub) GetTransactionsPool(options common.TransactionsPoolQueryOptions) (*common.TransactionsPoolAPIResponse, error) {
 	if ars.GetTransactionsPoolCalled != nil {
-		return ars.GetTransactionsPoolCalled(fields)
+		return ars.GetTransactionsPoolCalled(options)
 	}
 
 	return nil, nil
@@ -198,6 +275,51 @@ func (ars *ApiResolverStub) GetTransactionsPoolNonceGapsForSender(sender string)
 	return nil, nil
 }
 
+// GetTransactionsPoolReplacementsForSender -
+func (ars *ApiResolverStub) GetTransactionsPoolReplacementsForSender(sender string) (*common.TransactionsPoolReplacementsForSenderApiResponse, error) {
+	if ars.GetTransactionsPoolReplacementsForSenderCalled != nil {
+		return ars.GetTransactionsPoolReplacementsForSenderCalled(sender)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolStatsForSender -
+func (ars *ApiResolverStub) GetTransactionsPoolStatsForSender(sender string) (*common.TransactionsPoolSenderStatsApiResponse, error) {
+	if ars.GetTransactionsPoolStatsForSenderCalled != nil {
+		return ars.GetTransactionsPoolStatsForSenderCalled(sender)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolEvictions -
+func (ars *ApiResolverStub) GetTransactionsPoolEvictions() (*common.TransactionsPoolEvictionsApiResponse, error) {
+	if ars.GetTransactionsPoolEvictionsCalled != nil {
+		return ars.GetTransactionsPoolEvictionsCalled()
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsByAddress -
+func (ars *ApiResolverStub) GetTransactionsByAddress(address string, options common.AddressTransactionsQueryOptions) (*common.AddressTransactionsApiResponse, error) {
+	if ars.GetTransactionsByAddressCalled != nil {
+		return ars.GetTransactionsByAddressCalled(address, options)
+	}
+
+	return nil, nil
+}
+
+// GetLogEvents -
+func (ars *ApiResolverStub) GetLogEvents(options common.LogEventsQueryOptions) (*common.LogEventsApiResponse, error) {
+	if ars.GetLogEventsCalled != nil {
+		return ars.GetLogEventsCalled(options)
+	}
+
+	return nil, nil
+}
+
 // GetInternalMetaBlockByHash -
 func (ars *ApiResolverStub) GetInternalMetaBlockByHash(format common.ApiOutputFormat, hash string) (interface{}, error) {
 	if ars.GetInternalMetaBlockByHashCalled != nil {
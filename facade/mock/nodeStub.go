@@ -24,8 +24,10 @@ type NodeStub struct {
 		gasLimit uint64, data []byte, signatureHex string, chainID string, version, options uint32) (*transaction.Transaction, []byte, error)
 	ValidateTransactionHandler                     func(tx *transaction.Transaction) error
 	ValidateTransactionForSimulationCalled         func(tx *transaction.Transaction, bypassSignature bool) error
+	ValidateTransactionForSchedulingCalled         func(tx *transaction.Transaction) error
 	SendBulkTransactionsHandler                    func(txs []*transaction.Transaction) (uint64, error)
 	GetAccountCalled                               func(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error)
+	GetAccountsCalled                              func(addresses []string, options api.AccountQueryOptions) (map[string]*api.AccountResponse, api.BlockInfo, error)
 	GetCodeCalled                                  func(codeHash []byte, options api.AccountQueryOptions) ([]byte, api.BlockInfo)
 	GetCurrentPublicKeyHandler                     func() string
 	GenerateAndSendBulkTransactionsHandler         func(destination string, value *big.Int, nrTransactions uint64) error
@@ -45,10 +47,15 @@ type NodeStub struct {
 	GetESDTsWithRoleCalled                         func(address string, role string, options api.AccountQueryOptions, ctx context.Context) ([]string, api.BlockInfo, error)
 	GetESDTsRolesCalled                            func(address string, options api.AccountQueryOptions, ctx context.Context) (map[string][]string, api.BlockInfo, error)
 	GetKeyValuePairsCalled                         func(address string, options api.AccountQueryOptions, ctx context.Context) (map[string]string, api.BlockInfo, error)
+	GetKeyValuePairsPageCalled                     func(address string, options api.AccountQueryOptions, pageOptions common.KeyValuePairsQueryOptions, ctx context.Context) (*common.KeyValuePairsApiResponse, api.BlockInfo, error)
 	GetAllIssuedESDTsCalled                        func(tokenType string, ctx context.Context) ([]string, error)
+	GetTokenSupplyHistoryCalled                    func(token string, fromEpoch uint32, toEpoch uint32) (*common.ESDTSupplyHistoryApiResponse, error)
 	GetProofCalled                                 func(rootHash string, key string) (*common.GetProofResponse, error)
 	GetProofDataTrieCalled                         func(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	VerifyProofCalled                              func(rootHash string, address string, proof [][]byte) (bool, error)
+	ScheduleTransactionCalled                      func(tx *transaction.Transaction, condition string, conditionValue uint64) (string, error)
+	GetScheduledTransactionsCalled                 func() ([]common.ScheduledTransactionApiResponse, error)
+	CancelScheduledTransactionCalled               func(txHash string) error
 }
 
 // GetProof -
@@ -96,6 +103,15 @@ func (ns *NodeStub) GetKeyValuePairs(address string, options api.AccountQueryOpt
 	return nil, api.BlockInfo{}, nil
 }
 
+// GetKeyValuePairsPage -
+func (ns *NodeStub) GetKeyValuePairsPage(address string, options api.AccountQueryOptions, pageOptions common.KeyValuePairsQueryOptions, ctx context.Context) (*common.KeyValuePairsApiResponse, api.BlockInfo, error) {
+	if ns.GetKeyValuePairsPageCalled != nil {
+		return ns.GetKeyValuePairsPageCalled(address, options, pageOptions, ctx)
+	}
+
+	return &common.KeyValuePairsApiResponse{}, api.BlockInfo{}, nil
+}
+
 // GetValueForKey -
 func (ns *NodeStub) GetValueForKey(address string, key string, options api.AccountQueryOptions) (string, api.BlockInfo, error) {
 	if ns.GetValueForKeyCalled != nil {
@@ -137,16 +153,61 @@ func (ns *NodeStub) ValidateTransactionForSimulation(tx *transaction.Transaction
 	return ns.ValidateTransactionForSimulationCalled(tx, bypassSignature)
 }
 
+// ValidateTransactionForScheduling -
+func (ns *NodeStub) ValidateTransactionForScheduling(tx *transaction.Transaction) error {
+	if ns.ValidateTransactionForSchedulingCalled != nil {
+		return ns.ValidateTransactionForSchedulingCalled(tx)
+	}
+
+	return nil
+}
+
 // SendBulkTransactions -
 func (ns *NodeStub) SendBulkTransactions(txs []*transaction.Transaction) (uint64, error) {
 	return ns.SendBulkTransactionsHandler(txs)
 }
 
+// ScheduleTransaction -
+func (ns *NodeStub) ScheduleTransaction(tx *transaction.Transaction, condition string, conditionValue uint64) (string, error) {
+	if ns.ScheduleTransactionCalled != nil {
+		return ns.ScheduleTransactionCalled(tx, condition, conditionValue)
+	}
+
+	return "", nil
+}
+
+// GetScheduledTransactions -
+func (ns *NodeStub) GetScheduledTransactions() ([]common.ScheduledTransactionApiResponse, error) {
+	if ns.GetScheduledTransactionsCalled != nil {
+		return ns.GetScheduledTransactionsCalled()
+	}
+
+	return make([]common.ScheduledTransactionApiResponse, 0), nil
+}
+
+// CancelScheduledTransaction -
+func (ns *NodeStub) CancelScheduledTransaction(txHash string) error {
+	if ns.CancelScheduledTransactionCalled != nil {
+		return ns.CancelScheduledTransactionCalled(txHash)
+	}
+
+	return nil
+}
+
 // GetAccount -
 func (ns *NodeStub) GetAccount(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error) {
 	return ns.GetAccountCalled(address, options)
 }
 
+// GetAccounts -
+func (ns *NodeStub) GetAccounts(addresses []string, options api.AccountQueryOptions) (map[string]*api.AccountResponse, api.BlockInfo, error) {
+	if ns.GetAccountsCalled != nil {
+		return ns.GetAccountsCalled(addresses, options)
+	}
+
+	return make(map[string]*api.AccountResponse), api.BlockInfo{}, nil
+}
+
 // GetCode -
 func (ns *NodeStub) GetCode(codeHash []byte, options api.AccountQueryOptions) ([]byte, api.BlockInfo) {
 	if ns.GetCodeCalled != nil {
@@ -244,6 +305,15 @@ func (ns *NodeStub) GetTokenSupply(_ string) (*api.ESDTSupply, error) {
 	return nil, nil
 }
 
+// GetTokenSupplyHistory -
+func (ns *NodeStub) GetTokenSupplyHistory(token string, fromEpoch uint32, toEpoch uint32) (*common.ESDTSupplyHistoryApiResponse, error) {
+	if ns.GetTokenSupplyHistoryCalled != nil {
+		return ns.GetTokenSupplyHistoryCalled(token, fromEpoch, toEpoch)
+	}
+
+	return nil, nil
+}
+
 // GetAllIssuedESDTs -
 func (ns *NodeStub) GetAllIssuedESDTs(tokenType string, ctx context.Context) ([]string, error) {
 	if ns.GetAllIssuedESDTsCalled != nil {
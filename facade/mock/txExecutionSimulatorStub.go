@@ -1,19 +1,62 @@
 package mock
 
 import (
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+	"github.com/ElrondNetwork/elrond-go/state"
 )
 
 // TxExecutionSimulatorStub -
 type TxExecutionSimulatorStub struct {
-	ProcessTxCalled func(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ProcessTxCalled                   func(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error)
+	ProcessTxWithStateOverridesCalled func(tx *transaction.Transaction, overrides map[string]*state.AccountOverride, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error)
+	ProcessHistoricalTxCalled         func(tx *transaction.Transaction, options api.AccountQueryOptions) (*txSimData.SimulationResults, error)
+	ProcessTxsBatchCalled             func(txs []*transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.BatchSimulationResults, error)
+	ProcessSmartContractResultCalled  func(scr *smartContractResult.SmartContractResult) (*txSimData.SimulationResults, error)
 }
 
 // ProcessTx -
-func (t *TxExecutionSimulatorStub) ProcessTx(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
+func (t *TxExecutionSimulatorStub) ProcessTx(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
 	if t.ProcessTxCalled != nil {
-		return t.ProcessTxCalled(tx)
+		return t.ProcessTxCalled(tx, options)
+	}
+
+	return &txSimData.SimulationResults{}, nil
+}
+
+// ProcessTxWithStateOverrides -
+func (t *TxExecutionSimulatorStub) ProcessTxWithStateOverrides(tx *transaction.Transaction, overrides map[string]*state.AccountOverride, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+	if t.ProcessTxWithStateOverridesCalled != nil {
+		return t.ProcessTxWithStateOverridesCalled(tx, overrides, options)
+	}
+
+	return &txSimData.SimulationResults{}, nil
+}
+
+// ProcessHistoricalTx -
+func (t *TxExecutionSimulatorStub) ProcessHistoricalTx(tx *transaction.Transaction, options api.AccountQueryOptions) (*txSimData.SimulationResults, error) {
+	if t.ProcessHistoricalTxCalled != nil {
+		return t.ProcessHistoricalTxCalled(tx, options)
+	}
+
+	return &txSimData.SimulationResults{}, nil
+}
+
+// ProcessTxsBatch -
+func (t *TxExecutionSimulatorStub) ProcessTxsBatch(txs []*transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.BatchSimulationResults, error) {
+	if t.ProcessTxsBatchCalled != nil {
+		return t.ProcessTxsBatchCalled(txs, options)
+	}
+
+	return &txSimData.BatchSimulationResults{}, nil
+}
+
+// ProcessSmartContractResult -
+func (t *TxExecutionSimulatorStub) ProcessSmartContractResult(scr *smartContractResult.SmartContractResult) (*txSimData.SimulationResults, error) {
+	if t.ProcessSmartContractResultCalled != nil {
+		return t.ProcessSmartContractResultCalled(scr)
 	}
 
 	return &txSimData.SimulationResults{}, nil
@@ -23,6 +23,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/ntp"
+	"github.com/ElrondNetwork/elrond-go/outport"
 	"github.com/ElrondNetwork/elrond-go/process"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/state"
@@ -50,6 +51,7 @@ type ArgNodeFacade struct {
 	AccountsState          state.AccountsAdapter
 	PeerState              state.AccountsAdapter
 	Blockchain             chainData.ChainHandler
+	EventsSubscription     outport.EventsSubscriptionHandler
 }
 
 // nodeFacade represents a facade for grouping the functionality for the node
@@ -66,6 +68,7 @@ type nodeFacade struct {
 	accountsState          state.AccountsAdapter
 	peerState              state.AccountsAdapter
 	blockchain             chainData.ChainHandler
+	eventsSubscription     outport.EventsSubscriptionHandler
 	ctx                    context.Context
 	cancelFunc             func()
 }
@@ -105,6 +108,9 @@ func NewNodeFacade(arg ArgNodeFacade) (*nodeFacade, error) {
 	if check.IfNil(arg.Blockchain) {
 		return nil, ErrNilBlockchain
 	}
+	if check.IfNil(arg.EventsSubscription) {
+		return nil, ErrNilEventsSubscriptionHandler
+	}
 
 	throttlersMap := computeEndpointsNumGoRoutinesThrottlers(arg.WsAntifloodConfig)
 
@@ -120,6 +126,7 @@ func NewNodeFacade(arg ArgNodeFacade) (*nodeFacade, error) {
 		accountsState:          arg.AccountsState,
 		peerState:              arg.PeerState,
 		blockchain:             arg.Blockchain,
+		eventsSubscription:     arg.EventsSubscription,
 	}
 	nf.ctx, nf.cancelFunc = context.WithCancel(context.Background())
 
@@ -217,6 +224,18 @@ func (nf *nodeFacade) GetKeyValuePairs(address string, options apiData.AccountQu
 	return nf.node.GetKeyValuePairs(address, options, ctx)
 }
 
+// GetKeyValuePairsPage returns a page of the key-value pairs under the provided address
+func (nf *nodeFacade) GetKeyValuePairsPage(
+	address string,
+	options apiData.AccountQueryOptions,
+	pageOptions common.KeyValuePairsQueryOptions,
+) (*common.KeyValuePairsApiResponse, apiData.BlockInfo, error) {
+	ctx, cancel := nf.getContextForApiTrieRangeOperations()
+	defer cancel()
+
+	return nf.node.GetKeyValuePairsPage(address, options, pageOptions, ctx)
+}
+
 // GetAllESDTTokens returns all the esdt tokens for a given address
 func (nf *nodeFacade) GetAllESDTTokens(address string, options apiData.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, apiData.BlockInfo, error) {
 	ctx, cancel := nf.getContextForApiTrieRangeOperations()
@@ -230,6 +249,11 @@ func (nf *nodeFacade) GetTokenSupply(token string) (*apiData.ESDTSupply, error)
 	return nf.node.GetTokenSupply(token)
 }
 
+// GetTokenSupplyHistory returns the supply changes of the provided token, for each epoch of the given range
+func (nf *nodeFacade) GetTokenSupplyHistory(token string, fromEpoch uint32, toEpoch uint32) (*common.ESDTSupplyHistoryApiResponse, error) {
+	return nf.node.GetTokenSupplyHistory(token, fromEpoch, toEpoch)
+}
+
 // GetAllIssuedESDTs returns all the issued esdts from the esdt system smart contract
 func (nf *nodeFacade) GetAllIssuedESDTs(tokenType string) ([]string, error) {
 	ctx, cancel := nf.getContextForApiTrieRangeOperations()
@@ -274,6 +298,11 @@ func (nf *nodeFacade) ValidateTransactionForSimulation(tx *transaction.Transacti
 	return nf.node.ValidateTransactionForSimulation(tx, checkSignature)
 }
 
+// ValidateTransactionForScheduling will validate a transaction before adding it to the outbox
+func (nf *nodeFacade) ValidateTransactionForScheduling(tx *transaction.Transaction) error {
+	return nf.node.ValidateTransactionForScheduling(tx)
+}
+
 // ValidatorStatisticsApi will return the statistics for all validators
 func (nf *nodeFacade) ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error) {
 	return nf.node.ValidatorStatisticsApi()
@@ -284,9 +313,45 @@ func (nf *nodeFacade) SendBulkTransactions(txs []*transaction.Transaction) (uint
 	return nf.node.SendBulkTransactions(txs)
 }
 
+// ScheduleTransaction will add a transaction to the outbox, to be sent once a round, an epoch or an account nonce is reached
+func (nf *nodeFacade) ScheduleTransaction(tx *transaction.Transaction, condition string, conditionValue uint64) (string, error) {
+	return nf.node.ScheduleTransaction(tx, condition, conditionValue)
+}
+
+// GetScheduledTransactions will return the transactions waiting in the outbox
+func (nf *nodeFacade) GetScheduledTransactions() ([]common.ScheduledTransactionApiResponse, error) {
+	return nf.node.GetScheduledTransactions()
+}
+
+// CancelScheduledTransaction will remove a transaction from the outbox
+func (nf *nodeFacade) CancelScheduledTransaction(txHash string) error {
+	return nf.node.CancelScheduledTransaction(txHash)
+}
+
 // SimulateTransactionExecution will simulate a transaction's execution and will return the results
-func (nf *nodeFacade) SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
-	return nf.txSimulatorProc.ProcessTx(tx)
+func (nf *nodeFacade) SimulateTransactionExecution(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+	return nf.txSimulatorProc.ProcessTx(tx, options)
+}
+
+// SimulateTransactionExecutionWithStateOverrides will simulate the execution of a transaction against the current state
+// with the provided accounts overrides applied
+func (nf *nodeFacade) SimulateTransactionExecutionWithStateOverrides(
+	tx *transaction.Transaction,
+	overrides map[string]*state.AccountOverride,
+	options txSimData.SimulationOptions,
+) (*txSimData.SimulationResults, error) {
+	return nf.txSimulatorProc.ProcessTxWithStateOverrides(tx, overrides, options)
+}
+
+// GetTransactionTrace will replay a processed transaction on the state from before its block and will return the
+// results, including the execution trace
+func (nf *nodeFacade) GetTransactionTrace(hash string) (*txSimData.SimulationResults, error) {
+	return nf.apiResolver.GetTransactionTrace(hash)
+}
+
+// SimulateTransactionsBatchExecution will simulate, in order, the execution of a batch of transactions and will return the results
+func (nf *nodeFacade) SimulateTransactionsBatchExecution(txs []*transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.BatchSimulationResults, error) {
+	return nf.txSimulatorProc.ProcessTxsBatch(txs, options)
 }
 
 // GetTransaction gets the transaction with a specified hash
@@ -294,9 +359,20 @@ func (nf *nodeFacade) GetTransaction(hash string, withResults bool) (*transactio
 	return nf.apiResolver.GetTransaction(hash, withResults)
 }
 
+// GetTransactionStateDiff gets the state diff recorded for the transaction with a specified hash
+func (nf *nodeFacade) GetTransactionStateDiff(hash string) ([]*txSimData.AccountStateDiff, error) {
+	return nf.apiResolver.GetTransactionStateDiff(hash)
+}
+
+// WaitForTransactionStatus waits until the transaction with a specified hash reaches the provided status, or until its
+// outcome becomes final, but no longer than the provided timeout
+func (nf *nodeFacade) WaitForTransactionStatus(hash string, status string, timeout time.Duration) (*common.TransactionStatusWaitApiResponse, error) {
+	return nf.apiResolver.WaitForTransactionStatus(hash, status, timeout)
+}
+
 // GetTransactionsPool will return a structure containing the transactions pool that is to be returned on API calls
-func (nf *nodeFacade) GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error) {
-	return nf.apiResolver.GetTransactionsPool(fields)
+func (nf *nodeFacade) GetTransactionsPool(options common.TransactionsPoolQueryOptions) (*common.TransactionsPoolAPIResponse, error) {
+	return nf.apiResolver.GetTransactionsPool(options)
 }
 
 // GetTransactionsPoolForSender will return a structure containing the transactions for sender that is to be returned on API calls
@@ -314,11 +390,56 @@ func (nf *nodeFacade) GetTransactionsPoolNonceGapsForSender(sender string) (*com
 	return nf.apiResolver.GetTransactionsPoolNonceGapsForSender(sender)
 }
 
+// GetTransactionsPoolStatsForSender will return the statistics of the sender's transactions from pool, that is to be returned on API calls
+func (nf *nodeFacade) GetTransactionsPoolStatsForSender(sender string) (*common.TransactionsPoolSenderStatsApiResponse, error) {
+	return nf.apiResolver.GetTransactionsPoolStatsForSender(sender)
+}
+
+// GetTransactionsPoolEvictions will return the recent eviction rounds of the transactions pool, that is to be returned on API calls
+func (nf *nodeFacade) GetTransactionsPoolEvictions() (*common.TransactionsPoolEvictionsApiResponse, error) {
+	return nf.apiResolver.GetTransactionsPoolEvictions()
+}
+
+// GetTransactionsPoolReplacementsForSender will return the recent replacements by fee of the transactions of the sender, that is to be returned on API calls
+func (nf *nodeFacade) GetTransactionsPoolReplacementsForSender(sender string) (*common.TransactionsPoolReplacementsForSenderApiResponse, error) {
+	return nf.apiResolver.GetTransactionsPoolReplacementsForSender(sender)
+}
+
+// GetTransactionsByAddress will return the transactions involving the given address, as recorded by the transactions by address index
+func (nf *nodeFacade) GetTransactionsByAddress(address string, options common.AddressTransactionsQueryOptions) (*common.AddressTransactionsApiResponse, error) {
+	return nf.apiResolver.GetTransactionsByAddress(address, options)
+}
+
+// GetLogEvents will return the log events matching the provided query, as recorded by the log events index
+func (nf *nodeFacade) GetLogEvents(options common.LogEventsQueryOptions) (*common.LogEventsApiResponse, error) {
+	return nf.apiResolver.GetLogEvents(options)
+}
+
+// IsEventsSubscriptionEnabled returns true if the websocket events subscription is enabled
+func (nf *nodeFacade) IsEventsSubscriptionEnabled() bool {
+	return nf.eventsSubscription.IsEnabled()
+}
+
+// ServeEventsSubscriber will push the outport events to the provided websocket connection until it gets closed
+func (nf *nodeFacade) ServeEventsSubscriber(conn outport.WSConnection) error {
+	return nf.eventsSubscription.ServeSubscriber(conn)
+}
+
 // ComputeTransactionGasLimit will estimate how many gas a transaction will consume
 func (nf *nodeFacade) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
 	return nf.apiResolver.ComputeTransactionGasLimit(tx)
 }
 
+// EstimateTransactionGas will estimate the gas needed by a transaction, split by the phases of its execution
+func (nf *nodeFacade) EstimateTransactionGas(tx *transaction.Transaction) (*txSimData.GasEstimation, error) {
+	return nf.apiResolver.EstimateTransactionGas(tx)
+}
+
+// EstimateSmartContractResultGas will estimate the gas consumed by a smart contract result received from another shard
+func (nf *nodeFacade) EstimateSmartContractResultGas(scr *transaction.ApiSmartContractResult) (*txSimData.GasEstimation, error) {
+	return nf.apiResolver.EstimateSmartContractResultGas(scr)
+}
+
 // GetAccount returns a response containing information about the account correlated with provided address
 func (nf *nodeFacade) GetAccount(address string, options apiData.AccountQueryOptions) (apiData.AccountResponse, apiData.BlockInfo, error) {
 	accountResponse, blockInfo, err := nf.node.GetAccount(address, options)
@@ -332,6 +453,11 @@ func (nf *nodeFacade) GetAccount(address string, options apiData.AccountQueryOpt
 	return accountResponse, blockInfo, nil
 }
 
+// GetAccounts returns the accounts of the provided addresses, all resolved against the same state
+func (nf *nodeFacade) GetAccounts(addresses []string, options apiData.AccountQueryOptions) (map[string]*apiData.AccountResponse, apiData.BlockInfo, error) {
+	return nf.node.GetAccounts(addresses, options)
+}
+
 // GetHeartbeats returns the heartbeat status for each public key from initial list or later joined to the network
 func (nf *nodeFacade) GetHeartbeats() ([]data.PubKeyHeartbeat, error) {
 	hbStatus := nf.node.GetHeartbeats()
@@ -363,6 +489,14 @@ func (nf *nodeFacade) GetDirectStakedList() ([]*apiData.DirectStakedValue, error
 	return nf.apiResolver.GetDirectStakedList(ctx)
 }
 
+// GetESDTHolders will output the holders of the provided ESDT token
+func (nf *nodeFacade) GetESDTHolders(options common.ESDTHoldersQueryOptions) (*common.ESDTHoldersApiResponse, error) {
+	ctx, cancel := nf.getContextForApiTrieRangeOperations()
+	defer cancel()
+
+	return nf.apiResolver.GetESDTHolders(options, ctx)
+}
+
 // GetDelegatorsList will output the list for the delegators addresses
 func (nf *nodeFacade) GetDelegatorsList() ([]*apiData.Delegator, error) {
 	ctx, cancel := nf.getContextForApiTrieRangeOperations()
@@ -444,6 +578,11 @@ func (nf *nodeFacade) GetBlockByRound(round uint64, options apiData.BlockQueryOp
 	return nf.apiResolver.GetBlockByRound(round, options)
 }
 
+// GetHyperblockByNonce returns the hyperblock for a given meta nonce
+func (nf *nodeFacade) GetHyperblockByNonce(nonce uint64, options apiData.BlockQueryOptions) (*common.ApiHyperblock, error) {
+	return nf.apiResolver.GetHyperblockByNonce(nonce, options)
+}
+
 // GetInternalMetaBlockByHash return the meta block for a given hash
 func (nf *nodeFacade) GetInternalMetaBlockByHash(format common.ApiOutputFormat, hash string) (interface{}, error) {
 	return nf.apiResolver.GetInternalMetaBlockByHash(format, hash)
@@ -25,7 +25,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/facade/mock"
 	"github.com/ElrondNetwork/elrond-go/heartbeat/data"
 	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/outport"
 	"github.com/ElrondNetwork/elrond-go/process"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
@@ -69,6 +71,7 @@ func createMockArguments() ArgNodeFacade {
 				return []byte("root hash")
 			},
 		},
+		EventsSubscription: &testscommon.EventsSubscriptionHandlerStub{},
 	}
 }
 
@@ -96,6 +99,39 @@ func TestNewNodeFacade_WithNilApiResolverShouldErr(t *testing.T) {
 	assert.Equal(t, ErrNilApiResolver, err)
 }
 
+func TestNewNodeFacade_WithNilEventsSubscriptionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.EventsSubscription = nil
+	nf, err := NewNodeFacade(arg)
+
+	assert.True(t, check.IfNil(nf))
+	assert.Equal(t, ErrNilEventsSubscriptionHandler, err)
+}
+
+func TestNodeFacade_EventsSubscription(t *testing.T) {
+	t.Parallel()
+
+	serveCalled := false
+	arg := createMockArguments()
+	arg.EventsSubscription = &testscommon.EventsSubscriptionHandlerStub{
+		IsEnabledCalled: func() bool {
+			return true
+		},
+		ServeSubscriberCalled: func(conn outport.WSConnection) error {
+			serveCalled = true
+			return nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	assert.True(t, nf.IsEventsSubscriptionEnabled())
+	err := nf.ServeEventsSubscriber(nil)
+	assert.Nil(t, err)
+	assert.True(t, serveCalled)
+}
+
 func TestNewNodeFacade_WithInvalidSimultaneousRequestsShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -247,6 +283,83 @@ func TestNodeFacade_GetTransactionWithValidInputsShouldNotReturnError(t *testing
 	assert.Equal(t, testTx, tx)
 }
 
+func TestNodeFacade_GetTransactionStateDiff(t *testing.T) {
+	t.Parallel()
+
+	testHash := "testHash"
+	expectedStateDiff := []*txSimData.AccountStateDiff{{Address: "alice"}}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetTransactionStateDiffCalled: func(hash string) ([]*txSimData.AccountStateDiff, error) {
+			assert.Equal(t, testHash, hash)
+			return expectedStateDiff, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	stateDiff, err := nf.GetTransactionStateDiff(testHash)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedStateDiff, stateDiff)
+}
+
+func TestNodeFacade_EstimateTransactionGas(t *testing.T) {
+	t.Parallel()
+
+	expectedEstimation := &txSimData.GasEstimation{GasUnits: 1500}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		EstimateTransactionGasHandler: func(tx *transaction.Transaction) (*txSimData.GasEstimation, error) {
+			return expectedEstimation, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	estimation, err := nf.EstimateTransactionGas(&transaction.Transaction{})
+	assert.Nil(t, err)
+	assert.Equal(t, expectedEstimation, estimation)
+}
+
+func TestNodeFacade_EstimateSmartContractResultGas(t *testing.T) {
+	t.Parallel()
+
+	expectedEstimation := &txSimData.GasEstimation{GasUnits: 2000}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		EstimateSmartContractResultGasHandler: func(scr *transaction.ApiSmartContractResult) (*txSimData.GasEstimation, error) {
+			return expectedEstimation, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	estimation, err := nf.EstimateSmartContractResultGas(&transaction.ApiSmartContractResult{})
+	assert.Nil(t, err)
+	assert.Equal(t, expectedEstimation, estimation)
+}
+
+func TestNodeFacade_WaitForTransactionStatus(t *testing.T) {
+	t.Parallel()
+
+	testHash := "testHash"
+	expectedResponse := &common.TransactionStatusWaitApiResponse{
+		Transaction:   &transaction.ApiTransactionResult{Hash: testHash},
+		StatusReached: true,
+	}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		WaitForTransactionStatusCalled: func(hash string, status string, timeout time.Duration) (*common.TransactionStatusWaitApiResponse, error) {
+			assert.Equal(t, testHash, hash)
+			assert.Equal(t, common.TransactionStatusExecuted, status)
+			assert.Equal(t, time.Second, timeout)
+			return expectedResponse, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	response, err := nf.WaitForTransactionStatus(testHash, common.TransactionStatusExecuted, time.Second)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResponse, response)
+}
+
 func TestNodeFacade_GetTransactionWithUnknowHashShouldReturnNilAndNoError(t *testing.T) {
 	t.Parallel()
 
@@ -297,6 +410,46 @@ func TestNodeFacade_GetAccount(t *testing.T) {
 	assert.True(t, getAccountCalled)
 }
 
+func TestNodeFacade_GetAccounts(t *testing.T) {
+	t.Parallel()
+
+	expectedAccounts := map[string]*api.AccountResponse{"test": {Nonce: 1}}
+	node := &mock.NodeStub{}
+	node.GetAccountsCalled = func(addresses []string, _ api.AccountQueryOptions) (map[string]*api.AccountResponse, api.BlockInfo, error) {
+		assert.Equal(t, []string{"test"}, addresses)
+		return expectedAccounts, api.BlockInfo{}, nil
+	}
+
+	arg := createMockArguments()
+	arg.Node = node
+	nf, _ := NewNodeFacade(arg)
+
+	accounts, _, err := nf.GetAccounts([]string{"test"}, api.AccountQueryOptions{})
+	assert.Nil(t, err)
+	assert.Equal(t, expectedAccounts, accounts)
+}
+
+func TestNodeFacade_GetKeyValuePairsPage(t *testing.T) {
+	t.Parallel()
+
+	expectedPage := &common.KeyValuePairsApiResponse{Pairs: map[string]string{"k": "v"}}
+	pageOptions := common.KeyValuePairsQueryOptions{Limit: 1}
+	node := &mock.NodeStub{}
+	node.GetKeyValuePairsPageCalled = func(_ string, _ api.AccountQueryOptions, options common.KeyValuePairsQueryOptions, ctx context.Context) (*common.KeyValuePairsApiResponse, api.BlockInfo, error) {
+		assert.Equal(t, pageOptions, options)
+		assert.NotNil(t, ctx)
+		return expectedPage, api.BlockInfo{}, nil
+	}
+
+	arg := createMockArguments()
+	arg.Node = node
+	nf, _ := NewNodeFacade(arg)
+
+	page, _, err := nf.GetKeyValuePairsPage("test", api.AccountQueryOptions{}, pageOptions)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedPage, page)
+}
+
 func TestNodeFacade_GetUsername(t *testing.T) {
 	t.Parallel()
 
@@ -826,6 +979,24 @@ func TestNodeFacade_ValidateTransactionForSimulation(t *testing.T) {
 	assert.True(t, called)
 }
 
+func TestNodeFacade_GetTransactionTrace(t *testing.T) {
+	t.Parallel()
+
+	providedResults := &txSimData.SimulationResults{Status: transaction.TxStatusSuccess}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetTransactionTraceCalled: func(hash string) (*txSimData.SimulationResults, error) {
+			require.Equal(t, "hash", hash)
+			return providedResults, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	results, err := nf.GetTransactionTrace("hash")
+	require.Nil(t, err)
+	require.Equal(t, providedResults, results)
+}
+
 func TestNodeFacade_GetTotalStakedValue(t *testing.T) {
 	t.Parallel()
 
@@ -880,6 +1051,26 @@ func TestNodeFacade_GetDirectStakedList(t *testing.T) {
 	assert.True(t, called)
 }
 
+func TestNodeFacade_GetESDTHolders(t *testing.T) {
+	t.Parallel()
+
+	called := false
+	expectedOptions := common.ESDTHoldersQueryOptions{Token: "TKN-abcdef"}
+	arg := createMockArguments()
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetESDTHoldersCalled: func(options common.ESDTHoldersQueryOptions, ctx context.Context) (*common.ESDTHoldersApiResponse, error) {
+			called = true
+			assert.Equal(t, expectedOptions, options)
+			return nil, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+	_, err := nf.GetESDTHolders(expectedOptions)
+
+	assert.Nil(t, err)
+	assert.True(t, called)
+}
+
 func TestNodeFacade_GetProofCurrentRootHashIsEmptyShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -1280,13 +1471,13 @@ func TestNodeFacade_GetTransactionsPool(t *testing.T) {
 		arg := createMockArguments()
 		expectedErr := errors.New("expected error")
 		arg.ApiResolver = &mock.ApiResolverStub{
-			GetTransactionsPoolCalled: func(fields string) (*common.TransactionsPoolAPIResponse, error) {
+			GetTransactionsPoolCalled: func(options common.TransactionsPoolQueryOptions) (*common.TransactionsPoolAPIResponse, error) {
 				return nil, expectedErr
 			},
 		}
 
 		nf, _ := NewNodeFacade(arg)
-		res, err := nf.GetTransactionsPool("")
+		res, err := nf.GetTransactionsPool(common.TransactionsPoolQueryOptions{})
 		require.Nil(t, res)
 		require.Equal(t, expectedErr, err)
 	})
@@ -1329,13 +1520,13 @@ func TestNodeFacade_GetTransactionsPool(t *testing.T) {
 			},
 		}
 		arg.ApiResolver = &mock.ApiResolverStub{
-			GetTransactionsPoolCalled: func(fields string) (*common.TransactionsPoolAPIResponse, error) {
+			GetTransactionsPoolCalled: func(options common.TransactionsPoolQueryOptions) (*common.TransactionsPoolAPIResponse, error) {
 				return expectedPool, nil
 			},
 		}
 
 		nf, _ := NewNodeFacade(arg)
-		res, err := nf.GetTransactionsPool("")
+		res, err := nf.GetTransactionsPool(common.TransactionsPoolQueryOptions{})
 		require.NoError(t, err)
 		require.Equal(t, expectedPool, res)
 	})
@@ -1574,3 +1765,97 @@ func TestNodeFacade_GetTransactionsPoolNonceGapsForSender(t *testing.T) {
 		require.Equal(t, expectedNonceGaps, res)
 	})
 }
+
+func TestNodeFacade_GetTransactionsPoolStatsForSender(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	expectedSender := "alice"
+	expectedStats := &common.TransactionsPoolSenderStatsApiResponse{
+		Sender: expectedSender,
+		Score:  42,
+		NumTxs: 3,
+	}
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetTransactionsPoolStatsForSenderCalled: func(sender string) (*common.TransactionsPoolSenderStatsApiResponse, error) {
+			require.Equal(t, expectedSender, sender)
+			return expectedStats, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	res, err := nf.GetTransactionsPoolStatsForSender(expectedSender)
+	require.NoError(t, err)
+	require.Equal(t, expectedStats, res)
+}
+
+func TestNodeFacade_GetTransactionsPoolEvictions(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	expectedErr := errors.New("expected error")
+	arg.ApiResolver = &mock.ApiResolverStub{
+		GetTransactionsPoolEvictionsCalled: func() (*common.TransactionsPoolEvictionsApiResponse, error) {
+			return nil, expectedErr
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	res, err := nf.GetTransactionsPoolEvictions()
+	require.Nil(t, res)
+	require.Equal(t, expectedErr, err)
+}
+
+func TestNodeFacade_ScheduleTransaction(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	expectedTx := &transaction.Transaction{Nonce: 7}
+	arg.Node = &mock.NodeStub{
+		ScheduleTransactionCalled: func(tx *transaction.Transaction, condition string, conditionValue uint64) (string, error) {
+			require.Equal(t, expectedTx, tx)
+			require.Equal(t, common.ScheduledTxConditionRound, condition)
+			require.Equal(t, uint64(100), conditionValue)
+			return "aabbcc", nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	txHash, err := nf.ScheduleTransaction(expectedTx, common.ScheduledTxConditionRound, 100)
+	require.NoError(t, err)
+	require.Equal(t, "aabbcc", txHash)
+}
+
+func TestNodeFacade_GetScheduledTransactions(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	expectedTxs := []common.ScheduledTransactionApiResponse{{TxHash: "aabbcc", Nonce: 7}}
+	arg.Node = &mock.NodeStub{
+		GetScheduledTransactionsCalled: func() ([]common.ScheduledTransactionApiResponse, error) {
+			return expectedTxs, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	txs, err := nf.GetScheduledTransactions()
+	require.NoError(t, err)
+	require.Equal(t, expectedTxs, txs)
+}
+
+func TestNodeFacade_CancelScheduledTransaction(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	expectedErr := errors.New("expected error")
+	arg.Node = &mock.NodeStub{
+		CancelScheduledTransactionCalled: func(txHash string) error {
+			require.Equal(t, "aabbcc", txHash)
+			return expectedErr
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+	err := nf.CancelScheduledTransaction("aabbcc")
+	require.Equal(t, expectedErr, err)
+}
@@ -4,7 +4,10 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
@@ -168,6 +171,27 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		return nil, err
 	}
 
+	crossShardGasEstimator, err := createCrossShardGasEstimator(args)
+	if err != nil {
+		return nil, err
+	}
+
+	txGasEstimator, err := transaction.NewGasEstimator(transaction.ArgsGasEstimator{
+		TxSimulator:            args.ProcessComponents.TransactionSimulatorProcessor(),
+		CrossShardGasEstimator: crossShardGasEstimator,
+		FeeHandler:             args.CoreComponents.EconomicsData(),
+		Accounts:               args.StateComponents.AccountsAdapterAPI(),
+		ShardCoordinator:       args.ProcessComponents.ShardCoordinator(),
+		ESDTTransferParser:     esdtTransferParser,
+		GasScheduleNotifier:    args.GasScheduleNotifier,
+		AddressPubKeyConverter: args.CoreComponents.AddressPubKeyConverter(),
+		Marshalizer:            args.CoreComponents.InternalMarshalizer(),
+		Hasher:                 args.CoreComponents.Hasher(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	accountsWrapper := &trieIterators.AccountsWrapper{
 		Mutex:           &sync.Mutex{},
 		AccountsAdapter: args.StateComponents.AccountsAdapterAPI(),
@@ -178,6 +202,7 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		Accounts:           accountsWrapper,
 		PublicKeyConverter: args.CoreComponents.AddressPubKeyConverter(),
 		QueryService:       scQueryService,
+		Marshalizer:        args.CoreComponents.InternalMarshalizer(),
 	}
 	totalStakedValueHandler, err := trieIteratorsFactory.CreateTotalStakedValueHandler(argsProcessors)
 	if err != nil {
@@ -194,6 +219,11 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		return nil, err
 	}
 
+	esdtHoldersHandler, err := trieIteratorsFactory.CreateESDTHoldersHandler(argsProcessors)
+	if err != nil {
+		return nil, err
+	}
+
 	builtInCostHandler, err := economics.NewBuiltInFunctionsCost(&economics.ArgsBuiltInFunctionCost{
 		ArgsParser:  smartContract.NewArgumentParser(),
 		GasSchedule: args.GasScheduleNotifier,
@@ -261,6 +291,8 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		SCQueryService:           scQueryService,
 		StatusMetricsHandler:     args.CoreComponents.StatusHandlerUtils().Metrics(),
 		TxCostHandler:            txCostHandler,
+		TxGasEstimator:           txGasEstimator,
+		HistoricalTxSimulator:    args.ProcessComponents.TransactionSimulatorProcessor(),
 		TotalStakedValueHandler:  totalStakedValueHandler,
 		DirectStakedListHandler:  directStakedListHandler,
 		DelegatedListHandler:     delegatedListHandler,
@@ -271,6 +303,8 @@ func CreateApiResolver(args *ApiResolverArgs) (facade.ApiResolver, error) {
 		ValidatorPubKeyConverter: args.CoreComponents.ValidatorPubKeyConverter(),
 		AccountsParser:           args.ProcessComponents.AccountsParser(),
 		GasScheduleNotifier:      args.GasScheduleNotifier,
+		LogsFacade:               logsFacade,
+		ESDTHoldersHandler:       esdtHoldersHandler,
 	}
 
 	return external.NewNodeApiResolver(argsApiResolver)
@@ -335,10 +369,16 @@ func createScQueryElement(
 		return nil, errDecode
 	}
 
+	// each query element owns its overrides wrapper, as the overrides are applied per query
+	accountsWithOverrides, err := state.NewAccountsDBWithOverrides(args.stateComponents.AccountsAdapterAPI(), args.coreComponents.Hasher())
+	if err != nil {
+		return nil, err
+	}
+
 	builtInFuncFactory, err := createBuiltinFuncs(
 		args.gasScheduleNotifier,
 		args.coreComponents.InternalMarshalizer(),
-		args.stateComponents.AccountsAdapterAPI(),
+		accountsWithOverrides,
 		args.processComponents.ShardCoordinator(),
 		args.coreComponents.EpochNotifier(),
 		args.epochConfig.EnableEpochs.ESDTMultiTransferEnableEpoch,
@@ -366,7 +406,7 @@ func createScQueryElement(
 	scStorage := args.generalConfig.SmartContractsStorageForSCQuery
 	scStorage.DB.FilePath += fmt.Sprintf("%d", args.index)
 	argsHook := hooks.ArgBlockChainHook{
-		Accounts:              args.stateComponents.AccountsAdapterAPI(),
+		Accounts:              accountsWithOverrides,
 		PubkeyConv:            args.coreComponents.AddressPubKeyConverter(),
 		StorageService:        args.dataComponents.StorageService(),
 		BlockChain:            args.dataComponents.Blockchain(),
@@ -469,6 +509,7 @@ func createScQueryElement(
 		Bootstrapper:             args.bootstrapper,
 		AllowExternalQueriesChan: args.allowVMQueriesChan,
 		MaxGasLimitPerQuery:      maxGasForVmQueries,
+		AccountsOverrider:        accountsWithOverrides,
 	}
 
 	return smartContract.NewSCQueryService(argsNewSCQueryService)
@@ -514,6 +555,38 @@ func createBuiltinFuncs(
 	return builtInFunctions.CreateBuiltInFunctionsFactory(argsBuiltIn)
 }
 
+func createCrossShardGasEstimator(args *ApiResolverArgs) (process.CrossShardGasEstimator, error) {
+	gasEstimationConfig := args.Configs.GeneralConfig.GasEstimation
+	shardsObservers := make(map[uint32]string, len(gasEstimationConfig.ShardsObservers))
+	for _, observer := range gasEstimationConfig.ShardsObservers {
+		shardID, err := parseShardID(observer.ShardID)
+		if err != nil {
+			return nil, fmt.Errorf("%w in the gas estimation shards observers", err)
+		}
+
+		shardsObservers[shardID] = observer.URL
+	}
+
+	return transaction.NewShardObserversGasEstimator(transaction.ArgsShardObserversGasEstimator{
+		ShardsObservers:        shardsObservers,
+		RequestTimeout:         time.Duration(gasEstimationConfig.RequestTimeoutInSec) * time.Second,
+		AddressPubKeyConverter: args.CoreComponents.AddressPubKeyConverter(),
+	})
+}
+
+func parseShardID(shardID string) (uint32, error) {
+	if strings.ToLower(shardID) == common.MetachainShardName {
+		return core.MetachainShardId, nil
+	}
+
+	value, err := strconv.ParseUint(shardID, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint32(value), nil
+}
+
 func createAPIBlockProcessor(args *ApiResolverArgs, apiTransactionHandler external.APITransactionHandler) (blockAPI.APIBlockHandler, error) {
 	blockApiArgs, err := createAPIBlockProcessorArgs(args, apiTransactionHandler)
 	if err != nil {
@@ -566,8 +639,9 @@ func createAPIBlockProcessorArgs(args *ApiResolverArgs, apiTransactionHandler ex
 
 func createLogsFacade(args *ApiResolverArgs) (LogsFacade, error) {
 	return logs.NewLogsFacade(logs.ArgsNewLogsFacade{
-		StorageService:  args.DataComponents.StorageService(),
-		Marshaller:      args.CoreComponents.InternalMarshalizer(),
-		PubKeyConverter: args.CoreComponents.AddressPubKeyConverter(),
+		StorageService:    args.DataComponents.StorageService(),
+		Marshaller:        args.CoreComponents.InternalMarshalizer(),
+		PubKeyConverter:   args.CoreComponents.AddressPubKeyConverter(),
+		HistoryRepository: args.ProcessComponents.HistoryRepository(),
 	})
 }
@@ -277,11 +277,21 @@ func (pcf *processComponentsFactory) newShardBlockProcessor(
 		RelayedTxV2EnableEpoch:                enableEpochs.RelayedTransactionsV2EnableEpoch,
 		AddFailedRelayedToInvalidDisableEpoch: enableEpochs.AddFailedRelayedTxToInvalidMBsDisableEpoch,
 	}
-	transactionProcessor, err := transaction.NewTxProcessor(argsNewTxProcessor)
+	shardTxProcessor, err := transaction.NewTxProcessor(argsNewTxProcessor)
 	if err != nil {
 		return nil, errors.New("could not create transaction statisticsProcessor: " + err.Error())
 	}
 
+	transactionProcessor, err := pcf.createStateChangesTxProcessorIfNeeded(shardTxProcessor)
+	if err != nil {
+		return nil, err
+	}
+
+	scResultProcessor, err := pcf.createStateChangesScrProcessorIfNeeded(scProcessor)
+	if err != nil {
+		return nil, err
+	}
+
 	scheduledTxsExecutionHandler.SetTransactionProcessor(transactionProcessor)
 
 	vmFactoryTxSimulator, err := pcf.createShardTxSimulatorProcessor(txSimulatorProcessorArgs, argsNewScProcessor, argsNewTxProcessor, esdtTransferParser, arwenChangeLocker, mapDNSAddresses)
@@ -322,7 +332,7 @@ func (pcf *processComponentsFactory) newShardBlockProcessor(
 		requestHandler,
 		transactionProcessor,
 		scProcessor,
-		scProcessor,
+		scResultProcessor,
 		rewardsTxProcessor,
 		pcf.coreData.EconomicsData(),
 		gasHandler,
@@ -585,11 +595,21 @@ func (pcf *processComponentsFactory) newMetaBlockProcessor(
 		EpochNotifier:                         pcf.epochNotifier,
 	}
 
-	transactionProcessor, err := transaction.NewMetaTxProcessor(argsNewMetaTxProcessor)
+	metaTxProcessor, err := transaction.NewMetaTxProcessor(argsNewMetaTxProcessor)
 	if err != nil {
 		return nil, errors.New("could not create transaction processor: " + err.Error())
 	}
 
+	transactionProcessor, err := pcf.createStateChangesTxProcessorIfNeeded(metaTxProcessor)
+	if err != nil {
+		return nil, err
+	}
+
+	scResultProcessor, err := pcf.createStateChangesScrProcessorIfNeeded(scProcessor)
+	if err != nil {
+		return nil, err
+	}
+
 	scheduledTxsExecutionHandler.SetTransactionProcessor(transactionProcessor)
 
 	vmFactoryTxSimulator, err := pcf.createMetaTxSimulatorProcessor(txSimulatorProcessorArgs, argsNewScProcessor, txTypeHandler)
@@ -625,7 +645,7 @@ func (pcf *processComponentsFactory) newMetaBlockProcessor(
 		pcf.state.AccountsAdapter(),
 		requestHandler,
 		transactionProcessor,
-		scProcessor,
+		scResultProcessor,
 		pcf.coreData.EconomicsData(),
 		gasHandler,
 		blockTracker,
@@ -888,6 +908,52 @@ func (pcf *processComponentsFactory) newMetaBlockProcessor(
 	return blockProcessorComponents, nil
 }
 
+// createStateChangesTxProcessorIfNeeded wraps the provided transaction processor so that the state changes of the
+// processed transactions reach the state diffs index, if the index is enabled
+func (pcf *processComponentsFactory) createStateChangesTxProcessorIfNeeded(
+	txProcessor process.TransactionProcessor,
+) (process.TransactionProcessor, error) {
+	if !pcf.isStateDiffsIndexEnabled() {
+		return txProcessor, nil
+	}
+
+	argsStateChangesTxProcessor := transaction.ArgsStateChangesTxProcessor{
+		TxProcessor:           txProcessor,
+		StateChangesCollector: pcf.state.StateChangesCollector(),
+		StateChangesHandler:   pcf.historyRepo,
+		Marshalizer:           pcf.coreData.InternalMarshalizer(),
+		Hasher:                pcf.coreData.Hasher(),
+	}
+
+	return transaction.NewStateChangesTxProcessor(argsStateChangesTxProcessor)
+}
+
+// createStateChangesScrProcessorIfNeeded wraps the provided smart contract result processor so that the state changes
+// of the processed smart contract results reach the state diffs index, if the index is enabled
+func (pcf *processComponentsFactory) createStateChangesScrProcessorIfNeeded(
+	scrProcessor process.SmartContractResultProcessor,
+) (process.SmartContractResultProcessor, error) {
+	if !pcf.isStateDiffsIndexEnabled() {
+		return scrProcessor, nil
+	}
+
+	argsStateChangesScrProcessor := transaction.ArgsStateChangesScrProcessor{
+		ScrProcessor:          scrProcessor,
+		StateChangesCollector: pcf.state.StateChangesCollector(),
+		StateChangesHandler:   pcf.historyRepo,
+		Marshalizer:           pcf.coreData.InternalMarshalizer(),
+		Hasher:                pcf.coreData.Hasher(),
+	}
+
+	return transaction.NewStateChangesScrProcessor(argsStateChangesScrProcessor)
+}
+
+func (pcf *processComponentsFactory) isStateDiffsIndexEnabled() bool {
+	dbLookupExtensionsConfig := pcf.config.DbLookupExtensions
+
+	return dbLookupExtensionsConfig.Enabled && dbLookupExtensionsConfig.StateDiffsIndexEnabled
+}
+
 func (pcf *processComponentsFactory) createShardTxSimulatorProcessor(
 	txSimulatorProcessorArgs *txsimulator.ArgsTxSimulator,
 	scProcArgs smartContract.ArgsNewSmartContractProcessor,
@@ -896,7 +962,17 @@ func (pcf *processComponentsFactory) createShardTxSimulatorProcessor(
 	arwenChangeLocker common.Locker,
 	mapDNSAddresses map[string]struct{},
 ) (process.VirtualMachinesContainerFactory, error) {
-	readOnlyAccountsDB, err := txsimulator.NewReadOnlyAccountsDB(pcf.state.AccountsAdapterAPI())
+	accountsWithHistoricalView, err := state.NewAccountsDBWithHistoricalView(pcf.state.AccountsAdapterAPI(), pcf.state.AccountsRepository())
+	if err != nil {
+		return nil, err
+	}
+
+	accountsWithOverrides, err := state.NewAccountsDBWithOverrides(accountsWithHistoricalView, pcf.coreData.Hasher())
+	if err != nil {
+		return nil, err
+	}
+
+	readOnlyAccountsDB, err := txsimulator.NewReadOnlyAccountsDB(accountsWithOverrides, pcf.coreData.InternalMarshalizer(), pcf.coreData.Hasher())
 	if err != nil {
 		return nil, err
 	}
@@ -919,10 +995,20 @@ func (pcf *processComponentsFactory) createShardTxSimulatorProcessor(
 		return nil, err
 	}
 
+	callTracer, err := txsimulator.NewCallTracer(pcf.coreData.AddressPubKeyConverter())
+	if err != nil {
+		return nil, err
+	}
+
+	tracedBuiltInFunctions, err := callTracer.WrapBuiltInFunctionContainer(builtInFuncFactory.BuiltInFunctionContainer())
+	if err != nil {
+		return nil, err
+	}
+
 	smartContractStorageSimulate := pcf.config.SmartContractsStorageSimulate
 	vmFactory, err := pcf.createVMFactoryShard(
 		readOnlyAccountsDB,
-		builtInFuncFactory.BuiltInFunctionContainer(),
+		tracedBuiltInFunctions,
 		esdtTransferParser,
 		arwenChangeLocker,
 		smartContractStorageSimulate,
@@ -938,7 +1024,10 @@ func (pcf *processComponentsFactory) createShardTxSimulatorProcessor(
 		return nil, err
 	}
 
-	scProcArgs.VmContainer = vmContainer
+	scProcArgs.VmContainer, err = callTracer.WrapVirtualMachinesContainer(vmContainer)
+	if err != nil {
+		return nil, err
+	}
 
 	interimProcContainer, err := interimProcFactory.Create()
 	if err != nil {
@@ -983,7 +1072,13 @@ func (pcf *processComponentsFactory) createShardTxSimulatorProcessor(
 		return nil, err
 	}
 
+	txSimulatorProcessorArgs.SmartContractResultProcessor = scProcessor
 	txSimulatorProcessorArgs.IntermediateProcContainer = interimProcContainer
+	txSimulatorProcessorArgs.Accounts = readOnlyAccountsDB
+	txSimulatorProcessorArgs.AccountsOverrider = accountsWithOverrides
+	txSimulatorProcessorArgs.AccountsHistoricalView = accountsWithHistoricalView
+	txSimulatorProcessorArgs.BuiltInFunctions = builtInFuncFactory.BuiltInFunctionContainer()
+	txSimulatorProcessorArgs.CallTracer = callTracer
 
 	return vmFactory, nil
 }
@@ -1028,7 +1123,17 @@ func (pcf *processComponentsFactory) createMetaTxSimulatorProcessor(
 
 	scProcArgs.VMOutputCacher = txSimulatorProcessorArgs.VMOutputCacher
 
-	readOnlyAccountsDB, err := txsimulator.NewReadOnlyAccountsDB(pcf.state.AccountsAdapterAPI())
+	accountsWithHistoricalView, err := state.NewAccountsDBWithHistoricalView(pcf.state.AccountsAdapterAPI(), pcf.state.AccountsRepository())
+	if err != nil {
+		return nil, err
+	}
+
+	accountsWithOverrides, err := state.NewAccountsDBWithOverrides(accountsWithHistoricalView, pcf.coreData.Hasher())
+	if err != nil {
+		return nil, err
+	}
+
+	readOnlyAccountsDB, err := txsimulator.NewReadOnlyAccountsDB(accountsWithOverrides, pcf.coreData.InternalMarshalizer(), pcf.coreData.Hasher())
 	if err != nil {
 		return nil, err
 	}
@@ -1038,9 +1143,19 @@ func (pcf *processComponentsFactory) createMetaTxSimulatorProcessor(
 		return nil, err
 	}
 
+	callTracer, err := txsimulator.NewCallTracer(pcf.coreData.AddressPubKeyConverter())
+	if err != nil {
+		return nil, err
+	}
+
+	tracedBuiltInFunctions, err := callTracer.WrapBuiltInFunctionContainer(builtInFuncFactory.BuiltInFunctionContainer())
+	if err != nil {
+		return nil, err
+	}
+
 	vmFactory, err := pcf.createVMFactoryMeta(
 		readOnlyAccountsDB,
-		builtInFuncFactory.BuiltInFunctionContainer(),
+		tracedBuiltInFunctions,
 		pcf.config.SmartContractsStorageSimulate,
 		builtInFuncFactory.NFTStorageHandler(),
 		builtInFuncFactory.ESDTGlobalSettingsHandler(),
@@ -1054,7 +1169,10 @@ func (pcf *processComponentsFactory) createMetaTxSimulatorProcessor(
 		return nil, err
 	}
 
-	scProcArgs.VmContainer = vmContainer
+	scProcArgs.VmContainer, err = callTracer.WrapVirtualMachinesContainer(vmContainer)
+	if err != nil {
+		return nil, err
+	}
 	scProcArgs.BlockChainHook = vmFactory.BlockChainHookImpl()
 
 	scProcessor, err := smartContract.NewSmartContractProcessor(scProcArgs)
@@ -1081,7 +1199,13 @@ func (pcf *processComponentsFactory) createMetaTxSimulatorProcessor(
 		return nil, err
 	}
 
+	txSimulatorProcessorArgs.SmartContractResultProcessor = scProcessor
 	txSimulatorProcessorArgs.IntermediateProcContainer = interimProcContainer
+	txSimulatorProcessorArgs.Accounts = readOnlyAccountsDB
+	txSimulatorProcessorArgs.AccountsOverrider = accountsWithOverrides
+	txSimulatorProcessorArgs.AccountsHistoricalView = accountsWithHistoricalView
+	txSimulatorProcessorArgs.BuiltInFunctions = builtInFuncFactory.BuiltInFunctionContainer()
+	txSimulatorProcessorArgs.CallTracer = callTracer
 
 	return vmFactory, nil
 }
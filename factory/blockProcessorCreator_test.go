@@ -12,6 +12,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/factory/mock"
 	"github.com/ElrondNetwork/elrond-go/process/txsimulator"
 	"github.com/ElrondNetwork/elrond-go/state"
+	stateDisabled "github.com/ElrondNetwork/elrond-go/state/disabled"
 	factoryState "github.com/ElrondNetwork/elrond-go/state/factory"
 	"github.com/ElrondNetwork/elrond-go/state/storagePruningManager/disabled"
 	"github.com/ElrondNetwork/elrond-go/storage/txcache"
@@ -133,6 +134,9 @@ func Test_newBlockProcessorCreatorForMeta(t *testing.T) {
 		AccountsAdapterCalled: func() state.AccountsAdapter {
 			return accounts
 		},
+		AccountsRepositoryCalled: func() state.AccountsRepository {
+			return &stateMock.AccountsRepositoryStub{}
+		},
 		TriesContainerCalled: func() common.TriesHolder {
 			return &mock.TriesHolderStub{}
 		},
@@ -199,6 +203,7 @@ func createAccountAdapter(
 		StoragePruningManager: disabled.NewDisabledStoragePruningManager(),
 		ProcessingMode:        common.Normal,
 		ProcessStatusHandler:  &testscommon.ProcessStatusHandlerStub{},
+		StateChangesCollector: stateDisabled.NewDisabledStateChangesCollector(),
 	}
 	adb, err := state.NewAccountsDB(args)
 	if err != nil {
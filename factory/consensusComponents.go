@@ -1,6 +1,7 @@
 package factory
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
@@ -14,11 +15,13 @@ import (
 	"github.com/ElrondNetwork/elrond-go/consensus/chronology"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos"
 	"github.com/ElrondNetwork/elrond-go/consensus/spos/sposFactory"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever/txpool"
 	"github.com/ElrondNetwork/elrond-go/errors"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/sync"
 	"github.com/ElrondNetwork/elrond-go/process/sync/storageBootstrap"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/ElrondNetwork/elrond-go/state/syncer"
 	"github.com/ElrondNetwork/elrond-go/trie/factory"
 	"github.com/ElrondNetwork/elrond-go/update"
@@ -143,6 +146,17 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 
 	cc.bootstrapper.StartSyncingBlocks()
 
+	// the journal can only be replayed once the bootstrapper has loaded the last committed block from the storage, while
+	// the interceptors are running since the process components were created. This ordering is safe: the replayed
+	// transactions go through the same pool checks as the intercepted ones (the duplicates are ignored and the
+	// replace-by-fee rules apply), and the nonces are read from the committed state, never from a block being processed.
+	// A replayed transaction executed meanwhile by a synced block only becomes a stale pool entry, just like a transaction
+	// received late from the network, and it is removed from the pool at the next selection
+	err = ccf.replayTxPoolJournal()
+	if err != nil {
+		return nil, err
+	}
+
 	epoch := ccf.getEpoch()
 	consensusState, err := ccf.createConsensusState(epoch, cc.consensusGroupSize)
 	if err != nil {
@@ -299,6 +313,28 @@ func (cc *consensusComponents) Close() error {
 	return nil
 }
 
+// replayTxPoolJournal adds back in the transactions pool the journaled transactions. It must be called after the
+// bootstrapper has loaded the last committed block from the storage, as the transactions are validated against the
+// accounts state of that block
+func (ccf *consensusComponentsFactory) replayTxPoolJournal() error {
+	journalReplayer, ok := ccf.dataComponents.Datapool().Transactions().(txpool.JournalReplayer)
+	if !ok {
+		return nil
+	}
+
+	accountsRepository := ccf.stateComponents.AccountsRepository()
+	if check.IfNil(accountsRepository) {
+		return state.ErrNilAccountsRepository
+	}
+
+	err := journalReplayer.ReplayJournal(accountsRepository.GetCurrentStateAccountsWrapper())
+	if err != nil {
+		return fmt.Errorf("%w while replaying the transactions pool journal", err)
+	}
+
+	return nil
+}
+
 func (ccf *consensusComponentsFactory) createChronology() (consensus.ChronologyHandler, error) {
 	wd := ccf.coreComponents.Watchdog()
 	if ccf.statusComponents.OutportHandler().HasDrivers() {
@@ -7,8 +7,10 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
 	"github.com/ElrondNetwork/elrond-go-core/data/endProcess"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go-core/data/typeConverters"
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
@@ -29,6 +31,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/outport"
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/txsSender"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/sharding/nodesCoordinator"
@@ -230,7 +233,11 @@ type NetworkComponentsHandler interface {
 
 // TransactionSimulatorProcessor defines the actions which a transaction simulator processor has to implement
 type TransactionSimulatorProcessor interface {
-	ProcessTx(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ProcessTx(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error)
+	ProcessTxWithStateOverrides(tx *transaction.Transaction, overrides map[string]*state.AccountOverride, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error)
+	ProcessHistoricalTx(tx *transaction.Transaction, options api.AccountQueryOptions) (*txSimData.SimulationResults, error)
+	ProcessTxsBatch(txs []*transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.BatchSimulationResults, error)
+	ProcessSmartContractResult(scr *smartContractResult.SmartContractResult) (*txSimData.SimulationResults, error)
 	IsInterfaceNil() bool
 }
 
@@ -268,6 +275,7 @@ type ProcessComponentsHolder interface {
 	CurrentEpochProvider() process.CurrentNetworkEpochProviderHandler
 	ScheduledTxsExecutionHandler() process.ScheduledTxsExecutionHandler
 	TxsSenderHandler() process.TxsSenderHandler
+	ScheduledTxsOutbox() ScheduledTxsOutboxHandler
 	HardforkTrigger() HardforkTrigger
 	ProcessedMiniBlocksTracker() process.ProcessedMiniBlocksTracker
 	AccountsParser() genesis.AccountsParser
@@ -293,6 +301,7 @@ type StateComponentsHolder interface {
 	AccountsAdapter() state.AccountsAdapter
 	AccountsAdapterAPI() state.AccountsAdapter
 	AccountsRepository() state.AccountsRepository
+	StateChangesCollector() state.StateChangesCollector
 	TriesContainer() common.TriesHolder
 	TrieStorageManagers() map[string]common.StorageManager
 	IsInterfaceNil() bool
@@ -301,6 +310,7 @@ type StateComponentsHolder interface {
 // StatusComponentsHolder holds the status components
 type StatusComponentsHolder interface {
 	OutportHandler() outport.OutportHandler
+	EventsSubscriptionHandler() outport.EventsSubscriptionHandler
 	SoftwareVersionChecker() statistics.SoftwareVersionChecker
 	IsInterfaceNil() bool
 }
@@ -496,6 +506,18 @@ type EconomicsHandler interface {
 type LogsFacade interface {
 	GetLog(logKey []byte, epoch uint32) (*transaction.ApiLogs, error)
 	IncludeLogsInTransactions(txs []*transaction.ApiTransactionResult, logsKeys [][]byte, epoch uint32) error
+	GetLogEvents(options common.LogEventsQueryOptions) (*common.LogEventsApiResponse, error)
+	IsInterfaceNil() bool
+}
+
+// ScheduledTxsOutboxHandler defines the node-local outbox holding the transactions to be sent once a round, an epoch or
+// an account nonce is reached
+type ScheduledTxsOutboxHandler interface {
+	ScheduleTransaction(tx *transaction.Transaction, condition string, conditionValue uint64) ([]byte, error)
+	GetScheduledTransactions() []*txsSender.ScheduledTransactionInfo
+	CancelScheduledTransaction(txHash []byte) error
+	SetTransactionValidator(txValidator txsSender.TransactionValidator) error
+	Close() error
 	IsInterfaceNil() bool
 }
 
@@ -47,6 +47,7 @@ type ProcessComponentsMock struct {
 	CurrentEpochProviderInternal         process.CurrentNetworkEpochProviderHandler
 	ScheduledTxsExecutionHandlerInternal process.ScheduledTxsExecutionHandler
 	TxsSenderHandlerField                process.TxsSenderHandler
+	ScheduledTxsOutboxField              factory.ScheduledTxsOutboxHandler
 	HardforkTriggerField                 factory.HardforkTrigger
 	ProcessedMiniBlocksTrackerInternal   process.ProcessedMiniBlocksTracker
 	AccountsParserInternal               genesis.AccountsParser
@@ -238,6 +239,11 @@ func (pcm *ProcessComponentsMock) TxsSenderHandler() process.TxsSenderHandler {
 	return pcm.TxsSenderHandlerField
 }
 
+// ScheduledTxsOutbox -
+func (pcm *ProcessComponentsMock) ScheduledTxsOutbox() factory.ScheduledTxsOutboxHandler {
+	return pcm.ScheduledTxsOutboxField
+}
+
 // HardforkTrigger -
 func (pcm *ProcessComponentsMock) HardforkTrigger() factory.HardforkTrigger {
 	return pcm.HardforkTriggerField
@@ -7,12 +7,13 @@ import (
 
 // StateComponentsHolderStub -
 type StateComponentsHolderStub struct {
-	PeerAccountsCalled        func() state.AccountsAdapter
-	AccountsAdapterCalled     func() state.AccountsAdapter
-	AccountsAdapterAPICalled  func() state.AccountsAdapter
-	AccountsRepositoryCalled  func() state.AccountsRepository
-	TriesContainerCalled      func() common.TriesHolder
-	TrieStorageManagersCalled func() map[string]common.StorageManager
+	PeerAccountsCalled          func() state.AccountsAdapter
+	AccountsAdapterCalled       func() state.AccountsAdapter
+	AccountsAdapterAPICalled    func() state.AccountsAdapter
+	AccountsRepositoryCalled    func() state.AccountsRepository
+	StateChangesCollectorCalled func() state.StateChangesCollector
+	TriesContainerCalled        func() common.TriesHolder
+	TrieStorageManagersCalled   func() map[string]common.StorageManager
 }
 
 // PeerAccounts -
@@ -51,6 +52,15 @@ func (s *StateComponentsHolderStub) AccountsRepository() state.AccountsRepositor
 	return nil
 }
 
+// StateChangesCollector -
+func (s *StateComponentsHolderStub) StateChangesCollector() state.StateChangesCollector {
+	if s.StateChangesCollectorCalled != nil {
+		return s.StateChangesCollectorCalled()
+	}
+
+	return nil
+}
+
 // TriesContainer -
 func (s *StateComponentsHolderStub) TriesContainer() common.TriesHolder {
 	if s.TriesContainerCalled != nil {
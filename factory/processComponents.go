@@ -53,6 +53,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/track"
 	"github.com/ElrondNetwork/elrond-go/process/transactionLog"
 	"github.com/ElrondNetwork/elrond-go/process/txsSender"
+	txsSenderDisabled "github.com/ElrondNetwork/elrond-go/process/txsSender/disabled"
 	"github.com/ElrondNetwork/elrond-go/process/txsimulator"
 	"github.com/ElrondNetwork/elrond-go/redundancy"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -113,6 +114,7 @@ type processComponents struct {
 	vmFactoryForProcessing       process.VirtualMachinesContainerFactory
 	scheduledTxsExecutionHandler process.ScheduledTxsExecutionHandler
 	txsSender                    process.TxsSenderHandler
+	scheduledTxsOutbox           ScheduledTxsOutboxHandler
 	hardforkTrigger              HardforkTrigger
 	processedMiniBlocksTracker   process.ProcessedMiniBlocksTracker
 	accountsParser               genesis.AccountsParser
@@ -618,6 +620,11 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		return nil, err
 	}
 
+	scheduledTxsOutbox, err := pcf.createScheduledTxsOutbox(txsSenderWithAccumulator)
+	if err != nil {
+		return nil, err
+	}
+
 	return &processComponents{
 		nodesCoordinator:             pcf.nodesCoordinator,
 		shardCoordinator:             pcf.bootstrapComponents.ShardCoordinator(),
@@ -656,6 +663,7 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		vmFactoryForProcessing:       blockProcessorComponents.vmFactoryForProcessing,
 		scheduledTxsExecutionHandler: scheduledTxsExecutionHandler,
 		txsSender:                    txsSenderWithAccumulator,
+		scheduledTxsOutbox:           scheduledTxsOutbox,
 		hardforkTrigger:              hardforkTrigger,
 		processedMiniBlocksTracker:   processedMiniBlocksTracker,
 		accountsParser:               pcf.accountsParser,
@@ -663,6 +671,44 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 	}, nil
 }
 
+func (pcf *processComponentsFactory) createScheduledTxsOutbox(txsSenderHandler process.TxsSenderHandler) (ScheduledTxsOutboxHandler, error) {
+	outboxConfig := pcf.config.ScheduledTxsOutbox
+	if !outboxConfig.Enabled {
+		return txsSenderDisabled.NewScheduledTxsOutbox(), nil
+	}
+
+	dbConfig := storageFactory.GetDBFromConfig(outboxConfig.DB)
+	shardId := core.GetShardIDString(pcf.bootstrapComponents.ShardCoordinator().SelfId())
+	db, err := storageUnit.NewDB(storageUnit.ArgDB{
+		DBType:            dbConfig.Type,
+		Path:              pcf.coreData.PathHandler().PathForStatic(shardId, outboxConfig.DB.FilePath),
+		BatchDelaySeconds: dbConfig.BatchDelaySeconds,
+		MaxBatchSize:      dbConfig.MaxBatchSize,
+		MaxOpenFiles:      dbConfig.MaxOpenFiles,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating the db for the scheduled transactions outbox", err)
+	}
+
+	outbox, err := txsSender.NewScheduledTxsOutbox(txsSender.ArgsScheduledTxsOutbox{
+		TxsSender:          txsSenderHandler,
+		Persister:          db,
+		Marshaller:         pcf.coreData.InternalMarshalizer(),
+		Hasher:             pcf.coreData.Hasher(),
+		RoundHandler:       pcf.coreData.RoundHandler(),
+		EpochNotifier:      pcf.coreData.EpochNotifier(),
+		AccountsAdapter:    pcf.state.AccountsAdapterAPI(),
+		MaxNumTransactions: outboxConfig.MaxNumTransactions,
+		CheckInterval:      time.Duration(outboxConfig.CheckIntervalInMilliseconds) * time.Millisecond,
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("%w while creating the scheduled transactions outbox", err)
+	}
+
+	return outbox, nil
+}
+
 func (pcf *processComponentsFactory) newValidatorStatisticsProcessor() (process.ValidatorStatisticsProcessor, error) {
 
 	storageService := pcf.data.StorageService()
@@ -995,7 +1041,7 @@ func (pcf *processComponentsFactory) indexGenesisBlocks(genesisBlocks map[uint32
 
 	log.Info("indexGenesisBlocks(): historyRepo.RecordBlock", "shardID", currentShardId, "hash", genesisBlockHash)
 	// TODO: save also genesis body transactions into node storage
-	err = pcf.historyRepo.RecordBlock(genesisBlockHash, genesisBlockHeader, &dataBlock.Body{}, nil, nil, nil, nil)
+	err = pcf.historyRepo.RecordBlock(genesisBlockHash, genesisBlockHeader, &dataBlock.Body{}, nil, nil, nil, nil, nil)
 	if err != nil {
 		return err
 	}
@@ -1669,6 +1715,9 @@ func (pc *processComponents) Close() error {
 	if !check.IfNil(pc.vmFactoryForProcessing) {
 		log.LogIfError(pc.vmFactoryForProcessing.Close())
 	}
+	if !check.IfNil(pc.scheduledTxsOutbox) {
+		log.LogIfError(pc.scheduledTxsOutbox.Close())
+	}
 	if !check.IfNil(pc.txsSender) {
 		log.LogIfError(pc.txsSender.Close())
 	}
@@ -156,6 +156,9 @@ func (m *managedProcessComponents) CheckSubcomponents() error {
 	if check.IfNil(m.processComponents.txsSender) {
 		return errors.ErrNilTxsSender
 	}
+	if check.IfNil(m.processComponents.scheduledTxsOutbox) {
+		return errors.ErrNilScheduledTxsOutbox
+	}
 	if check.IfNil(m.processComponents.processedMiniBlocksTracker) {
 		return process.ErrNilProcessedMiniBlocksTracker
 	}
@@ -558,6 +561,18 @@ func (m *managedProcessComponents) TxsSenderHandler() process.TxsSenderHandler {
 	return m.processComponents.txsSender
 }
 
+// ScheduledTxsOutbox returns the outbox of the scheduled transactions
+func (m *managedProcessComponents) ScheduledTxsOutbox() ScheduledTxsOutboxHandler {
+	m.mutProcessComponents.RLock()
+	defer m.mutProcessComponents.RUnlock()
+
+	if m.processComponents == nil {
+		return nil
+	}
+
+	return m.processComponents.scheduledTxsOutbox
+}
+
 // HardforkTrigger returns the hardfork trigger
 func (m *managedProcessComponents) HardforkTrigger() HardforkTrigger {
 	m.mutProcessComponents.RLock()
@@ -11,6 +11,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/errors"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/state"
+	stateDisabled "github.com/ElrondNetwork/elrond-go/state/disabled"
 	factoryState "github.com/ElrondNetwork/elrond-go/state/factory"
 	"github.com/ElrondNetwork/elrond-go/state/storagePruningManager"
 	"github.com/ElrondNetwork/elrond-go/state/storagePruningManager/evictionWaitingList"
@@ -44,12 +45,13 @@ type stateComponentsFactory struct {
 
 // stateComponents struct holds the state components of the Elrond protocol
 type stateComponents struct {
-	peerAccounts        state.AccountsAdapter
-	accountsAdapter     state.AccountsAdapter
-	accountsAdapterAPI  state.AccountsAdapter
-	accountsRepository  state.AccountsRepository
-	triesContainer      common.TriesHolder
-	trieStorageManagers map[string]common.StorageManager
+	peerAccounts          state.AccountsAdapter
+	accountsAdapter       state.AccountsAdapter
+	accountsAdapterAPI    state.AccountsAdapter
+	accountsRepository    state.AccountsRepository
+	stateChangesCollector state.StateChangesCollector
+	triesContainer        common.TriesHolder
+	trieStorageManagers   map[string]common.StorageManager
 }
 
 // NewStateComponentsFactory will return a new instance of stateComponentsFactory
@@ -99,7 +101,8 @@ func (scf *stateComponentsFactory) Create() (*stateComponents, error) {
 		return nil, err
 	}
 
-	accountsAdapter, accountsAdapterAPI, accountsRepository, err := scf.createAccountsAdapters(triesContainer)
+	stateChangesCollector := scf.createStateChangesCollector()
+	accountsAdapter, accountsAdapterAPI, accountsRepository, err := scf.createAccountsAdapters(triesContainer, stateChangesCollector)
 	if err != nil {
 		return nil, err
 	}
@@ -110,16 +113,31 @@ func (scf *stateComponentsFactory) Create() (*stateComponents, error) {
 	}
 
 	return &stateComponents{
-		peerAccounts:        peerAdapter,
-		accountsAdapter:     accountsAdapter,
-		accountsAdapterAPI:  accountsAdapterAPI,
-		accountsRepository:  accountsRepository,
-		triesContainer:      triesContainer,
-		trieStorageManagers: trieStorageManagers,
+		peerAccounts:          peerAdapter,
+		accountsAdapter:       accountsAdapter,
+		accountsAdapterAPI:    accountsAdapterAPI,
+		accountsRepository:    accountsRepository,
+		stateChangesCollector: stateChangesCollector,
+		triesContainer:        triesContainer,
+		trieStorageManagers:   trieStorageManagers,
 	}, nil
 }
 
-func (scf *stateComponentsFactory) createAccountsAdapters(triesContainer common.TriesHolder) (state.AccountsAdapter, state.AccountsAdapter, state.AccountsRepository, error) {
+// createStateChangesCollector creates the collector of the changes made on the user accounts while processing blocks.
+// The changes are only needed for the state diffs index of the db lookup extensions
+func (scf *stateComponentsFactory) createStateChangesCollector() state.StateChangesCollector {
+	dbLookupExtensionsConfig := scf.config.DbLookupExtensions
+	if !dbLookupExtensionsConfig.Enabled || !dbLookupExtensionsConfig.StateDiffsIndexEnabled {
+		return stateDisabled.NewDisabledStateChangesCollector()
+	}
+
+	return state.NewStateChangesCollector()
+}
+
+func (scf *stateComponentsFactory) createAccountsAdapters(
+	triesContainer common.TriesHolder,
+	stateChangesCollector state.StateChangesCollector,
+) (state.AccountsAdapter, state.AccountsAdapter, state.AccountsRepository, error) {
 	accountFactory := factoryState.NewAccountCreator()
 	merkleTrie := triesContainer.Get([]byte(trieFactory.UserAccountTrie))
 	storagePruning, err := scf.newStoragePruningManager()
@@ -136,6 +154,7 @@ func (scf *stateComponentsFactory) createAccountsAdapters(triesContainer common.
 		ProcessingMode:           scf.processingMode,
 		ShouldSerializeSnapshots: scf.shouldSerializeSnapshots,
 		ProcessStatusHandler:     scf.core.ProcessStatusHandler(),
+		StateChangesCollector:    stateChangesCollector,
 	}
 	accountsAdapter, err := state.NewAccountsDB(argsProcessingAccountsDB)
 	if err != nil {
@@ -150,6 +169,7 @@ func (scf *stateComponentsFactory) createAccountsAdapters(triesContainer common.
 		StoragePruningManager: storagePruning,
 		ProcessingMode:        scf.processingMode,
 		ProcessStatusHandler:  scf.core.ProcessStatusHandler(),
+		StateChangesCollector: stateDisabled.NewDisabledStateChangesCollector(),
 	}
 
 	accountsAdapterApiOnFinal, err := factoryState.CreateAccountsAdapterAPIOnFinal(argsAPIAccountsDB, scf.chainHandler)
@@ -198,6 +218,7 @@ func (scf *stateComponentsFactory) createPeerAdapter(triesContainer common.Tries
 		ProcessingMode:           scf.processingMode,
 		ShouldSerializeSnapshots: scf.shouldSerializeSnapshots,
 		ProcessStatusHandler:     scf.core.ProcessStatusHandler(),
+		StateChangesCollector:    stateDisabled.NewDisabledStateChangesCollector(),
 	}
 	peerAdapter, err := state.NewPeerAccountsDB(argsProcessingPeerAccountsDB)
 	if err != nil {
@@ -141,6 +141,18 @@ func (msc *managedStateComponents) AccountsRepository() state.AccountsRepository
 	return msc.stateComponents.accountsRepository
 }
 
+// StateChangesCollector returns the collector of the changes made on the user accounts while processing blocks
+func (msc *managedStateComponents) StateChangesCollector() state.StateChangesCollector {
+	msc.mutStateComponents.RLock()
+	defer msc.mutStateComponents.RUnlock()
+
+	if msc.stateComponents == nil {
+		return nil
+	}
+
+	return msc.stateComponents.stateChangesCollector
+}
+
 // TriesContainer returns the tries container
 func (msc *managedStateComponents) TriesContainer() common.TriesHolder {
 	msc.mutStateComponents.RLock()
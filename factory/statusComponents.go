@@ -3,6 +3,7 @@ package factory
 import (
 	"context"
 	"fmt"
+	"time"
 
 	covalentFactory "github.com/ElrondNetwork/covalent-indexer-go/factory"
 	indexerFactory "github.com/ElrondNetwork/elastic-indexer-go/factory"
@@ -17,6 +18,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/epochStart/notifier"
 	"github.com/ElrondNetwork/elrond-go/errors"
 	"github.com/ElrondNetwork/elrond-go/outport"
+	disabledOutport "github.com/ElrondNetwork/elrond-go/outport/disabled"
 	outportDriverFactory "github.com/ElrondNetwork/elrond-go/outport/factory"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/sharding"
@@ -27,12 +29,13 @@ import (
 // TODO: move app status handler initialization here
 
 type statusComponents struct {
-	nodesCoordinator nodesCoordinator.NodesCoordinator
-	statusHandler    core.AppStatusHandler
-	outportHandler   outport.OutportHandler
-	softwareVersion  statistics.SoftwareVersionChecker
-	resourceMonitor  statistics.ResourceMonitorHandler
-	cancelFunc       func()
+	nodesCoordinator          nodesCoordinator.NodesCoordinator
+	statusHandler             core.AppStatusHandler
+	outportHandler            outport.OutportHandler
+	eventsSubscriptionHandler outport.EventsSubscriptionHandler
+	softwareVersion           statistics.SoftwareVersionChecker
+	resourceMonitor           statistics.ResourceMonitorHandler
+	cancelFunc                func()
 }
 
 // StatusComponentsFactoryArgs redefines the arguments structure needed for the status components factory
@@ -148,15 +151,21 @@ func (scf *statusComponentsFactory) Create() (*statusComponents, error) {
 		return nil, err
 	}
 
+	eventsSubscriptionHandler, err := scf.createEventsSubscriptionHandler(outportHandler)
+	if err != nil {
+		return nil, err
+	}
+
 	_, cancelFunc := context.WithCancel(context.Background())
 
 	statusComponentsInstance := &statusComponents{
-		nodesCoordinator: scf.nodesCoordinator,
-		softwareVersion:  softwareVersionChecker,
-		outportHandler:   outportHandler,
-		statusHandler:    scf.coreComponents.StatusHandler(),
-		resourceMonitor:  resMon,
-		cancelFunc:       cancelFunc,
+		nodesCoordinator:          scf.nodesCoordinator,
+		softwareVersion:           softwareVersionChecker,
+		outportHandler:            outportHandler,
+		eventsSubscriptionHandler: eventsSubscriptionHandler,
+		statusHandler:             scf.coreComponents.StatusHandler(),
+		resourceMonitor:           resMon,
+		cancelFunc:                cancelFunc,
 	}
 
 	if scf.shardCoordinator.SelfId() == core.MetachainShardId {
@@ -217,6 +226,35 @@ func (scf *statusComponentsFactory) createOutportDriver() (outport.OutportHandle
 	return outportDriverFactory.CreateOutport(outportFactoryArgs)
 }
 
+// createEventsSubscriptionHandler creates the websocket events hub, if enabled, and subscribes it as an outport driver
+func (scf *statusComponentsFactory) createEventsSubscriptionHandler(outportHandler outport.OutportHandler) (outport.EventsSubscriptionHandler, error) {
+	wsEventsConfig := scf.externalConfig.WebSocketEventsConnector
+	if !wsEventsConfig.Enabled {
+		return disabledOutport.NewDisabledEventsSubscriptionHandler(), nil
+	}
+
+	wsEventsHub, err := outportDriverFactory.CreateWSEventsHub(&outportDriverFactory.WSEventsHubFactoryArgs{
+		MaxSubscribers:      wsEventsConfig.MaxSubscribers,
+		SendBufferSize:      wsEventsConfig.SendBufferSize,
+		SubscriptionTimeout: time.Duration(wsEventsConfig.SubscriptionTimeoutInSec) * time.Second,
+		WriteTimeout:        time.Duration(wsEventsConfig.WriteTimeoutInSec) * time.Second,
+		PongTimeout:         time.Duration(wsEventsConfig.PongTimeoutInSec) * time.Second,
+		Marshaller:          scf.coreComponents.InternalMarshalizer(),
+		Hasher:              scf.coreComponents.Hasher(),
+		PubKeyConverter:     scf.coreComponents.AddressPubKeyConverter(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = outportHandler.SubscribeDriver(wsEventsHub)
+	if err != nil {
+		return nil, err
+	}
+
+	return wsEventsHub, nil
+}
+
 func (scf *statusComponentsFactory) makeElasticIndexerArgs() *indexerFactory.ArgsIndexerFactory {
 	elasticSearchConfig := scf.externalConfig.ElasticSearchConnector
 	return &indexerFactory.ArgsIndexerFactory{
@@ -96,6 +96,9 @@ func (msc *managedStatusComponents) CheckSubcomponents() error {
 	if check.IfNil(msc.outportHandler) {
 		return errors.ErrNilOutportHandler
 	}
+	if check.IfNil(msc.eventsSubscriptionHandler) {
+		return errors.ErrNilEventsSubscriptionHandler
+	}
 	if check.IfNil(msc.softwareVersion) {
 		return errors.ErrNilSoftwareVersion
 	}
@@ -147,6 +150,18 @@ func (msc *managedStatusComponents) OutportHandler() outport.OutportHandler {
 	return msc.statusComponents.outportHandler
 }
 
+// EventsSubscriptionHandler returns the websocket events subscription handler
+func (msc *managedStatusComponents) EventsSubscriptionHandler() outport.EventsSubscriptionHandler {
+	msc.mutStatusComponents.RLock()
+	defer msc.mutStatusComponents.RUnlock()
+
+	if msc.statusComponents == nil {
+		return nil
+	}
+
+	return msc.statusComponents.eventsSubscriptionHandler
+}
+
 // SoftwareVersionChecker returns the software version checker handler
 func (msc *managedStatusComponents) SoftwareVersionChecker() statistics.SoftwareVersionChecker {
 	msc.mutStatusComponents.RLock()
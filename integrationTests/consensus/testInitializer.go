@@ -39,6 +39,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/sharding/nodesCoordinator"
 	"github.com/ElrondNetwork/elrond-go/state"
+	stateDisabled "github.com/ElrondNetwork/elrond-go/state/disabled"
 	"github.com/ElrondNetwork/elrond-go/state/storagePruningManager"
 	"github.com/ElrondNetwork/elrond-go/state/storagePruningManager/evictionWaitingList"
 	"github.com/ElrondNetwork/elrond-go/storage"
@@ -199,6 +200,7 @@ func createAccountsDB(marshaller marshal.Marshalizer) state.AccountsAdapter {
 		StoragePruningManager: storagePruning,
 		ProcessingMode:        common.Normal,
 		ProcessStatusHandler:  &testscommon.ProcessStatusHandlerStub{},
+		StateChangesCollector: stateDisabled.NewDisabledStateChangesCollector(),
 	}
 
 	adb, _ := state.NewAccountsDB(argsAccountsDB)
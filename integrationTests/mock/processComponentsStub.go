@@ -48,6 +48,7 @@ type ProcessComponentsStub struct {
 	CurrentEpochProviderInternal         process.CurrentNetworkEpochProviderHandler
 	ScheduledTxsExecutionHandlerInternal process.ScheduledTxsExecutionHandler
 	TxsSenderHandlerField                process.TxsSenderHandler
+	ScheduledTxsOutboxField              factory.ScheduledTxsOutboxHandler
 	HardforkTriggerField                 factory.HardforkTrigger
 	ProcessedMiniBlocksTrackerInternal   process.ProcessedMiniBlocksTracker
 	ReceiptsRepositoryInternal           factory.ReceiptsRepository
@@ -238,6 +239,11 @@ func (pcs *ProcessComponentsStub) TxsSenderHandler() process.TxsSenderHandler {
 	return pcs.TxsSenderHandlerField
 }
 
+// ScheduledTxsOutbox -
+func (pcs *ProcessComponentsStub) ScheduledTxsOutbox() factory.ScheduledTxsOutboxHandler {
+	return pcs.ScheduledTxsOutboxField
+}
+
 // HardforkTrigger -
 func (pcs *ProcessComponentsStub) HardforkTrigger() factory.HardforkTrigger {
 	return pcs.HardforkTriggerField
@@ -1,19 +1,62 @@
 package mock
 
 import (
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+	"github.com/ElrondNetwork/elrond-go/state"
 )
 
 // TransactionSimulatorStub -
 type TransactionSimulatorStub struct {
-	ProcessTxCalled func(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ProcessTxCalled                   func(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error)
+	ProcessTxWithStateOverridesCalled func(tx *transaction.Transaction, overrides map[string]*state.AccountOverride, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error)
+	ProcessHistoricalTxCalled         func(tx *transaction.Transaction, options api.AccountQueryOptions) (*txSimData.SimulationResults, error)
+	ProcessTxsBatchCalled             func(txs []*transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.BatchSimulationResults, error)
+	ProcessSmartContractResultCalled  func(scr *smartContractResult.SmartContractResult) (*txSimData.SimulationResults, error)
 }
 
 // ProcessTx -
-func (tss *TransactionSimulatorStub) ProcessTx(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
+func (tss *TransactionSimulatorStub) ProcessTx(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
 	if tss.ProcessTxCalled != nil {
-		return tss.ProcessTxCalled(tx)
+		return tss.ProcessTxCalled(tx, options)
+	}
+
+	return nil, nil
+}
+
+// ProcessTxWithStateOverrides -
+func (tss *TransactionSimulatorStub) ProcessTxWithStateOverrides(tx *transaction.Transaction, overrides map[string]*state.AccountOverride, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+	if tss.ProcessTxWithStateOverridesCalled != nil {
+		return tss.ProcessTxWithStateOverridesCalled(tx, overrides, options)
+	}
+
+	return nil, nil
+}
+
+// ProcessHistoricalTx -
+func (tss *TransactionSimulatorStub) ProcessHistoricalTx(tx *transaction.Transaction, options api.AccountQueryOptions) (*txSimData.SimulationResults, error) {
+	if tss.ProcessHistoricalTxCalled != nil {
+		return tss.ProcessHistoricalTxCalled(tx, options)
+	}
+
+	return &txSimData.SimulationResults{}, nil
+}
+
+// ProcessTxsBatch -
+func (tss *TransactionSimulatorStub) ProcessTxsBatch(txs []*transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.BatchSimulationResults, error) {
+	if tss.ProcessTxsBatchCalled != nil {
+		return tss.ProcessTxsBatchCalled(txs, options)
+	}
+
+	return nil, nil
+}
+
+// ProcessSmartContractResult -
+func (tss *TransactionSimulatorStub) ProcessSmartContractResult(scr *smartContractResult.SmartContractResult) (*txSimData.SimulationResults, error) {
+	if tss.ProcessSmartContractResultCalled != nil {
+		return tss.ProcessSmartContractResultCalled(scr)
 	}
 
 	return nil, nil
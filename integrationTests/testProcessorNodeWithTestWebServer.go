@@ -23,6 +23,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/txsimulator"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/process/txstatus"
+	"github.com/ElrondNetwork/elrond-go/state"
+	stateDisabled "github.com/ElrondNetwork/elrond-go/state/disabled"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/ElrondNetwork/elrond-go/testscommon/genesisMocks"
 	"github.com/ElrondNetwork/elrond-go/vm/systemSmartContracts/defaults"
@@ -90,11 +92,12 @@ func createFacadeArg(tpn *TestProcessorNode) nodeFacade.ArgNodeFacade {
 			TrieOperationsDeadlineMilliseconds: 1,
 			EndpointsThrottlers:                []config.EndpointsThrottlersConfig{},
 		},
-		FacadeConfig:    config.FacadeConfig{},
-		ApiRoutesConfig: createTestApiConfig(),
-		AccountsState:   tpn.AccntState,
-		PeerState:       tpn.PeerState,
-		Blockchain:      tpn.BlockChain,
+		FacadeConfig:       config.FacadeConfig{},
+		ApiRoutesConfig:    createTestApiConfig(),
+		AccountsState:      tpn.AccntState,
+		PeerState:          tpn.PeerState,
+		Blockchain:         tpn.BlockChain,
+		EventsSubscription: &testscommon.EventsSubscriptionHandlerStub{},
 	}
 }
 
@@ -163,7 +166,7 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 		txTypeHandler,
 		tpn.EconomicsData,
 		&mock.TransactionSimulatorStub{
-			ProcessTxCalled: func(tx *dataTransaction.Transaction) (*txSimData.SimulationResults, error) {
+			ProcessTxCalled: func(tx *dataTransaction.Transaction, _ txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
 				return &txSimData.SimulationResults{}, nil
 			},
 		},
@@ -174,6 +177,23 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 	)
 	log.LogIfError(err)
 
+	txGasEstimator, err := transaction.NewGasEstimator(transaction.ArgsGasEstimator{
+		TxSimulator: &mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(tx *dataTransaction.Transaction, _ txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+				return &txSimData.SimulationResults{}, nil
+			},
+		},
+		FeeHandler:             tpn.EconomicsData,
+		Accounts:               tpn.AccntState,
+		ShardCoordinator:       tpn.ShardCoordinator,
+		ESDTTransferParser:     esdtTransferParser,
+		GasScheduleNotifier:    gasScheduleNotifier,
+		AddressPubKeyConverter: TestAddressPubkeyConverter,
+		Marshalizer:            TestMarshalizer,
+		Hasher:                 TestHasher,
+	})
+	log.LogIfError(err)
+
 	accountsWrapper := &trieIterators.AccountsWrapper{
 		Mutex:           &sync.Mutex{},
 		AccountsAdapter: tpn.AccntState,
@@ -194,6 +214,9 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 	delegatedListHandler, err := factory.CreateDelegatedListHandler(args)
 	log.LogIfError(err)
 
+	esdtHoldersHandler, err := factory.CreateESDTHoldersHandler(args)
+	log.LogIfError(err)
+
 	logsFacade := &testscommon.LogsFacadeStub{}
 	receiptsRepository := &testscommon.ReceiptsRepositoryStub{}
 
@@ -243,10 +266,36 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 	apiInternalBlockProcessor, err := blockAPI.CreateAPIInternalBlockProcessor(argsBlockAPI)
 	log.LogIfError(err)
 
+	accountsWithOverrides, err := state.NewAccountsDBWithOverrides(tpn.AccntState, TestHasher)
+	log.LogIfError(err)
+
+	readOnlyAccountsDB, err := txsimulator.NewReadOnlyAccountsDB(accountsWithOverrides, TestMarshalizer, TestHasher)
+	log.LogIfError(err)
+
+	argSimulator := txsimulator.ArgsTxSimulator{
+		TransactionProcessor:         tpn.TxProcessor,
+		IntermediateProcContainer:    tpn.InterimProcContainer,
+		AddressPubKeyConverter:       TestAddressPubkeyConverter,
+		ShardCoordinator:             tpn.ShardCoordinator,
+		Marshalizer:                  TestMarshalizer,
+		Hasher:                       TestHasher,
+		VMOutputCacher:               &testscommon.CacherMock{},
+		Accounts:                     readOnlyAccountsDB,
+		AccountsOverrider:            accountsWithOverrides,
+		AccountsHistoricalView:       stateDisabled.NewDisabledAccountsHistoricalViewHandler(),
+		BuiltInFunctions:             builtInFuncs.BuiltInFunctionContainer(),
+		SmartContractResultProcessor: tpn.ScProcessor,
+	}
+
+	txSimulator, err := txsimulator.NewTransactionSimulator(argSimulator)
+	log.LogIfError(err)
+
 	argsApiResolver := external.ArgNodeApiResolver{
 		SCQueryService:           tpn.SCQueryService,
 		StatusMetricsHandler:     &testscommon.StatusMetricsStub{},
 		TxCostHandler:            txCostHandler,
+		TxGasEstimator:           txGasEstimator,
+		HistoricalTxSimulator:    txSimulator,
 		TotalStakedValueHandler:  totalStakedValueHandler,
 		DirectStakedListHandler:  directStakedListHandler,
 		DelegatedListHandler:     delegatedListHandler,
@@ -257,24 +306,13 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 		ValidatorPubKeyConverter: &testscommon.PubkeyConverterMock{},
 		AccountsParser:           &genesisMocks.AccountsParserStub{},
 		GasScheduleNotifier:      &testscommon.GasScheduleNotifierMock{},
+		LogsFacade:               logsFacade,
+		ESDTHoldersHandler:       esdtHoldersHandler,
 	}
 
 	apiResolver, err := external.NewNodeApiResolver(argsApiResolver)
 	log.LogIfError(err)
 
-	argSimulator := txsimulator.ArgsTxSimulator{
-		TransactionProcessor:      tpn.TxProcessor,
-		IntermediateProcContainer: tpn.InterimProcContainer,
-		AddressPubKeyConverter:    TestAddressPubkeyConverter,
-		ShardCoordinator:          tpn.ShardCoordinator,
-		Marshalizer:               TestMarshalizer,
-		Hasher:                    TestHasher,
-		VMOutputCacher:            &testscommon.CacherMock{},
-	}
-
-	txSimulator, err := txsimulator.NewTransactionSimulator(argSimulator)
-	log.LogIfError(err)
-
 	return apiResolver, txSimulator
 }
 
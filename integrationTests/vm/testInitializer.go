@@ -47,6 +47,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/txsimulator"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/state"
+	stateDisabled "github.com/ElrondNetwork/elrond-go/state/disabled"
 	"github.com/ElrondNetwork/elrond-go/state/storagePruningManager"
 	"github.com/ElrondNetwork/elrond-go/state/storagePruningManager/evictionWaitingList"
 	"github.com/ElrondNetwork/elrond-go/storage"
@@ -236,6 +237,7 @@ func (vmTestContext *VMTestContext) GetVMOutputWithTransientVM(funcName string,
 		ArwenChangeLocker:        &sync.RWMutex{},
 		Bootstrapper:             syncDisabled.NewDisabledBootstrapper(),
 		AllowExternalQueriesChan: common.GetClosedUnbufferedChannel(),
+		AccountsOverrider:        stateDisabled.NewDisabledAccountsOverridesHandler(),
 	}
 	scQueryService, _ := smartContract.NewSCQueryService(argsNewSCQueryService)
 
@@ -315,6 +317,7 @@ func CreateInMemoryShardAccountsDB() *state.AccountsDB {
 		StoragePruningManager: spm,
 		ProcessingMode:        common.Normal,
 		ProcessStatusHandler:  &testscommon.ProcessStatusHandlerStub{},
+		StateChangesCollector: stateDisabled.NewDisabledStateChangesCollector(),
 	}
 	adb, _ := state.NewAccountsDB(argsAccountsDB)
 
@@ -872,7 +875,12 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 	}
 
 	// create transaction simulator
-	readOnlyAccountsDB, err := txsimulator.NewReadOnlyAccountsDB(accnts)
+	accountsWithOverrides, err := state.NewAccountsDBWithOverrides(accnts, testHasher)
+	if err != nil {
+		return nil, err
+	}
+
+	readOnlyAccountsDB, err := txsimulator.NewReadOnlyAccountsDB(accountsWithOverrides, testMarshalizer, testHasher)
 	if err != nil {
 		return nil, err
 	}
@@ -930,6 +938,10 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 		VMOutputCacher:         vmOutputCacher,
 		Marshalizer:            testMarshalizer,
 		Hasher:                 testHasher,
+		Accounts:               readOnlyAccountsDB,
+		AccountsOverrider:      accountsWithOverrides,
+		AccountsHistoricalView: stateDisabled.NewDisabledAccountsHistoricalViewHandler(),
+		BuiltInFunctions:       blockChainHook.GetBuiltinFunctionsContainer(),
 	}
 
 	argsNewSCProcessor.VMOutputCacher = txSimulatorProcessorArgs.VMOutputCacher
@@ -939,6 +951,7 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 		return nil, err
 	}
 	argsNewTxProcessor.ScProcessor = scProcessorTxSim
+	txSimulatorProcessorArgs.SmartContractResultProcessor = scProcessorTxSim
 
 	argsNewTxProcessor.Accounts = readOnlyAccountsDB
 
@@ -1376,6 +1389,7 @@ func GetVmOutput(gasSchedule map[string]map[string]uint64, accnts state.Accounts
 		ArwenChangeLocker:        &sync.RWMutex{},
 		Bootstrapper:             syncDisabled.NewDisabledBootstrapper(),
 		AllowExternalQueriesChan: common.GetClosedUnbufferedChannel(),
+		AccountsOverrider:        stateDisabled.NewDisabledAccountsOverridesHandler(),
 	}
 	scQueryService, _ := smartContract.NewSCQueryService(argsNewSCQueryService)
 
@@ -1424,6 +1438,7 @@ func ComputeGasLimit(gasSchedule map[string]map[string]uint64, testContext *VMTe
 		ArwenChangeLocker:        &sync.RWMutex{},
 		Bootstrapper:             syncDisabled.NewDisabledBootstrapper(),
 		AllowExternalQueriesChan: common.GetClosedUnbufferedChannel(),
+		AccountsOverrider:        stateDisabled.NewDisabledAccountsOverridesHandler(),
 	}
 	scQueryService, _ := smartContract.NewSCQueryService(argsNewSCQueryService)
 
@@ -130,5 +130,11 @@ var ErrCannotCastUserAccountHandlerToVmCommonUserAccountHandler = errors.New("ca
 // ErrTrieOperationsTimeout signals that a trie operation took too long
 var ErrTrieOperationsTimeout = errors.New("trie operations timeout")
 
+// ErrInvalidContinuationToken signals that an invalid continuation token was provided
+var ErrInvalidContinuationToken = errors.New("invalid continuation token")
+
+// ErrInvalidPageLimit signals that an invalid page limit was provided
+var ErrInvalidPageLimit = errors.New("invalid page limit")
+
 // ErrNilStorer signals the using of a nil storer
 var ErrNilStorer = errors.New("nil storer")
@@ -20,3 +20,4 @@ var errCannotLoadTransactions = errors.New("cannot load transaction(s)")
 var errCannotUnmarshalTransactions = errors.New("cannot unmarshal transaction(s)")
 var errCannotLoadReceipts = errors.New("cannot load receipt(s)")
 var errCannotUnmarshalReceipts = errors.New("cannot unmarshal receipt(s)")
+var errCannotLoadNotarizedBlock = errors.New("cannot load notarized block")
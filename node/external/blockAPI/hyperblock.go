@@ -0,0 +1,153 @@
+package blockAPI
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+)
+
+// GetHyperblockByNonce will return the meta block with the provided nonce, along with the shard blocks notarized by it.
+// When transactions are requested, the shard miniblocks which are not available in the local storage are reported
+// as incomplete instead of failing the whole request
+func (mbp *metaAPIBlockProcessor) GetHyperblockByNonce(nonce uint64, options api.BlockQueryOptions) (*common.ApiHyperblock, error) {
+	nonceToByteSlice := mbp.uint64ByteSliceConverter.ToByteSlice(nonce)
+	headerHash, err := mbp.store.Get(dataRetriever.MetaHdrNonceHashDataUnit, nonceToByteSlice)
+	if err != nil {
+		return nil, err
+	}
+
+	blockBytes, err := mbp.getFromStorer(dataRetriever.MetaBlockUnit, headerHash)
+	if err != nil {
+		return nil, err
+	}
+
+	metaBlock, err := mbp.convertMetaBlockBytesToAPIBlock(headerHash, blockBytes, options)
+	if err != nil {
+		return nil, err
+	}
+
+	blockHeader := &block.MetaBlock{}
+	err = mbp.marshalizer.Unmarshal(blockHeader, blockBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	hyperblock := &common.ApiHyperblock{
+		MetaBlock:   metaBlock,
+		ShardBlocks: make([]*api.Block, 0, len(blockHeader.ShardInfo)),
+	}
+	for _, shardData := range blockHeader.ShardInfo {
+		shardBlock, errGet := mbp.getNotarizedShardBlock(shardData.HeaderHash, blockHeader.Epoch, options, hyperblock)
+		if errGet != nil {
+			return nil, fmt.Errorf("%w: %v, hash = %s", errCannotLoadNotarizedBlock, errGet, hex.EncodeToString(shardData.HeaderHash))
+		}
+
+		hyperblock.ShardBlocks = append(hyperblock.ShardBlocks, shardBlock)
+	}
+
+	if options.WithTransactions {
+		hyperblock.Transactions = collectHyperblockTransactions(metaBlock, hyperblock.ShardBlocks)
+	}
+
+	return hyperblock, nil
+}
+
+func (mbp *metaAPIBlockProcessor) getNotarizedShardBlock(
+	headerHash []byte,
+	metaEpoch uint32,
+	options api.BlockQueryOptions,
+	hyperblock *common.ApiHyperblock,
+) (*api.Block, error) {
+	blockBytes, err := mbp.getShardHeaderBytes(headerHash, metaEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	shardBlockConverter := &shardAPIBlockProcessor{baseAPIBlockProcessor: mbp.baseAPIBlockProcessor}
+	shardBlock, err := shardBlockConverter.convertShardBlockBytesToAPIBlock(headerHash, blockBytes, api.BlockQueryOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	if !options.WithTransactions {
+		return shardBlock, nil
+	}
+
+	for _, miniblockAPI := range shardBlock.MiniBlocks {
+		if miniblockAPI.IsFromReceiptsStorage {
+			continue
+		}
+
+		err = mbp.attachTxsToNotarizedMiniblock(miniblockAPI, shardBlock.Epoch, options)
+		if errors.Is(err, errCannotLoadMiniblocks) {
+			hyperblock.IncompleteMiniBlocks = append(hyperblock.IncompleteMiniBlocks, miniblockAPI.Hash)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return shardBlock, nil
+}
+
+// getShardHeaderBytes searches the shard header in the epoch of the notarizing meta block and then in the previous
+// one, as the shard blocks notarized by an epoch start meta block belong to the previous epoch
+func (mbp *metaAPIBlockProcessor) getShardHeaderBytes(headerHash []byte, metaEpoch uint32) ([]byte, error) {
+	blockBytes, err := mbp.getFromStorerWithEpoch(dataRetriever.BlockHeaderUnit, headerHash, metaEpoch)
+	if err == nil || metaEpoch == 0 {
+		return blockBytes, err
+	}
+
+	return mbp.getFromStorerWithEpoch(dataRetriever.BlockHeaderUnit, headerHash, metaEpoch-1)
+}
+
+func (mbp *metaAPIBlockProcessor) attachTxsToNotarizedMiniblock(miniblockAPI *api.MiniBlock, epoch uint32, options api.BlockQueryOptions) error {
+	miniblockHash, err := hex.DecodeString(miniblockAPI.Hash)
+	if err != nil {
+		return err
+	}
+
+	miniblock, err := mbp.getMiniblockByHashAndEpoch(miniblockHash, epoch)
+	if err != nil {
+		return err
+	}
+
+	firstProcessed := miniblockAPI.IndexOfFirstTxProcessed
+	lastProcessed := miniblockAPI.IndexOfLastTxProcessed
+	return mbp.getAndAttachTxsToMbByEpoch(miniblockHash, miniblock, epoch, miniblockAPI, firstProcessed, lastProcessed, options)
+}
+
+// collectHyperblockTransactions gathers the transactions from all the blocks of a hyperblock. A cross-shard
+// transaction appears in both the source and the destination miniblocks, the latter one being kept, as it holds
+// the final status of the transaction
+func collectHyperblockTransactions(metaBlock *api.Block, shardBlocks []*api.Block) []*transaction.ApiTransactionResult {
+	txs := make([]*transaction.ApiTransactionResult, 0)
+	indexByHash := make(map[string]int)
+
+	blocks := append([]*api.Block{metaBlock}, shardBlocks...)
+	for _, apiBlock := range blocks {
+		for _, miniblock := range apiBlock.MiniBlocks {
+			isExecutedInThisBlock := miniblock.DestinationShard == apiBlock.Shard
+			for _, tx := range miniblock.Transactions {
+				index, found := indexByHash[tx.Hash]
+				if !found {
+					indexByHash[tx.Hash] = len(txs)
+					txs = append(txs, tx)
+					continue
+				}
+				if isExecutedInThisBlock {
+					txs[index] = tx
+				}
+			}
+		}
+	}
+
+	return txs
+}
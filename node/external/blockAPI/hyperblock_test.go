@@ -0,0 +1,154 @@
+package blockAPI
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShardAPIBlockProcessor_GetHyperblockByNonceShouldErr(t *testing.T) {
+	t.Parallel()
+
+	processor := createMockShardAPIProcessor(0, nil, nil, false, false)
+
+	hyperblock, err := processor.GetHyperblockByNonce(1, api.BlockQueryOptions{})
+	require.Equal(t, ErrMetachainOnlyEndpoint, err)
+	require.Nil(t, hyperblock)
+}
+
+func TestMetaAPIBlockProcessor_GetHyperblockByNonce(t *testing.T) {
+	t.Parallel()
+
+	testEpoch := uint32(7)
+	testNonce := uint64(42)
+
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	storageService := genericMocks.NewChainStorerMock(testEpoch)
+
+	processor := createMockMetaAPIProcessor(nil, nil, false, false)
+	processor.store = storageService
+	processor.marshalizer = marshalizer
+	processor.txStatusComputer = &mock.StatusComputerStub{}
+	processor.apiTransactionHandler = &mock.TransactionAPIHandlerStub{
+		UnmarshalTransactionCalled: func(_ []byte, _ transaction.TxType) (*transaction.ApiTransactionResult, error) {
+			return &transaction.ApiTransactionResult{}, nil
+		},
+	}
+
+	// A cross-shard miniblock, notarized in both the source and the destination shard blocks
+	txHash := []byte{0x01}
+	crossMiniblockHash := []byte{0xcc}
+	crossMiniblock := &block.MiniBlock{
+		Type:            block.TxBlock,
+		TxHashes:        [][]byte{txHash},
+		SenderShardID:   0,
+		ReceiverShardID: 1,
+	}
+	crossMiniblockHeader := block.MiniBlockHeader{
+		Hash:            crossMiniblockHash,
+		Type:            block.TxBlock,
+		TxCount:         1,
+		SenderShardID:   0,
+		ReceiverShardID: 1,
+	}
+	// An intra-shard miniblock of shard 0, whose body is not available in the metachain storage
+	missingMiniblockHash := []byte{0xdd}
+
+	shard0HeaderHash := []byte{0xa0}
+	shard0Header := &block.HeaderV2{
+		Header: &block.Header{
+			Nonce:   100,
+			ShardID: 0,
+			Epoch:   testEpoch,
+			MiniBlockHeaders: []block.MiniBlockHeader{
+				crossMiniblockHeader,
+				{Hash: missingMiniblockHash, Type: block.TxBlock, TxCount: 1},
+			},
+			AccumulatedFees: big.NewInt(0),
+			DeveloperFees:   big.NewInt(0),
+		},
+	}
+	// Notarized by an epoch start meta block, so it belongs to the previous epoch
+	shard1HeaderHash := []byte{0xa1}
+	shard1Header := &block.HeaderV2{
+		Header: &block.Header{
+			Nonce:            200,
+			ShardID:          1,
+			Epoch:            testEpoch - 1,
+			MiniBlockHeaders: []block.MiniBlockHeader{crossMiniblockHeader},
+			AccumulatedFees:  big.NewInt(0),
+			DeveloperFees:    big.NewInt(0),
+		},
+	}
+
+	metablockHash := []byte{0xaa, 0xbb}
+	metablock := &block.MetaBlock{
+		Nonce: testNonce,
+		Epoch: testEpoch,
+		ShardInfo: []block.ShardData{
+			{HeaderHash: shard0HeaderHash, ShardID: 0, Nonce: 100},
+			{HeaderHash: shard1HeaderHash, ShardID: 1, Nonce: 200},
+		},
+		AccumulatedFees:        big.NewInt(0),
+		DeveloperFees:          big.NewInt(0),
+		AccumulatedFeesInEpoch: big.NewInt(0),
+		DevFeesInEpoch:         big.NewInt(0),
+	}
+
+	crossMiniblockBytes, _ := marshalizer.Marshal(crossMiniblock)
+	shard0HeaderBytes, _ := marshalizer.Marshal(shard0Header)
+	shard1HeaderBytes, _ := marshalizer.Marshal(shard1Header)
+	metablockBytes, _ := marshalizer.Marshal(metablock)
+	metablockNonceBytes := mock.NewNonceHashConverterMock().ToByteSlice(testNonce)
+	_ = storageService.Miniblocks.PutInEpoch(crossMiniblockHash, crossMiniblockBytes, testEpoch)
+	_ = storageService.Miniblocks.PutInEpoch(crossMiniblockHash, crossMiniblockBytes, testEpoch-1)
+	_ = storageService.Transactions.PutInEpoch(txHash, []byte("tx"), testEpoch)
+	_ = storageService.Transactions.PutInEpoch(txHash, []byte("tx"), testEpoch-1)
+	_ = storageService.BlockHeaders.PutInEpoch(shard0HeaderHash, shard0HeaderBytes, testEpoch)
+	_ = storageService.BlockHeaders.PutInEpoch(shard1HeaderHash, shard1HeaderBytes, testEpoch-1)
+	_ = storageService.Metablocks.PutInEpoch(metablockHash, metablockBytes, testEpoch)
+	_ = storageService.MetaHdrNonce.PutInEpoch(metablockNonceBytes, metablockHash, testEpoch)
+
+	t.Run("without transactions", func(t *testing.T) {
+		hyperblock, err := processor.GetHyperblockByNonce(testNonce, api.BlockQueryOptions{})
+		require.Nil(t, err)
+		require.Equal(t, testNonce, hyperblock.MetaBlock.Nonce)
+		require.Equal(t, core.MetachainShardId, hyperblock.MetaBlock.Shard)
+		require.Len(t, hyperblock.ShardBlocks, 2)
+		require.Equal(t, uint64(100), hyperblock.ShardBlocks[0].Nonce)
+		require.Equal(t, hex.EncodeToString(shard1HeaderHash), hyperblock.ShardBlocks[1].Hash)
+		require.Equal(t, uint32(2), hyperblock.ShardBlocks[0].NumTxs)
+		require.Empty(t, hyperblock.Transactions)
+		require.Empty(t, hyperblock.IncompleteMiniBlocks)
+	})
+
+	t.Run("with transactions", func(t *testing.T) {
+		hyperblock, err := processor.GetHyperblockByNonce(testNonce, api.BlockQueryOptions{WithTransactions: true})
+		require.Nil(t, err)
+		require.Len(t, hyperblock.ShardBlocks, 2)
+		require.Equal(t, []string{hex.EncodeToString(missingMiniblockHash)}, hyperblock.IncompleteMiniBlocks)
+
+		require.Len(t, hyperblock.Transactions, 1)
+		require.Equal(t, hex.EncodeToString(txHash), hyperblock.Transactions[0].Hash)
+		// the destination shard occurrence is kept
+		require.True(t, hyperblock.Transactions[0] == hyperblock.ShardBlocks[1].MiniBlocks[0].Transactions[0])
+		require.Equal(t, testEpoch-1, hyperblock.Transactions[0].Epoch)
+	})
+
+	t.Run("missing notarized block should error", func(t *testing.T) {
+		_ = storageService.BlockHeaders.Remove(shard0HeaderHash)
+
+		hyperblock, err := processor.GetHyperblockByNonce(testNonce, api.BlockQueryOptions{})
+		require.ErrorIs(t, err, errCannotLoadNotarizedBlock)
+		require.Nil(t, hyperblock)
+	})
+}
@@ -11,6 +11,9 @@ var ErrNilStatusMetrics = errors.New("nil status metrics handler")
 // ErrNilTransactionCostHandler signals that a nil transaction cost handler was provided
 var ErrNilTransactionCostHandler = errors.New("nil transaction cost handler")
 
+// ErrNilTransactionGasEstimator signals that a nil transaction gas estimator was provided
+var ErrNilTransactionGasEstimator = errors.New("nil transaction gas estimator")
+
 // ErrNilTotalStakedValueHandler signals that a nil total staked value handler has been provided
 var ErrNilTotalStakedValueHandler = errors.New("nil total staked value handler")
 
@@ -40,3 +43,18 @@ var ErrNilAccountsParser = errors.New("nil accounts parser")
 
 // ErrNilGasScheduler signals that a nil gas scheduler has been provided
 var ErrNilGasScheduler = errors.New("nil gas scheduler")
+
+// ErrNilLogsFacade signals that a nil logs facade has been provided
+var ErrNilLogsFacade = errors.New("nil logs facade")
+
+// ErrNilESDTHoldersHandler signals that a nil ESDT holders handler has been provided
+var ErrNilESDTHoldersHandler = errors.New("nil ESDT holders handler")
+
+// ErrNilHistoricalTransactionSimulator signals that a nil historical transaction simulator has been provided
+var ErrNilHistoricalTransactionSimulator = errors.New("nil historical transaction simulator")
+
+// ErrTransactionTraceNotSupported signals that the execution trace is not supported for the type of the transaction
+var ErrTransactionTraceNotSupported = errors.New("execution trace is supported only for regular transactions")
+
+// ErrTransactionBlockNotKnown signals that the block of a transaction is not known. Finding it requires the db lookup extensions
+var ErrTransactionBlockNotKnown = errors.New("the block of the transaction is not known")
@@ -2,11 +2,13 @@ package external
 
 import (
 	"context"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/process"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
@@ -37,6 +39,21 @@ type TransactionCostHandler interface {
 	IsInterfaceNil() bool
 }
 
+// TransactionGasEstimator defines the actions which should be handled by a transaction gas estimator able to split
+// the estimated gas by its purpose
+type TransactionGasEstimator interface {
+	EstimateTransactionGas(tx *transaction.Transaction) (*txSimData.GasEstimation, error)
+	EstimateSmartContractResultGas(scr *transaction.ApiSmartContractResult) (*txSimData.GasEstimation, error)
+	IsInterfaceNil() bool
+}
+
+// HistoricalTransactionSimulator defines the actions which should be handled by a transaction simulator able to replay
+// a transaction on a historical state
+type HistoricalTransactionSimulator interface {
+	ProcessHistoricalTx(tx *transaction.Transaction, options api.AccountQueryOptions) (*txSimData.SimulationResults, error)
+	IsInterfaceNil() bool
+}
+
 // TotalStakedValueHandler defines the behavior of a component able to return total staked value
 type TotalStakedValueHandler interface {
 	GetTotalStakedValue(ctx context.Context) (*api.StakeValues, error)
@@ -55,15 +72,33 @@ type DelegatedListHandler interface {
 	IsInterfaceNil() bool
 }
 
+// ESDTHoldersHandler defines the behavior of a component able to return the holders of an ESDT token
+type ESDTHoldersHandler interface {
+	GetESDTHolders(options common.ESDTHoldersQueryOptions, ctx context.Context) (*common.ESDTHoldersApiResponse, error)
+	IsInterfaceNil() bool
+}
+
 // APITransactionHandler defines what an API transaction handler should be able to do
 type APITransactionHandler interface {
 	GetTransaction(txHash string, withResults bool) (*transaction.ApiTransactionResult, error)
-	GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error)
+	GetTransactionStateDiff(txHash string) ([]*txSimData.AccountStateDiff, error)
+	WaitForTransactionStatus(txHash string, status string, timeout time.Duration) (*common.TransactionStatusWaitApiResponse, error)
+	GetTransactionsPool(options common.TransactionsPoolQueryOptions) (*common.TransactionsPoolAPIResponse, error)
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionsPoolReplacementsForSender(sender string) (*common.TransactionsPoolReplacementsForSenderApiResponse, error)
+	GetTransactionsPoolStatsForSender(sender string) (*common.TransactionsPoolSenderStatsApiResponse, error)
+	GetTransactionsPoolEvictions() (*common.TransactionsPoolEvictionsApiResponse, error)
+	GetTransactionsByAddress(address string, options common.AddressTransactionsQueryOptions) (*common.AddressTransactionsApiResponse, error)
 	UnmarshalTransaction(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error)
 	PopulateComputedFields(tx *transaction.ApiTransactionResult)
 	UnmarshalReceipt(receiptBytes []byte) (*transaction.ApiReceipt, error)
 	IsInterfaceNil() bool
 }
+
+// LogsFacade defines what a logs facade should be able to do
+type LogsFacade interface {
+	GetLogEvents(options common.LogEventsQueryOptions) (*common.LogEventsApiResponse, error)
+	IsInterfaceNil() bool
+}
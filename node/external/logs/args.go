@@ -5,13 +5,15 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
+	"github.com/ElrondNetwork/elrond-go/dblookupext"
 )
 
 // ArgsNewLogsFacade holds the arguments for constructing a logsFacade
 type ArgsNewLogsFacade struct {
-	StorageService  dataRetriever.StorageService
-	Marshaller      marshal.Marshalizer
-	PubKeyConverter core.PubkeyConverter
+	StorageService    dataRetriever.StorageService
+	Marshaller        marshal.Marshalizer
+	PubKeyConverter   core.PubkeyConverter
+	HistoryRepository dblookupext.HistoryRepository
 }
 
 func (args *ArgsNewLogsFacade) check() error {
@@ -24,6 +26,9 @@ func (args *ArgsNewLogsFacade) check() error {
 	if check.IfNil(args.PubKeyConverter) {
 		return core.ErrNilPubkeyConverter
 	}
+	if check.IfNil(args.HistoryRepository) {
+		return errNilHistoryRepository
+	}
 
 	return nil
 }
@@ -5,3 +5,7 @@ import "errors"
 var errCannotCreateLogsFacade = errors.New("cannot create logs facade")
 var errCannotLoadLogs = errors.New("cannot load log(s)")
 var errCannotUnmarshalLog = errors.New("cannot unmarshal log")
+var errNilHistoryRepository = errors.New("nil history repository")
+var errInvalidAddress = errors.New("invalid address")
+var errInvalidBlockRange = errors.New("invalid block range")
+var errCannotLoadLogEvents = errors.New("cannot load log events")
@@ -0,0 +1,37 @@
+package logs
+
+import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go/dblookupext"
+)
+
+// logEventsFilter matches the indexed log events against the filters of a query. Empty filters match any event.
+type logEventsFilter struct {
+	address    []byte
+	identifier []byte
+	topic      []byte
+}
+
+func (filter *logEventsFilter) matches(event *dblookupext.IndexedLogEvent) bool {
+	if event == nil {
+		return false
+	}
+	if len(filter.address) > 0 && !bytes.Equal(filter.address, event.Address) {
+		return false
+	}
+	if len(filter.identifier) > 0 && !bytes.Equal(filter.identifier, event.Identifier) {
+		return false
+	}
+	if len(filter.topic) == 0 {
+		return true
+	}
+
+	for _, topic := range event.Topics {
+		if bytes.Equal(filter.topic, topic) {
+			return true
+		}
+	}
+
+	return false
+}
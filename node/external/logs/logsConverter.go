@@ -1,8 +1,12 @@
 package logs
 
 import (
+	"encoding/hex"
+
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dblookupext"
 )
 
 type logsConverter struct {
@@ -33,6 +37,21 @@ func (converter *logsConverter) txLogToApiResource(logKey []byte, log *transacti
 	}
 }
 
+func (converter *logsConverter) indexedLogEventToApiResource(record *dblookupext.LogEventsByBlock, event *dblookupext.IndexedLogEvent) *common.LogEventApiResponse {
+	return &common.LogEventApiResponse{
+		TxHash:     hex.EncodeToString(event.TxHash),
+		BlockNonce: record.BlockNonce,
+		BlockHash:  hex.EncodeToString(record.BlockHash),
+		Round:      record.Round,
+		Epoch:      record.Epoch,
+		Order:      event.Order,
+		Address:    converter.encodeAddress(event.Address),
+		Identifier: string(event.Identifier),
+		Topics:     event.Topics,
+		Data:       event.Data,
+	}
+}
+
 func (converter *logsConverter) encodeAddress(pubkey []byte) string {
 	return converter.pubKeyConverter.Encode(pubkey)
 }
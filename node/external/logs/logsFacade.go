@@ -1,17 +1,27 @@
 package logs
 
 import (
+	"errors"
 	"fmt"
 
+	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/storage"
 )
 
 var log = logger.GetOrCreate("node/external/logs")
 
+// MaxNumBlocksInLogEventsQuery is the maximum number of blocks that can be scanned by a single log events query
+const MaxNumBlocksInLogEventsQuery = 1000
+
 type logsFacade struct {
-	repository *logsRepository
-	converter  *logsConverter
+	repository        *logsRepository
+	converter         *logsConverter
+	historyRepository dblookupext.HistoryRepository
+	pubKeyConverter   core.PubkeyConverter
 }
 
 // NewLogsFacade creates a new logs facade
@@ -25,8 +35,10 @@ func NewLogsFacade(args ArgsNewLogsFacade) (*logsFacade, error) {
 	converter := newLogsConverter(args.PubKeyConverter)
 
 	return &logsFacade{
-		repository: repository,
-		converter:  converter,
+		repository:        repository,
+		converter:         converter,
+		historyRepository: args.HistoryRepository,
+		pubKeyConverter:   args.PubKeyConverter,
 	}, nil
 }
 
@@ -60,6 +72,65 @@ func (facade *logsFacade) IncludeLogsInTransactions(txs []*transaction.ApiTransa
 	return nil
 }
 
+// GetLogEvents scans the log events index over the requested block range and returns the events matching the provided filters,
+// in block and execution order. The blocks that are not found in the index (e.g. from pruned epochs) are skipped.
+func (facade *logsFacade) GetLogEvents(options common.LogEventsQueryOptions) (*common.LogEventsApiResponse, error) {
+	if options.ToNonce < options.FromNonce {
+		return nil, fmt.Errorf("%w: toNonce must not be lower than fromNonce", errInvalidBlockRange)
+	}
+	if options.ToNonce-options.FromNonce >= MaxNumBlocksInLogEventsQuery {
+		return nil, fmt.Errorf("%w: at most %d blocks can be queried at once", errInvalidBlockRange, MaxNumBlocksInLogEventsQuery)
+	}
+
+	filter, err := facade.createLogEventsFilter(options)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &common.LogEventsApiResponse{
+		FromNonce: options.FromNonce,
+		ToNonce:   options.ToNonce,
+		Events:    make([]*common.LogEventApiResponse, 0),
+	}
+
+	for nonce := options.FromNonce; nonce <= options.ToNonce; nonce++ {
+		record, errGet := facade.historyRepository.GetLogEventsByBlockNonce(nonce)
+		if errors.Is(errGet, storage.ErrKeyNotFound) {
+			log.Trace("logsFacade.GetLogEvents(): block not found in the log events index", "nonce", nonce)
+			continue
+		}
+		if errGet != nil {
+			return nil, fmt.Errorf("%w: %v, nonce = %d", errCannotLoadLogEvents, errGet, nonce)
+		}
+
+		for _, event := range record.Events {
+			if filter.matches(event) {
+				response.Events = append(response.Events, facade.converter.indexedLogEventToApiResource(record, event))
+			}
+		}
+	}
+
+	return response, nil
+}
+
+func (facade *logsFacade) createLogEventsFilter(options common.LogEventsQueryOptions) (*logEventsFilter, error) {
+	filter := &logEventsFilter{
+		identifier: []byte(options.Identifier),
+		topic:      options.Topic,
+	}
+
+	if len(options.Address) > 0 {
+		address, err := facade.pubKeyConverter.Decode(options.Address)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", errInvalidAddress, err)
+		}
+
+		filter.address = address
+	}
+
+	return filter, nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (facade *logsFacade) IsInterfaceNil() bool {
 	return facade == nil
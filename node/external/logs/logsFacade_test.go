@@ -1,13 +1,18 @@
 package logs
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
+	dblookupextMock "github.com/ElrondNetwork/elrond-go/testscommon/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
 	"github.com/stretchr/testify/require"
 )
@@ -15,9 +20,10 @@ import (
 func TestNewLogsFacade(t *testing.T) {
 	t.Run("NilStorageService", func(t *testing.T) {
 		arguments := ArgsNewLogsFacade{
-			StorageService:  nil,
-			Marshaller:      testscommon.MarshalizerMock{},
-			PubKeyConverter: testscommon.NewPubkeyConverterMock(32),
+			StorageService:    nil,
+			Marshaller:        testscommon.MarshalizerMock{},
+			PubKeyConverter:   testscommon.NewPubkeyConverterMock(32),
+			HistoryRepository: &dblookupextMock.HistoryRepositoryStub{},
 		}
 
 		facade, err := NewLogsFacade(arguments)
@@ -28,9 +34,10 @@ func TestNewLogsFacade(t *testing.T) {
 
 	t.Run("NilMarshaller", func(t *testing.T) {
 		arguments := ArgsNewLogsFacade{
-			StorageService:  genericMocks.NewChainStorerMock(7),
-			Marshaller:      nil,
-			PubKeyConverter: testscommon.NewPubkeyConverterMock(32),
+			StorageService:    genericMocks.NewChainStorerMock(7),
+			Marshaller:        nil,
+			PubKeyConverter:   testscommon.NewPubkeyConverterMock(32),
+			HistoryRepository: &dblookupextMock.HistoryRepositoryStub{},
 		}
 
 		facade, err := NewLogsFacade(arguments)
@@ -41,9 +48,10 @@ func TestNewLogsFacade(t *testing.T) {
 
 	t.Run("NilPubKeyConverter", func(t *testing.T) {
 		arguments := ArgsNewLogsFacade{
-			StorageService:  genericMocks.NewChainStorerMock(7),
-			Marshaller:      testscommon.MarshalizerMock{},
-			PubKeyConverter: nil,
+			StorageService:    genericMocks.NewChainStorerMock(7),
+			Marshaller:        testscommon.MarshalizerMock{},
+			PubKeyConverter:   nil,
+			HistoryRepository: &dblookupextMock.HistoryRepositoryStub{},
 		}
 
 		facade, err := NewLogsFacade(arguments)
@@ -51,6 +59,20 @@ func TestNewLogsFacade(t *testing.T) {
 		require.ErrorContains(t, err, core.ErrNilPubkeyConverter.Error())
 		require.True(t, check.IfNil(facade))
 	})
+
+	t.Run("NilHistoryRepository", func(t *testing.T) {
+		arguments := ArgsNewLogsFacade{
+			StorageService:    genericMocks.NewChainStorerMock(7),
+			Marshaller:        testscommon.MarshalizerMock{},
+			PubKeyConverter:   testscommon.NewPubkeyConverterMock(32),
+			HistoryRepository: nil,
+		}
+
+		facade, err := NewLogsFacade(arguments)
+		require.ErrorIs(t, err, errCannotCreateLogsFacade)
+		require.ErrorContains(t, err, errNilHistoryRepository.Error())
+		require.True(t, check.IfNil(facade))
+	})
 }
 
 func TestLogsFacade_GetLogShouldWork(t *testing.T) {
@@ -58,9 +80,10 @@ func TestLogsFacade_GetLogShouldWork(t *testing.T) {
 	marshaller := &marshal.GogoProtoMarshalizer{}
 
 	arguments := ArgsNewLogsFacade{
-		StorageService:  storageService,
-		Marshaller:      marshaller,
-		PubKeyConverter: testscommon.NewPubkeyConverterMock(32),
+		StorageService:    storageService,
+		Marshaller:        marshaller,
+		PubKeyConverter:   testscommon.NewPubkeyConverterMock(32),
+		HistoryRepository: &dblookupextMock.HistoryRepositoryStub{},
 	}
 
 	testLog := &transaction.Log{
@@ -96,9 +119,10 @@ func TestLogsFacade_IncludeLogsInTransactionsShouldWork(t *testing.T) {
 	marshaller := &marshal.GogoProtoMarshalizer{}
 
 	arguments := ArgsNewLogsFacade{
-		StorageService:  storageService,
-		Marshaller:      marshaller,
-		PubKeyConverter: testscommon.NewPubkeyConverterMock(32),
+		StorageService:    storageService,
+		Marshaller:        marshaller,
+		PubKeyConverter:   testscommon.NewPubkeyConverterMock(32),
+		HistoryRepository: &dblookupextMock.HistoryRepositoryStub{},
 	}
 
 	facade, _ := NewLogsFacade(arguments)
@@ -144,3 +168,109 @@ func TestLogsFacade_IncludeLogsInTransactionsShouldWork(t *testing.T) {
 	require.Nil(t, transactions[2].Logs)
 	require.Equal(t, "fourth", transactions[3].Logs.Events[0].Identifier)
 }
+
+func TestLogsFacade_GetLogEvents(t *testing.T) {
+	records := map[uint64]*dblookupext.LogEventsByBlock{
+		10: {
+			BlockHash:  []byte{0x10},
+			BlockNonce: 10,
+			Events: []*dblookupext.IndexedLogEvent{
+				{TxHash: []byte{0xaa}, Address: []byte{0xab}, Identifier: []byte("ESDTTransfer"), Topics: [][]byte{[]byte("TKN"), {0xcd}}, Order: 0},
+				{TxHash: []byte{0xaa}, Address: []byte{0xab}, Identifier: []byte("writeLog"), Order: 1},
+			},
+		},
+		// nonce 11 not found (e.g. not yet indexed)
+		12: {
+			BlockHash:  []byte{0x12},
+			BlockNonce: 12,
+			Epoch:      1,
+			Events: []*dblookupext.IndexedLogEvent{
+				{TxHash: []byte{0xbb}, Address: []byte{0xef}, Identifier: []byte("ESDTTransfer"), Topics: [][]byte{[]byte("OTHER"), {0xab}}, Order: 0},
+			},
+		},
+	}
+	historyRepository := &dblookupextMock.HistoryRepositoryStub{
+		GetLogEventsByBlockNonceCalled: func(blockNonce uint64) (*dblookupext.LogEventsByBlock, error) {
+			record, ok := records[blockNonce]
+			if !ok {
+				return nil, storage.ErrKeyNotFound
+			}
+
+			return record, nil
+		},
+	}
+
+	arguments := ArgsNewLogsFacade{
+		StorageService:    genericMocks.NewChainStorerMock(7),
+		Marshaller:        testscommon.MarshalizerMock{},
+		PubKeyConverter:   testscommon.NewPubkeyConverterMock(1),
+		HistoryRepository: historyRepository,
+	}
+	facade, _ := NewLogsFacade(arguments)
+
+	t.Run("no filters should return all events in order", func(t *testing.T) {
+		response, err := facade.GetLogEvents(common.LogEventsQueryOptions{FromNonce: 9, ToNonce: 13})
+		require.Nil(t, err)
+		require.Equal(t, uint64(9), response.FromNonce)
+		require.Equal(t, uint64(13), response.ToNonce)
+		require.Len(t, response.Events, 3)
+		require.Equal(t, &common.LogEventApiResponse{
+			TxHash:     "aa",
+			BlockNonce: 10,
+			BlockHash:  "10",
+			Order:      1,
+			Address:    "ab",
+			Identifier: "writeLog",
+		}, response.Events[1])
+		require.Equal(t, "bb", response.Events[2].TxHash)
+		require.Equal(t, uint32(1), response.Events[2].Epoch)
+	})
+
+	t.Run("filters should apply", func(t *testing.T) {
+		response, err := facade.GetLogEvents(common.LogEventsQueryOptions{Identifier: "ESDTTransfer", FromNonce: 10, ToNonce: 12})
+		require.Nil(t, err)
+		require.Len(t, response.Events, 2)
+
+		response, err = facade.GetLogEvents(common.LogEventsQueryOptions{Address: "ab", FromNonce: 10, ToNonce: 12})
+		require.Nil(t, err)
+		require.Len(t, response.Events, 2)
+		require.Equal(t, "aa", response.Events[0].TxHash)
+
+		response, err = facade.GetLogEvents(common.LogEventsQueryOptions{Topic: []byte{0xab}, FromNonce: 10, ToNonce: 12})
+		require.Nil(t, err)
+		require.Len(t, response.Events, 1)
+		require.Equal(t, "bb", response.Events[0].TxHash)
+
+		response, err = facade.GetLogEvents(common.LogEventsQueryOptions{Address: "ab", Identifier: "ESDTTransfer", Topic: []byte("OTHER"), FromNonce: 10, ToNonce: 12})
+		require.Nil(t, err)
+		require.Empty(t, response.Events)
+	})
+
+	t.Run("invalid block range should error", func(t *testing.T) {
+		response, err := facade.GetLogEvents(common.LogEventsQueryOptions{FromNonce: 10, ToNonce: 9})
+		require.ErrorIs(t, err, errInvalidBlockRange)
+		require.Nil(t, response)
+
+		response, err = facade.GetLogEvents(common.LogEventsQueryOptions{FromNonce: 10, ToNonce: 10 + MaxNumBlocksInLogEventsQuery})
+		require.ErrorIs(t, err, errInvalidBlockRange)
+		require.Nil(t, response)
+	})
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		response, err := facade.GetLogEvents(common.LogEventsQueryOptions{Address: "not hex", FromNonce: 10, ToNonce: 10})
+		require.ErrorIs(t, err, errInvalidAddress)
+		require.Nil(t, response)
+	})
+
+	t.Run("index error should be returned", func(t *testing.T) {
+		expectedErr := errors.New("index is disabled")
+		historyRepository.GetLogEventsByBlockNonceCalled = func(blockNonce uint64) (*dblookupext.LogEventsByBlock, error) {
+			return nil, expectedErr
+		}
+
+		response, err := facade.GetLogEvents(common.LogEventsQueryOptions{FromNonce: 10, ToNonce: 10})
+		require.ErrorIs(t, err, errCannotLoadLogEvents)
+		require.ErrorContains(t, err, expectedErr.Error())
+		require.Nil(t, response)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"math/big"
+	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
@@ -13,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/genesis"
 	"github.com/ElrondNetwork/elrond-go/node/external/blockAPI"
 	"github.com/ElrondNetwork/elrond-go/process"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/sharding/nodesCoordinator"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
@@ -23,6 +25,8 @@ type ArgNodeApiResolver struct {
 	SCQueryService           SCQueryService
 	StatusMetricsHandler     StatusMetricsHandler
 	TxCostHandler            TransactionCostHandler
+	TxGasEstimator           TransactionGasEstimator
+	HistoricalTxSimulator    HistoricalTransactionSimulator
 	TotalStakedValueHandler  TotalStakedValueHandler
 	DirectStakedListHandler  DirectStakedListHandler
 	DelegatedListHandler     DelegatedListHandler
@@ -33,6 +37,8 @@ type ArgNodeApiResolver struct {
 	ValidatorPubKeyConverter core.PubkeyConverter
 	AccountsParser           genesis.AccountsParser
 	GasScheduleNotifier      common.GasScheduleNotifierAPI
+	LogsFacade               LogsFacade
+	ESDTHoldersHandler       ESDTHoldersHandler
 }
 
 // nodeApiResolver can resolve API requests
@@ -40,6 +46,8 @@ type nodeApiResolver struct {
 	scQueryService           SCQueryService
 	statusMetricsHandler     StatusMetricsHandler
 	txCostHandler            TransactionCostHandler
+	txGasEstimator           TransactionGasEstimator
+	historicalTxSimulator    HistoricalTransactionSimulator
 	totalStakedValueHandler  TotalStakedValueHandler
 	directStakedListHandler  DirectStakedListHandler
 	delegatedListHandler     DelegatedListHandler
@@ -50,6 +58,8 @@ type nodeApiResolver struct {
 	validatorPubKeyConverter core.PubkeyConverter
 	accountsParser           genesis.AccountsParser
 	gasScheduleNotifier      common.GasScheduleNotifierAPI
+	logsFacade               LogsFacade
+	esdtHoldersHandler       ESDTHoldersHandler
 }
 
 // NewNodeApiResolver creates a new nodeApiResolver instance
@@ -63,6 +73,12 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 	if check.IfNil(arg.TxCostHandler) {
 		return nil, ErrNilTransactionCostHandler
 	}
+	if check.IfNil(arg.TxGasEstimator) {
+		return nil, ErrNilTransactionGasEstimator
+	}
+	if check.IfNil(arg.HistoricalTxSimulator) {
+		return nil, ErrNilHistoricalTransactionSimulator
+	}
 	if check.IfNil(arg.TotalStakedValueHandler) {
 		return nil, ErrNilTotalStakedValueHandler
 	}
@@ -93,11 +109,19 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 	if check.IfNil(arg.GasScheduleNotifier) {
 		return nil, ErrNilGasScheduler
 	}
+	if check.IfNil(arg.LogsFacade) {
+		return nil, ErrNilLogsFacade
+	}
+	if check.IfNil(arg.ESDTHoldersHandler) {
+		return nil, ErrNilESDTHoldersHandler
+	}
 
 	return &nodeApiResolver{
 		scQueryService:           arg.SCQueryService,
 		statusMetricsHandler:     arg.StatusMetricsHandler,
 		txCostHandler:            arg.TxCostHandler,
+		txGasEstimator:           arg.TxGasEstimator,
+		historicalTxSimulator:    arg.HistoricalTxSimulator,
 		totalStakedValueHandler:  arg.TotalStakedValueHandler,
 		directStakedListHandler:  arg.DirectStakedListHandler,
 		delegatedListHandler:     arg.DelegatedListHandler,
@@ -108,6 +132,8 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 		validatorPubKeyConverter: arg.ValidatorPubKeyConverter,
 		accountsParser:           arg.AccountsParser,
 		gasScheduleNotifier:      arg.GasScheduleNotifier,
+		logsFacade:               arg.LogsFacade,
+		esdtHoldersHandler:       arg.ESDTHoldersHandler,
 	}, nil
 }
 
@@ -126,6 +152,17 @@ func (nar *nodeApiResolver) ComputeTransactionGasLimit(tx *transaction.Transacti
 	return nar.txCostHandler.ComputeTransactionGasLimit(tx)
 }
 
+// EstimateTransactionGas will estimate the gas needed by a transaction, along with its breakdown
+func (nar *nodeApiResolver) EstimateTransactionGas(tx *transaction.Transaction) (*txSimData.GasEstimation, error) {
+	return nar.txGasEstimator.EstimateTransactionGas(tx)
+}
+
+// EstimateSmartContractResultGas will estimate the gas consumed by a smart contract result received from another shard,
+// along with its breakdown
+func (nar *nodeApiResolver) EstimateSmartContractResultGas(scr *transaction.ApiSmartContractResult) (*txSimData.GasEstimation, error) {
+	return nar.txGasEstimator.EstimateSmartContractResultGas(scr)
+}
+
 // Close closes all underlying components
 func (nar *nodeApiResolver) Close() error {
 	return nar.scQueryService.Close()
@@ -146,14 +183,84 @@ func (nar *nodeApiResolver) GetDelegatorsList(ctx context.Context) ([]*api.Deleg
 	return nar.delegatedListHandler.GetDelegatorsList(ctx)
 }
 
+// GetESDTHolders will return the holders of the provided ESDT token
+func (nar *nodeApiResolver) GetESDTHolders(options common.ESDTHoldersQueryOptions, ctx context.Context) (*common.ESDTHoldersApiResponse, error) {
+	return nar.esdtHoldersHandler.GetESDTHolders(options, ctx)
+}
+
 // GetTransaction will return the transaction with the given hash and optionally with results
 func (nar *nodeApiResolver) GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
 	return nar.apiTransactionHandler.GetTransaction(hash, withResults)
 }
 
+// GetTransactionStateDiff will return the state diff recorded for the transaction with the given hash
+func (nar *nodeApiResolver) GetTransactionStateDiff(hash string) ([]*txSimData.AccountStateDiff, error) {
+	return nar.apiTransactionHandler.GetTransactionStateDiff(hash)
+}
+
+// GetTransactionTrace will replay a processed transaction on the state from before its block and will return the
+// results, including the execution trace. The transactions processed before it in the same block are not replayed
+func (nar *nodeApiResolver) GetTransactionTrace(hash string) (*txSimData.SimulationResults, error) {
+	apiTx, err := nar.apiTransactionHandler.GetTransaction(hash, false)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, ok := apiTx.Tx.(*transaction.Transaction)
+	if !ok {
+		return nil, ErrTransactionTraceNotSupported
+	}
+	if len(apiTx.BlockHash) == 0 {
+		return nil, ErrTransactionBlockNotKnown
+	}
+
+	options, err := nar.getAccountQueryOptionsBeforeBlock(apiTx.BlockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := nar.historicalTxSimulator.ProcessHistoricalTx(tx, options)
+	if err != nil {
+		return nil, err
+	}
+	results.Hash = hash
+
+	return results, nil
+}
+
+// getAccountQueryOptionsBeforeBlock returns the options for reading the accounts at the state root hash of the block
+// preceding the one with the provided hash
+func (nar *nodeApiResolver) getAccountQueryOptionsBeforeBlock(blockHash string) (api.AccountQueryOptions, error) {
+	apiBlock, err := nar.GetBlockByHash(blockHash, api.BlockQueryOptions{})
+	if err != nil {
+		return api.AccountQueryOptions{}, err
+	}
+
+	previousBlock, err := nar.GetBlockByHash(apiBlock.PrevBlockHash, api.BlockQueryOptions{})
+	if err != nil {
+		return api.AccountQueryOptions{}, err
+	}
+
+	rootHash, err := hex.DecodeString(previousBlock.StateRootHash)
+	if err != nil {
+		return api.AccountQueryOptions{}, err
+	}
+
+	return api.AccountQueryOptions{
+		BlockRootHash: rootHash,
+		HintEpoch:     core.OptionalUint32{Value: previousBlock.Epoch, HasValue: true},
+	}, nil
+}
+
+// WaitForTransactionStatus will wait until the transaction with the given hash reaches the provided status, or until
+// its outcome becomes final, but no longer than the provided timeout
+func (nar *nodeApiResolver) WaitForTransactionStatus(hash string, status string, timeout time.Duration) (*common.TransactionStatusWaitApiResponse, error) {
+	return nar.apiTransactionHandler.WaitForTransactionStatus(hash, status, timeout)
+}
+
 // GetTransactionsPool will return a structure containing the transactions pool that is to be returned on API calls
-func (nar *nodeApiResolver) GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error) {
-	return nar.apiTransactionHandler.GetTransactionsPool(fields)
+func (nar *nodeApiResolver) GetTransactionsPool(options common.TransactionsPoolQueryOptions) (*common.TransactionsPoolAPIResponse, error) {
+	return nar.apiTransactionHandler.GetTransactionsPool(options)
 }
 
 // GetTransactionsPoolForSender will return a structure containing the transactions for sender that is to be returned on API calls
@@ -171,6 +278,31 @@ func (nar *nodeApiResolver) GetTransactionsPoolNonceGapsForSender(sender string)
 	return nar.apiTransactionHandler.GetTransactionsPoolNonceGapsForSender(sender)
 }
 
+// GetTransactionsPoolStatsForSender will return the statistics of the sender's transactions from pool, that is to be returned on API calls
+func (nar *nodeApiResolver) GetTransactionsPoolStatsForSender(sender string) (*common.TransactionsPoolSenderStatsApiResponse, error) {
+	return nar.apiTransactionHandler.GetTransactionsPoolStatsForSender(sender)
+}
+
+// GetTransactionsPoolEvictions will return the recent eviction rounds of the transactions pool, that is to be returned on API calls
+func (nar *nodeApiResolver) GetTransactionsPoolEvictions() (*common.TransactionsPoolEvictionsApiResponse, error) {
+	return nar.apiTransactionHandler.GetTransactionsPoolEvictions()
+}
+
+// GetTransactionsPoolReplacementsForSender will return the recent replacements by fee of the transactions of the sender, that is to be returned on API calls
+func (nar *nodeApiResolver) GetTransactionsPoolReplacementsForSender(sender string) (*common.TransactionsPoolReplacementsForSenderApiResponse, error) {
+	return nar.apiTransactionHandler.GetTransactionsPoolReplacementsForSender(sender)
+}
+
+// GetTransactionsByAddress will return the transactions involving the given address, as recorded by the transactions by address index
+func (nar *nodeApiResolver) GetTransactionsByAddress(address string, options common.AddressTransactionsQueryOptions) (*common.AddressTransactionsApiResponse, error) {
+	return nar.apiTransactionHandler.GetTransactionsByAddress(address, options)
+}
+
+// GetLogEvents will return the log events matching the provided query, as recorded by the log events index
+func (nar *nodeApiResolver) GetLogEvents(options common.LogEventsQueryOptions) (*common.LogEventsApiResponse, error) {
+	return nar.logsFacade.GetLogEvents(options)
+}
+
 // GetBlockByHash will return the block with the given hash and optionally with transactions
 func (nar *nodeApiResolver) GetBlockByHash(hash string, options api.BlockQueryOptions) (*api.Block, error) {
 	decodedHash, err := hex.DecodeString(hash)
@@ -191,6 +323,11 @@ func (nar *nodeApiResolver) GetBlockByRound(round uint64, options api.BlockQuery
 	return nar.apiBlockHandler.GetBlockByRound(round, options)
 }
 
+// GetHyperblockByNonce will return the hyperblock with the given meta nonce and optionally with transactions
+func (nar *nodeApiResolver) GetHyperblockByNonce(nonce uint64, options api.BlockQueryOptions) (*common.ApiHyperblock, error) {
+	return nar.apiBlockHandler.GetHyperblockByNonce(nonce, options)
+}
+
 // GetInternalMetaBlockByHash will return a meta block by hash
 func (nar *nodeApiResolver) GetInternalMetaBlockByHash(format common.ApiOutputFormat, hash string) (interface{}, error) {
 	decodedHash, err := hex.DecodeString(hash)
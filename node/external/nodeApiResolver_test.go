@@ -7,8 +7,11 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	nodeData "github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go-core/data/rewardTx"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/genesis"
@@ -16,6 +19,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/node/external"
 	"github.com/ElrondNetwork/elrond-go/node/mock"
 	"github.com/ElrondNetwork/elrond-go/process"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/sharding/nodesCoordinator"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/ElrondNetwork/elrond-go/testscommon/genesisMocks"
@@ -30,6 +34,8 @@ func createMockArgs() external.ArgNodeApiResolver {
 		SCQueryService:           &mock.SCQueryServiceStub{},
 		StatusMetricsHandler:     &testscommon.StatusMetricsStub{},
 		TxCostHandler:            &mock.TransactionCostEstimatorMock{},
+		TxGasEstimator:           &mock.TransactionGasEstimatorStub{},
+		HistoricalTxSimulator:    &mock.HistoricalTransactionSimulatorStub{},
 		TotalStakedValueHandler:  &mock.StakeValuesProcessorStub{},
 		DirectStakedListHandler:  &mock.DirectStakedListProcessorStub{},
 		DelegatedListHandler:     &mock.DelegatedListProcessorStub{},
@@ -40,6 +46,8 @@ func createMockArgs() external.ArgNodeApiResolver {
 		ValidatorPubKeyConverter: &testscommon.PubkeyConverterMock{},
 		AccountsParser:           &genesisMocks.AccountsParserStub{},
 		GasScheduleNotifier:      &testscommon.GasScheduleNotifierMock{},
+		LogsFacade:               &testscommon.LogsFacadeStub{},
+		ESDTHoldersHandler:       &mock.ESDTHoldersProcessorStub{},
 	}
 }
 
@@ -76,6 +84,28 @@ func TestNewNodeApiResolver_NilTransactionCostEstimator(t *testing.T) {
 	assert.Equal(t, external.ErrNilTransactionCostHandler, err)
 }
 
+func TestNewNodeApiResolver_NilTransactionGasEstimator(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.TxGasEstimator = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilTransactionGasEstimator, err)
+}
+
+func TestNewNodeApiResolver_NilHistoricalTransactionSimulator(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.HistoricalTxSimulator = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilHistoricalTransactionSimulator, err)
+}
+
 func TestNewNodeApiResolver_NilTotalStakedValueHandler(t *testing.T) {
 	t.Parallel()
 
@@ -120,6 +150,28 @@ func TestNewNodeApiResolver_NilGasSchedules(t *testing.T) {
 	assert.Equal(t, external.ErrNilGasScheduler, err)
 }
 
+func TestNewNodeApiResolver_NilLogsFacade(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.LogsFacade = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilLogsFacade, err)
+}
+
+func TestNewNodeApiResolver_NilESDTHoldersHandler(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgs()
+	arg.ESDTHoldersHandler = nil
+	nar, err := external.NewNodeApiResolver(arg)
+
+	assert.Nil(t, nar)
+	assert.Equal(t, external.ErrNilESDTHoldersHandler, err)
+}
+
 func TestNewNodeApiResolver_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -281,6 +333,27 @@ func TestNodeApiResolver_GetDirectStakedList(t *testing.T) {
 	assert.True(t, wasCalled)
 }
 
+func TestNodeApiResolver_GetESDTHolders(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	arg := createMockArgs()
+	expectedHolders := &common.ESDTHoldersApiResponse{Token: "TKN-abcdef"}
+	arg.ESDTHoldersHandler = &mock.ESDTHoldersProcessorStub{
+		GetESDTHoldersCalled: func(options common.ESDTHoldersQueryOptions, ctx context.Context) (*common.ESDTHoldersApiResponse, error) {
+			wasCalled = true
+			assert.Equal(t, "TKN-abcdef", options.Token)
+			return expectedHolders, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	holders, err := nar.GetESDTHolders(common.ESDTHoldersQueryOptions{Token: "TKN-abcdef"}, context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, expectedHolders, holders)
+	assert.True(t, wasCalled)
+}
+
 func TestNodeApiResolver_APIBlockHandler(t *testing.T) {
 	t.Parallel()
 
@@ -337,6 +410,24 @@ func TestNodeApiResolver_APIBlockHandler(t *testing.T) {
 		_, _ = nar.GetBlockByRound(10, api.BlockQueryOptions{WithTransactions: true})
 		require.True(t, wasCalled)
 	})
+
+	t.Run("GetHyperblockByNonce", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		arg := createMockArgs()
+		arg.APIBlockHandler = &mock.BlockAPIHandlerStub{
+			GetHyperblockByNonceCalled: func(nonce uint64, options api.BlockQueryOptions) (*common.ApiHyperblock, error) {
+				wasCalled = true
+				return nil, nil
+			},
+		}
+
+		nar, _ := external.NewNodeApiResolver(arg)
+
+		_, _ = nar.GetHyperblockByNonce(10, api.BlockQueryOptions{WithTransactions: true})
+		require.True(t, wasCalled)
+	})
 }
 
 func TestNodeApiResolver_APITransactionHandler(t *testing.T) {
@@ -357,6 +448,72 @@ func TestNodeApiResolver_APITransactionHandler(t *testing.T) {
 	require.True(t, wasCalled)
 }
 
+func TestNodeApiResolver_GetTransactionTrace(t *testing.T) {
+	t.Parallel()
+
+	blockHash := hex.EncodeToString([]byte("block"))
+	previousBlockHash := hex.EncodeToString([]byte("previous block"))
+	createArgs := func(tx nodeData.TransactionHandler, txBlockHash string) external.ArgNodeApiResolver {
+		arg := createMockArgs()
+		arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+			GetTransactionCalled: func(hash string, withResults bool) (*transaction.ApiTransactionResult, error) {
+				return &transaction.ApiTransactionResult{Tx: tx, BlockHash: txBlockHash}, nil
+			},
+		}
+		arg.APIBlockHandler = &mock.BlockAPIHandlerStub{
+			GetBlockByHashCalled: func(hash []byte, options api.BlockQueryOptions) (*api.Block, error) {
+				if string(hash) == "block" {
+					return &api.Block{PrevBlockHash: previousBlockHash}, nil
+				}
+
+				return &api.Block{Epoch: 3, StateRootHash: hex.EncodeToString([]byte("root hash"))}, nil
+			},
+		}
+
+		return arg
+	}
+
+	t.Run("not a regular transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		nar, _ := external.NewNodeApiResolver(createArgs(&rewardTx.RewardTx{}, blockHash))
+
+		results, err := nar.GetTransactionTrace("hash")
+		require.Nil(t, results)
+		require.Equal(t, external.ErrTransactionTraceNotSupported, err)
+	})
+	t.Run("unknown block should error", func(t *testing.T) {
+		t.Parallel()
+
+		nar, _ := external.NewNodeApiResolver(createArgs(&transaction.Transaction{}, ""))
+
+		results, err := nar.GetTransactionTrace("hash")
+		require.Nil(t, results)
+		require.Equal(t, external.ErrTransactionBlockNotKnown, err)
+	})
+	t.Run("should replay on the state of the previous block", func(t *testing.T) {
+		t.Parallel()
+
+		providedTx := &transaction.Transaction{Nonce: 7}
+		arg := createArgs(providedTx, blockHash)
+		arg.HistoricalTxSimulator = &mock.HistoricalTransactionSimulatorStub{
+			ProcessHistoricalTxCalled: func(tx *transaction.Transaction, options api.AccountQueryOptions) (*txSimData.SimulationResults, error) {
+				require.Equal(t, providedTx, tx)
+				require.Equal(t, []byte("root hash"), options.BlockRootHash)
+				require.Equal(t, core.OptionalUint32{Value: 3, HasValue: true}, options.HintEpoch)
+
+				return &txSimData.SimulationResults{Status: transaction.TxStatusSuccess}, nil
+			},
+		}
+		nar, _ := external.NewNodeApiResolver(arg)
+
+		results, err := nar.GetTransactionTrace("hash")
+		require.Nil(t, err)
+		require.Equal(t, "hash", results.Hash)
+		require.Equal(t, transaction.TxStatusSuccess, results.Status)
+	})
+}
+
 func TestNodeApiResolver_GetTransactionsPool(t *testing.T) {
 	t.Parallel()
 
@@ -366,13 +523,13 @@ func TestNodeApiResolver_GetTransactionsPool(t *testing.T) {
 		expectedErr := errors.New("expected error")
 		arg := createMockArgs()
 		arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
-			GetTransactionsPoolCalled: func(fields string) (*common.TransactionsPoolAPIResponse, error) {
+			GetTransactionsPoolCalled: func(options common.TransactionsPoolQueryOptions) (*common.TransactionsPoolAPIResponse, error) {
 				return nil, expectedErr
 			},
 		}
 
 		nar, _ := external.NewNodeApiResolver(arg)
-		res, err := nar.GetTransactionsPool("")
+		res, err := nar.GetTransactionsPool(common.TransactionsPoolQueryOptions{})
 		require.Nil(t, res)
 		require.Equal(t, expectedErr, err)
 	})
@@ -405,13 +562,13 @@ func TestNodeApiResolver_GetTransactionsPool(t *testing.T) {
 		}
 		arg := createMockArgs()
 		arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
-			GetTransactionsPoolCalled: func(fields string) (*common.TransactionsPoolAPIResponse, error) {
+			GetTransactionsPoolCalled: func(options common.TransactionsPoolQueryOptions) (*common.TransactionsPoolAPIResponse, error) {
 				return expectedTxsPool, nil
 			},
 		}
 
 		nar, _ := external.NewNodeApiResolver(arg)
-		res, err := nar.GetTransactionsPool("")
+		res, err := nar.GetTransactionsPool(common.TransactionsPoolQueryOptions{})
 		require.NoError(t, err)
 		require.Equal(t, expectedTxsPool, res)
 	})
@@ -562,6 +719,74 @@ func TestNodeApiResolver_GetTransactionsPoolNonceGapsForSender(t *testing.T) {
 	})
 }
 
+func TestNodeApiResolver_GetTransactionsPoolStatsForSender(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		arg := createMockArgs()
+		arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+			GetTransactionsPoolStatsForSenderCalled: func(sender string) (*common.TransactionsPoolSenderStatsApiResponse, error) {
+				return nil, expectedErr
+			},
+		}
+
+		nar, _ := external.NewNodeApiResolver(arg)
+		res, err := nar.GetTransactionsPoolStatsForSender("sender")
+		require.Nil(t, res)
+		require.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedSender := "alice"
+		expectedStats := &common.TransactionsPoolSenderStatsApiResponse{
+			Sender: expectedSender,
+			Score:  42,
+			NumTxs: 3,
+		}
+		arg := createMockArgs()
+		arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+			GetTransactionsPoolStatsForSenderCalled: func(sender string) (*common.TransactionsPoolSenderStatsApiResponse, error) {
+				require.Equal(t, expectedSender, sender)
+				return expectedStats, nil
+			},
+		}
+
+		nar, _ := external.NewNodeApiResolver(arg)
+		res, err := nar.GetTransactionsPoolStatsForSender(expectedSender)
+		require.NoError(t, err)
+		require.Equal(t, expectedStats, res)
+	})
+}
+
+func TestNodeApiResolver_GetTransactionsPoolEvictions(t *testing.T) {
+	t.Parallel()
+
+	expectedEvictions := &common.TransactionsPoolEvictionsApiResponse{
+		Rounds: []common.EvictionRoundApiResponse{
+			{
+				NumTxsEvicted:  10,
+				EvictedSenders: []string{"alice"},
+			},
+		},
+	}
+	arg := createMockArgs()
+	arg.APITransactionHandler = &mock.TransactionAPIHandlerStub{
+		GetTransactionsPoolEvictionsCalled: func() (*common.TransactionsPoolEvictionsApiResponse, error) {
+			return expectedEvictions, nil
+		},
+	}
+
+	nar, _ := external.NewNodeApiResolver(arg)
+	res, err := nar.GetTransactionsPoolEvictions()
+	require.NoError(t, err)
+	require.Equal(t, expectedEvictions, res)
+}
+
 func TestNodeApiResolver_GetGenesisNodesPubKeys(t *testing.T) {
 	t.Parallel()
 
@@ -676,3 +901,60 @@ func TestNodeApiResolver_GetGasConfigs(t *testing.T) {
 	_ = nar.GetGasConfigs()
 	require.True(t, wasCalled)
 }
+
+func TestNodeApiResolver_EstimateTransactionGas(t *testing.T) {
+	t.Parallel()
+
+	expectedEstimation := &txSimData.GasEstimation{GasUnits: 1500}
+	args := createMockArgs()
+	args.TxGasEstimator = &mock.TransactionGasEstimatorStub{
+		EstimateTransactionGasCalled: func(tx *transaction.Transaction) (*txSimData.GasEstimation, error) {
+			return expectedEstimation, nil
+		},
+	}
+
+	nar, err := external.NewNodeApiResolver(args)
+	require.Nil(t, err)
+
+	estimation, err := nar.EstimateTransactionGas(&transaction.Transaction{})
+	require.Nil(t, err)
+	require.Equal(t, expectedEstimation, estimation)
+}
+
+func TestNodeApiResolver_EstimateSmartContractResultGas(t *testing.T) {
+	t.Parallel()
+
+	expectedEstimation := &txSimData.GasEstimation{GasUnits: 2000}
+	args := createMockArgs()
+	args.TxGasEstimator = &mock.TransactionGasEstimatorStub{
+		EstimateSmartContractResultGasCalled: func(scr *transaction.ApiSmartContractResult) (*txSimData.GasEstimation, error) {
+			return expectedEstimation, nil
+		},
+	}
+
+	nar, err := external.NewNodeApiResolver(args)
+	require.Nil(t, err)
+
+	estimation, err := nar.EstimateSmartContractResultGas(&transaction.ApiSmartContractResult{})
+	require.Nil(t, err)
+	require.Equal(t, expectedEstimation, estimation)
+}
+
+func TestNodeApiResolver_GetLogEvents(t *testing.T) {
+	t.Parallel()
+
+	expectedOptions := common.LogEventsQueryOptions{Identifier: "ESDTTransfer", FromNonce: 1, ToNonce: 10}
+	expectedResponse := &common.LogEventsApiResponse{FromNonce: 1, ToNonce: 10}
+	arg := createMockArgs()
+	arg.LogsFacade = &testscommon.LogsFacadeStub{
+		GetLogEventsCalled: func(options common.LogEventsQueryOptions) (*common.LogEventsApiResponse, error) {
+			assert.Equal(t, expectedOptions, options)
+			return expectedResponse, nil
+		},
+	}
+	nar, _ := external.NewNodeApiResolver(arg)
+
+	response, err := nar.GetLogEvents(expectedOptions)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResponse, response)
+}
@@ -19,6 +19,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/dataRetriever"
 	"github.com/ElrondNetwork/elrond-go/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/stateDiff"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/process/txstatus"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/storage/txcache"
@@ -41,6 +43,8 @@ type apiTransactionProcessor struct {
 	txUnmarshaller              *txUnmarshaller
 	transactionResultsProcessor *apiTransactionResultsProcessor
 	refundDetector              *refundDetector
+	stateDiffConverter          stateDiffConverter
+	recordedBlocksNotifier      *recordedBlocksNotifier
 }
 
 // NewAPITransactionProcessor will create a new instance of apiTransactionProcessor
@@ -64,6 +68,14 @@ func NewAPITransactionProcessor(args *ArgAPITransactionProcessor) (*apiTransacti
 
 	refundDetector := newRefundDetector()
 
+	stateDiffConv, err := stateDiff.NewStateDiffConverter(args.AddressPubKeyConverter, args.Marshalizer)
+	if err != nil {
+		return nil, err
+	}
+
+	recordedBlocksNotifier := newRecordedBlocksNotifier()
+	args.HistoryRepository.RegisterRecordedBlocksHandler(recordedBlocksNotifier.onRecordedBlocks)
+
 	return &apiTransactionProcessor{
 		roundDuration:               args.RoundDuration,
 		genesisTime:                 args.GenesisTime,
@@ -79,6 +91,8 @@ func NewAPITransactionProcessor(args *ArgAPITransactionProcessor) (*apiTransacti
 		txUnmarshaller:              txUnmarshalerAndPreparer,
 		transactionResultsProcessor: txResultsProc,
 		refundDetector:              refundDetector,
+		stateDiffConverter:          stateDiffConv,
+		recordedBlocksNotifier:      recordedBlocksNotifier,
 	}, nil
 }
 
@@ -101,6 +115,142 @@ func (atp *apiTransactionProcessor) GetTransaction(txHash string, withResults bo
 	return tx, nil
 }
 
+// GetTransactionStateDiff returns the state diff recorded, by the db lookup extensions, for the transaction with the
+// given hash
+func (atp *apiTransactionProcessor) GetTransactionStateDiff(txHash string) ([]*txSimData.AccountStateDiff, error) {
+	hash, err := hex.DecodeString(txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if !atp.historyRepository.IsEnabled() {
+		return nil, ErrStateDiffNotAvailable
+	}
+
+	miniblockMetadata, err := atp.historyRepository.GetMiniblockMetadataByTxHash(hash)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", ErrTransactionNotFound.Error(), err)
+	}
+
+	stateChanges, err := atp.historyRepository.GetStateDiffByTxHash(hash, miniblockMetadata.Epoch)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", ErrStateDiffNotAvailable.Error(), err)
+	}
+
+	return atp.stateDiffConverter.ConvertStateChanges(stateChanges), nil
+}
+
+// WaitForTransactionStatus waits until the transaction with the given hash reaches the provided status, or until its
+// outcome becomes final, but no longer than the provided timeout. The transaction is evaluated again each time the
+// history repository records a committed or a notarized block
+func (atp *apiTransactionProcessor) WaitForTransactionStatus(txHash string, status string, timeout time.Duration) (*common.TransactionStatusWaitApiResponse, error) {
+	_, err := hex.DecodeString(txHash)
+	if err != nil {
+		return nil, err
+	}
+	if !isWaitableTransactionStatus(status) {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidTransactionStatus, status)
+	}
+	if !atp.historyRepository.IsEnabled() {
+		return nil, ErrTransactionStatusWaitNotAvailable
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		// the channel is fetched before evaluating the transaction, so that a block recorded meanwhile is not missed
+		recordedBlockChan := atp.recordedBlocksNotifier.getRecordedBlockChan()
+
+		tx, errGet := atp.GetTransaction(txHash, true)
+		if errGet == nil {
+			isReached, isFinal := atp.evaluateTransactionStatus(tx, status)
+			if isReached || isFinal {
+				return &common.TransactionStatusWaitApiResponse{
+					Transaction:   tx,
+					StatusReached: isReached,
+				}, nil
+			}
+		}
+
+		select {
+		case <-recordedBlockChan:
+		case <-timer.C:
+			if errGet != nil {
+				return nil, errGet
+			}
+
+			return &common.TransactionStatusWaitApiResponse{
+				Transaction:   tx,
+				StatusReached: false,
+			}, nil
+		}
+	}
+}
+
+func isWaitableTransactionStatus(status string) bool {
+	switch status {
+	case common.TransactionStatusExecuted,
+		string(transaction.TxStatusPending),
+		string(transaction.TxStatusSuccess),
+		string(transaction.TxStatusFail),
+		string(transaction.TxStatusInvalid),
+		string(transaction.TxStatusRewardReverted):
+		return true
+	default:
+		return false
+	}
+}
+
+// evaluateTransactionStatus returns whether the transaction reached the provided status and whether its outcome is final
+func (atp *apiTransactionProcessor) evaluateTransactionStatus(tx *transaction.ApiTransactionResult, status string) (bool, bool) {
+	isFinal := atp.isTransactionOutcomeFinal(tx)
+	if status == common.TransactionStatusExecuted {
+		return isFinal, isFinal
+	}
+	if string(tx.Status) != status {
+		return false, isFinal
+	}
+
+	// a successful transaction reaches its status only after its smart contract results are completed
+	return isFinal || tx.Status == transaction.TxStatusPending, isFinal
+}
+
+func (atp *apiTransactionProcessor) isTransactionOutcomeFinal(tx *transaction.ApiTransactionResult) bool {
+	switch tx.Status {
+	case transaction.TxStatusFail, transaction.TxStatusInvalid, transaction.TxStatusRewardReverted:
+		return true
+	case transaction.TxStatusSuccess:
+		return atp.areSmartContractResultsCompleted(tx)
+	default:
+		return false
+	}
+}
+
+// areSmartContractResultsCompleted returns true if all the known smart contract results of the transaction were
+// committed and, if cross-shard, notarized at destination
+func (atp *apiTransactionProcessor) areSmartContractResultsCompleted(tx *transaction.ApiTransactionResult) bool {
+	for _, scr := range tx.SmartContractResults {
+		scrHash, err := hex.DecodeString(scr.Hash)
+		if err != nil {
+			return false
+		}
+
+		miniblockMetadata, err := atp.historyRepository.GetMiniblockMetadataByTxHash(scrHash)
+		if err != nil {
+			return false
+		}
+
+		isExecutedAtDestination := miniblockMetadata.DestinationShardID == atp.shardCoordinator.SelfId() ||
+			miniblockMetadata.NotarizedAtDestinationInMetaNonce > 0
+		if !isExecutedAtDestination {
+			return false
+		}
+	}
+
+	return true
+}
+
 func (atp *apiTransactionProcessor) doGetTransaction(hash []byte, withResults bool) (*transaction.ApiTransactionResult, error) {
 	tx, err := atp.optionallyGetTransactionFromPool(hash)
 	if err != nil {
@@ -155,22 +305,24 @@ func (atp *apiTransactionProcessor) populateComputedFieldIsRefund(tx *transactio
 }
 
 // GetTransactionsPool will return a structure containing the transactions pool fields that is to be returned on API calls
-func (atp *apiTransactionProcessor) GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error) {
-	transactions := &common.TransactionsPoolAPIResponse{}
-	requestedFieldsHandler := newFieldsHandler(fields)
+func (atp *apiTransactionProcessor) GetTransactionsPool(options common.TransactionsPoolQueryOptions) (*common.TransactionsPoolAPIResponse, error) {
+	query, err := newTxsPoolQuery(options, atp.addressPubKeyConverter)
+	if err != nil {
+		return nil, err
+	}
 
-	var err error
-	transactions.RegularTransactions, err = atp.getRegularTransactionsFromPool(requestedFieldsHandler)
+	transactions := &common.TransactionsPoolAPIResponse{}
+	transactions.RegularTransactions, err = atp.getRegularTransactionsFromPool(query)
 	if err != nil {
 		return nil, err
 	}
 
-	transactions.Rewards, err = atp.getRewardTransactionsFromPool(requestedFieldsHandler)
+	transactions.Rewards, err = atp.getRewardTransactionsFromPool(query)
 	if err != nil {
 		return nil, err
 	}
 
-	transactions.SmartContractResults, err = atp.getUnsignedTransactionsFromPool(requestedFieldsHandler)
+	transactions.SmartContractResults, err = atp.getUnsignedTransactionsFromPool(query)
 	if err != nil {
 		return nil, err
 	}
@@ -241,78 +393,208 @@ func (atp *apiTransactionProcessor) GetTransactionsPoolNonceGapsForSender(sender
 	}, nil
 }
 
-func (atp *apiTransactionProcessor) extractRequestedTxInfoFromObj(txObj interface{}, txType transaction.TxType, txHash []byte, requestedFieldsHandler fieldsHandler) (common.Transaction, error) {
-	txResult, err := atp.getApiResultFromObj(txObj, txType)
+// GetTransactionsPoolReplacementsForSender will return the recent replacements by fee of the transactions of the sender, that is to be returned on API calls
+func (atp *apiTransactionProcessor) GetTransactionsPoolReplacementsForSender(sender string) (*common.TransactionsPoolReplacementsForSenderApiResponse, error) {
+	senderAddr, err := atp.addressPubKeyConverter.Decode(sender)
 	if err != nil {
-		return common.Transaction{}, err
+		return nil, fmt.Errorf("%s, %w", ErrInvalidAddress.Error(), err)
 	}
 
-	wrappedTx := &txcache.WrappedTransaction{
-		Tx:     txResult.Tx,
-		TxHash: txHash,
+	senderShard := atp.shardCoordinator.ComputeId(senderAddr)
+	replacements := atp.fetchReplacementsForSender(string(senderAddr), senderShard)
+
+	response := &common.TransactionsPoolReplacementsForSenderApiResponse{
+		Sender:       sender,
+		Replacements: make([]common.TxReplacementApiResponse, 0, len(replacements)),
+	}
+	for _, replacement := range replacements {
+		response.Replacements = append(response.Replacements, common.TxReplacementApiResponse{
+			Nonce:               replacement.Nonce,
+			ReplacedTxHash:      hex.EncodeToString(replacement.ReplacedTxHash),
+			ReplacedGasPrice:    replacement.ReplacedGasPrice,
+			ReplacementTxHash:   hex.EncodeToString(replacement.ReplacementTxHash),
+			ReplacementGasPrice: replacement.ReplacementGasPrice,
+		})
 	}
 
-	return atp.extractRequestedTxInfo(wrappedTx, requestedFieldsHandler), nil
+	return response, nil
 }
 
-func (atp *apiTransactionProcessor) getRegularTransactionsFromPool(requestedFieldsHandler fieldsHandler) ([]common.Transaction, error) {
-	regularTxKeys := atp.dataPool.Transactions().Keys()
-	regularTxs := make([]common.Transaction, len(regularTxKeys))
-	for idx, key := range regularTxKeys {
-		txObj, found := atp.getRegularTxObjFromDataPool(key)
-		if !found {
-			continue
-		}
+// GetTransactionsPoolStatsForSender will return the statistics of the sender's transactions, as seen by the selection
+// and the eviction processes of the pool, that is to be returned on API calls
+func (atp *apiTransactionProcessor) GetTransactionsPoolStatsForSender(sender string) (*common.TransactionsPoolSenderStatsApiResponse, error) {
+	senderAddr, err := atp.addressPubKeyConverter.Decode(sender)
+	if err != nil {
+		return nil, fmt.Errorf("%s, %w", ErrInvalidAddress.Error(), err)
+	}
 
-		tx, err := atp.extractRequestedTxInfoFromObj(txObj, transaction.TxTypeNormal, key, requestedFieldsHandler)
-		if err != nil {
-			return nil, err
+	senderShard := atp.shardCoordinator.ComputeId(senderAddr)
+	stats, found := atp.fetchStatsForSender(string(senderAddr), senderShard)
+	if !found {
+		return nil, fmt.Errorf("%w, no transaction in pool for sender", ErrCannotRetrieveTransactions)
+	}
+
+	nonceGaps := make([]common.NonceGapApiResponse, 0, len(stats.NonceGaps))
+	for _, gap := range stats.NonceGaps {
+		nonceGaps = append(nonceGaps, common.NonceGapApiResponse{
+			From: gap.From,
+			To:   gap.To,
+		})
+	}
+
+	return &common.TransactionsPoolSenderStatsApiResponse{
+		Sender:              sender,
+		Score:               stats.Score,
+		NumTxs:              stats.NumTxs,
+		NumBytes:            stats.NumBytes,
+		TotalGas:            stats.TotalGas,
+		AccountNonce:        stats.AccountNonce,
+		AccountNonceKnown:   stats.AccountNonceKnown,
+		NumFailedSelections: stats.NumFailedSelections,
+		IsInGracePeriod:     stats.IsInGracePeriod,
+		IsSweepable:         stats.IsSweepable,
+		NonceGaps:           nonceGaps,
+	}, nil
+}
+
+// GetTransactionsPoolEvictions will return the recent eviction rounds of the pool holding the transactions of the
+// senders in the self shard, that is to be returned on API calls
+func (atp *apiTransactionProcessor) GetTransactionsPoolEvictions() (*common.TransactionsPoolEvictionsApiResponse, error) {
+	rounds := atp.fetchEvictionRounds(atp.shardCoordinator.SelfId())
+
+	response := &common.TransactionsPoolEvictionsApiResponse{
+		Rounds: make([]common.EvictionRoundApiResponse, 0, len(rounds)),
+	}
+	for _, round := range rounds {
+		evictedSenders := make([]string, 0, len(round.EvictedSenders))
+		for _, sender := range round.EvictedSenders {
+			evictedSenders = append(evictedSenders, atp.addressPubKeyConverter.Encode(sender))
 		}
 
-		regularTxs[idx] = tx
+		response.Rounds = append(response.Rounds, common.EvictionRoundApiResponse{
+			Timestamp:         round.Timestamp,
+			DurationInMillis:  round.Duration.Milliseconds(),
+			NumTxsBefore:      round.NumTxsBefore,
+			NumSendersBefore:  round.NumSendersBefore,
+			NumBytesBefore:    round.NumBytesBefore,
+			NumTxsEvicted:     round.NumTxsEvicted,
+			NumSendersEvicted: round.NumSendersEvicted,
+			NumSteps:          round.NumSteps,
+			EvictedSenders:    evictedSenders,
+		})
 	}
 
-	return regularTxs, nil
+	return response, nil
 }
 
-func (atp *apiTransactionProcessor) getRewardTransactionsFromPool(requestedFieldsHandler fieldsHandler) ([]common.Transaction, error) {
-	rewardTxKeys := atp.dataPool.RewardTransactions().Keys()
-	rewardTxs := make([]common.Transaction, len(rewardTxKeys))
-	for idx, key := range rewardTxKeys {
-		txObj, found := atp.getRewardTxObjFromDataPool(key)
-		if !found {
-			continue
-		}
+// GetTransactionsByAddress will return the transactions involving the given address, as recorded by the transactions by address index
+func (atp *apiTransactionProcessor) GetTransactionsByAddress(address string, options common.AddressTransactionsQueryOptions) (*common.AddressTransactionsApiResponse, error) {
+	decodedAddress, err := atp.addressPubKeyConverter.Decode(address)
+	if err != nil {
+		return nil, fmt.Errorf("%s, %w", ErrInvalidAddress.Error(), err)
+	}
 
-		tx, err := atp.extractRequestedTxInfoFromObj(txObj, transaction.TxTypeReward, key, requestedFieldsHandler)
-		if err != nil {
-			return nil, err
-		}
+	entries, err := atp.historyRepository.GetTxHashesByAddress(decodedAddress, options.From, options.Size, options.Ascending)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", ErrCannotRetrieveTransactions.Error(), err)
+	}
 
-		rewardTxs[idx] = tx
+	response := &common.AddressTransactionsApiResponse{
+		Address:      address,
+		Transactions: make([]common.AddressTransactionApiResponse, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		response.Transactions = append(response.Transactions, common.AddressTransactionApiResponse{
+			Hash:          hex.EncodeToString(entry.TxHash),
+			MiniblockType: block.Type(entry.MiniblockType).String(),
+			Direction:     getAddressTransactionDirection(entry),
+			BlockNonce:    entry.BlockNonce,
+			BlockHash:     hex.EncodeToString(entry.BlockHash),
+			Round:         entry.Round,
+			Epoch:         entry.Epoch,
+		})
 	}
 
-	return rewardTxs, nil
+	return response, nil
 }
 
-func (atp *apiTransactionProcessor) getUnsignedTransactionsFromPool(requestedFieldsHandler fieldsHandler) ([]common.Transaction, error) {
+func getAddressTransactionDirection(entry *dblookupext.TxHashByAddress) string {
+	if entry.IsSender && entry.IsReceiver {
+		return directionSelf
+	}
+	if entry.IsSender {
+		return directionOut
+	}
+
+	return directionIn
+}
+
+func (atp *apiTransactionProcessor) getRegularTransactionsFromPool(query *txsPoolQuery) ([]common.Transaction, error) {
+	regularTxKeys := atp.dataPool.Transactions().Keys()
+	return atp.getTransactionsFromPool(regularTxKeys, atp.getRegularTxObjFromDataPool, transaction.TxTypeNormal, query)
+}
+
+func (atp *apiTransactionProcessor) getRewardTransactionsFromPool(query *txsPoolQuery) ([]common.Transaction, error) {
+	rewardTxKeys := atp.dataPool.RewardTransactions().Keys()
+	return atp.getTransactionsFromPool(rewardTxKeys, atp.getRewardTxObjFromDataPool, transaction.TxTypeReward, query)
+}
+
+func (atp *apiTransactionProcessor) getUnsignedTransactionsFromPool(query *txsPoolQuery) ([]common.Transaction, error) {
 	unsignedTxKeys := atp.dataPool.UnsignedTransactions().Keys()
-	unsignedTxs := make([]common.Transaction, len(unsignedTxKeys))
-	for idx, key := range unsignedTxKeys {
-		txObj, found := atp.getUnsignedTxObjFromDataPool(key)
+	return atp.getTransactionsFromPool(unsignedTxKeys, atp.getUnsignedTxObjFromDataPool, transaction.TxTypeUnsigned, query)
+}
+
+func (atp *apiTransactionProcessor) getTransactionsFromPool(
+	keys [][]byte,
+	getTxObj func(hash []byte) (interface{}, bool),
+	txType transaction.TxType,
+	query *txsPoolQuery,
+) ([]common.Transaction, error) {
+	wrappedTxs := make([]*txcache.WrappedTransaction, 0, len(keys))
+	for _, key := range keys {
+		txObj, found := getTxObj(key)
 		if !found {
 			continue
 		}
 
-		tx, err := atp.extractRequestedTxInfoFromObj(txObj, transaction.TxTypeUnsigned, key, requestedFieldsHandler)
+		txResult, err := atp.getApiResultFromObj(txObj, txType)
 		if err != nil {
 			return nil, err
 		}
+		if !query.matches(txResult.Tx) {
+			continue
+		}
 
-		unsignedTxs[idx] = tx
+		wrappedTxs = append(wrappedTxs, &txcache.WrappedTransaction{
+			Tx:     txResult.Tx,
+			TxHash: key,
+		})
 	}
 
-	return unsignedTxs, nil
+	query.sort(wrappedTxs, atp.getScoreOfSender)
+
+	txs := make([]common.Transaction, 0, len(wrappedTxs))
+	for _, wrappedTx := range wrappedTxs {
+		txs = append(txs, atp.extractRequestedTxInfo(wrappedTx, query.requestedFieldsHandler))
+	}
+
+	return txs, nil
+}
+
+// getScoreOfSender returns the score of a sender in the self shard. The transactions of the senders in other shards are
+// not subject to selection, thus they have no score
+func (atp *apiTransactionProcessor) getScoreOfSender(sender []byte) uint32 {
+	senderShard := atp.shardCoordinator.ComputeId(sender)
+	if senderShard != atp.shardCoordinator.SelfId() {
+		return 0
+	}
+
+	stats, found := atp.fetchStatsForSender(string(sender), senderShard)
+	if !found {
+		return 0
+	}
+
+	return stats.Score
 }
 
 func (atp *apiTransactionProcessor) extractRequestedTxInfo(wrappedTx *txcache.WrappedTransaction, requestedFieldsHandler fieldsHandler) common.Transaction {
@@ -368,6 +650,42 @@ func (atp *apiTransactionProcessor) fetchTxsForSender(sender string, senderShard
 	return txsForSender
 }
 
+func (atp *apiTransactionProcessor) fetchReplacementsForSender(sender string, senderShard uint32) []*txcache.TxReplacement {
+	cacheId := process.ShardCacherIdentifier(senderShard, senderShard)
+	cache := atp.dataPool.Transactions().ShardDataStore(cacheId)
+	txCache, ok := cache.(*txcache.TxCache)
+	if !ok {
+		log.Warn("fetchReplacementsForSender could not cast to TxCache")
+		return nil
+	}
+
+	return txCache.GetReplacementsForSender(sender)
+}
+
+func (atp *apiTransactionProcessor) fetchStatsForSender(sender string, senderShard uint32) (*txcache.SenderStats, bool) {
+	cacheId := process.ShardCacherIdentifier(senderShard, senderShard)
+	cache := atp.dataPool.Transactions().ShardDataStore(cacheId)
+	txCache, ok := cache.(*txcache.TxCache)
+	if !ok {
+		log.Warn("fetchStatsForSender could not cast to TxCache")
+		return nil, false
+	}
+
+	return txCache.GetSenderStats(sender)
+}
+
+func (atp *apiTransactionProcessor) fetchEvictionRounds(shard uint32) []*txcache.EvictionRound {
+	cacheId := process.ShardCacherIdentifier(shard, shard)
+	cache := atp.dataPool.Transactions().ShardDataStore(cacheId)
+	txCache, ok := cache.(*txcache.TxCache)
+	if !ok {
+		log.Warn("fetchEvictionRounds could not cast to TxCache")
+		return nil
+	}
+
+	return txCache.GetEvictionRounds()
+}
+
 func (atp *apiTransactionProcessor) fetchLastNonceForSender(sender string, senderShard uint32) (uint64, error) {
 	wrappedTxs := atp.fetchTxsForSender(sender, senderShard)
 	if len(wrappedTxs) == 0 {
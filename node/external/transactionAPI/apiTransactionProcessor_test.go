@@ -8,6 +8,8 @@ import (
 	"math"
 	"math/big"
 	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -26,6 +28,8 @@ import (
 	"github.com/ElrondNetwork/elrond-go/node/mock"
 	"github.com/ElrondNetwork/elrond-go/process"
 	processMocks "github.com/ElrondNetwork/elrond-go/process/mock"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
@@ -674,7 +678,7 @@ func TestApiTransactionProcessor_GetTransactionsPool(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, atp)
 
-	res, err := atp.GetTransactionsPool("")
+	res, err := atp.GetTransactionsPool(common.TransactionsPoolQueryOptions{})
 	require.NoError(t, err)
 
 	regularTxs := []common.Transaction{
@@ -723,6 +727,252 @@ func createTx(hash []byte, sender string, nonce uint64) *txcache.WrappedTransact
 	}
 }
 
+func TestApiTransactionProcessor_GetTransactionsPoolWithOrderingAndFiltering(t *testing.T) {
+	t.Parallel()
+
+	createTxWithParams := func(hash string, sender string, receiver string, gasPrice uint64, data string) *txcache.WrappedTransaction {
+		wrappedTx := createTx([]byte(hash), sender, 1)
+		tx := wrappedTx.Tx.(*transaction.Transaction)
+		tx.RcvAddr = []byte(receiver)
+		tx.GasPrice = gasPrice
+		tx.GasLimit = 100000
+		tx.Data = []byte(data)
+
+		return wrappedTx
+	}
+
+	txs := map[string]*txcache.WrappedTransaction{
+		"txHash0": createTxWithParams("txHash0", "alice", "contract", 1000, "claim@01"),
+		"txHash1": createTxWithParams("txHash1", "bob", "contract", 3000, "claim@02"),
+		"txHash2": createTxWithParams("txHash2", "carol", "contract", 2000, "stake"),
+		"txHash3": createTxWithParams("txHash3", "dave", "other", 4000, "claim@03"),
+		"txHash4": createTxWithParams("txHash4", "erin", "contract", 2000, "claim@04"),
+	}
+	txCacheIntraShard, _ := txcache.NewTxCache(txcache.ConfigSourceMe{
+		Name:                       "test",
+		NumChunks:                  4,
+		NumBytesPerSenderThreshold: 1_048_576, // 1 MB
+		CountPerSenderThreshold:    math.MaxUint32,
+	}, &txcachemocks.TxGasHandlerMock{
+		MinimumGasMove:       1,
+		MinimumGasPrice:      1,
+		GasProcessingDivisor: 1,
+	})
+	for _, tx := range txs {
+		txCacheIntraShard.AddTx(tx)
+	}
+
+	args := createMockArgAPITransactionProcessor()
+	args.DataPool = &dataRetrieverMock.PoolsHolderStub{
+		TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+			return &testscommon.ShardedDataStub{
+				KeysCalled: func() [][]byte {
+					return [][]byte{[]byte("txHash0"), []byte("txHash1"), []byte("txHash2"), []byte("txHash3"), []byte("txHash4")}
+				},
+				SearchFirstDataCalled: func(key []byte) (value interface{}, ok bool) {
+					return txs[string(key)].Tx, true
+				},
+				ShardDataStoreCalled: func(cacheID string) storage.Cacher {
+					return txCacheIntraShard
+				},
+			}
+		},
+		UnsignedTransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+			return &testscommon.ShardedDataStub{}
+		},
+		RewardTransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+			return &testscommon.ShardedDataStub{}
+		},
+	}
+	args.AddressPubKeyConverter = &mock.PubkeyConverterStub{
+		DecodeCalled: func(humanReadable string) ([]byte, error) {
+			return []byte(humanReadable), nil
+		},
+		EncodeCalled: func(pkBytes []byte) string {
+			return string(pkBytes)
+		},
+	}
+	args.ShardCoordinator = &processMocks.ShardCoordinatorStub{
+		NumberOfShardsCalled: func() uint32 {
+			return 1
+		},
+	}
+	atp, err := NewAPITransactionProcessor(args)
+	require.NoError(t, err)
+
+	getHashes := func(txs []common.Transaction) []string {
+		hashes := make([]string, 0, len(txs))
+		for _, tx := range txs {
+			hashes = append(hashes, tx.TxFields[hashField].(string))
+		}
+
+		return hashes
+	}
+
+	t.Run("invalid order should error", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := atp.GetTransactionsPool(common.TransactionsPoolQueryOptions{OrderBy: "nonce"})
+		require.Nil(t, res)
+		require.True(t, errors.Is(err, ErrInvalidTransactionsPoolOrder))
+	})
+	t.Run("filter by receiver and data prefix, order by gas price", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := atp.GetTransactionsPool(common.TransactionsPoolQueryOptions{
+			Fields:     "sender,gasprice",
+			OrderBy:    common.TransactionsPoolOrderByGasPrice,
+			Receiver:   "contract",
+			DataPrefix: "claim",
+		})
+		require.NoError(t, err)
+
+		expectedHashes := []string{
+			hex.EncodeToString([]byte("txHash1")),
+			hex.EncodeToString([]byte("txHash4")),
+			hex.EncodeToString([]byte("txHash0")),
+		}
+		require.Equal(t, expectedHashes, getHashes(res.RegularTransactions))
+		require.Equal(t, "bob", res.RegularTransactions[0].TxFields[senderField])
+		require.Equal(t, uint64(3000), res.RegularTransactions[0].TxFields[gasPriceField])
+		require.Empty(t, res.SmartContractResults)
+		require.Empty(t, res.Rewards)
+	})
+	t.Run("order by score", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := atp.GetTransactionsPool(common.TransactionsPoolQueryOptions{
+			OrderBy: common.TransactionsPoolOrderByScore,
+		})
+		require.NoError(t, err)
+		require.Len(t, res.RegularTransactions, len(txs))
+
+		previousScore := uint32(math.MaxUint32)
+		for _, hash := range getHashes(res.RegularTransactions) {
+			decodedHash, _ := hex.DecodeString(hash)
+			stats, found := txCacheIntraShard.GetSenderStats(string(txs[string(decodedHash)].Tx.GetSndAddr()))
+			require.True(t, found)
+			require.LessOrEqual(t, stats.Score, previousScore)
+			previousScore = stats.Score
+		}
+	})
+}
+
+func TestApiTransactionProcessor_GetTransactionsPoolStatsForSender(t *testing.T) {
+	t.Parallel()
+
+	sender := "alice"
+	txCacheIntraShard, _ := txcache.NewTxCache(txcache.ConfigSourceMe{
+		Name:                       "test",
+		NumChunks:                  4,
+		NumBytesPerSenderThreshold: 1_048_576, // 1 MB
+		CountPerSenderThreshold:    math.MaxUint32,
+	}, &txcachemocks.TxGasHandlerMock{
+		MinimumGasMove:       1,
+		MinimumGasPrice:      1,
+		GasProcessingDivisor: 1,
+	})
+	txCacheIntraShard.AddTx(createTx([]byte("txHash0"), sender, 1))
+	txCacheIntraShard.AddTx(createTx([]byte("txHash1"), sender, 2))
+	txCacheIntraShard.AddTx(createTx([]byte("txHash2"), sender, 5))
+
+	args := createMockArgAPITransactionProcessor()
+	args.DataPool = &dataRetrieverMock.PoolsHolderStub{
+		TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+			return &testscommon.ShardedDataStub{
+				ShardDataStoreCalled: func(cacheID string) storage.Cacher {
+					return txCacheIntraShard
+				},
+			}
+		},
+	}
+	args.AddressPubKeyConverter = &mock.PubkeyConverterStub{
+		DecodeCalled: func(humanReadable string) ([]byte, error) {
+			return []byte(humanReadable), nil
+		},
+		EncodeCalled: func(pkBytes []byte) string {
+			return string(pkBytes)
+		},
+	}
+	args.ShardCoordinator = &processMocks.ShardCoordinatorStub{
+		NumberOfShardsCalled: func() uint32 {
+			return 1
+		},
+	}
+	atp, err := NewAPITransactionProcessor(args)
+	require.NoError(t, err)
+
+	res, err := atp.GetTransactionsPoolStatsForSender(sender)
+	require.NoError(t, err)
+	require.Equal(t, sender, res.Sender)
+	require.Equal(t, uint64(3), res.NumTxs)
+	require.Equal(t, uint64(3*128), res.NumBytes)
+	require.False(t, res.AccountNonceKnown)
+	require.Equal(t, []common.NonceGapApiResponse{{From: 3, To: 4}}, res.NonceGaps)
+
+	res, err = atp.GetTransactionsPoolStatsForSender("new-sender")
+	require.Nil(t, res)
+	require.True(t, errors.Is(err, ErrCannotRetrieveTransactions))
+}
+
+func TestApiTransactionProcessor_GetTransactionsPoolEvictions(t *testing.T) {
+	t.Parallel()
+
+	txCacheIntraShard, _ := txcache.NewTxCache(txcache.ConfigSourceMe{
+		Name:                          "test",
+		NumChunks:                     4,
+		EvictionEnabled:               true,
+		NumBytesThreshold:             1_048_576, // 1 MB
+		NumBytesPerSenderThreshold:    1_048_576, // 1 MB
+		CountThreshold:                4,
+		CountPerSenderThreshold:       math.MaxUint32,
+		NumSendersToPreemptivelyEvict: 2,
+	}, &txcachemocks.TxGasHandlerMock{
+		MinimumGasMove:       1,
+		MinimumGasPrice:      1,
+		GasProcessingDivisor: 1,
+	})
+
+	args := createMockArgAPITransactionProcessor()
+	args.DataPool = &dataRetrieverMock.PoolsHolderStub{
+		TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+			return &testscommon.ShardedDataStub{
+				ShardDataStoreCalled: func(cacheID string) storage.Cacher {
+					return txCacheIntraShard
+				},
+			}
+		},
+	}
+	args.AddressPubKeyConverter = &mock.PubkeyConverterStub{
+		EncodeCalled: func(pkBytes []byte) string {
+			return string(pkBytes)
+		},
+	}
+	atp, err := NewAPITransactionProcessor(args)
+	require.NoError(t, err)
+
+	res, err := atp.GetTransactionsPoolEvictions()
+	require.NoError(t, err)
+	require.Empty(t, res.Rounds)
+
+	txCacheIntraShard.AddTx(createTx([]byte("txHash0"), "alice", 1))
+	txCacheIntraShard.AddTx(createTx([]byte("txHash1"), "bob", 1))
+	txCacheIntraShard.AddTx(createTx([]byte("txHash2"), "carol", 1))
+	txCacheIntraShard.AddTx(createTx([]byte("txHash3"), "dave", 1))
+	txCacheIntraShard.AddTx(createTx([]byte("txHash4"), "erin", 1))
+	// the capacity is exceeded, thus adding a new transaction triggers the eviction
+	txCacheIntraShard.AddTx(createTx([]byte("txHash5"), "frank", 1))
+
+	res, err = atp.GetTransactionsPoolEvictions()
+	require.NoError(t, err)
+	require.Len(t, res.Rounds, 1)
+	require.Equal(t, uint64(5), res.Rounds[0].NumTxsBefore)
+	require.Equal(t, uint64(5), res.Rounds[0].NumSendersBefore)
+	require.Equal(t, uint32(2), res.Rounds[0].NumSendersEvicted)
+	require.Len(t, res.Rounds[0].EvictedSenders, 2)
+	require.Subset(t, []string{"alice", "bob", "carol", "dave", "erin"}, res.Rounds[0].EvictedSenders)
+}
+
 func TestApiTransactionProcessor_GetTransactionsPoolForSender(t *testing.T) {
 	t.Parallel()
 
@@ -954,6 +1204,83 @@ func TestApiTransactionProcessor_GetTransactionsPoolNonceGapsForSender(t *testin
 	}, res)
 }
 
+func TestApiTransactionProcessor_GetTransactionsPoolReplacementsForSender(t *testing.T) {
+	t.Parallel()
+
+	txHash0, txHash1 := []byte("txHash0"), []byte("txHash1")
+	sender := "alice"
+	txCacheIntraShard, _ := txcache.NewTxCache(txcache.ConfigSourceMe{
+		Name:                               "test",
+		NumChunks:                          4,
+		NumBytesPerSenderThreshold:         1_048_576, // 1 MB
+		CountPerSenderThreshold:            math.MaxUint32,
+		ReplaceByFeeMinGasPriceBumpPercent: 10,
+	}, &txcachemocks.TxGasHandlerMock{
+		MinimumGasMove:       1,
+		MinimumGasPrice:      1,
+		GasProcessingDivisor: 1,
+	})
+
+	replacedTx := createTx(txHash0, sender, 7)
+	replacedTx.Tx.(*transaction.Transaction).GasPrice = 100
+	replacementTx := createTx(txHash1, sender, 7)
+	replacementTx.Tx.(*transaction.Transaction).GasPrice = 110
+	txCacheIntraShard.AddTx(replacedTx)
+	txCacheIntraShard.AddTx(replacementTx)
+
+	args := createMockArgAPITransactionProcessor()
+	args.DataPool = &dataRetrieverMock.PoolsHolderStub{
+		TransactionsCalled: func() dataRetriever.ShardedDataCacherNotifier {
+			return &testscommon.ShardedDataStub{
+				ShardDataStoreCalled: func(cacheID string) storage.Cacher {
+					return txCacheIntraShard
+				},
+			}
+		},
+	}
+	args.AddressPubKeyConverter = &mock.PubkeyConverterStub{
+		DecodeCalled: func(humanReadable string) ([]byte, error) {
+			return []byte(humanReadable), nil
+		},
+		EncodeCalled: func(pkBytes []byte) string {
+			return string(pkBytes)
+		},
+	}
+	args.ShardCoordinator = &processMocks.ShardCoordinatorStub{
+		NumberOfShardsCalled: func() uint32 {
+			return 1
+		},
+	}
+	atp, err := NewAPITransactionProcessor(args)
+	require.NoError(t, err)
+	require.NotNil(t, atp)
+
+	expectedResponse := &common.TransactionsPoolReplacementsForSenderApiResponse{
+		Sender: sender,
+		Replacements: []common.TxReplacementApiResponse{
+			{
+				Nonce:               7,
+				ReplacedTxHash:      hex.EncodeToString(txHash0),
+				ReplacedGasPrice:    100,
+				ReplacementTxHash:   hex.EncodeToString(txHash1),
+				ReplacementGasPrice: 110,
+			},
+		},
+	}
+	res, err := atp.GetTransactionsPoolReplacementsForSender(sender)
+	require.NoError(t, err)
+	require.Equal(t, expectedResponse, res)
+
+	// a sender without replacements isn't an error, but returns an empty slice
+	newSender := "new-sender"
+	res, err = atp.GetTransactionsPoolReplacementsForSender(newSender)
+	require.NoError(t, err)
+	require.Equal(t, &common.TransactionsPoolReplacementsForSenderApiResponse{
+		Sender:       newSender,
+		Replacements: []common.TxReplacementApiResponse{},
+	}, res)
+}
+
 func createAPITransactionProc(t *testing.T, epoch uint32, withDbLookupExt bool) (*apiTransactionProcessor, *genericMocks.ChainStorerMock, *dataRetrieverMock.PoolsHolderMock, *dblookupextMock.HistoryRepositoryStub) {
 	chainStorer := genericMocks.NewChainStorerMock(epoch)
 	dataPool := dataRetrieverMock.NewPoolsHolderMock()
@@ -1031,6 +1358,70 @@ func setupGetMiniblockMetadataByTxHash(
 	}
 }
 
+func TestApiTransactionProcessor_GetTransactionsByAddress(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgAPITransactionProcessor()
+		atp, _ := NewAPITransactionProcessor(args)
+
+		response, err := atp.GetTransactionsByAddress("not hex", common.AddressTransactionsQueryOptions{Size: 10})
+		require.Nil(t, response)
+		require.True(t, strings.Contains(err.Error(), ErrInvalidAddress.Error()))
+	})
+
+	t.Run("history repository error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgAPITransactionProcessor()
+		args.HistoryRepository = &dblookupextMock.HistoryRepositoryStub{
+			GetTxHashesByAddressCalled: func(address []byte, from uint32, size uint32, ascending bool) ([]*dblookupext.TxHashByAddress, error) {
+				return nil, expectedErr
+			},
+		}
+		atp, _ := NewAPITransactionProcessor(args)
+
+		response, err := atp.GetTransactionsByAddress("aabb", common.AddressTransactionsQueryOptions{Size: 10})
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, expectedErr))
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgAPITransactionProcessor()
+		args.HistoryRepository = &dblookupextMock.HistoryRepositoryStub{
+			GetTxHashesByAddressCalled: func(address []byte, from uint32, size uint32, ascending bool) ([]*dblookupext.TxHashByAddress, error) {
+				require.Equal(t, []byte{0xaa, 0xbb}, address)
+				require.Equal(t, uint32(5), from)
+				require.Equal(t, uint32(10), size)
+				require.True(t, ascending)
+
+				return []*dblookupext.TxHashByAddress{
+					{TxHash: []byte{1}, BlockHash: []byte{2}, BlockNonce: 3, Round: 4, Epoch: 5, MiniblockType: int32(block.TxBlock), IsSender: true},
+					{TxHash: []byte{6}, BlockHash: []byte{7}, BlockNonce: 8, Round: 9, Epoch: 10, MiniblockType: int32(block.SmartContractResultBlock), IsReceiver: true},
+					{TxHash: []byte{11}, BlockHash: []byte{12}, BlockNonce: 13, Round: 14, Epoch: 15, MiniblockType: int32(block.TxBlock), IsSender: true, IsReceiver: true},
+				}, nil
+			},
+		}
+		atp, _ := NewAPITransactionProcessor(args)
+
+		response, err := atp.GetTransactionsByAddress("aabb", common.AddressTransactionsQueryOptions{From: 5, Size: 10, Ascending: true})
+		require.Nil(t, err)
+		require.Equal(t, &common.AddressTransactionsApiResponse{
+			Address: "aabb",
+			Transactions: []common.AddressTransactionApiResponse{
+				{Hash: "01", MiniblockType: "TxBlock", Direction: directionOut, BlockNonce: 3, BlockHash: "02", Round: 4, Epoch: 5},
+				{Hash: "06", MiniblockType: "SmartContractResultBlock", Direction: directionIn, BlockNonce: 8, BlockHash: "07", Round: 9, Epoch: 10},
+				{Hash: "0b", MiniblockType: "TxBlock", Direction: directionSelf, BlockNonce: 13, BlockHash: "0c", Round: 14, Epoch: 15},
+			},
+		}, response)
+	})
+}
+
 func TestPrepareUnsignedTx(t *testing.T) {
 	t.Parallel()
 	addrSize := 32
@@ -1203,3 +1594,268 @@ func TestApiTransactionProcessor_PopulateComputedFields(t *testing.T) {
 	require.Equal(t, "SCDeployment", apiTx.ProcessingTypeOnDestination)
 	require.Equal(t, "1000", apiTx.InitiallyPaidFee)
 }
+
+func TestApiTransactionProcessor_GetTransactionStateDiff(t *testing.T) {
+	t.Parallel()
+
+	txHash := []byte("hash")
+	t.Run("invalid hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _ := NewAPITransactionProcessor(createMockArgAPITransactionProcessor())
+		stateDiff, err := atp.GetTransactionStateDiff("not hex")
+		require.Nil(t, stateDiff)
+		require.NotNil(t, err)
+	})
+	t.Run("db lookup extensions disabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgAPITransactionProcessor()
+		args.HistoryRepository = &dblookupextMock.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return false
+			},
+		}
+		atp, _ := NewAPITransactionProcessor(args)
+		stateDiff, err := atp.GetTransactionStateDiff(hex.EncodeToString(txHash))
+		require.Nil(t, stateDiff)
+		require.Equal(t, ErrStateDiffNotAvailable, err)
+	})
+	t.Run("unknown transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgAPITransactionProcessor()
+		args.HistoryRepository = &dblookupextMock.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+			GetMiniblockMetadataByTxHashCalled: func(hash []byte) (*dblookupext.MiniblockMetadata, error) {
+				return nil, storage.ErrKeyNotFound
+			},
+		}
+		atp, _ := NewAPITransactionProcessor(args)
+		stateDiff, err := atp.GetTransactionStateDiff(hex.EncodeToString(txHash))
+		require.Nil(t, stateDiff)
+		require.True(t, errors.Is(err, storage.ErrKeyNotFound))
+		require.True(t, strings.Contains(err.Error(), ErrTransactionNotFound.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgAPITransactionProcessor()
+		args.HistoryRepository = &dblookupextMock.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+			GetMiniblockMetadataByTxHashCalled: func(hash []byte) (*dblookupext.MiniblockMetadata, error) {
+				return &dblookupext.MiniblockMetadata{Epoch: 7}, nil
+			},
+			GetStateDiffByTxHashCalled: func(hash []byte, epoch uint32) ([]*state.AccountStateChanges, error) {
+				require.Equal(t, txHash, hash)
+				require.Equal(t, uint32(7), epoch)
+
+				return []*state.AccountStateChanges{
+					{
+						Address:       []byte("alice"),
+						BalanceBefore: big.NewInt(100),
+						BalanceAfter:  big.NewInt(90),
+						NonceBefore:   1,
+						NonceAfter:    2,
+					},
+				}, nil
+			},
+		}
+		atp, _ := NewAPITransactionProcessor(args)
+		stateDiff, err := atp.GetTransactionStateDiff(hex.EncodeToString(txHash))
+		require.Nil(t, err)
+
+		expectedStateDiff := []*txSimData.AccountStateDiff{
+			{
+				Address:       hex.EncodeToString([]byte("alice")),
+				BalanceBefore: "100",
+				BalanceAfter:  "90",
+				NonceBefore:   1,
+				NonceAfter:    2,
+			},
+		}
+		require.Equal(t, expectedStateDiff, stateDiff)
+	})
+}
+
+func TestApiTransactionProcessor_WaitForTransactionStatus(t *testing.T) {
+	t.Parallel()
+
+	txHash := []byte("hash")
+	scrHash := []byte("scrHash")
+	createProcessor := func(miniblocksMetadata map[string]*dblookupext.MiniblockMetadata, mutMetadata *sync.RWMutex) (*apiTransactionProcessor, func()) {
+		chainStorer := genericMocks.NewChainStorerMock(0)
+		_ = chainStorer.Transactions.PutWithMarshalizer(txHash, &transaction.Transaction{Nonce: 7, SndAddr: []byte("alice"), RcvAddr: []byte("bob")}, &mock.MarshalizerFake{})
+		_ = chainStorer.Unsigned.PutWithMarshalizer(scrHash, &smartContractResult.SmartContractResult{Value: big.NewInt(0), OriginalTxHash: txHash}, &mock.MarshalizerFake{})
+
+		var recordedBlocksHandler func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)
+		args := createMockArgAPITransactionProcessor()
+		args.StorageService = chainStorer
+		args.DataPool = dataRetrieverMock.NewPoolsHolderMock()
+		args.HistoryRepository = &dblookupextMock.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+			RegisterRecordedBlocksHandlerCalled: func(handler func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)) {
+				recordedBlocksHandler = handler
+			},
+			GetMiniblockMetadataByTxHashCalled: func(hash []byte) (*dblookupext.MiniblockMetadata, error) {
+				mutMetadata.RLock()
+				defer mutMetadata.RUnlock()
+
+				miniblockMetadata, found := miniblocksMetadata[string(hash)]
+				if !found {
+					return nil, storage.ErrKeyNotFound
+				}
+
+				metadataCopy := *miniblockMetadata
+				return &metadataCopy, nil
+			},
+			GetEventsHashesByTxHashCalled: func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error) {
+				if !bytes.Equal(hash, txHash) {
+					return nil, dblookupext.ErrNotFoundInStorage
+				}
+
+				return &dblookupext.ResultsHashesByTxHash{
+					ScResultsHashesAndEpoch: []*dblookupext.ScResultsHashesAndEpoch{{ScResultsHashes: [][]byte{scrHash}}},
+				}, nil
+			},
+		}
+		atp, err := NewAPITransactionProcessor(args)
+		require.Nil(t, err)
+		require.NotNil(t, recordedBlocksHandler)
+
+		notifyRecordedBlock := func() {
+			recordedBlocksHandler(0, []data.HeaderHandler{&block.Header{}}, [][]byte{[]byte("header hash")})
+		}
+
+		return atp, notifyRecordedBlock
+	}
+
+	t.Run("invalid hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _ := NewAPITransactionProcessor(createMockArgAPITransactionProcessor())
+		response, err := atp.WaitForTransactionStatus("not hex", common.TransactionStatusExecuted, time.Second)
+		require.Nil(t, response)
+		require.NotNil(t, err)
+	})
+	t.Run("invalid status should error", func(t *testing.T) {
+		t.Parallel()
+
+		atp, _ := NewAPITransactionProcessor(createMockArgAPITransactionProcessor())
+		response, err := atp.WaitForTransactionStatus(hex.EncodeToString(txHash), "committed", time.Second)
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, ErrInvalidTransactionStatus))
+	})
+	t.Run("db lookup extensions disabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgAPITransactionProcessor()
+		args.HistoryRepository = &dblookupextMock.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return false
+			},
+		}
+		atp, _ := NewAPITransactionProcessor(args)
+		response, err := atp.WaitForTransactionStatus(hex.EncodeToString(txHash), common.TransactionStatusExecuted, time.Second)
+		require.Nil(t, response)
+		require.Equal(t, ErrTransactionStatusWaitNotAvailable, err)
+	})
+	t.Run("unknown transaction should error on timeout", func(t *testing.T) {
+		t.Parallel()
+
+		atp, notifyRecordedBlock := createProcessor(make(map[string]*dblookupext.MiniblockMetadata), &sync.RWMutex{})
+		notifyRecordedBlock()
+
+		response, err := atp.WaitForTransactionStatus(hex.EncodeToString(txHash), common.TransactionStatusExecuted, time.Millisecond*10)
+		require.Nil(t, response)
+		require.True(t, errors.Is(err, storage.ErrKeyNotFound))
+	})
+	t.Run("status reached before waiting should return immediately", func(t *testing.T) {
+		t.Parallel()
+
+		miniblocksMetadata := map[string]*dblookupext.MiniblockMetadata{
+			string(txHash): {Type: int32(block.TxBlock), SourceShardID: 1, DestinationShardID: 2},
+		}
+		atp, _ := createProcessor(miniblocksMetadata, &sync.RWMutex{})
+
+		response, err := atp.WaitForTransactionStatus(hex.EncodeToString(txHash), string(transaction.TxStatusPending), time.Minute)
+		require.Nil(t, err)
+		require.True(t, response.StatusReached)
+		require.Equal(t, transaction.TxStatusPending, response.Transaction.Status)
+	})
+	t.Run("final outcome with another status should return", func(t *testing.T) {
+		t.Parallel()
+
+		miniblocksMetadata := map[string]*dblookupext.MiniblockMetadata{
+			string(txHash): {Type: int32(block.InvalidBlock), SourceShardID: 1, DestinationShardID: 1},
+		}
+		atp, _ := createProcessor(miniblocksMetadata, &sync.RWMutex{})
+
+		response, err := atp.WaitForTransactionStatus(hex.EncodeToString(txHash), string(transaction.TxStatusSuccess), time.Minute)
+		require.Nil(t, err)
+		require.False(t, response.StatusReached)
+		require.Equal(t, transaction.TxStatusInvalid, response.Transaction.Status)
+	})
+	t.Run("pending results should time out", func(t *testing.T) {
+		t.Parallel()
+
+		miniblocksMetadata := map[string]*dblookupext.MiniblockMetadata{
+			string(txHash):  {Type: int32(block.TxBlock), SourceShardID: 1, DestinationShardID: 2, NotarizedAtDestinationInMetaNonce: 3},
+			string(scrHash): {Type: int32(block.SmartContractResultBlock), SourceShardID: 2, DestinationShardID: 0},
+		}
+		atp, _ := createProcessor(miniblocksMetadata, &sync.RWMutex{})
+
+		response, err := atp.WaitForTransactionStatus(hex.EncodeToString(txHash), common.TransactionStatusExecuted, time.Millisecond*10)
+		require.Nil(t, err)
+		require.False(t, response.StatusReached)
+		require.Equal(t, transaction.TxStatusSuccess, response.Transaction.Status)
+	})
+	t.Run("should wait for the cross-shard execution of the transaction and of its results", func(t *testing.T) {
+		t.Parallel()
+
+		mutMetadata := &sync.RWMutex{}
+		miniblocksMetadata := map[string]*dblookupext.MiniblockMetadata{
+			string(txHash): {Type: int32(block.TxBlock), SourceShardID: 1, DestinationShardID: 2},
+		}
+		atp, notifyRecordedBlock := createProcessor(miniblocksMetadata, mutMetadata)
+
+		chResponse := make(chan *common.TransactionStatusWaitApiResponse)
+		go func() {
+			response, err := atp.WaitForTransactionStatus(hex.EncodeToString(txHash), common.TransactionStatusExecuted, time.Minute)
+			require.Nil(t, err)
+			chResponse <- response
+		}()
+
+		updateMetadataAndNotify := func(hash []byte, metadata *dblookupext.MiniblockMetadata) {
+			mutMetadata.Lock()
+			miniblocksMetadata[string(hash)] = metadata
+			mutMetadata.Unlock()
+			notifyRecordedBlock()
+		}
+
+		// the transaction gets executed at destination, the result is sent to the third shard
+		updateMetadataAndNotify(txHash, &dblookupext.MiniblockMetadata{Type: int32(block.TxBlock), SourceShardID: 1, DestinationShardID: 2, NotarizedAtDestinationInMetaNonce: 3})
+		updateMetadataAndNotify(scrHash, &dblookupext.MiniblockMetadata{Type: int32(block.SmartContractResultBlock), SourceShardID: 2, DestinationShardID: 0})
+		select {
+		case <-chResponse:
+			require.Fail(t, "should have waited for the smart contract result")
+		case <-time.After(time.Millisecond * 50):
+		}
+
+		updateMetadataAndNotify(scrHash, &dblookupext.MiniblockMetadata{Type: int32(block.SmartContractResultBlock), SourceShardID: 2, DestinationShardID: 0, NotarizedAtDestinationInMetaNonce: 5})
+		select {
+		case response := <-chResponse:
+			require.True(t, response.StatusReached)
+			require.Equal(t, transaction.TxStatusSuccess, response.Transaction.Status)
+			require.Len(t, response.Transaction.SmartContractResults, 1)
+		case <-time.After(time.Second * 5):
+			require.Fail(t, "timeout waiting for the transaction status")
+		}
+	})
+}
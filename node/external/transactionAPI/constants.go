@@ -4,4 +4,7 @@ const (
 	gasRefundForRelayerMessage            = "gas refund for relayer"
 	okReturnCodeMarker                    = "@6f6b"
 	okReturnCodeMarkerBackwardsCompatible = "@ok"
+	directionIn                           = "in"
+	directionOut                          = "out"
+	directionSelf                         = "self"
 )
@@ -34,3 +34,15 @@ var ErrInvalidAddress = errors.New("invalid address")
 
 // ErrCannotRetrieveNonce signals that nonce cannot be retrieved
 var ErrCannotRetrieveNonce = errors.New("nonce cannot be retrieved")
+
+// ErrStateDiffNotAvailable signals that the state diff of a transaction is not available
+var ErrStateDiffNotAvailable = errors.New("state diff not available")
+
+// ErrTransactionStatusWaitNotAvailable signals that waiting for a transaction status is not available
+var ErrTransactionStatusWaitNotAvailable = errors.New("waiting for a transaction status is not available")
+
+// ErrInvalidTransactionStatus signals that an invalid transaction status has been provided
+var ErrInvalidTransactionStatus = errors.New("invalid transaction status")
+
+// ErrInvalidTransactionsPoolOrder signals that an invalid order of the transactions from pool has been provided
+var ErrInvalidTransactionsPoolOrder = errors.New("invalid order of the transactions from pool")
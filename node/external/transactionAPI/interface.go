@@ -4,6 +4,8 @@ import (
 	"math/big"
 
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+	"github.com/ElrondNetwork/elrond-go/state"
 	datafield "github.com/ElrondNetwork/elrond-vm-common/parsers/dataField"
 )
 
@@ -12,6 +14,11 @@ type feeComputer interface {
 	IsInterfaceNil() bool
 }
 
+type stateDiffConverter interface {
+	ConvertStateChanges(stateChanges []*state.AccountStateChanges) []*txSimData.AccountStateDiff
+	IsInterfaceNil() bool
+}
+
 // LogsFacade defines the interface of a logs facade
 type LogsFacade interface {
 	GetLog(logKey []byte, epoch uint32) (*transaction.ApiLogs, error)
@@ -0,0 +1,35 @@
+package transactionAPI
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/data"
+)
+
+// recordedBlocksNotifier wakes up all the routines waiting for the history repository to record new blocks. Each
+// recording closes the current channel and replaces it with a new one
+type recordedBlocksNotifier struct {
+	mutChannel        sync.RWMutex
+	recordedBlockChan chan struct{}
+}
+
+func newRecordedBlocksNotifier() *recordedBlocksNotifier {
+	return &recordedBlocksNotifier{
+		recordedBlockChan: make(chan struct{}),
+	}
+}
+
+func (rbn *recordedBlocksNotifier) onRecordedBlocks(_ uint32, _ []data.HeaderHandler, _ [][]byte) {
+	rbn.mutChannel.Lock()
+	close(rbn.recordedBlockChan)
+	rbn.recordedBlockChan = make(chan struct{})
+	rbn.mutChannel.Unlock()
+}
+
+// getRecordedBlockChan returns a channel which will be closed when the next block gets recorded
+func (rbn *recordedBlocksNotifier) getRecordedBlockChan() <-chan struct{} {
+	rbn.mutChannel.RLock()
+	defer rbn.mutChannel.RUnlock()
+
+	return rbn.recordedBlockChan
+}
@@ -0,0 +1,99 @@
+package transactionAPI
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/storage/txcache"
+)
+
+// txsPoolQuery holds the fields to be returned, the filters and the order applied to the transactions from pool
+type txsPoolQuery struct {
+	requestedFieldsHandler fieldsHandler
+	orderBy                string
+	receiver               []byte
+	dataPrefix             []byte
+}
+
+func newTxsPoolQuery(options common.TransactionsPoolQueryOptions, pubKeyConverter core.PubkeyConverter) (*txsPoolQuery, error) {
+	switch options.OrderBy {
+	case "", common.TransactionsPoolOrderByGasPrice, common.TransactionsPoolOrderByScore:
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidTransactionsPoolOrder, options.OrderBy)
+	}
+
+	query := &txsPoolQuery{
+		requestedFieldsHandler: newFieldsHandler(options.Fields),
+		orderBy:                options.OrderBy,
+		dataPrefix:             []byte(options.DataPrefix),
+	}
+
+	if len(options.Receiver) > 0 {
+		receiver, err := pubKeyConverter.Decode(options.Receiver)
+		if err != nil {
+			return nil, fmt.Errorf("%s, %w", ErrInvalidAddress.Error(), err)
+		}
+
+		query.receiver = receiver
+	}
+
+	return query, nil
+}
+
+func (query *txsPoolQuery) matches(tx data.TransactionHandler) bool {
+	if len(query.receiver) > 0 && !bytes.Equal(tx.GetRcvAddr(), query.receiver) {
+		return false
+	}
+
+	return bytes.HasPrefix(tx.GetData(), query.dataPrefix)
+}
+
+// sort orders the transactions as requested. The transactions having the same gas price (or whose senders have the same
+// score) are ordered by sender and nonce
+func (query *txsPoolQuery) sort(wrappedTxs []*txcache.WrappedTransaction, getScoreOfSender func(sender []byte) uint32) {
+	switch query.orderBy {
+	case common.TransactionsPoolOrderByGasPrice:
+		sort.SliceStable(wrappedTxs, func(i, j int) bool {
+			gasPriceI, gasPriceJ := wrappedTxs[i].Tx.GetGasPrice(), wrappedTxs[j].Tx.GetGasPrice()
+			if gasPriceI != gasPriceJ {
+				return gasPriceI > gasPriceJ
+			}
+
+			return isLowerBySenderAndNonce(wrappedTxs[i], wrappedTxs[j])
+		})
+	case common.TransactionsPoolOrderByScore:
+		scores := make(map[string]uint32)
+		scoreOf := func(wrappedTx *txcache.WrappedTransaction) uint32 {
+			sender := wrappedTx.Tx.GetSndAddr()
+			score, found := scores[string(sender)]
+			if !found {
+				score = getScoreOfSender(sender)
+				scores[string(sender)] = score
+			}
+
+			return score
+		}
+
+		sort.SliceStable(wrappedTxs, func(i, j int) bool {
+			scoreI, scoreJ := scoreOf(wrappedTxs[i]), scoreOf(wrappedTxs[j])
+			if scoreI != scoreJ {
+				return scoreI > scoreJ
+			}
+
+			return isLowerBySenderAndNonce(wrappedTxs[i], wrappedTxs[j])
+		})
+	}
+}
+
+func isLowerBySenderAndNonce(first *txcache.WrappedTransaction, second *txcache.WrappedTransaction) bool {
+	senderComparison := bytes.Compare(first.Tx.GetSndAddr(), second.Tx.GetSndAddr())
+	if senderComparison != 0 {
+		return senderComparison < 0
+	}
+
+	return first.Tx.GetNonce() < second.Tx.GetNonce()
+}
@@ -1,12 +1,16 @@
 package mock
 
-import "github.com/ElrondNetwork/elrond-go-core/data/api"
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go/common"
+)
 
 // BlockAPIHandlerStub -
 type BlockAPIHandlerStub struct {
-	GetBlockByNonceCalled func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
-	GetBlockByHashCalled  func(hash []byte, options api.BlockQueryOptions) (*api.Block, error)
-	GetBlockByRoundCalled func(round uint64, options api.BlockQueryOptions) (*api.Block, error)
+	GetBlockByNonceCalled      func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
+	GetBlockByHashCalled       func(hash []byte, options api.BlockQueryOptions) (*api.Block, error)
+	GetBlockByRoundCalled      func(round uint64, options api.BlockQueryOptions) (*api.Block, error)
+	GetHyperblockByNonceCalled func(nonce uint64, options api.BlockQueryOptions) (*common.ApiHyperblock, error)
 }
 
 // GetBlockByNonce -
@@ -36,6 +40,15 @@ func (bah *BlockAPIHandlerStub) GetBlockByRound(round uint64, options api.BlockQ
 	return nil, nil
 }
 
+// GetHyperblockByNonce -
+func (bah *BlockAPIHandlerStub) GetHyperblockByNonce(nonce uint64, options api.BlockQueryOptions) (*common.ApiHyperblock, error) {
+	if bah.GetHyperblockByNonceCalled != nil {
+		return bah.GetHyperblockByNonceCalled(nonce, options)
+	}
+
+	return nil, nil
+}
+
 // IsInterfaceNil -
 func (bah *BlockAPIHandlerStub) IsInterfaceNil() bool {
 	return bah == nil
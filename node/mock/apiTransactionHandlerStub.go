@@ -1,20 +1,29 @@
 package mock
 
 import (
+	"time"
+
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 	"github.com/ElrondNetwork/elrond-go/common"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 )
 
 // TransactionAPIHandlerStub -
 type TransactionAPIHandlerStub struct {
-	GetTransactionCalled                        func(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
-	GetTransactionsPoolCalled                   func(fields string) (*common.TransactionsPoolAPIResponse, error)
-	GetTransactionsPoolForSenderCalled          func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
-	GetLastPoolNonceForSenderCalled             func(sender string) (uint64, error)
-	GetTransactionsPoolNonceGapsForSenderCalled func(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
-	UnmarshalTransactionCalled                  func(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error)
-	UnmarshalReceiptCalled                      func(receiptBytes []byte) (*transaction.ApiReceipt, error)
-	PopulateComputedFieldsCalled                func(tx *transaction.ApiTransactionResult)
+	GetTransactionCalled                           func(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
+	GetTransactionStateDiffCalled                  func(hash string) ([]*txSimData.AccountStateDiff, error)
+	WaitForTransactionStatusCalled                 func(hash string, status string, timeout time.Duration) (*common.TransactionStatusWaitApiResponse, error)
+	GetTransactionsPoolCalled                      func(options common.TransactionsPoolQueryOptions) (*common.TransactionsPoolAPIResponse, error)
+	GetTransactionsPoolForSenderCalled             func(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
+	GetLastPoolNonceForSenderCalled                func(sender string) (uint64, error)
+	GetTransactionsPoolNonceGapsForSenderCalled    func(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
+	GetTransactionsPoolReplacementsForSenderCalled func(sender string) (*common.TransactionsPoolReplacementsForSenderApiResponse, error)
+	GetTransactionsPoolStatsForSenderCalled        func(sender string) (*common.TransactionsPoolSenderStatsApiResponse, error)
+	GetTransactionsPoolEvictionsCalled             func() (*common.TransactionsPoolEvictionsApiResponse, error)
+	GetTransactionsByAddressCalled                 func(address string, options common.AddressTransactionsQueryOptions) (*common.AddressTransactionsApiResponse, error)
+	UnmarshalTransactionCalled                     func(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error)
+	UnmarshalReceiptCalled                         func(receiptBytes []byte) (*transaction.ApiReceipt, error)
+	PopulateComputedFieldsCalled                   func(tx *transaction.ApiTransactionResult)
 }
 
 // GetTransaction -
@@ -26,10 +35,28 @@ func (tas *TransactionAPIHandlerStub) GetTransaction(hash string, withResults bo
 	return nil, nil
 }
 
+// GetTransactionStateDiff -
+func (tas *TransactionAPIHandlerStub) GetTransactionStateDiff(hash string) ([]*txSimData.AccountStateDiff, error) {
+	if tas.GetTransactionStateDiffCalled != nil {
+		return tas.GetTransactionStateDiffCalled(hash)
+	}
+
+	return nil, nil
+}
+
+// WaitForTransactionStatus -
+func (tas *TransactionAPIHandlerStub) WaitForTransactionStatus(hash string, status string, timeout time.Duration) (*common.TransactionStatusWaitApiResponse, error) {
+	if tas.WaitForTransactionStatusCalled != nil {
+		return tas.WaitForTransactionStatusCalled(hash, status, timeout)
+	}
+
+	return nil, nil
+}
+
 // GetTransactionsPool -
-func (tas *TransactionAPIHandlerStub) GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error) {
+func (tas *TransactionAPIHandlerStub) GetTransactionsPool(options common.TransactionsPoolQueryOptions) (*common.TransactionsPoolAPIResponse, error) {
 	if tas.GetTransactionsPoolCalled != nil {
-		return tas.GetTransactionsPoolCalled(fields)
+		return tas.GetTransactionsPoolCalled(options)
 	}
 
 	return nil, nil
@@ -62,6 +89,42 @@ func (tas *TransactionAPIHandlerStub) GetTransactionsPoolNonceGapsForSender(send
 	return nil, nil
 }
 
+// GetTransactionsPoolReplacementsForSender -
+func (tas *TransactionAPIHandlerStub) GetTransactionsPoolReplacementsForSender(sender string) (*common.TransactionsPoolReplacementsForSenderApiResponse, error) {
+	if tas.GetTransactionsPoolReplacementsForSenderCalled != nil {
+		return tas.GetTransactionsPoolReplacementsForSenderCalled(sender)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolStatsForSender -
+func (tas *TransactionAPIHandlerStub) GetTransactionsPoolStatsForSender(sender string) (*common.TransactionsPoolSenderStatsApiResponse, error) {
+	if tas.GetTransactionsPoolStatsForSenderCalled != nil {
+		return tas.GetTransactionsPoolStatsForSenderCalled(sender)
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsPoolEvictions -
+func (tas *TransactionAPIHandlerStub) GetTransactionsPoolEvictions() (*common.TransactionsPoolEvictionsApiResponse, error) {
+	if tas.GetTransactionsPoolEvictionsCalled != nil {
+		return tas.GetTransactionsPoolEvictionsCalled()
+	}
+
+	return nil, nil
+}
+
+// GetTransactionsByAddress -
+func (tas *TransactionAPIHandlerStub) GetTransactionsByAddress(address string, options common.AddressTransactionsQueryOptions) (*common.AddressTransactionsApiResponse, error) {
+	if tas.GetTransactionsByAddressCalled != nil {
+		return tas.GetTransactionsByAddressCalled(address, options)
+	}
+
+	return nil, nil
+}
+
 // UnmarshalTransaction -
 func (tas *TransactionAPIHandlerStub) UnmarshalTransaction(txBytes []byte, txType transaction.TxType) (*transaction.ApiTransactionResult, error) {
 	if tas.UnmarshalTransactionCalled != nil {
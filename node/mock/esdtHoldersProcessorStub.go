@@ -0,0 +1,26 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+// ESDTHoldersProcessorStub -
+type ESDTHoldersProcessorStub struct {
+	GetESDTHoldersCalled func(options common.ESDTHoldersQueryOptions, ctx context.Context) (*common.ESDTHoldersApiResponse, error)
+}
+
+// GetESDTHolders -
+func (ehps *ESDTHoldersProcessorStub) GetESDTHolders(options common.ESDTHoldersQueryOptions, ctx context.Context) (*common.ESDTHoldersApiResponse, error) {
+	if ehps.GetESDTHoldersCalled != nil {
+		return ehps.GetESDTHoldersCalled(options, ctx)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (ehps *ESDTHoldersProcessorStub) IsInterfaceNil() bool {
+	return ehps == nil
+}
@@ -0,0 +1,26 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+)
+
+// HistoricalTransactionSimulatorStub -
+type HistoricalTransactionSimulatorStub struct {
+	ProcessHistoricalTxCalled func(tx *transaction.Transaction, options api.AccountQueryOptions) (*txSimData.SimulationResults, error)
+}
+
+// ProcessHistoricalTx -
+func (htss *HistoricalTransactionSimulatorStub) ProcessHistoricalTx(tx *transaction.Transaction, options api.AccountQueryOptions) (*txSimData.SimulationResults, error) {
+	if htss.ProcessHistoricalTxCalled != nil {
+		return htss.ProcessHistoricalTxCalled(tx, options)
+	}
+
+	return &txSimData.SimulationResults{}, nil
+}
+
+// IsInterfaceNil -
+func (htss *HistoricalTransactionSimulatorStub) IsInterfaceNil() bool {
+	return htss == nil
+}
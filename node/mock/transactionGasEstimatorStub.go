@@ -0,0 +1,35 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+)
+
+// TransactionGasEstimatorStub -
+type TransactionGasEstimatorStub struct {
+	EstimateTransactionGasCalled         func(tx *transaction.Transaction) (*txSimData.GasEstimation, error)
+	EstimateSmartContractResultGasCalled func(scr *transaction.ApiSmartContractResult) (*txSimData.GasEstimation, error)
+}
+
+// EstimateTransactionGas -
+func (tges *TransactionGasEstimatorStub) EstimateTransactionGas(tx *transaction.Transaction) (*txSimData.GasEstimation, error) {
+	if tges.EstimateTransactionGasCalled != nil {
+		return tges.EstimateTransactionGasCalled(tx)
+	}
+
+	return &txSimData.GasEstimation{}, nil
+}
+
+// EstimateSmartContractResultGas -
+func (tges *TransactionGasEstimatorStub) EstimateSmartContractResultGas(scr *transaction.ApiSmartContractResult) (*txSimData.GasEstimation, error) {
+	if tges.EstimateSmartContractResultGasCalled != nil {
+		return tges.EstimateSmartContractResultGasCalled(scr)
+	}
+
+	return &txSimData.GasEstimation{}, nil
+}
+
+// IsInterfaceNil -
+func (tges *TransactionGasEstimatorStub) IsInterfaceNil() bool {
+	return tges == nil
+}
@@ -34,6 +34,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process/dataValidators"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	procTx "github.com/ElrondNetwork/elrond-go/process/transaction"
+	"github.com/ElrondNetwork/elrond-go/process/txsSender"
 	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/ElrondNetwork/elrond-go/trie"
 	"github.com/ElrondNetwork/elrond-go/vm"
@@ -44,6 +45,9 @@ import (
 const (
 	// esdtTickerNumChars represents the number of hex-encoded characters of a ticker
 	esdtTickerNumChars = 6
+
+	// keyValuePairsContinuationTokenSeparator separates the data trie root hash from the next key in a continuation token
+	keyValuePairsContinuationTokenSeparator = "-"
 )
 
 var log = logger.GetOrCreate("node")
@@ -299,6 +303,123 @@ func (n *Node) GetKeyValuePairs(address string, options api.AccountQueryOptions,
 	return mapToReturn, blockInfo, nil
 }
 
+// GetKeyValuePairsPage returns a page of the key-value pairs under the address, filtered by the provided key prefix. The
+// returned continuation token pins the data trie root hash, so that all the pages are read from the same state
+func (n *Node) GetKeyValuePairsPage(
+	address string,
+	options api.AccountQueryOptions,
+	pageOptions common.KeyValuePairsQueryOptions,
+	ctx context.Context,
+) (*common.KeyValuePairsApiResponse, api.BlockInfo, error) {
+	if pageOptions.Limit == 0 {
+		return nil, api.BlockInfo{}, ErrInvalidPageLimit
+	}
+
+	rootHash, startKey, err := parseKeyValuePairsContinuationToken(pageOptions.ContinuationToken)
+	if err != nil {
+		return nil, api.BlockInfo{}, err
+	}
+
+	userAccount, blockInfo, err := n.loadUserAccountHandlerByAddress(address, options)
+	if err != nil {
+		return nil, api.BlockInfo{}, err
+	}
+
+	response := &common.KeyValuePairsApiResponse{
+		Pairs: make(map[string]string),
+	}
+	if check.IfNil(userAccount.DataTrie()) {
+		return response, blockInfo, nil
+	}
+
+	if len(rootHash) == 0 {
+		rootHash, err = userAccount.DataTrie().RootHash()
+		if err != nil {
+			return nil, api.BlockInfo{}, err
+		}
+	}
+
+	// the trie walk starts from the continuation token key and is interrupted as soon as the page is complete
+	leavesCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	chLeaves := make(chan core.KeyValueHolder, common.TrieLeavesChannelDefaultCapacity)
+	err = userAccount.DataTrie().GetAllLeavesFromKeyOnChannel(chLeaves, leavesCtx, rootHash, startKey)
+	if err != nil {
+		return nil, api.BlockInfo{}, err
+	}
+
+	startKeyReached := len(startKey) == 0
+	isFirstLeaf := true
+	for leaf := range chLeaves {
+		if isFirstLeaf {
+			isFirstLeaf = false
+			startKeyReached = startKeyReached || bytes.Equal(leaf.Key(), startKey)
+			if !startKeyReached {
+				cancel()
+				break
+			}
+		}
+		// the trie paths are built from the key nibbles in the reversed order, so the prefix can not be seeked
+		if !bytes.HasPrefix(leaf.Key(), pageOptions.Prefix) {
+			continue
+		}
+		if uint32(len(response.Pairs)) == pageOptions.Limit {
+			response.ContinuationToken = createKeyValuePairsContinuationToken(rootHash, leaf.Key())
+			cancel()
+			break
+		}
+
+		suffix := append(leaf.Key(), userAccount.AddressBytes()...)
+		value, errVal := leaf.ValueWithoutSuffix(suffix)
+		if errVal != nil {
+			log.Warn("cannot get value without suffix", "error", errVal, "key", leaf.Key())
+			continue
+		}
+
+		response.Pairs[hex.EncodeToString(leaf.Key())] = hex.EncodeToString(value)
+	}
+
+	// drain the channel, so the trie walk can end
+	for range chLeaves {
+	}
+
+	if common.IsContextDone(ctx) {
+		return nil, api.BlockInfo{}, ErrTrieOperationsTimeout
+	}
+	if !startKeyReached {
+		return nil, api.BlockInfo{}, fmt.Errorf("%w: start key not found", ErrInvalidContinuationToken)
+	}
+
+	return response, blockInfo, nil
+}
+
+func createKeyValuePairsContinuationToken(rootHash []byte, nextKey []byte) string {
+	return hex.EncodeToString(rootHash) + keyValuePairsContinuationTokenSeparator + hex.EncodeToString(nextKey)
+}
+
+func parseKeyValuePairsContinuationToken(token string) ([]byte, []byte, error) {
+	if len(token) == 0 {
+		return nil, nil, nil
+	}
+
+	parts := strings.Split(token, keyValuePairsContinuationTokenSeparator)
+	if len(parts) != 2 {
+		return nil, nil, ErrInvalidContinuationToken
+	}
+
+	rootHash, err := hex.DecodeString(parts[0])
+	if err != nil || len(rootHash) == 0 {
+		return nil, nil, ErrInvalidContinuationToken
+	}
+	startKey, err := hex.DecodeString(parts[1])
+	if err != nil || len(startKey) == 0 {
+		return nil, nil, ErrInvalidContinuationToken
+	}
+
+	return rootHash, startKey, nil
+}
+
 // GetValueForKey will return the value for a key from a given account
 func (n *Node) GetValueForKey(address string, key string, options api.AccountQueryOptions) (string, api.BlockInfo, error) {
 	keyBytes, err := hex.DecodeString(key)
@@ -463,6 +584,30 @@ func (n *Node) GetTokenSupply(token string) (*api.ESDTSupply, error) {
 	}, nil
 }
 
+// GetTokenSupplyHistory returns the supply changes of the provided token, for each epoch of the given range in which the
+// supply changed
+func (n *Node) GetTokenSupplyHistory(token string, fromEpoch uint32, toEpoch uint32) (*common.ESDTSupplyHistoryApiResponse, error) {
+	supplies, err := n.processComponents.HistoryRepository().GetESDTSupplyHistory(token, fromEpoch, toEpoch)
+	if err != nil {
+		return nil, err
+	}
+
+	epochs := make([]*common.ESDTSupplyInEpochApiResponse, 0, len(supplies))
+	for _, supplyInEpoch := range supplies {
+		epochs = append(epochs, &common.ESDTSupplyInEpochApiResponse{
+			Epoch:       supplyInEpoch.Epoch,
+			SupplyDelta: bigToString(supplyInEpoch.Supply.Supply),
+			Minted:      bigToString(supplyInEpoch.Supply.Minted),
+			Burned:      bigToString(supplyInEpoch.Supply.Burned),
+		})
+	}
+
+	return &common.ESDTSupplyHistoryApiResponse{
+		Token:  token,
+		Epochs: epochs,
+	}, nil
+}
+
 func bigToString(bigValue *big.Int) string {
 	if bigValue == nil {
 		return "0"
@@ -582,6 +727,57 @@ func (n *Node) SendBulkTransactions(txs []*transaction.Transaction) (uint64, err
 	return n.processComponents.TxsSenderHandler().SendBulkTransactions(txs)
 }
 
+// ScheduleTransaction adds the transaction to the node-local outbox, to be sent once the release condition is met
+func (n *Node) ScheduleTransaction(tx *transaction.Transaction, condition string, conditionValue uint64) (string, error) {
+	txHash, err := n.processComponents.ScheduledTxsOutbox().ScheduleTransaction(tx, condition, conditionValue)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(txHash), nil
+}
+
+// GetScheduledTransactions returns the transactions waiting in the node-local outbox
+func (n *Node) GetScheduledTransactions() ([]common.ScheduledTransactionApiResponse, error) {
+	pubKeyConverter := n.coreComponents.AddressPubKeyConverter()
+	scheduledTxs := n.processComponents.ScheduledTxsOutbox().GetScheduledTransactions()
+
+	response := make([]common.ScheduledTransactionApiResponse, 0, len(scheduledTxs))
+	for _, scheduledTx := range scheduledTxs {
+		response = append(response, common.ScheduledTransactionApiResponse{
+			TxHash:         hex.EncodeToString(scheduledTx.TxHash),
+			Sender:         pubKeyConverter.Encode(scheduledTx.Tx.SndAddr),
+			Receiver:       pubKeyConverter.Encode(scheduledTx.Tx.RcvAddr),
+			Nonce:          scheduledTx.Tx.Nonce,
+			Condition:      scheduledTx.Condition,
+			ConditionValue: scheduledTx.ConditionValue,
+			ScheduledAt:    scheduledTx.ScheduledAt,
+			Status:         getScheduledTxStatus(scheduledTx),
+			DiscardReason:  scheduledTx.DiscardReason,
+		})
+	}
+
+	return response, nil
+}
+
+func getScheduledTxStatus(scheduledTx *txsSender.ScheduledTransactionInfo) string {
+	if scheduledTx.IsDiscarded() {
+		return common.ScheduledTxStatusDiscarded
+	}
+
+	return common.ScheduledTxStatusPending
+}
+
+// CancelScheduledTransaction removes the transaction from the node-local outbox
+func (n *Node) CancelScheduledTransaction(txHash string) error {
+	txHashBytes, err := hex.DecodeString(txHash)
+	if err != nil {
+		return err
+	}
+
+	return n.processComponents.ScheduledTxsOutbox().CancelScheduledTransaction(txHashBytes)
+}
+
 // ValidateTransaction will validate a transaction
 func (n *Node) ValidateTransaction(tx *transaction.Transaction) error {
 	err := n.checkSenderIsInShard(tx)
@@ -614,6 +810,20 @@ func (n *Node) ValidateTransactionForSimulation(tx *transaction.Transaction, che
 	return err
 }
 
+// ValidateTransactionForScheduling will validate the signature and the format of a transaction added to the outbox. The
+// state of the sender's account is not checked, as the transaction is only sent later, once its release condition is met
+func (n *Node) ValidateTransactionForScheduling(tx *transaction.Transaction) error {
+	err := n.checkSenderIsInShard(tx)
+	if err != nil {
+		return err
+	}
+
+	disabledWhiteListHandler := disabled.NewDisabledWhiteListDataVerifier()
+	_, _, err = n.commonTransactionValidation(tx, disabledWhiteListHandler, disabledWhiteListHandler, true)
+
+	return err
+}
+
 func (n *Node) commonTransactionValidation(
 	tx *transaction.Transaction,
 	whiteListerVerifiedTxs process.WhiteListHandler,
@@ -787,6 +997,15 @@ func (n *Node) CreateTransaction(
 // GetAccount will return account details for a given address
 func (n *Node) GetAccount(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error) {
 	account, blockInfo, err := n.loadUserAccountHandlerByAddress(address, options)
+	return n.createAccountResponse(address, account, blockInfo, err)
+}
+
+func (n *Node) createAccountResponse(
+	address string,
+	account state.UserAccountHandler,
+	blockInfo api.BlockInfo,
+	err error,
+) (api.AccountResponse, api.BlockInfo, error) {
 	if err != nil {
 		apiBlockInfo, ok := extractApiBlockInfoIfErrAccountNotFoundAtBlock(err)
 		if ok {
@@ -819,6 +1038,81 @@ func (n *Node) GetAccount(address string, options api.AccountQueryOptions) (api.
 	}, blockInfo, nil
 }
 
+// GetAccounts returns the accounts of the provided addresses, all resolved against the same state root hash. The
+// returned block info is the one of the state on which the accounts were resolved
+func (n *Node) GetAccounts(addresses []string, options api.AccountQueryOptions) (map[string]*api.AccountResponse, api.BlockInfo, error) {
+	// the block coordinates, including the epoch hint, are resolved once and reused for every account
+	options, err := n.addBlockCoordinatesToAccountQueryOptions(options)
+	if err != nil {
+		return nil, api.BlockInfo{}, err
+	}
+
+	accounts := make(map[string]*api.AccountResponse, len(addresses))
+	blockInfo := api.BlockInfo{}
+	for _, address := range addresses {
+		_, exists := accounts[address]
+		if exists {
+			continue
+		}
+
+		account, accountBlockInfo, errGet := n.getAccountWithResolvedOptions(address, options)
+		if errGet != nil {
+			return nil, api.BlockInfo{}, fmt.Errorf("%w for address %s", errGet, address)
+		}
+		accounts[address] = &account
+
+		if len(accounts) > 1 {
+			continue
+		}
+
+		// the following accounts are loaded from the state of the first one
+		blockInfo = accountBlockInfo
+		options, err = pinAccountQueryOptionsToBlockInfo(options, accountBlockInfo)
+		if err != nil {
+			return nil, api.BlockInfo{}, err
+		}
+	}
+
+	return accounts, blockInfo, nil
+}
+
+func (n *Node) getAccountWithResolvedOptions(address string, options api.AccountQueryOptions) (api.AccountResponse, api.BlockInfo, error) {
+	pubKey, err := n.decodeAddressToPubKey(address)
+	if err != nil {
+		return api.AccountResponse{}, api.BlockInfo{}, err
+	}
+
+	account, blockInfo, err := n.loadUserAccountHandlerWithResolvedOptions(pubKey, options)
+	return n.createAccountResponse(address, account, blockInfo, err)
+}
+
+// pinAccountQueryOptionsToBlockInfo completes the resolved options with the coordinates of the block on which the first
+// account was loaded, if the options did not already select a root hash
+func pinAccountQueryOptionsToBlockInfo(options api.AccountQueryOptions, blockInfo api.BlockInfo) (api.AccountQueryOptions, error) {
+	if len(options.BlockRootHash) > 0 || len(blockInfo.RootHash) == 0 {
+		return options, nil
+	}
+
+	rootHash, err := hex.DecodeString(blockInfo.RootHash)
+	if err != nil {
+		return api.AccountQueryOptions{}, err
+	}
+	blockHash, err := hex.DecodeString(blockInfo.Hash)
+	if err != nil {
+		return api.AccountQueryOptions{}, err
+	}
+
+	options.BlockRootHash = rootHash
+	if len(options.BlockHash) == 0 {
+		options.BlockHash = blockHash
+	}
+	if !options.BlockNonce.HasValue && blockInfo.Nonce > 0 {
+		options.BlockNonce = core.OptionalUint64{Value: blockInfo.Nonce, HasValue: true}
+	}
+
+	return options, nil
+}
+
 // GetCode returns the code for the given code hash
 func (n *Node) GetCode(codeHash []byte, options api.AccountQueryOptions) ([]byte, api.BlockInfo) {
 	return n.loadAccountCode(codeHash, options)
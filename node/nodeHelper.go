@@ -125,6 +125,11 @@ func CreateNode(
 		}
 	}
 
+	err = processComponents.ScheduledTxsOutbox().SetTransactionValidator(nd)
+	if err != nil {
+		return nil, err
+	}
+
 	err = nodeDebugFactory.CreateInterceptedDebugHandler(
 		nd,
 		processComponents.InterceptorsContainer(),
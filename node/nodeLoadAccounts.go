@@ -27,6 +27,11 @@ func (n *Node) loadUserAccountHandlerByPubKey(pubKey []byte, options api.Account
 		return nil, api.BlockInfo{}, err
 	}
 
+	return n.loadUserAccountHandlerWithResolvedOptions(pubKey, options)
+}
+
+// loadUserAccountHandlerWithResolvedOptions loads the account using options already completed with the block coordinates
+func (n *Node) loadUserAccountHandlerWithResolvedOptions(pubKey []byte, options api.AccountQueryOptions) (state.UserAccountHandler, api.BlockInfo, error) {
 	repository := n.stateComponents.AccountsRepository()
 
 	account, blockInfo, err := repository.GetAccountWithBlockInfo(pubKey, options)
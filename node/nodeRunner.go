@@ -558,10 +558,11 @@ func (nr *nodeRunner) createApiFacade(
 			RestApiInterface: flagsConfig.RestApiInterface,
 			PprofEnabled:     flagsConfig.EnablePprof,
 		},
-		ApiRoutesConfig: *configs.ApiRoutesConfig,
-		AccountsState:   currentNode.stateComponents.AccountsAdapter(),
-		PeerState:       currentNode.stateComponents.PeerAccounts(),
-		Blockchain:      currentNode.dataComponents.Blockchain(),
+		ApiRoutesConfig:    *configs.ApiRoutesConfig,
+		AccountsState:      currentNode.stateComponents.AccountsAdapter(),
+		PeerState:          currentNode.stateComponents.PeerAccounts(),
+		Blockchain:         currentNode.dataComponents.Blockchain(),
+		EventsSubscription: currentNode.statusComponents.EventsSubscriptionHandler(),
 	}
 
 	ef, err := facade.NewNodeFacade(argNodeFacade)
@@ -49,6 +49,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/testscommon/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/testscommon/economicsmocks"
 	"github.com/ElrondNetwork/elrond-go/testscommon/epochNotifier"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
 	"github.com/ElrondNetwork/elrond-go/testscommon/mainFactoryMocks"
 	"github.com/ElrondNetwork/elrond-go/testscommon/p2pmocks"
 	"github.com/ElrondNetwork/elrond-go/testscommon/shardingMocks"
@@ -310,6 +311,140 @@ func TestNode_GetKeyValuePairs(t *testing.T) {
 	assert.Equal(t, hex.EncodeToString(v2), resV2)
 }
 
+func createNodeWithDataTrieLeaves(keys [][]byte, rootHash []byte, requestedRootHashes *[][]byte, requestedStartKeys *[][]byte) *node.Node {
+	acc, _ := state.NewUserAccount([]byte("newaddress"))
+	acc.DataTrieTracker().SetDataTrie(
+		&trieMock.TrieStub{
+			GetAllLeavesFromKeyOnChannelCalled: func(ch chan core.KeyValueHolder, ctx context.Context, rootHash []byte, startKey []byte) error {
+				*requestedRootHashes = append(*requestedRootHashes, rootHash)
+				*requestedStartKeys = append(*requestedStartKeys, startKey)
+				go func() {
+					defer close(ch)
+					startKeyReached := len(startKey) == 0
+					for _, key := range keys {
+						startKeyReached = startKeyReached || bytes.Equal(key, startKey)
+						if !startKeyReached {
+							continue
+						}
+
+						suffix := append(key, acc.AddressBytes()...)
+						value := append([]byte("value-"+string(key)), suffix...)
+						select {
+						case ch <- keyValStorage.NewKeyValStorage(key, value):
+						case <-ctx.Done():
+							return
+						}
+					}
+				}()
+
+				return nil
+			},
+			RootCalled: func() ([]byte, error) {
+				return rootHash, nil
+			},
+		})
+
+	accDB := &stateMock.AccountsStub{
+		GetAccountWithBlockInfoCalled: func(address []byte, options common.RootHashHolder) (vmcommon.AccountHandler, common.BlockInfo, error) {
+			return acc, nil, nil
+		},
+	}
+
+	coreComponents := getDefaultCoreComponents()
+	coreComponents.AddrPubKeyConv = createMockPubkeyConverter()
+	stateComponents := getDefaultStateComponents()
+	args := state.ArgsAccountsRepository{
+		FinalStateAccountsWrapper:      accDB,
+		CurrentStateAccountsWrapper:    accDB,
+		HistoricalStateAccountsWrapper: accDB,
+	}
+	stateComponents.AccountsRepo, _ = state.NewAccountsRepository(args)
+	n, _ := node.NewNode(
+		node.WithCoreComponents(coreComponents),
+		node.WithStateComponents(stateComponents),
+		node.WithDataComponents(getDefaultDataComponents()),
+	)
+
+	return n
+}
+
+func TestNode_GetKeyValuePairsPageShouldIterateAllPages(t *testing.T) {
+	t.Parallel()
+
+	keys := [][]byte{[]byte("a1"), []byte("b1"), []byte("a2"), []byte("b2"), []byte("a3")}
+	rootHash := []byte("root hash")
+	requestedRootHashes := make([][]byte, 0)
+	requestedStartKeys := make([][]byte, 0)
+	n := createNodeWithDataTrieLeaves(keys, rootHash, &requestedRootHashes, &requestedStartKeys)
+	address := createDummyHexAddress(64)
+	pageOptions := common.KeyValuePairsQueryOptions{Limit: 2}
+
+	page, _, err := n.GetKeyValuePairsPage(address, api.AccountQueryOptions{}, pageOptions, context.Background())
+	require.Nil(t, err)
+	require.Equal(t, map[string]string{
+		hex.EncodeToString(keys[0]): hex.EncodeToString([]byte("value-a1")),
+		hex.EncodeToString(keys[1]): hex.EncodeToString([]byte("value-b1")),
+	}, page.Pairs)
+	require.Equal(t, hex.EncodeToString(rootHash)+"-"+hex.EncodeToString(keys[2]), page.ContinuationToken)
+
+	pageOptions.ContinuationToken = page.ContinuationToken
+	page, _, err = n.GetKeyValuePairsPage(address, api.AccountQueryOptions{}, pageOptions, context.Background())
+	require.Nil(t, err)
+	require.Equal(t, 2, len(page.Pairs))
+	require.Contains(t, page.Pairs, hex.EncodeToString(keys[2]))
+	require.Contains(t, page.Pairs, hex.EncodeToString(keys[3]))
+
+	pageOptions.ContinuationToken = page.ContinuationToken
+	page, _, err = n.GetKeyValuePairsPage(address, api.AccountQueryOptions{}, pageOptions, context.Background())
+	require.Nil(t, err)
+	require.Equal(t, 1, len(page.Pairs))
+	require.Contains(t, page.Pairs, hex.EncodeToString(keys[4]))
+	require.Empty(t, page.ContinuationToken)
+
+	require.Equal(t, [][]byte{rootHash, rootHash, rootHash}, requestedRootHashes)
+	require.Equal(t, [][]byte{nil, keys[2], keys[4]}, requestedStartKeys)
+}
+
+func TestNode_GetKeyValuePairsPageWithPrefixShouldFilter(t *testing.T) {
+	t.Parallel()
+
+	keys := [][]byte{[]byte("a1"), []byte("b1"), []byte("a2"), []byte("b2"), []byte("a3")}
+	requestedRootHashes := make([][]byte, 0)
+	requestedStartKeys := make([][]byte, 0)
+	n := createNodeWithDataTrieLeaves(keys, []byte("root hash"), &requestedRootHashes, &requestedStartKeys)
+	pageOptions := common.KeyValuePairsQueryOptions{Limit: 10, Prefix: []byte("b")}
+
+	page, _, err := n.GetKeyValuePairsPage(createDummyHexAddress(64), api.AccountQueryOptions{}, pageOptions, context.Background())
+	require.Nil(t, err)
+	require.Equal(t, 2, len(page.Pairs))
+	require.Contains(t, page.Pairs, hex.EncodeToString(keys[1]))
+	require.Contains(t, page.Pairs, hex.EncodeToString(keys[3]))
+	require.Empty(t, page.ContinuationToken)
+}
+
+func TestNode_GetKeyValuePairsPageInvalidOptionsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	keys := [][]byte{[]byte("a1"), []byte("b1")}
+	requestedRootHashes := make([][]byte, 0)
+	requestedStartKeys := make([][]byte, 0)
+	n := createNodeWithDataTrieLeaves(keys, []byte("root hash"), &requestedRootHashes, &requestedStartKeys)
+	address := createDummyHexAddress(64)
+
+	_, _, err := n.GetKeyValuePairsPage(address, api.AccountQueryOptions{}, common.KeyValuePairsQueryOptions{}, context.Background())
+	require.Equal(t, node.ErrInvalidPageLimit, err)
+
+	for _, token := range []string{"abcd", "zz-abcd", "abcd-zz", "-abcd", "abcd-"} {
+		pageOptions := common.KeyValuePairsQueryOptions{Limit: 1, ContinuationToken: token}
+		_, _, err = n.GetKeyValuePairsPage(address, api.AccountQueryOptions{}, pageOptions, context.Background())
+		require.ErrorIs(t, err, node.ErrInvalidContinuationToken)
+	}
+
+	pageOptions := common.KeyValuePairsQueryOptions{Limit: 1, ContinuationToken: "abcd-" + hex.EncodeToString([]byte("missing"))}
+	_, _, err = n.GetKeyValuePairsPage(address, api.AccountQueryOptions{}, pageOptions, context.Background())
+	require.ErrorIs(t, err, node.ErrInvalidContinuationToken)
+}
+
 func TestNode_GetKeyValuePairsContextShouldTimeout(t *testing.T) {
 	acc, _ := state.NewUserAccount([]byte("newaddress"))
 
@@ -2781,6 +2916,162 @@ func TestNode_GetAccountAccountExistsShouldReturn(t *testing.T) {
 	require.Equal(t, testscommon.TestAddressAlice, recovAccnt.OwnerAddress)
 }
 
+func TestNode_GetAccountsShouldResolveAllAccountsOnTheSameRootHash(t *testing.T) {
+	t.Parallel()
+
+	rootHash := []byte("root hash")
+	accountAlice, _ := state.NewUserAccount(testscommon.TestPubKeyAlice)
+	accountAlice.IncreaseNonce(1)
+	accountBob, _ := state.NewUserAccount(testscommon.TestPubKeyBob)
+	accountBob.IncreaseNonce(2)
+	accounts := map[string]vmcommon.AccountHandler{
+		string(testscommon.TestPubKeyAlice): accountAlice,
+		string(testscommon.TestPubKeyBob):   accountBob,
+	}
+
+	currentStateCalls := 0
+	currentAccDB := &stateMock.AccountsStub{
+		GetAccountWithBlockInfoCalled: func(address []byte, options common.RootHashHolder) (vmcommon.AccountHandler, common.BlockInfo, error) {
+			currentStateCalls++
+			return accounts[string(address)], holders.NewBlockInfo([]byte("hash"), 7, rootHash), nil
+		},
+	}
+	historicalRootHashes := make([][]byte, 0)
+	historicalAccDB := &stateMock.AccountsStub{
+		GetAccountWithBlockInfoCalled: func(address []byte, options common.RootHashHolder) (vmcommon.AccountHandler, common.BlockInfo, error) {
+			historicalRootHashes = append(historicalRootHashes, options.GetRootHash())
+			return accounts[string(address)], holders.NewBlockInfo(nil, 0, options.GetRootHash()), nil
+		},
+	}
+
+	coreComponents := getDefaultCoreComponents()
+	dataComponents := getDefaultDataComponents()
+	stateComponents := getDefaultStateComponents()
+	args := state.ArgsAccountsRepository{
+		FinalStateAccountsWrapper:      currentAccDB,
+		CurrentStateAccountsWrapper:    currentAccDB,
+		HistoricalStateAccountsWrapper: historicalAccDB,
+	}
+	stateComponents.AccountsRepo, _ = state.NewAccountsRepository(args)
+	n, _ := node.NewNode(
+		node.WithCoreComponents(coreComponents),
+		node.WithDataComponents(dataComponents),
+		node.WithStateComponents(stateComponents),
+	)
+
+	addresses := []string{testscommon.TestAddressAlice, testscommon.TestAddressBob, testscommon.TestAddressAlice}
+	recovAccounts, blockInfo, err := n.GetAccounts(addresses, api.AccountQueryOptions{})
+
+	require.Nil(t, err)
+	require.Equal(t, 2, len(recovAccounts))
+	require.Equal(t, uint64(1), recovAccounts[testscommon.TestAddressAlice].Nonce)
+	require.Equal(t, uint64(2), recovAccounts[testscommon.TestAddressBob].Nonce)
+	require.Equal(t, uint64(7), blockInfo.Nonce)
+	require.Equal(t, hex.EncodeToString(rootHash), blockInfo.RootHash)
+	require.Equal(t, 1, currentStateCalls)
+	require.Equal(t, [][]byte{rootHash}, historicalRootHashes)
+}
+
+func TestNode_GetAccountsOnBlockNonceShouldReuseTheResolvedOptions(t *testing.T) {
+	t.Parallel()
+
+	epoch := uint32(7)
+	blockHash := []byte("blockHash")
+	blockRootHash := []byte("blockRootHash")
+	accountAlice, _ := state.NewUserAccount(testscommon.TestPubKeyAlice)
+	accountBob, _ := state.NewUserAccount(testscommon.TestPubKeyBob)
+	accounts := map[string]vmcommon.AccountHandler{
+		string(testscommon.TestPubKeyAlice): accountAlice,
+		string(testscommon.TestPubKeyBob):   accountBob,
+	}
+
+	loadedOptions := make([]common.RootHashHolder, 0)
+	historicalAccDB := &stateMock.AccountsStub{
+		GetAccountWithBlockInfoCalled: func(address []byte, options common.RootHashHolder) (vmcommon.AccountHandler, common.BlockInfo, error) {
+			loadedOptions = append(loadedOptions, options)
+			return accounts[string(address)], holders.NewBlockInfo(nil, 0, options.GetRootHash()), nil
+		},
+	}
+
+	coreComponents := getDefaultCoreComponents()
+	dataComponents := getDefaultDataComponents()
+	stateComponents := getDefaultStateComponents()
+	processComponents := getDefaultProcessComponents()
+
+	blockHeader := &block.Header{
+		Nonce:    42,
+		Epoch:    epoch,
+		RootHash: blockRootHash,
+	}
+	blockHeaderBytes, _ := coreComponents.InternalMarshalizer().Marshal(blockHeader)
+	chainStorerMock := genericMocks.NewChainStorerMock(epoch)
+	_ = chainStorerMock.BlockHeaders.PutInEpoch(blockHash, blockHeaderBytes, epoch)
+	nonceAsStorerKey := coreComponents.Uint64ByteSliceConverter().ToByteSlice(42)
+	_ = chainStorerMock.ShardHdrNonce.PutInEpoch(nonceAsStorerKey, blockHash, epoch)
+	dataComponents.Store = chainStorerMock
+	processComponents.HistoryRepositoryInternal = &dblookupext.HistoryRepositoryStub{
+		IsEnabledCalled: func() bool {
+			return true
+		},
+		GetEpochByHashCalled: func(hash []byte) (uint32, error) {
+			return epoch, nil
+		},
+	}
+	processComponents.ScheduledTxsExecutionHandlerInternal = &testscommon.ScheduledTxsExecutionStub{
+		GetScheduledRootHashForHeaderWithEpochCalled: func(headerHash []byte, epoch uint32) ([]byte, error) {
+			return nil, errors.New("missing")
+		},
+	}
+
+	args := state.ArgsAccountsRepository{
+		FinalStateAccountsWrapper:      &stateMock.AccountsStub{},
+		CurrentStateAccountsWrapper:    &stateMock.AccountsStub{},
+		HistoricalStateAccountsWrapper: historicalAccDB,
+	}
+	stateComponents.AccountsRepo, _ = state.NewAccountsRepository(args)
+	n, _ := node.NewNode(
+		node.WithCoreComponents(coreComponents),
+		node.WithDataComponents(dataComponents),
+		node.WithStateComponents(stateComponents),
+		node.WithProcessComponents(processComponents),
+	)
+
+	addresses := []string{testscommon.TestAddressAlice, testscommon.TestAddressBob}
+	options := api.AccountQueryOptions{BlockNonce: core.OptionalUint64{Value: 42, HasValue: true}}
+	recovAccounts, blockInfo, err := n.GetAccounts(addresses, options)
+
+	require.Nil(t, err)
+	require.Equal(t, 2, len(recovAccounts))
+	require.Equal(t, uint64(42), blockInfo.Nonce)
+	require.Equal(t, hex.EncodeToString(blockHash), blockInfo.Hash)
+	require.Equal(t, hex.EncodeToString(blockRootHash), blockInfo.RootHash)
+	require.Equal(t, 2, len(loadedOptions))
+	for _, loaded := range loadedOptions {
+		require.Equal(t, blockRootHash, loaded.GetRootHash())
+		require.Equal(t, core.OptionalUint32{Value: epoch, HasValue: true}, loaded.GetEpoch())
+	}
+}
+
+func TestNode_GetAccountsInvalidAddressShouldErr(t *testing.T) {
+	t.Parallel()
+
+	errExpected := errors.New("expected error")
+	coreComponents := getDefaultCoreComponents()
+	coreComponents.AddrPubKeyConv = &mock.PubkeyConverterStub{
+		DecodeCalled: func(humanReadable string) ([]byte, error) {
+			return nil, errExpected
+		},
+	}
+	n, _ := node.NewNode(
+		node.WithCoreComponents(coreComponents),
+	)
+
+	recovAccounts, _, err := n.GetAccounts([]string{"invalid"}, api.AccountQueryOptions{})
+	require.Nil(t, recovAccounts)
+	require.ErrorIs(t, err, errExpected)
+	require.Contains(t, err.Error(), "invalid")
+}
+
 func TestNode_AppStatusHandlersShouldIncrement(t *testing.T) {
 	t.Parallel()
 
@@ -3205,6 +3496,68 @@ func TestNode_ValidateTransactionForSimulation_CheckSignatureFalse(t *testing.T)
 	require.NoError(t, err)
 }
 
+func TestNode_ValidateTransactionForScheduling_ShouldNotCheckTheSenderAccount(t *testing.T) {
+	t.Parallel()
+
+	coreComponents := getDefaultCoreComponents()
+	coreComponents.IntMarsh = getMarshalizer()
+	coreComponents.VmMarsh = getMarshalizer()
+	coreComponents.Hash = getHasher()
+	coreComponents.AddrPubKeyConv = mock.NewPubkeyConverterMock(3)
+	stateComponents := getDefaultStateComponents()
+	stateComponents.AccountsAPI = &stateMock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return nil, errors.New("missing account")
+		},
+	}
+
+	bootstrapComponents := getDefaultBootstrapComponents()
+	bootstrapComponents.ShCoordinator = &mock.ShardCoordinatorMock{}
+
+	processComponents := getDefaultProcessComponents()
+	processComponents.ShardCoord = bootstrapComponents.ShCoordinator
+	processComponents.WhiteListHandlerInternal = &testscommon.WhiteListHandlerStub{}
+	processComponents.WhiteListerVerifiedTxsInternal = &testscommon.WhiteListHandlerStub{}
+	processComponents.EpochTrigger = &mock.EpochStartTriggerStub{}
+
+	cryptoComponents := getDefaultCryptoComponents()
+	cryptoComponents.TxKeyGen = &mock.KeyGenMock{
+		PublicKeyFromByteArrayMock: func(b []byte) (crypto.PublicKey, error) {
+			return nil, nil
+		},
+	}
+
+	n, _ := node.NewNode(
+		node.WithCoreComponents(coreComponents),
+		node.WithProcessComponents(processComponents),
+		node.WithBootstrapComponents(bootstrapComponents),
+		node.WithStateComponents(stateComponents),
+		node.WithCryptoComponents(cryptoComponents),
+	)
+
+	tx := &transaction.Transaction{
+		Nonce:     11,
+		Value:     big.NewInt(25),
+		RcvAddr:   []byte("rec"),
+		SndAddr:   []byte("snd"),
+		GasPrice:  6,
+		GasLimit:  12,
+		Data:      []byte(""),
+		Signature: []byte("sig1"),
+		ChainID:   []byte(coreComponents.ChainID()),
+	}
+
+	err := n.ValidateTransaction(tx)
+	require.True(t, errors.Is(err, process.ErrAccountNotFound))
+
+	err = n.ValidateTransactionForScheduling(tx)
+	require.NoError(t, err)
+
+	tx.ChainID = []byte("other chain")
+	err = n.ValidateTransactionForScheduling(tx)
+	require.True(t, errors.Is(err, process.ErrInvalidChainID))
+}
+
 func TestGetKeyValuePairs_CannotDecodeAddress(t *testing.T) {
 	t.Parallel()
 
@@ -3603,6 +3956,49 @@ func TestGetESDTSupply(t *testing.T) {
 	}, supply)
 }
 
+func TestGetESDTSupplyHistory(t *testing.T) {
+	t.Parallel()
+
+	historyProc := &dblookupext.HistoryRepositoryStub{
+		GetESDTSupplyHistoryCalled: func(token string, fromEpoch uint32, toEpoch uint32) ([]*esdtSupply.SupplyESDTInEpoch, error) {
+			require.Equal(t, "my-token", token)
+			require.Equal(t, uint32(2), fromEpoch)
+			require.Equal(t, uint32(5), toEpoch)
+
+			return []*esdtSupply.SupplyESDTInEpoch{
+				{
+					Epoch: 3,
+					Supply: &esdtSupply.SupplyESDT{
+						Supply: big.NewInt(-10),
+						Burned: big.NewInt(10),
+					},
+				},
+			}, nil
+		},
+	}
+	processComponentsMock := getDefaultProcessComponents()
+	processComponentsMock.HistoryRepositoryInternal = historyProc
+
+	n, _ := node.NewNode(
+		node.WithProcessComponents(processComponentsMock),
+	)
+
+	supplyHistory, err := n.GetTokenSupplyHistory("my-token", 2, 5)
+	require.Nil(t, err)
+
+	require.Equal(t, &common.ESDTSupplyHistoryApiResponse{
+		Token: "my-token",
+		Epochs: []*common.ESDTSupplyInEpochApiResponse{
+			{
+				Epoch:       3,
+				SupplyDelta: "-10",
+				Minted:      "0",
+				Burned:      "10",
+			},
+		},
+	}, supplyHistory)
+}
+
 func TestNode_SendBulkTransactions(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,27 @@
+package disabled
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ElrondNetwork/elrond-go/common"
+)
+
+var errCannotReturnESDTHoldersFromMetachainNode = errors.New("ESDT holders can not be returned by a metachain node")
+
+type esdtHoldersProcessor struct{}
+
+// NewDisabledESDTHoldersProcessor returns a disabled implementation to be used on metachain nodes
+func NewDisabledESDTHoldersProcessor() *esdtHoldersProcessor {
+	return &esdtHoldersProcessor{}
+}
+
+// GetESDTHolders returns the errCannotReturnESDTHoldersFromMetachainNode error
+func (ehp *esdtHoldersProcessor) GetESDTHolders(_ common.ESDTHoldersQueryOptions, _ context.Context) (*common.ESDTHoldersApiResponse, error) {
+	return nil, errCannotReturnESDTHoldersFromMetachainNode
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ehp *esdtHoldersProcessor) IsInterfaceNil() bool {
+	return ehp == nil
+}
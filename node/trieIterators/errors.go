@@ -19,3 +19,9 @@ var ErrNilMutex = errors.New("nil mutex")
 
 // ErrTrieOperationsTimeout signals a timeout during trie operations
 var ErrTrieOperationsTimeout = errors.New("trie operations timeout")
+
+// ErrNilMarshalizer signals that a nil marshalizer has been provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrEmptyTokenIdentifier signals that an empty token identifier has been provided
+var ErrEmptyTokenIdentifier = errors.New("empty token identifier")
@@ -0,0 +1,190 @@
+package trieIterators
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+var log = logger.GetOrCreate("node/trieIterators")
+
+type esdtHolder struct {
+	address string
+	balance *big.Int
+}
+
+type esdtHoldersProcessor struct {
+	accounts           *AccountsWrapper
+	publicKeyConverter core.PubkeyConverter
+	marshalizer        marshal.Marshalizer
+}
+
+// NewESDTHoldersProcessor will create a new instance of the ESDT holders processor
+func NewESDTHoldersProcessor(arg ArgTrieIteratorProcessor) (*esdtHoldersProcessor, error) {
+	err := checkArguments(arg)
+	if err != nil {
+		return nil, err
+	}
+	if check.IfNil(arg.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+
+	return &esdtHoldersProcessor{
+		accounts:           arg.Accounts,
+		publicKeyConverter: arg.PublicKeyConverter,
+		marshalizer:        arg.Marshalizer,
+	}, nil
+}
+
+// GetESDTHolders will return the accounts holding the provided token, with their balances, by iterating all the
+// accounts of the state with the provided root hash
+func (ehp *esdtHoldersProcessor) GetESDTHolders(options common.ESDTHoldersQueryOptions, ctx context.Context) (*common.ESDTHoldersApiResponse, error) {
+	if len(options.Token) == 0 {
+		return nil, ErrEmptyTokenIdentifier
+	}
+
+	ehp.accounts.Lock()
+	defer ehp.accounts.Unlock()
+
+	rootHash := options.RootHash
+	if len(rootHash) == 0 {
+		var err error
+		rootHash, err = ehp.accounts.RootHash()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	chLeaves := make(chan core.KeyValueHolder, common.TrieLeavesChannelDefaultCapacity)
+	err := ehp.accounts.GetAllLeaves(chLeaves, ctx, rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	esdtTokenKey := createESDTTokenKey(options.Token, options.Nonce)
+	holders := make([]*esdtHolder, 0)
+	for leaf := range chLeaves {
+		userAccount, errUnmarshal := ehp.unmarshalUserAccount(leaf.Key(), leaf.Value())
+		if errUnmarshal != nil {
+			// code leaves are not accounts
+			continue
+		}
+		if len(userAccount.GetRootHash()) == 0 {
+			continue
+		}
+
+		balance, errGet := ehp.getESDTBalance(userAccount, esdtTokenKey)
+		if errGet != nil {
+			log.Debug("esdtHoldersProcessor.GetESDTHolders: cannot get the ESDT balance", "address", leaf.Key(), "error", errGet)
+			continue
+		}
+		if balance.Sign() == 0 {
+			continue
+		}
+
+		holders = append(holders, &esdtHolder{
+			address: ehp.publicKeyConverter.Encode(leaf.Key()),
+			balance: balance,
+		})
+	}
+
+	if common.IsContextDone(ctx) {
+		return nil, ErrTrieOperationsTimeout
+	}
+
+	// the richest holders are returned first
+	sort.Slice(holders, func(i, j int) bool {
+		comparison := holders[i].balance.Cmp(holders[j].balance)
+		if comparison != 0 {
+			return comparison > 0
+		}
+
+		return holders[i].address < holders[j].address
+	})
+
+	response := &common.ESDTHoldersApiResponse{
+		Token:    options.Token,
+		Nonce:    options.Nonce,
+		RootHash: hex.EncodeToString(rootHash),
+		Holders:  make([]*common.ESDTHolderApiResponse, 0, len(holders)),
+	}
+	for _, holder := range holders {
+		response.Holders = append(response.Holders, &common.ESDTHolderApiResponse{
+			Address: holder.address,
+			Balance: holder.balance.String(),
+		})
+	}
+
+	return response, nil
+}
+
+func (ehp *esdtHoldersProcessor) unmarshalUserAccount(address []byte, accountBytes []byte) (state.UserAccountHandler, error) {
+	userAccount, err := state.NewUserAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ehp.marshalizer.Unmarshal(userAccount, accountBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return userAccount, nil
+}
+
+func (ehp *esdtHoldersProcessor) getESDTBalance(userAccount state.UserAccountHandler, esdtTokenKey []byte) (*big.Int, error) {
+	dataTrie, err := ehp.accounts.GetTrie(userAccount.GetRootHash())
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := dataTrie.Get(esdtTokenKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(value) == 0 {
+		return big.NewInt(0), nil
+	}
+
+	// the values are saved in the data tries followed by the key and the address of the account
+	suffixLength := len(esdtTokenKey) + len(userAccount.AddressBytes())
+	if len(value) < suffixLength || !bytes.HasSuffix(value, userAccount.AddressBytes()) {
+		return nil, fmt.Errorf("invalid data trie value for key %s", esdtTokenKey)
+	}
+
+	esdtToken := &esdt.ESDigitalToken{}
+	err = ehp.marshalizer.Unmarshal(esdtToken, value[:len(value)-suffixLength])
+	if err != nil {
+		return nil, err
+	}
+	if esdtToken.Value == nil {
+		return big.NewInt(0), nil
+	}
+
+	return esdtToken.Value, nil
+}
+
+func createESDTTokenKey(token string, nonce uint64) []byte {
+	esdtTokenKey := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + token)
+	if nonce == 0 {
+		return esdtTokenKey
+	}
+
+	return append(esdtTokenKey, big.NewInt(0).SetUint64(nonce).Bytes()...)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ehp *esdtHoldersProcessor) IsInterfaceNil() bool {
+	return ehp == nil
+}
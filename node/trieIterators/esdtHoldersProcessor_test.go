@@ -0,0 +1,151 @@
+package trieIterators
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/core/keyValStorage"
+	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/state"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	trieMock "github.com/ElrondNetwork/elrond-go/testscommon/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockESDTHoldersArgs() ArgTrieIteratorProcessor {
+	arg := createMockArgs()
+	arg.Marshalizer = &marshal.GogoProtoMarshalizer{}
+
+	return arg
+}
+
+func TestNewESDTHoldersProcessor(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockESDTHoldersArgs()
+	arg.Accounts = nil
+	ehp, err := NewESDTHoldersProcessor(arg)
+	assert.True(t, check.IfNil(ehp))
+	assert.Equal(t, ErrNilAccountsAdapter, err)
+
+	arg = createMockESDTHoldersArgs()
+	arg.Marshalizer = nil
+	ehp, err = NewESDTHoldersProcessor(arg)
+	assert.True(t, check.IfNil(ehp))
+	assert.Equal(t, ErrNilMarshalizer, err)
+
+	ehp, err = NewESDTHoldersProcessor(createMockESDTHoldersArgs())
+	assert.False(t, check.IfNil(ehp))
+	assert.Nil(t, err)
+}
+
+func TestESDTHoldersProcessor_GetESDTHoldersEmptyTokenShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ehp, _ := NewESDTHoldersProcessor(createMockESDTHoldersArgs())
+	response, err := ehp.GetESDTHolders(common.ESDTHoldersQueryOptions{}, context.Background())
+	assert.Nil(t, response)
+	assert.Equal(t, ErrEmptyTokenIdentifier, err)
+}
+
+func TestESDTHoldersProcessor_GetESDTHoldersGetAllLeavesFailsShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	arg := createMockESDTHoldersArgs()
+	arg.Accounts.AccountsAdapter = &stateMock.AccountsStub{
+		GetAllLeavesCalled: func(_ chan core.KeyValueHolder, _ context.Context, _ []byte) error {
+			return expectedErr
+		},
+	}
+
+	ehp, _ := NewESDTHoldersProcessor(arg)
+	response, err := ehp.GetESDTHolders(common.ESDTHoldersQueryOptions{Token: "TKN-abcdef", RootHash: []byte("root hash")}, context.Background())
+	assert.Nil(t, response)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestESDTHoldersProcessor_GetESDTHoldersShouldWork(t *testing.T) {
+	t.Parallel()
+
+	token := "TKN-abcdef"
+	nonce := uint64(2)
+	esdtTokenKey := createESDTTokenKey(token, nonce)
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	rootHash := []byte("state root hash")
+
+	balances := map[string]int64{
+		"addressA": 100,
+		"addressB": 300,
+		"addressC": 100,
+		"addressD": 0,
+	}
+	dataTries := make(map[string]common.Trie)
+	accountsBytes := make(map[string][]byte)
+	for address, balance := range balances {
+		account, _ := state.NewUserAccount([]byte(address))
+		dataTrieRootHash := []byte("root hash of " + address)
+		account.SetRootHash(dataTrieRootHash)
+		accountsBytes[address], _ = marshalizer.Marshal(account)
+
+		tokenBytes, _ := marshalizer.Marshal(&esdt.ESDigitalToken{Value: big.NewInt(balance)})
+		value := append(tokenBytes, esdtTokenKey...)
+		value = append(value, address...)
+		dataTries[string(dataTrieRootHash)] = &trieMock.TrieStub{
+			GetCalled: func(key []byte) ([]byte, error) {
+				require.Equal(t, esdtTokenKey, key)
+				return value, nil
+			},
+		}
+	}
+	accountWithoutDataTrie, _ := state.NewUserAccount([]byte("addressE"))
+	accountsBytes["addressE"], _ = marshalizer.Marshal(accountWithoutDataTrie)
+
+	arg := createMockESDTHoldersArgs()
+	arg.Marshalizer = marshalizer
+	arg.Accounts.AccountsAdapter = &stateMock.AccountsStub{
+		RootHashCalled: func() ([]byte, error) {
+			return rootHash, nil
+		},
+		GetAllLeavesCalled: func(ch chan core.KeyValueHolder, _ context.Context, providedRootHash []byte) error {
+			require.Equal(t, rootHash, providedRootHash)
+			go func() {
+				for address, accountBytes := range accountsBytes {
+					ch <- keyValStorage.NewKeyValStorage([]byte(address), accountBytes)
+				}
+				close(ch)
+			}()
+
+			return nil
+		},
+		GetTrieCalled: func(dataTrieRootHash []byte) (common.Trie, error) {
+			return dataTries[string(dataTrieRootHash)], nil
+		},
+	}
+	arg.PublicKeyConverter = mock.NewPubkeyConverterMock(8)
+
+	ehp, _ := NewESDTHoldersProcessor(arg)
+	response, err := ehp.GetESDTHolders(common.ESDTHoldersQueryOptions{Token: token, Nonce: nonce}, context.Background())
+	require.Nil(t, err)
+
+	expectedResponse := &common.ESDTHoldersApiResponse{
+		Token:    token,
+		Nonce:    nonce,
+		RootHash: hex.EncodeToString(rootHash),
+		Holders: []*common.ESDTHolderApiResponse{
+			{Address: hex.EncodeToString([]byte("addressB")), Balance: "300"},
+			{Address: hex.EncodeToString([]byte("addressA")), Balance: "100"},
+			{Address: hex.EncodeToString([]byte("addressC")), Balance: "100"},
+		},
+	}
+	assert.Equal(t, expectedResponse, response)
+}
@@ -0,0 +1,17 @@
+package factory
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/node/external"
+	"github.com/ElrondNetwork/elrond-go/node/trieIterators"
+	"github.com/ElrondNetwork/elrond-go/node/trieIterators/disabled"
+)
+
+// CreateESDTHoldersHandler will create a new instance of ESDTHoldersHandler
+func CreateESDTHoldersHandler(args trieIterators.ArgTrieIteratorProcessor) (external.ESDTHoldersHandler, error) {
+	if args.ShardID == core.MetachainShardId {
+		return disabled.NewDisabledESDTHoldersProcessor(), nil
+	}
+
+	return trieIterators.NewESDTHoldersProcessor(args)
+}
@@ -0,0 +1,46 @@
+package factory
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go/node/mock"
+	"github.com/ElrondNetwork/elrond-go/node/trieIterators"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateESDTHoldersHandler_Disabled(t *testing.T) {
+	t.Parallel()
+
+	args := trieIterators.ArgTrieIteratorProcessor{
+		ShardID: core.MetachainShardId,
+	}
+
+	esdtHoldersHandler, err := CreateESDTHoldersHandler(args)
+	require.Nil(t, err)
+	assert.Equal(t, "*disabled.esdtHoldersProcessor", fmt.Sprintf("%T", esdtHoldersHandler))
+}
+
+func TestCreateESDTHoldersHandler_ESDTHoldersProcessor(t *testing.T) {
+	t.Parallel()
+
+	args := trieIterators.ArgTrieIteratorProcessor{
+		ShardID: 0,
+		Accounts: &trieIterators.AccountsWrapper{
+			Mutex:           &sync.Mutex{},
+			AccountsAdapter: &stateMock.AccountsStub{},
+		},
+		PublicKeyConverter: &mock.PubkeyConverterMock{},
+		QueryService:       &mock.SCQueryServiceStub{},
+		Marshalizer:        &testscommon.MarshalizerMock{},
+	}
+
+	esdtHoldersHandler, err := CreateESDTHoldersHandler(args)
+	require.Nil(t, err)
+	assert.Equal(t, "*trieIterators.esdtHoldersProcessor", fmt.Sprintf("%T", esdtHoldersHandler))
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
 	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/state"
@@ -32,6 +33,7 @@ type ArgTrieIteratorProcessor struct {
 	Accounts           *AccountsWrapper
 	QueryService       process.SCQueryService
 	PublicKeyConverter core.PubkeyConverter
+	Marshalizer        marshal.Marshalizer
 }
 
 // NewTotalStakedValueProcessor will create a new instance of stakedValuesProc
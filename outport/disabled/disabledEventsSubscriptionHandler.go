@@ -0,0 +1,31 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go/outport"
+)
+
+type disabledEventsSubscriptionHandler struct{}
+
+// NewDisabledEventsSubscriptionHandler will create a new instance of disabledEventsSubscriptionHandler
+func NewDisabledEventsSubscriptionHandler() *disabledEventsSubscriptionHandler {
+	return new(disabledEventsSubscriptionHandler)
+}
+
+// ServeSubscriber closes the connection and returns ErrEventsSubscriptionDisabled
+func (d *disabledEventsSubscriptionHandler) ServeSubscriber(conn outport.WSConnection) error {
+	if conn != nil {
+		_ = conn.Close()
+	}
+
+	return outport.ErrEventsSubscriptionDisabled
+}
+
+// IsEnabled returns false
+func (d *disabledEventsSubscriptionHandler) IsEnabled() bool {
+	return false
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *disabledEventsSubscriptionHandler) IsInterfaceNil() bool {
+	return d == nil
+}
@@ -13,3 +13,6 @@ var ErrInvalidRetrialInterval = errors.New("invalid retrial interval")
 
 // ErrNilPubKeyConverter signals that a nil pubkey converter has been provided
 var ErrNilPubKeyConverter = errors.New("nil pub key converter")
+
+// ErrEventsSubscriptionDisabled signals that the websocket events subscription is disabled
+var ErrEventsSubscriptionDisabled = errors.New("websocket events subscription is disabled")
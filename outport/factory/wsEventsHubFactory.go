@@ -0,0 +1,48 @@
+package factory
+
+import (
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/notifier"
+)
+
+// WSEventsHubFactoryArgs defines the args needed for websocket events hub creation
+type WSEventsHubFactoryArgs struct {
+	MaxSubscribers      int
+	SendBufferSize      int
+	SubscriptionTimeout time.Duration
+	WriteTimeout        time.Duration
+	PongTimeout         time.Duration
+	Marshaller          marshal.Marshalizer
+	Hasher              hashing.Hasher
+	PubKeyConverter     core.PubkeyConverter
+}
+
+// CreateWSEventsHub will create a new websocket events hub instance
+func CreateWSEventsHub(args *WSEventsHubFactoryArgs) (outport.WSEventsDriver, error) {
+	if args == nil {
+		return nil, outport.ErrNilArgsOutportFactory
+	}
+
+	hubArgs := notifier.ArgsWSEventsHub{
+		Marshaller:          args.Marshaller,
+		Hasher:              args.Hasher,
+		PubKeyConverter:     args.PubKeyConverter,
+		MaxSubscribers:      args.MaxSubscribers,
+		SendBufferSize:      args.SendBufferSize,
+		SubscriptionTimeout: args.SubscriptionTimeout,
+		WriteTimeout:        args.WriteTimeout,
+		PongTimeout:         args.PongTimeout,
+	}
+
+	hub, err := notifier.NewWSEventsHub(hubArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return hub, nil
+}
@@ -0,0 +1,59 @@
+package factory_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/factory"
+	"github.com/ElrondNetwork/elrond-go/outport/notifier"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockWSEventsHubFactoryArgs() *factory.WSEventsHubFactoryArgs {
+	return &factory.WSEventsHubFactoryArgs{
+		MaxSubscribers:      10,
+		SendBufferSize:      10,
+		SubscriptionTimeout: time.Second,
+		WriteTimeout:        time.Second,
+		PongTimeout:         time.Second,
+		Marshaller:          &testscommon.MarshalizerMock{},
+		Hasher:              &hashingMocks.HasherMock{},
+		PubKeyConverter:     &testscommon.PubkeyConverterMock{},
+	}
+}
+
+func TestCreateWSEventsHub(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil args", func(t *testing.T) {
+		t.Parallel()
+
+		hub, err := factory.CreateWSEventsHub(nil)
+		require.Nil(t, hub)
+		require.Equal(t, outport.ErrNilArgsOutportFactory, err)
+	})
+
+	t.Run("invalid max subscribers", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockWSEventsHubFactoryArgs()
+		args.MaxSubscribers = 0
+
+		hub, err := factory.CreateWSEventsHub(args)
+		require.Nil(t, hub)
+		require.Equal(t, notifier.ErrInvalidMaxSubscribers, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		hub, err := factory.CreateWSEventsHub(createMockWSEventsHubFactoryArgs())
+		require.Nil(t, err)
+		require.False(t, check.IfNil(hub))
+		require.True(t, hub.IsEnabled())
+	})
+}
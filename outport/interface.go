@@ -1,6 +1,9 @@
 package outport
 
 import (
+	"io"
+	"time"
+
 	"github.com/ElrondNetwork/elrond-go-core/data"
 	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
 )
@@ -34,3 +37,27 @@ type OutportHandler interface {
 	Close() error
 	IsInterfaceNil() bool
 }
+
+// WSConnection defines the websocket connection methods used when pushing outport events to subscribers
+type WSConnection interface {
+	io.Closer
+	ReadMessage() (messageType int, p []byte, err error)
+	WriteMessage(messageType int, data []byte) error
+	WriteControl(messageType int, data []byte, deadline time.Time) error
+	SetReadDeadline(t time.Time) error
+	SetWriteDeadline(t time.Time) error
+	SetPongHandler(handler func(appData string) error)
+}
+
+// EventsSubscriptionHandler defines what a component that pushes outport events to websocket subscribers should do
+type EventsSubscriptionHandler interface {
+	ServeSubscriber(conn WSConnection) error
+	IsEnabled() bool
+	IsInterfaceNil() bool
+}
+
+// WSEventsDriver is an outport driver that also pushes the received data to websocket subscribers
+type WSEventsDriver interface {
+	Driver
+	EventsSubscriptionHandler
+}
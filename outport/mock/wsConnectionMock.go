@@ -0,0 +1,175 @@
+package mock
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+var errConnectionClosed = errors.New("connection closed")
+var errReadTimeout = errors.New("read timeout")
+var errWriteTimeout = errors.New("write timeout")
+
+// WSConnectionMock -
+type WSConnectionMock struct {
+	incoming      chan []byte
+	written       chan []byte
+	pings         chan struct{}
+	closeOnce     sync.Once
+	closed        chan struct{}
+	stopOnce      sync.Once
+	stopped       chan struct{}
+	mutDeadline   sync.RWMutex
+	readDeadline  time.Time
+	writeDeadline time.Time
+	pongHandler   func(appData string) error
+}
+
+// NewWSConnectionMock -
+func NewWSConnectionMock() *WSConnectionMock {
+	return &WSConnectionMock{
+		incoming: make(chan []byte, 100),
+		written:  make(chan []byte, 100),
+		pings:    make(chan struct{}, 100),
+		closed:   make(chan struct{}),
+		stopped:  make(chan struct{}),
+	}
+}
+
+// SendFromClient -
+func (conn *WSConnectionMock) SendFromClient(message []byte) {
+	conn.incoming <- message
+}
+
+// StopResponding makes the client stop reading the connection while keeping it open: the pings are no longer
+// answered and the writes block once the written messages buffer is full
+func (conn *WSConnectionMock) StopResponding() {
+	conn.stopOnce.Do(func() {
+		close(conn.stopped)
+	})
+}
+
+// Written -
+func (conn *WSConnectionMock) Written() <-chan []byte {
+	return conn.written
+}
+
+// ReadMessage -
+func (conn *WSConnectionMock) ReadMessage() (int, []byte, error) {
+	for {
+		conn.mutDeadline.RLock()
+		readDeadline := conn.readDeadline
+		conn.mutDeadline.RUnlock()
+
+		var timeout <-chan time.Time
+		if !readDeadline.IsZero() {
+			timeout = time.After(time.Until(readDeadline))
+		}
+
+		select {
+		case message := <-conn.incoming:
+			return websocket.TextMessage, message, nil
+		case <-conn.pings:
+			conn.mutDeadline.RLock()
+			pongHandler := conn.pongHandler
+			conn.mutDeadline.RUnlock()
+			if pongHandler != nil {
+				_ = pongHandler("")
+			}
+		case <-conn.closed:
+			return websocket.CloseMessage, nil, errConnectionClosed
+		case <-timeout:
+			return 0, nil, errReadTimeout
+		}
+	}
+}
+
+// SetReadDeadline -
+func (conn *WSConnectionMock) SetReadDeadline(t time.Time) error {
+	conn.mutDeadline.Lock()
+	conn.readDeadline = t
+	conn.mutDeadline.Unlock()
+
+	return nil
+}
+
+// SetWriteDeadline -
+func (conn *WSConnectionMock) SetWriteDeadline(t time.Time) error {
+	conn.mutDeadline.Lock()
+	conn.writeDeadline = t
+	conn.mutDeadline.Unlock()
+
+	return nil
+}
+
+// SetPongHandler -
+func (conn *WSConnectionMock) SetPongHandler(handler func(appData string) error) {
+	conn.mutDeadline.Lock()
+	conn.pongHandler = handler
+	conn.mutDeadline.Unlock()
+}
+
+// WriteMessage -
+func (conn *WSConnectionMock) WriteMessage(_ int, data []byte) error {
+	conn.mutDeadline.RLock()
+	writeDeadline := conn.writeDeadline
+	conn.mutDeadline.RUnlock()
+
+	var timeout <-chan time.Time
+	if !writeDeadline.IsZero() {
+		timeout = time.After(time.Until(writeDeadline))
+	}
+
+	select {
+	case <-conn.closed:
+		return errConnectionClosed
+	case conn.written <- data:
+		return nil
+	case <-timeout:
+		return errWriteTimeout
+	}
+}
+
+// WriteControl answers the pings, unless the client stopped responding
+func (conn *WSConnectionMock) WriteControl(messageType int, _ []byte, _ time.Time) error {
+	if conn.IsClosed() {
+		return errConnectionClosed
+	}
+	if messageType != websocket.PingMessage {
+		return nil
+	}
+
+	select {
+	case <-conn.stopped:
+		return nil
+	default:
+	}
+
+	select {
+	case conn.pings <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// Close -
+func (conn *WSConnectionMock) Close() error {
+	conn.closeOnce.Do(func() {
+		close(conn.closed)
+	})
+
+	return nil
+}
+
+// IsClosed -
+func (conn *WSConnectionMock) IsClosed() bool {
+	select {
+	case <-conn.closed:
+		return true
+	default:
+		return false
+	}
+}
@@ -6,3 +6,30 @@ import (
 
 // ErrNilTransactionsPool signals that a nil transactions pool was provided
 var ErrNilTransactionsPool = errors.New("nil transactions pool")
+
+// ErrNilWSConnection signals that a nil websocket connection was provided
+var ErrNilWSConnection = errors.New("nil websocket connection")
+
+// ErrInvalidMaxSubscribers signals that an invalid maximum number of subscribers was provided
+var ErrInvalidMaxSubscribers = errors.New("invalid maximum number of subscribers")
+
+// ErrInvalidSendBufferSize signals that an invalid send buffer size was provided
+var ErrInvalidSendBufferSize = errors.New("invalid send buffer size")
+
+// ErrInvalidSubscriptionTimeout signals that an invalid subscription timeout was provided
+var ErrInvalidSubscriptionTimeout = errors.New("invalid subscription timeout")
+
+// ErrInvalidWriteTimeout signals that an invalid write timeout was provided
+var ErrInvalidWriteTimeout = errors.New("invalid write timeout")
+
+// ErrInvalidPongTimeout signals that an invalid pong timeout was provided
+var ErrInvalidPongTimeout = errors.New("invalid pong timeout")
+
+// ErrTooManySubscribers signals that the maximum number of websocket subscribers was reached
+var ErrTooManySubscribers = errors.New("too many websocket subscribers")
+
+// ErrWSEventsHubClosed signals that the websocket events hub was closed
+var ErrWSEventsHubClosed = errors.New("websocket events hub is closed")
+
+// ErrInvalidSubscriptionType signals that an unknown notification type was requested in a subscription
+var ErrInvalidSubscriptionType = errors.New("invalid subscription type")
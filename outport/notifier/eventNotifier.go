@@ -93,7 +93,7 @@ func (en *eventNotifier) SaveBlock(args *indexer.ArgsSaveBlockData) error {
 	log.Debug("eventNotifier: checking if block has logs", "num logs", len(args.TransactionsPool.Logs))
 	log.Debug("eventNotifier: checking if block has txs", "num txs", len(args.TransactionsPool.Txs))
 
-	events := en.getLogEventsFromTransactionsPool(args.TransactionsPool.Logs)
+	events := extractLogEvents(args.TransactionsPool.Logs, en.pubKeyConverter)
 	log.Debug("eventNotifier: extracted events from block logs", "num events", len(events))
 
 	blockData := SaveBlockData{
@@ -111,7 +111,7 @@ func (en *eventNotifier) SaveBlock(args *indexer.ArgsSaveBlockData) error {
 	return nil
 }
 
-func (en *eventNotifier) getLogEventsFromTransactionsPool(logs []*nodeData.LogData) []Event {
+func extractLogEvents(logs []*nodeData.LogData, pubKeyConverter core.PubkeyConverter) []Event {
 	var logEvents []*logEvent
 	for _, logData := range logs {
 		if logData == nil {
@@ -141,7 +141,7 @@ func (en *eventNotifier) getLogEventsFromTransactionsPool(logs []*nodeData.LogDa
 			continue
 		}
 
-		bech32Address := en.pubKeyConverter.Encode(event.eventHandler.GetAddress())
+		bech32Address := pubKeyConverter.Encode(event.eventHandler.GetAddress())
 		eventIdentifier := string(event.eventHandler.GetIdentifier())
 
 		log.Debug("eventNotifier: received event from address",
@@ -1,7 +1,26 @@
 package notifier
 
-import nodeData "github.com/ElrondNetwork/elrond-go-core/data"
+import (
+	nodeData "github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go/outport"
+)
 
 func (en *eventNotifier) GetLogEventsFromTransactionsPool(logs []*nodeData.LogData) []Event {
-	return en.getLogEventsFromTransactionsPool(logs)
+	return extractLogEvents(logs, en.pubKeyConverter)
+}
+
+func (filter *WSEventFilter) Matches(event Event) bool {
+	return filter.matches(event)
+}
+
+func (hub *wsEventsHub) NumSubscribers() int {
+	hub.mutSubscribers.RLock()
+	defer hub.mutSubscribers.RUnlock()
+
+	return len(hub.subscribers)
+}
+
+type WSEventsHubHandler interface {
+	ServeSubscriber(conn outport.WSConnection) error
+	NumSubscribers() int
 }
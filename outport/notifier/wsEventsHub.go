@@ -0,0 +1,382 @@
+package notifier
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	nodeData "github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/outport"
+)
+
+const (
+	// SaveBlockMessageType is the type of the notifications pushed when a block is saved
+	SaveBlockMessageType = "saveBlock"
+	// RevertBlockMessageType is the type of the notifications pushed when a block is reverted
+	RevertBlockMessageType = "revertBlock"
+	// FinalizedBlockMessageType is the type of the notifications pushed when a block is finalized
+	FinalizedBlockMessageType = "finalizedBlock"
+)
+
+// WSMessage holds a notification pushed to the websocket subscribers
+type WSMessage struct {
+	Type string      `json:"type"`
+	Data interface{} `json:"data"`
+}
+
+// WSSaveBlockData holds the saved block data pushed to the websocket subscribers
+type WSSaveBlockData struct {
+	Hash    string  `json:"hash"`
+	Nonce   uint64  `json:"nonce"`
+	Round   uint64  `json:"round"`
+	Epoch   uint32  `json:"epoch"`
+	ShardID uint32  `json:"shardID"`
+	Events  []Event `json:"events"`
+}
+
+// ArgsWSEventsHub defines the arguments needed for websocket events hub creation
+type ArgsWSEventsHub struct {
+	Marshaller          marshal.Marshalizer
+	Hasher              hashing.Hasher
+	PubKeyConverter     core.PubkeyConverter
+	MaxSubscribers      int
+	SendBufferSize      int
+	SubscriptionTimeout time.Duration
+	WriteTimeout        time.Duration
+	PongTimeout         time.Duration
+}
+
+type wsEventsHub struct {
+	marshaller          marshal.Marshalizer
+	hasher              hashing.Hasher
+	pubKeyConverter     core.PubkeyConverter
+	maxSubscribers      int
+	sendBufferSize      int
+	subscriptionTimeout time.Duration
+	writeTimeout        time.Duration
+	pongTimeout         time.Duration
+	mutSubscribers      sync.RWMutex
+	subscribers         map[*wsSubscriber]struct{}
+	numPending          int
+	isClosed            bool
+}
+
+// NewWSEventsHub creates a new outport driver that pushes the saved, reverted and finalized blocks to the
+// connected websocket subscribers
+func NewWSEventsHub(args ArgsWSEventsHub) (*wsEventsHub, error) {
+	if check.IfNil(args.Marshaller) {
+		return nil, core.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, core.ErrNilHasher
+	}
+	if check.IfNil(args.PubKeyConverter) {
+		return nil, outport.ErrNilPubKeyConverter
+	}
+	if args.MaxSubscribers < 1 {
+		return nil, ErrInvalidMaxSubscribers
+	}
+	if args.SendBufferSize < 1 {
+		return nil, ErrInvalidSendBufferSize
+	}
+	if args.SubscriptionTimeout <= 0 {
+		return nil, ErrInvalidSubscriptionTimeout
+	}
+	if args.WriteTimeout <= 0 {
+		return nil, ErrInvalidWriteTimeout
+	}
+	if args.PongTimeout <= 0 {
+		return nil, ErrInvalidPongTimeout
+	}
+
+	return &wsEventsHub{
+		marshaller:          args.Marshaller,
+		hasher:              args.Hasher,
+		pubKeyConverter:     args.PubKeyConverter,
+		maxSubscribers:      args.MaxSubscribers,
+		sendBufferSize:      args.SendBufferSize,
+		subscriptionTimeout: args.SubscriptionTimeout,
+		writeTimeout:        args.WriteTimeout,
+		pongTimeout:         args.PongTimeout,
+		subscribers:         make(map[*wsSubscriber]struct{}),
+	}, nil
+}
+
+// ServeSubscriber reads the subscription sent on the provided connection and then pushes the matching notifications
+// until the connection is closed. This call is blocking. The connections still waiting for their subscription are
+// counted against the maximum number of subscribers, and are closed if the subscription is not received in time.
+// The subscribers which stop reading their notifications or stop answering the pings are disconnected
+func (hub *wsEventsHub) ServeSubscriber(conn outport.WSConnection) error {
+	if conn == nil {
+		return ErrNilWSConnection
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	err := hub.addPendingSubscriber()
+	if err != nil {
+		return err
+	}
+
+	subscriber, err := hub.readSubscriber(conn)
+	if err != nil {
+		hub.removePendingSubscriber()
+		return err
+	}
+
+	err = hub.addSubscriber(subscriber)
+	if err != nil {
+		return err
+	}
+	defer hub.removeSubscriber(subscriber)
+
+	go subscriber.monitorConnection()
+	subscriber.sendContinuously()
+
+	return nil
+}
+
+func (hub *wsEventsHub) readSubscriber(conn outport.WSConnection) (*wsSubscriber, error) {
+	subscription, err := readSubscription(conn, hub.subscriptionTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return newWSSubscriber(argsWSSubscriber{
+		conn:           conn,
+		subscription:   subscription,
+		sendBufferSize: hub.sendBufferSize,
+		writeTimeout:   hub.writeTimeout,
+		pongTimeout:    hub.pongTimeout,
+	})
+}
+
+func (hub *wsEventsHub) addPendingSubscriber() error {
+	hub.mutSubscribers.Lock()
+	defer hub.mutSubscribers.Unlock()
+
+	if hub.isClosed {
+		return ErrWSEventsHubClosed
+	}
+	if len(hub.subscribers)+hub.numPending >= hub.maxSubscribers {
+		return ErrTooManySubscribers
+	}
+
+	hub.numPending++
+
+	return nil
+}
+
+func (hub *wsEventsHub) removePendingSubscriber() {
+	hub.mutSubscribers.Lock()
+	hub.numPending--
+	hub.mutSubscribers.Unlock()
+}
+
+// addSubscriber turns a pending subscriber into a subscriber
+func (hub *wsEventsHub) addSubscriber(subscriber *wsSubscriber) error {
+	hub.mutSubscribers.Lock()
+	defer hub.mutSubscribers.Unlock()
+
+	hub.numPending--
+	if hub.isClosed {
+		return ErrWSEventsHubClosed
+	}
+
+	hub.subscribers[subscriber] = struct{}{}
+	log.Debug("wsEventsHub: subscriber added", "num subscribers", len(hub.subscribers))
+
+	return nil
+}
+
+func (hub *wsEventsHub) removeSubscriber(subscriber *wsSubscriber) {
+	hub.mutSubscribers.Lock()
+	delete(hub.subscribers, subscriber)
+	numSubscribers := len(hub.subscribers)
+	hub.mutSubscribers.Unlock()
+
+	subscriber.close()
+	log.Debug("wsEventsHub: subscriber removed", "num subscribers", numSubscribers)
+}
+
+func (hub *wsEventsHub) getSubscribers(messageType string) []*wsSubscriber {
+	hub.mutSubscribers.RLock()
+	defer hub.mutSubscribers.RUnlock()
+
+	subscribers := make([]*wsSubscriber, 0, len(hub.subscribers))
+	for subscriber := range hub.subscribers {
+		if subscriber.isSubscribedTo(messageType) {
+			subscribers = append(subscribers, subscriber)
+		}
+	}
+
+	return subscribers
+}
+
+// pushMessage disconnects the subscribers that do not keep up, so that the outport is never blocked
+func (hub *wsEventsHub) pushMessage(subscriber *wsSubscriber, message []byte) {
+	if subscriber.push(message) {
+		return
+	}
+
+	log.Debug("wsEventsHub: subscriber buffer is full, disconnecting")
+	subscriber.close()
+}
+
+// SaveBlock pushes the saved block, along with the log events matching each subscriber's filters
+func (hub *wsEventsHub) SaveBlock(args *indexer.ArgsSaveBlockData) error {
+	if args == nil {
+		return nil
+	}
+
+	subscribers := hub.getSubscribers(SaveBlockMessageType)
+	if len(subscribers) == 0 {
+		return nil
+	}
+
+	var events []Event
+	if args.TransactionsPool != nil {
+		events = extractLogEvents(args.TransactionsPool.Logs, hub.pubKeyConverter)
+	}
+
+	blockData := WSSaveBlockData{
+		Hash:   hex.EncodeToString(args.HeaderHash),
+		Events: events,
+	}
+	if !check.IfNil(args.Header) {
+		blockData.Nonce = args.Header.GetNonce()
+		blockData.Round = args.Header.GetRound()
+		blockData.Epoch = args.Header.GetEpoch()
+		blockData.ShardID = args.Header.GetShardID()
+	}
+
+	var messageWithAllEvents []byte
+	for _, subscriber := range subscribers {
+		if !subscriber.hasFilters() {
+			if messageWithAllEvents == nil {
+				message, err := json.Marshal(WSMessage{Type: SaveBlockMessageType, Data: blockData})
+				if err != nil {
+					return fmt.Errorf("%w in wsEventsHub.SaveBlock while marshaling block data", err)
+				}
+				messageWithAllEvents = message
+			}
+
+			hub.pushMessage(subscriber, messageWithAllEvents)
+			continue
+		}
+
+		filteredBlockData := blockData
+		filteredBlockData.Events = subscriber.filterEvents(events)
+		if len(filteredBlockData.Events) == 0 {
+			continue
+		}
+
+		message, err := json.Marshal(WSMessage{Type: SaveBlockMessageType, Data: filteredBlockData})
+		if err != nil {
+			return fmt.Errorf("%w in wsEventsHub.SaveBlock while marshaling block data", err)
+		}
+
+		hub.pushMessage(subscriber, message)
+	}
+
+	return nil
+}
+
+// RevertIndexedBlock pushes the reverted block to all subscribers, regardless of their filters
+func (hub *wsEventsHub) RevertIndexedBlock(header nodeData.HeaderHandler, _ nodeData.BodyHandler) error {
+	if check.IfNil(header) {
+		return nil
+	}
+
+	blockHash, err := core.CalculateHash(hub.marshaller, hub.hasher, header)
+	if err != nil {
+		return fmt.Errorf("%w in wsEventsHub.RevertIndexedBlock while computing the block hash", err)
+	}
+
+	revertBlock := RevertBlock{
+		Hash:  hex.EncodeToString(blockHash),
+		Nonce: header.GetNonce(),
+		Round: header.GetRound(),
+		Epoch: header.GetEpoch(),
+	}
+
+	return hub.broadcast(RevertBlockMessageType, revertBlock)
+}
+
+// FinalizedBlock pushes the finalized block hash to all subscribers, regardless of their filters
+func (hub *wsEventsHub) FinalizedBlock(headerHash []byte) error {
+	finalizedBlock := FinalizedBlock{
+		Hash: hex.EncodeToString(headerHash),
+	}
+
+	return hub.broadcast(FinalizedBlockMessageType, finalizedBlock)
+}
+
+func (hub *wsEventsHub) broadcast(messageType string, data interface{}) error {
+	subscribers := hub.getSubscribers(messageType)
+	if len(subscribers) == 0 {
+		return nil
+	}
+
+	message, err := json.Marshal(WSMessage{Type: messageType, Data: data})
+	if err != nil {
+		return fmt.Errorf("%w in wsEventsHub while marshaling %s data", err, messageType)
+	}
+
+	for _, subscriber := range subscribers {
+		hub.pushMessage(subscriber, message)
+	}
+
+	return nil
+}
+
+// SaveRoundsInfo returns nil
+func (hub *wsEventsHub) SaveRoundsInfo(_ []*indexer.RoundInfo) error {
+	return nil
+}
+
+// SaveValidatorsRating returns nil
+func (hub *wsEventsHub) SaveValidatorsRating(_ string, _ []*indexer.ValidatorRatingInfo) error {
+	return nil
+}
+
+// SaveValidatorsPubKeys returns nil
+func (hub *wsEventsHub) SaveValidatorsPubKeys(_ map[uint32][][]byte, _ uint32) error {
+	return nil
+}
+
+// SaveAccounts does nothing
+func (hub *wsEventsHub) SaveAccounts(_ uint64, _ []nodeData.UserAccountHandler) error {
+	return nil
+}
+
+// IsEnabled returns true
+func (hub *wsEventsHub) IsEnabled() bool {
+	return true
+}
+
+// Close disconnects all subscribers and rejects the new ones
+func (hub *wsEventsHub) Close() error {
+	hub.mutSubscribers.Lock()
+	defer hub.mutSubscribers.Unlock()
+
+	hub.isClosed = true
+	for subscriber := range hub.subscribers {
+		subscriber.close()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns whether the interface is nil
+func (hub *wsEventsHub) IsInterfaceNil() bool {
+	return hub == nil
+}
@@ -0,0 +1,429 @@
+package notifier_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/block"
+	"github.com/ElrondNetwork/elrond-go-core/data/indexer"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/ElrondNetwork/elrond-go/outport/mock"
+	"github.com/ElrondNetwork/elrond-go/outport/notifier"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
+	"github.com/stretchr/testify/require"
+)
+
+const waitTimeout = time.Second * 5
+
+type receivedWSMessage struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+func createMockWSEventsHubArgs() notifier.ArgsWSEventsHub {
+	return notifier.ArgsWSEventsHub{
+		Marshaller:          &testscommon.MarshalizerMock{},
+		Hasher:              &hashingMocks.HasherMock{},
+		PubKeyConverter:     &testscommon.PubkeyConverterMock{},
+		MaxSubscribers:      2,
+		SendBufferSize:      10,
+		SubscriptionTimeout: waitTimeout,
+		WriteTimeout:        waitTimeout,
+		PongTimeout:         waitTimeout,
+	}
+}
+
+func subscribe(t *testing.T, hub notifier.WSEventsHubHandler, subscription notifier.WSSubscription) (*mock.WSConnectionMock, chan error) {
+	conn := mock.NewWSConnectionMock()
+	subscriptionBytes, err := json.Marshal(subscription)
+	require.Nil(t, err)
+	conn.SendFromClient(subscriptionBytes)
+
+	numSubscribers := hub.NumSubscribers()
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- hub.ServeSubscriber(conn)
+	}()
+
+	require.Eventually(t, func() bool {
+		return hub.NumSubscribers() == numSubscribers+1
+	}, waitTimeout, time.Millisecond)
+
+	return conn, errChan
+}
+
+func readMessage(t *testing.T, conn *mock.WSConnectionMock) receivedWSMessage {
+	select {
+	case messageBytes := <-conn.Written():
+		message := receivedWSMessage{}
+		require.Nil(t, json.Unmarshal(messageBytes, &message))
+		return message
+	case <-time.After(waitTimeout):
+		require.Fail(t, "timeout while waiting for the websocket message")
+		return receivedWSMessage{}
+	}
+}
+
+func requireNoMessage(t *testing.T, conn *mock.WSConnectionMock) {
+	select {
+	case messageBytes := <-conn.Written():
+		require.Fail(t, "unexpected websocket message", string(messageBytes))
+	case <-time.After(time.Millisecond * 50):
+	}
+}
+
+func createSaveBlockArgs() *indexer.ArgsSaveBlockData {
+	return &indexer.ArgsSaveBlockData{
+		HeaderHash: []byte("hash"),
+		Header:     &block.Header{Nonce: 7, Round: 8, Epoch: 1, ShardID: 2},
+		TransactionsPool: &indexer.Pool{
+			Logs: []*data.LogData{
+				{
+					TxHash: "txHash",
+					LogHandler: &transaction.Log{
+						Events: []*transaction.Event{
+							{Address: []byte("alice"), Identifier: []byte("ESDTTransfer"), Topics: [][]byte{[]byte("TKN"), []byte("nonce")}},
+							{Address: []byte("bob"), Identifier: []byte("writeLog")},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestNewWSEventsHub(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller", func(t *testing.T) {
+		args := createMockWSEventsHubArgs()
+		args.Marshaller = nil
+		hub, err := notifier.NewWSEventsHub(args)
+		require.True(t, check.IfNil(hub))
+		require.Equal(t, core.ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher", func(t *testing.T) {
+		args := createMockWSEventsHubArgs()
+		args.Hasher = nil
+		hub, err := notifier.NewWSEventsHub(args)
+		require.True(t, check.IfNil(hub))
+		require.Equal(t, core.ErrNilHasher, err)
+	})
+	t.Run("nil pub key converter", func(t *testing.T) {
+		args := createMockWSEventsHubArgs()
+		args.PubKeyConverter = nil
+		hub, err := notifier.NewWSEventsHub(args)
+		require.True(t, check.IfNil(hub))
+		require.Equal(t, outport.ErrNilPubKeyConverter, err)
+	})
+	t.Run("invalid max subscribers", func(t *testing.T) {
+		args := createMockWSEventsHubArgs()
+		args.MaxSubscribers = 0
+		hub, err := notifier.NewWSEventsHub(args)
+		require.True(t, check.IfNil(hub))
+		require.Equal(t, notifier.ErrInvalidMaxSubscribers, err)
+	})
+	t.Run("invalid send buffer size", func(t *testing.T) {
+		args := createMockWSEventsHubArgs()
+		args.SendBufferSize = 0
+		hub, err := notifier.NewWSEventsHub(args)
+		require.True(t, check.IfNil(hub))
+		require.Equal(t, notifier.ErrInvalidSendBufferSize, err)
+	})
+	t.Run("invalid subscription timeout", func(t *testing.T) {
+		args := createMockWSEventsHubArgs()
+		args.SubscriptionTimeout = 0
+		hub, err := notifier.NewWSEventsHub(args)
+		require.True(t, check.IfNil(hub))
+		require.Equal(t, notifier.ErrInvalidSubscriptionTimeout, err)
+	})
+	t.Run("invalid write timeout", func(t *testing.T) {
+		args := createMockWSEventsHubArgs()
+		args.WriteTimeout = 0
+		hub, err := notifier.NewWSEventsHub(args)
+		require.True(t, check.IfNil(hub))
+		require.Equal(t, notifier.ErrInvalidWriteTimeout, err)
+	})
+	t.Run("invalid pong timeout", func(t *testing.T) {
+		args := createMockWSEventsHubArgs()
+		args.PongTimeout = 0
+		hub, err := notifier.NewWSEventsHub(args)
+		require.True(t, check.IfNil(hub))
+		require.Equal(t, notifier.ErrInvalidPongTimeout, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		hub, err := notifier.NewWSEventsHub(createMockWSEventsHubArgs())
+		require.False(t, check.IfNil(hub))
+		require.Nil(t, err)
+		require.True(t, hub.IsEnabled())
+	})
+}
+
+func TestWSEventsHub_ServeSubscriberInvalidSubscriptionShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := notifier.NewWSEventsHub(createMockWSEventsHubArgs())
+
+	err := hub.ServeSubscriber(nil)
+	require.Equal(t, notifier.ErrNilWSConnection, err)
+
+	conn := mock.NewWSConnectionMock()
+	conn.SendFromClient([]byte("not a subscription"))
+	err = hub.ServeSubscriber(conn)
+	require.NotNil(t, err)
+	require.True(t, conn.IsClosed())
+
+	conn = mock.NewWSConnectionMock()
+	conn.SendFromClient([]byte(`{"types":["unknown"]}`))
+	err = hub.ServeSubscriber(conn)
+	require.ErrorIs(t, err, notifier.ErrInvalidSubscriptionType)
+	require.True(t, conn.IsClosed())
+	require.Equal(t, 0, hub.NumSubscribers())
+}
+
+func TestWSEventsHub_ServeSubscriberTooManySubscribersShouldErr(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := notifier.NewWSEventsHub(createMockWSEventsHubArgs())
+	_, _ = subscribe(t, hub, notifier.WSSubscription{})
+	_, _ = subscribe(t, hub, notifier.WSSubscription{})
+
+	conn := mock.NewWSConnectionMock()
+	conn.SendFromClient([]byte(`{}`))
+	err := hub.ServeSubscriber(conn)
+	require.Equal(t, notifier.ErrTooManySubscribers, err)
+
+	_ = hub.Close()
+	require.Eventually(t, func() bool {
+		return hub.NumSubscribers() == 0
+	}, waitTimeout, time.Millisecond)
+
+	conn = mock.NewWSConnectionMock()
+	conn.SendFromClient([]byte(`{}`))
+	err = hub.ServeSubscriber(conn)
+	require.Equal(t, notifier.ErrWSEventsHubClosed, err)
+}
+
+func TestWSEventsHub_PendingSubscribersShouldCountAgainstMaxSubscribers(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := notifier.NewWSEventsHub(createMockWSEventsHubArgs())
+	_, _ = subscribe(t, hub, notifier.WSSubscription{})
+
+	// connected, but not subscribed yet
+	pendingConn := mock.NewWSConnectionMock()
+	pendingErrChan := make(chan error, 1)
+	go func() {
+		pendingErrChan <- hub.ServeSubscriber(pendingConn)
+	}()
+
+	require.Eventually(t, func() bool {
+		conn := mock.NewWSConnectionMock()
+		conn.SendFromClient([]byte(`{}`))
+		return hub.ServeSubscriber(conn) == notifier.ErrTooManySubscribers
+	}, waitTimeout, time.Millisecond)
+
+	// the slot is freed once the pending connection is closed
+	_ = pendingConn.Close()
+	require.NotNil(t, <-pendingErrChan)
+	_, _ = subscribe(t, hub, notifier.WSSubscription{})
+}
+
+func TestWSEventsHub_SubscriptionTimeoutShouldDisconnect(t *testing.T) {
+	t.Parallel()
+
+	args := createMockWSEventsHubArgs()
+	args.SubscriptionTimeout = time.Millisecond * 10
+	args.MaxSubscribers = 1
+	hub, _ := notifier.NewWSEventsHub(args)
+
+	conn := mock.NewWSConnectionMock()
+	err := hub.ServeSubscriber(conn)
+	require.NotNil(t, err)
+	require.True(t, conn.IsClosed())
+
+	_, _ = subscribe(t, hub, notifier.WSSubscription{})
+}
+
+func TestWSEventsHub_PushesNotifications(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := notifier.NewWSEventsHub(createMockWSEventsHubArgs())
+	connAll, errChanAll := subscribe(t, hub, notifier.WSSubscription{})
+	connFiltered, errChanFiltered := subscribe(t, hub, notifier.WSSubscription{
+		Types: []string{notifier.SaveBlockMessageType, notifier.RevertBlockMessageType},
+		Filters: []notifier.WSEventFilter{
+			{Identifier: "ESDTTransfer", Topics: [][]byte{[]byte("TKN")}},
+		},
+	})
+
+	err := hub.SaveBlock(createSaveBlockArgs())
+	require.Nil(t, err)
+
+	message := readMessage(t, connAll)
+	require.Equal(t, notifier.SaveBlockMessageType, message.Type)
+	blockData := notifier.WSSaveBlockData{}
+	require.Nil(t, json.Unmarshal(message.Data, &blockData))
+	require.Equal(t, hex.EncodeToString([]byte("hash")), blockData.Hash)
+	require.Equal(t, uint64(7), blockData.Nonce)
+	require.Equal(t, uint32(2), blockData.ShardID)
+	require.Equal(t, 2, len(blockData.Events))
+
+	message = readMessage(t, connFiltered)
+	require.Equal(t, notifier.SaveBlockMessageType, message.Type)
+	blockData = notifier.WSSaveBlockData{}
+	require.Nil(t, json.Unmarshal(message.Data, &blockData))
+	require.Equal(t, 1, len(blockData.Events))
+	require.Equal(t, "ESDTTransfer", blockData.Events[0].Identifier)
+	require.Equal(t, hex.EncodeToString([]byte("txHash")), blockData.Events[0].TxHash)
+
+	// blocks without matching events are not pushed to the filtered subscriber
+	saveBlockArgs := createSaveBlockArgs()
+	saveBlockArgs.TransactionsPool.Logs = nil
+	err = hub.SaveBlock(saveBlockArgs)
+	require.Nil(t, err)
+	message = readMessage(t, connAll)
+	require.Equal(t, notifier.SaveBlockMessageType, message.Type)
+	requireNoMessage(t, connFiltered)
+
+	err = hub.RevertIndexedBlock(&block.Header{Nonce: 7, Round: 8, Epoch: 1}, &block.Body{})
+	require.Nil(t, err)
+	require.Equal(t, notifier.RevertBlockMessageType, readMessage(t, connAll).Type)
+	message = readMessage(t, connFiltered)
+	require.Equal(t, notifier.RevertBlockMessageType, message.Type)
+	revertBlock := notifier.RevertBlock{}
+	require.Nil(t, json.Unmarshal(message.Data, &revertBlock))
+	require.Equal(t, uint64(7), revertBlock.Nonce)
+
+	err = hub.FinalizedBlock([]byte("hash"))
+	require.Nil(t, err)
+	message = readMessage(t, connAll)
+	require.Equal(t, notifier.FinalizedBlockMessageType, message.Type)
+	finalizedBlock := notifier.FinalizedBlock{}
+	require.Nil(t, json.Unmarshal(message.Data, &finalizedBlock))
+	require.Equal(t, hex.EncodeToString([]byte("hash")), finalizedBlock.Hash)
+	requireNoMessage(t, connFiltered)
+
+	// a client closing its connection is removed from the hub
+	_ = connFiltered.Close()
+	require.Nil(t, <-errChanFiltered)
+	require.Equal(t, 1, hub.NumSubscribers())
+
+	_ = hub.Close()
+	require.Nil(t, <-errChanAll)
+	require.True(t, connAll.IsClosed())
+	require.Equal(t, 0, hub.NumSubscribers())
+}
+
+func TestWSEventsHub_SlowSubscriberShouldBeDisconnected(t *testing.T) {
+	t.Parallel()
+
+	args := createMockWSEventsHubArgs()
+	args.SendBufferSize = 1
+	hub, _ := notifier.NewWSEventsHub(args)
+	conn, errChan := subscribe(t, hub, notifier.WSSubscription{})
+
+	// the connection mock accepts 100 messages before blocking, the client never reads them
+	for i := 0; i < 200; i++ {
+		err := hub.FinalizedBlock([]byte("hash"))
+		require.Nil(t, err)
+	}
+
+	select {
+	case err := <-errChan:
+		require.Nil(t, err)
+	case <-time.After(waitTimeout):
+		require.Fail(t, "slow subscriber was not disconnected")
+	}
+	require.True(t, conn.IsClosed())
+	require.Equal(t, 0, hub.NumSubscribers())
+}
+
+func TestWSEventFilter_Matches(t *testing.T) {
+	t.Parallel()
+
+	event := notifier.Event{
+		Address:    "alice",
+		Identifier: "ESDTTransfer",
+		Topics:     [][]byte{[]byte("TKN"), []byte("nonce")},
+	}
+
+	matchingFilters := []notifier.WSEventFilter{
+		{},
+		{Address: "alice"},
+		{Identifier: "ESDTTransfer"},
+		{Topics: [][]byte{[]byte("TKN")}},
+		{Topics: [][]byte{nil, []byte("nonce")}},
+		{Address: "alice", Identifier: "ESDTTransfer", Topics: [][]byte{[]byte("TKN"), []byte("nonce")}},
+	}
+	for i := range matchingFilters {
+		require.True(t, matchingFilters[i].Matches(event), i)
+	}
+
+	notMatchingFilters := []notifier.WSEventFilter{
+		{Address: "bob"},
+		{Identifier: "writeLog"},
+		{Topics: [][]byte{[]byte("nonce")}},
+		{Topics: [][]byte{[]byte("TKN"), []byte("nonce"), []byte("extra")}},
+	}
+	for i := range notMatchingFilters {
+		require.False(t, notMatchingFilters[i].Matches(event), i)
+	}
+}
+
+func TestWSEventsHub_SubscriberNotReadingShouldBeDisconnected(t *testing.T) {
+	t.Parallel()
+
+	args := createMockWSEventsHubArgs()
+	args.SendBufferSize = 200
+	args.WriteTimeout = time.Millisecond * 10
+	hub, _ := notifier.NewWSEventsHub(args)
+	conn, errChan := subscribe(t, hub, notifier.WSSubscription{})
+
+	// the connection mock accepts 100 messages before blocking the writes, while the subscriber's buffer is not full
+	conn.StopResponding()
+	for i := 0; i < 150; i++ {
+		err := hub.FinalizedBlock([]byte("hash"))
+		require.Nil(t, err)
+	}
+
+	select {
+	case err := <-errChan:
+		require.Nil(t, err)
+	case <-time.After(waitTimeout):
+		require.Fail(t, "subscriber not reading its notifications was not disconnected")
+	}
+	require.True(t, conn.IsClosed())
+	require.Equal(t, 0, hub.NumSubscribers())
+}
+
+func TestWSEventsHub_SubscriberNotAnsweringPingsShouldBeDisconnected(t *testing.T) {
+	t.Parallel()
+
+	args := createMockWSEventsHubArgs()
+	args.PongTimeout = time.Millisecond * 50
+	hub, _ := notifier.NewWSEventsHub(args)
+	respondingConn, _ := subscribe(t, hub, notifier.WSSubscription{})
+	conn, errChan := subscribe(t, hub, notifier.WSSubscription{})
+
+	conn.StopResponding()
+	select {
+	case err := <-errChan:
+		require.Nil(t, err)
+	case <-time.After(waitTimeout):
+		require.Fail(t, "subscriber not answering the pings was not disconnected")
+	}
+	require.True(t, conn.IsClosed())
+
+	// the subscriber answering the pings stays connected
+	time.Sleep(args.PongTimeout * 3)
+	require.False(t, respondingConn.IsClosed())
+	require.Equal(t, 1, hub.NumSubscribers())
+}
@@ -0,0 +1,210 @@
+package notifier
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/outport"
+	"github.com/gorilla/websocket"
+)
+
+// WSSubscription is the first message a websocket client has to send. It selects the notification types the client
+// wants to receive (all of them if empty) and the log events pushed along with the saved blocks
+type WSSubscription struct {
+	Types   []string        `json:"types"`
+	Filters []WSEventFilter `json:"filters"`
+}
+
+// WSEventFilter selects log events by address, identifier and topics. Empty fields match any value and the topics
+// are matched by position, an empty topic matching any value on that position
+type WSEventFilter struct {
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     [][]byte `json:"topics"`
+}
+
+func (filter *WSEventFilter) matches(event Event) bool {
+	if len(filter.Address) > 0 && filter.Address != event.Address {
+		return false
+	}
+	if len(filter.Identifier) > 0 && filter.Identifier != event.Identifier {
+		return false
+	}
+	if len(filter.Topics) > len(event.Topics) {
+		return false
+	}
+
+	for i, topic := range filter.Topics {
+		if len(topic) > 0 && !bytes.Equal(topic, event.Topics[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type argsWSSubscriber struct {
+	conn           outport.WSConnection
+	subscription   *WSSubscription
+	sendBufferSize int
+	writeTimeout   time.Duration
+	pongTimeout    time.Duration
+}
+
+type wsSubscriber struct {
+	conn         outport.WSConnection
+	types        map[string]struct{}
+	filters      []WSEventFilter
+	messages     chan []byte
+	writeTimeout time.Duration
+	pongTimeout  time.Duration
+	closeOnce    sync.Once
+	closeChan    chan struct{}
+}
+
+func newWSSubscriber(args argsWSSubscriber) (*wsSubscriber, error) {
+	types := make(map[string]struct{})
+	for _, messageType := range args.subscription.Types {
+		switch messageType {
+		case SaveBlockMessageType, RevertBlockMessageType, FinalizedBlockMessageType:
+			types[messageType] = struct{}{}
+		default:
+			return nil, fmt.Errorf("%w: %s", ErrInvalidSubscriptionType, messageType)
+		}
+	}
+
+	return &wsSubscriber{
+		conn:         args.conn,
+		types:        types,
+		filters:      args.subscription.Filters,
+		messages:     make(chan []byte, args.sendBufferSize),
+		writeTimeout: args.writeTimeout,
+		pongTimeout:  args.pongTimeout,
+		closeChan:    make(chan struct{}),
+	}, nil
+}
+
+func readSubscription(conn outport.WSConnection, timeout time.Duration) (*WSSubscription, error) {
+	err := conn.SetReadDeadline(time.Now().Add(timeout))
+	if err != nil {
+		return nil, err
+	}
+
+	_, message, err := conn.ReadMessage()
+	if err != nil {
+		return nil, fmt.Errorf("%w while reading the websocket subscription", err)
+	}
+
+	subscription := &WSSubscription{}
+	err = json.Unmarshal(message, subscription)
+	if err != nil {
+		return nil, fmt.Errorf("%w while reading the websocket subscription", err)
+	}
+
+	return subscription, nil
+}
+
+func (ws *wsSubscriber) isSubscribedTo(messageType string) bool {
+	if len(ws.types) == 0 {
+		return true
+	}
+
+	_, ok := ws.types[messageType]
+	return ok
+}
+
+func (ws *wsSubscriber) hasFilters() bool {
+	return len(ws.filters) > 0
+}
+
+func (ws *wsSubscriber) filterEvents(events []Event) []Event {
+	filteredEvents := make([]Event, 0)
+	for _, event := range events {
+		for i := range ws.filters {
+			if ws.filters[i].matches(event) {
+				filteredEvents = append(filteredEvents, event)
+				break
+			}
+		}
+	}
+
+	return filteredEvents
+}
+
+// push will not block, returning false if the subscriber's buffer is full
+func (ws *wsSubscriber) push(message []byte) bool {
+	select {
+	case ws.messages <- message:
+		return true
+	default:
+		return false
+	}
+}
+
+// close also closes the connection, so that the reads and the writes blocked on it return
+func (ws *wsSubscriber) close() {
+	ws.closeOnce.Do(func() {
+		close(ws.closeChan)
+		_ = ws.conn.Close()
+	})
+}
+
+// monitorConnection reads the connection for detecting when the client disconnects or stops answering the pings.
+// Each pong extends the read deadline
+func (ws *wsSubscriber) monitorConnection() {
+	defer ws.close()
+
+	ws.conn.SetPongHandler(func(_ string) error {
+		return ws.conn.SetReadDeadline(time.Now().Add(ws.pongTimeout))
+	})
+	err := ws.conn.SetReadDeadline(time.Now().Add(ws.pongTimeout))
+	if err != nil {
+		return
+	}
+
+	for {
+		mt, _, errRead := ws.conn.ReadMessage()
+		if mt == websocket.CloseMessage || errRead != nil {
+			return
+		}
+	}
+}
+
+// sendContinuously writes the notifications and the pings. A write not completed in time disconnects the subscriber
+func (ws *wsSubscriber) sendContinuously() {
+	pingTicker := time.NewTicker(ws.pongTimeout * 9 / 10)
+	defer pingTicker.Stop()
+
+	for {
+		select {
+		case <-ws.closeChan:
+			return
+		case message := <-ws.messages:
+			err := ws.writeMessage(message)
+			if err != nil {
+				log.Debug("wsEventsHub: cannot write to subscriber", "error", err.Error())
+				ws.close()
+				return
+			}
+		case <-pingTicker.C:
+			err := ws.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(ws.writeTimeout))
+			if err != nil {
+				log.Debug("wsEventsHub: cannot ping subscriber", "error", err.Error())
+				ws.close()
+				return
+			}
+		}
+	}
+}
+
+func (ws *wsSubscriber) writeMessage(message []byte) error {
+	err := ws.conn.SetWriteDeadline(time.Now().Add(ws.writeTimeout))
+	if err != nil {
+		return err
+	}
+
+	return ws.conn.WriteMessage(websocket.TextMessage, message)
+}
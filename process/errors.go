@@ -1142,3 +1142,43 @@ var ErrNilCrawlerAllowedAddress = errors.New("nil crawler allowed address")
 
 // ErrNilPayloadValidator signals that a nil payload validator was provided
 var ErrNilPayloadValidator = errors.New("nil payload validator")
+
+// ErrNilAccountsOverrider signals that a nil accounts overrider has been provided
+var ErrNilAccountsOverrider = errors.New("nil accounts overrider")
+
+// ErrNilStateChangesCollector signals that a nil state changes collector has been provided
+var ErrNilStateChangesCollector = errors.New("nil state changes collector")
+
+// ErrNilTxStateChangesHandler signals that a nil transaction state changes handler has been provided
+var ErrNilTxStateChangesHandler = errors.New("nil transaction state changes handler")
+
+// ErrGasEstimationNotAvailableInShard signals that the gas of a transaction cannot be estimated, as neither its sender,
+// nor its receiver belong to the self shard
+var ErrGasEstimationNotAvailableInShard = errors.New("gas estimation not available in this shard")
+
+// ErrNilCrossShardGasEstimator signals that a nil cross shard gas estimator has been provided
+var ErrNilCrossShardGasEstimator = errors.New("nil cross shard gas estimator")
+
+// ErrNoObserverForShard signals that no observer is configured for the shard which should estimate a part of the gas
+var ErrNoObserverForShard = errors.New("no observer configured for shard")
+
+// ErrCrossShardGasEstimationFailed signals that the observer of another shard could not estimate the gas of a request
+var ErrCrossShardGasEstimationFailed = errors.New("cross shard gas estimation failed")
+
+// ErrNilTxsSenderHandler signals that a nil transactions sender handler has been provided
+var ErrNilTxsSenderHandler = errors.New("nil transactions sender handler")
+
+// ErrInvalidScheduledTxCondition signals that an unknown release condition has been provided for a scheduled transaction
+var ErrInvalidScheduledTxCondition = errors.New("invalid release condition for scheduled transaction, allowed values: round, epoch, nonce")
+
+// ErrScheduledTxsOutboxFull signals that the outbox of scheduled transactions cannot hold more transactions
+var ErrScheduledTxsOutboxFull = errors.New("the outbox of scheduled transactions is full")
+
+// ErrScheduledTxAlreadyExists signals that a transaction has already been scheduled
+var ErrScheduledTxAlreadyExists = errors.New("transaction already scheduled")
+
+// ErrScheduledTxNotFound signals that a scheduled transaction was not found in the outbox
+var ErrScheduledTxNotFound = errors.New("scheduled transaction not found")
+
+// ErrScheduledTxsOutboxDisabled signals that the outbox of scheduled transactions is not enabled on this node
+var ErrScheduledTxsOutboxDisabled = errors.New("the outbox of scheduled transactions is disabled")
@@ -23,6 +23,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/p2p"
 	"github.com/ElrondNetwork/elrond-go/process/block/bootstrapStorage"
 	"github.com/ElrondNetwork/elrond-go/process/block/processedMb"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/ElrondNetwork/elrond-go/storage"
@@ -737,6 +738,7 @@ type SCQuery struct {
 	Arguments      [][]byte
 	SameScState    bool
 	ShouldBeSynced bool
+	StateOverrides map[string]*state.AccountOverride
 }
 
 // GasHandler is able to perform some gas calculation
@@ -1246,3 +1248,25 @@ type PeerAuthenticationPayloadValidator interface {
 	ValidateTimestamp(payloadTimestamp int64) error
 	IsInterfaceNil() bool
 }
+
+// TxStateChangesHandler defines the behavior of a component able to receive the state changes of a processed transaction
+type TxStateChangesHandler interface {
+	AddStateChanges(txHash []byte, stateChanges []*state.AccountStateChanges)
+	IsInterfaceNil() bool
+}
+
+// TransactionSimulator defines the behavior of a component able to simulate the processing of a transaction or of a
+// smart contract result against the self shard state
+type TransactionSimulator interface {
+	ProcessTx(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error)
+	ProcessSmartContractResult(scr *smartContractResult.SmartContractResult) (*txSimData.SimulationResults, error)
+	IsInterfaceNil() bool
+}
+
+// CrossShardGasEstimator defines the behavior of a component able to estimate the gas needed by the part of a
+// transaction, or by a smart contract result, which is executed in another shard, against the state of that shard
+type CrossShardGasEstimator interface {
+	EstimateTransactionGas(shardID uint32, tx *transaction.Transaction) (*txSimData.GasEstimation, error)
+	EstimateSmartContractResultGas(shardID uint32, scr *transaction.ApiSmartContractResult) (*txSimData.GasEstimation, error)
+	IsInterfaceNil() bool
+}
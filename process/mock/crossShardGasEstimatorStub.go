@@ -0,0 +1,36 @@
+package mock
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+)
+
+// CrossShardGasEstimatorStub -
+type CrossShardGasEstimatorStub struct {
+	EstimateTransactionGasCalled         func(shardID uint32, tx *transaction.Transaction) (*txSimData.GasEstimation, error)
+	EstimateSmartContractResultGasCalled func(shardID uint32, scr *transaction.ApiSmartContractResult) (*txSimData.GasEstimation, error)
+}
+
+// EstimateTransactionGas -
+func (stub *CrossShardGasEstimatorStub) EstimateTransactionGas(shardID uint32, tx *transaction.Transaction) (*txSimData.GasEstimation, error) {
+	if stub.EstimateTransactionGasCalled != nil {
+		return stub.EstimateTransactionGasCalled(shardID, tx)
+	}
+
+	return nil, process.ErrNoObserverForShard
+}
+
+// EstimateSmartContractResultGas -
+func (stub *CrossShardGasEstimatorStub) EstimateSmartContractResultGas(shardID uint32, scr *transaction.ApiSmartContractResult) (*txSimData.GasEstimation, error) {
+	if stub.EstimateSmartContractResultGasCalled != nil {
+		return stub.EstimateSmartContractResultGasCalled(shardID, scr)
+	}
+
+	return nil, process.ErrNoObserverForShard
+}
+
+// IsInterfaceNil -
+func (stub *CrossShardGasEstimatorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
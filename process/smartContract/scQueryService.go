@@ -13,6 +13,7 @@ import (
 	vmData "github.com/ElrondNetwork/elrond-go-core/data/vm"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/state"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/ElrondNetwork/elrond-vm-common/parsers"
 )
@@ -31,6 +32,7 @@ type SCQueryService struct {
 	arwenChangeLocker        common.Locker
 	bootstrapper             process.Bootstrapper
 	allowExternalQueriesChan chan struct{}
+	accountsOverrider        state.AccountsOverridesHandler
 }
 
 // ArgsNewSCQueryService defines the arguments needed for the sc query service
@@ -43,6 +45,7 @@ type ArgsNewSCQueryService struct {
 	Bootstrapper             process.Bootstrapper
 	AllowExternalQueriesChan chan struct{}
 	MaxGasLimitPerQuery      uint64
+	AccountsOverrider        state.AccountsOverridesHandler
 }
 
 // NewSCQueryService returns a new instance of SCQueryService
@@ -70,6 +73,9 @@ func NewSCQueryService(
 	if args.AllowExternalQueriesChan == nil {
 		return nil, process.ErrNilAllowExternalQueriesChan
 	}
+	if check.IfNil(args.AccountsOverrider) {
+		return nil, process.ErrNilAccountsOverrider
+	}
 
 	gasForQuery := uint64(math.MaxUint64)
 	if args.MaxGasLimitPerQuery > 0 {
@@ -84,6 +90,7 @@ func NewSCQueryService(
 		bootstrapper:             args.Bootstrapper,
 		gasForQuery:              gasForQuery,
 		allowExternalQueriesChan: args.AllowExternalQueriesChan,
+		accountsOverrider:        args.AccountsOverrider,
 	}, nil
 }
 
@@ -133,6 +140,14 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 
 	service.blockChainHook.SetCurrentHeader(service.blockChain.GetCurrentBlockHeader())
 
+	if len(query.StateOverrides) > 0 {
+		err := service.accountsOverrider.SetOverrides(query.StateOverrides)
+		if err != nil {
+			return nil, err
+		}
+		defer service.accountsOverrider.ResetOverrides()
+	}
+
 	service.arwenChangeLocker.RLock()
 	vm, err := findVMByScAddress(service.vmContainer, query.ScAddress)
 	if err != nil {
@@ -16,7 +16,9 @@ import (
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -33,6 +35,7 @@ func createMockArgumentsForSCQuery() ArgsNewSCQueryService {
 		ArwenChangeLocker:        &sync.RWMutex{},
 		Bootstrapper:             &mock.BootstrapperStub{},
 		AllowExternalQueriesChan: common.GetClosedUnbufferedChannel(),
+		AccountsOverrider:        &stateMock.AccountsOverridesHandlerStub{},
 	}
 }
 
@@ -102,6 +105,17 @@ func TestNewSCQueryService_NilBootstrapperShouldErr(t *testing.T) {
 	assert.Equal(t, process.ErrNilBootstrapper, err)
 }
 
+func TestNewSCQueryService_NilAccountsOverriderShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgumentsForSCQuery()
+	args.AccountsOverrider = nil
+	target, err := NewSCQueryService(args)
+
+	assert.Nil(t, target)
+	assert.Equal(t, process.ErrNilAccountsOverrider, err)
+}
+
 func TestNewSCQueryService_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -745,6 +759,7 @@ func TestNewSCQueryService_CloseShouldWork(t *testing.T) {
 		ArwenChangeLocker:        &sync.RWMutex{},
 		Bootstrapper:             &mock.BootstrapperStub{},
 		AllowExternalQueriesChan: common.GetClosedUnbufferedChannel(),
+		AccountsOverrider:        &stateMock.AccountsOverridesHandlerStub{},
 	}
 
 	target, _ := NewSCQueryService(argsNewSCQueryService)
@@ -753,3 +768,81 @@ func TestNewSCQueryService_CloseShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 	assert.True(t, closeCalled)
 }
+
+func TestSCQueryService_ExecuteQueryWithStateOverrides(t *testing.T) {
+	t.Parallel()
+
+	overrides := map[string]*state.AccountOverride{
+		"address": {Balance: big.NewInt(37)},
+	}
+
+	t.Run("set overrides error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		runScCalled := false
+		args := createMockArgumentsForSCQuery()
+		args.VmContainer = &mock.VMContainerMock{
+			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+				return &mock.VMExecutionHandlerStub{
+					RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+						runScCalled = true
+						return &vmcommon.VMOutput{}, nil
+					},
+				}, nil
+			},
+		}
+		args.AccountsOverrider = &stateMock.AccountsOverridesHandlerStub{
+			SetOverridesCalled: func(_ map[string]*state.AccountOverride) error {
+				return expectedErr
+			},
+		}
+		qs, _ := NewSCQueryService(args)
+
+		res, err := qs.ExecuteQuery(&process.SCQuery{
+			ScAddress:      []byte(DummyScAddress),
+			FuncName:       "function",
+			StateOverrides: overrides,
+		})
+		require.Nil(t, res)
+		require.Equal(t, expectedErr, err)
+		require.False(t, runScCalled)
+	})
+	t.Run("should apply the overrides only during the execution", func(t *testing.T) {
+		t.Parallel()
+
+		areOverridesSet := false
+		wereOverridesSetAtExecution := false
+		args := createMockArgumentsForSCQuery()
+		args.VmContainer = &mock.VMContainerMock{
+			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+				return &mock.VMExecutionHandlerStub{
+					RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+						wereOverridesSetAtExecution = areOverridesSet
+						return &vmcommon.VMOutput{}, nil
+					},
+				}, nil
+			},
+		}
+		args.AccountsOverrider = &stateMock.AccountsOverridesHandlerStub{
+			SetOverridesCalled: func(providedOverrides map[string]*state.AccountOverride) error {
+				require.Equal(t, overrides, providedOverrides)
+				areOverridesSet = true
+				return nil
+			},
+			ResetOverridesCalled: func() {
+				areOverridesSet = false
+			},
+		}
+		qs, _ := NewSCQueryService(args)
+
+		_, err := qs.ExecuteQuery(&process.SCQuery{
+			ScAddress:      []byte(DummyScAddress),
+			FuncName:       "function",
+			StateOverrides: overrides,
+		})
+		require.Nil(t, err)
+		require.True(t, wereOverridesSetAtExecution)
+		require.False(t, areOverridesSet)
+	})
+}
@@ -0,0 +1,141 @@
+package stateDiff
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+// esdtTokenRandomSequenceLength is the length of the dash and the random sequence ending a token identifier
+const esdtTokenRandomSequenceLength = 7
+
+var esdtKeyPrefix = []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier)
+
+type stateDiffConverter struct {
+	addressPubKeyConverter core.PubkeyConverter
+	marshalizer            marshal.Marshalizer
+}
+
+// NewStateDiffConverter creates a new instance of stateDiffConverter, able to convert the recorded state changes
+// into their API representation
+func NewStateDiffConverter(addressPubKeyConverter core.PubkeyConverter, marshalizer marshal.Marshalizer) (*stateDiffConverter, error) {
+	if check.IfNil(addressPubKeyConverter) {
+		return nil, process.ErrNilPubkeyConverter
+	}
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+
+	return &stateDiffConverter{
+		addressPubKeyConverter: addressPubKeyConverter,
+		marshalizer:            marshalizer,
+	}, nil
+}
+
+// ConvertStateChanges converts the provided state changes, decoding the ESDT balances found in the data tries
+func (sdc *stateDiffConverter) ConvertStateChanges(stateChanges []*state.AccountStateChanges) []*txSimData.AccountStateDiff {
+	stateDiff := make([]*txSimData.AccountStateDiff, 0, len(stateChanges))
+	for _, accountChanges := range stateChanges {
+		stateDiff = append(stateDiff, sdc.convertAccountStateChanges(accountChanges))
+	}
+
+	return stateDiff
+}
+
+func (sdc *stateDiffConverter) convertAccountStateChanges(accountChanges *state.AccountStateChanges) *txSimData.AccountStateDiff {
+	accountDiff := &txSimData.AccountStateDiff{
+		Address:       sdc.addressPubKeyConverter.Encode(accountChanges.Address),
+		BalanceBefore: bigIntToString(accountChanges.BalanceBefore),
+		BalanceAfter:  bigIntToString(accountChanges.BalanceAfter),
+		NonceBefore:   accountChanges.NonceBefore,
+		NonceAfter:    accountChanges.NonceAfter,
+	}
+
+	for _, dataTrieChange := range accountChanges.DataTrieChanges {
+		if sdc.addESDTBalanceDiff(accountDiff, dataTrieChange) {
+			continue
+		}
+
+		if accountDiff.Storage == nil {
+			accountDiff.Storage = make(map[string]*txSimData.StorageValueDiff)
+		}
+		accountDiff.Storage[hex.EncodeToString(dataTrieChange.Key)] = &txSimData.StorageValueDiff{
+			Before: hex.EncodeToString(dataTrieChange.Before),
+			After:  hex.EncodeToString(dataTrieChange.After),
+		}
+	}
+
+	return accountDiff
+}
+
+// addESDTBalanceDiff returns false if the data trie change is not an ESDT balance change
+func (sdc *stateDiffConverter) addESDTBalanceDiff(accountDiff *txSimData.AccountStateDiff, dataTrieChange *state.DataTrieChange) bool {
+	if !bytes.HasPrefix(dataTrieChange.Key, esdtKeyPrefix) {
+		return false
+	}
+
+	balanceBefore, err := sdc.getESDTBalance(dataTrieChange.Before)
+	if err != nil {
+		return false
+	}
+	balanceAfter, err := sdc.getESDTBalance(dataTrieChange.After)
+	if err != nil {
+		return false
+	}
+
+	if accountDiff.ESDT == nil {
+		accountDiff.ESDT = make(map[string]*txSimData.ESDTBalanceDiff)
+	}
+	accountDiff.ESDT[getESDTIdentifier(dataTrieChange.Key[len(esdtKeyPrefix):])] = &txSimData.ESDTBalanceDiff{
+		Before: balanceBefore,
+		After:  balanceAfter,
+	}
+
+	return true
+}
+
+func (sdc *stateDiffConverter) getESDTBalance(value []byte) (string, error) {
+	if len(value) == 0 {
+		return "0", nil
+	}
+
+	esdtToken := &esdt.ESDigitalToken{}
+	err := sdc.marshalizer.Unmarshal(esdtToken, value)
+	if err != nil {
+		return "", err
+	}
+
+	return bigIntToString(esdtToken.Value), nil
+}
+
+// getESDTIdentifier returns the token identifier, followed by the hex encoded nonce in case of NFTs
+func getESDTIdentifier(esdtKey []byte) string {
+	dashIndex := bytes.IndexByte(esdtKey, '-')
+	tokenIDLength := dashIndex + esdtTokenRandomSequenceLength
+	if dashIndex < 0 || len(esdtKey) <= tokenIDLength {
+		return string(esdtKey)
+	}
+
+	return string(esdtKey[:tokenIDLength]) + "-" + hex.EncodeToString(esdtKey[tokenIDLength:])
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sdc *stateDiffConverter) IsInterfaceNil() bool {
+	return sdc == nil
+}
@@ -0,0 +1,75 @@
+package stateDiff
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStateDiffConverter(t *testing.T) {
+	t.Parallel()
+
+	converter, err := NewStateDiffConverter(nil, &mock.MarshalizerMock{})
+	require.True(t, check.IfNil(converter))
+	require.Equal(t, process.ErrNilPubkeyConverter, err)
+
+	converter, err = NewStateDiffConverter(&mock.PubkeyConverterMock{}, nil)
+	require.True(t, check.IfNil(converter))
+	require.Equal(t, process.ErrNilMarshalizer, err)
+
+	converter, err = NewStateDiffConverter(&mock.PubkeyConverterMock{}, &mock.MarshalizerMock{})
+	require.False(t, check.IfNil(converter))
+	require.Nil(t, err)
+}
+
+func TestStateDiffConverter_ConvertStateChanges(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &mock.MarshalizerMock{}
+	converter, _ := NewStateDiffConverter(&mock.PubkeyConverterMock{}, marshalizer)
+
+	tokenBalance, _ := marshalizer.Marshal(&esdt.ESDigitalToken{Value: big.NewInt(25)})
+	nftKey := append([]byte("ELRONDesdtNFT-abcdef"), 0x0a)
+	stateChanges := []*state.AccountStateChanges{
+		{
+			Address:       []byte("alice"),
+			BalanceBefore: big.NewInt(100),
+			BalanceAfter:  big.NewInt(90),
+			NonceBefore:   1,
+			NonceAfter:    2,
+			DataTrieChanges: []*state.DataTrieChange{
+				{Key: []byte("ELRONDesdtWEGLD-123456"), Before: tokenBalance, After: nil},
+				{Key: nftKey, Before: nil, After: tokenBalance},
+				{Key: []byte("ELRONDesdtBAD"), Before: []byte("not a token"), After: nil},
+				{Key: []byte("key"), Before: nil, After: []byte("value")},
+			},
+		},
+	}
+
+	expectedStateDiff := []*txSimData.AccountStateDiff{
+		{
+			Address:       hex.EncodeToString([]byte("alice")),
+			BalanceBefore: "100",
+			BalanceAfter:  "90",
+			NonceBefore:   1,
+			NonceAfter:    2,
+			ESDT: map[string]*txSimData.ESDTBalanceDiff{
+				"WEGLD-123456":  {Before: "25", After: "0"},
+				"NFT-abcdef-0a": {Before: "0", After: "25"},
+			},
+			Storage: map[string]*txSimData.StorageValueDiff{
+				hex.EncodeToString([]byte("ELRONDesdtBAD")): {Before: hex.EncodeToString([]byte("not a token")), After: ""},
+				hex.EncodeToString([]byte("key")):           {Before: "", After: hex.EncodeToString([]byte("value"))},
+			},
+		},
+	}
+	require.Equal(t, expectedStateDiff, converter.ConvertStateChanges(stateChanges))
+}
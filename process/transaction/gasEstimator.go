@@ -0,0 +1,631 @@
+package transaction
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	vmData "github.com/ElrondNetwork/elrond-go-core/data/vm"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/smartContract"
+	"github.com/ElrondNetwork/elrond-go/process/txsimulator"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/state"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/ElrondNetwork/elrond-vm-common/parsers"
+)
+
+const notSimulatedCallsMessage = "the gas needed by the calls to be executed in other shards is not included"
+
+// ArgsGasEstimator holds the arguments needed to create a new gas estimator
+type ArgsGasEstimator struct {
+	TxSimulator            process.TransactionSimulator
+	CrossShardGasEstimator process.CrossShardGasEstimator
+	FeeHandler             process.FeeHandler
+	Accounts               state.AccountsAdapter
+	ShardCoordinator       sharding.Coordinator
+	ESDTTransferParser     vmcommon.ESDTTransferParser
+	GasScheduleNotifier    core.GasScheduleNotifier
+	AddressPubKeyConverter core.PubkeyConverter
+	Marshalizer            marshal.Marshalizer
+	Hasher                 hashing.Hasher
+}
+
+// gasEstimator estimates the gas needed by a transaction by simulating the parts of the transaction which are executed
+// in the self shard. On the sender shard, a cross-shard transaction is simulated up to the call to the destination
+// shard, whose execution is estimated by the destination shard against its own state. The asynchronous callbacks
+// returned by the destination shard are then simulated on the self shard. On the destination shard, the call received
+// from the sender shard is simulated against the local state, while the gas consumed on the sender shard is computed
+// out of the gas schedule
+type gasEstimator struct {
+	txSimulator            process.TransactionSimulator
+	crossShardGasEstimator process.CrossShardGasEstimator
+	feeHandler             process.FeeHandler
+	accounts               state.AccountsAdapter
+	shardCoordinator       sharding.Coordinator
+	esdtTransferParser     vmcommon.ESDTTransferParser
+	gasScheduleNotifier    core.GasScheduleNotifier
+	pubkeyConverter        core.PubkeyConverter
+	marshalizer            marshal.Marshalizer
+	hasher                 hashing.Hasher
+	argsParser             process.CallArgumentsParser
+}
+
+// NewGasEstimator creates a new instance of gasEstimator
+func NewGasEstimator(args ArgsGasEstimator) (*gasEstimator, error) {
+	if check.IfNil(args.TxSimulator) {
+		return nil, txsimulator.ErrNilTxSimulatorProcessor
+	}
+	if check.IfNil(args.CrossShardGasEstimator) {
+		return nil, process.ErrNilCrossShardGasEstimator
+	}
+	if check.IfNil(args.FeeHandler) {
+		return nil, process.ErrNilEconomicsFeeHandler
+	}
+	if check.IfNil(args.Accounts) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+	if check.IfNil(args.ESDTTransferParser) {
+		return nil, process.ErrNilESDTTransferParser
+	}
+	if check.IfNil(args.GasScheduleNotifier) {
+		return nil, process.ErrNilGasSchedule
+	}
+	if check.IfNil(args.AddressPubKeyConverter) {
+		return nil, process.ErrNilPubkeyConverter
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, process.ErrNilHasher
+	}
+
+	return &gasEstimator{
+		txSimulator:            args.TxSimulator,
+		crossShardGasEstimator: args.CrossShardGasEstimator,
+		feeHandler:             args.FeeHandler,
+		accounts:               args.Accounts,
+		shardCoordinator:       args.ShardCoordinator,
+		esdtTransferParser:     args.ESDTTransferParser,
+		gasScheduleNotifier:    args.GasScheduleNotifier,
+		pubkeyConverter:        args.AddressPubKeyConverter,
+		marshalizer:            args.Marshalizer,
+		hasher:                 args.Hasher,
+		argsParser:             parsers.NewCallArgsParser(),
+	}, nil
+}
+
+// EstimateTransactionGas estimates the gas needed by a transaction, split by its purpose: the move balance, the
+// execution on the sender and on the destination shards and the gas locked for the asynchronous callbacks
+func (ge *gasEstimator) EstimateTransactionGas(tx *transaction.Transaction) (*txSimData.GasEstimation, error) {
+	providedGasLimit := tx.GasLimit
+	err := addMissingFieldsIfNeeded(tx, ge.feeHandler, ge.shardCoordinator, ge.accounts)
+	if err != nil {
+		return nil, err
+	}
+
+	function, arguments, _ := ge.argsParser.ParseData(string(tx.Data))
+	if function == core.RelayedTransaction || function == core.RelayedTransactionV2 {
+		return newFailedGasEstimation("cannot estimate the gas of the relayed transaction", nil), nil
+	}
+
+	receiver := tx.RcvAddr
+	esdtTransfers, errParse := ge.esdtTransferParser.ParseESDTTransfers(tx.SndAddr, tx.RcvAddr, function, arguments)
+	if errParse != nil {
+		esdtTransfers = nil
+	} else {
+		receiver = esdtTransfers.RcvAddr
+	}
+
+	estimation := &txSimData.GasEstimation{
+		Breakdown: &txSimData.GasBreakdown{
+			MoveBalance: ge.feeHandler.ComputeGasLimit(tx),
+		},
+		NotSimulatedCalls: make([]*txSimData.CrossShardCall, 0),
+	}
+
+	selfShardID := ge.shardCoordinator.SelfId()
+	senderShardID := ge.shardCoordinator.ComputeId(tx.SndAddr)
+	receiverShardID := ge.shardCoordinator.ComputeId(receiver)
+	switch {
+	case senderShardID == selfShardID:
+		estimation, err = ge.estimateOnSenderShard(tx, receiver, function, estimation)
+	case receiverShardID == selfShardID:
+		estimation, err = ge.estimateOnDestinationShard(tx, function, esdtTransfers, estimation)
+	default:
+		return newFailedGasEstimation(fmt.Sprintf("%s: sender shard %d, receiver shard %d",
+			process.ErrGasEstimationNotAvailableInShard.Error(), senderShardID, receiverShardID), nil), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if estimation.Breakdown == nil {
+		return estimation, nil
+	}
+
+	finishGasEstimation(estimation, providedGasLimit)
+
+	return estimation, nil
+}
+
+func (ge *gasEstimator) estimateOnSenderShard(
+	tx *transaction.Transaction,
+	receiver []byte,
+	function string,
+	estimation *txSimData.GasEstimation,
+) (*txSimData.GasEstimation, error) {
+	results, err := ge.txSimulator.ProcessTx(tx, txSimData.SimulationOptions{})
+	if err != nil {
+		return newFailedGasEstimation(err.Error(), nil), nil
+	}
+
+	gasProvided := subtractOrZero(tx.GasLimit, estimation.Breakdown.MoveBalance)
+	estimation = ge.addSimulatedExecution(estimation, results, gasProvided)
+	if estimation.Breakdown == nil {
+		return estimation, nil
+	}
+
+	// a smart contract call to another shard is only moving the balance on the sender shard
+	receiverShardID := ge.shardCoordinator.ComputeId(receiver)
+	isCrossShardContractCall := results.VMOutput == nil && len(function) > 0 &&
+		receiverShardID != ge.shardCoordinator.SelfId() && core.IsSmartContractAddress(receiver)
+	if !isCrossShardContractCall {
+		return estimation, nil
+	}
+
+	// the destination shard estimates the whole transaction, including the gas consumed on the sender shard
+	destinationEstimation, err := ge.crossShardGasEstimator.EstimateTransactionGas(receiverShardID, tx)
+	if err == nil {
+		return destinationEstimation, nil
+	}
+
+	log.Debug("gasEstimator: cannot estimate the gas on the destination shard", "shard", receiverShardID, "error", err.Error())
+	estimation.NotSimulatedCalls = append(estimation.NotSimulatedCalls, &txSimData.CrossShardCall{
+		Receiver:     ge.pubkeyConverter.Encode(receiver),
+		ShardID:      receiverShardID,
+		Function:     function,
+		GasForwarded: gasProvided,
+	})
+
+	return estimation, nil
+}
+
+func (ge *gasEstimator) estimateOnDestinationShard(
+	tx *transaction.Transaction,
+	function string,
+	esdtTransfers *vmcommon.ParsedESDTTransfers,
+	estimation *txSimData.GasEstimation,
+) (*txSimData.GasEstimation, error) {
+	gasOnSenderShard := ge.computeBuiltInFunctionGasOnSenderShard(function, esdtTransfers)
+	estimation.Breakdown.Execution = gasOnSenderShard
+
+	// the transaction itself is executed on the destination shard, unless its receiver is the sender, as for the
+	// NFT and multi transfers, in which case the destination shard executes the smart contract result of the transfer
+	isTransferFromSender := esdtTransfers != nil && bytes.Equal(tx.SndAddr, tx.RcvAddr)
+	if !isTransferFromSender {
+		results, err := ge.txSimulator.ProcessTx(tx, txSimData.SimulationOptions{})
+		if err != nil {
+			return newFailedGasEstimation(err.Error(), nil), nil
+		}
+
+		gasProvided := subtractOrZero(tx.GasLimit, estimation.Breakdown.MoveBalance)
+		return ge.addSimulatedExecution(estimation, results, gasProvided), nil
+	}
+
+	gasForDestination := uint64(0)
+	if len(esdtTransfers.CallFunction) > 0 {
+		gasForDestination = subtractOrZero(tx.GasLimit, estimation.Breakdown.MoveBalance+gasOnSenderShard)
+	}
+	scr, err := ge.createTransferSmartContractResult(tx, function, esdtTransfers, gasForDestination)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := ge.txSimulator.ProcessSmartContractResult(scr)
+	if err != nil {
+		return newFailedGasEstimation(err.Error(), nil), nil
+	}
+
+	return ge.addSimulatedExecution(estimation, results, gasForDestination), nil
+}
+
+func (ge *gasEstimator) computeBuiltInFunctionGasOnSenderShard(function string, esdtTransfers *vmcommon.ParsedESDTTransfers) uint64 {
+	if esdtTransfers == nil {
+		return 0
+	}
+
+	builtInCosts := ge.gasScheduleNotifier.LatestGasSchedule()[common.BuiltInCost]
+	switch function {
+	case core.BuiltInFunctionESDTTransfer:
+		return builtInCosts[core.BuiltInFunctionESDTTransfer]
+	case core.BuiltInFunctionESDTNFTTransfer:
+		return builtInCosts[core.BuiltInFunctionESDTNFTTransfer]
+	case core.BuiltInFunctionMultiESDTNFTTransfer:
+		return uint64(len(esdtTransfers.ESDTTransfers)) * builtInCosts["ESDTNFTMultiTransfer"]
+	default:
+		return 0
+	}
+}
+
+// createTransferSmartContractResult rebuilds the smart contract result created by the sender shard for an NFT or a
+// multi transfer, in the format expected by the destination shard
+func (ge *gasEstimator) createTransferSmartContractResult(
+	tx *transaction.Transaction,
+	function string,
+	esdtTransfers *vmcommon.ParsedESDTTransfers,
+	gasLimit uint64,
+) (*smartContractResult.SmartContractResult, error) {
+	txHash, err := core.CalculateHash(ge.marshalizer, ge.hasher, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	arguments := make([][]byte, 0)
+	if function == core.BuiltInFunctionMultiESDTNFTTransfer {
+		arguments = append(arguments, big.NewInt(int64(len(esdtTransfers.ESDTTransfers))).Bytes())
+	}
+	for _, esdtTransfer := range esdtTransfers.ESDTTransfers {
+		nonce := []byte{0}
+		if esdtTransfer.ESDTTokenNonce > 0 {
+			nonce = big.NewInt(0).SetUint64(esdtTransfer.ESDTTokenNonce).Bytes()
+		}
+		arguments = append(arguments, esdtTransfer.ESDTTokenName, nonce, esdtTransfer.ESDTValue.Bytes())
+	}
+	if function == core.BuiltInFunctionESDTNFTTransfer {
+		// the token data is not sent along with the transferred quantity
+		arguments = append(arguments, []byte{0})
+	}
+	if len(esdtTransfers.CallFunction) > 0 {
+		arguments = append(arguments, []byte(esdtTransfers.CallFunction))
+		arguments = append(arguments, esdtTransfers.CallArgs...)
+	}
+
+	data := function
+	for _, argument := range arguments {
+		data += "@" + hex.EncodeToString(argument)
+	}
+
+	return &smartContractResult.SmartContractResult{
+		Nonce:          tx.Nonce,
+		Value:          big.NewInt(0),
+		RcvAddr:        esdtTransfers.RcvAddr,
+		SndAddr:        tx.SndAddr,
+		Data:           []byte(data),
+		PrevTxHash:     txHash,
+		OriginalTxHash: txHash,
+		GasLimit:       gasLimit,
+		GasPrice:       tx.GasPrice,
+		CallType:       vmData.DirectCall,
+		OriginalSender: tx.SndAddr,
+	}, nil
+}
+
+// EstimateSmartContractResultGas estimates the gas consumed by a smart contract result received from another shard,
+// when executed on the self shard. The calls to other shards produced by the execution are estimated as for the
+// transactions, while the callback sent back to the shard of the caller is returned along with the smart contract
+// results, to be simulated by the caller shard
+func (ge *gasEstimator) EstimateSmartContractResultGas(apiScr *transaction.ApiSmartContractResult) (*txSimData.GasEstimation, error) {
+	scr, err := ge.createSmartContractResult(apiScr)
+	if err != nil {
+		return nil, err
+	}
+
+	receiverShardID := ge.shardCoordinator.ComputeId(scr.RcvAddr)
+	if receiverShardID != ge.shardCoordinator.SelfId() {
+		return newFailedGasEstimation(fmt.Sprintf("%s: receiver shard %d",
+			process.ErrGasEstimationNotAvailableInShard.Error(), receiverShardID), nil), nil
+	}
+
+	results, err := ge.txSimulator.ProcessSmartContractResult(scr)
+	if err != nil {
+		return newFailedGasEstimation(err.Error(), nil), nil
+	}
+
+	estimation := &txSimData.GasEstimation{
+		Breakdown:         &txSimData.GasBreakdown{},
+		NotSimulatedCalls: make([]*txSimData.CrossShardCall, 0),
+	}
+	estimation = ge.addSimulatedExecution(estimation, results, scr.GasLimit)
+	if estimation.Breakdown == nil {
+		return estimation, nil
+	}
+
+	finishGasEstimation(estimation, scr.GasLimit)
+
+	return estimation, nil
+}
+
+// createSmartContractResult rebuilds a smart contract result out of its API representation
+func (ge *gasEstimator) createSmartContractResult(apiScr *transaction.ApiSmartContractResult) (*smartContractResult.SmartContractResult, error) {
+	if apiScr == nil {
+		return nil, process.ErrNilSmartContractResult
+	}
+
+	receiver, err := ge.pubkeyConverter.Decode(apiScr.RcvAddr)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the receiver", err)
+	}
+	sender, err := ge.pubkeyConverter.Decode(apiScr.SndAddr)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the sender", err)
+	}
+	prevTxHash, err := hex.DecodeString(apiScr.PrevTxHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the previous transaction hash", err)
+	}
+	originalTxHash, err := hex.DecodeString(apiScr.OriginalTxHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the original transaction hash", err)
+	}
+
+	scr := &smartContractResult.SmartContractResult{
+		Nonce:          apiScr.Nonce,
+		Value:          big.NewInt(0),
+		RcvAddr:        receiver,
+		SndAddr:        sender,
+		RelayedValue:   apiScr.RelayedValue,
+		Code:           []byte(apiScr.Code),
+		Data:           []byte(apiScr.Data),
+		PrevTxHash:     prevTxHash,
+		OriginalTxHash: originalTxHash,
+		GasLimit:       apiScr.GasLimit,
+		GasPrice:       apiScr.GasPrice,
+		CallType:       apiScr.CallType,
+		CodeMetadata:   []byte(apiScr.CodeMetadata),
+		ReturnMessage:  []byte(apiScr.ReturnMessage),
+	}
+	if apiScr.Value != nil {
+		scr.Value.Set(apiScr.Value)
+	}
+	if len(apiScr.OriginalSender) > 0 {
+		scr.OriginalSender, err = ge.pubkeyConverter.Decode(apiScr.OriginalSender)
+		if err != nil {
+			return nil, fmt.Errorf("%w for the original sender", err)
+		}
+	}
+	if len(apiScr.RelayerAddr) > 0 {
+		scr.RelayerAddr, err = ge.pubkeyConverter.Decode(apiScr.RelayerAddr)
+		if err != nil {
+			return nil, fmt.Errorf("%w for the relayer", err)
+		}
+	}
+
+	return scr, nil
+}
+
+// addSimulatedExecution adds the gas consumed by a simulated execution, out of the gas provided for processing. The gas
+// sent to the calls to other shards is replaced by the gas estimated by those shards, while the gas locked for the
+// callbacks is replaced by the gas consumed by the callbacks
+func (ge *gasEstimator) addSimulatedExecution(
+	estimation *txSimData.GasEstimation,
+	results *txSimData.SimulationResults,
+	gasProvided uint64,
+) *txSimData.GasEstimation {
+	if len(results.FailReason) > 0 {
+		return newFailedGasEstimation(results.FailReason, results.ScResults)
+	}
+
+	estimation.SmartContractResults = results.ScResults
+	vmOutput := results.VMOutput
+	if vmOutput == nil {
+		return estimation
+	}
+	if vmOutput.ReturnCode != vmcommon.Ok {
+		return newFailedGasEstimation(fmt.Sprintf("%s %s", vmOutput.ReturnCode.String(), vmOutput.ReturnMessage), results.ScResults)
+	}
+
+	gasUsed := computeGasUsedForProcessing(gasProvided, vmOutput)
+	gasSent := uint64(0)
+	for _, scr := range ge.getCallsToOtherShards(results.ScResults) {
+		gasSent += scr.GasLimit
+
+		failedEstimation := ge.addCallToOtherShard(estimation, scr)
+		if failedEstimation != nil {
+			return failedEstimation
+		}
+	}
+	estimation.Breakdown.Execution += subtractOrZero(gasUsed, gasSent)
+
+	return estimation
+}
+
+// getCallsToOtherShards returns, sorted by hash, the smart contract results which carry gas to be consumed in other
+// shards. The callbacks are not included, as they carry the gas remaining after the execution
+func (ge *gasEstimator) getCallsToOtherShards(scResults map[string]*transaction.ApiSmartContractResult) []*transaction.ApiSmartContractResult {
+	hashes := make([]string, 0, len(scResults))
+	for hash := range scResults {
+		hashes = append(hashes, hash)
+	}
+	sort.Strings(hashes)
+
+	calls := make([]*transaction.ApiSmartContractResult, 0)
+	for _, hash := range hashes {
+		scr := scResults[hash]
+		if scr == nil || scr.GasLimit == 0 || scr.CallType == vmData.AsynchronousCallBack {
+			continue
+		}
+		shardID, err := ge.computeShardID(scr.RcvAddr)
+		if err != nil || shardID == ge.shardCoordinator.SelfId() {
+			continue
+		}
+
+		calls = append(calls, scr)
+	}
+
+	return calls
+}
+
+// addCallToOtherShard adds the gas estimated by the receiver shard for the call and the gas consumed by the callback
+// returned by it. If the receiver shard cannot estimate the call, the call is reported as not simulated, while the gas
+// locked for its callback is considered entirely consumed
+func (ge *gasEstimator) addCallToOtherShard(estimation *txSimData.GasEstimation, scr *transaction.ApiSmartContractResult) *txSimData.GasEstimation {
+	shardID, _ := ge.computeShardID(scr.RcvAddr)
+	gasLocked := ge.getGasLocked(scr)
+
+	destinationEstimation, err := ge.crossShardGasEstimator.EstimateSmartContractResultGas(shardID, scr)
+	if err != nil {
+		log.Debug("gasEstimator: cannot estimate the gas of the call to another shard", "shard", shardID, "error", err.Error())
+
+		function, _, _ := ge.argsParser.ParseData(scr.Data)
+		estimation.NotSimulatedCalls = append(estimation.NotSimulatedCalls, &txSimData.CrossShardCall{
+			Receiver:     scr.RcvAddr,
+			ShardID:      shardID,
+			Function:     function,
+			GasForwarded: subtractOrZero(scr.GasLimit, gasLocked),
+		})
+		estimation.Breakdown.AsyncCallback += gasLocked
+
+		return nil
+	}
+	if destinationEstimation.Breakdown == nil {
+		return newFailedGasEstimation(destinationEstimation.ReturnMessage, destinationEstimation.SmartContractResults)
+	}
+
+	estimation.Breakdown.Execution += destinationEstimation.Breakdown.Execution
+	estimation.Breakdown.AsyncCallback += destinationEstimation.Breakdown.AsyncCallback
+	estimation.NotSimulatedCalls = append(estimation.NotSimulatedCalls, destinationEstimation.NotSimulatedCalls...)
+
+	for _, callback := range ge.getCallbacksToSelfShard(destinationEstimation.SmartContractResults) {
+		gasUsed, failedEstimation := ge.simulateCallback(callback)
+		if failedEstimation != nil {
+			return failedEstimation
+		}
+
+		estimation.Breakdown.AsyncCallback += gasUsed
+	}
+
+	return nil
+}
+
+// getGasLocked returns the gas locked for the callback of an asynchronous call, sent as the last argument of the call
+func (ge *gasEstimator) getGasLocked(scr *transaction.ApiSmartContractResult) uint64 {
+	if scr.CallType != vmData.AsynchronousCall {
+		return 0
+	}
+
+	_, arguments, err := ge.argsParser.ParseData(scr.Data)
+	if err != nil || len(arguments) == 0 {
+		return 0
+	}
+
+	return big.NewInt(0).SetBytes(arguments[len(arguments)-1]).Uint64()
+}
+
+func (ge *gasEstimator) getCallbacksToSelfShard(scResults map[string]*transaction.ApiSmartContractResult) []*transaction.ApiSmartContractResult {
+	hashes := make([]string, 0, len(scResults))
+	for hash := range scResults {
+		hashes = append(hashes, hash)
+	}
+	sort.Strings(hashes)
+
+	callbacks := make([]*transaction.ApiSmartContractResult, 0)
+	for _, hash := range hashes {
+		scr := scResults[hash]
+		if scr == nil || scr.CallType != vmData.AsynchronousCallBack {
+			continue
+		}
+		shardID, err := ge.computeShardID(scr.RcvAddr)
+		if err != nil || shardID != ge.shardCoordinator.SelfId() {
+			continue
+		}
+
+		callbacks = append(callbacks, scr)
+	}
+
+	return callbacks
+}
+
+// simulateCallback returns the gas consumed by the callback on the self shard. The callback is simulated against the
+// current state, as the changes made by the call which produced it are not kept
+func (ge *gasEstimator) simulateCallback(apiScr *transaction.ApiSmartContractResult) (uint64, *txSimData.GasEstimation) {
+	callback, err := ge.createSmartContractResult(apiScr)
+	if err != nil {
+		return 0, newFailedGasEstimation(err.Error(), nil)
+	}
+
+	results, err := ge.txSimulator.ProcessSmartContractResult(callback)
+	if err != nil {
+		return 0, newFailedGasEstimation(err.Error(), nil)
+	}
+	if len(results.FailReason) > 0 {
+		return 0, newFailedGasEstimation(results.FailReason, results.ScResults)
+	}
+	if results.VMOutput == nil {
+		return 0, nil
+	}
+	if results.VMOutput.ReturnCode != vmcommon.Ok {
+		message := fmt.Sprintf("callback %s %s", results.VMOutput.ReturnCode.String(), results.VMOutput.ReturnMessage)
+		return 0, newFailedGasEstimation(message, results.ScResults)
+	}
+
+	return computeGasUsedForProcessing(callback.GasLimit, results.VMOutput), nil
+}
+
+func (ge *gasEstimator) computeShardID(encodedAddress string) (uint32, error) {
+	address, err := ge.pubkeyConverter.Decode(encodedAddress)
+	if err != nil {
+		return 0, err
+	}
+
+	return ge.shardCoordinator.ComputeId(address), nil
+}
+
+// computeGasUsedForProcessing takes into account the penalty for too much gas provided, which zeroes the gas remaining
+func computeGasUsedForProcessing(gasProvided uint64, vmOutput *vmcommon.VMOutput) uint64 {
+	isTooMuchGasProvided := strings.Contains(vmOutput.ReturnMessage, smartContract.TooMuchGasProvidedMessage)
+	if !isTooMuchGasProvided {
+		return subtractOrZero(gasProvided, vmOutput.GasRemaining)
+	}
+	if strings.Contains(vmOutput.ReturnMessage, gasUsedSlitString) {
+		return extractGasRemainedFromMessage(vmOutput.ReturnMessage, gasUsedSlitString)
+	}
+
+	return subtractOrZero(gasProvided, extractGasRemainedFromMessage(vmOutput.ReturnMessage, gasRemainedSplitString))
+}
+
+func finishGasEstimation(estimation *txSimData.GasEstimation, providedGasLimit uint64) {
+	breakdown := estimation.Breakdown
+	estimation.GasUnits = breakdown.MoveBalance + breakdown.Execution + breakdown.AsyncCallback
+	breakdown.Refund = 0
+
+	if len(estimation.NotSimulatedCalls) > 0 {
+		estimation.ReturnMessage = notSimulatedCallsMessage
+		return
+	}
+	breakdown.Refund = subtractOrZero(providedGasLimit, estimation.GasUnits)
+}
+
+func newFailedGasEstimation(message string, scResults map[string]*transaction.ApiSmartContractResult) *txSimData.GasEstimation {
+	return &txSimData.GasEstimation{
+		ReturnMessage:        message,
+		SmartContractResults: scResults,
+	}
+}
+
+func subtractOrZero(value uint64, subtrahend uint64) uint64 {
+	if value < subtrahend {
+		return 0
+	}
+
+	return value - subtrahend
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ge *gasEstimator) IsInterfaceNil() bool {
+	return ge == nil
+}
@@ -0,0 +1,463 @@
+package transaction
+
+import (
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	vmData "github.com/ElrondNetwork/elrond-go-core/data/vm"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	"github.com/ElrondNetwork/elrond-go/process/txsimulator"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/ElrondNetwork/elrond-vm-common/parsers"
+	"github.com/stretchr/testify/require"
+)
+
+const moveBalanceGas = 100
+
+// createAddressInShard creates an address whose last byte is the shard ID, as considered by the tests shard coordinator
+func createAddressInShard(name string, isContract bool, shardID byte) []byte {
+	address := make([]byte, 32)
+	if isContract {
+		copy(address[core.NumInitCharactersForScAddress:], name)
+	} else {
+		copy(address, name)
+	}
+	address[len(address)-1] = shardID
+
+	return address
+}
+
+func createMockArgsGasEstimator(selfShardID uint32) ArgsGasEstimator {
+	esdtTransferParser, _ := parsers.NewESDTTransferParser(&mock.MarshalizerMock{})
+
+	return ArgsGasEstimator{
+		TxSimulator:            &mock.TransactionSimulatorStub{},
+		CrossShardGasEstimator: &mock.CrossShardGasEstimatorStub{},
+		FeeHandler: &mock.FeeHandlerStub{
+			ComputeGasLimitCalled: func(tx data.TransactionWithFeeHandler) uint64 {
+				return moveBalanceGas
+			},
+		},
+		Accounts: &stateMock.AccountsStub{},
+		ShardCoordinator: &mock.ShardCoordinatorStub{
+			SelfIdCalled: func() uint32 {
+				return selfShardID
+			},
+			ComputeIdCalled: func(address []byte) uint32 {
+				return uint32(address[len(address)-1])
+			},
+		},
+		ESDTTransferParser: esdtTransferParser,
+		GasScheduleNotifier: testscommon.NewGasScheduleNotifierMock(map[string]map[string]uint64{
+			common.BuiltInCost: {"ESDTNFTMultiTransfer": 200},
+		}),
+		AddressPubKeyConverter: &mock.PubkeyConverterMock{},
+		Marshalizer:            &mock.MarshalizerMock{},
+		Hasher:                 &hashingMocks.HasherMock{},
+	}
+}
+
+func TestNewGasEstimator(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsGasEstimator(0)
+	args.TxSimulator = nil
+	estimator, err := NewGasEstimator(args)
+	require.True(t, check.IfNil(estimator))
+	require.Equal(t, txsimulator.ErrNilTxSimulatorProcessor, err)
+
+	args = createMockArgsGasEstimator(0)
+	args.CrossShardGasEstimator = nil
+	_, err = NewGasEstimator(args)
+	require.Equal(t, process.ErrNilCrossShardGasEstimator, err)
+
+	args = createMockArgsGasEstimator(0)
+	args.FeeHandler = nil
+	_, err = NewGasEstimator(args)
+	require.Equal(t, process.ErrNilEconomicsFeeHandler, err)
+
+	args = createMockArgsGasEstimator(0)
+	args.Accounts = nil
+	_, err = NewGasEstimator(args)
+	require.Equal(t, process.ErrNilAccountsAdapter, err)
+
+	args = createMockArgsGasEstimator(0)
+	args.ShardCoordinator = nil
+	_, err = NewGasEstimator(args)
+	require.Equal(t, process.ErrNilShardCoordinator, err)
+
+	args = createMockArgsGasEstimator(0)
+	args.ESDTTransferParser = nil
+	_, err = NewGasEstimator(args)
+	require.Equal(t, process.ErrNilESDTTransferParser, err)
+
+	args = createMockArgsGasEstimator(0)
+	args.GasScheduleNotifier = nil
+	_, err = NewGasEstimator(args)
+	require.Equal(t, process.ErrNilGasSchedule, err)
+
+	args = createMockArgsGasEstimator(0)
+	args.AddressPubKeyConverter = nil
+	_, err = NewGasEstimator(args)
+	require.Equal(t, process.ErrNilPubkeyConverter, err)
+
+	args = createMockArgsGasEstimator(0)
+	args.Marshalizer = nil
+	_, err = NewGasEstimator(args)
+	require.Equal(t, process.ErrNilMarshalizer, err)
+
+	args = createMockArgsGasEstimator(0)
+	args.Hasher = nil
+	_, err = NewGasEstimator(args)
+	require.Equal(t, process.ErrNilHasher, err)
+
+	estimator, err = NewGasEstimator(createMockArgsGasEstimator(0))
+	require.False(t, check.IfNil(estimator))
+	require.Nil(t, err)
+}
+
+func TestGasEstimator_EstimateTransactionGasIntraShardWithAsyncCallToOtherShard(t *testing.T) {
+	t.Parallel()
+
+	contract := createAddressInShard("contract", true, 0)
+	contractInOtherShard := createAddressInShard("other", true, 1)
+	asyncCall := &transaction.ApiSmartContractResult{
+		SndAddr:  hex.EncodeToString(contract),
+		RcvAddr:  hex.EncodeToString(contractInOtherShard),
+		Data:     "claim@01@03e8",
+		GasLimit: 6000,
+		CallType: vmData.AsynchronousCall,
+	}
+	createArgs := func() ArgsGasEstimator {
+		args := createMockArgsGasEstimator(0)
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(tx *transaction.Transaction, _ txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+				return &txSimData.SimulationResults{
+					Status:    transaction.TxStatusSuccess,
+					ScResults: map[string]*transaction.ApiSmartContractResult{"aa": asyncCall},
+					VMOutput:  &vmcommon.VMOutput{ReturnCode: vmcommon.Ok},
+				}, nil
+			},
+		}
+
+		return args
+	}
+	tx := &transaction.Transaction{
+		SndAddr:  createAddressInShard("alice", false, 0),
+		RcvAddr:  contract,
+		Data:     []byte("callOther"),
+		GasLimit: 10000,
+	}
+
+	t.Run("destination shard not available", func(t *testing.T) {
+		t.Parallel()
+
+		estimator, _ := NewGasEstimator(createArgs())
+		estimation, err := estimator.EstimateTransactionGas(tx)
+		require.Nil(t, err)
+		require.Equal(t, &txSimData.GasBreakdown{MoveBalance: moveBalanceGas, Execution: 3900, AsyncCallback: 1000}, estimation.Breakdown)
+		require.Equal(t, uint64(5000), estimation.GasUnits)
+		require.Equal(t, notSimulatedCallsMessage, estimation.ReturnMessage)
+		require.Equal(t, []*txSimData.CrossShardCall{
+			{Receiver: hex.EncodeToString(contractInOtherShard), ShardID: 1, Function: "claim", GasForwarded: 5000},
+		}, estimation.NotSimulatedCalls)
+	})
+	t.Run("should add the destination shard execution and the callback", func(t *testing.T) {
+		t.Parallel()
+
+		callback := &transaction.ApiSmartContractResult{
+			SndAddr:  hex.EncodeToString(contractInOtherShard),
+			RcvAddr:  hex.EncodeToString(contract),
+			Data:     "@00",
+			GasLimit: 4000,
+			CallType: vmData.AsynchronousCallBack,
+		}
+		args := createArgs()
+		args.CrossShardGasEstimator = &mock.CrossShardGasEstimatorStub{
+			EstimateSmartContractResultGasCalled: func(shardID uint32, scr *transaction.ApiSmartContractResult) (*txSimData.GasEstimation, error) {
+				require.Equal(t, uint32(1), shardID)
+				require.Equal(t, asyncCall, scr)
+
+				return &txSimData.GasEstimation{
+					Breakdown:            &txSimData.GasBreakdown{Execution: 2000},
+					SmartContractResults: map[string]*transaction.ApiSmartContractResult{"bb": callback},
+				}, nil
+			},
+		}
+		simulator := args.TxSimulator.(*mock.TransactionSimulatorStub)
+		simulator.ProcessSmartContractResultCalled = func(scr *smartContractResult.SmartContractResult) (*txSimData.SimulationResults, error) {
+			require.Equal(t, contract, scr.RcvAddr)
+			require.Equal(t, contractInOtherShard, scr.SndAddr)
+			require.Equal(t, []byte("@00"), scr.Data)
+			require.Equal(t, vmData.AsynchronousCallBack, scr.CallType)
+
+			return &txSimData.SimulationResults{
+				Status:   transaction.TxStatusSuccess,
+				VMOutput: &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRemaining: 3500},
+			}, nil
+		}
+		estimator, _ := NewGasEstimator(args)
+
+		estimation, err := estimator.EstimateTransactionGas(tx)
+		require.Nil(t, err)
+		expectedBreakdown := &txSimData.GasBreakdown{
+			MoveBalance:   moveBalanceGas,
+			Execution:     3900 + 2000,
+			AsyncCallback: 500,
+			Refund:        10000 - 6500,
+		}
+		require.Equal(t, expectedBreakdown, estimation.Breakdown)
+		require.Equal(t, uint64(6500), estimation.GasUnits)
+		require.Empty(t, estimation.ReturnMessage)
+		require.Empty(t, estimation.NotSimulatedCalls)
+	})
+	t.Run("failed execution on the destination shard should fail", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.CrossShardGasEstimator = &mock.CrossShardGasEstimatorStub{
+			EstimateSmartContractResultGasCalled: func(shardID uint32, scr *transaction.ApiSmartContractResult) (*txSimData.GasEstimation, error) {
+				return &txSimData.GasEstimation{ReturnMessage: "user error not allowed"}, nil
+			},
+		}
+		estimator, _ := NewGasEstimator(args)
+
+		estimation, err := estimator.EstimateTransactionGas(tx)
+		require.Nil(t, err)
+		require.Equal(t, &txSimData.GasEstimation{ReturnMessage: "user error not allowed"}, estimation)
+	})
+}
+
+func TestGasEstimator_EstimateTransactionGasCrossShardContractCallOnSenderShard(t *testing.T) {
+	t.Parallel()
+
+	contract := createAddressInShard("contract", true, 1)
+	createArgs := func() ArgsGasEstimator {
+		args := createMockArgsGasEstimator(0)
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(tx *transaction.Transaction, _ txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+				return &txSimData.SimulationResults{Status: transaction.TxStatusSuccess}, nil
+			},
+		}
+
+		return args
+	}
+	tx := &transaction.Transaction{
+		SndAddr:  createAddressInShard("alice", false, 0),
+		RcvAddr:  contract,
+		Data:     []byte("claim"),
+		GasLimit: 10000,
+	}
+
+	t.Run("destination shard not available", func(t *testing.T) {
+		t.Parallel()
+
+		estimator, _ := NewGasEstimator(createArgs())
+		estimation, err := estimator.EstimateTransactionGas(tx)
+		require.Nil(t, err)
+		require.Equal(t, uint64(moveBalanceGas), estimation.GasUnits)
+		require.Equal(t, []*txSimData.CrossShardCall{
+			{Receiver: hex.EncodeToString(contract), ShardID: 1, Function: "claim", GasForwarded: 10000 - moveBalanceGas},
+		}, estimation.NotSimulatedCalls)
+	})
+	t.Run("should use the estimation of the destination shard", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.CrossShardGasEstimator = &mock.CrossShardGasEstimatorStub{
+			EstimateTransactionGasCalled: func(shardID uint32, providedTx *transaction.Transaction) (*txSimData.GasEstimation, error) {
+				require.Equal(t, uint32(1), shardID)
+				require.Equal(t, tx, providedTx)
+
+				return &txSimData.GasEstimation{
+					Breakdown: &txSimData.GasBreakdown{MoveBalance: moveBalanceGas, Execution: 1500},
+				}, nil
+			},
+		}
+		estimator, _ := NewGasEstimator(args)
+
+		estimation, err := estimator.EstimateTransactionGas(tx)
+		require.Nil(t, err)
+		require.Equal(t, &txSimData.GasBreakdown{MoveBalance: moveBalanceGas, Execution: 1500, Refund: 10000 - 1600}, estimation.Breakdown)
+		require.Equal(t, uint64(1600), estimation.GasUnits)
+		require.Empty(t, estimation.NotSimulatedCalls)
+	})
+}
+
+func TestGasEstimator_EstimateTransactionGasMultiTransferOnDestinationShard(t *testing.T) {
+	t.Parallel()
+
+	sender := createAddressInShard("alice", false, 0)
+	contract := createAddressInShard("contract", true, 1)
+	tokenA := hex.EncodeToString([]byte("TOKA-aaaaaa"))
+	tokenB := hex.EncodeToString([]byte("NFT-bbbbbb"))
+	function := hex.EncodeToString([]byte("claim"))
+	tx := &transaction.Transaction{
+		Nonce:    7,
+		SndAddr:  sender,
+		RcvAddr:  sender,
+		Data:     []byte(core.BuiltInFunctionMultiESDTNFTTransfer + "@" + hex.EncodeToString(contract) + "@02@" + tokenA + "@00@0a@" + tokenB + "@05@01@" + function),
+		GasLimit: 10000,
+		GasPrice: 1,
+	}
+
+	args := createMockArgsGasEstimator(1)
+	args.TxSimulator = &mock.TransactionSimulatorStub{
+		ProcessTxCalled: func(tx *transaction.Transaction, _ txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+			require.Fail(t, "the transaction should not be processed on the destination shard")
+			return nil, nil
+		},
+		ProcessSmartContractResultCalled: func(scr *smartContractResult.SmartContractResult) (*txSimData.SimulationResults, error) {
+			expectedData := core.BuiltInFunctionMultiESDTNFTTransfer + "@02@" + tokenA + "@00@0a@" + tokenB + "@05@01@" + function
+			require.Equal(t, expectedData, string(scr.Data))
+			require.Equal(t, contract, scr.RcvAddr)
+			require.Equal(t, sender, scr.SndAddr)
+			require.Equal(t, uint64(7), scr.Nonce)
+			require.Equal(t, vmData.DirectCall, scr.CallType)
+			require.Equal(t, uint64(10000-moveBalanceGas-2*200), scr.GasLimit)
+
+			return &txSimData.SimulationResults{
+				Status:   transaction.TxStatusSuccess,
+				VMOutput: &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRemaining: 9000},
+			}, nil
+		},
+	}
+	estimator, _ := NewGasEstimator(args)
+
+	estimation, err := estimator.EstimateTransactionGas(tx)
+	require.Nil(t, err)
+	require.Equal(t, &txSimData.GasBreakdown{MoveBalance: moveBalanceGas, Execution: 400 + 500, Refund: 9000}, estimation.Breakdown)
+	require.Equal(t, uint64(1000), estimation.GasUnits)
+	require.Empty(t, estimation.ReturnMessage)
+	require.Empty(t, estimation.NotSimulatedCalls)
+}
+
+func TestGasEstimator_EstimateTransactionGasShouldReportFailures(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{
+		SndAddr:  createAddressInShard("alice", false, 0),
+		RcvAddr:  createAddressInShard("contract", true, 0),
+		Data:     []byte("claim"),
+		GasLimit: 10000,
+	}
+
+	t.Run("not handled by the self shard", func(t *testing.T) {
+		estimator, _ := NewGasEstimator(createMockArgsGasEstimator(2))
+		estimation, err := estimator.EstimateTransactionGas(tx)
+		require.Nil(t, err)
+		require.Zero(t, estimation.GasUnits)
+		require.Nil(t, estimation.Breakdown)
+		require.True(t, strings.Contains(estimation.ReturnMessage, process.ErrGasEstimationNotAvailableInShard.Error()))
+	})
+	t.Run("simulation error", func(t *testing.T) {
+		args := createMockArgsGasEstimator(0)
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(tx *transaction.Transaction, _ txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+				return nil, errors.New("simulation error")
+			},
+		}
+		estimator, _ := NewGasEstimator(args)
+		estimation, err := estimator.EstimateTransactionGas(tx)
+		require.Nil(t, err)
+		require.Equal(t, &txSimData.GasEstimation{ReturnMessage: "simulation error"}, estimation)
+	})
+	t.Run("execution error", func(t *testing.T) {
+		args := createMockArgsGasEstimator(0)
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			ProcessTxCalled: func(tx *transaction.Transaction, _ txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+				return &txSimData.SimulationResults{
+					VMOutput: &vmcommon.VMOutput{ReturnCode: vmcommon.UserError, ReturnMessage: "not allowed"},
+				}, nil
+			},
+		}
+		estimator, _ := NewGasEstimator(args)
+		estimation, err := estimator.EstimateTransactionGas(tx)
+		require.Nil(t, err)
+		require.Equal(t, &txSimData.GasEstimation{ReturnMessage: "user error not allowed"}, estimation)
+	})
+}
+
+func TestGasEstimator_EstimateSmartContractResultGas(t *testing.T) {
+	t.Parallel()
+
+	caller := createAddressInShard("caller", true, 0)
+	contract := createAddressInShard("contract", true, 1)
+	apiScr := &transaction.ApiSmartContractResult{
+		Nonce:          3,
+		SndAddr:        hex.EncodeToString(caller),
+		RcvAddr:        hex.EncodeToString(contract),
+		OriginalSender: hex.EncodeToString(createAddressInShard("alice", false, 0)),
+		PrevTxHash:     hex.EncodeToString([]byte("tx hash")),
+		Data:           "claim@01@03e8",
+		GasLimit:       6000,
+		CallType:       vmData.AsynchronousCall,
+	}
+
+	t.Run("nil smart contract result should error", func(t *testing.T) {
+		t.Parallel()
+
+		estimator, _ := NewGasEstimator(createMockArgsGasEstimator(1))
+		estimation, err := estimator.EstimateSmartContractResultGas(nil)
+		require.Nil(t, estimation)
+		require.Equal(t, process.ErrNilSmartContractResult, err)
+	})
+	t.Run("not handled by the self shard", func(t *testing.T) {
+		t.Parallel()
+
+		estimator, _ := NewGasEstimator(createMockArgsGasEstimator(0))
+		estimation, err := estimator.EstimateSmartContractResultGas(apiScr)
+		require.Nil(t, err)
+		require.Nil(t, estimation.Breakdown)
+		require.True(t, strings.Contains(estimation.ReturnMessage, process.ErrGasEstimationNotAvailableInShard.Error()))
+	})
+	t.Run("should return the gas consumed on the receiver shard", func(t *testing.T) {
+		t.Parallel()
+
+		callback := &transaction.ApiSmartContractResult{
+			SndAddr:  hex.EncodeToString(contract),
+			RcvAddr:  hex.EncodeToString(caller),
+			Data:     "@00",
+			GasLimit: 4000,
+			CallType: vmData.AsynchronousCallBack,
+		}
+		args := createMockArgsGasEstimator(1)
+		args.TxSimulator = &mock.TransactionSimulatorStub{
+			ProcessSmartContractResultCalled: func(scr *smartContractResult.SmartContractResult) (*txSimData.SimulationResults, error) {
+				require.Equal(t, uint64(3), scr.Nonce)
+				require.Equal(t, caller, scr.SndAddr)
+				require.Equal(t, contract, scr.RcvAddr)
+				require.Equal(t, []byte("tx hash"), scr.PrevTxHash)
+				require.Equal(t, []byte("claim@01@03e8"), scr.Data)
+				require.Equal(t, vmData.AsynchronousCall, scr.CallType)
+
+				return &txSimData.SimulationResults{
+					Status:    transaction.TxStatusSuccess,
+					ScResults: map[string]*transaction.ApiSmartContractResult{"bb": callback},
+					VMOutput:  &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRemaining: 4000},
+				}, nil
+			},
+		}
+		estimator, _ := NewGasEstimator(args)
+
+		estimation, err := estimator.EstimateSmartContractResultGas(apiScr)
+		require.Nil(t, err)
+		require.Equal(t, &txSimData.GasBreakdown{Execution: 2000, Refund: 4000}, estimation.Breakdown)
+		require.Equal(t, uint64(2000), estimation.GasUnits)
+		require.Equal(t, map[string]*transaction.ApiSmartContractResult{"bb": callback}, estimation.SmartContractResults)
+		require.Empty(t, estimation.NotSimulatedCalls)
+	})
+}
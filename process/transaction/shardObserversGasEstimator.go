@@ -0,0 +1,157 @@
+package transaction
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+)
+
+const (
+	estimateTransactionGasRoute         = "/transaction/estimate-gas"
+	estimateSmartContractResultGasRoute = "/transaction/estimate-gas-scr"
+	contentTypeJSON                     = "application/json"
+)
+
+// ArgsShardObserversGasEstimator holds the arguments needed to create a new shard observers gas estimator
+type ArgsShardObserversGasEstimator struct {
+	ShardsObservers        map[uint32]string
+	RequestTimeout         time.Duration
+	AddressPubKeyConverter core.PubkeyConverter
+}
+
+// shardObserversGasEstimator requests the gas estimations of the parts executed in other shards from the REST API of
+// the observers of those shards. The shards without a configured observer cannot be estimated
+type shardObserversGasEstimator struct {
+	shardsObservers map[uint32]string
+	httpClient      *http.Client
+	pubkeyConverter core.PubkeyConverter
+}
+
+// estimateGasRequest holds a transaction in the format accepted by the gas estimation endpoint
+type estimateGasRequest struct {
+	Sender           string `json:"sender"`
+	Receiver         string `json:"receiver"`
+	SenderUsername   []byte `json:"senderUsername,omitempty"`
+	ReceiverUsername []byte `json:"receiverUsername,omitempty"`
+	Value            string `json:"value"`
+	Data             []byte `json:"data"`
+	Nonce            uint64 `json:"nonce"`
+	GasPrice         uint64 `json:"gasPrice"`
+	GasLimit         uint64 `json:"gasLimit"`
+	Signature        string `json:"signature"`
+	ChainID          string `json:"chainID"`
+	Version          uint32 `json:"version"`
+	Options          uint32 `json:"options,omitempty"`
+}
+
+// estimateGasResponse holds the response of the gas estimation endpoints
+type estimateGasResponse struct {
+	Data  *txSimData.GasEstimation `json:"data"`
+	Error string                   `json:"error"`
+	Code  string                   `json:"code"`
+}
+
+// NewShardObserversGasEstimator creates a new instance of shardObserversGasEstimator
+func NewShardObserversGasEstimator(args ArgsShardObserversGasEstimator) (*shardObserversGasEstimator, error) {
+	if check.IfNil(args.AddressPubKeyConverter) {
+		return nil, process.ErrNilPubkeyConverter
+	}
+
+	shardsObservers := make(map[uint32]string, len(args.ShardsObservers))
+	for shardID, url := range args.ShardsObservers {
+		shardsObservers[shardID] = url
+	}
+
+	return &shardObserversGasEstimator{
+		shardsObservers: shardsObservers,
+		httpClient:      &http.Client{Timeout: args.RequestTimeout},
+		pubkeyConverter: args.AddressPubKeyConverter,
+	}, nil
+}
+
+// EstimateTransactionGas requests the gas estimation of a transaction from an observer of its receiver shard
+func (soge *shardObserversGasEstimator) EstimateTransactionGas(shardID uint32, tx *transaction.Transaction) (*txSimData.GasEstimation, error) {
+	value := "0"
+	if tx.Value != nil {
+		value = tx.Value.String()
+	}
+
+	request := &estimateGasRequest{
+		Sender:           soge.pubkeyConverter.Encode(tx.SndAddr),
+		Receiver:         soge.pubkeyConverter.Encode(tx.RcvAddr),
+		SenderUsername:   tx.SndUserName,
+		ReceiverUsername: tx.RcvUserName,
+		Value:            value,
+		Data:             tx.Data,
+		Nonce:            tx.Nonce,
+		GasPrice:         tx.GasPrice,
+		GasLimit:         tx.GasLimit,
+		Signature:        hex.EncodeToString(tx.Signature),
+		ChainID:          string(tx.ChainID),
+		Version:          tx.Version,
+		Options:          tx.Options,
+	}
+
+	return soge.requestGasEstimation(shardID, estimateTransactionGasRoute, request)
+}
+
+// EstimateSmartContractResultGas requests the gas estimation of a smart contract result from an observer of its
+// receiver shard
+func (soge *shardObserversGasEstimator) EstimateSmartContractResultGas(shardID uint32, scr *transaction.ApiSmartContractResult) (*txSimData.GasEstimation, error) {
+	return soge.requestGasEstimation(shardID, estimateSmartContractResultGasRoute, scr)
+}
+
+func (soge *shardObserversGasEstimator) requestGasEstimation(shardID uint32, route string, request interface{}) (*txSimData.GasEstimation, error) {
+	observerUrl, found := soge.shardsObservers[shardID]
+	if !found {
+		return nil, fmt.Errorf("%w %d", process.ErrNoObserverForShard, shardID)
+	}
+
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	httpResponse, err := soge.httpClient.Post(observerUrl+route, contentTypeJSON, bytes.NewReader(requestBytes))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		errClose := httpResponse.Body.Close()
+		if errClose != nil {
+			log.Warn("shardObserversGasEstimator: error closing the response body", "error", errClose.Error())
+		}
+	}()
+
+	responseBytes, err := ioutil.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &estimateGasResponse{}
+	err = json.Unmarshal(responseBytes, response)
+	if err != nil {
+		return nil, fmt.Errorf("%w: shard %d, HTTP status %d", process.ErrCrossShardGasEstimationFailed, shardID, httpResponse.StatusCode)
+	}
+	if httpResponse.StatusCode != http.StatusOK || response.Data == nil {
+		return nil, fmt.Errorf("%w: shard %d, HTTP status %d, %s", process.ErrCrossShardGasEstimationFailed,
+			shardID, httpResponse.StatusCode, response.Error)
+	}
+
+	return response.Data, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (soge *shardObserversGasEstimator) IsInterfaceNil() bool {
+	return soge == nil
+}
@@ -0,0 +1,135 @@
+package transaction
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+	"github.com/stretchr/testify/require"
+)
+
+func createObserverServer(t *testing.T, expectedRoute string, statusCode int, response *estimateGasResponse) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, expectedRoute, r.URL.Path)
+
+		w.WriteHeader(statusCode)
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+}
+
+func TestNewShardObserversGasEstimator(t *testing.T) {
+	t.Parallel()
+
+	estimator, err := NewShardObserversGasEstimator(ArgsShardObserversGasEstimator{})
+	require.True(t, check.IfNil(estimator))
+	require.Equal(t, process.ErrNilPubkeyConverter, err)
+
+	estimator, err = NewShardObserversGasEstimator(ArgsShardObserversGasEstimator{
+		AddressPubKeyConverter: &mock.PubkeyConverterMock{},
+	})
+	require.False(t, check.IfNil(estimator))
+	require.Nil(t, err)
+}
+
+func TestShardObserversGasEstimator_EstimateTransactionGas(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{
+		Nonce:     2,
+		Value:     big.NewInt(10),
+		SndAddr:   []byte("alice"),
+		RcvAddr:   []byte("contract"),
+		Data:      []byte("claim"),
+		GasLimit:  10000,
+		Signature: []byte("signature"),
+	}
+	expectedEstimation := &txSimData.GasEstimation{
+		GasUnits:  1000,
+		Breakdown: &txSimData.GasBreakdown{MoveBalance: moveBalanceGas, Execution: 900, Refund: 9000},
+	}
+
+	t.Run("no observer for the shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		estimator, _ := NewShardObserversGasEstimator(ArgsShardObserversGasEstimator{
+			AddressPubKeyConverter: &mock.PubkeyConverterMock{},
+		})
+		estimation, err := estimator.EstimateTransactionGas(1, tx)
+		require.Nil(t, estimation)
+		require.True(t, errors.Is(err, process.ErrNoObserverForShard))
+	})
+	t.Run("error response should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := createObserverServer(t, estimateTransactionGasRoute, http.StatusInternalServerError,
+			&estimateGasResponse{Error: "internal error"})
+		defer server.Close()
+
+		estimator, _ := NewShardObserversGasEstimator(ArgsShardObserversGasEstimator{
+			ShardsObservers:        map[uint32]string{1: server.URL},
+			RequestTimeout:         time.Second,
+			AddressPubKeyConverter: &mock.PubkeyConverterMock{},
+		})
+		estimation, err := estimator.EstimateTransactionGas(1, tx)
+		require.Nil(t, estimation)
+		require.True(t, errors.Is(err, process.ErrCrossShardGasEstimationFailed))
+		require.Contains(t, err.Error(), "internal error")
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.Equal(t, estimateTransactionGasRoute, r.URL.Path)
+
+			request := &estimateGasRequest{}
+			err := json.NewDecoder(r.Body).Decode(request)
+			require.Nil(t, err)
+			require.Equal(t, "10", request.Value)
+			require.Equal(t, tx.Data, request.Data)
+			require.Equal(t, tx.GasLimit, request.GasLimit)
+
+			_ = json.NewEncoder(w).Encode(&estimateGasResponse{Data: expectedEstimation, Code: "successful"})
+		}))
+		defer server.Close()
+
+		estimator, _ := NewShardObserversGasEstimator(ArgsShardObserversGasEstimator{
+			ShardsObservers:        map[uint32]string{1: server.URL},
+			RequestTimeout:         time.Second,
+			AddressPubKeyConverter: &mock.PubkeyConverterMock{},
+		})
+		estimation, err := estimator.EstimateTransactionGas(1, tx)
+		require.Nil(t, err)
+		require.Equal(t, expectedEstimation, estimation)
+	})
+}
+
+func TestShardObserversGasEstimator_EstimateSmartContractResultGas(t *testing.T) {
+	t.Parallel()
+
+	expectedEstimation := &txSimData.GasEstimation{
+		GasUnits:  2000,
+		Breakdown: &txSimData.GasBreakdown{Execution: 2000},
+	}
+	server := createObserverServer(t, estimateSmartContractResultGasRoute, http.StatusOK,
+		&estimateGasResponse{Data: expectedEstimation, Code: "successful"})
+	defer server.Close()
+
+	estimator, _ := NewShardObserversGasEstimator(ArgsShardObserversGasEstimator{
+		ShardsObservers:        map[uint32]string{1: server.URL},
+		RequestTimeout:         time.Second,
+		AddressPubKeyConverter: &mock.PubkeyConverterMock{},
+	})
+	estimation, err := estimator.EstimateSmartContractResultGas(1, &transaction.ApiSmartContractResult{Data: "claim"})
+	require.Nil(t, err)
+	require.Equal(t, expectedEstimation, estimation)
+}
@@ -0,0 +1,60 @@
+package transaction
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/state"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var _ process.SmartContractResultProcessor = (*stateChangesScrProcessor)(nil)
+
+// ArgsStateChangesScrProcessor defines the arguments needed for a new state changes smart contract result processor
+type ArgsStateChangesScrProcessor struct {
+	ScrProcessor          process.SmartContractResultProcessor
+	StateChangesCollector state.StateChangesCollector
+	StateChangesHandler   process.TxStateChangesHandler
+	Marshalizer           marshal.Marshalizer
+	Hasher                hashing.Hasher
+}
+
+// stateChangesScrProcessor wraps a smart contract result processor, handing over the state changes caused by each
+// processed smart contract result, such as the cross-shard calls and their callbacks
+type stateChangesScrProcessor struct {
+	process.SmartContractResultProcessor
+	*stateChangesRecorder
+}
+
+// NewStateChangesScrProcessor creates a new stateChangesScrProcessor. The provided state changes collector should be
+// the one used by the accounts adapter of the wrapped smart contract result processor
+func NewStateChangesScrProcessor(args ArgsStateChangesScrProcessor) (*stateChangesScrProcessor, error) {
+	if check.IfNil(args.ScrProcessor) {
+		return nil, process.ErrNilSmartContractResultProcessor
+	}
+
+	recorder, err := newStateChangesRecorder(args.StateChangesCollector, args.StateChangesHandler, args.Marshalizer, args.Hasher)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stateChangesScrProcessor{
+		SmartContractResultProcessor: args.ScrProcessor,
+		stateChangesRecorder:         recorder,
+	}, nil
+}
+
+// ProcessSmartContractResult processes the smart contract result using the wrapped processor and hands over the
+// resulting state changes
+func (scsp *stateChangesScrProcessor) ProcessSmartContractResult(scr *smartContractResult.SmartContractResult) (vmcommon.ReturnCode, error) {
+	return scsp.recordStateChanges(scr, func() (vmcommon.ReturnCode, error) {
+		return scsp.SmartContractResultProcessor.ProcessSmartContractResult(scr)
+	})
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (scsp *stateChangesScrProcessor) IsInterfaceNil() bool {
+	return scsp == nil
+}
@@ -0,0 +1,94 @@
+package transaction_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	txproc "github.com/ElrondNetwork/elrond-go/process/transaction"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsStateChangesScrProcessor() txproc.ArgsStateChangesScrProcessor {
+	return txproc.ArgsStateChangesScrProcessor{
+		ScrProcessor:          &testscommon.SCProcessorMock{},
+		StateChangesCollector: state.NewStateChangesCollector(),
+		StateChangesHandler:   &dblookupext.HistoryRepositoryStub{},
+		Marshalizer:           &mock.MarshalizerMock{},
+		Hasher:                &hashingMocks.HasherMock{},
+	}
+}
+
+func TestNewStateChangesScrProcessor(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsStateChangesScrProcessor()
+	args.ScrProcessor = nil
+	scrProc, err := txproc.NewStateChangesScrProcessor(args)
+	assert.True(t, check.IfNil(scrProc))
+	assert.Equal(t, process.ErrNilSmartContractResultProcessor, err)
+
+	args = createMockArgsStateChangesScrProcessor()
+	args.StateChangesCollector = nil
+	scrProc, err = txproc.NewStateChangesScrProcessor(args)
+	assert.True(t, check.IfNil(scrProc))
+	assert.Equal(t, process.ErrNilStateChangesCollector, err)
+
+	args = createMockArgsStateChangesScrProcessor()
+	args.StateChangesHandler = nil
+	scrProc, err = txproc.NewStateChangesScrProcessor(args)
+	assert.True(t, check.IfNil(scrProc))
+	assert.Equal(t, process.ErrNilTxStateChangesHandler, err)
+
+	args = createMockArgsStateChangesScrProcessor()
+	scrProc, err = txproc.NewStateChangesScrProcessor(args)
+	assert.False(t, check.IfNil(scrProc))
+	assert.Nil(t, err)
+}
+
+func TestStateChangesScrProcessor_ProcessSmartContractResultShouldHandOverTheStateChangesOfTheScr(t *testing.T) {
+	t.Parallel()
+
+	scr := &smartContractResult.SmartContractResult{Nonce: 7, Data: []byte("callBack")}
+	args := createMockArgsStateChangesScrProcessor()
+	collector := args.StateChangesCollector
+	// changes recorded before processing the smart contract result do not belong to it
+	collector.AddDataTrieChange(0, []byte("bob"), []byte("key"), nil, []byte("value"))
+
+	args.ScrProcessor = &testscommon.SCProcessorMock{
+		ProcessSmartContractResultCalled: func(scr *smartContractResult.SmartContractResult) (vmcommon.ReturnCode, error) {
+			account, _ := state.NewUserAccount([]byte("alice"))
+			_ = account.AddToBalance(big.NewInt(10))
+			collector.AddAccountChange(0, nil, account)
+
+			return vmcommon.Ok, nil
+		},
+	}
+
+	expectedScrHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, scr)
+	var handedOverStateChanges []*state.AccountStateChanges
+	args.StateChangesHandler = &dblookupext.HistoryRepositoryStub{
+		AddStateChangesCalled: func(txHash []byte, stateChanges []*state.AccountStateChanges) {
+			assert.Equal(t, expectedScrHash, txHash)
+			handedOverStateChanges = stateChanges
+		},
+	}
+
+	scrProc, _ := txproc.NewStateChangesScrProcessor(args)
+	returnCode, err := scrProc.ProcessSmartContractResult(scr)
+	assert.Equal(t, vmcommon.Ok, returnCode)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(handedOverStateChanges))
+	assert.Equal(t, []byte("alice"), handedOverStateChanges[0].Address)
+	assert.Equal(t, big.NewInt(10), handedOverStateChanges[0].BalanceAfter)
+	assert.Equal(t, 0, len(collector.GetStateChanges()))
+}
@@ -0,0 +1,122 @@
+package transaction
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/state"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+var _ process.TransactionProcessor = (*stateChangesTxProcessor)(nil)
+
+// ArgsStateChangesTxProcessor defines the arguments needed for a new state changes tx processor
+type ArgsStateChangesTxProcessor struct {
+	TxProcessor           process.TransactionProcessor
+	StateChangesCollector state.StateChangesCollector
+	StateChangesHandler   process.TxStateChangesHandler
+	Marshalizer           marshal.Marshalizer
+	Hasher                hashing.Hasher
+}
+
+// stateChangesTxProcessor wraps a transaction processor, handing over the state changes caused by each processed
+// transaction
+type stateChangesTxProcessor struct {
+	process.TransactionProcessor
+	*stateChangesRecorder
+}
+
+// NewStateChangesTxProcessor creates a new stateChangesTxProcessor. The provided state changes collector should be the
+// one used by the accounts adapter of the wrapped transaction processor
+func NewStateChangesTxProcessor(args ArgsStateChangesTxProcessor) (*stateChangesTxProcessor, error) {
+	if check.IfNil(args.TxProcessor) {
+		return nil, process.ErrNilTxProcessor
+	}
+
+	recorder, err := newStateChangesRecorder(args.StateChangesCollector, args.StateChangesHandler, args.Marshalizer, args.Hasher)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stateChangesTxProcessor{
+		TransactionProcessor: args.TxProcessor,
+		stateChangesRecorder: recorder,
+	}, nil
+}
+
+// ProcessTransaction processes the transaction using the wrapped processor and hands over the resulting state changes
+func (sctp *stateChangesTxProcessor) ProcessTransaction(tx *transaction.Transaction) (vmcommon.ReturnCode, error) {
+	return sctp.recordStateChanges(tx, func() (vmcommon.ReturnCode, error) {
+		return sctp.TransactionProcessor.ProcessTransaction(tx)
+	})
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sctp *stateChangesTxProcessor) IsInterfaceNil() bool {
+	return sctp == nil
+}
+
+// stateChangesRecorder hands over the state changes recorded by the collector while processing a transaction or a
+// smart contract result
+type stateChangesRecorder struct {
+	stateChangesCollector state.StateChangesCollector
+	stateChangesHandler   process.TxStateChangesHandler
+	marshalizer           marshal.Marshalizer
+	hasher                hashing.Hasher
+}
+
+func newStateChangesRecorder(
+	stateChangesCollector state.StateChangesCollector,
+	stateChangesHandler process.TxStateChangesHandler,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+) (*stateChangesRecorder, error) {
+	if check.IfNil(stateChangesCollector) {
+		return nil, process.ErrNilStateChangesCollector
+	}
+	if check.IfNil(stateChangesHandler) {
+		return nil, process.ErrNilTxStateChangesHandler
+	}
+	if check.IfNil(marshalizer) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(hasher) {
+		return nil, process.ErrNilHasher
+	}
+
+	return &stateChangesRecorder{
+		stateChangesCollector: stateChangesCollector,
+		stateChangesHandler:   stateChangesHandler,
+		marshalizer:           marshalizer,
+		hasher:                hasher,
+	}, nil
+}
+
+// recordStateChanges hands over, under the hash of the provided transaction, the state changes made by the processing
+// function. The changes recorded before or after the processing do not belong to the transaction, so they are discarded
+func (recorder *stateChangesRecorder) recordStateChanges(
+	tx data.TransactionHandler,
+	processTx func() (vmcommon.ReturnCode, error),
+) (vmcommon.ReturnCode, error) {
+	recorder.stateChangesCollector.Reset()
+	defer recorder.stateChangesCollector.Reset()
+
+	returnCode, err := processTx()
+	if check.IfNil(tx) {
+		return returnCode, err
+	}
+
+	txHash, errHash := core.CalculateHash(recorder.marshalizer, recorder.hasher, tx)
+	if errHash != nil {
+		log.Debug("stateChangesRecorder.recordStateChanges: cannot compute the transaction hash", "error", errHash)
+		return returnCode, err
+	}
+
+	recorder.stateChangesHandler.AddStateChanges(txHash, recorder.stateChangesCollector.GetStateChanges())
+
+	return returnCode, err
+}
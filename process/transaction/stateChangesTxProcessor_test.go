@@ -0,0 +1,106 @@
+package transaction_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	txproc "github.com/ElrondNetwork/elrond-go/process/transaction"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/dblookupext"
+	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgsStateChangesTxProcessor() txproc.ArgsStateChangesTxProcessor {
+	return txproc.ArgsStateChangesTxProcessor{
+		TxProcessor:           &testscommon.TxProcessorStub{},
+		StateChangesCollector: state.NewStateChangesCollector(),
+		StateChangesHandler:   &dblookupext.HistoryRepositoryStub{},
+		Marshalizer:           &mock.MarshalizerMock{},
+		Hasher:                &hashingMocks.HasherMock{},
+	}
+}
+
+func TestNewStateChangesTxProcessor(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsStateChangesTxProcessor()
+	args.TxProcessor = nil
+	txProc, err := txproc.NewStateChangesTxProcessor(args)
+	assert.True(t, check.IfNil(txProc))
+	assert.Equal(t, process.ErrNilTxProcessor, err)
+
+	args = createMockArgsStateChangesTxProcessor()
+	args.StateChangesCollector = nil
+	txProc, err = txproc.NewStateChangesTxProcessor(args)
+	assert.True(t, check.IfNil(txProc))
+	assert.Equal(t, process.ErrNilStateChangesCollector, err)
+
+	args = createMockArgsStateChangesTxProcessor()
+	args.StateChangesHandler = nil
+	txProc, err = txproc.NewStateChangesTxProcessor(args)
+	assert.True(t, check.IfNil(txProc))
+	assert.Equal(t, process.ErrNilTxStateChangesHandler, err)
+
+	args = createMockArgsStateChangesTxProcessor()
+	args.Marshalizer = nil
+	txProc, err = txproc.NewStateChangesTxProcessor(args)
+	assert.True(t, check.IfNil(txProc))
+	assert.Equal(t, process.ErrNilMarshalizer, err)
+
+	args = createMockArgsStateChangesTxProcessor()
+	args.Hasher = nil
+	txProc, err = txproc.NewStateChangesTxProcessor(args)
+	assert.True(t, check.IfNil(txProc))
+	assert.Equal(t, process.ErrNilHasher, err)
+
+	args = createMockArgsStateChangesTxProcessor()
+	txProc, err = txproc.NewStateChangesTxProcessor(args)
+	assert.False(t, check.IfNil(txProc))
+	assert.Nil(t, err)
+}
+
+func TestStateChangesTxProcessor_ProcessTransactionShouldHandOverTheStateChangesOfTheTransaction(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{Nonce: 7}
+	args := createMockArgsStateChangesTxProcessor()
+	collector := args.StateChangesCollector
+	// changes recorded before processing the transaction do not belong to it
+	collector.AddDataTrieChange(0, []byte("bob"), []byte("key"), nil, []byte("value"))
+
+	args.TxProcessor = &testscommon.TxProcessorStub{
+		ProcessTransactionCalled: func(transaction *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			account, _ := state.NewUserAccount([]byte("alice"))
+			_ = account.AddToBalance(big.NewInt(10))
+			collector.AddAccountChange(0, nil, account)
+
+			return vmcommon.UserError, process.ErrFailedTransaction
+		},
+	}
+
+	expectedTxHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, tx)
+	var handedOverStateChanges []*state.AccountStateChanges
+	args.StateChangesHandler = &dblookupext.HistoryRepositoryStub{
+		AddStateChangesCalled: func(txHash []byte, stateChanges []*state.AccountStateChanges) {
+			assert.Equal(t, expectedTxHash, txHash)
+			handedOverStateChanges = stateChanges
+		},
+	}
+
+	txProc, _ := txproc.NewStateChangesTxProcessor(args)
+	returnCode, err := txProc.ProcessTransaction(tx)
+	assert.Equal(t, vmcommon.UserError, returnCode)
+	assert.Equal(t, process.ErrFailedTransaction, err)
+	assert.Equal(t, 1, len(handedOverStateChanges))
+	assert.Equal(t, []byte("alice"), handedOverStateChanges[0].Address)
+	assert.Equal(t, big.NewInt(10), handedOverStateChanges[0].BalanceAfter)
+	assert.Equal(t, 0, len(collector.GetStateChanges()))
+}
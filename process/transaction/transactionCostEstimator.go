@@ -14,6 +14,7 @@ import (
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/smartContract"
 	"github.com/ElrondNetwork/elrond-go/process/txsimulator"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/sharding"
 	"github.com/ElrondNetwork/elrond-go/state"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
@@ -116,12 +117,12 @@ func (tce *transactionCostEstimator) computeMoveBalanceCost(tx *transaction.Tran
 }
 
 func (tce *transactionCostEstimator) simulateTransactionCost(tx *transaction.Transaction, txType process.TransactionType) (*transaction.CostResponse, error) {
-	err := tce.addMissingFieldsIfNeeded(tx)
+	err := addMissingFieldsIfNeeded(tx, tce.feeHandler, tce.shardCoordinator, tce.accounts)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := tce.txSimulator.ProcessTx(tx)
+	res, err := tce.txSimulator.ProcessTx(tx, txSimData.SimulationOptions{})
 	if err != nil {
 		return &transaction.CostResponse{
 			GasUnits:      0,
@@ -198,16 +199,23 @@ func extractGasRemainedFromMessage(message string, splitString string) uint64 {
 	return gasValue
 }
 
-func (tce *transactionCostEstimator) addMissingFieldsIfNeeded(tx *transaction.Transaction) error {
+// addMissingFieldsIfNeeded completes the fields of a transaction which are not mandatory for a gas estimation. A missing
+// gas limit is replaced by the maximum gas limit which can be afforded by the sender
+func addMissingFieldsIfNeeded(
+	tx *transaction.Transaction,
+	feeHandler process.FeeHandler,
+	shardCoordinator sharding.Coordinator,
+	accounts state.AccountsAdapter,
+) error {
 	if tx.GasPrice == 0 {
-		tx.GasPrice = tce.feeHandler.MinGasPrice()
+		tx.GasPrice = feeHandler.MinGasPrice()
 	}
 	if len(tx.Signature) == 0 {
 		tx.Signature = []byte(dummySignature)
 	}
 	if tx.GasLimit == 0 {
 		var err error
-		tx.GasLimit, err = tce.getTxGasLimit(tx)
+		tx.GasLimit, err = getTxGasLimit(tx, feeHandler, shardCoordinator, accounts)
 
 		return err
 	}
@@ -215,16 +223,21 @@ func (tce *transactionCostEstimator) addMissingFieldsIfNeeded(tx *transaction.Tr
 	return nil
 }
 
-func (tce *transactionCostEstimator) getTxGasLimit(tx *transaction.Transaction) (uint64, error) {
-	selfShardID := tce.shardCoordinator.SelfId()
-	maxGasLimitPerBlock := tce.feeHandler.MaxGasLimitPerBlock(selfShardID) - 1
+func getTxGasLimit(
+	tx *transaction.Transaction,
+	feeHandler process.FeeHandler,
+	shardCoordinator sharding.Coordinator,
+	accounts state.AccountsAdapter,
+) (uint64, error) {
+	selfShardID := shardCoordinator.SelfId()
+	maxGasLimitPerBlock := feeHandler.MaxGasLimitPerBlock(selfShardID) - 1
 
-	senderShardID := tce.shardCoordinator.ComputeId(tx.SndAddr)
-	if tce.shardCoordinator.SelfId() != senderShardID {
+	senderShardID := shardCoordinator.ComputeId(tx.SndAddr)
+	if shardCoordinator.SelfId() != senderShardID {
 		return maxGasLimitPerBlock, nil
 	}
 
-	accountHandler, err := tce.accounts.LoadAccount(tx.SndAddr)
+	accountHandler, err := accounts.LoadAccount(tx.SndAddr)
 	if err != nil {
 		return 0, err
 	}
@@ -240,9 +253,9 @@ func (tce *transactionCostEstimator) getTxGasLimit(tx *transaction.Transaction)
 
 	accountSenderBalance := accountSender.GetBalance()
 	tx.GasLimit = maxGasLimitPerBlock
-	txFee := tce.feeHandler.ComputeTxFee(tx)
+	txFee := feeHandler.ComputeTxFee(tx)
 	if txFee.Cmp(accountSenderBalance) > 0 && big.NewInt(0).Cmp(accountSenderBalance) != 0 {
-		return tce.feeHandler.ComputeGasLimitBasedOnBalance(tx, accountSenderBalance)
+		return feeHandler.ComputeGasLimitBasedOnBalance(tx, accountSenderBalance)
 	}
 
 	return maxGasLimitPerBlock, nil
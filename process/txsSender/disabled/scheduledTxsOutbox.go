@@ -0,0 +1,45 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/txsSender"
+)
+
+type scheduledTxsOutbox struct {
+}
+
+// NewScheduledTxsOutbox returns a disabled outbox of scheduled transactions
+func NewScheduledTxsOutbox() *scheduledTxsOutbox {
+	return &scheduledTxsOutbox{}
+}
+
+// ScheduleTransaction returns a disabled outbox error
+func (outbox *scheduledTxsOutbox) ScheduleTransaction(_ *transaction.Transaction, _ string, _ uint64) ([]byte, error) {
+	return nil, process.ErrScheduledTxsOutboxDisabled
+}
+
+// GetScheduledTransactions returns an empty slice
+func (outbox *scheduledTxsOutbox) GetScheduledTransactions() []*txsSender.ScheduledTransactionInfo {
+	return make([]*txsSender.ScheduledTransactionInfo, 0)
+}
+
+// CancelScheduledTransaction returns a disabled outbox error
+func (outbox *scheduledTxsOutbox) CancelScheduledTransaction(_ []byte) error {
+	return process.ErrScheduledTxsOutboxDisabled
+}
+
+// SetTransactionValidator does nothing
+func (outbox *scheduledTxsOutbox) SetTransactionValidator(_ txsSender.TransactionValidator) error {
+	return nil
+}
+
+// Close does nothing
+func (outbox *scheduledTxsOutbox) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (outbox *scheduledTxsOutbox) IsInterfaceNil() bool {
+	return outbox == nil
+}
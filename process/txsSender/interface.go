@@ -2,6 +2,8 @@ package txsSender
 
 import (
 	"io"
+
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
 )
 
 // NetworkMessenger defines the basic functionality of a network messenger
@@ -15,3 +17,9 @@ type NetworkMessenger interface {
 	// IsInterfaceNil checks if the underlying pointer is nil
 	IsInterfaceNil() bool
 }
+
+// TransactionValidator defines the validation of a transaction before it is sent on the network
+type TransactionValidator interface {
+	ValidateTransaction(tx *transaction.Transaction) error
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,637 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: scheduledTransaction.proto
+
+package txsSender
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ScheduledTransaction holds a signed transaction kept in the outbox until its release condition is met
+type ScheduledTransaction struct {
+	TxHash         []byte `protobuf:"bytes,1,opt,name=TxHash,proto3" json:"TxHash,omitempty"`
+	Transaction    []byte `protobuf:"bytes,2,opt,name=Transaction,proto3" json:"Transaction,omitempty"`
+	Condition      string `protobuf:"bytes,3,opt,name=Condition,proto3" json:"Condition,omitempty"`
+	ConditionValue uint64 `protobuf:"varint,4,opt,name=ConditionValue,proto3" json:"ConditionValue,omitempty"`
+	ScheduledAt    int64  `protobuf:"varint,5,opt,name=ScheduledAt,proto3" json:"ScheduledAt,omitempty"`
+	DiscardReason  string `protobuf:"bytes,6,opt,name=DiscardReason,proto3" json:"DiscardReason,omitempty"`
+}
+
+func (m *ScheduledTransaction) Reset()      { *m = ScheduledTransaction{} }
+func (*ScheduledTransaction) ProtoMessage() {}
+func (*ScheduledTransaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_613470005d7b530b, []int{0}
+}
+func (m *ScheduledTransaction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledTransaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ScheduledTransaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledTransaction.Merge(m, src)
+}
+func (m *ScheduledTransaction) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledTransaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledTransaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledTransaction proto.InternalMessageInfo
+
+func (m *ScheduledTransaction) GetTxHash() []byte {
+	if m != nil {
+		return m.TxHash
+	}
+	return nil
+}
+
+func (m *ScheduledTransaction) GetTransaction() []byte {
+	if m != nil {
+		return m.Transaction
+	}
+	return nil
+}
+
+func (m *ScheduledTransaction) GetCondition() string {
+	if m != nil {
+		return m.Condition
+	}
+	return ""
+}
+
+func (m *ScheduledTransaction) GetConditionValue() uint64 {
+	if m != nil {
+		return m.ConditionValue
+	}
+	return 0
+}
+
+func (m *ScheduledTransaction) GetScheduledAt() int64 {
+	if m != nil {
+		return m.ScheduledAt
+	}
+	return 0
+}
+
+func (m *ScheduledTransaction) GetDiscardReason() string {
+	if m != nil {
+		return m.DiscardReason
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ScheduledTransaction)(nil), "proto.ScheduledTransaction")
+}
+
+func init() { proto.RegisterFile("scheduledTransaction.proto", fileDescriptor_613470005d7b530b) }
+
+var fileDescriptor_613470005d7b530b = []byte{
+	// 277 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2a, 0x4e, 0xce, 0x48,
+	0x4d, 0x29, 0xcd, 0x49, 0x4d, 0x09, 0x29, 0x4a, 0xcc, 0x2b, 0x4e, 0x4c, 0x2e, 0xc9, 0xcc, 0xcf,
+	0xd3, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x05, 0x53, 0x52, 0xba, 0xe9, 0x99, 0x25, 0x19,
+	0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xe9, 0xf9, 0xe9, 0xf9, 0xfa, 0x60, 0xe1, 0xa4, 0xd2,
+	0x34, 0x30, 0x0f, 0xcc, 0x01, 0xb3, 0x20, 0xba, 0x94, 0xee, 0x31, 0x72, 0x89, 0x04, 0x63, 0x31,
+	0x54, 0x48, 0x8c, 0x8b, 0x2d, 0xa4, 0xc2, 0x23, 0xb1, 0x38, 0x43, 0x82, 0x51, 0x81, 0x51, 0x83,
+	0x27, 0x08, 0xca, 0x13, 0x52, 0xe0, 0xe2, 0x46, 0x52, 0x26, 0xc1, 0x04, 0x96, 0x44, 0x16, 0x12,
+	0x92, 0xe1, 0xe2, 0x74, 0xce, 0xcf, 0x4b, 0xc9, 0x04, 0xcb, 0x33, 0x2b, 0x30, 0x6a, 0x70, 0x06,
+	0x21, 0x04, 0x84, 0xd4, 0xb8, 0xf8, 0xe0, 0x9c, 0xb0, 0xc4, 0x9c, 0xd2, 0x54, 0x09, 0x16, 0x05,
+	0x46, 0x0d, 0x96, 0x20, 0x34, 0x51, 0x90, 0x3d, 0x70, 0x77, 0x39, 0x96, 0x48, 0xb0, 0x2a, 0x30,
+	0x6a, 0x30, 0x07, 0x21, 0x0b, 0x09, 0xa9, 0x70, 0xf1, 0xba, 0x64, 0x16, 0x27, 0x27, 0x16, 0xa5,
+	0x04, 0xa5, 0x26, 0x16, 0xe7, 0xe7, 0x49, 0xb0, 0x81, 0xed, 0x42, 0x15, 0x74, 0x72, 0xbe, 0xf0,
+	0x50, 0x8e, 0xe1, 0xc6, 0x43, 0x39, 0x86, 0x0f, 0x0f, 0xe5, 0x18, 0x1b, 0x1e, 0xc9, 0x31, 0xae,
+	0x78, 0x24, 0xc7, 0x78, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x37, 0x1e, 0xc9, 0x31,
+	0x3e, 0x78, 0x24, 0xc7, 0xf8, 0xe2, 0x91, 0x1c, 0xc3, 0x87, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb,
+	0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x14, 0x67, 0x49, 0x45, 0x71, 0x70,
+	0x6a, 0x5e, 0x4a, 0x6a, 0x51, 0x12, 0x1b, 0x38, 0xb0, 0x8c, 0x01, 0x03, 0x00, 0x98, 0x01, 0x6b,
+	0xb8, 0x80, 0x01, 0x00, 0x00,
+}
+
+func (this *ScheduledTransaction) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduledTransaction)
+	if !ok {
+		that2, ok := that.(ScheduledTransaction)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.TxHash, that1.TxHash) {
+		return false
+	}
+	if !bytes.Equal(this.Transaction, that1.Transaction) {
+		return false
+	}
+	if this.Condition != that1.Condition {
+		return false
+	}
+	if this.ConditionValue != that1.ConditionValue {
+		return false
+	}
+	if this.ScheduledAt != that1.ScheduledAt {
+		return false
+	}
+	if this.DiscardReason != that1.DiscardReason {
+		return false
+	}
+	return true
+}
+func (this *ScheduledTransaction) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&txsSender.ScheduledTransaction{")
+	s = append(s, "TxHash: "+fmt.Sprintf("%#v", this.TxHash)+",\n")
+	s = append(s, "Transaction: "+fmt.Sprintf("%#v", this.Transaction)+",\n")
+	s = append(s, "Condition: "+fmt.Sprintf("%#v", this.Condition)+",\n")
+	s = append(s, "ConditionValue: "+fmt.Sprintf("%#v", this.ConditionValue)+",\n")
+	s = append(s, "ScheduledAt: "+fmt.Sprintf("%#v", this.ScheduledAt)+",\n")
+	s = append(s, "DiscardReason: "+fmt.Sprintf("%#v", this.DiscardReason)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringScheduledTransaction(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *ScheduledTransaction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledTransaction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledTransaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DiscardReason) > 0 {
+		i -= len(m.DiscardReason)
+		copy(dAtA[i:], m.DiscardReason)
+		i = encodeVarintScheduledTransaction(dAtA, i, uint64(len(m.DiscardReason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ScheduledAt != 0 {
+		i = encodeVarintScheduledTransaction(dAtA, i, uint64(m.ScheduledAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ConditionValue != 0 {
+		i = encodeVarintScheduledTransaction(dAtA, i, uint64(m.ConditionValue))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Condition) > 0 {
+		i -= len(m.Condition)
+		copy(dAtA[i:], m.Condition)
+		i = encodeVarintScheduledTransaction(dAtA, i, uint64(len(m.Condition)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Transaction) > 0 {
+		i -= len(m.Transaction)
+		copy(dAtA[i:], m.Transaction)
+		i = encodeVarintScheduledTransaction(dAtA, i, uint64(len(m.Transaction)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintScheduledTransaction(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintScheduledTransaction(dAtA []byte, offset int, v uint64) int {
+	offset -= sovScheduledTransaction(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ScheduledTransaction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovScheduledTransaction(uint64(l))
+	}
+	l = len(m.Transaction)
+	if l > 0 {
+		n += 1 + l + sovScheduledTransaction(uint64(l))
+	}
+	l = len(m.Condition)
+	if l > 0 {
+		n += 1 + l + sovScheduledTransaction(uint64(l))
+	}
+	if m.ConditionValue != 0 {
+		n += 1 + sovScheduledTransaction(uint64(m.ConditionValue))
+	}
+	if m.ScheduledAt != 0 {
+		n += 1 + sovScheduledTransaction(uint64(m.ScheduledAt))
+	}
+	l = len(m.DiscardReason)
+	if l > 0 {
+		n += 1 + l + sovScheduledTransaction(uint64(l))
+	}
+	return n
+}
+
+func sovScheduledTransaction(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozScheduledTransaction(x uint64) (n int) {
+	return sovScheduledTransaction(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ScheduledTransaction) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduledTransaction{`,
+		`TxHash:` + fmt.Sprintf("%v", this.TxHash) + `,`,
+		`Transaction:` + fmt.Sprintf("%v", this.Transaction) + `,`,
+		`Condition:` + fmt.Sprintf("%v", this.Condition) + `,`,
+		`ConditionValue:` + fmt.Sprintf("%v", this.ConditionValue) + `,`,
+		`ScheduledAt:` + fmt.Sprintf("%v", this.ScheduledAt) + `,`,
+		`DiscardReason:` + fmt.Sprintf("%v", this.DiscardReason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringScheduledTransaction(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ScheduledTransaction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduledTransaction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledTransaction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledTransaction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScheduledTransaction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = append(m.TxHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.TxHash == nil {
+				m.TxHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transaction", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScheduledTransaction
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transaction = append(m.Transaction[:0], dAtA[iNdEx:postIndex]...)
+			if m.Transaction == nil {
+				m.Transaction = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Condition", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScheduledTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Condition = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionValue", wireType)
+			}
+			m.ConditionValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConditionValue |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledAt", wireType)
+			}
+			m.ScheduledAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscardReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTransaction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScheduledTransaction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTransaction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DiscardReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduledTransaction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthScheduledTransaction
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthScheduledTransaction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipScheduledTransaction(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowScheduledTransaction
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScheduledTransaction
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScheduledTransaction
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthScheduledTransaction
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupScheduledTransaction
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthScheduledTransaction
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthScheduledTransaction        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowScheduledTransaction          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupScheduledTransaction = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,447 @@
+package txsSender
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage"
+)
+
+// ArgsScheduledTxsOutbox is a holder struct for all necessary arguments to create a NewScheduledTxsOutbox
+type ArgsScheduledTxsOutbox struct {
+	TxsSender          process.TxsSenderHandler
+	Persister          storage.Persister
+	Marshaller         marshal.Marshalizer
+	Hasher             hashing.Hasher
+	RoundHandler       process.RoundHandler
+	EpochNotifier      process.EpochNotifier
+	AccountsAdapter    state.AccountsAdapter
+	MaxNumTransactions uint32
+	CheckInterval      time.Duration
+}
+
+// ScheduledTransactionInfo holds a scheduled transaction together with its release condition. The discard reason is
+// set when the transaction was not sent because it can never become valid
+type ScheduledTransactionInfo struct {
+	TxHash         []byte
+	Tx             *transaction.Transaction
+	Condition      string
+	ConditionValue uint64
+	ScheduledAt    int64
+	DiscardReason  string
+}
+
+// IsDiscarded returns true if the transaction was not sent because it can never become valid
+func (info *ScheduledTransactionInfo) IsDiscarded() bool {
+	return len(info.DiscardReason) > 0
+}
+
+type scheduledTxsOutbox struct {
+	txsSender          process.TxsSenderHandler
+	persister          storage.Persister
+	marshaller         marshal.Marshalizer
+	hasher             hashing.Hasher
+	roundHandler       process.RoundHandler
+	epochNotifier      process.EpochNotifier
+	accountsAdapter    state.AccountsAdapter
+	maxNumTransactions uint32
+
+	mutScheduledTxs sync.RWMutex
+	scheduledTxs    map[string]*ScheduledTransactionInfo
+	txValidator     TransactionValidator
+
+	cancelFunc context.CancelFunc
+}
+
+// NewScheduledTxsOutbox creates a node-local outbox which holds signed transactions and sends them on the network once
+// a round, an epoch or an account nonce is reached. The transactions are persisted, thus they survive a node restart
+func NewScheduledTxsOutbox(args ArgsScheduledTxsOutbox) (*scheduledTxsOutbox, error) {
+	if check.IfNil(args.TxsSender) {
+		return nil, process.ErrNilTxsSenderHandler
+	}
+	if check.IfNil(args.Persister) {
+		return nil, process.ErrNilStorage
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, process.ErrNilHasher
+	}
+	if check.IfNil(args.RoundHandler) {
+		return nil, process.ErrNilRoundHandler
+	}
+	if check.IfNil(args.EpochNotifier) {
+		return nil, process.ErrNilEpochNotifier
+	}
+	if check.IfNil(args.AccountsAdapter) {
+		return nil, process.ErrNilAccountsAdapter
+	}
+	if args.MaxNumTransactions == 0 {
+		return nil, fmt.Errorf("%w for MaxNumTransactions", process.ErrInvalidValue)
+	}
+	if args.CheckInterval <= 0 {
+		return nil, fmt.Errorf("%w for CheckInterval", process.ErrInvalidValue)
+	}
+
+	outbox := &scheduledTxsOutbox{
+		txsSender:          args.TxsSender,
+		persister:          args.Persister,
+		marshaller:         args.Marshaller,
+		hasher:             args.Hasher,
+		roundHandler:       args.RoundHandler,
+		epochNotifier:      args.EpochNotifier,
+		accountsAdapter:    args.AccountsAdapter,
+		maxNumTransactions: args.MaxNumTransactions,
+		scheduledTxs:       make(map[string]*ScheduledTransactionInfo),
+	}
+
+	outbox.loadFromStorage()
+
+	var ctx context.Context
+	ctx, outbox.cancelFunc = context.WithCancel(context.Background())
+	go outbox.releaseTransactionsPeriodically(ctx, args.CheckInterval)
+
+	return outbox, nil
+}
+
+func (outbox *scheduledTxsOutbox) loadFromStorage() {
+	outbox.persister.RangeKeys(func(key []byte, value []byte) bool {
+		info, err := outbox.unmarshalScheduledTransaction(value)
+		if err != nil {
+			log.Warn("scheduledTxsOutbox.loadFromStorage: cannot unmarshal scheduled transaction",
+				"key", key,
+				"error", err,
+			)
+			return true
+		}
+
+		outbox.scheduledTxs[string(info.TxHash)] = info
+		return true
+	})
+
+	log.Debug("scheduledTxsOutbox: loaded scheduled transactions from storage", "num", len(outbox.scheduledTxs))
+}
+
+// ScheduleTransaction adds the signed transaction to the outbox. The transaction will be sent once the release
+// condition is met: the current round or epoch reaches the given value, or the nonce of the sender's account reaches
+// the given value. The hash of the transaction is returned
+func (outbox *scheduledTxsOutbox) ScheduleTransaction(tx *transaction.Transaction, condition string, conditionValue uint64) ([]byte, error) {
+	if check.IfNil(tx) {
+		return nil, process.ErrNilTransaction
+	}
+	if !isValidScheduledTxCondition(condition) {
+		return nil, fmt.Errorf("%w: %s", process.ErrInvalidScheduledTxCondition, condition)
+	}
+
+	txHash, err := core.CalculateHash(outbox.marshaller, outbox.hasher, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	info := &ScheduledTransactionInfo{
+		TxHash:         txHash,
+		Tx:             tx,
+		Condition:      condition,
+		ConditionValue: conditionValue,
+		ScheduledAt:    time.Now().Unix(),
+	}
+
+	outbox.mutScheduledTxs.Lock()
+	defer outbox.mutScheduledTxs.Unlock()
+
+	_, exists := outbox.scheduledTxs[string(txHash)]
+	if exists {
+		return nil, process.ErrScheduledTxAlreadyExists
+	}
+	if uint32(len(outbox.scheduledTxs)) >= outbox.maxNumTransactions {
+		return nil, process.ErrScheduledTxsOutboxFull
+	}
+
+	buff, err := outbox.marshalScheduledTransaction(info)
+	if err != nil {
+		return nil, err
+	}
+
+	err = outbox.persister.Put(txHash, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	outbox.scheduledTxs[string(txHash)] = info
+
+	log.Debug("scheduledTxsOutbox: scheduled transaction",
+		"hash", txHash,
+		"condition", condition,
+		"value", conditionValue,
+	)
+
+	return txHash, nil
+}
+
+// GetScheduledTransactions returns the transactions waiting in the outbox, together with the discarded ones, ordered
+// by the time they were scheduled
+func (outbox *scheduledTxsOutbox) GetScheduledTransactions() []*ScheduledTransactionInfo {
+	outbox.mutScheduledTxs.RLock()
+	scheduledTxs := make([]*ScheduledTransactionInfo, 0, len(outbox.scheduledTxs))
+	for _, info := range outbox.scheduledTxs {
+		scheduledTxs = append(scheduledTxs, info)
+	}
+	outbox.mutScheduledTxs.RUnlock()
+
+	sort.Slice(scheduledTxs, func(i, j int) bool {
+		if scheduledTxs[i].ScheduledAt != scheduledTxs[j].ScheduledAt {
+			return scheduledTxs[i].ScheduledAt < scheduledTxs[j].ScheduledAt
+		}
+
+		return bytes.Compare(scheduledTxs[i].TxHash, scheduledTxs[j].TxHash) < 0
+	})
+
+	return scheduledTxs
+}
+
+// SetTransactionValidator sets the validator checking the transactions before they are sent. The transactions are not
+// released until the validator is set
+func (outbox *scheduledTxsOutbox) SetTransactionValidator(txValidator TransactionValidator) error {
+	if check.IfNil(txValidator) {
+		return process.ErrNilTxValidator
+	}
+
+	outbox.mutScheduledTxs.Lock()
+	outbox.txValidator = txValidator
+	outbox.mutScheduledTxs.Unlock()
+
+	return nil
+}
+
+// CancelScheduledTransaction removes the transaction from the outbox, so that it will never be sent. It also removes
+// the discarded transactions
+func (outbox *scheduledTxsOutbox) CancelScheduledTransaction(txHash []byte) error {
+	outbox.mutScheduledTxs.Lock()
+	defer outbox.mutScheduledTxs.Unlock()
+
+	_, exists := outbox.scheduledTxs[string(txHash)]
+	if !exists {
+		return process.ErrScheduledTxNotFound
+	}
+
+	err := outbox.persister.Remove(txHash)
+	if err != nil {
+		return err
+	}
+
+	delete(outbox.scheduledTxs, string(txHash))
+
+	log.Debug("scheduledTxsOutbox: cancelled scheduled transaction", "hash", txHash)
+
+	return nil
+}
+
+func (outbox *scheduledTxsOutbox) releaseTransactionsPeriodically(ctx context.Context, checkInterval time.Duration) {
+	for {
+		select {
+		case <-time.After(checkInterval):
+			outbox.releaseTransactions()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// releaseTransactions sends the transactions whose release condition is met. A transaction is removed from the outbox
+// only after it was sent, otherwise it is retried on the next check. The transactions which are only temporarily
+// invalid (e.g. the sender's account is not yet funded) are also retried, while the ones which can never become valid
+// are discarded: they are kept, with the reason, until they are cancelled, so they are listed with the others
+func (outbox *scheduledTxsOutbox) releaseTransactions() {
+	outbox.mutScheduledTxs.Lock()
+	defer outbox.mutScheduledTxs.Unlock()
+
+	if check.IfNil(outbox.txValidator) {
+		return
+	}
+
+	txsToSend := make([]*transaction.Transaction, 0)
+	releasedTxs := make([]*ScheduledTransactionInfo, 0)
+	for _, info := range outbox.scheduledTxs {
+		if info.IsDiscarded() || !outbox.isReleaseConditionMet(info) {
+			continue
+		}
+
+		err := outbox.txValidator.ValidateTransaction(info.Tx)
+		if err != nil && outbox.isTemporarilyInvalid(info.Tx, err) {
+			log.Debug("scheduledTxsOutbox.releaseTransactions: scheduled transaction not yet valid, will retry",
+				"hash", info.TxHash,
+				"error", err,
+			)
+			continue
+		}
+		if err != nil {
+			log.Warn("scheduledTxsOutbox.releaseTransactions: discarding invalid scheduled transaction",
+				"hash", info.TxHash,
+				"error", err,
+			)
+			outbox.discardScheduledTransaction(info, err)
+			continue
+		}
+
+		txsToSend = append(txsToSend, info.Tx)
+		releasedTxs = append(releasedTxs, info)
+	}
+
+	if len(txsToSend) == 0 {
+		return
+	}
+
+	numSent, err := outbox.txsSender.SendBulkTransactions(txsToSend)
+	if err != nil {
+		log.Warn("scheduledTxsOutbox.releaseTransactions: cannot send transactions, will retry", "error", err)
+		return
+	}
+
+	for _, info := range releasedTxs {
+		outbox.removeScheduledTransaction(info)
+	}
+
+	log.Debug("scheduledTxsOutbox: released scheduled transactions", "num", numSent)
+}
+
+// removeScheduledTransaction should be called under mutex protection. A transaction which cannot be removed from the
+// storage is only removed from memory, as it was already handled, and it will be handled again after a restart
+func (outbox *scheduledTxsOutbox) removeScheduledTransaction(info *ScheduledTransactionInfo) {
+	err := outbox.persister.Remove(info.TxHash)
+	if err != nil {
+		log.Warn("scheduledTxsOutbox: cannot remove scheduled transaction from storage",
+			"hash", info.TxHash,
+			"error", err,
+		)
+	}
+
+	delete(outbox.scheduledTxs, string(info.TxHash))
+}
+
+// isTemporarilyInvalid returns true if the validation error can go away as the sender's account changes: the account
+// does not exist yet, its balance does not cover the fee or the transaction's nonce is too far ahead
+func (outbox *scheduledTxsOutbox) isTemporarilyInvalid(tx *transaction.Transaction, err error) bool {
+	if errors.Is(err, process.ErrAccountNotFound) || errors.Is(err, process.ErrInsufficientFunds) {
+		return true
+	}
+
+	return errors.Is(err, process.ErrWrongTransaction) && tx.Nonce >= outbox.getAccountNonce(tx.SndAddr)
+}
+
+// discardScheduledTransaction should be called under mutex protection. The discard reason is kept in memory even if it
+// cannot be saved, in which case the transaction is validated again after a restart
+func (outbox *scheduledTxsOutbox) discardScheduledTransaction(info *ScheduledTransactionInfo, reason error) {
+	info.DiscardReason = reason.Error()
+
+	buff, err := outbox.marshalScheduledTransaction(info)
+	if err == nil {
+		err = outbox.persister.Put(info.TxHash, buff)
+	}
+	if err != nil {
+		log.Warn("scheduledTxsOutbox: cannot save discarded scheduled transaction",
+			"hash", info.TxHash,
+			"error", err,
+		)
+	}
+}
+
+func (outbox *scheduledTxsOutbox) isReleaseConditionMet(info *ScheduledTransactionInfo) bool {
+	switch info.Condition {
+	case common.ScheduledTxConditionRound:
+		return outbox.roundHandler.Index() >= int64(info.ConditionValue)
+	case common.ScheduledTxConditionEpoch:
+		return uint64(outbox.epochNotifier.CurrentEpoch()) >= info.ConditionValue
+	case common.ScheduledTxConditionNonce:
+		return outbox.getAccountNonce(info.Tx.SndAddr) >= info.ConditionValue
+	default:
+		return false
+	}
+}
+
+func (outbox *scheduledTxsOutbox) getAccountNonce(address []byte) uint64 {
+	account, err := outbox.accountsAdapter.GetExistingAccount(address)
+	if err != nil {
+		// the account does not exist yet, thus its nonce is 0
+		return 0
+	}
+
+	return account.GetNonce()
+}
+
+func (outbox *scheduledTxsOutbox) marshalScheduledTransaction(info *ScheduledTransactionInfo) ([]byte, error) {
+	txBuff, err := outbox.marshaller.Marshal(info.Tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return outbox.marshaller.Marshal(&ScheduledTransaction{
+		TxHash:         info.TxHash,
+		Transaction:    txBuff,
+		Condition:      info.Condition,
+		ConditionValue: info.ConditionValue,
+		ScheduledAt:    info.ScheduledAt,
+		DiscardReason:  info.DiscardReason,
+	})
+}
+
+func (outbox *scheduledTxsOutbox) unmarshalScheduledTransaction(buff []byte) (*ScheduledTransactionInfo, error) {
+	scheduledTx := &ScheduledTransaction{}
+	err := outbox.marshaller.Unmarshal(scheduledTx, buff)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := &transaction.Transaction{}
+	err = outbox.marshaller.Unmarshal(tx, scheduledTx.Transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ScheduledTransactionInfo{
+		TxHash:         scheduledTx.TxHash,
+		Tx:             tx,
+		Condition:      scheduledTx.Condition,
+		ConditionValue: scheduledTx.ConditionValue,
+		ScheduledAt:    scheduledTx.ScheduledAt,
+		DiscardReason:  scheduledTx.DiscardReason,
+	}, nil
+}
+
+func isValidScheduledTxCondition(condition string) bool {
+	switch condition {
+	case common.ScheduledTxConditionRound, common.ScheduledTxConditionEpoch, common.ScheduledTxConditionNonce:
+		return true
+	default:
+		return false
+	}
+}
+
+// Close stops the release of the scheduled transactions and closes the persister. The transactions still waiting in the
+// outbox will be loaded back on the next start
+func (outbox *scheduledTxsOutbox) Close() error {
+	outbox.cancelFunc()
+
+	outbox.mutScheduledTxs.Lock()
+	defer outbox.mutScheduledTxs.Unlock()
+
+	return outbox.persister.Close()
+}
+
+// IsInterfaceNil checks if the underlying pointer is nil
+func (outbox *scheduledTxsOutbox) IsInterfaceNil() bool {
+	return outbox == nil
+}
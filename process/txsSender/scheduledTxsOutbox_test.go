@@ -0,0 +1,568 @@
+package txsSender
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/testscommon/epochNotifier"
+	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon/txsSenderMock"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsScheduledTxsOutbox() ArgsScheduledTxsOutbox {
+	return ArgsScheduledTxsOutbox{
+		TxsSender:          &txsSenderMock.TxsSenderHandlerMock{},
+		Persister:          memorydb.New(),
+		Marshaller:         &testscommon.ProtoMarshalizerMock{},
+		Hasher:             &hashingMocks.HasherMock{},
+		RoundHandler:       &testscommon.RoundHandlerMock{},
+		EpochNotifier:      &epochNotifier.EpochNotifierStub{},
+		AccountsAdapter:    &stateMock.AccountsStub{},
+		MaxNumTransactions: 10,
+		CheckInterval:      time.Hour,
+	}
+}
+
+func TestNewScheduledTxsOutbox(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		args          func() ArgsScheduledTxsOutbox
+		expectedError error
+	}{
+		{
+			name: "nil txs sender",
+			args: func() ArgsScheduledTxsOutbox {
+				args := createMockArgsScheduledTxsOutbox()
+				args.TxsSender = nil
+				return args
+			},
+			expectedError: process.ErrNilTxsSenderHandler,
+		},
+		{
+			name: "nil persister",
+			args: func() ArgsScheduledTxsOutbox {
+				args := createMockArgsScheduledTxsOutbox()
+				args.Persister = nil
+				return args
+			},
+			expectedError: process.ErrNilStorage,
+		},
+		{
+			name: "nil marshaller",
+			args: func() ArgsScheduledTxsOutbox {
+				args := createMockArgsScheduledTxsOutbox()
+				args.Marshaller = nil
+				return args
+			},
+			expectedError: process.ErrNilMarshalizer,
+		},
+		{
+			name: "nil hasher",
+			args: func() ArgsScheduledTxsOutbox {
+				args := createMockArgsScheduledTxsOutbox()
+				args.Hasher = nil
+				return args
+			},
+			expectedError: process.ErrNilHasher,
+		},
+		{
+			name: "nil round handler",
+			args: func() ArgsScheduledTxsOutbox {
+				args := createMockArgsScheduledTxsOutbox()
+				args.RoundHandler = nil
+				return args
+			},
+			expectedError: process.ErrNilRoundHandler,
+		},
+		{
+			name: "nil epoch notifier",
+			args: func() ArgsScheduledTxsOutbox {
+				args := createMockArgsScheduledTxsOutbox()
+				args.EpochNotifier = nil
+				return args
+			},
+			expectedError: process.ErrNilEpochNotifier,
+		},
+		{
+			name: "nil accounts adapter",
+			args: func() ArgsScheduledTxsOutbox {
+				args := createMockArgsScheduledTxsOutbox()
+				args.AccountsAdapter = nil
+				return args
+			},
+			expectedError: process.ErrNilAccountsAdapter,
+		},
+		{
+			name: "zero max num transactions",
+			args: func() ArgsScheduledTxsOutbox {
+				args := createMockArgsScheduledTxsOutbox()
+				args.MaxNumTransactions = 0
+				return args
+			},
+			expectedError: process.ErrInvalidValue,
+		},
+		{
+			name: "zero check interval",
+			args: func() ArgsScheduledTxsOutbox {
+				args := createMockArgsScheduledTxsOutbox()
+				args.CheckInterval = 0
+				return args
+			},
+			expectedError: process.ErrInvalidValue,
+		},
+		{
+			name: "should work",
+			args: func() ArgsScheduledTxsOutbox {
+				return createMockArgsScheduledTxsOutbox()
+			},
+			expectedError: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			outbox, err := NewScheduledTxsOutbox(tt.args())
+			require.True(t, errors.Is(err, tt.expectedError))
+			if tt.expectedError != nil {
+				require.True(t, check.IfNil(outbox))
+				return
+			}
+
+			require.False(t, check.IfNil(outbox))
+			require.Nil(t, outbox.Close())
+		})
+	}
+}
+
+func TestScheduledTxsOutbox_ScheduleTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		outbox, _ := NewScheduledTxsOutbox(createMockArgsScheduledTxsOutbox())
+		defer func() {
+			_ = outbox.Close()
+		}()
+
+		txHash, err := outbox.ScheduleTransaction(nil, common.ScheduledTxConditionRound, 10)
+		assert.Nil(t, txHash)
+		assert.Equal(t, process.ErrNilTransaction, err)
+	})
+	t.Run("invalid condition should error", func(t *testing.T) {
+		t.Parallel()
+
+		outbox, _ := NewScheduledTxsOutbox(createMockArgsScheduledTxsOutbox())
+		defer func() {
+			_ = outbox.Close()
+		}()
+
+		txHash, err := outbox.ScheduleTransaction(&transaction.Transaction{Nonce: 1}, "timestamp", 10)
+		assert.Nil(t, txHash)
+		assert.True(t, errors.Is(err, process.ErrInvalidScheduledTxCondition))
+	})
+	t.Run("duplicated transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		outbox, _ := NewScheduledTxsOutbox(createMockArgsScheduledTxsOutbox())
+		defer func() {
+			_ = outbox.Close()
+		}()
+
+		_, err := outbox.ScheduleTransaction(&transaction.Transaction{Nonce: 1}, common.ScheduledTxConditionRound, 10)
+		require.Nil(t, err)
+
+		txHash, err := outbox.ScheduleTransaction(&transaction.Transaction{Nonce: 1}, common.ScheduledTxConditionEpoch, 2)
+		assert.Nil(t, txHash)
+		assert.Equal(t, process.ErrScheduledTxAlreadyExists, err)
+	})
+	t.Run("full outbox should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScheduledTxsOutbox()
+		args.MaxNumTransactions = 2
+		outbox, _ := NewScheduledTxsOutbox(args)
+		defer func() {
+			_ = outbox.Close()
+		}()
+
+		_, err := outbox.ScheduleTransaction(&transaction.Transaction{Nonce: 1}, common.ScheduledTxConditionRound, 10)
+		require.Nil(t, err)
+		_, err = outbox.ScheduleTransaction(&transaction.Transaction{Nonce: 2}, common.ScheduledTxConditionRound, 10)
+		require.Nil(t, err)
+
+		txHash, err := outbox.ScheduleTransaction(&transaction.Transaction{Nonce: 3}, common.ScheduledTxConditionRound, 10)
+		assert.Nil(t, txHash)
+		assert.Equal(t, process.ErrScheduledTxsOutboxFull, err)
+	})
+	t.Run("should work and persist the transaction", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScheduledTxsOutbox()
+		outbox, _ := NewScheduledTxsOutbox(args)
+		defer func() {
+			_ = outbox.Close()
+		}()
+
+		tx := &transaction.Transaction{Nonce: 7, SndAddr: []byte("sender"), RcvAddr: []byte("receiver")}
+		txHash, err := outbox.ScheduleTransaction(tx, common.ScheduledTxConditionNonce, 6)
+		require.Nil(t, err)
+		assert.NotEmpty(t, txHash)
+
+		scheduledTxs := outbox.GetScheduledTransactions()
+		require.Equal(t, 1, len(scheduledTxs))
+		assert.Equal(t, txHash, scheduledTxs[0].TxHash)
+		assert.Equal(t, tx, scheduledTxs[0].Tx)
+		assert.Equal(t, common.ScheduledTxConditionNonce, scheduledTxs[0].Condition)
+		assert.Equal(t, uint64(6), scheduledTxs[0].ConditionValue)
+
+		assert.Nil(t, args.Persister.Has(txHash))
+	})
+}
+
+func TestScheduledTxsOutbox_CancelScheduledTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unknown transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		outbox, _ := NewScheduledTxsOutbox(createMockArgsScheduledTxsOutbox())
+		defer func() {
+			_ = outbox.Close()
+		}()
+
+		err := outbox.CancelScheduledTransaction([]byte("missing"))
+		assert.Equal(t, process.ErrScheduledTxNotFound, err)
+	})
+	t.Run("should work and remove the transaction from storage", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScheduledTxsOutbox()
+		outbox, _ := NewScheduledTxsOutbox(args)
+		defer func() {
+			_ = outbox.Close()
+		}()
+
+		txHash, _ := outbox.ScheduleTransaction(&transaction.Transaction{Nonce: 1}, common.ScheduledTxConditionRound, 10)
+
+		err := outbox.CancelScheduledTransaction(txHash)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(outbox.GetScheduledTransactions()))
+		assert.NotNil(t, args.Persister.Has(txHash))
+	})
+}
+
+func TestScheduledTxsOutbox_ReleaseTransactions(t *testing.T) {
+	t.Parallel()
+
+	var currentRound int64 = 5
+	var currentEpoch uint32 = 1
+	mutSentTxs := sync.Mutex{}
+	sentTxs := make([]*transaction.Transaction, 0)
+
+	account := stateMock.NewAccountWrapMock([]byte("sender"))
+	account.IncreaseNonce(3)
+
+	args := createMockArgsScheduledTxsOutbox()
+	args.RoundHandler = &testscommon.RoundHandlerMock{
+		IndexCalled: func() int64 {
+			return currentRound
+		},
+	}
+	args.EpochNotifier = &epochNotifier.EpochNotifierStub{
+		CurrentEpochCalled: func() uint32 {
+			return currentEpoch
+		},
+	}
+	args.AccountsAdapter = &stateMock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			if string(address) == "sender" {
+				return account, nil
+			}
+
+			return nil, errors.New("account not found")
+		},
+	}
+	args.TxsSender = &txsSenderMock.TxsSenderHandlerMock{
+		SendBulkTransactionsCalled: func(txs []*transaction.Transaction) (uint64, error) {
+			mutSentTxs.Lock()
+			sentTxs = append(sentTxs, txs...)
+			mutSentTxs.Unlock()
+
+			return uint64(len(txs)), nil
+		},
+	}
+	outbox, _ := NewScheduledTxsOutbox(args)
+	defer func() {
+		_ = outbox.Close()
+	}()
+	_ = outbox.SetTransactionValidator(&txsSenderMock.TransactionValidatorStub{})
+
+	txRound := &transaction.Transaction{Nonce: 1, SndAddr: []byte("sender")}
+	txEpoch := &transaction.Transaction{Nonce: 2, SndAddr: []byte("sender")}
+	txNonce := &transaction.Transaction{Nonce: 3, SndAddr: []byte("sender")}
+	txUnknownAccount := &transaction.Transaction{Nonce: 1, SndAddr: []byte("unknown")}
+	_, _ = outbox.ScheduleTransaction(txRound, common.ScheduledTxConditionRound, 6)
+	_, _ = outbox.ScheduleTransaction(txEpoch, common.ScheduledTxConditionEpoch, 2)
+	_, _ = outbox.ScheduleTransaction(txNonce, common.ScheduledTxConditionNonce, 3)
+	_, _ = outbox.ScheduleTransaction(txUnknownAccount, common.ScheduledTxConditionNonce, 1)
+
+	outbox.releaseTransactions()
+	assert.Equal(t, []*transaction.Transaction{txNonce}, sentTxs)
+	assert.Equal(t, 3, len(outbox.GetScheduledTransactions()))
+
+	currentRound = 6
+	outbox.releaseTransactions()
+	assert.Equal(t, []*transaction.Transaction{txNonce, txRound}, sentTxs)
+
+	currentEpoch = 2
+	outbox.releaseTransactions()
+	assert.Equal(t, []*transaction.Transaction{txNonce, txRound, txEpoch}, sentTxs)
+
+	scheduledTxs := outbox.GetScheduledTransactions()
+	require.Equal(t, 1, len(scheduledTxs))
+	assert.Equal(t, txUnknownAccount, scheduledTxs[0].Tx)
+}
+
+func TestScheduledTxsOutbox_SetTransactionValidator(t *testing.T) {
+	t.Parallel()
+
+	outbox, _ := NewScheduledTxsOutbox(createMockArgsScheduledTxsOutbox())
+	defer func() {
+		_ = outbox.Close()
+	}()
+
+	err := outbox.SetTransactionValidator(nil)
+	assert.Equal(t, process.ErrNilTxValidator, err)
+
+	err = outbox.SetTransactionValidator(&txsSenderMock.TransactionValidatorStub{})
+	assert.Nil(t, err)
+}
+
+func TestScheduledTxsOutbox_ReleaseTransactionsShouldKeepTheTransactionsNotSent(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.Transaction{Nonce: 1, SndAddr: []byte("sender")}
+
+	t.Run("without a transaction validator", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsScheduledTxsOutbox()
+		args.TxsSender = &txsSenderMock.TxsSenderHandlerMock{
+			SendBulkTransactionsCalled: func(txs []*transaction.Transaction) (uint64, error) {
+				assert.Fail(t, "should have not sent the transactions")
+				return 0, nil
+			},
+		}
+		outbox, _ := NewScheduledTxsOutbox(args)
+		defer func() {
+			_ = outbox.Close()
+		}()
+
+		txHash, _ := outbox.ScheduleTransaction(tx, common.ScheduledTxConditionRound, 0)
+		outbox.releaseTransactions()
+
+		assert.Equal(t, 1, len(outbox.GetScheduledTransactions()))
+		has := args.Persister.Has(txHash)
+		assert.Nil(t, has)
+	})
+	t.Run("send error", func(t *testing.T) {
+		t.Parallel()
+
+		numSendCalls := 0
+		args := createMockArgsScheduledTxsOutbox()
+		args.TxsSender = &txsSenderMock.TxsSenderHandlerMock{
+			SendBulkTransactionsCalled: func(txs []*transaction.Transaction) (uint64, error) {
+				numSendCalls++
+				if numSendCalls == 1 {
+					return 0, errors.New("send error")
+				}
+
+				return uint64(len(txs)), nil
+			},
+		}
+		outbox, _ := NewScheduledTxsOutbox(args)
+		defer func() {
+			_ = outbox.Close()
+		}()
+		_ = outbox.SetTransactionValidator(&txsSenderMock.TransactionValidatorStub{})
+
+		txHash, _ := outbox.ScheduleTransaction(tx, common.ScheduledTxConditionRound, 0)
+		outbox.releaseTransactions()
+		assert.Equal(t, 1, len(outbox.GetScheduledTransactions()))
+		has := args.Persister.Has(txHash)
+		assert.Nil(t, has)
+
+		outbox.releaseTransactions()
+		assert.Equal(t, 2, numSendCalls)
+		assert.Equal(t, 0, len(outbox.GetScheduledTransactions()))
+		has = args.Persister.Has(txHash)
+		assert.NotNil(t, has)
+	})
+}
+
+func TestScheduledTxsOutbox_ReleaseTransactionsShouldDiscardTheInvalidTransactions(t *testing.T) {
+	t.Parallel()
+
+	validTx := &transaction.Transaction{Nonce: 1, SndAddr: []byte("sender")}
+	invalidTx := &transaction.Transaction{Nonce: 2, SndAddr: []byte("sender")}
+	sentTxs := make([]*transaction.Transaction, 0)
+	args := createMockArgsScheduledTxsOutbox()
+	args.TxsSender = &txsSenderMock.TxsSenderHandlerMock{
+		SendBulkTransactionsCalled: func(txs []*transaction.Transaction) (uint64, error) {
+			sentTxs = append(sentTxs, txs...)
+			return uint64(len(txs)), nil
+		},
+	}
+	outbox, _ := NewScheduledTxsOutbox(args)
+	defer func() {
+		_ = outbox.Close()
+	}()
+	_ = outbox.SetTransactionValidator(&txsSenderMock.TransactionValidatorStub{
+		ValidateTransactionCalled: func(tx *transaction.Transaction) error {
+			if tx == invalidTx {
+				return process.ErrLowerNonceInTransaction
+			}
+
+			return nil
+		},
+	})
+
+	_, _ = outbox.ScheduleTransaction(validTx, common.ScheduledTxConditionRound, 0)
+	invalidTxHash, _ := outbox.ScheduleTransaction(invalidTx, common.ScheduledTxConditionRound, 0)
+	outbox.releaseTransactions()
+
+	assert.Equal(t, []*transaction.Transaction{validTx}, sentTxs)
+	scheduledTxs := outbox.GetScheduledTransactions()
+	require.Equal(t, 1, len(scheduledTxs))
+	assert.Equal(t, invalidTxHash, scheduledTxs[0].TxHash)
+	assert.True(t, scheduledTxs[0].IsDiscarded())
+	assert.Equal(t, process.ErrLowerNonceInTransaction.Error(), scheduledTxs[0].DiscardReason)
+
+	// the discarded transaction is not validated again
+	outbox.releaseTransactions()
+	assert.Equal(t, []*transaction.Transaction{validTx}, sentTxs)
+
+	// the discarded transaction survives a restart, until it is cancelled
+	reloadedOutbox, _ := NewScheduledTxsOutbox(args)
+	reloadedOutbox.cancelFunc()
+	scheduledTxs = reloadedOutbox.GetScheduledTransactions()
+	require.Equal(t, 1, len(scheduledTxs))
+	assert.Equal(t, process.ErrLowerNonceInTransaction.Error(), scheduledTxs[0].DiscardReason)
+
+	err := outbox.CancelScheduledTransaction(invalidTxHash)
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(outbox.GetScheduledTransactions()))
+	has := args.Persister.Has(invalidTxHash)
+	assert.NotNil(t, has)
+}
+
+func TestScheduledTxsOutbox_ReleaseTransactionsShouldRetryTheTemporarilyInvalidTransactions(t *testing.T) {
+	t.Parallel()
+
+	account := stateMock.NewAccountWrapMock([]byte("sender"))
+	account.IncreaseNonce(3)
+	sentTxs := make([]*transaction.Transaction, 0)
+	args := createMockArgsScheduledTxsOutbox()
+	args.AccountsAdapter = &stateMock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return account, nil
+		},
+	}
+	args.TxsSender = &txsSenderMock.TxsSenderHandlerMock{
+		SendBulkTransactionsCalled: func(txs []*transaction.Transaction) (uint64, error) {
+			sentTxs = append(sentTxs, txs...)
+			return uint64(len(txs)), nil
+		},
+	}
+	outbox, _ := NewScheduledTxsOutbox(args)
+	defer func() {
+		_ = outbox.Close()
+	}()
+
+	notFundedTx := &transaction.Transaction{Nonce: 3, SndAddr: []byte("sender")}
+	notExistingSenderTx := &transaction.Transaction{Nonce: 0, SndAddr: []byte("new sender")}
+	highNonceTx := &transaction.Transaction{Nonce: 1000, SndAddr: []byte("sender")}
+	lowNonceTx := &transaction.Transaction{Nonce: 2, SndAddr: []byte("sender")}
+	isValid := false
+	_ = outbox.SetTransactionValidator(&txsSenderMock.TransactionValidatorStub{
+		ValidateTransactionCalled: func(tx *transaction.Transaction) error {
+			switch {
+			case isValid:
+				return nil
+			case tx == notFundedTx:
+				return process.ErrInsufficientFunds
+			case tx == notExistingSenderTx:
+				return process.ErrAccountNotFound
+			default:
+				return process.ErrWrongTransaction
+			}
+		},
+	})
+
+	_, _ = outbox.ScheduleTransaction(notFundedTx, common.ScheduledTxConditionRound, 0)
+	_, _ = outbox.ScheduleTransaction(notExistingSenderTx, common.ScheduledTxConditionRound, 0)
+	_, _ = outbox.ScheduleTransaction(highNonceTx, common.ScheduledTxConditionRound, 0)
+	lowNonceTxHash, _ := outbox.ScheduleTransaction(lowNonceTx, common.ScheduledTxConditionRound, 0)
+	outbox.releaseTransactions()
+
+	assert.Equal(t, 0, len(sentTxs))
+	numDiscarded := 0
+	for _, info := range outbox.GetScheduledTransactions() {
+		if info.IsDiscarded() {
+			numDiscarded++
+			assert.Equal(t, lowNonceTxHash, info.TxHash)
+		}
+	}
+	assert.Equal(t, 1, numDiscarded)
+
+	isValid = true
+	outbox.releaseTransactions()
+	assert.Equal(t, 3, len(sentTxs))
+	scheduledTxs := outbox.GetScheduledTransactions()
+	require.Equal(t, 1, len(scheduledTxs))
+	assert.Equal(t, lowNonceTxHash, scheduledTxs[0].TxHash)
+}
+
+func TestScheduledTxsOutbox_ShouldLoadTransactionsFromStorage(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsScheduledTxsOutbox()
+	outbox, _ := NewScheduledTxsOutbox(args)
+	outbox.cancelFunc()
+
+	tx := &transaction.Transaction{Nonce: 4, SndAddr: []byte("sender")}
+	txHash, err := outbox.ScheduleTransaction(tx, common.ScheduledTxConditionEpoch, 3)
+	require.Nil(t, err)
+
+	// the memory persister is reused, without being closed, so that the new outbox reads what the first one wrote
+	reloadedOutbox, _ := NewScheduledTxsOutbox(args)
+	defer func() {
+		_ = reloadedOutbox.Close()
+	}()
+
+	scheduledTxs := reloadedOutbox.GetScheduledTransactions()
+	require.Equal(t, 1, len(scheduledTxs))
+	assert.Equal(t, txHash, scheduledTxs[0].TxHash)
+	assert.Equal(t, tx.Nonce, scheduledTxs[0].Tx.Nonce)
+	assert.Equal(t, tx.SndAddr, scheduledTxs[0].Tx.SndAddr)
+	assert.Equal(t, common.ScheduledTxConditionEpoch, scheduledTxs[0].Condition)
+	assert.Equal(t, uint64(3), scheduledTxs[0].ConditionValue)
+}
@@ -0,0 +1,275 @@
+package txsimulator
+
+import (
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/process"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// callTracer records, while tracing, the virtual machine and built-in function calls made by the components it wraps.
+// A call started before the previous one ended is recorded as an inner call of the previous one
+type callTracer struct {
+	mutCalls               sync.Mutex
+	isTracing              bool
+	calls                  []*txSimData.CallTrace
+	callsStack             []*txSimData.CallTrace
+	addressPubKeyConverter core.PubkeyConverter
+}
+
+// NewCallTracer returns a new instance of a callTracer
+func NewCallTracer(addressPubKeyConverter core.PubkeyConverter) (*callTracer, error) {
+	if check.IfNil(addressPubKeyConverter) {
+		return nil, ErrNilPubkeyConverter
+	}
+
+	return &callTracer{
+		addressPubKeyConverter: addressPubKeyConverter,
+	}, nil
+}
+
+// StartTracing discards the previously recorded calls and starts recording the new ones
+func (ct *callTracer) StartTracing() {
+	ct.mutCalls.Lock()
+	ct.isTracing = true
+	ct.calls = make([]*txSimData.CallTrace, 0)
+	ct.callsStack = make([]*txSimData.CallTrace, 0)
+	ct.mutCalls.Unlock()
+}
+
+// StopTracing stops recording the calls and returns the ones recorded since tracing was started
+func (ct *callTracer) StopTracing() []*txSimData.CallTrace {
+	ct.mutCalls.Lock()
+	defer ct.mutCalls.Unlock()
+
+	calls := ct.calls
+	ct.isTracing = false
+	ct.calls = nil
+	ct.callsStack = nil
+
+	return calls
+}
+
+// WrapVirtualMachinesContainer returns a virtual machines container whose virtual machines report their calls to the tracer
+func (ct *callTracer) WrapVirtualMachinesContainer(container process.VirtualMachinesContainer) (process.VirtualMachinesContainer, error) {
+	if check.IfNil(container) {
+		return nil, ErrNilVirtualMachinesContainer
+	}
+
+	return &tracingVMContainer{
+		VirtualMachinesContainer: container,
+		tracer:                   ct,
+	}, nil
+}
+
+// WrapBuiltInFunctionContainer returns a built-in functions container whose functions report their calls to the tracer
+func (ct *callTracer) WrapBuiltInFunctionContainer(container vmcommon.BuiltInFunctionContainer) (vmcommon.BuiltInFunctionContainer, error) {
+	if check.IfNil(container) {
+		return nil, ErrNilBuiltInFunctionsContainer
+	}
+
+	return &tracingBuiltInFunctionContainer{
+		BuiltInFunctionContainer: container,
+		tracer:                   ct,
+	}, nil
+}
+
+// enterCall returns nil if the tracer is not recording the calls
+func (ct *callTracer) enterCall(receiver []byte, function string, callType string, input *vmcommon.VMInput) *txSimData.CallTrace {
+	ct.mutCalls.Lock()
+	defer ct.mutCalls.Unlock()
+
+	if !ct.isTracing {
+		return nil
+	}
+
+	call := &txSimData.CallTrace{
+		Sender:    ct.addressPubKeyConverter.Encode(input.CallerAddr),
+		Value:     getBigIntString(input.CallValue),
+		Function:  function,
+		CallType:  callType,
+		GasLimit:  input.GasProvided,
+		GasLocked: input.GasLocked,
+	}
+	if len(receiver) > 0 {
+		call.Receiver = ct.addressPubKeyConverter.Encode(receiver)
+	}
+
+	numCallsInProgress := len(ct.callsStack)
+	if numCallsInProgress == 0 {
+		ct.calls = append(ct.calls, call)
+	} else {
+		parentCall := ct.callsStack[numCallsInProgress-1]
+		parentCall.Calls = append(parentCall.Calls, call)
+	}
+	ct.callsStack = append(ct.callsStack, call)
+
+	return call
+}
+
+func (ct *callTracer) exitCall(call *txSimData.CallTrace, vmOutput *vmcommon.VMOutput, err error) {
+	if call == nil {
+		return
+	}
+
+	ct.mutCalls.Lock()
+	defer ct.mutCalls.Unlock()
+
+	numCallsInProgress := len(ct.callsStack)
+	if numCallsInProgress > 0 && ct.callsStack[numCallsInProgress-1] == call {
+		ct.callsStack = ct.callsStack[:numCallsInProgress-1]
+	}
+
+	if err != nil {
+		call.ReturnMessage = err.Error()
+		return
+	}
+	if vmOutput == nil {
+		return
+	}
+
+	call.ReturnCode = vmOutput.ReturnCode.String()
+	call.ReturnMessage = vmOutput.ReturnMessage
+	if call.GasLimit >= vmOutput.GasRemaining {
+		call.GasUsed = call.GasLimit - vmOutput.GasRemaining
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ct *callTracer) IsInterfaceNil() bool {
+	return ct == nil
+}
+
+type tracingVMContainer struct {
+	process.VirtualMachinesContainer
+	tracer *callTracer
+}
+
+// Get returns the virtual machine stored at the provided key, wrapped so it reports its calls to the tracer
+func (container *tracingVMContainer) Get(key []byte) (vmcommon.VMExecutionHandler, error) {
+	vm, err := container.VirtualMachinesContainer.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tracingVM{
+		VMExecutionHandler: vm,
+		tracer:             container.tracer,
+	}, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (container *tracingVMContainer) IsInterfaceNil() bool {
+	return container == nil
+}
+
+type tracingVM struct {
+	vmcommon.VMExecutionHandler
+	tracer *callTracer
+}
+
+// RunSmartContractCreate deploys the contract and reports the call to the tracer. The receiver of the call is the
+// address of the new contract, if the deployment succeeded
+func (vm *tracingVM) RunSmartContractCreate(input *vmcommon.ContractCreateInput) (*vmcommon.VMOutput, error) {
+	if input == nil {
+		return vm.VMExecutionHandler.RunSmartContractCreate(input)
+	}
+
+	call := vm.tracer.enterCall(nil, "", callTypeDeploy, &input.VMInput)
+	vmOutput, err := vm.VMExecutionHandler.RunSmartContractCreate(input)
+	contractAddress := getDeployedContractAddress(vmOutput)
+	if call != nil && len(contractAddress) > 0 {
+		call.Receiver = vm.tracer.addressPubKeyConverter.Encode(contractAddress)
+	}
+	vm.tracer.exitCall(call, vmOutput, err)
+
+	return vmOutput, err
+}
+
+// RunSmartContractCall executes the contract call and reports it to the tracer
+func (vm *tracingVM) RunSmartContractCall(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+	if input == nil {
+		return vm.VMExecutionHandler.RunSmartContractCall(input)
+	}
+
+	call := vm.tracer.enterCall(input.RecipientAddr, input.Function, callTypesNames[input.CallType], &input.VMInput)
+	vmOutput, err := vm.VMExecutionHandler.RunSmartContractCall(input)
+	vm.tracer.exitCall(call, vmOutput, err)
+
+	return vmOutput, err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (vm *tracingVM) IsInterfaceNil() bool {
+	return vm == nil
+}
+
+type tracingBuiltInFunctionContainer struct {
+	vmcommon.BuiltInFunctionContainer
+	tracer *callTracer
+}
+
+// Get returns the built-in function stored at the provided key, wrapped so it reports its calls to the tracer
+func (container *tracingBuiltInFunctionContainer) Get(key string) (vmcommon.BuiltinFunction, error) {
+	function, err := container.BuiltInFunctionContainer.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tracingBuiltInFunction{
+		BuiltinFunction: function,
+		tracer:          container.tracer,
+	}, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (container *tracingBuiltInFunctionContainer) IsInterfaceNil() bool {
+	return container == nil
+}
+
+type tracingBuiltInFunction struct {
+	vmcommon.BuiltinFunction
+	tracer *callTracer
+}
+
+// ProcessBuiltinFunction executes the built-in function and reports the call to the tracer
+func (function *tracingBuiltInFunction) ProcessBuiltinFunction(
+	acntSnd, acntDst vmcommon.UserAccountHandler,
+	vmInput *vmcommon.ContractCallInput,
+) (*vmcommon.VMOutput, error) {
+	if vmInput == nil {
+		return function.BuiltinFunction.ProcessBuiltinFunction(acntSnd, acntDst, vmInput)
+	}
+
+	call := function.tracer.enterCall(vmInput.RecipientAddr, vmInput.Function, callTypesNames[vmInput.CallType], &vmInput.VMInput)
+	if call != nil {
+		call.IsBuiltInFunction = true
+	}
+
+	vmOutput, err := function.BuiltinFunction.ProcessBuiltinFunction(acntSnd, acntDst, vmInput)
+	function.tracer.exitCall(call, vmOutput, err)
+
+	return vmOutput, err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (function *tracingBuiltInFunction) IsInterfaceNil() bool {
+	return function == nil
+}
+
+func getDeployedContractAddress(vmOutput *vmcommon.VMOutput) []byte {
+	if vmOutput == nil {
+		return nil
+	}
+
+	for _, outputAccount := range vmOutput.OutputAccounts {
+		if len(outputAccount.Code) > 0 {
+			return outputAccount.Address
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package txsimulator
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	vmData "github.com/ElrondNetwork/elrond-go-core/data/vm"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/ElrondNetwork/elrond-vm-common/builtInFunctions"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCallTracer(t *testing.T) {
+	t.Parallel()
+
+	tracer, err := NewCallTracer(nil)
+	require.True(t, check.IfNil(tracer))
+	require.Equal(t, ErrNilPubkeyConverter, err)
+
+	tracer, err = NewCallTracer(&mock.PubkeyConverterMock{})
+	require.False(t, check.IfNil(tracer))
+	require.Nil(t, err)
+
+	vmContainer, err := tracer.WrapVirtualMachinesContainer(nil)
+	require.Nil(t, vmContainer)
+	require.Equal(t, ErrNilVirtualMachinesContainer, err)
+
+	builtInFunctionContainer, err := tracer.WrapBuiltInFunctionContainer(nil)
+	require.Nil(t, builtInFunctionContainer)
+	require.Equal(t, ErrNilBuiltInFunctionsContainer, err)
+}
+
+func TestCallTracer_ShouldRecordTheNestedCalls(t *testing.T) {
+	t.Parallel()
+
+	tracer, _ := NewCallTracer(&mock.PubkeyConverterMock{})
+
+	builtInFunctionErr := errors.New("insufficient funds")
+	builtInFunctionContainer := builtInFunctions.NewBuiltInFunctionContainer()
+	_ = builtInFunctionContainer.Add(core.BuiltInFunctionESDTTransfer, &mock.BuiltInFunctionStub{
+		ProcessBuiltinFunctionCalled: func(_, _ vmcommon.UserAccountHandler, _ *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			return nil, builtInFunctionErr
+		},
+	})
+	tracedBuiltInFunctions, _ := tracer.WrapBuiltInFunctionContainer(builtInFunctionContainer)
+
+	vm := &mock.VMExecutionHandlerStub{
+		RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (*vmcommon.VMOutput, error) {
+			function, _ := tracedBuiltInFunctions.Get(core.BuiltInFunctionESDTTransfer)
+			_, _ = function.ProcessBuiltinFunction(nil, nil, &vmcommon.ContractCallInput{
+				VMInput: vmcommon.VMInput{
+					CallerAddr:  input.RecipientAddr,
+					CallValue:   big.NewInt(0),
+					CallType:    vmData.AsynchronousCall,
+					GasProvided: 100,
+					GasLocked:   20,
+				},
+				RecipientAddr: []byte("other"),
+				Function:      core.BuiltInFunctionESDTTransfer,
+			})
+
+			return &vmcommon.VMOutput{ReturnCode: vmcommon.UserError, ReturnMessage: "failed", GasRemaining: 300}, nil
+		},
+		RunSmartContractCreateCalled: func(input *vmcommon.ContractCreateInput) (*vmcommon.VMOutput, error) {
+			return &vmcommon.VMOutput{
+				ReturnCode:   vmcommon.Ok,
+				GasRemaining: 10,
+				OutputAccounts: map[string]*vmcommon.OutputAccount{
+					"new contract": {Address: []byte("new contract"), Code: []byte("code")},
+				},
+			}, nil
+		},
+	}
+	tracedVMs, _ := tracer.WrapVirtualMachinesContainer(&mock.VMContainerMock{
+		GetCalled: func(key []byte) (vmcommon.VMExecutionHandler, error) {
+			return vm, nil
+		},
+	})
+	tracedVM, _ := tracedVMs.Get([]byte("vm type"))
+
+	callInput := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  []byte("sender"),
+			CallValue:   big.NewInt(5),
+			GasProvided: 1000,
+		},
+		RecipientAddr: []byte("contract"),
+		Function:      "swap",
+	}
+	createInput := &vmcommon.ContractCreateInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  []byte("sender"),
+			CallValue:   big.NewInt(0),
+			GasProvided: 50,
+		},
+	}
+
+	// the calls are not recorded while not tracing
+	_, _ = tracedVM.RunSmartContractCall(callInput)
+	require.Nil(t, tracer.StopTracing())
+
+	tracer.StartTracing()
+	_, _ = tracedVM.RunSmartContractCall(callInput)
+	_, _ = tracedVM.RunSmartContractCreate(createInput)
+	calls := tracer.StopTracing()
+
+	expectedCalls := []*txSimData.CallTrace{
+		{
+			Sender:        hex.EncodeToString([]byte("sender")),
+			Receiver:      hex.EncodeToString([]byte("contract")),
+			Value:         "5",
+			Function:      "swap",
+			CallType:      callTypeDirect,
+			GasLimit:      1000,
+			GasUsed:       700,
+			ReturnCode:    vmcommon.UserError.String(),
+			ReturnMessage: "failed",
+			Calls: []*txSimData.CallTrace{
+				{
+					Sender:            hex.EncodeToString([]byte("contract")),
+					Receiver:          hex.EncodeToString([]byte("other")),
+					Value:             "0",
+					Function:          core.BuiltInFunctionESDTTransfer,
+					IsBuiltInFunction: true,
+					CallType:          "asynchronousCall",
+					GasLimit:          100,
+					GasLocked:         20,
+					ReturnMessage:     builtInFunctionErr.Error(),
+				},
+			},
+		},
+		{
+			Sender:     hex.EncodeToString([]byte("sender")),
+			Receiver:   hex.EncodeToString([]byte("new contract")),
+			Value:      "0",
+			CallType:   callTypeDeploy,
+			GasLimit:   50,
+			GasUsed:    40,
+			ReturnCode: vmcommon.Ok.String(),
+		},
+	}
+	require.Equal(t, expectedCalls, calls)
+}
@@ -12,5 +12,116 @@ type SimulationResults struct {
 	ScResults  map[string]*transaction.ApiSmartContractResult `json:"scResults,omitempty"`
 	Receipts   map[string]*transaction.ApiReceipt             `json:"receipts,omitempty"`
 	Hash       string                                         `json:"hash,omitempty"`
+	Logs       *transaction.ApiLogs                           `json:"logs,omitempty"`
+	Trace      *ExecutionTrace                                `json:"trace,omitempty"`
+	StateDiff  []*AccountStateDiff                            `json:"stateDiff,omitempty"`
 	VMOutput   *vmcommon.VMOutput                             `json:"-"`
 }
+
+// SimulationOptions holds the optional outputs requested for a simulation, which are not computed otherwise
+type SimulationOptions struct {
+	WithTrace     bool
+	WithStateDiff bool
+}
+
+// ExecutionTrace holds the call tree of a transaction, along with the effects of its execution on each touched account
+type ExecutionTrace struct {
+	Call                 *CallTrace      `json:"call"`
+	Accounts             []*AccountTrace `json:"accounts,omitempty"`
+	SmartContractResults []string        `json:"smartContractResults,omitempty"`
+}
+
+// CallTrace holds a call made while executing a transaction, along with the virtual machine and built-in function
+// calls made in turn. The calls between contracts executed by the same virtual machine instance are reported as part of
+// their caller
+type CallTrace struct {
+	Sender            string       `json:"sender"`
+	Receiver          string       `json:"receiver"`
+	Value             string       `json:"value"`
+	Function          string       `json:"function,omitempty"`
+	IsBuiltInFunction bool         `json:"isBuiltInFunction,omitempty"`
+	CallType          string       `json:"callType"`
+	GasLimit          uint64       `json:"gasLimit"`
+	GasLocked         uint64       `json:"gasLocked,omitempty"`
+	GasUsed           uint64       `json:"gasUsed,omitempty"`
+	ReturnCode        string       `json:"returnCode,omitempty"`
+	ReturnMessage     string       `json:"returnMessage,omitempty"`
+	Calls             []*CallTrace `json:"calls,omitempty"`
+}
+
+// AccountTrace holds the gas consumed, the storage writes and the logs emitted by an account while executing a
+// transaction
+type AccountTrace struct {
+	Address       string                `json:"address"`
+	GasUsed       uint64                `json:"gasUsed,omitempty"`
+	BalanceDelta  string                `json:"balanceDelta,omitempty"`
+	StorageWrites []*StorageWriteTrace  `json:"storageWrites,omitempty"`
+	Logs          []*transaction.Events `json:"logs,omitempty"`
+}
+
+// StorageWriteTrace holds the hex encoded key and new value of a data trie write. An empty value stands for a deletion
+type StorageWriteTrace struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// BatchSimulationResults is the data transfer object which will hold the results of simulating, in order, a batch of
+// transactions, each one seeing the effects of the previous ones
+type BatchSimulationResults struct {
+	Results   []*SimulationResults `json:"results"`
+	StateDiff []*AccountStateDiff  `json:"stateDiff"`
+}
+
+// AccountStateDiff holds the changes of an account touched by a transaction or by a batch simulation. The ESDT
+// balances are keyed by token identifier, followed by the hex encoded nonce in case of NFTs, while the rest of the
+// storage is keyed by the hex encoded data trie key
+type AccountStateDiff struct {
+	Address       string                       `json:"address"`
+	BalanceBefore string                       `json:"balanceBefore"`
+	BalanceAfter  string                       `json:"balanceAfter"`
+	NonceBefore   uint64                       `json:"nonceBefore"`
+	NonceAfter    uint64                       `json:"nonceAfter"`
+	ESDT          map[string]*ESDTBalanceDiff  `json:"esdt,omitempty"`
+	Storage       map[string]*StorageValueDiff `json:"storage,omitempty"`
+}
+
+// ESDTBalanceDiff holds an ESDT balance of an account, before and after the changes
+type ESDTBalanceDiff struct {
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// StorageValueDiff holds the hex encoded values of a data trie key, before and after the changes
+type StorageValueDiff struct {
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// GasEstimation holds the gas units estimated for a transaction, split by their purpose. The calls to be executed in
+// other shards are estimated by those shards. The calls to the shards which could not be reached are not simulated, so
+// only the gas forwarded to them is known and it is not included in the estimation
+type GasEstimation struct {
+	GasUnits             uint64                                         `json:"txGasUnits"`
+	ReturnMessage        string                                         `json:"returnMessage"`
+	Breakdown            *GasBreakdown                                  `json:"breakdown,omitempty"`
+	NotSimulatedCalls    []*CrossShardCall                              `json:"notSimulatedCalls,omitempty"`
+	SmartContractResults map[string]*transaction.ApiSmartContractResult `json:"smartContractResults,omitempty"`
+}
+
+// GasBreakdown splits the estimated gas units of a transaction. The refund is the part of the provided gas limit which
+// would be returned to the sender, if a gas limit was provided
+type GasBreakdown struct {
+	MoveBalance   uint64 `json:"moveBalance"`
+	Execution     uint64 `json:"execution"`
+	AsyncCallback uint64 `json:"asyncCallback"`
+	Refund        uint64 `json:"refund"`
+}
+
+// CrossShardCall holds a call produced by a simulated execution, to be executed in a shard which could not be reached
+// to estimate it
+type CrossShardCall struct {
+	Receiver     string `json:"receiver"`
+	ShardID      uint32 `json:"shardID"`
+	Function     string `json:"function,omitempty"`
+	GasForwarded uint64 `json:"gasForwarded"`
+}
@@ -5,6 +5,9 @@ import "errors"
 // ErrNilTxSimulatorProcessor signals that a nil transaction simulator processor has been provided
 var ErrNilTxSimulatorProcessor = errors.New("nil transaction simulator processor")
 
+// ErrNilSmartContractResultProcessor signals that a nil smart contract result processor has been provided
+var ErrNilSmartContractResultProcessor = errors.New("nil smart contract result processor")
+
 // ErrNilIntermediateProcessorContainer signals that intermediate processors container is nil
 var ErrNilIntermediateProcessorContainer = errors.New("intermediate processor container is nil")
 
@@ -25,3 +28,24 @@ var ErrNilMarshalizer = errors.New("nil marshalizer provided")
 
 // ErrNilHasher signals that a nil hasher has been provided
 var ErrNilHasher = errors.New("nil hasher provided")
+
+// ErrEmptyTransactionsBatch signals that an empty batch of transactions has been provided
+var ErrEmptyTransactionsBatch = errors.New("empty batch of transactions")
+
+// ErrTooManyTransactionsInBatch signals that too many transactions have been provided in a batch
+var ErrTooManyTransactionsInBatch = errors.New("too many transactions in batch")
+
+// ErrNilAccountsOverrider signals that a nil accounts overrider has been provided
+var ErrNilAccountsOverrider = errors.New("nil accounts overrider")
+
+// ErrNilAccountsHistoricalView signals that a nil accounts historical view handler has been provided
+var ErrNilAccountsHistoricalView = errors.New("nil accounts historical view handler")
+
+// ErrNilBuiltInFunctionsContainer signals that a nil built-in functions container has been provided
+var ErrNilBuiltInFunctionsContainer = errors.New("nil built-in functions container")
+
+// ErrNilCallTracer signals that a nil call tracer has been provided
+var ErrNilCallTracer = errors.New("nil call tracer")
+
+// ErrNilVirtualMachinesContainer signals that a nil virtual machines container has been provided
+var ErrNilVirtualMachinesContainer = errors.New("nil virtual machines container")
@@ -0,0 +1,181 @@
+package txsimulator
+
+import (
+	"encoding/hex"
+	"math/big"
+	"sort"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	vmData "github.com/ElrondNetwork/elrond-go-core/data/vm"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+const (
+	callTypeDeploy = "deploy"
+	callTypeDirect = "directCall"
+)
+
+var callTypesNames = map[vmData.CallType]string{
+	vmData.DirectCall:             callTypeDirect,
+	vmData.AsynchronousCall:       "asynchronousCall",
+	vmData.AsynchronousCallBack:   "asynchronousCallBack",
+	vmData.ESDTTransferAndExecute: "esdtTransferAndExecute",
+	vmData.ExecOnDestByCaller:     "execOnDestByCaller",
+}
+
+// buildExecutionTrace creates the execution trace of a processed transaction out of the calls recorded while processing
+// it and of its virtual machine output. The virtual machine output is not split per call, so the storage writes, the
+// logs and the gas consumed are reported for each touched account
+func (ts *transactionSimulator) buildExecutionTrace(
+	tx data.TransactionHandler,
+	results *txSimData.SimulationResults,
+	calls []*txSimData.CallTrace,
+) *txSimData.ExecutionTrace {
+	rootCall := &txSimData.CallTrace{
+		Sender:   ts.addressPubKeyConverter.Encode(tx.GetSndAddr()),
+		Receiver: ts.addressPubKeyConverter.Encode(tx.GetRcvAddr()),
+		Value:    getBigIntString(tx.GetValue()),
+		CallType: callTypeDirect,
+		GasLimit: tx.GetGasLimit(),
+		Calls:    append(make([]*txSimData.CallTrace, 0, len(calls)), calls...),
+	}
+	scr, isSmartContractResult := tx.(*smartContractResult.SmartContractResult)
+	if isSmartContractResult {
+		rootCall.CallType = callTypesNames[scr.CallType]
+	}
+	if core.IsEmptyAddress(tx.GetRcvAddr()) && len(tx.GetData()) > 0 {
+		rootCall.CallType = callTypeDeploy
+	} else {
+		rootCall.Function, rootCall.IsBuiltInFunction = ts.parseFunction(tx.GetData())
+	}
+
+	trace := &txSimData.ExecutionTrace{
+		Call:                 rootCall,
+		SmartContractResults: sortedKeys(results.ScResults),
+	}
+	vmOutput := results.VMOutput
+	if vmOutput == nil {
+		rootCall.ReturnMessage = results.FailReason
+		return trace
+	}
+
+	rootCall.ReturnCode = vmOutput.ReturnCode.String()
+	rootCall.ReturnMessage = vmOutput.ReturnMessage
+	if tx.GetGasLimit() >= vmOutput.GasRemaining {
+		rootCall.GasUsed = tx.GetGasLimit() - vmOutput.GasRemaining
+	}
+
+	logsByAddress := make(map[string][]*transaction.Events)
+	for _, logEntry := range vmOutput.Logs {
+		logsByAddress[string(logEntry.Address)] = append(logsByAddress[string(logEntry.Address)], &transaction.Events{
+			Address:    ts.addressPubKeyConverter.Encode(logEntry.Address),
+			Identifier: string(logEntry.Identifier),
+			Topics:     logEntry.Topics,
+			Data:       logEntry.Data,
+		})
+	}
+
+	addresses := make([]string, 0, len(vmOutput.OutputAccounts))
+	for address := range vmOutput.OutputAccounts {
+		addresses = append(addresses, address)
+	}
+	for address := range logsByAddress {
+		_, found := vmOutput.OutputAccounts[address]
+		if !found {
+			addresses = append(addresses, address)
+		}
+	}
+	sort.Strings(addresses)
+
+	trace.Accounts = make([]*txSimData.AccountTrace, 0, len(addresses))
+	for _, address := range addresses {
+		outputAccount := vmOutput.OutputAccounts[address]
+		accountTrace := ts.createAccountTrace([]byte(address), outputAccount, logsByAddress[address])
+		if accountTrace != nil {
+			trace.Accounts = append(trace.Accounts, accountTrace)
+		}
+	}
+
+	return trace
+}
+
+// createAccountTrace returns nil if the account has nothing to be reported
+func (ts *transactionSimulator) createAccountTrace(address []byte, outputAccount *vmcommon.OutputAccount, logs []*transaction.Events) *txSimData.AccountTrace {
+	accountTrace := &txSimData.AccountTrace{
+		Address: ts.addressPubKeyConverter.Encode(address),
+		Logs:    logs,
+	}
+	if outputAccount == nil {
+		return accountTrace
+	}
+
+	accountTrace.GasUsed = outputAccount.GasUsed
+	if outputAccount.BalanceDelta != nil && outputAccount.BalanceDelta.Sign() != 0 {
+		accountTrace.BalanceDelta = outputAccount.BalanceDelta.String()
+	}
+
+	keys := make([]string, 0, len(outputAccount.StorageUpdates))
+	for key, storageUpdate := range outputAccount.StorageUpdates {
+		if storageUpdate == nil || !storageUpdate.Written {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		accountTrace.StorageWrites = append(accountTrace.StorageWrites, &txSimData.StorageWriteTrace{
+			Key:   hex.EncodeToString([]byte(key)),
+			Value: hex.EncodeToString(outputAccount.StorageUpdates[key].Data),
+		})
+	}
+
+	isEmpty := accountTrace.GasUsed == 0 && len(accountTrace.BalanceDelta) == 0 &&
+		len(accountTrace.StorageWrites) == 0 && len(accountTrace.Logs) == 0
+	if isEmpty {
+		return nil
+	}
+
+	return accountTrace
+}
+
+func (ts *transactionSimulator) parseFunction(data []byte) (string, bool) {
+	if len(data) == 0 {
+		return "", false
+	}
+
+	function, _, err := ts.argsParser.ParseData(string(data))
+	if err != nil {
+		return "", false
+	}
+
+	_, err = ts.builtInFunctions.Get(function)
+
+	return function, err == nil
+}
+
+func sortedKeys(scResults map[string]*transaction.ApiSmartContractResult) []string {
+	if len(scResults) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(scResults))
+	for key := range scResults {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+func getBigIntString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
@@ -0,0 +1,125 @@
+package txsimulator
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/process/mock"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionSimulator_BuildExecutionTraceWithoutVMOutput(t *testing.T) {
+	t.Parallel()
+
+	ts, _ := NewTransactionSimulator(getTxSimulatorArgs())
+
+	tx := &transaction.Transaction{
+		SndAddr:  []byte("sender"),
+		RcvAddr:  []byte("receiver"),
+		Value:    big.NewInt(10),
+		GasLimit: 50000,
+	}
+	results := &txSimData.SimulationResults{FailReason: "insufficient funds"}
+
+	trace := ts.buildExecutionTrace(tx, results, nil)
+	require.Equal(t, &txSimData.ExecutionTrace{
+		Call: &txSimData.CallTrace{
+			Sender:        hex.EncodeToString([]byte("sender")),
+			Receiver:      hex.EncodeToString([]byte("receiver")),
+			Value:         "10",
+			CallType:      callTypeDirect,
+			GasLimit:      50000,
+			ReturnMessage: "insufficient funds",
+			Calls:         make([]*txSimData.CallTrace, 0),
+		},
+	}, trace)
+}
+
+func TestTransactionSimulator_BuildExecutionTrace(t *testing.T) {
+	t.Parallel()
+
+	args := getTxSimulatorArgs()
+	_ = args.BuiltInFunctions.Add(core.BuiltInFunctionESDTTransfer, &mock.BuiltInFunctionStub{})
+	ts, _ := NewTransactionSimulator(args)
+
+	tx := &transaction.Transaction{
+		SndAddr:  []byte("sender"),
+		RcvAddr:  []byte("contract"),
+		Data:     []byte("swap@01"),
+		GasLimit: 1000,
+	}
+	results := &txSimData.SimulationResults{
+		ScResults: map[string]*transaction.ApiSmartContractResult{
+			"bb": {},
+			"aa": {},
+		},
+		VMOutput: &vmcommon.VMOutput{
+			ReturnCode:   vmcommon.Ok,
+			GasRemaining: 400,
+			OutputAccounts: map[string]*vmcommon.OutputAccount{
+				"contract": {
+					Address: []byte("contract"),
+					GasUsed: 500,
+					StorageUpdates: map[string]*vmcommon.StorageUpdate{
+						"reserve": {Offset: []byte("reserve"), Data: []byte{5}, Written: true},
+						"read":    {Offset: []byte("read"), Data: []byte{1}, Written: false},
+					},
+				},
+			},
+			Logs: []*vmcommon.LogEntry{
+				{Address: []byte("contract"), Identifier: []byte("swap")},
+			},
+		},
+	}
+	calls := []*txSimData.CallTrace{
+		{
+			Sender:   hex.EncodeToString([]byte("sender")),
+			Receiver: hex.EncodeToString([]byte("contract")),
+			Value:    "0",
+			Function: "swap",
+			CallType: callTypeDirect,
+			GasLimit: 950,
+			GasUsed:  550,
+			Calls: []*txSimData.CallTrace{
+				{
+					Sender:            hex.EncodeToString([]byte("contract")),
+					Receiver:          hex.EncodeToString([]byte("other")),
+					Value:             "0",
+					Function:          core.BuiltInFunctionESDTTransfer,
+					IsBuiltInFunction: true,
+					CallType:          callTypeDirect,
+					GasLimit:          80,
+				},
+			},
+		},
+	}
+
+	trace := ts.buildExecutionTrace(tx, results, calls)
+
+	require.Equal(t, []string{"aa", "bb"}, trace.SmartContractResults)
+
+	rootCall := trace.Call
+	require.Equal(t, "swap", rootCall.Function)
+	require.False(t, rootCall.IsBuiltInFunction)
+	require.Equal(t, uint64(600), rootCall.GasUsed)
+	require.Equal(t, vmcommon.Ok.String(), rootCall.ReturnCode)
+	require.Equal(t, calls, rootCall.Calls)
+
+	require.Equal(t, []*txSimData.AccountTrace{
+		{
+			Address: hex.EncodeToString([]byte("contract")),
+			GasUsed: 500,
+			StorageWrites: []*txSimData.StorageWriteTrace{
+				{Key: hex.EncodeToString([]byte("reserve")), Value: "05"},
+			},
+			Logs: []*transaction.Events{
+				{Address: hex.EncodeToString([]byte("contract")), Identifier: "swap"},
+			},
+		},
+	}, trace.Accounts)
+}
@@ -2,6 +2,8 @@ package txsimulator
 
 import (
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+	"github.com/ElrondNetwork/elrond-go/state"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
@@ -11,3 +13,28 @@ type TransactionProcessor interface {
 	VerifyTransaction(transaction *transaction.Transaction) error
 	IsInterfaceNil() bool
 }
+
+// CopyOnWriteAccountsHandler defines the accounts view used by the simulation, able to keep in memory the accounts
+// saved while simulating transactions, along with their state changes
+type CopyOnWriteAccountsHandler interface {
+	StartCopyOnWrite()
+	StopCopyOnWrite()
+	GetSavedAccounts() ([]vmcommon.AccountHandler, error)
+	GetOriginalAccount(address []byte) (vmcommon.AccountHandler, error)
+	ResetStateChanges()
+	GetStateChanges() []*state.AccountStateChanges
+	IsInterfaceNil() bool
+}
+
+// CallTracer defines the component able to record the calls made while a transaction is processed
+type CallTracer interface {
+	StartTracing()
+	StopTracing() []*txSimData.CallTrace
+	IsInterfaceNil() bool
+}
+
+// StateDiffConverter defines the component able to convert the recorded state changes into their API representation
+type StateDiffConverter interface {
+	ConvertStateChanges(stateChanges []*state.AccountStateChanges) []*txSimData.AccountStateDiff
+	IsInterfaceNil() bool
+}
@@ -1,11 +1,16 @@
 package txsimulator
 
 import (
+	"bytes"
 	"encoding/hex"
+	"fmt"
+	"math/big"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
 	"github.com/ElrondNetwork/elrond-go-core/data/receipt"
 	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
@@ -13,32 +18,52 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/hashing"
 	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go/process"
+	"github.com/ElrondNetwork/elrond-go/process/stateDiff"
 	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
 	"github.com/ElrondNetwork/elrond-go/sharding"
+	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/ElrondNetwork/elrond-go/storage"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/ElrondNetwork/elrond-vm-common/parsers"
 )
 
 // ArgsTxSimulator holds the arguments required for creating a new transaction simulator
 type ArgsTxSimulator struct {
-	TransactionProcessor      TransactionProcessor
-	IntermediateProcContainer process.IntermediateProcessorContainer
-	AddressPubKeyConverter    core.PubkeyConverter
-	ShardCoordinator          sharding.Coordinator
-	VMOutputCacher            storage.Cacher
-	Hasher                    hashing.Hasher
-	Marshalizer               marshal.Marshalizer
+	TransactionProcessor         TransactionProcessor
+	SmartContractResultProcessor process.SmartContractResultProcessor
+	IntermediateProcContainer    process.IntermediateProcessorContainer
+	AddressPubKeyConverter       core.PubkeyConverter
+	ShardCoordinator             sharding.Coordinator
+	VMOutputCacher               storage.Cacher
+	Hasher                       hashing.Hasher
+	Marshalizer                  marshal.Marshalizer
+	Accounts                     CopyOnWriteAccountsHandler
+	AccountsOverrider            state.AccountsOverridesHandler
+	AccountsHistoricalView       state.AccountsHistoricalViewHandler
+	BuiltInFunctions             vmcommon.BuiltInFunctionContainer
+	CallTracer                   CallTracer
 }
 
+// maxNumTxsInBatch is the maximum number of transactions which can be simulated in a batch
+const maxNumTxsInBatch = 100
+
 type transactionSimulator struct {
 	mutOperation           sync.Mutex
 	txProcessor            TransactionProcessor
+	scrProcessor           process.SmartContractResultProcessor
 	intermProcContainer    process.IntermediateProcessorContainer
 	addressPubKeyConverter core.PubkeyConverter
 	shardCoordinator       sharding.Coordinator
 	vmOutputCacher         storage.Cacher
 	hasher                 hashing.Hasher
 	marshalizer            marshal.Marshalizer
+	accounts               CopyOnWriteAccountsHandler
+	accountsOverrider      state.AccountsOverridesHandler
+	accountsHistoricalView state.AccountsHistoricalViewHandler
+	builtInFunctions       vmcommon.BuiltInFunctionContainer
+	callTracer             CallTracer
+	argsParser             process.CallArgumentsParser
+	stateDiffConverter     StateDiffConverter
 }
 
 // NewTransactionSimulator returns a new instance of a transactionSimulator
@@ -46,6 +71,9 @@ func NewTransactionSimulator(args ArgsTxSimulator) (*transactionSimulator, error
 	if check.IfNil(args.TransactionProcessor) {
 		return nil, ErrNilTxSimulatorProcessor
 	}
+	if check.IfNil(args.SmartContractResultProcessor) {
+		return nil, ErrNilSmartContractResultProcessor
+	}
 	if check.IfNil(args.IntermediateProcContainer) {
 		return nil, ErrNilIntermediateProcessorContainer
 	}
@@ -64,29 +92,193 @@ func NewTransactionSimulator(args ArgsTxSimulator) (*transactionSimulator, error
 	if check.IfNil(args.Hasher) {
 		return nil, ErrNilHasher
 	}
+	if check.IfNil(args.Accounts) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.AccountsOverrider) {
+		return nil, ErrNilAccountsOverrider
+	}
+	if check.IfNil(args.AccountsHistoricalView) {
+		return nil, ErrNilAccountsHistoricalView
+	}
+	if check.IfNil(args.BuiltInFunctions) {
+		return nil, ErrNilBuiltInFunctionsContainer
+	}
+	if check.IfNil(args.CallTracer) {
+		return nil, ErrNilCallTracer
+	}
+
+	converter, err := stateDiff.NewStateDiffConverter(args.AddressPubKeyConverter, args.Marshalizer)
+	if err != nil {
+		return nil, err
+	}
 
 	return &transactionSimulator{
 		txProcessor:            args.TransactionProcessor,
+		scrProcessor:           args.SmartContractResultProcessor,
 		intermProcContainer:    args.IntermediateProcContainer,
 		addressPubKeyConverter: args.AddressPubKeyConverter,
 		shardCoordinator:       args.ShardCoordinator,
 		vmOutputCacher:         args.VMOutputCacher,
 		marshalizer:            args.Marshalizer,
 		hasher:                 args.Hasher,
+		accounts:               args.Accounts,
+		accountsOverrider:      args.AccountsOverrider,
+		accountsHistoricalView: args.AccountsHistoricalView,
+		builtInFunctions:       args.BuiltInFunctions,
+		callTracer:             args.CallTracer,
+		argsParser:             parsers.NewCallArgsParser(),
+		stateDiffConverter:     converter,
 	}, nil
 }
 
 // ProcessTx will process the transaction in a special environment, where state-writing is not allowed
-func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
+func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
 	ts.mutOperation.Lock()
 	defer ts.mutOperation.Unlock()
 
-	txStatus := transaction.TxStatusPending
-	failReason := ""
+	return ts.processSingleTx(tx, options)
+}
 
-	retCode, err := ts.txProcessor.ProcessTransaction(tx)
+// ProcessTxWithStateOverrides will process the transaction in the same environment as ProcessTx, with the state of
+// the provided accounts replaced by the overrides
+func (ts *transactionSimulator) ProcessTxWithStateOverrides(
+	tx *transaction.Transaction,
+	overrides map[string]*state.AccountOverride,
+	options txSimData.SimulationOptions,
+) (*txSimData.SimulationResults, error) {
+	ts.mutOperation.Lock()
+	defer ts.mutOperation.Unlock()
+
+	err := ts.accountsOverrider.SetOverrides(overrides)
 	if err != nil {
-		failReason = err.Error()
+		return nil, err
+	}
+	defer ts.accountsOverrider.ResetOverrides()
+
+	return ts.processSingleTx(tx, options)
+}
+
+// ProcessHistoricalTx will process the transaction in the same environment as ProcessTx, with the accounts read as
+// they were at the root hash from the provided options. It is meant to replay already processed transactions, so the
+// execution trace and the state diff are always computed
+func (ts *transactionSimulator) ProcessHistoricalTx(tx *transaction.Transaction, options api.AccountQueryOptions) (*txSimData.SimulationResults, error) {
+	ts.mutOperation.Lock()
+	defer ts.mutOperation.Unlock()
+
+	err := ts.accountsHistoricalView.SetHistoricalView(options)
+	if err != nil {
+		return nil, err
+	}
+	defer ts.accountsHistoricalView.ResetHistoricalView()
+
+	return ts.processSingleTx(tx, txSimData.SimulationOptions{WithTrace: true, WithStateDiff: true})
+}
+
+// ProcessSmartContractResult will process the smart contract result in the same environment as ProcessTx. It is meant
+// to simulate the part of a cross-shard transaction which is executed in the destination shard
+func (ts *transactionSimulator) ProcessSmartContractResult(scr *smartContractResult.SmartContractResult) (*txSimData.SimulationResults, error) {
+	if check.IfNil(scr) {
+		return nil, process.ErrNilSmartContractResult
+	}
+
+	ts.mutOperation.Lock()
+	defer ts.mutOperation.Unlock()
+
+	ts.accounts.StartCopyOnWrite()
+	defer ts.accounts.StopCopyOnWrite()
+
+	ts.accounts.ResetStateChanges()
+
+	retCode, err := ts.scrProcessor.ProcessSmartContractResult(scr)
+
+	return ts.createSimulationResults(scr, retCode, err, txSimData.SimulationOptions{})
+}
+
+// ProcessTxsBatch will process, in order, the transactions in a special environment, where the state changes are kept
+// in memory, so each transaction sees the effects of the previous ones. The changes, including the code of the deployed
+// contracts, are discarded at the end
+func (ts *transactionSimulator) ProcessTxsBatch(txs []*transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.BatchSimulationResults, error) {
+	if len(txs) == 0 {
+		return nil, ErrEmptyTransactionsBatch
+	}
+	if len(txs) > maxNumTxsInBatch {
+		return nil, fmt.Errorf("%w: provided %d, maximum %d", ErrTooManyTransactionsInBatch, len(txs), maxNumTxsInBatch)
+	}
+
+	ts.mutOperation.Lock()
+	defer ts.mutOperation.Unlock()
+
+	ts.accounts.StartCopyOnWrite()
+	defer ts.accounts.StopCopyOnWrite()
+
+	batchResults := &txSimData.BatchSimulationResults{
+		Results: make([]*txSimData.SimulationResults, 0, len(txs)),
+	}
+	for _, tx := range txs {
+		results, err := ts.processTx(tx, options)
+		if err != nil {
+			return nil, err
+		}
+
+		txHash, err := core.CalculateHash(ts.marshalizer, ts.hasher, tx)
+		if err != nil {
+			return nil, err
+		}
+		results.Hash = hex.EncodeToString(txHash)
+
+		batchResults.Results = append(batchResults.Results, results)
+	}
+
+	stateDiff, err := ts.computeStateDiff()
+	if err != nil {
+		return nil, err
+	}
+	batchResults.StateDiff = stateDiff
+
+	return batchResults, nil
+}
+
+// processSingleTx keeps the state changes in memory while processing the transaction only if the state diff is
+// requested, so they can be reported. Otherwise, the accounts saved while processing the transaction are discarded
+func (ts *transactionSimulator) processSingleTx(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+	if !options.WithStateDiff {
+		return ts.processTx(tx, options)
+	}
+
+	ts.accounts.StartCopyOnWrite()
+	defer ts.accounts.StopCopyOnWrite()
+
+	return ts.processTx(tx, options)
+}
+
+func (ts *transactionSimulator) processTx(tx *transaction.Transaction, options txSimData.SimulationOptions) (*txSimData.SimulationResults, error) {
+	ts.accounts.ResetStateChanges()
+
+	if options.WithTrace {
+		ts.callTracer.StartTracing()
+	}
+
+	retCode, err := ts.txProcessor.ProcessTransaction(tx)
+
+	return ts.createSimulationResults(tx, retCode, err, options)
+}
+
+func (ts *transactionSimulator) createSimulationResults(
+	tx data.TransactionHandler,
+	retCode vmcommon.ReturnCode,
+	processingErr error,
+	options txSimData.SimulationOptions,
+) (*txSimData.SimulationResults, error) {
+	var calls []*txSimData.CallTrace
+	if options.WithTrace {
+		calls = ts.callTracer.StopTracing()
+	}
+
+	txStatus := transaction.TxStatusPending
+	failReason := ""
+	if processingErr != nil {
+		failReason = processingErr.Error()
 		txStatus = transaction.TxStatusFail
 	} else {
 		if retCode == vmcommon.Ok {
@@ -99,7 +291,7 @@ func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction) (*txSimDa
 		FailReason: failReason,
 	}
 
-	err = ts.addIntermediateTxsToResult(results)
+	err := ts.addIntermediateTxsToResult(results)
 	if err != nil {
 		return nil, err
 	}
@@ -107,12 +299,121 @@ func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction) (*txSimDa
 	vmOutput, ok := ts.getVMOutputOfTx(tx)
 	if ok {
 		results.VMOutput = vmOutput
+		results.Logs = ts.adaptLogs(tx, vmOutput.Logs)
+	}
+	if options.WithTrace {
+		results.Trace = ts.buildExecutionTrace(tx, results, calls)
+	}
+	if options.WithStateDiff {
+		results.StateDiff = ts.stateDiffConverter.ConvertStateChanges(ts.accounts.GetStateChanges())
 	}
 
 	return results, nil
 }
 
-func (ts *transactionSimulator) getVMOutputOfTx(tx *transaction.Transaction) (*vmcommon.VMOutput, bool) {
+func (ts *transactionSimulator) adaptLogs(tx data.TransactionHandler, logs []*vmcommon.LogEntry) *transaction.ApiLogs {
+	if len(logs) == 0 {
+		return nil
+	}
+
+	apiLogs := &transaction.ApiLogs{
+		Address: ts.addressPubKeyConverter.Encode(tx.GetRcvAddr()),
+		Events:  make([]*transaction.Events, 0, len(logs)),
+	}
+	for _, logEntry := range logs {
+		apiLogs.Events = append(apiLogs.Events, &transaction.Events{
+			Address:    ts.addressPubKeyConverter.Encode(logEntry.Address),
+			Identifier: string(logEntry.Identifier),
+			Topics:     logEntry.Topics,
+			Data:       logEntry.Data,
+		})
+	}
+
+	return apiLogs
+}
+
+// computeStateDiff compares the user accounts saved by the simulated batch with their original state
+func (ts *transactionSimulator) computeStateDiff() ([]*txSimData.AccountStateDiff, error) {
+	savedAccounts, err := ts.accounts.GetSavedAccounts()
+	if err != nil {
+		return nil, err
+	}
+
+	stateChanges := make([]*state.AccountStateChanges, 0, len(savedAccounts))
+	for _, savedAccount := range savedAccounts {
+		account, ok := savedAccount.(state.UserAccountHandler)
+		if !ok {
+			continue
+		}
+
+		var originalAccount state.UserAccountHandler
+		originalAccountHandler, errGet := ts.accounts.GetOriginalAccount(account.AddressBytes())
+		if errGet == nil {
+			originalAccount, _ = originalAccountHandler.(state.UserAccountHandler)
+		}
+
+		accountChanges := computeAccountStateChanges(originalAccount, account)
+		if accountChanges != nil {
+			stateChanges = append(stateChanges, accountChanges)
+		}
+	}
+
+	return ts.stateDiffConverter.ConvertStateChanges(stateChanges), nil
+}
+
+// computeAccountStateChanges returns nil if the account was not changed. A nil original account stands for a new account
+func computeAccountStateChanges(originalAccount state.UserAccountHandler, account state.UserAccountHandler) *state.AccountStateChanges {
+	balanceBefore := big.NewInt(0)
+	nonceBefore := uint64(0)
+	if !check.IfNil(originalAccount) {
+		balanceBefore = getBalance(originalAccount)
+		nonceBefore = originalAccount.GetNonce()
+	}
+	balanceAfter := getBalance(account)
+
+	dataTrieChanges := make([]*state.DataTrieChange, 0)
+	for key := range account.DataTrieTracker().DirtyData() {
+		valueAfter, _ := account.RetrieveValueFromDataTrieTracker([]byte(key))
+		var valueBefore []byte
+		if !check.IfNil(originalAccount) {
+			valueBefore, _ = originalAccount.RetrieveValueFromDataTrieTracker([]byte(key))
+		}
+		if bytes.Equal(valueBefore, valueAfter) {
+			continue
+		}
+
+		dataTrieChanges = append(dataTrieChanges, &state.DataTrieChange{
+			Key:    []byte(key),
+			Before: valueBefore,
+			After:  valueAfter,
+		})
+	}
+
+	isChanged := balanceBefore.Cmp(balanceAfter) != 0 || nonceBefore != account.GetNonce() || len(dataTrieChanges) > 0
+	if !isChanged {
+		return nil
+	}
+
+	return &state.AccountStateChanges{
+		Address:         account.AddressBytes(),
+		BalanceBefore:   balanceBefore,
+		BalanceAfter:    balanceAfter,
+		NonceBefore:     nonceBefore,
+		NonceAfter:      account.GetNonce(),
+		DataTrieChanges: dataTrieChanges,
+	}
+}
+
+func getBalance(account state.UserAccountHandler) *big.Int {
+	balance := account.GetBalance()
+	if balance == nil {
+		return big.NewInt(0)
+	}
+
+	return balance
+}
+
+func (ts *transactionSimulator) getVMOutputOfTx(tx data.TransactionHandler) (*vmcommon.VMOutput, bool) {
 	txHash, err := core.CalculateHash(ts.marshalizer, ts.hasher, tx)
 	if err != nil {
 		return nil, false
@@ -3,23 +3,30 @@ package txsimulator
 import (
 	"encoding/hex"
 	"errors"
+	"math/big"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
 	"github.com/ElrondNetwork/elrond-go-core/data/receipt"
 	"github.com/ElrondNetwork/elrond-go-core/data/smartContractResult"
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	vmData "github.com/ElrondNetwork/elrond-go-core/data/vm"
 	"github.com/ElrondNetwork/elrond-go/process"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
+	txSimData "github.com/ElrondNetwork/elrond-go/process/txsimulator/data"
+	"github.com/ElrondNetwork/elrond-go/state"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/ElrondNetwork/elrond-go/storage/txcache"
 	"github.com/ElrondNetwork/elrond-go/testscommon"
 	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
+	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/ElrondNetwork/elrond-vm-common/builtInFunctions"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -48,6 +55,15 @@ func TestNewTransactionSimulator(t *testing.T) {
 			},
 			exError: ErrNilTxSimulatorProcessor,
 		},
+		{
+			name: "NilSmartContractResultProcessor",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.SmartContractResultProcessor = nil
+				return args
+			},
+			exError: ErrNilSmartContractResultProcessor,
+		},
 		{
 			name: "NilIntermProcessorContainer",
 			argsFunc: func() ArgsTxSimulator {
@@ -93,6 +109,51 @@ func TestNewTransactionSimulator(t *testing.T) {
 			},
 			exError: ErrNilCacher,
 		},
+		{
+			name: "NilAccounts",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.Accounts = nil
+				return args
+			},
+			exError: ErrNilAccountsAdapter,
+		},
+		{
+			name: "NilAccountsOverrider",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.AccountsOverrider = nil
+				return args
+			},
+			exError: ErrNilAccountsOverrider,
+		},
+		{
+			name: "NilAccountsHistoricalView",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.AccountsHistoricalView = nil
+				return args
+			},
+			exError: ErrNilAccountsHistoricalView,
+		},
+		{
+			name: "NilBuiltInFunctions",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.BuiltInFunctions = nil
+				return args
+			},
+			exError: ErrNilBuiltInFunctionsContainer,
+		},
+		{
+			name: "NilCallTracer",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.CallTracer = nil
+				return args
+			},
+			exError: ErrNilCallTracer,
+		},
 		{
 			name: "Ok",
 			argsFunc: func() ArgsTxSimulator {
@@ -124,7 +185,7 @@ func TestTransactionSimulator_ProcessTxProcessingErrShouldSignal(t *testing.T) {
 	}
 	ts, _ := NewTransactionSimulator(args)
 
-	results, err := ts.ProcessTx(&transaction.Transaction{Nonce: 37})
+	results, err := ts.ProcessTx(&transaction.Transaction{Nonce: 37}, txSimData.SimulationOptions{})
 	require.NoError(t, err)
 	require.Equal(t, expErr.Error(), results.FailReason)
 }
@@ -206,7 +267,7 @@ func TestTransactionSimulator_ProcessTxShouldIncludeScrsAndReceipts(t *testing.T
 	txHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, tx)
 	args.VMOutputCacher.Put(txHash, &vmcommon.VMOutput{}, 0)
 
-	results, err := ts.ProcessTx(tx)
+	results, err := ts.ProcessTx(tx, txSimData.SimulationOptions{})
 	require.NoError(t, err)
 	require.Equal(
 		t,
@@ -220,15 +281,178 @@ func TestTransactionSimulator_ProcessTxShouldIncludeScrsAndReceipts(t *testing.T
 	)
 }
 
+func TestTransactionSimulator_ProcessTxShouldReturnTheStateDiff(t *testing.T) {
+	t.Parallel()
+
+	alice, _ := state.NewUserAccount([]byte("alice"))
+	_ = alice.AddToBalance(big.NewInt(100))
+	accounts := createCopyOnWriteAccountsDB(map[string]state.UserAccountHandler{"alice": alice})
+
+	args := getTxSimulatorArgs()
+	args.Accounts = accounts
+	args.TransactionProcessor = &testscommon.TxProcessorStub{
+		ProcessTransactionCalled: func(tx *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			account, _ := accounts.LoadAccount([]byte("alice"))
+			userAccount := account.(state.UserAccountHandler)
+			_ = userAccount.SubFromBalance(big.NewInt(10))
+			_ = userAccount.DataTrieTracker().SaveKeyValue([]byte("key"), []byte("value"))
+
+			return vmcommon.Ok, accounts.SaveAccount(account)
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	results, err := ts.ProcessTx(&transaction.Transaction{Nonce: 37}, txSimData.SimulationOptions{WithStateDiff: true})
+	require.Nil(t, err)
+	expectedStateDiff := []*txSimData.AccountStateDiff{
+		{
+			Address:       hex.EncodeToString([]byte("alice")),
+			BalanceBefore: "100",
+			BalanceAfter:  "90",
+			Storage: map[string]*txSimData.StorageValueDiff{
+				hex.EncodeToString([]byte("key")): {Before: "", After: hex.EncodeToString([]byte("value"))},
+			},
+		},
+	}
+	require.Equal(t, expectedStateDiff, results.StateDiff)
+
+	// the changes are discarded after the simulation
+	account, _ := accounts.GetExistingAccount([]byte("alice"))
+	require.Equal(t, big.NewInt(100), account.(state.UserAccountHandler).GetBalance())
+}
+
+func TestTransactionSimulator_ProcessTxShouldNotKeepTheSavedAccountsIfTheStateDiffIsNotRequested(t *testing.T) {
+	t.Parallel()
+
+	alice, _ := state.NewUserAccount([]byte("alice"))
+	_ = alice.AddToBalance(big.NewInt(100))
+	accounts := createCopyOnWriteAccountsDB(map[string]state.UserAccountHandler{"alice": alice})
+
+	args := getTxSimulatorArgs()
+	args.Accounts = accounts
+	args.TransactionProcessor = &testscommon.TxProcessorStub{
+		ProcessTransactionCalled: func(tx *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			account, _ := accounts.LoadAccount([]byte("alice"))
+			_ = account.(state.UserAccountHandler).SubFromBalance(big.NewInt(10))
+			_ = accounts.SaveAccount(account)
+
+			savedAccount, _ := accounts.LoadAccount([]byte("alice"))
+			require.Equal(t, big.NewInt(100), savedAccount.(state.UserAccountHandler).GetBalance())
+
+			return vmcommon.Ok, nil
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	results, err := ts.ProcessTx(&transaction.Transaction{Nonce: 37}, txSimData.SimulationOptions{})
+	require.Nil(t, err)
+	require.Equal(t, transaction.TxStatusSuccess, results.Status)
+	require.Nil(t, results.StateDiff)
+}
+
+func TestTransactionSimulator_ProcessSmartContractResult(t *testing.T) {
+	t.Parallel()
+
+	args := getTxSimulatorArgs()
+	args.VMOutputCacher, _ = storageUnit.NewCache(storageUnit.CacheConfig{
+		Type:     storageUnit.LRUCache,
+		Capacity: 100,
+	})
+	processedScr := &smartContractResult.SmartContractResult{
+		SndAddr:  []byte("sender"),
+		RcvAddr:  []byte("contract"),
+		Data:     []byte("claim@01"),
+		GasLimit: 1000,
+		CallType: vmData.AsynchronousCall,
+	}
+	scrHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, processedScr)
+	args.SmartContractResultProcessor = &testscommon.SCProcessorMock{
+		ProcessSmartContractResultCalled: func(scr *smartContractResult.SmartContractResult) (vmcommon.ReturnCode, error) {
+			require.Equal(t, processedScr, scr)
+			args.VMOutputCacher.Put(scrHash, &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRemaining: 400}, 0)
+
+			return vmcommon.Ok, nil
+		},
+	}
+	args.IntermediateProcContainer = &mock.IntermProcessorContainerStub{
+		GetCalled: func(key block.Type) (process.IntermediateTransactionHandler, error) {
+			return &mock.IntermediateTransactionHandlerStub{}, nil
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	results, err := ts.ProcessSmartContractResult(nil)
+	require.Nil(t, results)
+	require.Equal(t, process.ErrNilSmartContractResult, err)
+
+	results, err = ts.ProcessSmartContractResult(processedScr)
+	require.Nil(t, err)
+	require.Equal(t, transaction.TxStatusSuccess, results.Status)
+	require.Equal(t, uint64(400), results.VMOutput.GasRemaining)
+	require.Nil(t, results.Trace)
+}
+
+func TestTransactionSimulator_ProcessTxShouldComputeTheTraceOnlyIfRequested(t *testing.T) {
+	t.Parallel()
+
+	tracer, _ := NewCallTracer(&mock.PubkeyConverterMock{})
+	args := getTxSimulatorArgs()
+	args.CallTracer = tracer
+	args.TransactionProcessor = &testscommon.TxProcessorStub{
+		ProcessTransactionCalled: func(tx *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			input := &vmcommon.VMInput{CallerAddr: tx.SndAddr, GasProvided: tx.GasLimit}
+			call := tracer.enterCall(tx.RcvAddr, "claim", callTypeDirect, input)
+			tracer.exitCall(call, &vmcommon.VMOutput{ReturnCode: vmcommon.Ok, GasRemaining: 400}, nil)
+
+			return vmcommon.Ok, nil
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	tx := &transaction.Transaction{
+		SndAddr:  []byte("sender"),
+		RcvAddr:  []byte("contract"),
+		Data:     []byte("claim"),
+		GasLimit: 1000,
+	}
+
+	results, err := ts.ProcessTx(tx, txSimData.SimulationOptions{})
+	require.Nil(t, err)
+	require.Nil(t, results.Trace)
+
+	results, err = ts.ProcessTx(tx, txSimData.SimulationOptions{WithTrace: true})
+	require.Nil(t, err)
+	require.Equal(t, []*txSimData.CallTrace{
+		{
+			Sender:     hex.EncodeToString([]byte("sender")),
+			Receiver:   hex.EncodeToString([]byte("contract")),
+			Value:      "0",
+			Function:   "claim",
+			CallType:   callTypeDirect,
+			GasLimit:   1000,
+			GasUsed:    600,
+			ReturnCode: vmcommon.Ok.String(),
+		},
+	}, results.Trace.Call.Calls)
+}
+
 func getTxSimulatorArgs() ArgsTxSimulator {
+	tracer, _ := NewCallTracer(&mock.PubkeyConverterMock{})
+
 	return ArgsTxSimulator{
-		TransactionProcessor:      &testscommon.TxProcessorStub{},
-		IntermediateProcContainer: &mock.IntermProcessorContainerStub{},
-		AddressPubKeyConverter:    &mock.PubkeyConverterMock{},
-		ShardCoordinator:          mock.NewMultiShardsCoordinatorMock(2),
-		VMOutputCacher:            txcache.NewDisabledCache(),
-		Marshalizer:               &mock.MarshalizerMock{},
-		Hasher:                    &hashingMocks.HasherMock{},
+		TransactionProcessor:         &testscommon.TxProcessorStub{},
+		SmartContractResultProcessor: &testscommon.SCProcessorMock{},
+		IntermediateProcContainer:    &mock.IntermProcessorContainerStub{},
+		AddressPubKeyConverter:       &mock.PubkeyConverterMock{},
+		ShardCoordinator:             mock.NewMultiShardsCoordinatorMock(2),
+		VMOutputCacher:               txcache.NewDisabledCache(),
+		Marshalizer:                  &mock.MarshalizerMock{},
+		Hasher:                       &hashingMocks.HasherMock{},
+		Accounts:                     createCopyOnWriteAccountsDB(make(map[string]state.UserAccountHandler)),
+		AccountsOverrider:            &stateMock.AccountsOverridesHandlerStub{},
+		AccountsHistoricalView:       &stateMock.AccountsHistoricalViewHandlerStub{},
+		BuiltInFunctions:             builtInFunctions.NewBuiltInFunctionContainer(),
+		CallTracer:                   tracer,
 	}
 }
 
@@ -254,7 +478,7 @@ func TestTransactionSimulator_ProcessTxConcurrentCalls(t *testing.T) {
 	for i := 0; i < numCalls; i++ {
 		go func(idx int) {
 			time.Sleep(time.Millisecond * 10)
-			_, _ = txSimulator.ProcessTx(tx)
+			_, _ = txSimulator.ProcessTx(tx, txSimData.SimulationOptions{})
 			wg.Done()
 		}(i)
 	}
@@ -262,3 +486,250 @@ func TestTransactionSimulator_ProcessTxConcurrentCalls(t *testing.T) {
 	wg.Wait()
 	assert.Equal(t, numCalls, numTransactionProcessorCalls)
 }
+
+func TestTransactionSimulator_ProcessTxWithStateOverrides(t *testing.T) {
+	t.Parallel()
+
+	overrides := map[string]*state.AccountOverride{
+		"alice": {Balance: big.NewInt(1000)},
+	}
+
+	t.Run("set overrides error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := getTxSimulatorArgs()
+		args.TransactionProcessor = &testscommon.TxProcessorStub{
+			ProcessTransactionCalled: func(tx *transaction.Transaction) (vmcommon.ReturnCode, error) {
+				require.Fail(t, "should have not processed the transaction")
+				return vmcommon.Ok, nil
+			},
+		}
+		args.AccountsOverrider = &stateMock.AccountsOverridesHandlerStub{
+			SetOverridesCalled: func(_ map[string]*state.AccountOverride) error {
+				return expectedErr
+			},
+		}
+		ts, _ := NewTransactionSimulator(args)
+
+		results, err := ts.ProcessTxWithStateOverrides(&transaction.Transaction{Nonce: 37}, overrides, txSimData.SimulationOptions{})
+		require.Nil(t, results)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("should apply the overrides only during the processing", func(t *testing.T) {
+		t.Parallel()
+
+		areOverridesSet := false
+		args := getTxSimulatorArgs()
+		args.TransactionProcessor = &testscommon.TxProcessorStub{
+			ProcessTransactionCalled: func(tx *transaction.Transaction) (vmcommon.ReturnCode, error) {
+				require.True(t, areOverridesSet)
+				return vmcommon.Ok, nil
+			},
+		}
+		args.AccountsOverrider = &stateMock.AccountsOverridesHandlerStub{
+			SetOverridesCalled: func(providedOverrides map[string]*state.AccountOverride) error {
+				require.Equal(t, overrides, providedOverrides)
+				areOverridesSet = true
+				return nil
+			},
+			ResetOverridesCalled: func() {
+				areOverridesSet = false
+			},
+		}
+		ts, _ := NewTransactionSimulator(args)
+
+		results, err := ts.ProcessTxWithStateOverrides(&transaction.Transaction{Nonce: 37}, overrides, txSimData.SimulationOptions{})
+		require.Nil(t, err)
+		require.Equal(t, transaction.TxStatusSuccess, results.Status)
+		require.False(t, areOverridesSet)
+	})
+}
+
+func TestTransactionSimulator_ProcessHistoricalTx(t *testing.T) {
+	t.Parallel()
+
+	options := api.AccountQueryOptions{BlockRootHash: []byte("root hash")}
+
+	t.Run("set historical view error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := getTxSimulatorArgs()
+		args.TransactionProcessor = &testscommon.TxProcessorStub{
+			ProcessTransactionCalled: func(tx *transaction.Transaction) (vmcommon.ReturnCode, error) {
+				require.Fail(t, "should have not processed the transaction")
+				return vmcommon.Ok, nil
+			},
+		}
+		args.AccountsHistoricalView = &stateMock.AccountsHistoricalViewHandlerStub{
+			SetHistoricalViewCalled: func(_ api.AccountQueryOptions) error {
+				return expectedErr
+			},
+		}
+		ts, _ := NewTransactionSimulator(args)
+
+		results, err := ts.ProcessHistoricalTx(&transaction.Transaction{Nonce: 37}, options)
+		require.Nil(t, results)
+		require.Equal(t, expectedErr, err)
+	})
+	t.Run("should read the historical state only during the processing", func(t *testing.T) {
+		t.Parallel()
+
+		isHistoricalViewSet := false
+		args := getTxSimulatorArgs()
+		args.TransactionProcessor = &testscommon.TxProcessorStub{
+			ProcessTransactionCalled: func(tx *transaction.Transaction) (vmcommon.ReturnCode, error) {
+				require.True(t, isHistoricalViewSet)
+				return vmcommon.Ok, nil
+			},
+		}
+		args.AccountsHistoricalView = &stateMock.AccountsHistoricalViewHandlerStub{
+			SetHistoricalViewCalled: func(providedOptions api.AccountQueryOptions) error {
+				require.Equal(t, options, providedOptions)
+				isHistoricalViewSet = true
+				return nil
+			},
+			ResetHistoricalViewCalled: func() {
+				isHistoricalViewSet = false
+			},
+		}
+		ts, _ := NewTransactionSimulator(args)
+
+		results, err := ts.ProcessHistoricalTx(&transaction.Transaction{Nonce: 37}, options)
+		require.Nil(t, err)
+		require.Equal(t, transaction.TxStatusSuccess, results.Status)
+		require.NotNil(t, results.Trace)
+		require.False(t, isHistoricalViewSet)
+	})
+}
+
+func TestTransactionSimulator_ProcessTxsBatch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty batch should error", func(t *testing.T) {
+		t.Parallel()
+
+		ts, _ := NewTransactionSimulator(getTxSimulatorArgs())
+		results, err := ts.ProcessTxsBatch(nil, txSimData.SimulationOptions{})
+		require.Nil(t, results)
+		require.Equal(t, ErrEmptyTransactionsBatch, err)
+	})
+	t.Run("too many transactions should error", func(t *testing.T) {
+		t.Parallel()
+
+		ts, _ := NewTransactionSimulator(getTxSimulatorArgs())
+		results, err := ts.ProcessTxsBatch(make([]*transaction.Transaction, maxNumTxsInBatch+1), txSimData.SimulationOptions{})
+		require.Nil(t, results)
+		require.True(t, errors.Is(err, ErrTooManyTransactionsInBatch))
+	})
+	t.Run("should chain the state changes", func(t *testing.T) {
+		t.Parallel()
+
+		alice, _ := state.NewUserAccount([]byte("alice"))
+		_ = alice.AddToBalance(big.NewInt(100))
+		accounts := createCopyOnWriteAccountsDB(map[string]state.UserAccountHandler{"alice": alice})
+
+		moveBalance := func(tx *transaction.Transaction) error {
+			sender, _ := accounts.LoadAccount(tx.SndAddr)
+			senderAccount := sender.(state.UserAccountHandler)
+			err := senderAccount.SubFromBalance(tx.Value)
+			if err != nil {
+				return err
+			}
+			senderAccount.IncreaseNonce(1)
+			_ = accounts.SaveAccount(sender)
+
+			receiver, _ := accounts.LoadAccount(tx.RcvAddr)
+			_ = receiver.(state.UserAccountHandler).AddToBalance(tx.Value)
+
+			return accounts.SaveAccount(receiver)
+		}
+
+		args := getTxSimulatorArgs()
+		args.Accounts = accounts
+		args.TransactionProcessor = &testscommon.TxProcessorStub{
+			ProcessTransactionCalled: func(tx *transaction.Transaction) (vmcommon.ReturnCode, error) {
+				return vmcommon.Ok, moveBalance(tx)
+			},
+		}
+		ts, _ := NewTransactionSimulator(args)
+
+		txs := []*transaction.Transaction{
+			{Nonce: 0, SndAddr: []byte("alice"), RcvAddr: []byte("bob"), Value: big.NewInt(70)},
+			{Nonce: 0, SndAddr: []byte("bob"), RcvAddr: []byte("carol"), Value: big.NewInt(30)},
+			{Nonce: 1, SndAddr: []byte("alice"), RcvAddr: []byte("bob"), Value: big.NewInt(70)},
+		}
+		results, err := ts.ProcessTxsBatch(txs, txSimData.SimulationOptions{WithStateDiff: true})
+		require.Nil(t, err)
+		require.Equal(t, 3, len(results.Results))
+		require.Equal(t, transaction.TxStatusSuccess, results.Results[0].Status)
+		require.Equal(t, transaction.TxStatusSuccess, results.Results[1].Status)
+		require.Equal(t, transaction.TxStatusFail, results.Results[2].Status)
+		require.NotEmpty(t, results.Results[0].Hash)
+
+		expectedStateDiff := []*txSimData.AccountStateDiff{
+			{Address: hex.EncodeToString([]byte("alice")), BalanceBefore: "100", BalanceAfter: "30", NonceBefore: 0, NonceAfter: 1},
+			{Address: hex.EncodeToString([]byte("bob")), BalanceBefore: "0", BalanceAfter: "40", NonceBefore: 0, NonceAfter: 1},
+			{Address: hex.EncodeToString([]byte("carol")), BalanceBefore: "0", BalanceAfter: "30", NonceBefore: 0, NonceAfter: 0},
+		}
+		require.Equal(t, expectedStateDiff, results.StateDiff)
+		require.Equal(t, big.NewInt(100), alice.GetBalance())
+
+		expectedSecondTxStateDiff := []*txSimData.AccountStateDiff{
+			{Address: hex.EncodeToString([]byte("bob")), BalanceBefore: "70", BalanceAfter: "40", NonceBefore: 0, NonceAfter: 1},
+			{Address: hex.EncodeToString([]byte("carol")), BalanceBefore: "0", BalanceAfter: "30", NonceBefore: 0, NonceAfter: 0},
+		}
+		require.Equal(t, expectedSecondTxStateDiff, results.Results[1].StateDiff)
+		require.Empty(t, results.Results[2].StateDiff)
+
+		_, err = accounts.GetExistingAccount([]byte("bob"))
+		require.Equal(t, state.ErrAccNotFound, err)
+	})
+	t.Run("deployed contract should be callable by the next transactions", func(t *testing.T) {
+		t.Parallel()
+
+		accounts := createCopyOnWriteAccountsDB(map[string]state.UserAccountHandler{})
+		errContractNotFound := errors.New("contract not found")
+
+		deployOrCall := func(tx *transaction.Transaction) error {
+			contract, _ := accounts.LoadAccount(tx.RcvAddr)
+			contractAccount := contract.(state.UserAccountHandler)
+			if string(tx.Data) == "deploy" {
+				contractAccount.SetCode([]byte("contract code"))
+				return accounts.SaveAccount(contract)
+			}
+
+			code := accounts.GetCode(contractAccount.GetCodeHash())
+			if len(code) == 0 {
+				return errContractNotFound
+			}
+
+			return nil
+		}
+
+		args := getTxSimulatorArgs()
+		args.Accounts = accounts
+		args.TransactionProcessor = &testscommon.TxProcessorStub{
+			ProcessTransactionCalled: func(tx *transaction.Transaction) (vmcommon.ReturnCode, error) {
+				return vmcommon.Ok, deployOrCall(tx)
+			},
+		}
+		ts, _ := NewTransactionSimulator(args)
+
+		txs := []*transaction.Transaction{
+			{Nonce: 0, SndAddr: []byte("alice"), RcvAddr: []byte("contract"), Data: []byte("deploy")},
+			{Nonce: 1, SndAddr: []byte("alice"), RcvAddr: []byte("contract"), Data: []byte("call")},
+			{Nonce: 2, SndAddr: []byte("alice"), RcvAddr: []byte("other contract"), Data: []byte("call")},
+		}
+		results, err := ts.ProcessTxsBatch(txs, txSimData.SimulationOptions{})
+		require.Nil(t, err)
+		require.Equal(t, 3, len(results.Results))
+		require.Equal(t, transaction.TxStatusSuccess, results.Results[0].Status)
+		require.Equal(t, transaction.TxStatusSuccess, results.Results[1].Status)
+		require.Equal(t, transaction.TxStatusFail, results.Results[2].Status)
+
+		codeHash := (&hashingMocks.HasherMock{}).Compute("contract code")
+		require.Nil(t, accounts.GetCode(codeHash))
+	})
+}
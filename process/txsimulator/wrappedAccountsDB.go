@@ -2,39 +2,210 @@ package txsimulator
 
 import (
 	"context"
+	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/state"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 )
 
-// readOnlyAccountsDB is a wrapper over an accounts db which works read-only. write operation are disabled
+// readOnlyAccountsDB is a wrapper over an accounts db which works read-only. write operation are disabled, unless
+// the copy-on-write mode is started: in this mode, the saved accounts and their new code are kept in memory, so the
+// subsequent operations see them, until the mode is stopped
 type readOnlyAccountsDB struct {
 	originalAccounts state.AccountsAdapter
+	marshalizer      marshal.Marshalizer
+	hasher           hashing.Hasher
+
+	mutCopyOnWrite     sync.RWMutex
+	isCopyOnWriteMode  bool
+	savedAccounts      map[string]vmcommon.AccountHandler
+	savedAccountsOrder [][]byte
+	savedCodes         map[string][]byte
+	journal            []*savedAccountEntry
+	stateChanges       state.StateChangesCollector
+}
+
+// savedAccountEntry records the account saved at an address before a save operation, so the operation can be reverted
+type savedAccountEntry struct {
+	address         []byte
+	previousAccount vmcommon.AccountHandler
+}
+
+// accountWithNewCode defines an account on which a new code can be set
+type accountWithNewCode interface {
+	HasNewCode() bool
+	GetCode() []byte
+	SetCodeHash([]byte)
 }
 
 // NewReadOnlyAccountsDB returns a new instance of readOnlyAccountsDB
-func NewReadOnlyAccountsDB(accountsDB state.AccountsAdapter) (*readOnlyAccountsDB, error) {
+func NewReadOnlyAccountsDB(
+	accountsDB state.AccountsAdapter,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+) (*readOnlyAccountsDB, error) {
 	if check.IfNil(accountsDB) {
 		return nil, ErrNilAccountsAdapter
 	}
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+
+	return &readOnlyAccountsDB{
+		originalAccounts: accountsDB,
+		marshalizer:      marshalizer,
+		hasher:           hasher,
+		stateChanges:     state.NewStateChangesCollector(),
+	}, nil
+}
+
+// StartCopyOnWrite starts keeping in memory the saved accounts, discarding the previously kept ones
+func (r *readOnlyAccountsDB) StartCopyOnWrite() {
+	r.mutCopyOnWrite.Lock()
+	defer r.mutCopyOnWrite.Unlock()
+
+	r.isCopyOnWriteMode = true
+	r.resetSavedAccounts()
+}
+
+// StopCopyOnWrite stops the copy-on-write mode and discards the accounts kept in memory
+func (r *readOnlyAccountsDB) StopCopyOnWrite() {
+	r.mutCopyOnWrite.Lock()
+	defer r.mutCopyOnWrite.Unlock()
+
+	r.isCopyOnWriteMode = false
+	r.resetSavedAccounts()
+}
+
+func (r *readOnlyAccountsDB) resetSavedAccounts() {
+	r.savedAccounts = make(map[string]vmcommon.AccountHandler)
+	r.savedAccountsOrder = make([][]byte, 0)
+	r.savedCodes = make(map[string][]byte)
+	r.journal = make([]*savedAccountEntry, 0)
+	r.stateChanges.Reset()
+}
+
+// ResetStateChanges discards the state changes recorded so far in the copy-on-write mode
+func (r *readOnlyAccountsDB) ResetStateChanges() {
+	r.stateChanges.Reset()
+}
+
+// GetStateChanges returns the state changes recorded in the copy-on-write mode since the last reset
+func (r *readOnlyAccountsDB) GetStateChanges() []*state.AccountStateChanges {
+	return r.stateChanges.GetStateChanges()
+}
+
+// GetSavedAccounts returns copies of the accounts saved since the copy-on-write mode was started, in the order in
+// which they were first saved
+func (r *readOnlyAccountsDB) GetSavedAccounts() ([]vmcommon.AccountHandler, error) {
+	r.mutCopyOnWrite.RLock()
+	defer r.mutCopyOnWrite.RUnlock()
+
+	accounts := make([]vmcommon.AccountHandler, 0, len(r.savedAccountsOrder))
+	for _, address := range r.savedAccountsOrder {
+		account, found := r.savedAccounts[string(address)]
+		if !found {
+			// the save operation was reverted
+			continue
+		}
+
+		accountCopy, err := r.copyAccount(account)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, accountCopy)
+	}
 
-	return &readOnlyAccountsDB{originalAccounts: accountsDB}, nil
+	return accounts, nil
+}
+
+// GetOriginalAccount returns the account from the wrapped accounts db, ignoring the accounts kept in memory
+func (r *readOnlyAccountsDB) GetOriginalAccount(address []byte) (vmcommon.AccountHandler, error) {
+	return r.originalAccounts.GetExistingAccount(address)
+}
+
+// getSavedAccountCopy returns a copy of the account kept in memory, so the changes made on it are visible only after
+// it gets saved again
+func (r *readOnlyAccountsDB) getSavedAccountCopy(address []byte) (vmcommon.AccountHandler, bool, error) {
+	r.mutCopyOnWrite.RLock()
+	defer r.mutCopyOnWrite.RUnlock()
+
+	if !r.isCopyOnWriteMode {
+		return nil, false, nil
+	}
+
+	account, found := r.savedAccounts[string(address)]
+	if !found {
+		return nil, false, nil
+	}
+
+	accountCopy, err := r.copyAccount(account)
+
+	return accountCopy, true, err
+}
+
+// copyAccount creates a copy of the account, including its not yet committed data trie changes. The code set on
+// the account is not copied, but it is kept by SaveAccount and can be fetched by its hash
+func (r *readOnlyAccountsDB) copyAccount(account vmcommon.AccountHandler) (vmcommon.AccountHandler, error) {
+	accountBytes, err := r.marshalizer.Marshal(account)
+	if err != nil {
+		return nil, err
+	}
+
+	accountCopy, err := r.originalAccounts.GetAccountFromBytes(account.AddressBytes(), accountBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return accountCopy, nil
+	}
+	userAccountCopy, ok := accountCopy.(state.UserAccountHandler)
+	if !ok {
+		return accountCopy, nil
+	}
+
+	dirtyDataCopy := userAccountCopy.DataTrieTracker().DirtyData()
+	for key, value := range userAccount.DataTrieTracker().DirtyData() {
+		dirtyDataCopy[key] = append([]byte{}, value...)
+	}
+
+	return accountCopy, nil
 }
 
 // StartSnapshotIfNeeded does nothing for this implementation
 func (r *readOnlyAccountsDB) StartSnapshotIfNeeded() {
 }
 
-// GetCode returns the code for the given account
+// GetCode returns the code for the given code hash, looking first at the code saved in the copy-on-write mode
 func (r *readOnlyAccountsDB) GetCode(codeHash []byte) []byte {
+	r.mutCopyOnWrite.RLock()
+	code, found := r.savedCodes[string(codeHash)]
+	r.mutCopyOnWrite.RUnlock()
+	if found {
+		return code
+	}
+
 	return r.originalAccounts.GetCode(codeHash)
 }
 
-// GetExistingAccount will call the original accounts' function with the same name
+// GetExistingAccount will call the original accounts' function with the same name, unless the account was saved in the
+// copy-on-write mode
 func (r *readOnlyAccountsDB) GetExistingAccount(address []byte) (vmcommon.AccountHandler, error) {
+	account, found, err := r.getSavedAccountCopy(address)
+	if found {
+		return account, err
+	}
+
 	return r.originalAccounts.GetExistingAccount(address)
 }
 
@@ -43,16 +214,94 @@ func (r *readOnlyAccountsDB) GetAccountFromBytes(address []byte, accountBytes []
 	return r.originalAccounts.GetAccountFromBytes(address, accountBytes)
 }
 
-// LoadAccount will call the original accounts' function with the same name
+// LoadAccount will call the original accounts' function with the same name, unless the account was saved in the
+// copy-on-write mode
 func (r *readOnlyAccountsDB) LoadAccount(address []byte) (vmcommon.AccountHandler, error) {
+	account, found, err := r.getSavedAccountCopy(address)
+	if found {
+		return account, err
+	}
+
 	return r.originalAccounts.LoadAccount(address)
 }
 
-// SaveAccount won't do anything as write operations are disabled on this component
-func (r *readOnlyAccountsDB) SaveAccount(_ vmcommon.AccountHandler) error {
+// SaveAccount won't do anything as write operations are disabled on this component, unless the copy-on-write mode is
+// started. In this mode, a copy of the account and its new code, if any, are kept in memory
+func (r *readOnlyAccountsDB) SaveAccount(account vmcommon.AccountHandler) error {
+	r.mutCopyOnWrite.Lock()
+	defer r.mutCopyOnWrite.Unlock()
+
+	if !r.isCopyOnWriteMode || check.IfNil(account) {
+		return nil
+	}
+
+	r.saveNewCode(account)
+	accountCopy, err := r.copyAccount(account)
+	if err != nil {
+		return err
+	}
+
+	address := account.AddressBytes()
+	previousAccount, found := r.savedAccounts[string(address)]
+	if !found {
+		r.savedAccountsOrder = append(r.savedAccountsOrder, address)
+	}
+	r.recordStateChanges(previousAccount, account)
+	r.journal = append(r.journal, &savedAccountEntry{
+		address:         address,
+		previousAccount: previousAccount,
+	})
+	r.savedAccounts[string(address)] = accountCopy
+
 	return nil
 }
 
+// saveNewCode keeps the new code of the account, as the accounts db would do on save, and sets its hash on the account
+func (r *readOnlyAccountsDB) saveNewCode(account vmcommon.AccountHandler) {
+	accountWithCode, ok := account.(accountWithNewCode)
+	if !ok || !accountWithCode.HasNewCode() {
+		return
+	}
+
+	code := accountWithCode.GetCode()
+	var codeHash []byte
+	if len(code) != 0 {
+		codeHash = r.hasher.Compute(string(code))
+		r.savedCodes[string(codeHash)] = code
+	}
+	accountWithCode.SetCodeHash(codeHash)
+}
+
+// recordStateChanges records the changes of a saved account against its previously saved copy or, if none, against
+// the account from the wrapped accounts db
+func (r *readOnlyAccountsDB) recordStateChanges(previousAccount vmcommon.AccountHandler, account vmcommon.AccountHandler) {
+	accountBefore := previousAccount
+	if check.IfNil(accountBefore) {
+		originalAccount, err := r.originalAccounts.GetExistingAccount(account.AddressBytes())
+		if err == nil {
+			accountBefore = originalAccount
+		}
+	}
+
+	journalIndex := len(r.journal)
+	r.stateChanges.AddAccountChange(journalIndex, accountBefore, account)
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return
+	}
+	userAccountBefore, _ := accountBefore.(state.UserAccountHandler)
+	for key := range userAccount.DataTrieTracker().DirtyData() {
+		var valueBefore []byte
+		if !check.IfNil(userAccountBefore) {
+			valueBefore, _ = userAccountBefore.RetrieveValueFromDataTrieTracker([]byte(key))
+		}
+		valueAfter, _ := userAccount.RetrieveValueFromDataTrieTracker([]byte(key))
+
+		r.stateChanges.AddDataTrieChange(journalIndex, account.AddressBytes(), []byte(key), valueBefore, valueAfter)
+	}
+}
+
 // RemoveAccount won't do anything as write operations are disabled on this component
 func (r *readOnlyAccountsDB) RemoveAccount(_ []byte) error {
 	return nil
@@ -63,13 +312,44 @@ func (r *readOnlyAccountsDB) Commit() ([]byte, error) {
 	return nil, nil
 }
 
-// JournalLen will call the original accounts' function with the same name
+// JournalLen will call the original accounts' function with the same name, unless the copy-on-write mode is started.
+// In this mode, the number of save operations is returned
 func (r *readOnlyAccountsDB) JournalLen() int {
+	r.mutCopyOnWrite.RLock()
+	defer r.mutCopyOnWrite.RUnlock()
+
+	if r.isCopyOnWriteMode {
+		return len(r.journal)
+	}
+
 	return r.originalAccounts.JournalLen()
 }
 
-// RevertToSnapshot won't do anything as write operations are disabled on this component
-func (r *readOnlyAccountsDB) RevertToSnapshot(_ int) error {
+// RevertToSnapshot won't do anything as write operations are disabled on this component, unless the copy-on-write
+// mode is started. In this mode, the save operations done after the snapshot are reverted
+func (r *readOnlyAccountsDB) RevertToSnapshot(snapshot int) error {
+	r.mutCopyOnWrite.Lock()
+	defer r.mutCopyOnWrite.Unlock()
+
+	if !r.isCopyOnWriteMode {
+		return nil
+	}
+	if snapshot < 0 || snapshot > len(r.journal) {
+		return state.ErrSnapshotValueOutOfBounds
+	}
+
+	for i := len(r.journal) - 1; i >= snapshot; i-- {
+		entry := r.journal[i]
+		if check.IfNil(entry.previousAccount) {
+			delete(r.savedAccounts, string(entry.address))
+			continue
+		}
+
+		r.savedAccounts[string(entry.address)] = entry.previousAccount
+	}
+	r.journal = r.journal[:snapshot]
+	r.stateChanges.RevertToIndex(snapshot)
+
 	return nil
 }
 
@@ -2,13 +2,16 @@ package txsimulator
 
 import (
 	"context"
+	"math/big"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/process/mock"
 	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
 	stateMock "github.com/ElrondNetwork/elrond-go/testscommon/state"
 	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
 	"github.com/stretchr/testify/require"
@@ -17,15 +20,31 @@ import (
 func TestNewReadOnlyAccountsDB_NilOriginalAccountsDBShouldErr(t *testing.T) {
 	t.Parallel()
 
-	roAccDb, err := NewReadOnlyAccountsDB(nil)
+	roAccDb, err := NewReadOnlyAccountsDB(nil, &mock.MarshalizerMock{}, &hashingMocks.HasherMock{})
 	require.True(t, check.IfNil(roAccDb))
 	require.Equal(t, ErrNilAccountsAdapter, err)
 }
 
+func TestNewReadOnlyAccountsDB_NilMarshalizerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	roAccDb, err := NewReadOnlyAccountsDB(&stateMock.AccountsStub{}, nil, &hashingMocks.HasherMock{})
+	require.True(t, check.IfNil(roAccDb))
+	require.Equal(t, ErrNilMarshalizer, err)
+}
+
+func TestNewReadOnlyAccountsDB_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	roAccDb, err := NewReadOnlyAccountsDB(&stateMock.AccountsStub{}, &mock.MarshalizerMock{}, nil)
+	require.True(t, check.IfNil(roAccDb))
+	require.Equal(t, ErrNilHasher, err)
+}
+
 func TestNewReadOnlyAccountsDB(t *testing.T) {
 	t.Parallel()
 
-	roAccDb, err := NewReadOnlyAccountsDB(&stateMock.AccountsStub{})
+	roAccDb, err := NewReadOnlyAccountsDB(&stateMock.AccountsStub{}, &mock.MarshalizerMock{}, &hashingMocks.HasherMock{})
 	require.False(t, check.IfNil(roAccDb))
 	require.NoError(t, err)
 }
@@ -73,7 +92,7 @@ func TestReadOnlyAccountsDB_WriteOperationsShouldNotCalled(t *testing.T) {
 		},
 	}
 
-	roAccDb, _ := NewReadOnlyAccountsDB(accDb)
+	roAccDb, _ := NewReadOnlyAccountsDB(accDb, &mock.MarshalizerMock{}, &hashingMocks.HasherMock{})
 	require.NotNil(t, roAccDb)
 
 	err := roAccDb.SaveAccount(nil)
@@ -128,7 +147,7 @@ func TestReadOnlyAccountsDB_ReadOperationsShouldWork(t *testing.T) {
 		},
 	}
 
-	roAccDb, _ := NewReadOnlyAccountsDB(accDb)
+	roAccDb, _ := NewReadOnlyAccountsDB(accDb, &mock.MarshalizerMock{}, &hashingMocks.HasherMock{})
 	require.NotNil(t, roAccDb)
 
 	actualAcc, err := roAccDb.GetExistingAccount(nil)
@@ -153,3 +172,156 @@ func TestReadOnlyAccountsDB_ReadOperationsShouldWork(t *testing.T) {
 	err = roAccDb.GetAllLeaves(allLeaves, context.Background(), nil)
 	require.NoError(t, err)
 }
+
+func createCopyOnWriteAccountsDB(originalAccounts map[string]state.UserAccountHandler) *readOnlyAccountsDB {
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	getAccountFromBytes := func(address []byte, accountBytes []byte) (vmcommon.AccountHandler, error) {
+		account, _ := state.NewUserAccount(address)
+		err := marshalizer.Unmarshal(account, accountBytes)
+
+		return account, err
+	}
+	// as the accounts db does, each get operation returns a new instance of the account
+	getOriginalAccount := func(address []byte) (vmcommon.AccountHandler, bool) {
+		account, found := originalAccounts[string(address)]
+		if !found {
+			return nil, false
+		}
+
+		accountBytes, _ := marshalizer.Marshal(account)
+		accountCopy, _ := getAccountFromBytes(address, accountBytes)
+
+		return accountCopy, true
+	}
+
+	accDb := &stateMock.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			account, found := getOriginalAccount(address)
+			if !found {
+				return nil, state.ErrAccNotFound
+			}
+
+			return account, nil
+		},
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			account, found := getOriginalAccount(address)
+			if !found {
+				return state.NewUserAccount(address)
+			}
+
+			return account, nil
+		},
+		GetAccountFromBytesCalled: getAccountFromBytes,
+		JournalLenCalled: func() int {
+			return 0
+		},
+		GetCodeCalled: func(_ []byte) []byte {
+			return nil
+		},
+	}
+
+	roAccDb, _ := NewReadOnlyAccountsDB(accDb, marshalizer, &hashingMocks.HasherMock{})
+
+	return roAccDb
+}
+
+func TestReadOnlyAccountsDB_CopyOnWrite(t *testing.T) {
+	t.Parallel()
+
+	alice, _ := state.NewUserAccount([]byte("alice"))
+	_ = alice.AddToBalance(big.NewInt(100))
+	roAccDb := createCopyOnWriteAccountsDB(map[string]state.UserAccountHandler{"alice": alice})
+
+	t.Run("save outside the copy-on-write mode should not change anything", func(t *testing.T) {
+		account, _ := roAccDb.LoadAccount([]byte("alice"))
+		_ = account.(state.UserAccountHandler).AddToBalance(big.NewInt(10))
+		require.Nil(t, roAccDb.SaveAccount(account))
+
+		savedAccounts, err := roAccDb.GetSavedAccounts()
+		require.Nil(t, err)
+		require.Empty(t, savedAccounts)
+	})
+
+	roAccDb.StartCopyOnWrite()
+
+	account, _ := roAccDb.LoadAccount([]byte("alice"))
+	userAccount := account.(state.UserAccountHandler)
+	_ = userAccount.AddToBalance(big.NewInt(-40))
+	_ = userAccount.DataTrieTracker().SaveKeyValue([]byte("key"), []byte("value"))
+	require.Nil(t, roAccDb.SaveAccount(account))
+	require.Equal(t, big.NewInt(100), alice.GetBalance())
+
+	snapshot := roAccDb.JournalLen()
+	require.Equal(t, 1, snapshot)
+
+	account, _ = roAccDb.LoadAccount([]byte("alice"))
+	require.Equal(t, big.NewInt(60), account.(state.UserAccountHandler).GetBalance())
+	value, _ := account.(state.UserAccountHandler).RetrieveValueFromDataTrieTracker([]byte("key"))
+	require.Equal(t, []byte("value"), value)
+
+	_ = account.(state.UserAccountHandler).AddToBalance(big.NewInt(-60))
+	require.Nil(t, roAccDb.SaveAccount(account))
+
+	bob, _ := roAccDb.LoadAccount([]byte("bob"))
+	_ = bob.(state.UserAccountHandler).AddToBalance(big.NewInt(60))
+	require.Nil(t, roAccDb.SaveAccount(bob))
+	require.Equal(t, 3, roAccDb.JournalLen())
+
+	require.Equal(t, state.ErrSnapshotValueOutOfBounds, roAccDb.RevertToSnapshot(4))
+	require.Nil(t, roAccDb.RevertToSnapshot(snapshot))
+
+	account, _ = roAccDb.GetExistingAccount([]byte("alice"))
+	require.Equal(t, big.NewInt(60), account.(state.UserAccountHandler).GetBalance())
+	_, err := roAccDb.GetExistingAccount([]byte("bob"))
+	require.Equal(t, state.ErrAccNotFound, err)
+
+	savedAccounts, err := roAccDb.GetSavedAccounts()
+	require.Nil(t, err)
+	require.Equal(t, 1, len(savedAccounts))
+	require.Equal(t, []byte("alice"), savedAccounts[0].AddressBytes())
+
+	original, _ := roAccDb.GetOriginalAccount([]byte("alice"))
+	require.Equal(t, big.NewInt(100), original.(state.UserAccountHandler).GetBalance())
+
+	expectedStateChanges := []*state.AccountStateChanges{
+		{
+			Address:       []byte("alice"),
+			BalanceBefore: big.NewInt(100),
+			BalanceAfter:  big.NewInt(60),
+			DataTrieChanges: []*state.DataTrieChange{
+				{Key: []byte("key"), After: []byte("value")},
+			},
+		},
+	}
+	require.Equal(t, expectedStateChanges, roAccDb.GetStateChanges())
+	roAccDb.ResetStateChanges()
+	require.Empty(t, roAccDb.GetStateChanges())
+
+	roAccDb.StopCopyOnWrite()
+
+	account, _ = roAccDb.LoadAccount([]byte("alice"))
+	require.Equal(t, big.NewInt(100), account.(state.UserAccountHandler).GetBalance())
+	require.Equal(t, 0, roAccDb.JournalLen())
+}
+
+func TestReadOnlyAccountsDB_CopyOnWriteShouldKeepTheNewCode(t *testing.T) {
+	t.Parallel()
+
+	roAccDb := createCopyOnWriteAccountsDB(map[string]state.UserAccountHandler{})
+	code := []byte("contract code")
+	codeHash := (&hashingMocks.HasherMock{}).Compute(string(code))
+
+	roAccDb.StartCopyOnWrite()
+
+	account, _ := roAccDb.LoadAccount([]byte("contract"))
+	account.(state.UserAccountHandler).SetCode(code)
+	require.Nil(t, roAccDb.SaveAccount(account))
+
+	account, _ = roAccDb.LoadAccount([]byte("contract"))
+	require.Equal(t, codeHash, account.(state.UserAccountHandler).GetCodeHash())
+	require.Equal(t, code, roAccDb.GetCode(codeHash))
+
+	roAccDb.StopCopyOnWrite()
+
+	require.Nil(t, roAccDb.GetCode(codeHash))
+}
@@ -84,6 +84,7 @@ type AccountsDB struct {
 	shouldSerializeSnapshots bool
 	loadCodeMeasurements     *loadingMeasurements
 	processStatusHandler     common.ProcessStatusHandler
+	stateChangesCollector    StateChangesCollector
 
 	stackDebug []byte
 }
@@ -100,6 +101,7 @@ type ArgsAccountsDB struct {
 	ProcessingMode           common.NodeProcessingMode
 	ShouldSerializeSnapshots bool
 	ProcessStatusHandler     common.ProcessStatusHandler
+	StateChangesCollector    StateChangesCollector
 }
 
 // NewAccountsDB creates a new account manager
@@ -126,6 +128,7 @@ func NewAccountsDB(args ArgsAccountsDB) (*AccountsDB, error) {
 		shouldSerializeSnapshots: args.ShouldSerializeSnapshots,
 		lastSnapshot:             &snapshotInfo{},
 		processStatusHandler:     args.ProcessStatusHandler,
+		stateChangesCollector:    args.StateChangesCollector,
 	}
 
 	return adb, nil
@@ -150,6 +153,9 @@ func checkArgsAccountsDB(args ArgsAccountsDB) error {
 	if check.IfNil(args.ProcessStatusHandler) {
 		return ErrNilProcessStatusHandler
 	}
+	if check.IfNil(args.StateChangesCollector) {
+		return ErrNilStateChangesCollector
+	}
 
 	return nil
 }
@@ -266,6 +272,8 @@ func (adb *AccountsDB) SaveAccount(account vmcommon.AccountHandler) error {
 		return err
 	}
 
+	adb.stateChangesCollector.AddAccountChange(len(adb.entries), oldAccount, account)
+
 	var entry JournalEntry
 	if check.IfNil(oldAccount) {
 		entry, err = NewJournalEntryAccountCreation(account.AddressBytes(), adb.mainTrie)
@@ -495,6 +503,7 @@ func (adb *AccountsDB) saveDataTrie(accountHandler baseAccountHandler) error {
 	trackableDataTrie := accountHandler.DataTrieTracker()
 	dataTrie := trackableDataTrie.DataTrie()
 	oldValues := make(map[string][]byte)
+	journalIndex := len(adb.entries)
 
 	for k, v := range trackableDataTrie.DirtyData() {
 		val, err := dataTrie.Get([]byte(k))
@@ -503,6 +512,13 @@ func (adb *AccountsDB) saveDataTrie(accountHandler baseAccountHandler) error {
 		}
 
 		oldValues[k] = val
+		adb.stateChangesCollector.AddDataTrieChange(
+			journalIndex,
+			accountHandler.AddressBytes(),
+			[]byte(k),
+			trimDataTrieValue(val, []byte(k), accountHandler.AddressBytes()),
+			trimDataTrieValue(v, []byte(k), accountHandler.AddressBytes()),
+		)
 
 		err = dataTrie.Update([]byte(k), v)
 		if err != nil {
@@ -805,6 +821,8 @@ func (adb *AccountsDB) RevertToSnapshot(snapshot int) error {
 		return ErrSnapshotValueOutOfBounds
 	}
 
+	adb.stateChangesCollector.RevertToIndex(snapshot)
+
 	if snapshot == 0 {
 		log.Trace("revert snapshot to adb.lastRootHash", "hash", adb.lastRootHash)
 		return adb.recreateTrie(holders.NewRootHashHolder(adb.lastRootHash, core.OptionalUint32{}))
@@ -870,6 +888,7 @@ func (adb *AccountsDB) Commit() ([]byte, error) {
 func (adb *AccountsDB) commit() ([]byte, error) {
 	log.Trace("accountsDB.Commit started")
 	adb.entries = make([]JournalEntry, 0)
+	adb.stateChangesCollector.Reset()
 
 	oldHashes := make(common.ModifiedHashes)
 	newHashes := make(common.ModifiedHashes)
@@ -997,6 +1016,7 @@ func (adb *AccountsDB) recreateTrie(options common.RootHashHolder) error {
 	adb.obsoleteDataTrieHashes = make(map[string][][]byte)
 	adb.dataTries.Reset()
 	adb.entries = make([]JournalEntry, 0)
+	adb.stateChangesCollector.Reset()
 	newTrie, err := adb.mainTrie.RecreateFromEpoch(options)
 	if err != nil {
 		return err
@@ -0,0 +1,122 @@
+package state
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+type accountsDBWithHistoricalView struct {
+	AccountsAdapter
+	accountsRepository AccountsRepository
+
+	mutOptions sync.RWMutex
+	options    *api.AccountQueryOptions
+}
+
+// NewAccountsDBWithHistoricalView creates a wrapper over an accounts adapter which can serve, on request, the accounts
+// as they were at a past root hash. The historical accounts are fetched through the provided accounts repository
+func NewAccountsDBWithHistoricalView(innerAccountsAdapter AccountsAdapter, accountsRepository AccountsRepository) (*accountsDBWithHistoricalView, error) {
+	if check.IfNil(innerAccountsAdapter) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if check.IfNil(accountsRepository) {
+		return nil, ErrNilAccountsRepository
+	}
+
+	return &accountsDBWithHistoricalView{
+		AccountsAdapter:    innerAccountsAdapter,
+		accountsRepository: accountsRepository,
+	}, nil
+}
+
+// SetHistoricalView makes the accounts to be read at the root hash from the provided options, until the view is reset
+func (accountsDB *accountsDBWithHistoricalView) SetHistoricalView(options api.AccountQueryOptions) error {
+	if len(options.BlockRootHash) == 0 {
+		return ErrNilRootHash
+	}
+
+	accountsDB.mutOptions.Lock()
+	accountsDB.options = &options
+	accountsDB.mutOptions.Unlock()
+
+	return nil
+}
+
+// ResetHistoricalView makes the accounts to be read again from the wrapped accounts adapter
+func (accountsDB *accountsDBWithHistoricalView) ResetHistoricalView() {
+	accountsDB.mutOptions.Lock()
+	accountsDB.options = nil
+	accountsDB.mutOptions.Unlock()
+}
+
+func (accountsDB *accountsDBWithHistoricalView) getOptions() (api.AccountQueryOptions, bool) {
+	accountsDB.mutOptions.RLock()
+	defer accountsDB.mutOptions.RUnlock()
+
+	if accountsDB.options == nil {
+		return api.AccountQueryOptions{}, false
+	}
+
+	return *accountsDB.options, true
+}
+
+// GetExistingAccount returns the account at the historical root hash, if set, otherwise it calls the wrapped
+// accounts adapter
+func (accountsDB *accountsDBWithHistoricalView) GetExistingAccount(address []byte) (vmcommon.AccountHandler, error) {
+	options, isSet := accountsDB.getOptions()
+	if !isSet {
+		return accountsDB.AccountsAdapter.GetExistingAccount(address)
+	}
+
+	account, _, err := accountsDB.accountsRepository.GetAccountWithBlockInfo(address, options)
+	var accountNotFoundErr *ErrAccountNotFoundAtBlock
+	if errors.As(err, &accountNotFoundErr) {
+		return nil, ErrAccNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+// LoadAccount returns the account at the historical root hash, if set, or a new account if it did not exist at that
+// root hash. If the historical view is not set, it calls the wrapped accounts adapter
+func (accountsDB *accountsDBWithHistoricalView) LoadAccount(address []byte) (vmcommon.AccountHandler, error) {
+	_, isSet := accountsDB.getOptions()
+	if !isSet {
+		return accountsDB.AccountsAdapter.LoadAccount(address)
+	}
+
+	account, err := accountsDB.GetExistingAccount(address)
+	if err == ErrAccNotFound {
+		return NewUserAccount(address)
+	}
+
+	return account, err
+}
+
+// GetCode returns the code at the historical root hash, if set, otherwise it calls the wrapped accounts adapter
+func (accountsDB *accountsDBWithHistoricalView) GetCode(codeHash []byte) []byte {
+	options, isSet := accountsDB.getOptions()
+	if !isSet {
+		return accountsDB.AccountsAdapter.GetCode(codeHash)
+	}
+
+	code, _, err := accountsDB.accountsRepository.GetCodeWithBlockInfo(codeHash, options)
+	if err != nil {
+		log.Debug("accountsDBWithHistoricalView.GetCode", "code hash", codeHash, "error", err)
+		return nil
+	}
+
+	return code
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (accountsDB *accountsDBWithHistoricalView) IsInterfaceNil() bool {
+	return accountsDB == nil
+}
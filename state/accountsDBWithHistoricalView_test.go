@@ -0,0 +1,114 @@
+package state_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/common/holders"
+	"github.com/ElrondNetwork/elrond-go/state"
+	mockState "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAccountsDBWithHistoricalView(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil accounts adapter should error", func(t *testing.T) {
+		t.Parallel()
+
+		accountsDB, err := state.NewAccountsDBWithHistoricalView(nil, &mockState.AccountsRepositoryStub{})
+		require.True(t, check.IfNil(accountsDB))
+		require.Equal(t, state.ErrNilAccountsAdapter, err)
+	})
+	t.Run("nil accounts repository should error", func(t *testing.T) {
+		t.Parallel()
+
+		accountsDB, err := state.NewAccountsDBWithHistoricalView(&mockState.AccountsStub{}, nil)
+		require.True(t, check.IfNil(accountsDB))
+		require.Equal(t, state.ErrNilAccountsRepository, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		accountsDB, err := state.NewAccountsDBWithHistoricalView(&mockState.AccountsStub{}, &mockState.AccountsRepositoryStub{})
+		require.False(t, check.IfNil(accountsDB))
+		require.Nil(t, err)
+	})
+}
+
+func TestAccountsDBWithHistoricalView_SetHistoricalViewWithoutRootHashShouldErr(t *testing.T) {
+	t.Parallel()
+
+	accountsDB, _ := state.NewAccountsDBWithHistoricalView(&mockState.AccountsStub{}, &mockState.AccountsRepositoryStub{})
+
+	err := accountsDB.SetHistoricalView(api.AccountQueryOptions{})
+	require.Equal(t, state.ErrNilRootHash, err)
+}
+
+func TestAccountsDBWithHistoricalView_ShouldReadAtTheHistoricalRootHash(t *testing.T) {
+	t.Parallel()
+
+	historicalRootHash := []byte("historical root hash")
+	createAccount := func(address []byte, balance int64) vmcommon.AccountHandler {
+		account, _ := state.NewUserAccount(address)
+		_ = account.AddToBalance(big.NewInt(balance))
+
+		return account
+	}
+
+	accountsStub := &mockState.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return createAccount(address, 10), nil
+		},
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return createAccount(address, 10), nil
+		},
+		GetCodeCalled: func(_ []byte) []byte {
+			return []byte("current code")
+		},
+	}
+	repository := &mockState.AccountsRepositoryStub{
+		GetAccountWithBlockInfoCalled: func(address []byte, options api.AccountQueryOptions) (vmcommon.AccountHandler, common.BlockInfo, error) {
+			require.Equal(t, historicalRootHash, options.BlockRootHash)
+
+			blockInfo := holders.NewBlockInfo(nil, 0, options.BlockRootHash)
+			if string(address) == "bob" {
+				return nil, nil, state.NewErrAccountNotFoundAtBlock(blockInfo)
+			}
+
+			return createAccount(address, 5), blockInfo, nil
+		},
+		GetCodeWithBlockInfoCalled: func(codeHash []byte, options api.AccountQueryOptions) ([]byte, common.BlockInfo, error) {
+			return []byte("historical code"), nil, nil
+		},
+	}
+	accountsDB, _ := state.NewAccountsDBWithHistoricalView(accountsStub, repository)
+
+	err := accountsDB.SetHistoricalView(api.AccountQueryOptions{BlockRootHash: historicalRootHash})
+	require.Nil(t, err)
+
+	account, err := accountsDB.GetExistingAccount([]byte("alice"))
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(5), account.(state.UserAccountHandler).GetBalance())
+
+	_, err = accountsDB.GetExistingAccount([]byte("bob"))
+	require.Equal(t, state.ErrAccNotFound, err)
+
+	account, err = accountsDB.LoadAccount([]byte("bob"))
+	require.Nil(t, err)
+	require.Equal(t, []byte("bob"), account.AddressBytes())
+	require.Equal(t, big.NewInt(0), account.(state.UserAccountHandler).GetBalance())
+
+	require.Equal(t, []byte("historical code"), accountsDB.GetCode([]byte("code hash")))
+
+	accountsDB.ResetHistoricalView()
+
+	account, err = accountsDB.LoadAccount([]byte("bob"))
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(10), account.(state.UserAccountHandler).GetBalance())
+	require.Equal(t, []byte("current code"), accountsDB.GetCode([]byte("code hash")))
+}
@@ -0,0 +1,197 @@
+package state
+
+import (
+	"errors"
+	"math/big"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/data"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// AccountOverride holds the values that will replace the ones from the state of an account. The nil fields are not
+// overridden
+type AccountOverride struct {
+	Balance *big.Int
+	Nonce   *uint64
+	Code    []byte
+	Storage map[string][]byte
+}
+
+type accountsDBWithOverrides struct {
+	AccountsAdapter
+	hasher hashing.Hasher
+
+	mutOverrides sync.RWMutex
+	overrides    map[string]*AccountOverride
+	codes        map[string][]byte
+}
+
+// NewAccountsDBWithOverrides creates a wrapper over an accounts adapter which can replace, on request, the state of
+// some accounts. The overridden values are never written in the wrapped accounts adapter
+func NewAccountsDBWithOverrides(innerAccountsAdapter AccountsAdapter, hasher hashing.Hasher) (*accountsDBWithOverrides, error) {
+	if check.IfNil(innerAccountsAdapter) {
+		return nil, ErrNilAccountsAdapter
+	}
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+
+	return &accountsDBWithOverrides{
+		AccountsAdapter: innerAccountsAdapter,
+		hasher:          hasher,
+		overrides:       make(map[string]*AccountOverride),
+		codes:           make(map[string][]byte),
+	}, nil
+}
+
+// SetOverrides replaces the current overrides. The map is keyed by the address bytes of the overridden accounts
+func (accountsDB *accountsDBWithOverrides) SetOverrides(overrides map[string]*AccountOverride) error {
+	for address, override := range overrides {
+		if len(address) == 0 {
+			return ErrNilAddress
+		}
+		if override == nil {
+			continue
+		}
+		if override.Balance != nil && override.Balance.Sign() < 0 {
+			return ErrNegativeValue
+		}
+		for _, value := range override.Storage {
+			if uint64(len(value)) > core.MaxLeafSize {
+				return data.ErrLeafSizeTooBig
+			}
+		}
+	}
+
+	codes := make(map[string][]byte)
+	for _, override := range overrides {
+		if override == nil || override.Code == nil {
+			continue
+		}
+
+		codes[string(accountsDB.hasher.Compute(string(override.Code)))] = override.Code
+	}
+
+	accountsDB.mutOverrides.Lock()
+	accountsDB.overrides = overrides
+	accountsDB.codes = codes
+	accountsDB.mutOverrides.Unlock()
+
+	return nil
+}
+
+// ResetOverrides removes all the overrides
+func (accountsDB *accountsDBWithOverrides) ResetOverrides() {
+	accountsDB.mutOverrides.Lock()
+	accountsDB.overrides = make(map[string]*AccountOverride)
+	accountsDB.codes = make(map[string][]byte)
+	accountsDB.mutOverrides.Unlock()
+}
+
+func (accountsDB *accountsDBWithOverrides) getOverride(address []byte) (*AccountOverride, bool) {
+	accountsDB.mutOverrides.RLock()
+	defer accountsDB.mutOverrides.RUnlock()
+
+	override, found := accountsDB.overrides[string(address)]
+
+	return override, found && override != nil
+}
+
+// GetExistingAccount returns the account from the wrapped accounts adapter, with the overrides applied. An overridden
+// account is returned even if it does not exist in the wrapped accounts adapter
+func (accountsDB *accountsDBWithOverrides) GetExistingAccount(address []byte) (vmcommon.AccountHandler, error) {
+	override, found := accountsDB.getOverride(address)
+	if !found {
+		return accountsDB.AccountsAdapter.GetExistingAccount(address)
+	}
+
+	account, err := accountsDB.AccountsAdapter.GetExistingAccount(address)
+	if isAccountNotFoundError(err) {
+		account, err = accountsDB.AccountsAdapter.LoadAccount(address)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return accountsDB.applyOverride(account, override)
+}
+
+// isAccountNotFoundError returns true for the errors returned by the accounts adapters, including the API ones, when the
+// account does not exist
+func isAccountNotFoundError(err error) bool {
+	var accountNotFoundErr *ErrAccountNotFoundAtBlock
+
+	return errors.Is(err, ErrAccNotFound) || errors.As(err, &accountNotFoundErr)
+}
+
+// LoadAccount returns the account from the wrapped accounts adapter, with the overrides applied
+func (accountsDB *accountsDBWithOverrides) LoadAccount(address []byte) (vmcommon.AccountHandler, error) {
+	account, err := accountsDB.AccountsAdapter.LoadAccount(address)
+	if err != nil {
+		return nil, err
+	}
+
+	override, found := accountsDB.getOverride(address)
+	if !found {
+		return account, nil
+	}
+
+	return accountsDB.applyOverride(account, override)
+}
+
+func (accountsDB *accountsDBWithOverrides) applyOverride(account vmcommon.AccountHandler, override *AccountOverride) (vmcommon.AccountHandler, error) {
+	userAcc, ok := account.(*userAccount)
+	if !ok {
+		return account, nil
+	}
+
+	if override.Balance != nil {
+		userAcc.Balance = big.NewInt(0).Set(override.Balance)
+	}
+	if override.Nonce != nil {
+		userAcc.Nonce = *override.Nonce
+	}
+	if override.Code != nil {
+		userAcc.CodeHash = accountsDB.hasher.Compute(string(override.Code))
+	}
+
+	shouldSetEmptyDataTrie := (override.Code != nil || len(override.Storage) > 0) && check.IfNil(userAcc.DataTrie())
+	if shouldSetEmptyDataTrie {
+		// the missing keys of a new account should be read as empty values
+		emptyTrie, err := accountsDB.AccountsAdapter.GetTrie(make([]byte, 0))
+		if err != nil {
+			return nil, err
+		}
+		userAcc.SetDataTrie(emptyTrie)
+	}
+
+	for key, value := range override.Storage {
+		err := userAcc.DataTrieTracker().SaveKeyValue([]byte(key), append(make([]byte, 0, len(value)), value...))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return userAcc, nil
+}
+
+// GetCode returns the overridden code with the provided hash, if any, otherwise it calls the wrapped accounts adapter
+func (accountsDB *accountsDBWithOverrides) GetCode(codeHash []byte) []byte {
+	accountsDB.mutOverrides.RLock()
+	code, found := accountsDB.codes[string(codeHash)]
+	accountsDB.mutOverrides.RUnlock()
+	if found {
+		return code
+	}
+
+	return accountsDB.AccountsAdapter.GetCode(codeHash)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (accountsDB *accountsDBWithOverrides) IsInterfaceNil() bool {
+	return accountsDB == nil
+}
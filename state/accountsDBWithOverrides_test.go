@@ -0,0 +1,187 @@
+package state_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon/hashingMocks"
+	mockState "github.com/ElrondNetwork/elrond-go/testscommon/state"
+	"github.com/ElrondNetwork/elrond-go/testscommon/trie"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+	"github.com/stretchr/testify/require"
+)
+
+func createAccountsStubForOverrides(existingAccounts map[string]*big.Int) *mockState.AccountsStub {
+	createAccount := func(address []byte) vmcommon.AccountHandler {
+		account, _ := state.NewUserAccount(address)
+		balance, found := existingAccounts[string(address)]
+		if found {
+			_ = account.AddToBalance(balance)
+			account.IncreaseNonce(5)
+		}
+
+		return account
+	}
+
+	return &mockState.AccountsStub{
+		GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			_, found := existingAccounts[string(address)]
+			if !found {
+				return nil, state.ErrAccNotFound
+			}
+
+			return createAccount(address), nil
+		},
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return createAccount(address), nil
+		},
+		GetTrieCalled: func(_ []byte) (common.Trie, error) {
+			return &trie.TrieStub{}, nil
+		},
+		GetCodeCalled: func(_ []byte) []byte {
+			return []byte("original code")
+		},
+	}
+}
+
+func TestNewAccountsDBWithOverrides(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil accounts adapter should error", func(t *testing.T) {
+		t.Parallel()
+
+		accountsDB, err := state.NewAccountsDBWithOverrides(nil, &hashingMocks.HasherMock{})
+		require.True(t, check.IfNil(accountsDB))
+		require.Equal(t, state.ErrNilAccountsAdapter, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		accountsDB, err := state.NewAccountsDBWithOverrides(&mockState.AccountsStub{}, nil)
+		require.True(t, check.IfNil(accountsDB))
+		require.Equal(t, state.ErrNilHasher, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		accountsDB, err := state.NewAccountsDBWithOverrides(&mockState.AccountsStub{}, &hashingMocks.HasherMock{})
+		require.False(t, check.IfNil(accountsDB))
+		require.Nil(t, err)
+	})
+}
+
+func TestAccountsDBWithOverrides_SetOverridesInvalidValuesShouldErr(t *testing.T) {
+	t.Parallel()
+
+	accountsDB, _ := state.NewAccountsDBWithOverrides(&mockState.AccountsStub{}, &hashingMocks.HasherMock{})
+
+	err := accountsDB.SetOverrides(map[string]*state.AccountOverride{"": {}})
+	require.Equal(t, state.ErrNilAddress, err)
+
+	err = accountsDB.SetOverrides(map[string]*state.AccountOverride{"alice": {Balance: big.NewInt(-1)}})
+	require.Equal(t, state.ErrNegativeValue, err)
+}
+
+func TestAccountsDBWithOverrides_ShouldApplyOverrides(t *testing.T) {
+	t.Parallel()
+
+	hasher := &hashingMocks.HasherMock{}
+	accountsDB, _ := state.NewAccountsDBWithOverrides(createAccountsStubForOverrides(map[string]*big.Int{"alice": big.NewInt(10)}), hasher)
+
+	nonce := uint64(2)
+	code := []byte("overridden code")
+	err := accountsDB.SetOverrides(map[string]*state.AccountOverride{
+		"alice": {Balance: big.NewInt(1000), Nonce: &nonce},
+		"bob":   {Code: code, Storage: map[string][]byte{"key": []byte("value")}},
+	})
+	require.Nil(t, err)
+
+	account, err := accountsDB.GetExistingAccount([]byte("alice"))
+	require.Nil(t, err)
+	alice := account.(state.UserAccountHandler)
+	require.Equal(t, big.NewInt(1000), alice.GetBalance())
+	require.Equal(t, nonce, alice.GetNonce())
+
+	account, err = accountsDB.GetExistingAccount([]byte("bob"))
+	require.Nil(t, err)
+	bob := account.(state.UserAccountHandler)
+	require.Equal(t, big.NewInt(0), bob.GetBalance())
+	require.Equal(t, hasher.Compute(string(code)), bob.GetCodeHash())
+	require.Equal(t, code, accountsDB.GetCode(bob.GetCodeHash()))
+	value, err := bob.RetrieveValueFromDataTrieTracker([]byte("key"))
+	require.Nil(t, err)
+	require.Equal(t, []byte("value"), value)
+
+	_, err = accountsDB.GetExistingAccount([]byte("carol"))
+	require.Equal(t, state.ErrAccNotFound, err)
+
+	accountsDB.ResetOverrides()
+
+	account, err = accountsDB.LoadAccount([]byte("alice"))
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(10), account.(state.UserAccountHandler).GetBalance())
+	require.Equal(t, uint64(5), account.GetNonce())
+	require.Equal(t, []byte("original code"), accountsDB.GetCode(hasher.Compute(string(code))))
+
+	_, err = accountsDB.GetExistingAccount([]byte("bob"))
+	require.Equal(t, state.ErrAccNotFound, err)
+}
+
+func TestAccountsDBWithOverrides_ShouldApplyOverridesOverAccountsDBApi(t *testing.T) {
+	t.Parallel()
+
+	_, adb := getDefaultTrieAndAccountsDb()
+	account, _ := adb.LoadAccount([]byte("alice"))
+	_ = account.(state.UserAccountHandler).AddToBalance(big.NewInt(10))
+	_ = adb.SaveAccount(account)
+	rootHash, _ := adb.Commit()
+
+	accountsApi, _ := state.NewAccountsDBApi(adb, createBlockInfoProviderStub(rootHash))
+	accountsDB, _ := state.NewAccountsDBWithOverrides(accountsApi, &hashingMocks.HasherMock{})
+
+	_, err := accountsDB.GetExistingAccount([]byte("bob"))
+	notFoundErr := &state.ErrAccountNotFoundAtBlock{}
+	require.ErrorAs(t, err, &notFoundErr)
+
+	nonce := uint64(7)
+	err = accountsDB.SetOverrides(map[string]*state.AccountOverride{
+		"alice": {Nonce: &nonce},
+		"bob":   {Balance: big.NewInt(1000), Storage: map[string][]byte{"key": []byte("value")}},
+	})
+	require.Nil(t, err)
+
+	account, err = accountsDB.GetExistingAccount([]byte("alice"))
+	require.Nil(t, err)
+	require.Equal(t, big.NewInt(10), account.(state.UserAccountHandler).GetBalance())
+	require.Equal(t, nonce, account.GetNonce())
+
+	account, err = accountsDB.GetExistingAccount([]byte("bob"))
+	require.Nil(t, err)
+	bob := account.(state.UserAccountHandler)
+	require.Equal(t, big.NewInt(1000), bob.GetBalance())
+	value, err := bob.RetrieveValueFromDataTrieTracker([]byte("key"))
+	require.Nil(t, err)
+	require.Equal(t, []byte("value"), value)
+}
+
+func TestAccountsDBWithOverrides_GetTrieErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	accountsStub := createAccountsStubForOverrides(make(map[string]*big.Int))
+	accountsStub.GetTrieCalled = func(_ []byte) (common.Trie, error) {
+		return nil, expectedErr
+	}
+	accountsDB, _ := state.NewAccountsDBWithOverrides(accountsStub, &hashingMocks.HasherMock{})
+	_ = accountsDB.SetOverrides(map[string]*state.AccountOverride{
+		"bob": {Storage: map[string][]byte{"key": []byte("value")}},
+	})
+
+	account, err := accountsDB.LoadAccount([]byte("bob"))
+	require.Nil(t, account)
+	require.Equal(t, expectedErr, err)
+}
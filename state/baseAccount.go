@@ -24,6 +24,11 @@ func (ba *baseAccount) SetCode(code []byte) {
 	ba.code = code
 }
 
+// GetCode returns the code set on the account with SetCode, if any. The code is not loaded together with the account
+func (ba *baseAccount) GetCode() []byte {
+	return ba.code
+}
+
 // DataTrie returns the trie that holds the current account's data
 func (ba *baseAccount) DataTrie() common.Trie {
 	return ba.dataTrieTracker.DataTrie()
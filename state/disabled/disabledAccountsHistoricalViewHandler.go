@@ -0,0 +1,28 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+type disabledAccountsHistoricalViewHandler struct {
+}
+
+// NewDisabledAccountsHistoricalViewHandler creates a new instance of disabledAccountsHistoricalViewHandler
+func NewDisabledAccountsHistoricalViewHandler() *disabledAccountsHistoricalViewHandler {
+	return &disabledAccountsHistoricalViewHandler{}
+}
+
+// SetHistoricalView returns an error as the historical view is not supported by this implementation
+func (d *disabledAccountsHistoricalViewHandler) SetHistoricalView(_ api.AccountQueryOptions) error {
+	return state.ErrHistoricalViewNotSupported
+}
+
+// ResetHistoricalView does nothing for this implementation
+func (d *disabledAccountsHistoricalViewHandler) ResetHistoricalView() {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *disabledAccountsHistoricalViewHandler) IsInterfaceNil() bool {
+	return d == nil
+}
@@ -0,0 +1,27 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+type disabledAccountsOverridesHandler struct {
+}
+
+// NewDisabledAccountsOverridesHandler creates a new instance of disabledAccountsOverridesHandler
+func NewDisabledAccountsOverridesHandler() *disabledAccountsOverridesHandler {
+	return &disabledAccountsOverridesHandler{}
+}
+
+// SetOverrides returns an error as the overrides are not supported by this implementation
+func (d *disabledAccountsOverridesHandler) SetOverrides(_ map[string]*state.AccountOverride) error {
+	return state.ErrAccountsOverridesNotSupported
+}
+
+// ResetOverrides does nothing for this implementation
+func (d *disabledAccountsOverridesHandler) ResetOverrides() {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *disabledAccountsOverridesHandler) IsInterfaceNil() bool {
+	return d == nil
+}
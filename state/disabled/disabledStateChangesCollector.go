@@ -0,0 +1,40 @@
+package disabled
+
+import (
+	"github.com/ElrondNetwork/elrond-go/state"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+type disabledStateChangesCollector struct {
+}
+
+// NewDisabledStateChangesCollector creates a new instance of disabledStateChangesCollector
+func NewDisabledStateChangesCollector() *disabledStateChangesCollector {
+	return &disabledStateChangesCollector{}
+}
+
+// AddAccountChange does nothing for this implementation
+func (d *disabledStateChangesCollector) AddAccountChange(_ int, _ vmcommon.AccountHandler, _ vmcommon.AccountHandler) {
+}
+
+// AddDataTrieChange does nothing for this implementation
+func (d *disabledStateChangesCollector) AddDataTrieChange(_ int, _ []byte, _ []byte, _ []byte, _ []byte) {
+}
+
+// RevertToIndex does nothing for this implementation
+func (d *disabledStateChangesCollector) RevertToIndex(_ int) {
+}
+
+// GetStateChanges returns an empty slice as no changes are recorded by this implementation
+func (d *disabledStateChangesCollector) GetStateChanges() []*state.AccountStateChanges {
+	return make([]*state.AccountStateChanges, 0)
+}
+
+// Reset does nothing for this implementation
+func (d *disabledStateChangesCollector) Reset() {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *disabledStateChangesCollector) IsInterfaceNil() bool {
+	return d == nil
+}
@@ -152,3 +152,15 @@ var ErrNilBlockInfoProvider = errors.New("nil block info provider")
 
 // ErrFunctionalityNotImplemented signals that the functionality has not been implemented yet
 var ErrFunctionalityNotImplemented = errors.New("functionality not implemented yet")
+
+// ErrAccountsOverridesNotSupported signals that the accounts overrides are not supported
+var ErrAccountsOverridesNotSupported = errors.New("accounts overrides are not supported")
+
+// ErrNilAccountsRepository signals that a nil accounts repository has been provided
+var ErrNilAccountsRepository = errors.New("nil accounts repository")
+
+// ErrHistoricalViewNotSupported signals that reading the accounts at a past root hash is not supported
+var ErrHistoricalViewNotSupported = errors.New("historical view of the accounts is not supported")
+
+// ErrNilStateChangesCollector signals that a nil state changes collector has been provided
+var ErrNilStateChangesCollector = errors.New("nil state changes collector")
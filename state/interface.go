@@ -212,3 +212,29 @@ type AccountsAdapterAPI interface {
 	GetAccountWithBlockInfo(address []byte, options common.RootHashHolder) (vmcommon.AccountHandler, common.BlockInfo, error)
 	GetCodeWithBlockInfo(codeHash []byte, options common.RootHashHolder) ([]byte, common.BlockInfo, error)
 }
+
+// AccountsOverridesHandler defines the behavior of a component able to override the state of some accounts
+type AccountsOverridesHandler interface {
+	SetOverrides(overrides map[string]*AccountOverride) error
+	ResetOverrides()
+	IsInterfaceNil() bool
+}
+
+// AccountsHistoricalViewHandler defines the behavior of a component able to serve the accounts as they were at a past
+// root hash
+type AccountsHistoricalViewHandler interface {
+	SetHistoricalView(options api.AccountQueryOptions) error
+	ResetHistoricalView()
+	IsInterfaceNil() bool
+}
+
+// StateChangesCollector defines the behavior of a component which records the changes made on the accounts, so that the
+// state diff of an operation can be computed
+type StateChangesCollector interface {
+	AddAccountChange(journalIndex int, accountBefore vmcommon.AccountHandler, accountAfter vmcommon.AccountHandler)
+	AddDataTrieChange(journalIndex int, address []byte, key []byte, valueBefore []byte, valueAfter []byte)
+	RevertToIndex(journalIndex int)
+	GetStateChanges() []*AccountStateChanges
+	Reset()
+	IsInterfaceNil() bool
+}
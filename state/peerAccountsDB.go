@@ -35,6 +35,7 @@ func NewPeerAccountsDB(args ArgsAccountsDB) (*PeerAccountsDB, error) {
 			processingMode:        args.ProcessingMode,
 			lastSnapshot:          &snapshotInfo{},
 			processStatusHandler:  args.ProcessStatusHandler,
+			stateChangesCollector: args.StateChangesCollector,
 		},
 	}
 
@@ -79,6 +79,16 @@ func TestNewPeerAccountsDB(t *testing.T) {
 		assert.True(t, check.IfNil(adb))
 		assert.Equal(t, state.ErrNilProcessStatusHandler, err)
 	})
+	t.Run("nil state changes collector should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockAccountsDBArgs()
+		args.StateChangesCollector = nil
+
+		adb, err := state.NewPeerAccountsDB(args)
+		assert.True(t, check.IfNil(adb))
+		assert.Equal(t, state.ErrNilStateChangesCollector, err)
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -90,6 +100,29 @@ func TestNewPeerAccountsDB(t *testing.T) {
 	})
 }
 
+func TestPeerAccountsDB_SaveAccountShouldWork(t *testing.T) {
+	t.Parallel()
+
+	args := createMockAccountsDBArgs()
+	args.Trie = &trieMock.TrieStub{
+		GetCalled: func(key []byte) ([]byte, error) {
+			return nil, nil
+		},
+		UpdateCalled: func(key, value []byte) error {
+			return nil
+		},
+		GetStorageManagerCalled: func() common.StorageManager {
+			return &testscommon.StorageManagerStub{}
+		},
+	}
+	adb, _ := state.NewPeerAccountsDB(args)
+
+	account, _ := state.NewPeerAccount([]byte("validator"))
+	err := adb.SaveAccount(account)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, adb.JournalLen())
+}
+
 func TestNewPeerAccountsDB_SnapshotState(t *testing.T) {
 	t.Parallel()
 
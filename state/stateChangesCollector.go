@@ -0,0 +1,216 @@
+package state
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	vmcommon "github.com/ElrondNetwork/elrond-vm-common"
+)
+
+// AccountStateChanges holds the changes of an account, as recorded by a state changes collector
+type AccountStateChanges struct {
+	Address         []byte
+	BalanceBefore   *big.Int
+	BalanceAfter    *big.Int
+	NonceBefore     uint64
+	NonceAfter      uint64
+	DataTrieChanges []*DataTrieChange
+}
+
+// DataTrieChange holds the values of a data trie key, before and after the recorded changes. An empty value stands for
+// a missing key
+type DataTrieChange struct {
+	Key    []byte
+	Before []byte
+	After  []byte
+}
+
+type accountChangeEntry struct {
+	balanceBefore *big.Int
+	balanceAfter  *big.Int
+	nonceBefore   uint64
+	nonceAfter    uint64
+}
+
+type stateChangeEntry struct {
+	journalIndex   int
+	address        []byte
+	accountChange  *accountChangeEntry
+	dataTrieChange *DataTrieChange
+}
+
+type stateChangesCollector struct {
+	mutEntries sync.RWMutex
+	entries    []*stateChangeEntry
+}
+
+// NewStateChangesCollector creates a new instance of stateChangesCollector. The collector records the changes made on
+// the user accounts, along with the index of the journal entry of each change, so that the reverted changes can be
+// discarded
+func NewStateChangesCollector() *stateChangesCollector {
+	return &stateChangesCollector{
+		entries: make([]*stateChangeEntry, 0),
+	}
+}
+
+// AddAccountChange records the balance and nonce of a user account, before and after a save operation. A nil account
+// before the operation stands for a new account. Other types of accounts are ignored
+func (scc *stateChangesCollector) AddAccountChange(journalIndex int, accountBefore vmcommon.AccountHandler, accountAfter vmcommon.AccountHandler) {
+	userAccountAfter, ok := accountAfter.(UserAccountHandler)
+	if !ok || check.IfNil(userAccountAfter) {
+		return
+	}
+
+	change := &accountChangeEntry{
+		balanceBefore: big.NewInt(0),
+		balanceAfter:  getBalanceOrZero(userAccountAfter),
+		nonceAfter:    userAccountAfter.GetNonce(),
+	}
+	userAccountBefore, ok := accountBefore.(UserAccountHandler)
+	if ok && !check.IfNil(userAccountBefore) {
+		change.balanceBefore = getBalanceOrZero(userAccountBefore)
+		change.nonceBefore = userAccountBefore.GetNonce()
+	}
+
+	scc.addEntry(&stateChangeEntry{
+		journalIndex:  journalIndex,
+		address:       userAccountAfter.AddressBytes(),
+		accountChange: change,
+	})
+}
+
+// AddDataTrieChange records the values of a data trie key, before and after a save operation
+func (scc *stateChangesCollector) AddDataTrieChange(journalIndex int, address []byte, key []byte, valueBefore []byte, valueAfter []byte) {
+	scc.addEntry(&stateChangeEntry{
+		journalIndex: journalIndex,
+		address:      address,
+		dataTrieChange: &DataTrieChange{
+			Key:    key,
+			Before: valueBefore,
+			After:  valueAfter,
+		},
+	})
+}
+
+func (scc *stateChangesCollector) addEntry(entry *stateChangeEntry) {
+	scc.mutEntries.Lock()
+	scc.entries = append(scc.entries, entry)
+	scc.mutEntries.Unlock()
+}
+
+// RevertToIndex discards the changes recorded for the journal entries starting with the provided index
+func (scc *stateChangesCollector) RevertToIndex(journalIndex int) {
+	scc.mutEntries.Lock()
+	defer scc.mutEntries.Unlock()
+
+	numKeptEntries := len(scc.entries)
+	for numKeptEntries > 0 && scc.entries[numKeptEntries-1].journalIndex >= journalIndex {
+		numKeptEntries--
+	}
+	scc.entries = scc.entries[:numKeptEntries]
+}
+
+// GetStateChanges returns the changes recorded since the last reset, aggregated by account, in the order in which the
+// accounts were first changed. The accounts and the data trie keys left with their initial values are omitted
+func (scc *stateChangesCollector) GetStateChanges() []*AccountStateChanges {
+	scc.mutEntries.RLock()
+	defer scc.mutEntries.RUnlock()
+
+	accountsChanges := make(map[string]*AccountStateChanges)
+	dataTriesChanges := make(map[string]map[string]*DataTrieChange)
+	addresses := make([][]byte, 0)
+	for _, entry := range scc.entries {
+		accountChanges, found := accountsChanges[string(entry.address)]
+		if !found {
+			accountChanges = &AccountStateChanges{
+				Address: entry.address,
+			}
+			accountsChanges[string(entry.address)] = accountChanges
+			dataTriesChanges[string(entry.address)] = make(map[string]*DataTrieChange)
+			addresses = append(addresses, entry.address)
+		}
+
+		if entry.accountChange != nil {
+			if accountChanges.BalanceBefore == nil {
+				accountChanges.BalanceBefore = entry.accountChange.balanceBefore
+				accountChanges.NonceBefore = entry.accountChange.nonceBefore
+			}
+			accountChanges.BalanceAfter = entry.accountChange.balanceAfter
+			accountChanges.NonceAfter = entry.accountChange.nonceAfter
+		}
+
+		if entry.dataTrieChange != nil {
+			dataTrieChanges := dataTriesChanges[string(entry.address)]
+			dataTrieChange, keyFound := dataTrieChanges[string(entry.dataTrieChange.Key)]
+			if !keyFound {
+				dataTrieChange = &DataTrieChange{
+					Key:    entry.dataTrieChange.Key,
+					Before: entry.dataTrieChange.Before,
+				}
+				dataTrieChanges[string(entry.dataTrieChange.Key)] = dataTrieChange
+			}
+			dataTrieChange.After = entry.dataTrieChange.After
+		}
+	}
+
+	stateChanges := make([]*AccountStateChanges, 0, len(addresses))
+	for _, address := range addresses {
+		accountChanges := accountsChanges[string(address)]
+		accountChanges.DataTrieChanges = getChangedDataTrieValues(dataTriesChanges[string(address)])
+		if accountChanges.BalanceBefore == nil {
+			// only the data trie was recorded for this account
+			accountChanges.BalanceBefore = big.NewInt(0)
+			accountChanges.BalanceAfter = big.NewInt(0)
+		}
+
+		isChanged := accountChanges.BalanceBefore.Cmp(accountChanges.BalanceAfter) != 0 ||
+			accountChanges.NonceBefore != accountChanges.NonceAfter ||
+			len(accountChanges.DataTrieChanges) > 0
+		if isChanged {
+			stateChanges = append(stateChanges, accountChanges)
+		}
+	}
+
+	return stateChanges
+}
+
+func getChangedDataTrieValues(dataTrieChanges map[string]*DataTrieChange) []*DataTrieChange {
+	changedValues := make([]*DataTrieChange, 0, len(dataTrieChanges))
+	for _, dataTrieChange := range dataTrieChanges {
+		if bytes.Equal(dataTrieChange.Before, dataTrieChange.After) {
+			continue
+		}
+
+		changedValues = append(changedValues, dataTrieChange)
+	}
+
+	sort.Slice(changedValues, func(i, j int) bool {
+		return bytes.Compare(changedValues[i].Key, changedValues[j].Key) < 0
+	})
+
+	return changedValues
+}
+
+func getBalanceOrZero(account UserAccountHandler) *big.Int {
+	balance := account.GetBalance()
+	if balance == nil {
+		return big.NewInt(0)
+	}
+
+	return big.NewInt(0).Set(balance)
+}
+
+// Reset discards all the recorded changes
+func (scc *stateChangesCollector) Reset() {
+	scc.mutEntries.Lock()
+	scc.entries = make([]*stateChangeEntry, 0)
+	scc.mutEntries.Unlock()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (scc *stateChangesCollector) IsInterfaceNil() bool {
+	return scc == nil
+}
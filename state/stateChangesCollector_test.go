@@ -0,0 +1,72 @@
+package state_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/stretchr/testify/assert"
+)
+
+func createUserAccountWithBalanceAndNonce(address string, balance int64, nonce uint64) state.UserAccountHandler {
+	account, _ := state.NewUserAccount([]byte(address))
+	_ = account.AddToBalance(big.NewInt(balance))
+	account.IncreaseNonce(nonce)
+
+	return account
+}
+
+func TestNewStateChangesCollector(t *testing.T) {
+	t.Parallel()
+
+	collector := state.NewStateChangesCollector()
+	assert.False(t, check.IfNil(collector))
+	assert.Equal(t, 0, len(collector.GetStateChanges()))
+}
+
+func TestStateChangesCollector_GetStateChangesShouldAggregateByAccount(t *testing.T) {
+	t.Parallel()
+
+	collector := state.NewStateChangesCollector()
+	collector.AddAccountChange(0, nil, createUserAccountWithBalanceAndNonce("alice", 10, 0))
+	collector.AddAccountChange(1, createUserAccountWithBalanceAndNonce("bob", 5, 3), createUserAccountWithBalanceAndNonce("bob", 5, 3))
+	collector.AddDataTrieChange(2, []byte("alice"), []byte("key2"), nil, []byte("value2"))
+	collector.AddDataTrieChange(2, []byte("alice"), []byte("key1"), []byte("old"), []byte("new"))
+	collector.AddAccountChange(2, createUserAccountWithBalanceAndNonce("alice", 10, 0), createUserAccountWithBalanceAndNonce("alice", 7, 1))
+	collector.AddDataTrieChange(3, []byte("alice"), []byte("key2"), []byte("value2"), nil)
+
+	stateChanges := collector.GetStateChanges()
+	expectedStateChanges := []*state.AccountStateChanges{
+		{
+			Address:       []byte("alice"),
+			BalanceBefore: big.NewInt(0),
+			BalanceAfter:  big.NewInt(7),
+			NonceBefore:   0,
+			NonceAfter:    1,
+			DataTrieChanges: []*state.DataTrieChange{
+				{Key: []byte("key1"), Before: []byte("old"), After: []byte("new")},
+			},
+		},
+	}
+	assert.Equal(t, expectedStateChanges, stateChanges)
+}
+
+func TestStateChangesCollector_RevertToIndexShouldDiscardTheRevertedChanges(t *testing.T) {
+	t.Parallel()
+
+	collector := state.NewStateChangesCollector()
+	collector.AddAccountChange(0, nil, createUserAccountWithBalanceAndNonce("alice", 10, 0))
+	collector.AddAccountChange(1, nil, createUserAccountWithBalanceAndNonce("bob", 20, 0))
+	collector.AddDataTrieChange(2, []byte("alice"), []byte("key"), nil, []byte("value"))
+
+	collector.RevertToIndex(1)
+	stateChanges := collector.GetStateChanges()
+	assert.Equal(t, 1, len(stateChanges))
+	assert.Equal(t, []byte("alice"), stateChanges[0].Address)
+	assert.Equal(t, big.NewInt(10), stateChanges[0].BalanceAfter)
+	assert.Equal(t, 0, len(stateChanges[0].DataTrieChanges))
+
+	collector.Reset()
+	assert.Equal(t, 0, len(collector.GetStateChanges()))
+}
@@ -67,6 +67,20 @@ func trimValue(value []byte, tailLength int) ([]byte, error) {
 	return value[:dataLength], nil
 }
 
+// trimDataTrieValue returns a copy of the value saved in a data trie, without the key and identifier appended on save
+func trimDataTrieValue(value []byte, key []byte, identifier []byte) []byte {
+	if len(value) == 0 {
+		return nil
+	}
+
+	trimmedValue, err := trimValue(value, len(key)+len(identifier))
+	if err != nil {
+		trimmedValue = value
+	}
+
+	return append([]byte{}, trimmedValue...)
+}
+
 // SaveKeyValue stores in dirtyData the data keys "touched"
 // It does not care if the data is really dirty as calling this check here will be sub-optimal
 func (tdaw *TrackableDataTrie) SaveKeyValue(key []byte, value []byte) error {
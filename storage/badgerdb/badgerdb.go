@@ -0,0 +1,476 @@
+package badgerdb
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/errors"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/dgraph-io/badger/v2"
+	"github.com/dgraph-io/badger/v2/options"
+)
+
+var _ storage.Persister = (*DB)(nil)
+
+// read + write + execute for owner only
+const rwxOwner = 0700
+const mkdirAllFunction = "mkdirAll"
+const openBadgerDBFunction = "openBadgerDB"
+
+const resourceUnavailable = "resource temporarily unavailable"
+const maxRetries = 10
+const timeBetweenRetries = time.Second
+
+// a node opens tens of persisters at once, so the memtables and the tables are kept a lot smaller than the badger
+// defaults (64MB each, 5 memtables). Values under the badger value threshold (1KB), which covers the trie nodes,
+// are kept inside the LSM tree
+const maxTableSize = 8 << 20
+const numMemtables = 2
+const levelOneSize = 64 << 20
+const valueLogFileSize = 256 << 20
+
+const valueLogGCInterval = 10 * time.Minute
+const valueLogGCDiscardRatio = 0.5
+
+var log = logger.GetOrCreate("storage/badgerdb")
+
+// loggingDBCounter this variable should be used only used in logging prints
+var loggingDBCounter = uint32(0)
+
+// DB holds a pointer to the badger database and the path to where it is stored
+type DB struct {
+	mutDb             sync.RWMutex
+	db                *badger.DB
+	path              string
+	maxBatchSize      int
+	batchDelaySeconds int
+	sizeBatch         int
+	batch             *batch
+	mutBatch          sync.RWMutex
+	cancel            context.CancelFunc
+}
+
+// NewDB is a constructor for the badger persister, an LSM engine written in pure Go
+// It creates the files in the location given as parameter
+func NewDB(path string, batchDelaySeconds int, maxBatchSize int) (s *DB, err error) {
+	constructorName := "NewDB"
+
+	sw := core.NewStopWatch()
+	sw.Start(constructorName)
+
+	sw.Start(mkdirAllFunction)
+	err = os.MkdirAll(path, rwxOwner)
+	if err != nil {
+		return nil, err
+	}
+	sw.Stop(mkdirAllFunction)
+
+	dbOptions := badger.DefaultOptions(path).
+		WithLogger(&badgerLogger{log: log}).
+		WithMaxTableSize(maxTableSize).
+		WithNumMemtables(numMemtables).
+		WithLevelOneSize(levelOneSize).
+		WithValueLogFileSize(valueLogFileSize).
+		WithCompression(options.None).
+		WithDetectConflicts(false)
+
+	sw.Start(openBadgerDBFunction)
+	db, err := openBadgerDB(path, dbOptions)
+	if err != nil {
+		return nil, fmt.Errorf("%w for path %s", err, path)
+	}
+	sw.Stop(openBadgerDBFunction)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	dbStore := &DB{
+		db:                db,
+		path:              path,
+		maxBatchSize:      maxBatchSize,
+		batchDelaySeconds: batchDelaySeconds,
+		sizeBatch:         0,
+		batch:             NewBatch(),
+		cancel:            cancel,
+	}
+
+	go dbStore.batchTimeoutHandle(ctx)
+	go dbStore.valueLogGCHandle(ctx)
+
+	runtime.SetFinalizer(dbStore, func(db *DB) {
+		_ = db.Close()
+	})
+
+	crtCounter := atomic.AddUint32(&loggingDBCounter, 1)
+	sw.Stop(constructorName)
+
+	logArguments := []interface{}{"path", path, "created pointer", fmt.Sprintf("%p", db), "global db counter", crtCounter}
+	logArguments = append(logArguments, sw.GetMeasurements()...)
+	log.Debug("opened badger db persister", logArguments...)
+
+	return dbStore, nil
+}
+
+func openBadgerDB(path string, dbOptions badger.Options) (*badger.DB, error) {
+	retries := 0
+	for {
+		db, err := badger.Open(dbOptions)
+		if err == nil {
+			return db, nil
+		}
+		// the directory lock is held by another instance which might be just closing
+		if !strings.Contains(err.Error(), resourceUnavailable) {
+			return nil, err
+		}
+
+		log.Debug("error opening DB",
+			"error", err,
+			"path", path,
+			"retry", retries,
+		)
+
+		time.Sleep(timeBetweenRetries)
+		retries++
+		if retries > maxRetries {
+			return nil, fmt.Errorf("%w, retried %d number of times", err, maxRetries)
+		}
+	}
+}
+
+func (s *DB) batchTimeoutHandle(ctx context.Context) {
+	interval := time.Duration(s.batchDelaySeconds) * time.Second
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+
+	for {
+		timer.Reset(interval)
+
+		select {
+		case <-timer.C:
+			s.mutBatch.Lock()
+			err := s.putBatch(s.batch)
+			if err != nil {
+				log.Warn("badgerdb putBatch", "error", err.Error())
+				s.mutBatch.Unlock()
+				continue
+			}
+
+			s.batch.Reset()
+			s.sizeBatch = 0
+			s.mutBatch.Unlock()
+		case <-ctx.Done():
+			log.Debug("closing the timed batch handler", "path", s.path)
+			return
+		}
+	}
+}
+
+// valueLogGCHandle periodically reclaims the space held in the value log by the overwritten or removed values
+func (s *DB) valueLogGCHandle(ctx context.Context) {
+	timer := time.NewTimer(valueLogGCInterval)
+	defer timer.Stop()
+
+	for {
+		timer.Reset(valueLogGCInterval)
+
+		select {
+		case <-timer.C:
+			s.runValueLogGC()
+		case <-ctx.Done():
+			log.Debug("closing the value log GC handler", "path", s.path)
+			return
+		}
+	}
+}
+
+func (s *DB) runValueLogGC() {
+	s.mutDb.RLock()
+	defer s.mutDb.RUnlock()
+
+	if s.db == nil {
+		return
+	}
+
+	numRewrites := 0
+	for {
+		// each successful call rewrites at most one value log file
+		err := s.db.RunValueLogGC(valueLogGCDiscardRatio)
+		if err != nil {
+			break
+		}
+		numRewrites++
+	}
+
+	if numRewrites > 0 {
+		log.Debug("badgerdb value log GC", "path", s.path, "rewritten files", numRewrites)
+	}
+}
+
+func (s *DB) updateBatchWithIncrement() error {
+	s.mutBatch.Lock()
+	defer s.mutBatch.Unlock()
+
+	s.sizeBatch++
+	if s.sizeBatch < s.maxBatchSize {
+		return nil
+	}
+
+	err := s.putBatch(s.batch)
+	if err != nil {
+		log.Warn("badgerdb putBatch", "error", err.Error())
+		return err
+	}
+
+	s.batch.Reset()
+	s.sizeBatch = 0
+
+	return nil
+}
+
+// Put adds the value to the (key, val) storage medium
+func (s *DB) Put(key, val []byte) error {
+	err := s.batch.Put(key, val)
+	if err != nil {
+		return err
+	}
+
+	return s.updateBatchWithIncrement()
+}
+
+// Get returns the value associated to the key
+func (s *DB) Get(key []byte) ([]byte, error) {
+	s.mutDb.RLock()
+	defer s.mutDb.RUnlock()
+
+	if s.db == nil {
+		return nil, errors.ErrDBIsClosed
+	}
+
+	if s.batch.IsRemoved(key) {
+		return nil, storage.ErrKeyNotFound
+	}
+
+	data := s.batch.Get(key)
+	if data != nil {
+		return data, nil
+	}
+
+	err := s.db.View(func(txn *badger.Txn) error {
+		item, errGet := txn.Get(key)
+		if errGet != nil {
+			return errGet
+		}
+
+		data, errGet = item.ValueCopy(nil)
+		return errGet
+	})
+	if err == badger.ErrKeyNotFound {
+		return nil, storage.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// Has returns nil if the given key is present in the persistence medium
+func (s *DB) Has(key []byte) error {
+	s.mutDb.RLock()
+	defer s.mutDb.RUnlock()
+
+	if s.db == nil {
+		return errors.ErrDBIsClosed
+	}
+
+	if s.batch.IsRemoved(key) {
+		return storage.ErrKeyNotFound
+	}
+
+	data := s.batch.Get(key)
+	if data != nil {
+		return nil
+	}
+
+	err := s.db.View(func(txn *badger.Txn) error {
+		_, errGet := txn.Get(key)
+		return errGet
+	})
+	if err == badger.ErrKeyNotFound {
+		return storage.ErrKeyNotFound
+	}
+
+	return err
+}
+
+// putBatch writes the Batch data into the database
+func (s *DB) putBatch(b *batch) error {
+	s.mutDb.RLock()
+	defer s.mutDb.RUnlock()
+
+	if s.db == nil {
+		return errors.ErrDBIsClosed
+	}
+	if b.isEmpty() {
+		return nil
+	}
+
+	wb := s.db.NewWriteBatch()
+	err := b.writeTo(wb)
+	if err != nil {
+		wb.Cancel()
+		return err
+	}
+
+	return wb.Flush()
+}
+
+// RangeKeys will call the handler function for each (key, value) pair
+// If the handler returns true, the iteration will continue, otherwise will stop
+func (s *DB) RangeKeys(handler func(key []byte, value []byte) bool) {
+	err := s.RangeKeysWithError(handler)
+	if err != nil && !errors.IsClosingError(err) {
+		log.Warn("badgerdb RangeKeys", "path", s.path, "error", err.Error())
+	}
+}
+
+// RangeKeysWithError calls the handler function for each (key, value) pair, as RangeKeys does, and returns the error
+// which ended the iteration prematurely, if any
+func (s *DB) RangeKeysWithError(handler func(key []byte, value []byte) bool) error {
+	if handler == nil {
+		return nil
+	}
+
+	s.mutDb.RLock()
+	defer s.mutDb.RUnlock()
+
+	if s.db == nil {
+		return errors.ErrDBIsClosed
+	}
+
+	return s.db.View(func(txn *badger.Txn) error {
+		iterator := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer iterator.Close()
+
+		for iterator.Rewind(); iterator.Valid(); iterator.Next() {
+			item := iterator.Item()
+			clonedKey := item.KeyCopy(nil)
+			clonedVal, errValue := item.ValueCopy(nil)
+			if errValue != nil {
+				return errValue
+			}
+
+			shouldContinue := handler(clonedKey, clonedVal)
+			if !shouldContinue {
+				return nil
+			}
+		}
+
+		return nil
+	})
+}
+
+// Compact writes the pending batch, merges all the LSM levels into the last one and reclaims the space held by the
+// overwritten or removed values from the value log
+func (s *DB) Compact() error {
+	s.mutBatch.Lock()
+	err := s.putBatch(s.batch)
+	if err == nil {
+		s.batch.Reset()
+		s.sizeBatch = 0
+	}
+	s.mutBatch.Unlock()
+	if err != nil {
+		return err
+	}
+
+	s.mutDb.RLock()
+	if s.db == nil {
+		s.mutDb.RUnlock()
+		return errors.ErrDBIsClosed
+	}
+	err = s.db.Flatten(runtime.NumCPU())
+	s.mutDb.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	s.runValueLogGC()
+
+	return nil
+}
+
+func (s *DB) makeDbPointerNilReturningLast() *badger.DB {
+	s.mutDb.Lock()
+	defer s.mutDb.Unlock()
+
+	if s.db != nil {
+		crtCounter := atomic.AddUint32(&loggingDBCounter, ^uint32(0)) // subtract 1
+		log.Debug("makeDbPointerNilReturningLast", "path", s.path, "nilled pointer", fmt.Sprintf("%p", s.db), "global db counter", crtCounter)
+	}
+
+	db := s.db
+	s.db = nil
+
+	return db
+}
+
+// Close closes the files/resources associated to the storage medium
+func (s *DB) Close() error {
+	s.mutBatch.Lock()
+	_ = s.putBatch(s.batch)
+	s.sizeBatch = 0
+	s.mutBatch.Unlock()
+
+	s.cancel()
+	db := s.makeDbPointerNilReturningLast()
+	if db != nil {
+		return db.Close()
+	}
+
+	return nil
+}
+
+// Remove removes the data associated to the given key
+func (s *DB) Remove(key []byte) error {
+	s.mutBatch.Lock()
+	_ = s.batch.Delete(key)
+	s.mutBatch.Unlock()
+
+	return s.updateBatchWithIncrement()
+}
+
+// Destroy removes the storage medium stored data
+func (s *DB) Destroy() error {
+	s.mutBatch.Lock()
+	s.batch.Reset()
+	s.sizeBatch = 0
+	s.mutBatch.Unlock()
+
+	s.cancel()
+	db := s.makeDbPointerNilReturningLast()
+	if db != nil {
+		err := db.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	return os.RemoveAll(s.path)
+}
+
+// DestroyClosed removes the already closed storage medium stored data
+func (s *DB) DestroyClosed() error {
+	return os.RemoveAll(s.path)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *DB) IsInterfaceNil() bool {
+	return s == nil
+}
@@ -0,0 +1,367 @@
+package badgerdb_test
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ElrondNetwork/elrond-go/errors"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/badgerdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createBadgerDb(t testing.TB, batchDelaySeconds int, maxBatchSize int) (p *badgerdb.DB) {
+	bdb, err := badgerdb.NewDB(t.TempDir(), batchDelaySeconds, maxBatchSize)
+
+	assert.Nil(t, err, "Failed creating badger database")
+	return bdb
+}
+
+func TestDB_DoubleOpenShouldError(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	dir := t.TempDir()
+	bdb1, err := badgerdb.NewDB(dir, 10, 1)
+	require.Nil(t, err)
+
+	defer func() {
+		_ = bdb1.Close()
+	}()
+
+	_, err = badgerdb.NewDB(dir, 10, 1)
+	assert.NotNil(t, err)
+}
+
+func TestDB_DoubleOpenButClosedInTimeShouldWork(t *testing.T) {
+	dir := t.TempDir()
+	bdb1, err := badgerdb.NewDB(dir, 10, 1)
+	require.Nil(t, err)
+
+	defer func() {
+		_ = bdb1.Close()
+	}()
+
+	go func() {
+		time.Sleep(time.Second * 3)
+		_ = bdb1.Close()
+	}()
+
+	bdb2, err := badgerdb.NewDB(dir, 10, 1)
+	assert.Nil(t, err)
+	assert.NotNil(t, bdb2)
+
+	_ = bdb2.Close()
+}
+
+func TestDB_ReopenShouldKeepData(t *testing.T) {
+	dir := t.TempDir()
+	bdb, err := badgerdb.NewDB(dir, 10, 100)
+	require.Nil(t, err)
+
+	key, val := []byte("key"), []byte("value")
+	err = bdb.Put(key, val)
+	require.Nil(t, err)
+
+	// the batch is written on close
+	err = bdb.Close()
+	require.Nil(t, err)
+
+	bdbReopened, err := badgerdb.NewDB(dir, 10, 100)
+	require.Nil(t, err)
+	defer func() {
+		_ = bdbReopened.Close()
+	}()
+
+	recovered, err := bdbReopened.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, recovered)
+}
+
+func TestDB_GetErrorAfterPutBeforeTimeout(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	bdb := createBadgerDb(t, 1, 100)
+
+	err := bdb.Put(key, val)
+	assert.Nil(t, err)
+	v, err := bdb.Get(key)
+	assert.Equal(t, val, v)
+	assert.Nil(t, err)
+}
+
+func TestDB_GetOKAfterPutWithTimeout(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	bdb := createBadgerDb(t, 1, 100)
+
+	err := bdb.Put(key, val)
+	assert.Nil(t, err)
+	time.Sleep(time.Second * 3)
+
+	v, err := bdb.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, v)
+}
+
+func TestDB_RemoveAfterTimeoutOK(t *testing.T) {
+	key, val := []byte("key"), []byte("value")
+	bdb := createBadgerDb(t, 1, 100)
+
+	err := bdb.Put(key, val)
+	assert.Nil(t, err)
+	time.Sleep(time.Second * 2)
+
+	_ = bdb.Remove(key)
+
+	v, err := bdb.Get(key)
+	assert.Nil(t, v)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+}
+
+func TestDB_GetPresent(t *testing.T) {
+	key, val := []byte("key1"), []byte("value1")
+	bdb := createBadgerDb(t, 10, 1)
+
+	err := bdb.Put(key, val)
+	assert.Nil(t, err)
+
+	v, err := bdb.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, v)
+}
+
+func TestDB_GetNotPresent(t *testing.T) {
+	bdb := createBadgerDb(t, 10, 1)
+
+	v, err := bdb.Get([]byte("key2"))
+	assert.Nil(t, v)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+}
+
+func TestDB_HasPresent(t *testing.T) {
+	key, val := []byte("key3"), []byte("value3")
+	bdb := createBadgerDb(t, 10, 1)
+
+	err := bdb.Put(key, val)
+	assert.Nil(t, err)
+
+	err = bdb.Has(key)
+	assert.Nil(t, err)
+}
+
+func TestDB_HasNotPresent(t *testing.T) {
+	bdb := createBadgerDb(t, 10, 1)
+
+	err := bdb.Has([]byte("key4"))
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+}
+
+func TestDB_RemovePresent(t *testing.T) {
+	key, val := []byte("key5"), []byte("value5")
+	bdb := createBadgerDb(t, 10, 1)
+
+	err := bdb.Put(key, val)
+	assert.Nil(t, err)
+
+	err = bdb.Remove(key)
+	assert.Nil(t, err)
+
+	err = bdb.Has(key)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+}
+
+func TestDB_RemoveNotPresent(t *testing.T) {
+	bdb := createBadgerDb(t, 10, 1)
+
+	err := bdb.Remove([]byte("key6"))
+	assert.Nil(t, err)
+}
+
+func TestDB_Close(t *testing.T) {
+	bdb := createBadgerDb(t, 10, 1)
+
+	err := bdb.Close()
+	assert.Nil(t, err)
+}
+
+func TestDB_Destroy(t *testing.T) {
+	bdb := createBadgerDb(t, 10, 1)
+
+	err := bdb.Destroy()
+	assert.Nil(t, err)
+}
+
+func TestDB_RangeKeys(t *testing.T) {
+	bdb := createBadgerDb(t, 1, 1)
+	defer func() {
+		_ = bdb.Close()
+	}()
+
+	keysVals := map[string][]byte{
+		"key1": []byte("value1"),
+		"key2": []byte("value2"),
+		"key3": []byte("value3"),
+		"key4": []byte("value4"),
+		"key5": []byte("value5"),
+		"key6": []byte("value6"),
+		"key7": []byte("value7"),
+	}
+
+	for key, val := range keysVals {
+		_ = bdb.Put([]byte(key), val)
+	}
+
+	recovered := make(map[string][]byte)
+	handler := func(key []byte, val []byte) bool {
+		recovered[string(key)] = val
+		return true
+	}
+
+	bdb.RangeKeys(handler)
+
+	assert.Equal(t, keysVals, recovered)
+}
+
+func TestDB_RangeKeysShouldStopWhenHandlerReturnsFalse(t *testing.T) {
+	bdb := createBadgerDb(t, 1, 1)
+	defer func() {
+		_ = bdb.Close()
+	}()
+
+	for i := 0; i < 10; i++ {
+		_ = bdb.Put([]byte(fmt.Sprintf("key%d", i)), []byte("value"))
+	}
+
+	numCalls := 0
+	bdb.RangeKeys(func(key []byte, val []byte) bool {
+		numCalls++
+		return numCalls < 3
+	})
+
+	assert.Equal(t, 3, numCalls)
+}
+
+func TestDB_RangeKeysWithError(t *testing.T) {
+	bdb := createBadgerDb(t, 1, 1)
+	_ = bdb.Put([]byte("key"), []byte("value"))
+
+	numCalls := 0
+	err := bdb.RangeKeysWithError(func(key []byte, val []byte) bool {
+		numCalls++
+		return true
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, numCalls)
+
+	_ = bdb.Close()
+	err = bdb.RangeKeysWithError(func(key []byte, val []byte) bool {
+		return true
+	})
+	assert.Equal(t, errors.ErrDBIsClosed, err)
+}
+
+func TestDB_PutGetLargeValue(t *testing.T) {
+	t.Parallel()
+
+	buffLargeValue := make([]byte, 32*100000)
+	key := []byte("key")
+	_, _ = rand.Read(buffLargeValue)
+
+	bdb := createBadgerDb(t, 1, 1)
+	defer func() {
+		_ = bdb.Close()
+	}()
+
+	err := bdb.Put(key, buffLargeValue)
+	assert.Nil(t, err)
+
+	recovered, err := bdb.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, buffLargeValue, recovered)
+}
+
+func TestDB_MethodCallsAfterCloseOrDestroy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("when closing", func(t *testing.T) {
+		t.Parallel()
+
+		testDbAllMethodsShouldNotPanic(t, func(db *badgerdb.DB) {
+			_ = db.Close()
+		})
+	})
+	t.Run("when destroying", func(t *testing.T) {
+		t.Parallel()
+
+		testDbAllMethodsShouldNotPanic(t, func(db *badgerdb.DB) {
+			_ = db.Destroy()
+		})
+	})
+}
+
+func testDbAllMethodsShouldNotPanic(t *testing.T, closeHandler func(db *badgerdb.DB)) {
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panic %v", r))
+		}
+	}()
+
+	bdb := createBadgerDb(t, 1, 1)
+	closeHandler(bdb)
+
+	err := bdb.Put([]byte("key1"), []byte("val1"))
+	require.Equal(t, errors.ErrDBIsClosed, err)
+
+	_, err = bdb.Get([]byte("key2"))
+	require.Equal(t, errors.ErrDBIsClosed, err)
+
+	err = bdb.Has([]byte("key3"))
+	require.Equal(t, errors.ErrDBIsClosed, err)
+
+	bdb.RangeKeys(func(key []byte, value []byte) bool {
+		require.Fail(t, "should have not called range")
+		return false
+	})
+
+	err = bdb.Remove([]byte("key4"))
+	require.Equal(t, errors.ErrDBIsClosed, err)
+}
+
+func TestDB_SpecialValueTest(t *testing.T) {
+	t.Parallel()
+
+	bdb := createBadgerDb(t, 100, 100)
+	key := []byte("key")
+	value := []byte("value")
+	t.Run("operations: put -> remove -> get", func(t *testing.T) {
+		err := bdb.Put(key, value)
+		require.Nil(t, err)
+
+		err = bdb.Remove(key)
+		require.Nil(t, err)
+
+		recovered, err := bdb.Get(key)
+		assert.Equal(t, storage.ErrKeyNotFound, err)
+		assert.Nil(t, recovered)
+	})
+	t.Run("operations: put -> remove -> put -> get", func(t *testing.T) {
+		err := bdb.Put(key, value)
+		require.Nil(t, err)
+
+		err = bdb.Remove(key)
+		require.Nil(t, err)
+
+		err = bdb.Put(key, value)
+		require.Nil(t, err)
+
+		recovered, err := bdb.Get(key)
+		assert.Nil(t, err)
+		assert.Equal(t, value, recovered)
+	})
+
+	_ = bdb.Close()
+}
@@ -0,0 +1,153 @@
+package badgerdb_test
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/badgerdb"
+	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
+)
+
+// the trie workload is simulated with 32 bytes hashes as keys and values sized as the encoded trie nodes: leaf nodes
+// holding an account (~150 bytes), extension nodes (~70 bytes) and branch nodes with most children set (~550 bytes)
+var trieNodeValueSizes = []int{150, 150, 70, 550}
+
+const trieNodeKeySize = 32
+const numTrieNodesInDb = 100000
+
+// same batch settings as the AccountsTrieStorage from config.toml
+const trieBatchDelaySeconds = 2
+const trieMaxBatchSize = 45000
+
+type persisterCreator func(b *testing.B, path string) storage.Persister
+
+func createLevelDbPersister(b *testing.B, path string) storage.Persister {
+	db, err := leveldb.NewSerialDB(path, trieBatchDelaySeconds, trieMaxBatchSize, 10)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	return db
+}
+
+func createBadgerDbPersister(b *testing.B, path string) storage.Persister {
+	db, err := badgerdb.NewDB(path, trieBatchDelaySeconds, trieMaxBatchSize)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	return db
+}
+
+var persisterCreators = []struct {
+	name    string
+	creator persisterCreator
+}{
+	{name: "leveldb", creator: createLevelDbPersister},
+	{name: "badger", creator: createBadgerDbPersister},
+}
+
+func generateTrieNodes(numNodes int) ([][]byte, [][]byte) {
+	keys := make([][]byte, numNodes)
+	values := make([][]byte, numNodes)
+	for i := 0; i < numNodes; i++ {
+		keys[i] = make([]byte, trieNodeKeySize)
+		_, _ = rand.Read(keys[i])
+
+		values[i] = make([]byte, trieNodeValueSizes[i%len(trieNodeValueSizes)])
+		_, _ = rand.Read(values[i])
+	}
+
+	return keys, values
+}
+
+func createPopulatedPersister(b *testing.B, creator persisterCreator) (storage.Persister, [][]byte) {
+	dir := b.TempDir()
+	db := creator(b, dir)
+	keys, values := generateTrieNodes(numTrieNodesInDb)
+	for i := range keys {
+		_ = db.Put(keys[i], values[i])
+	}
+
+	// reopen the persister so that the reads are served by the engine instead of the pending batch
+	_ = db.Close()
+
+	return creator(b, dir), keys
+}
+
+func BenchmarkPersister_PutTrieNodes(b *testing.B) {
+	for _, pc := range persisterCreators {
+		creator := pc.creator
+		b.Run(pc.name, func(b *testing.B) {
+			db := creator(b, b.TempDir())
+			defer func() {
+				_ = db.Close()
+			}()
+
+			keys, values := generateTrieNodes(b.N)
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				_ = db.Put(keys[i], values[i])
+			}
+		})
+	}
+}
+
+func BenchmarkPersister_GetPresentTrieNodes(b *testing.B) {
+	for _, pc := range persisterCreators {
+		creator := pc.creator
+		b.Run(pc.name, func(b *testing.B) {
+			db, keys := createPopulatedPersister(b, creator)
+			defer func() {
+				_ = db.Close()
+			}()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				_, _ = db.Get(keys[i%len(keys)])
+			}
+		})
+	}
+}
+
+func BenchmarkPersister_GetMissingTrieNodes(b *testing.B) {
+	for _, pc := range persisterCreators {
+		creator := pc.creator
+		b.Run(pc.name, func(b *testing.B) {
+			db, _ := createPopulatedPersister(b, creator)
+			defer func() {
+				_ = db.Close()
+			}()
+
+			missingKeys, _ := generateTrieNodes(1000)
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				_ = db.Has(missingKeys[i%len(missingKeys)])
+			}
+		})
+	}
+}
+
+// BenchmarkPersister_PruneAndCommitTrieNodes mimics the trie pruning: for each new node written, an old one is removed
+func BenchmarkPersister_PruneAndCommitTrieNodes(b *testing.B) {
+	for _, pc := range persisterCreators {
+		creator := pc.creator
+		b.Run(pc.name, func(b *testing.B) {
+			db, oldKeys := createPopulatedPersister(b, creator)
+			defer func() {
+				_ = db.Close()
+			}()
+
+			keys, values := generateTrieNodes(b.N)
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				_ = db.Put(keys[i], values[i])
+				_ = db.Remove(oldKeys[i%len(oldKeys)])
+			}
+		})
+	}
+}
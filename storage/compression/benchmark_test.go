@@ -0,0 +1,247 @@
+package compression_test
+
+import (
+	"fmt"
+	"math/big"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
+	"github.com/ElrondNetwork/elrond-go-core/data/esdt"
+	"github.com/ElrondNetwork/elrond-go-core/hashing/blake2b"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+	"github.com/ElrondNetwork/elrond-go/common"
+	"github.com/ElrondNetwork/elrond-go/state"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/compression"
+	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/ElrondNetwork/elrond-go/testscommon/genericMocks"
+	storageMock "github.com/ElrondNetwork/elrond-go/testscommon/storage"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/stretchr/testify/require"
+)
+
+const measurementSeed = 2
+const numMeasuredAccounts = 20000
+const numBenchmarkAccounts = 2000
+
+var testMarshalizer = &marshal.GogoProtoMarshalizer{}
+var testHasher = blake2b.NewBlake2b()
+
+type compressionCase struct {
+	name        string
+	compressed  bool
+	compression compression.Type
+}
+
+var compressionCases = []compressionCase{
+	{name: "raw"},
+	{name: "snappy", compressed: true, compression: compression.Snappy},
+	{name: "zstd", compressed: true, compression: compression.Zstd},
+}
+
+func (cc compressionCase) wrap(tb testing.TB, db storage.Persister) storage.Persister {
+	if !cc.compressed {
+		return db
+	}
+
+	p, err := compression.NewPersister(compression.ArgsPersister{
+		Persister: db,
+		Type:      cc.compression,
+	})
+	require.Nil(tb, err)
+
+	return p
+}
+
+func createTrie(tb testing.TB, storageManager common.StorageManager) common.Trie {
+	tr, err := trie.NewTrie(storageManager, testMarshalizer, testHasher, 5)
+	require.Nil(tb, err)
+
+	return tr
+}
+
+func randomBytes(r *rand.Rand, size int) []byte {
+	buff := make([]byte, size)
+	_, _ = r.Read(buff)
+
+	return buff
+}
+
+func randomBigInt(r *rand.Rand, maxDigits int) *big.Int {
+	value, _ := big.NewInt(0).SetString(fmt.Sprintf("%d%0*d", r.Intn(9)+1, r.Intn(maxDigits), 0), 10)
+	return value.Add(value, big.NewInt(r.Int63()))
+}
+
+// generateTrieNodes builds an accounts trie resembling the mainnet one: user accounts with balances of up to 10^21,
+// a tenth of them being smart contracts, and a fourth of them owning a data trie with ESDT tokens. It returns the
+// encoded nodes of the accounts trie and of the data tries, as saved in the trie storer
+func generateTrieNodes(tb testing.TB, numAccounts int, seed int64) ([][]byte, [][]byte) {
+	r := rand.New(rand.NewSource(seed))
+	args, options := storageMock.GetStorageManagerArgsAndOptions()
+	mainStorer := genericMocks.NewStorerMock()
+	args.MainStorer = mainStorer
+	args.Marshalizer = testMarshalizer
+	args.Hasher = testHasher
+	storageManager, err := trie.CreateTrieStorageManager(args, options)
+	require.Nil(tb, err)
+
+	tokens := make([]string, 50)
+	for i := range tokens {
+		tokens[i] = fmt.Sprintf("TKN%d-%06x", i, r.Intn(1<<24))
+	}
+
+	accountsTrie := createTrie(tb, storageManager)
+	for i := 0; i < numAccounts; i++ {
+		address := randomBytes(r, 32)
+		account := &state.UserAccountData{
+			Nonce:   uint64(r.Intn(5000)),
+			Balance: randomBigInt(r, 21),
+			Address: address,
+		}
+		if i%10 == 0 {
+			account.CodeHash = randomBytes(r, 32)
+			account.CodeMetadata = []byte{5, 0}
+			account.OwnerAddress = randomBytes(r, 32)
+			account.DeveloperReward = randomBigInt(r, 18)
+		}
+		if i%4 == 0 {
+			account.RootHash = generateDataTrie(tb, r, storageManager, address, tokens)
+		}
+
+		buff, errMarshal := testMarshalizer.Marshal(account)
+		require.Nil(tb, errMarshal)
+		require.Nil(tb, accountsTrie.Update(testHasher.Compute(string(address)), buff))
+	}
+	require.Nil(tb, accountsTrie.Commit())
+
+	keys := make([][]byte, 0)
+	values := make([][]byte, 0)
+	mainStorer.RangeKeys(func(key []byte, val []byte) bool {
+		keys = append(keys, key)
+		values = append(values, val)
+		return true
+	})
+
+	return keys, values
+}
+
+func generateDataTrie(tb testing.TB, r *rand.Rand, storageManager common.StorageManager, address []byte, tokens []string) []byte {
+	dataTrie := createTrie(tb, storageManager)
+	numTokens := r.Intn(5) + 1
+	for i := 0; i < numTokens; i++ {
+		key := []byte(core.ElrondProtectedKeyPrefix + core.ESDTKeyIdentifier + tokens[r.Intn(len(tokens))])
+		token := &esdt.ESDigitalToken{
+			Value: randomBigInt(r, 24),
+		}
+		buff, err := testMarshalizer.Marshal(token)
+		require.Nil(tb, err)
+
+		// the data trie values are suffixed with the key and the address, as done by the trackable data trie
+		value := append(append(buff, key...), address...)
+		require.Nil(tb, dataTrie.Update(testHasher.Compute(string(key)), value))
+	}
+	require.Nil(tb, dataTrie.Commit())
+
+	rootHash, err := dataTrie.RootHash()
+	require.Nil(tb, err)
+
+	return rootHash
+}
+
+func directorySize(tb testing.TB, path string) int64 {
+	size := int64(0)
+	err := filepath.Walk(path, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			size += info.Size()
+		}
+		return nil
+	})
+	require.Nil(tb, err)
+
+	return size
+}
+
+// TestPersister_TrieNodesSizes measures the size of the trie nodes, as values handed to the persister and as
+// LevelDB files, which are already compressed by LevelDB with snappy, block by block
+func TestPersister_TrieNodesSizes(t *testing.T) {
+	if testing.Short() {
+		t.Skip("this is not a short test")
+	}
+
+	keys, values := generateTrieNodes(t, numMeasuredAccounts, measurementSeed)
+	rawSize := 0
+	for _, value := range values {
+		rawSize += len(value)
+	}
+	t.Logf("%d trie nodes, %d bytes", len(values), rawSize)
+
+	for _, cc := range compressionCases {
+		memDB := memorydb.New()
+		memPersister := cc.wrap(t, memDB)
+		dir := t.TempDir()
+		levelDB, err := leveldb.NewSerialDB(dir, 2, 45000, 10)
+		require.Nil(t, err)
+		levelDBPersister := cc.wrap(t, levelDB)
+
+		for i := range keys {
+			require.Nil(t, memPersister.Put(keys[i], values[i]))
+			require.Nil(t, levelDBPersister.Put(keys[i], values[i]))
+		}
+		require.Nil(t, levelDB.Compact())
+		require.Nil(t, levelDBPersister.Close())
+
+		storedSize := 0
+		memDB.RangeKeys(func(_ []byte, val []byte) bool {
+			storedSize += len(val)
+			return true
+		})
+		for i := range keys {
+			recovered, errGet := memPersister.Get(keys[i])
+			require.Nil(t, errGet)
+			require.Equal(t, values[i], recovered)
+		}
+
+		t.Logf("%-16s values %10d bytes (%5.1f%%), leveldb files %10d bytes",
+			cc.name, storedSize, 100*float64(storedSize)/float64(rawSize), directorySize(t, dir))
+	}
+}
+
+func BenchmarkPersister_PutTrieNodes(b *testing.B) {
+	keys, values := generateTrieNodes(b, numBenchmarkAccounts, measurementSeed)
+	for _, cc := range compressionCases {
+		caseToRun := cc
+		b.Run(cc.name, func(b *testing.B) {
+			db := caseToRun.wrap(b, memorydb.New())
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				_ = db.Put(keys[i%len(keys)], values[i%len(values)])
+			}
+		})
+	}
+}
+
+func BenchmarkPersister_GetTrieNodes(b *testing.B) {
+	keys, values := generateTrieNodes(b, numBenchmarkAccounts, measurementSeed)
+	for _, cc := range compressionCases {
+		caseToRun := cc
+		b.Run(cc.name, func(b *testing.B) {
+			db := caseToRun.wrap(b, memorydb.New())
+			for i := range keys {
+				_ = db.Put(keys[i], values[i])
+			}
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				_, _ = db.Get(keys[i%len(keys)])
+			}
+		})
+	}
+}
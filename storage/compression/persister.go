@@ -0,0 +1,255 @@
+package compression
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	logger "github.com/ElrondNetwork/elrond-go-logger"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+var _ storage.Persister = (*persister)(nil)
+
+var log = logger.GetOrCreate("storage/compression")
+
+// Type represents the compression algorithm applied to the values written by the persister
+type Type string
+
+// None, Snappy and Zstd are the supported compression types. None only decodes the already compressed values, so
+// that the compression of a storer can be turned off without making its database unreadable
+const (
+	None   Type = "None"
+	Snappy Type = "Snappy"
+	Zstd   Type = "Zstd"
+)
+
+// The first byte of a written value tells how the rest of it is encoded. The markers are chosen among the bytes that
+// can not start a protobuf message (field number 0 or the unused wire types 6 and 7), so the values written before the
+// compression was enabled are told apart and returned as they are, as long as the storer holds protobuf messages,
+// such as the trie nodes, the headers, the miniblocks and the transactions
+const (
+	markerUncompressed byte = 0x06
+	markerSnappy       byte = 0x07
+	markerZstd         byte = 0x0F
+)
+
+// compressedDatabaseKey is written by the persisters writing compressed values, so that the database is not opened
+// later without a compression type, which would return the compressed values as they are
+var compressedDatabaseKey = []byte("compressedDatabase")
+
+// errorReportingRanger is implemented by the persisters reporting the errors which end their iteration prematurely
+type errorReportingRanger interface {
+	RangeKeysWithError(handler func(key []byte, val []byte) bool) error
+}
+
+// ArgsPersister holds the arguments needed to create a compressing persister
+type ArgsPersister struct {
+	Persister storage.Persister
+	Type      Type
+}
+
+// persister is a storage.Persister decorator compressing the values before handing them to the wrapped persister.
+// A value is stored compressed only if that makes it shorter. The zstd coders are only used for stateless
+// (de)compression, holding no goroutines, so they are not released when the persister is closed: a read racing with
+// the closing of the persister must not find them released
+type persister struct {
+	storage.Persister
+	compressionType Type
+	encoder         *zstd.Encoder
+	decoder         *zstd.Decoder
+}
+
+// NewPersister creates a persister which compresses the values written to the provided one, using the provided
+// compression type. Values compressed with any of the supported types are decoded, regardless of the type used for
+// writing
+func NewPersister(args ArgsPersister) (*persister, error) {
+	if check.IfNil(args.Persister) {
+		return nil, storage.ErrNilPersister
+	}
+
+	switch args.Type {
+	case None, Snappy, Zstd:
+	default:
+		return nil, fmt.Errorf("%w: %s", storage.ErrNotSupportedCompressionType, args.Type)
+	}
+
+	decoder, err := zstd.NewReader(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &persister{
+		Persister:       args.Persister,
+		compressionType: args.Type,
+		decoder:         decoder,
+	}
+
+	if args.Type == Zstd {
+		// the database engines checksum their own blocks
+		p.encoder, err = zstd.NewWriter(nil, zstd.WithEncoderCRC(false))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if args.Type != None {
+		err = args.Persister.Put(compressedDatabaseKey, []byte(args.Type))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return p, nil
+}
+
+// NewPersisterIfEnabled returns the provided persister if no compression type is set, or a compressing persister
+// wrapping it otherwise. A database which was written compressed can not be opened without a compression type. The
+// provided persister is closed if the compressing persister can not be created
+func NewPersisterIfEnabled(args ArgsPersister) (storage.Persister, error) {
+	if len(args.Type) == 0 {
+		if check.IfNil(args.Persister) || !IsCompressedDatabase(args.Persister) {
+			return args.Persister, nil
+		}
+
+		_ = args.Persister.Close()
+		return nil, fmt.Errorf("%w, its compression type must be set to %s", storage.ErrCompressedDatabase, None)
+	}
+
+	p, err := NewPersister(args)
+	if err != nil {
+		if !check.IfNil(args.Persister) {
+			_ = args.Persister.Close()
+		}
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// IsCompressedDatabase returns true if the provided persister holds values written by a compressing persister
+func IsCompressedDatabase(persister storage.Persister) bool {
+	return persister.Has(compressedDatabaseKey) == nil
+}
+
+// Put compresses the value and adds it to the wrapped persister
+func (p *persister) Put(key, val []byte) error {
+	return p.Persister.Put(key, p.encode(val))
+}
+
+// Get gets the value associated to the key from the wrapped persister and decompresses it
+func (p *persister) Get(key []byte) ([]byte, error) {
+	val, err := p.Persister.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.decode(val)
+}
+
+// RangeKeys iterates over the entries of the wrapped persister, handing the decompressed values to the handler.
+// The values which can not be decompressed are skipped
+func (p *persister) RangeKeys(handler func(key []byte, val []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	p.Persister.RangeKeys(func(key []byte, val []byte) bool {
+		if bytes.Equal(key, compressedDatabaseKey) {
+			return true
+		}
+
+		decoded, err := p.decode(val)
+		if err != nil {
+			log.Warn("persister.RangeKeys: cannot decompress value", "key", key, "error", err)
+			return true
+		}
+
+		return handler(key, decoded)
+	})
+}
+
+// RangeKeysWithError iterates over the entries of the wrapped persister, handing the decompressed values to the
+// handler. The iteration ends with an error on the first value which can not be decompressed, or on the first error
+// of the wrapped persister, if it reports them
+func (p *persister) RangeKeysWithError(handler func(key []byte, val []byte) bool) error {
+	if handler == nil {
+		return nil
+	}
+
+	var errDecode error
+	decodingHandler := func(key []byte, val []byte) bool {
+		if bytes.Equal(key, compressedDatabaseKey) {
+			return true
+		}
+
+		var decoded []byte
+		decoded, errDecode = p.decode(val)
+		if errDecode != nil {
+			errDecode = fmt.Errorf("%w while decompressing the value of key %x", errDecode, key)
+			return false
+		}
+
+		return handler(key, decoded)
+	}
+
+	var err error
+	wrappedRanger, ok := p.Persister.(errorReportingRanger)
+	if ok {
+		err = wrappedRanger.RangeKeysWithError(decodingHandler)
+	} else {
+		p.Persister.RangeKeys(decodingHandler)
+	}
+	if err != nil {
+		return err
+	}
+
+	return errDecode
+}
+
+func (p *persister) encode(val []byte) []byte {
+	var compressed []byte
+	switch p.compressionType {
+	case Snappy:
+		buff := make([]byte, 1+snappy.MaxEncodedLen(len(val)))
+		buff[0] = markerSnappy
+		compressed = buff[:1+len(snappy.Encode(buff[1:], val))]
+	case Zstd:
+		compressed = p.encoder.EncodeAll(val, []byte{markerZstd})
+	}
+
+	if len(compressed) > 0 && len(compressed) < len(val) {
+		return compressed
+	}
+	if len(val) > 0 && isMarker(val[0]) {
+		return append([]byte{markerUncompressed}, val...)
+	}
+
+	return val
+}
+
+func (p *persister) decode(val []byte) ([]byte, error) {
+	if len(val) == 0 || !isMarker(val[0]) {
+		return val, nil
+	}
+
+	switch val[0] {
+	case markerSnappy:
+		return snappy.Decode(nil, val[1:])
+	case markerZstd:
+		return p.decoder.DecodeAll(val[1:], nil)
+	default:
+		return val[1:], nil
+	}
+}
+
+func isMarker(b byte) bool {
+	return b == markerUncompressed || b == markerSnappy || b == markerZstd
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (p *persister) IsInterfaceNil() bool {
+	return p == nil
+}
@@ -0,0 +1,266 @@
+package compression
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var compressibleValue = bytes.Repeat([]byte("compressible trie node "), 20)
+var incompressibleValue = []byte("short value")
+
+func createPersister(t *testing.T, db storage.Persister, compressionType Type) *persister {
+	p, err := NewPersister(ArgsPersister{
+		Persister: db,
+		Type:      compressionType,
+	})
+	require.Nil(t, err)
+
+	return p
+}
+
+func TestNewPersister(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil persister should error", func(t *testing.T) {
+		t.Parallel()
+
+		p, err := NewPersister(ArgsPersister{Type: Snappy})
+		assert.Nil(t, p)
+		assert.Equal(t, storage.ErrNilPersister, err)
+	})
+	t.Run("unknown type should error", func(t *testing.T) {
+		t.Parallel()
+
+		p, err := NewPersister(ArgsPersister{Persister: memorydb.New(), Type: "lz4"})
+		assert.Nil(t, p)
+		assert.True(t, errors.Is(err, storage.ErrNotSupportedCompressionType))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		for _, compressionType := range []Type{None, Snappy, Zstd} {
+			p, err := NewPersister(ArgsPersister{Persister: memorydb.New(), Type: compressionType})
+			assert.Nil(t, err)
+			assert.False(t, p.IsInterfaceNil())
+		}
+	})
+}
+
+func TestNewPersisterIfEnabled(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no compression type should return the provided persister", func(t *testing.T) {
+		t.Parallel()
+
+		db := memorydb.New()
+		_ = createPersister(t, db, None)
+
+		p, err := NewPersisterIfEnabled(ArgsPersister{Persister: db})
+		assert.Nil(t, err)
+		assert.True(t, p == db)
+	})
+	t.Run("no compression type on a compressed database should error", func(t *testing.T) {
+		t.Parallel()
+
+		for _, compressionType := range []Type{Snappy, Zstd} {
+			db := memorydb.New()
+			_ = createPersister(t, db, compressionType)
+
+			p, err := NewPersisterIfEnabled(ArgsPersister{Persister: db})
+			assert.Nil(t, p)
+			assert.True(t, errors.Is(err, storage.ErrCompressedDatabase))
+
+			p, err = NewPersisterIfEnabled(ArgsPersister{Persister: db, Type: None})
+			assert.Nil(t, err)
+			assert.False(t, check.IfNil(p))
+		}
+	})
+	t.Run("unknown type should error", func(t *testing.T) {
+		t.Parallel()
+
+		p, err := NewPersisterIfEnabled(ArgsPersister{Persister: memorydb.New(), Type: "lz4"})
+		assert.Nil(t, p)
+		assert.True(t, errors.Is(err, storage.ErrNotSupportedCompressionType))
+	})
+}
+
+func TestPersister_PutGet(t *testing.T) {
+	t.Parallel()
+
+	values := [][]byte{
+		compressibleValue,
+		incompressibleValue,
+		{markerUncompressed},
+		append([]byte{markerSnappy}, incompressibleValue...),
+		append([]byte{markerZstd}, compressibleValue...),
+		{},
+	}
+
+	for _, compressionType := range []Type{None, Snappy, Zstd} {
+		p := createPersister(t, memorydb.New(), compressionType)
+		for i, value := range values {
+			key := []byte{byte(i)}
+			require.Nil(t, p.Put(key, value))
+
+			recovered, err := p.Get(key)
+			assert.Nil(t, err)
+			assert.Equal(t, value, recovered, "type %s, value %d", compressionType, i)
+		}
+	}
+}
+
+func TestPersister_PutShouldCompressOnlyIfShorter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("snappy", func(t *testing.T) {
+		t.Parallel()
+
+		db := memorydb.New()
+		p := createPersister(t, db, Snappy)
+
+		_ = p.Put([]byte("compressible"), compressibleValue)
+		stored, _ := db.Get([]byte("compressible"))
+		assert.Equal(t, markerSnappy, stored[0])
+		assert.Less(t, len(stored), len(compressibleValue))
+
+		_ = p.Put([]byte("incompressible"), incompressibleValue)
+		stored, _ = db.Get([]byte("incompressible"))
+		assert.Equal(t, incompressibleValue, stored)
+	})
+	t.Run("zstd", func(t *testing.T) {
+		t.Parallel()
+
+		db := memorydb.New()
+		p := createPersister(t, db, Zstd)
+
+		_ = p.Put([]byte("compressible"), compressibleValue)
+		stored, _ := db.Get([]byte("compressible"))
+		assert.Equal(t, markerZstd, stored[0])
+		assert.Less(t, len(stored), len(compressibleValue))
+
+		_ = p.Put([]byte("incompressible"), incompressibleValue)
+		stored, _ = db.Get([]byte("incompressible"))
+		assert.Equal(t, incompressibleValue, stored)
+	})
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		db := memorydb.New()
+		p := createPersister(t, db, None)
+
+		_ = p.Put([]byte("compressible"), compressibleValue)
+		stored, _ := db.Get([]byte("compressible"))
+		assert.Equal(t, compressibleValue, stored)
+	})
+	t.Run("value starting with a marker should be escaped", func(t *testing.T) {
+		t.Parallel()
+
+		db := memorydb.New()
+		p := createPersister(t, db, None)
+		value := append([]byte{markerZstd}, incompressibleValue...)
+
+		_ = p.Put([]byte("key"), value)
+		stored, _ := db.Get([]byte("key"))
+		assert.Equal(t, append([]byte{markerUncompressed}, value...), stored)
+	})
+}
+
+func TestPersister_GetShouldReadMixedValues(t *testing.T) {
+	t.Parallel()
+
+	db := memorydb.New()
+	// written before the compression was enabled
+	_ = db.Put([]byte("raw"), compressibleValue)
+	_ = createPersister(t, db, Snappy).Put([]byte("snappy"), compressibleValue)
+	_ = createPersister(t, db, Zstd).Put([]byte("zstd"), compressibleValue)
+
+	for _, compressionType := range []Type{None, Snappy, Zstd} {
+		p := createPersister(t, db, compressionType)
+		for _, key := range []string{"raw", "snappy", "zstd"} {
+			recovered, err := p.Get([]byte(key))
+			assert.Nil(t, err)
+			assert.Equal(t, compressibleValue, recovered, "type %s, key %s", compressionType, key)
+		}
+	}
+}
+
+func TestPersister_GetErrors(t *testing.T) {
+	t.Parallel()
+
+	db := memorydb.New()
+	p := createPersister(t, db, Zstd)
+
+	recovered, err := p.Get([]byte("missing"))
+	assert.Nil(t, recovered)
+	assert.NotNil(t, err)
+
+	_ = db.Put([]byte("corrupted"), []byte{markerSnappy, 0xFF, 0xFF, 0xFF})
+	recovered, err = p.Get([]byte("corrupted"))
+	assert.Nil(t, recovered)
+	assert.NotNil(t, err)
+}
+
+func TestPersister_RangeKeys(t *testing.T) {
+	t.Parallel()
+
+	db := memorydb.New()
+	p := createPersister(t, db, Zstd)
+	_ = p.Put([]byte("compressible"), compressibleValue)
+	_ = p.Put([]byte("incompressible"), incompressibleValue)
+	_ = db.Put([]byte("corrupted"), []byte{markerZstd, 0xFF, 0xFF, 0xFF})
+
+	p.RangeKeys(nil)
+
+	recovered := make(map[string][]byte)
+	p.RangeKeys(func(key []byte, val []byte) bool {
+		recovered[string(key)] = val
+		return true
+	})
+
+	assert.Equal(t, map[string][]byte{
+		"compressible":   compressibleValue,
+		"incompressible": incompressibleValue,
+	}, recovered)
+}
+
+func TestPersister_RangeKeysWithError(t *testing.T) {
+	t.Parallel()
+
+	db := memorydb.New()
+	p := createPersister(t, db, Zstd)
+	_ = p.Put([]byte("compressible"), compressibleValue)
+
+	recovered := make(map[string][]byte)
+	err := p.RangeKeysWithError(func(key []byte, val []byte) bool {
+		recovered[string(key)] = val
+		return true
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, map[string][]byte{"compressible": compressibleValue}, recovered)
+
+	_ = db.Put([]byte("corrupted"), []byte{markerZstd, 0xFF, 0xFF, 0xFF})
+	err = p.RangeKeysWithError(func(key []byte, val []byte) bool {
+		return true
+	})
+	assert.NotNil(t, err)
+}
+
+func TestPersister_ShouldPassThroughToTheWrappedPersister(t *testing.T) {
+	t.Parallel()
+
+	db := memorydb.New()
+	p := createPersister(t, db, Snappy)
+	_ = p.Put([]byte("key"), compressibleValue)
+
+	assert.Nil(t, p.Has([]byte("key")))
+	assert.Nil(t, p.Remove([]byte("key")))
+	assert.NotNil(t, db.Has([]byte("key")))
+	assert.Nil(t, p.Close())
+}
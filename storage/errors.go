@@ -152,6 +152,12 @@ var ErrInvalidDefaultSpan = errors.New("invalid default span")
 // ErrInvalidCacheExpiry signals that an invalid cache expiry was provided
 var ErrInvalidCacheExpiry = errors.New("invalid cache expiry")
 
+// ErrNotSupportedCompressionType is raised when an unsupported compression type is provided
+var ErrNotSupportedCompressionType = errors.New("not supported compression type")
+
+// ErrCompressedDatabase is raised when a database holding compressed values is opened without a compression type
+var ErrCompressedDatabase = errors.New("the database holds compressed values")
+
 // IsNotFoundInStorageErr returns whether an error is a "not found in storage" error.
 // Currently, "item not found" storage errors are untyped (thus not distinguishable from others). E.g. see "pruningStorer.go".
 // As a workaround, we test the error message for a match.
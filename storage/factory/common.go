@@ -2,19 +2,21 @@ package factory
 
 import (
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/storage/compression"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 )
 
 // GetCacherFromConfig will return the cache config needed for storage unit from a config came from the toml file
 func GetCacherFromConfig(cfg config.CacheConfig) storageUnit.CacheConfig {
 	return storageUnit.CacheConfig{
-		Name:                 cfg.Name,
-		Capacity:             cfg.Capacity,
-		SizePerSender:        cfg.SizePerSender,
-		SizeInBytes:          cfg.SizeInBytes,
-		SizeInBytesPerSender: cfg.SizeInBytesPerSender,
-		Type:                 storageUnit.CacheType(cfg.Type),
-		Shards:               cfg.Shards,
+		Name:                               cfg.Name,
+		Capacity:                           cfg.Capacity,
+		SizePerSender:                      cfg.SizePerSender,
+		SizeInBytes:                        cfg.SizeInBytes,
+		SizeInBytesPerSender:               cfg.SizeInBytesPerSender,
+		Type:                               storageUnit.CacheType(cfg.Type),
+		Shards:                             cfg.Shards,
+		ReplaceByFeeMinGasPriceBumpPercent: cfg.ReplaceByFeeMinGasPriceBumpPercent,
 	}
 }
 
@@ -25,5 +27,6 @@ func GetDBFromConfig(cfg config.DBConfig) storageUnit.DBConfig {
 		MaxBatchSize:      cfg.MaxBatchSize,
 		BatchDelaySeconds: cfg.BatchDelaySeconds,
 		MaxOpenFiles:      cfg.MaxOpenFiles,
+		Compression:       compression.Type(cfg.Compression),
 	}
 }
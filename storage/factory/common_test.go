@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/config"
+	"github.com/ElrondNetwork/elrond-go/storage/compression"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
 	"github.com/stretchr/testify/assert"
 )
@@ -35,6 +36,7 @@ func TestGetDBFromConfig(t *testing.T) {
 		MaxBatchSize:      10,
 		BatchDelaySeconds: 2,
 		MaxOpenFiles:      20,
+		Compression:       "Zstd",
 	}
 
 	storageDBConfig := GetDBFromConfig(cfg)
@@ -43,5 +45,6 @@ func TestGetDBFromConfig(t *testing.T) {
 		MaxBatchSize:      cfg.MaxBatchSize,
 		BatchDelaySeconds: cfg.BatchDelaySeconds,
 		MaxOpenFiles:      cfg.MaxOpenFiles,
+		Compression:       compression.Zstd,
 	}, storageDBConfig)
 }
@@ -5,6 +5,8 @@ import (
 
 	"github.com/ElrondNetwork/elrond-go/config"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/badgerdb"
+	"github.com/ElrondNetwork/elrond-go/storage/compression"
 	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
 	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
@@ -16,6 +18,7 @@ type PersisterFactory struct {
 	batchDelaySeconds int
 	maxBatchSize      int
 	maxOpenFiles      int
+	compression       compression.Type
 }
 
 // NewPersisterFactory will return a new instance of a PersisterFactory
@@ -25,20 +28,36 @@ func NewPersisterFactory(config config.DBConfig) *PersisterFactory {
 		batchDelaySeconds: config.BatchDelaySeconds,
 		maxBatchSize:      config.MaxBatchSize,
 		maxOpenFiles:      config.MaxOpenFiles,
+		compression:       compression.Type(config.Compression),
 	}
 }
 
-// Create will return a new instance of a DB with a given path
+// Create will return a new instance of a DB with a given path. If a compression type is set, the DB is wrapped by a
+// persister compressing its values
 func (pf *PersisterFactory) Create(path string) (storage.Persister, error) {
 	if len(path) == 0 {
 		return nil, errors.New("invalid file path")
 	}
 
+	db, err := pf.createDB(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return compression.NewPersisterIfEnabled(compression.ArgsPersister{
+		Persister: db,
+		Type:      pf.compression,
+	})
+}
+
+func (pf *PersisterFactory) createDB(path string) (storage.Persister, error) {
 	switch storageUnit.DBType(pf.dbType) {
 	case storageUnit.LvlDB:
 		return leveldb.NewDB(path, pf.batchDelaySeconds, pf.maxBatchSize, pf.maxOpenFiles)
 	case storageUnit.LvlDBSerial:
 		return leveldb.NewSerialDB(path, pf.batchDelaySeconds, pf.maxBatchSize, pf.maxOpenFiles)
+	case storageUnit.BadgerDB:
+		return badgerdb.NewDB(path, pf.batchDelaySeconds, pf.maxBatchSize)
 	case storageUnit.MemoryDB:
 		return memorydb.New(), nil
 	default:
@@ -589,6 +589,54 @@ func (psf *StorageServiceFactory) setupDbLookupExtensions(chainStorer *dataRetri
 
 	chainStorer.AddStorer(dataRetriever.ESDTSuppliesUnit, esdtSuppliesUnit)
 
+	if psf.generalConfig.DbLookupExtensions.TxHashesByAddressEnabled {
+		// Create the txHashesByAddress (PRUNING) storer
+		txHashesByAddressConfig := psf.generalConfig.DbLookupExtensions.TxHashesByAddressStorageConfig
+		txHashesByAddressStorerArgs := psf.createPruningStorerArgs(txHashesByAddressConfig, disabled.NewDisabledCustomDatabaseRemover())
+		txHashesByAddressPruningStorer, err := psf.createPruningPersister(txHashesByAddressStorerArgs)
+		if err != nil {
+			return err
+		}
+
+		chainStorer.AddStorer(dataRetriever.TxHashesByAddressUnit, txHashesByAddressPruningStorer)
+
+		// Create the epochByAddress (STATIC) storer
+		epochByAddressConfig := psf.generalConfig.DbLookupExtensions.EpochByAddressStorageConfig
+		epochByAddressDbConfig := GetDBFromConfig(epochByAddressConfig.DB)
+		epochByAddressDbConfig.FilePath = psf.pathManager.PathForStatic(shardID, epochByAddressConfig.DB.FilePath)
+		epochByAddressCacherConfig := GetCacherFromConfig(epochByAddressConfig.Cache)
+		epochByAddressUnit, err := storageUnit.NewStorageUnitFromConf(epochByAddressCacherConfig, epochByAddressDbConfig)
+		if err != nil {
+			return err
+		}
+
+		chainStorer.AddStorer(dataRetriever.EpochByAddressUnit, epochByAddressUnit)
+	}
+
+	if psf.generalConfig.DbLookupExtensions.LogEventsIndexEnabled {
+		// Create the logEvents (PRUNING) storer
+		logEventsConfig := psf.generalConfig.DbLookupExtensions.LogEventsStorageConfig
+		logEventsStorerArgs := psf.createPruningStorerArgs(logEventsConfig, disabled.NewDisabledCustomDatabaseRemover())
+		logEventsPruningStorer, err := psf.createPruningPersister(logEventsStorerArgs)
+		if err != nil {
+			return err
+		}
+
+		chainStorer.AddStorer(dataRetriever.LogEventsUnit, logEventsPruningStorer)
+	}
+
+	if psf.generalConfig.DbLookupExtensions.StateDiffsIndexEnabled {
+		// Create the stateDiffs (PRUNING) storer
+		stateDiffsConfig := psf.generalConfig.DbLookupExtensions.StateDiffsStorageConfig
+		stateDiffsStorerArgs := psf.createPruningStorerArgs(stateDiffsConfig, disabled.NewDisabledCustomDatabaseRemover())
+		stateDiffsPruningStorer, err := psf.createPruningPersister(stateDiffsStorerArgs)
+		if err != nil {
+			return err
+		}
+
+		chainStorer.AddStorer(dataRetriever.StateDiffsUnit, stateDiffsPruningStorer)
+	}
+
 	return nil
 }
 
@@ -6,9 +6,11 @@ import (
 	"sync/atomic"
 	"time"
 
+	storageErrors "github.com/ElrondNetwork/elrond-go/errors"
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/errors"
 	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
 )
 
 const resourceUnavailable = "resource temporarily unavailable"
@@ -104,16 +106,27 @@ func (bldb *baseLevelDb) makeDbPointerNilReturningLast() *leveldb.DB {
 // RangeKeys will call the handler function for each (key, value) pair
 // If the handler returns true, the iteration will continue, otherwise will stop
 func (bldb *baseLevelDb) RangeKeys(handler func(key []byte, value []byte) bool) {
+	err := bldb.RangeKeysWithError(handler)
+	if err != nil && !storageErrors.IsClosingError(err) {
+		log.Warn("leveldb RangeKeys", "path", bldb.path, "error", err.Error())
+	}
+}
+
+// RangeKeysWithError calls the handler function for each (key, value) pair, as RangeKeys does, and returns the error
+// which ended the iteration prematurely, if any
+func (bldb *baseLevelDb) RangeKeysWithError(handler func(key []byte, value []byte) bool) error {
 	if handler == nil {
-		return
+		return nil
 	}
 
 	db := bldb.getDbPointer()
 	if db == nil {
-		return
+		return storageErrors.ErrDBIsClosed
 	}
 
 	iterator := db.NewIterator(nil, nil)
+	defer iterator.Release()
+
 	for {
 		if !iterator.Next() {
 			break
@@ -133,5 +146,16 @@ func (bldb *baseLevelDb) RangeKeys(handler func(key []byte, value []byte) bool)
 		}
 	}
 
-	iterator.Release()
+	return iterator.Error()
+}
+
+// Compact runs a full compaction of the database, reclaiming the space held by the overwritten or removed entries
+// The data still waiting in the batch of the persister is not included
+func (bldb *baseLevelDb) Compact() error {
+	db := bldb.getDbPointer()
+	if db == nil {
+		return storageErrors.ErrDBIsClosed
+	}
+
+	return db.CompactRange(util.Range{})
 }
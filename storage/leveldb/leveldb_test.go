@@ -282,6 +282,26 @@ func TestDB_RangeKeys(t *testing.T) {
 	assert.Equal(t, keysVals, recovered)
 }
 
+func TestDB_RangeKeysWithError(t *testing.T) {
+	ldb := createLevelDb(t, 1, 1, 10)
+	_ = ldb.Put([]byte("key"), []byte("value"))
+	time.Sleep(time.Second * 2)
+
+	numCalls := 0
+	err := ldb.RangeKeysWithError(func(key []byte, val []byte) bool {
+		numCalls++
+		return true
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, numCalls)
+
+	_ = ldb.Close()
+	err = ldb.RangeKeysWithError(func(key []byte, val []byte) bool {
+		return true
+	})
+	assert.Equal(t, errors.ErrDBIsClosed, err)
+}
+
 func TestDB_PutGetLargeValue(t *testing.T) {
 	t.Parallel()
 
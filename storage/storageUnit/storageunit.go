@@ -16,6 +16,8 @@ import (
 	logger "github.com/ElrondNetwork/elrond-go-logger"
 	"github.com/ElrondNetwork/elrond-go/common"
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/badgerdb"
+	"github.com/ElrondNetwork/elrond-go/storage/compression"
 	"github.com/ElrondNetwork/elrond-go/storage/fifocache"
 	"github.com/ElrondNetwork/elrond-go/storage/leveldb"
 	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
@@ -42,11 +44,12 @@ const (
 
 var log = logger.GetOrCreate("storage/storageUnit")
 
-// LvlDB currently the only supported DBs
+// LvlDB, LvlDBSerial, BadgerDB and MemoryDB are the supported DBs
 // More to be added
 const (
 	LvlDB       DBType = "LvlDB"
 	LvlDBSerial DBType = "LvlDBSerial"
+	BadgerDB    DBType = "BadgerDB"
 	MemoryDB    DBType = "MemoryDB"
 )
 
@@ -69,13 +72,14 @@ type UnitConfig struct {
 
 // CacheConfig holds the configurable elements of a cache
 type CacheConfig struct {
-	Name                 string
-	Type                 CacheType
-	SizeInBytes          uint64
-	SizeInBytesPerSender uint32
-	Capacity             uint32
-	SizePerSender        uint32
-	Shards               uint32
+	Name                               string
+	Type                               CacheType
+	SizeInBytes                        uint64
+	SizeInBytesPerSender               uint32
+	Capacity                           uint32
+	SizePerSender                      uint32
+	Shards                             uint32
+	ReplaceByFeeMinGasPriceBumpPercent uint32
 }
 
 // String returns a readable representation of the object
@@ -95,6 +99,7 @@ type DBConfig struct {
 	BatchDelaySeconds int
 	MaxBatchSize      int
 	MaxOpenFiles      int
+	Compression       compression.Type
 }
 
 // Unit represents a storer's data bank
@@ -299,6 +304,7 @@ func NewStorageUnitFromConf(cacheConf CacheConfig, dbConf DBConfig) (*Unit, erro
 		BatchDelaySeconds: dbConf.BatchDelaySeconds,
 		MaxBatchSize:      dbConf.MaxBatchSize,
 		MaxOpenFiles:      dbConf.MaxOpenFiles,
+		Compression:       dbConf.Compression,
 	}
 	db, err = NewDB(argDB)
 	if err != nil {
@@ -361,10 +367,26 @@ type ArgDB struct {
 	BatchDelaySeconds int
 	MaxBatchSize      int
 	MaxOpenFiles      int
+	Compression       compression.Type
 }
 
-// NewDB creates a new database from database config
+// NewDB creates a new database from database config. If a compression type is set, the database is wrapped by a
+// persister compressing its values
 func NewDB(argDB ArgDB) (storage.Persister, error) {
+	db, err := NewRawDB(argDB)
+	if err != nil {
+		return nil, err
+	}
+
+	return compression.NewPersisterIfEnabled(compression.ArgsPersister{
+		Persister: db,
+		Type:      argDB.Compression,
+	})
+}
+
+// NewRawDB creates a new database from database config, ignoring its compression type, so that the values are read
+// and written as they are stored
+func NewRawDB(argDB ArgDB) (storage.Persister, error) {
 	var db storage.Persister
 	var err error
 
@@ -374,6 +396,8 @@ func NewDB(argDB ArgDB) (storage.Persister, error) {
 			db, err = leveldb.NewDB(argDB.Path, argDB.BatchDelaySeconds, argDB.MaxBatchSize, argDB.MaxOpenFiles)
 		case LvlDBSerial:
 			db, err = leveldb.NewSerialDB(argDB.Path, argDB.BatchDelaySeconds, argDB.MaxBatchSize, argDB.MaxOpenFiles)
+		case BadgerDB:
+			db, err = badgerdb.NewDB(argDB.Path, argDB.BatchDelaySeconds, argDB.MaxBatchSize)
 		case MemoryDB:
 			db = memorydb.New()
 		default:
@@ -1,12 +1,15 @@
 package storageUnit_test
 
 import (
+	"errors"
 	"fmt"
 	"math/rand"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/ElrondNetwork/elrond-go/storage"
+	"github.com/ElrondNetwork/elrond-go/storage/compression"
 	"github.com/ElrondNetwork/elrond-go/storage/lrucache"
 	"github.com/ElrondNetwork/elrond-go/storage/memorydb"
 	"github.com/ElrondNetwork/elrond-go/storage/storageUnit"
@@ -347,6 +350,60 @@ func TestNewStorageUnit_FromConfWrongDBConfig(t *testing.T) {
 	assert.Nil(t, storer, "storer expected to be nil but got %s", storer)
 }
 
+func TestCreateDBFromConfBadgerDBOk(t *testing.T) {
+	arg := storageUnit.ArgDB{
+		DBType:            storageUnit.BadgerDB,
+		Path:              t.TempDir(),
+		BatchDelaySeconds: 10,
+		MaxBatchSize:      10,
+		MaxOpenFiles:      10,
+	}
+	persister, err := storageUnit.NewDB(arg)
+	assert.Nil(t, err, "no error expected")
+	assert.NotNil(t, persister, "valid persister expected but got nil")
+
+	err = persister.Destroy()
+	assert.Nil(t, err, "no error expected destroying the persister")
+}
+
+func TestCreateDBFromConfWrongCompression(t *testing.T) {
+	arg := storageUnit.ArgDB{
+		DBType:            storageUnit.LvlDBSerial,
+		Path:              t.TempDir(),
+		BatchDelaySeconds: 10,
+		MaxBatchSize:      10,
+		MaxOpenFiles:      10,
+		Compression:       "lz4",
+	}
+	persister, err := storageUnit.NewDB(arg)
+
+	assert.True(t, errors.Is(err, storage.ErrNotSupportedCompressionType))
+	assert.Nil(t, persister, "persister expected to be nil, but got %s", persister)
+}
+
+func TestCreateDBFromConfCompressionOk(t *testing.T) {
+	arg := storageUnit.ArgDB{
+		DBType:            storageUnit.LvlDBSerial,
+		Path:              t.TempDir(),
+		BatchDelaySeconds: 10,
+		MaxBatchSize:      10,
+		MaxOpenFiles:      10,
+		Compression:       compression.Snappy,
+	}
+	persister, err := storageUnit.NewDB(arg)
+	assert.Nil(t, err, "no error expected")
+
+	value := []byte(strings.Repeat("compressible value ", 10))
+	err = persister.Put([]byte("key"), value)
+	assert.Nil(t, err)
+	recovered, err := persister.Get([]byte("key"))
+	assert.Nil(t, err)
+	assert.Equal(t, value, recovered)
+
+	err = persister.Destroy()
+	assert.Nil(t, err, "no error expected destroying the persister")
+}
+
 func TestNewStorageUnit_FromConfLvlDBOk(t *testing.T) {
 	storer, err := storageUnit.NewStorageUnitFromConf(storageUnit.CacheConfig{
 		Capacity: 10,
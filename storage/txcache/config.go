@@ -17,6 +17,7 @@ const maxNumBytesPerSenderLowerBound = maxNumItemsPerSenderLowerBound * 1
 const maxNumBytesPerSenderUpperBound = 33_554_432 // 32 MB
 const numTxsToPreemptivelyEvictLowerBound = 1
 const numSendersToPreemptivelyEvictLowerBound = 1
+const replaceByFeeMinGasPriceBumpPercentUpperBound = 1000
 
 // ConfigSourceMe holds cache configuration
 type ConfigSourceMe struct {
@@ -28,6 +29,9 @@ type ConfigSourceMe struct {
 	CountThreshold                uint32
 	CountPerSenderThreshold       uint32
 	NumSendersToPreemptivelyEvict uint32
+	// ReplaceByFeeMinGasPriceBumpPercent is the minimum gas price increase, in percents, that a transaction needs in order
+	// to replace a transaction of the same sender, having the same nonce. Zero disables the replacement by fee
+	ReplaceByFeeMinGasPriceBumpPercent uint32
 }
 
 type senderConstraints struct {
@@ -49,6 +53,9 @@ func (config *ConfigSourceMe) verify() error {
 	if config.CountPerSenderThreshold < maxNumItemsPerSenderLowerBound {
 		return fmt.Errorf("%w: config.CountPerSenderThreshold is invalid", storage.ErrInvalidConfig)
 	}
+	if config.ReplaceByFeeMinGasPriceBumpPercent > replaceByFeeMinGasPriceBumpPercentUpperBound {
+		return fmt.Errorf("%w: config.ReplaceByFeeMinGasPriceBumpPercent is invalid", storage.ErrInvalidConfig)
+	}
 	if config.EvictionEnabled {
 		if config.NumBytesThreshold < maxNumBytesLowerBound || config.NumBytesThreshold > maxNumBytesUpperBound {
 			return fmt.Errorf("%w: config.NumBytesThreshold is invalid", storage.ErrInvalidConfig)
@@ -71,6 +78,10 @@ func (config *ConfigSourceMe) getSenderConstraints() senderConstraints {
 	}
 }
 
+func (config *ConfigSourceMe) isReplaceByFeeEnabled() bool {
+	return config.ReplaceByFeeMinGasPriceBumpPercent > 0
+}
+
 // String returns a readable representation of the object
 func (config *ConfigSourceMe) String() string {
 	bytes, err := json.Marshal(config)
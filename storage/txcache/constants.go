@@ -7,3 +7,9 @@ const senderGracePeriodLowerBound = 2
 const senderGracePeriodUpperBound = 2
 
 const numEvictedTxsToDisplay = 3
+
+const maxNumReplacementsInJournal = 10000
+
+const maxNumEvictionRoundsInHistory = 20
+
+const maxNumEvictedSendersInEvictionRound = 100
@@ -1,6 +1,8 @@
 package txcache
 
 import (
+	"time"
+
 	"github.com/ElrondNetwork/elrond-go-core/core"
 )
 
@@ -26,6 +28,12 @@ func (cache *TxCache) doEviction() {
 	}
 
 	stopWatch := cache.monitorEvictionStart()
+	round := &EvictionRound{
+		Timestamp:        time.Now().Unix(),
+		NumTxsBefore:     cache.CountTx(),
+		NumSendersBefore: cache.CountSenders(),
+		NumBytesBefore:   cache.NumBytes(),
+	}
 	cache.makeSnapshotOfSenders()
 
 	journal := evictionJournal{}
@@ -34,15 +42,39 @@ func (cache *TxCache) doEviction() {
 	cache.evictionJournal = journal
 
 	cache.monitorEvictionEnd(stopWatch)
+	cache.recordEvictionRound(round, journal, stopWatch.GetMeasurement("eviction"))
 	cache.destroySnapshotOfSenders()
 }
 
 func (cache *TxCache) makeSnapshotOfSenders() {
 	cache.evictionSnapshotOfSenders = cache.txListBySender.getSnapshotAscending()
+	cache.evictionEvictedSenders = make([][]byte, 0)
 }
 
 func (cache *TxCache) destroySnapshotOfSenders() {
 	cache.evictionSnapshotOfSenders = nil
+	cache.evictionEvictedSenders = nil
+}
+
+// collectEvictedSenders keeps track of (a limited number of) the senders evicted in the current eviction round
+func (cache *TxCache) collectEvictedSenders(listsToEvict []*txListForSender) {
+	for _, txList := range listsToEvict {
+		if len(cache.evictionEvictedSenders) >= maxNumEvictedSendersInEvictionRound {
+			return
+		}
+
+		cache.evictionEvictedSenders = append(cache.evictionEvictedSenders, []byte(txList.sender))
+	}
+}
+
+func (cache *TxCache) recordEvictionRound(round *EvictionRound, journal evictionJournal, duration time.Duration) {
+	round.Duration = duration
+	round.NumTxsEvicted = journal.passOneNumTxs
+	round.NumSendersEvicted = journal.passOneNumSenders
+	round.NumSteps = journal.passOneNumSteps
+	round.EvictedSenders = cache.evictionEvictedSenders
+
+	cache.evictionRounds.add(round)
 }
 
 func (cache *TxCache) isCapacityExceeded() bool {
@@ -71,6 +103,7 @@ func (cache *TxCache) areThereTooManyTxs() bool {
 func (cache *TxCache) doEvictItems(txsToEvict [][]byte, sendersToEvict []string) (countTxs uint32, countSenders uint32) {
 	countTxs = cache.txByHash.RemoveTxsBulk(txsToEvict)
 	countSenders = cache.txListBySender.RemoveSendersBulk(sendersToEvict)
+	cache.notifyEvicted(txsToEvict)
 	return
 }
 
@@ -96,6 +129,7 @@ func (cache *TxCache) evictSendersWhile(shouldContinue func() bool) (step uint32
 		batch := snapshot[batchStart:batchEndBounded]
 
 		numTxsEvictedInStep, numSendersEvictedInStep := cache.evictSendersAndTheirTxs(batch)
+		cache.collectEvictedSenders(batch)
 
 		numTxs += numTxsEvictedInStep
 		numSenders += numSendersEvictedInStep
@@ -0,0 +1,60 @@
+package txcache
+
+import (
+	"sync"
+	"time"
+)
+
+// EvictionRound holds the details of an eviction performed because the capacity of the cache was exceeded
+type EvictionRound struct {
+	Timestamp         int64
+	Duration          time.Duration
+	NumTxsBefore      uint64
+	NumSendersBefore  uint64
+	NumBytesBefore    int
+	NumTxsEvicted     uint32
+	NumSendersEvicted uint32
+	NumSteps          uint32
+	EvictedSenders    [][]byte
+}
+
+// evictionRoundsHistory keeps the most recent eviction rounds. When the capacity is reached, the oldest rounds are forgotten
+type evictionRoundsHistory struct {
+	mutex    sync.RWMutex
+	capacity int
+	rounds   []*EvictionRound
+}
+
+func newEvictionRoundsHistory(capacity int) *evictionRoundsHistory {
+	return &evictionRoundsHistory{
+		capacity: capacity,
+		rounds:   make([]*EvictionRound, 0, capacity),
+	}
+}
+
+func (history *evictionRoundsHistory) add(round *EvictionRound) {
+	history.mutex.Lock()
+	defer history.mutex.Unlock()
+
+	history.rounds = append(history.rounds, round)
+	if len(history.rounds) > history.capacity {
+		history.rounds = history.rounds[len(history.rounds)-history.capacity:]
+	}
+}
+
+// getAll returns the eviction rounds, from the oldest to the most recent one
+func (history *evictionRoundsHistory) getAll() []*EvictionRound {
+	history.mutex.RLock()
+	defer history.mutex.RUnlock()
+
+	result := make([]*EvictionRound, len(history.rounds))
+	copy(result, history.rounds)
+
+	return result
+}
+
+func (history *evictionRoundsHistory) clear() {
+	history.mutex.Lock()
+	history.rounds = make([]*EvictionRound, 0, history.capacity)
+	history.mutex.Unlock()
+}
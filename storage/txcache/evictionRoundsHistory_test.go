@@ -0,0 +1,22 @@
+package txcache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEvictionRoundsHistory_AddShouldForgetTheOldestRounds(t *testing.T) {
+	t.Parallel()
+
+	history := newEvictionRoundsHistory(2)
+	history.add(&EvictionRound{Timestamp: 1})
+	history.add(&EvictionRound{Timestamp: 2})
+	require.Equal(t, []*EvictionRound{{Timestamp: 1}, {Timestamp: 2}}, history.getAll())
+
+	history.add(&EvictionRound{Timestamp: 3})
+	require.Equal(t, []*EvictionRound{{Timestamp: 2}, {Timestamp: 3}}, history.getAll())
+
+	history.clear()
+	require.Empty(t, history.getAll())
+}
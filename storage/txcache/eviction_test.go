@@ -113,6 +113,65 @@ func TestEviction_DoEvictionDoneInPassTwo_BecauseOfCount(t *testing.T) {
 	require.Equal(t, uint64(1), cache.CountTx())
 }
 
+func TestEviction_DoEvictionShouldRecordTheEvictionRound(t *testing.T) {
+	config := ConfigSourceMe{
+		Name:                          "untitled",
+		NumChunks:                     16,
+		NumBytesThreshold:             maxNumBytesUpperBound,
+		NumBytesPerSenderThreshold:    maxNumBytesPerSenderUpperBound,
+		CountThreshold:                2,
+		CountPerSenderThreshold:       math.MaxUint32,
+		NumSendersToPreemptivelyEvict: 2,
+	}
+	txGasHandler, _ := dummyParamsWithGasPrice(100 * oneBillion)
+	cache, err := NewTxCache(config, txGasHandler)
+	require.Nil(t, err)
+
+	cache.AddTx(createTxWithParams([]byte("hash-alice"), "alice", uint64(1), 1000, 100000, 100*oneBillion))
+	cache.AddTx(createTxWithParams([]byte("hash-bob"), "bob", uint64(1), 1000, 100000, 100*oneBillion))
+	cache.AddTx(createTxWithParams([]byte("hash-carol"), "carol", uint64(1), 1000, 100000, 700*oneBillion))
+
+	cache.doEviction()
+
+	rounds := cache.GetEvictionRounds()
+	require.Len(t, rounds, 1)
+	require.Equal(t, uint64(3), rounds[0].NumTxsBefore)
+	require.Equal(t, uint64(3), rounds[0].NumSendersBefore)
+	require.Equal(t, uint32(2), rounds[0].NumTxsEvicted)
+	require.Equal(t, uint32(2), rounds[0].NumSendersEvicted)
+	require.Equal(t, uint32(1), rounds[0].NumSteps)
+	require.ElementsMatch(t, [][]byte{[]byte("alice"), []byte("bob")}, rounds[0].EvictedSenders)
+	require.Nil(t, cache.evictionEvictedSenders)
+}
+
+func TestEviction_DoEvictionShouldNotifyTheEvictedTxs(t *testing.T) {
+	config := ConfigSourceMe{
+		Name:                          "untitled",
+		NumChunks:                     16,
+		NumBytesThreshold:             maxNumBytesUpperBound,
+		NumBytesPerSenderThreshold:    maxNumBytesPerSenderUpperBound,
+		CountThreshold:                2,
+		CountPerSenderThreshold:       math.MaxUint32,
+		NumSendersToPreemptivelyEvict: 2,
+	}
+	txGasHandler, _ := dummyParamsWithGasPrice(100 * oneBillion)
+	cache, err := NewTxCache(config, txGasHandler)
+	require.Nil(t, err)
+
+	evictedTxs := make([][]byte, 0)
+	cache.RegisterOnTxsEvicted(func(txHashes [][]byte) {
+		evictedTxs = append(evictedTxs, txHashes...)
+	})
+
+	cache.AddTx(createTxWithParams([]byte("hash-alice"), "alice", uint64(1), 1000, 100000, 100*oneBillion))
+	cache.AddTx(createTxWithParams([]byte("hash-bob"), "bob", uint64(1), 1000, 100000, 100*oneBillion))
+	cache.AddTx(createTxWithParams([]byte("hash-carol"), "carol", uint64(1), 1000, 100000, 700*oneBillion))
+
+	cache.doEviction()
+
+	require.ElementsMatch(t, [][]byte{[]byte("hash-alice"), []byte("hash-bob")}, evictedTxs)
+}
+
 func TestEviction_DoEvictionDoneInPassTwo_BecauseOfSize(t *testing.T) {
 	config := ConfigSourceMe{
 		Name:                          "untitled",
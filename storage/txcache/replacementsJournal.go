@@ -0,0 +1,77 @@
+package txcache
+
+import (
+	"sync"
+)
+
+// TxReplacement holds the details of a transaction which was replaced by a transaction of the same sender, having the
+// same nonce and a higher gas price
+type TxReplacement struct {
+	Sender              []byte
+	Nonce               uint64
+	ReplacedTxHash      []byte
+	ReplacedGasPrice    uint64
+	ReplacementTxHash   []byte
+	ReplacementGasPrice uint64
+}
+
+// replacementsJournal keeps the most recent replacements, grouped by sender. When the capacity is reached, the oldest
+// replacements are forgotten
+type replacementsJournal struct {
+	mutex    sync.RWMutex
+	capacity int
+	bySender map[string][]*TxReplacement
+	order    []*TxReplacement
+}
+
+func newReplacementsJournal(capacity int) *replacementsJournal {
+	return &replacementsJournal{
+		capacity: capacity,
+		bySender: make(map[string][]*TxReplacement),
+		order:    make([]*TxReplacement, 0),
+	}
+}
+
+func (journal *replacementsJournal) add(replacement *TxReplacement) {
+	journal.mutex.Lock()
+	defer journal.mutex.Unlock()
+
+	sender := string(replacement.Sender)
+	journal.bySender[sender] = append(journal.bySender[sender], replacement)
+	journal.order = append(journal.order, replacement)
+
+	for len(journal.order) > journal.capacity {
+		oldest := journal.order[0]
+		journal.order = journal.order[1:]
+		journal.removeOldestOfSender(string(oldest.Sender))
+	}
+}
+
+// This function should only be used in critical section (journal.mutex)
+func (journal *replacementsJournal) removeOldestOfSender(sender string) {
+	replacementsOfSender := journal.bySender[sender]
+	if len(replacementsOfSender) <= 1 {
+		delete(journal.bySender, sender)
+		return
+	}
+
+	journal.bySender[sender] = replacementsOfSender[1:]
+}
+
+func (journal *replacementsJournal) getForSender(sender string) []*TxReplacement {
+	journal.mutex.RLock()
+	defer journal.mutex.RUnlock()
+
+	replacementsOfSender := journal.bySender[sender]
+	result := make([]*TxReplacement, len(replacementsOfSender))
+	copy(result, replacementsOfSender)
+
+	return result
+}
+
+func (journal *replacementsJournal) clear() {
+	journal.mutex.Lock()
+	journal.bySender = make(map[string][]*TxReplacement)
+	journal.order = make([]*TxReplacement, 0)
+	journal.mutex.Unlock()
+}
@@ -0,0 +1,44 @@
+package txcache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func createReplacementToTest(sender string, nonce uint64) *TxReplacement {
+	return &TxReplacement{
+		Sender: []byte(sender),
+		Nonce:  nonce,
+	}
+}
+
+func TestReplacementsJournal_AddShouldForgetTheOldestReplacements(t *testing.T) {
+	t.Parallel()
+
+	journal := newReplacementsJournal(3)
+	journal.add(createReplacementToTest("alice", 1))
+	journal.add(createReplacementToTest("bob", 1))
+	journal.add(createReplacementToTest("alice", 2))
+	require.Len(t, journal.getForSender("alice"), 2)
+	require.Len(t, journal.getForSender("bob"), 1)
+
+	journal.add(createReplacementToTest("alice", 3))
+	require.Equal(t, []*TxReplacement{createReplacementToTest("alice", 2), createReplacementToTest("alice", 3)}, journal.getForSender("alice"))
+	require.Len(t, journal.getForSender("bob"), 1)
+
+	journal.add(createReplacementToTest("carol", 1))
+	require.Empty(t, journal.getForSender("bob"))
+	require.Len(t, journal.bySender, 2)
+}
+
+func TestReplacementsJournal_Clear(t *testing.T) {
+	t.Parallel()
+
+	journal := newReplacementsJournal(3)
+	journal.add(createReplacementToTest("alice", 1))
+	journal.clear()
+
+	require.Empty(t, journal.getForSender("alice"))
+	require.Empty(t, journal.order)
+}
@@ -0,0 +1,71 @@
+package txcache
+
+// NonceGap holds an interval of nonces missing from the list of transactions of a sender
+// From - first missing nonce
+// To   - last missing nonce
+type NonceGap struct {
+	From uint64
+	To   uint64
+}
+
+// SenderStats holds the details of the list of transactions of a sender, as seen by the selection and the eviction
+// processes. These are useful when diagnosing why the transactions of a sender are not selected
+type SenderStats struct {
+	Score               uint32
+	NumTxs              uint64
+	NumBytes            uint64
+	TotalGas            uint64
+	AccountNonce        uint64
+	AccountNonceKnown   bool
+	NumFailedSelections uint64
+	IsInGracePeriod     bool
+	IsSweepable         bool
+	NonceGaps           []NonceGap
+}
+
+func (listForSender *txListForSender) getStats() *SenderStats {
+	listForSender.mutex.RLock()
+	defer listForSender.mutex.RUnlock()
+
+	return &SenderStats{
+		Score:               listForSender.getLastComputedScore(),
+		NumTxs:              listForSender.countTx(),
+		NumBytes:            listForSender.totalBytes.GetUint64(),
+		TotalGas:            listForSender.totalGas.GetUint64(),
+		AccountNonce:        listForSender.accountNonce.Get(),
+		AccountNonceKnown:   listForSender.accountNonceKnown.IsSet(),
+		NumFailedSelections: listForSender.numFailedSelections.GetUint64(),
+		IsInGracePeriod:     listForSender.isInGracePeriod(),
+		IsSweepable:         listForSender.sweepable.IsSet(),
+		NonceGaps:           listForSender.getNonceGaps(),
+	}
+}
+
+// getNonceGaps returns the nonce gaps of the list, including the initial gap (with respect to the account nonce), if known
+// This function should only be used in critical section (listForSender.mutex)
+func (listForSender *txListForSender) getNonceGaps() []NonceGap {
+	gaps := make([]NonceGap, 0)
+
+	firstTx := listForSender.getLowestNonceTx()
+	if firstTx == nil {
+		return gaps
+	}
+
+	accountNonce := listForSender.accountNonce.Get()
+	firstTxNonce := firstTx.Tx.GetNonce()
+	if listForSender.accountNonceKnown.IsSet() && firstTxNonce > accountNonce {
+		gaps = append(gaps, NonceGap{From: accountNonce, To: firstTxNonce - 1})
+	}
+
+	previousNonce := firstTxNonce
+	for element := listForSender.items.Front().Next(); element != nil; element = element.Next() {
+		nonce := element.Value.(*WrappedTransaction).Tx.GetNonce()
+		if nonce > previousNonce+1 {
+			gaps = append(gaps, NonceGap{From: previousNonce + 1, To: nonce - 1})
+		}
+
+		previousNonce = nonce
+	}
+
+	return gaps
+}
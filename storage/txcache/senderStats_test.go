@@ -0,0 +1,38 @@
+package txcache
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestListForSender_getStats(t *testing.T) {
+	t.Parallel()
+
+	list := newUnconstrainedListToTest()
+	txGasHandler, txFeeHelper := dummyParams()
+
+	stats := list.getStats()
+	require.Equal(t, uint64(0), stats.NumTxs)
+	require.Empty(t, stats.NonceGaps)
+
+	list.AddTx(createTx([]byte("a"), ".", 5), txGasHandler, txFeeHelper)
+	list.AddTx(createTx([]byte("b"), ".", 6), txGasHandler, txFeeHelper)
+	list.AddTx(createTx([]byte("c"), ".", 9), txGasHandler, txFeeHelper)
+	list.AddTx(createTx([]byte("d"), ".", 11), txGasHandler, txFeeHelper)
+
+	stats = list.getStats()
+	require.Equal(t, uint64(4), stats.NumTxs)
+	require.False(t, stats.AccountNonceKnown)
+	require.Equal(t, []NonceGap{{From: 7, To: 8}, {From: 10, To: 10}}, stats.NonceGaps)
+
+	// the initial gap is known once the account nonce is notified
+	list.notifyAccountNonce(2)
+	_ = list.verifyInitialGapOnSelectionStart()
+
+	stats = list.getStats()
+	require.True(t, stats.AccountNonceKnown)
+	require.Equal(t, uint64(2), stats.AccountNonce)
+	require.Equal(t, uint64(1), stats.NumFailedSelections)
+	require.Equal(t, []NonceGap{{From: 2, To: 4}, {From: 7, To: 8}, {From: 10, To: 10}}, stats.NonceGaps)
+}
@@ -116,3 +116,23 @@ func TestSweeping_SweepSweepable(t *testing.T) {
 	require.Equal(t, uint64(1), cache.CountTx())
 	require.Equal(t, uint64(1), cache.CountSenders())
 }
+
+func TestSweeping_SweepSweepableShouldNotifyTheEvictedTxs(t *testing.T) {
+	cache := newUnconstrainedCacheToTest()
+
+	evictedTxs := make([][]byte, 0)
+	cache.RegisterOnTxsEvicted(func(txHashes [][]byte) {
+		evictedTxs = append(evictedTxs, txHashes...)
+	})
+
+	cache.AddTx(createTx([]byte("alice-42"), "alice", 42))
+	cache.AddTx(createTx([]byte("alice-43"), "alice", 43))
+	cache.AddTx(createTx([]byte("bob-42"), "bob", 42))
+
+	cache.sweepingListOfSenders = []*txListForSender{
+		cache.getListForSender("alice"),
+	}
+	cache.sweepSweepable()
+
+	require.ElementsMatch(t, [][]byte{[]byte("alice-42"), []byte("alice-43")}, evictedTxs)
+}
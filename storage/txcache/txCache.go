@@ -1,6 +1,7 @@
 package txcache
 
 import (
+	"math/big"
 	"sync"
 
 	"github.com/ElrondNetwork/elrond-go-core/core/atomic"
@@ -19,6 +20,8 @@ type TxCache struct {
 	evictionMutex             sync.Mutex
 	evictionJournal           evictionJournal
 	evictionSnapshotOfSenders []*txListForSender
+	evictionEvictedSenders    [][]byte
+	evictionRounds            *evictionRoundsHistory
 	isEvictionInProgress      atomic.Flag
 	numSendersSelected        atomic.Counter
 	numSendersWithInitialGap  atomic.Counter
@@ -27,6 +30,11 @@ type TxCache struct {
 	sweepingMutex             sync.Mutex
 	sweepingListOfSenders     []*txListForSender
 	mutTxOperation            sync.Mutex
+	replacements              *replacementsJournal
+	mutOnReplacedHandlers     sync.RWMutex
+	onReplacedHandlers        []func(replacement *TxReplacement)
+	mutOnEvictedHandlers      sync.RWMutex
+	onEvictedHandlers         []func(txHashes [][]byte)
 }
 
 // NewTxCache creates a new transaction cache
@@ -54,6 +62,8 @@ func NewTxCache(config ConfigSourceMe, txGasHandler TxGasHandler) (*TxCache, err
 		txByHash:        newTxByHashMap(numChunks),
 		config:          config,
 		evictionJournal: evictionJournal{},
+		replacements:    newReplacementsJournal(maxNumReplacementsInJournal),
+		evictionRounds:  newEvictionRoundsHistory(maxNumEvictionRoundsInHistory),
 	}
 
 	txCache.initSweepable()
@@ -62,6 +72,8 @@ func NewTxCache(config ConfigSourceMe, txGasHandler TxGasHandler) (*TxCache, err
 
 // AddTx adds a transaction in the cache
 // Eviction happens if maximum capacity is reached
+// If the replacement by fee is enabled, a transaction having the same sender and nonce as the ones already in the cache
+// replaces them, as long as its gas price is high enough. Otherwise, the transaction is not added
 func (cache *TxCache) AddTx(tx *WrappedTransaction) (ok bool, added bool) {
 	if tx == nil || check.IfNil(tx.Tx) {
 		return false, false
@@ -72,6 +84,12 @@ func (cache *TxCache) AddTx(tx *WrappedTransaction) (ok bool, added bool) {
 	}
 
 	cache.mutTxOperation.Lock()
+	replacements, canBeAdded := cache.replaceTxsWithSameNonce(tx)
+	if !canBeAdded {
+		cache.mutTxOperation.Unlock()
+		return true, false
+	}
+
 	addedInByHash := cache.txByHash.addTx(tx)
 	addedInBySender, evicted := cache.txListBySender.addTx(tx)
 	cache.mutTxOperation.Unlock()
@@ -87,13 +105,142 @@ func (cache *TxCache) AddTx(tx *WrappedTransaction) (ok bool, added bool) {
 	if len(evicted) > 0 {
 		cache.monitorEvictionWrtSenderLimit(tx.Tx.GetSndAddr(), evicted)
 		cache.txByHash.RemoveTxsBulk(evicted)
+		cache.notifyEvicted(evicted)
 	}
 
+	cache.notifyReplacements(replacements)
+
 	// The return value "added" is true even if transaction added, but then removed due to limits be sender.
 	// This it to ensure that onAdded() notification is triggered.
 	return true, addedInByHash || addedInBySender
 }
 
+// replaceTxsWithSameNonce removes the transactions of the same sender having the same nonce as the provided one, if the
+// provided transaction pays a gas price high enough to replace all of them
+// This function should only be used in critical section (cache.mutTxOperation)
+func (cache *TxCache) replaceTxsWithSameNonce(tx *WrappedTransaction) ([]*TxReplacement, bool) {
+	if !cache.config.isReplaceByFeeEnabled() {
+		return nil, true
+	}
+
+	_, alreadyInCache := cache.txByHash.getTx(string(tx.TxHash))
+	if alreadyInCache {
+		return nil, true
+	}
+
+	listForSender, ok := cache.txListBySender.getListForSender(string(tx.Tx.GetSndAddr()))
+	if !ok {
+		return nil, true
+	}
+
+	txsWithSameNonce := listForSender.getTxsWithNonce(tx.Tx.GetNonce())
+	for _, existingTx := range txsWithSameNonce {
+		if !cache.isGasPriceBumpSufficient(existingTx, tx) {
+			log.Trace("TxCache.replaceTxsWithSameNonce(): gas price bump too low",
+				"name", cache.name,
+				"tx", tx.TxHash,
+				"existing tx", existingTx.TxHash,
+				"gas price", tx.Tx.GetGasPrice(),
+				"existing gas price", existingTx.Tx.GetGasPrice(),
+			)
+			return nil, false
+		}
+	}
+
+	replacements := make([]*TxReplacement, 0, len(txsWithSameNonce))
+	for _, existingTx := range txsWithSameNonce {
+		cache.txByHash.removeTx(string(existingTx.TxHash))
+		cache.txListBySender.removeTx(existingTx)
+
+		replacements = append(replacements, &TxReplacement{
+			Sender:              tx.Tx.GetSndAddr(),
+			Nonce:               tx.Tx.GetNonce(),
+			ReplacedTxHash:      existingTx.TxHash,
+			ReplacedGasPrice:    existingTx.Tx.GetGasPrice(),
+			ReplacementTxHash:   tx.TxHash,
+			ReplacementGasPrice: tx.Tx.GetGasPrice(),
+		})
+	}
+
+	return replacements, true
+}
+
+func (cache *TxCache) isGasPriceBumpSufficient(existingTx *WrappedTransaction, incomingTx *WrappedTransaction) bool {
+	bumpPercent := uint64(cache.config.ReplaceByFeeMinGasPriceBumpPercent)
+
+	// incoming gas price * 100 >= existing gas price * (100 + bump), computed without overflows
+	incomingValue := big.NewInt(0).Mul(big.NewInt(0).SetUint64(incomingTx.Tx.GetGasPrice()), big.NewInt(100))
+	minValue := big.NewInt(0).Mul(big.NewInt(0).SetUint64(existingTx.Tx.GetGasPrice()), big.NewInt(0).SetUint64(100+bumpPercent))
+
+	return incomingValue.Cmp(minValue) >= 0
+}
+
+func (cache *TxCache) notifyReplacements(replacements []*TxReplacement) {
+	if len(replacements) == 0 {
+		return
+	}
+
+	cache.mutOnReplacedHandlers.RLock()
+	defer cache.mutOnReplacedHandlers.RUnlock()
+
+	for _, replacement := range replacements {
+		log.Debug("TxCache: transaction replaced by fee",
+			"name", cache.name,
+			"nonce", replacement.Nonce,
+			"replaced tx", replacement.ReplacedTxHash,
+			"replacement tx", replacement.ReplacementTxHash,
+		)
+
+		cache.replacements.add(replacement)
+		for _, handler := range cache.onReplacedHandlers {
+			handler(replacement)
+		}
+	}
+}
+
+// RegisterOnTxReplaced registers a handler to be called when a transaction is replaced by fee
+func (cache *TxCache) RegisterOnTxReplaced(handler func(replacement *TxReplacement)) {
+	if handler == nil {
+		log.Error("attempt to register a nil handler", "name", cache.name)
+		return
+	}
+
+	cache.mutOnReplacedHandlers.Lock()
+	cache.onReplacedHandlers = append(cache.onReplacedHandlers, handler)
+	cache.mutOnReplacedHandlers.Unlock()
+}
+
+func (cache *TxCache) notifyEvicted(txHashes [][]byte) {
+	if len(txHashes) == 0 {
+		return
+	}
+
+	cache.mutOnEvictedHandlers.RLock()
+	defer cache.mutOnEvictedHandlers.RUnlock()
+
+	for _, handler := range cache.onEvictedHandlers {
+		handler(txHashes)
+	}
+}
+
+// RegisterOnTxsEvicted registers a handler to be called when transactions are removed by the cache itself, either
+// by eviction or by sweeping
+func (cache *TxCache) RegisterOnTxsEvicted(handler func(txHashes [][]byte)) {
+	if handler == nil {
+		log.Error("attempt to register a nil handler", "name", cache.name)
+		return
+	}
+
+	cache.mutOnEvictedHandlers.Lock()
+	cache.onEvictedHandlers = append(cache.onEvictedHandlers, handler)
+	cache.mutOnEvictedHandlers.Unlock()
+}
+
+// GetReplacementsForSender returns the most recent replacements by fee of the transactions of the provided sender
+func (cache *TxCache) GetReplacementsForSender(sender string) []*TxReplacement {
+	return cache.replacements.getForSender(sender)
+}
+
 // GetByTxHash gets the transaction by hash
 func (cache *TxCache) GetByTxHash(txHash []byte) (*WrappedTransaction, bool) {
 	tx, ok := cache.txByHash.getTx(string(txHash))
@@ -234,11 +381,29 @@ func (cache *TxCache) GetTransactionsPoolForSender(sender string) []*WrappedTran
 	return wrappedTxs
 }
 
+// GetSenderStats returns the statistics of the list of transactions of the provided sender, as used by the selection
+// and the eviction processes
+func (cache *TxCache) GetSenderStats(sender string) (*SenderStats, bool) {
+	listForSender, ok := cache.txListBySender.getListForSender(sender)
+	if !ok {
+		return nil, false
+	}
+
+	return listForSender.getStats(), true
+}
+
+// GetEvictionRounds returns the most recent eviction rounds, from the oldest to the most recent one
+func (cache *TxCache) GetEvictionRounds() []*EvictionRound {
+	return cache.evictionRounds.getAll()
+}
+
 // Clear clears the cache
 func (cache *TxCache) Clear() {
 	cache.mutTxOperation.Lock()
 	cache.txListBySender.clear()
 	cache.txByHash.clear()
+	cache.replacements.clear()
+	cache.evictionRounds.clear()
 	cache.mutTxOperation.Unlock()
 }
 
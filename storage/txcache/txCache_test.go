@@ -56,6 +56,10 @@ func Test_NewTxCache(t *testing.T) {
 	badConfig.CountPerSenderThreshold = 0
 	requireErrorOnNewTxCache(t, badConfig, storage.ErrInvalidConfig, "config.CountPerSenderThreshold", txGasHandler)
 
+	badConfig = config
+	badConfig.ReplaceByFeeMinGasPriceBumpPercent = replaceByFeeMinGasPriceBumpPercentUpperBound + 1
+	requireErrorOnNewTxCache(t, badConfig, storage.ErrInvalidConfig, "config.ReplaceByFeeMinGasPriceBumpPercent", txGasHandler)
+
 	badConfig = config
 	cache, err = NewTxCache(config, nil)
 	require.Nil(t, cache)
@@ -297,6 +301,23 @@ func Test_GetTransactionsPoolForSender(t *testing.T) {
 	require.Equal(t, expectedTxs, txs)
 }
 
+func Test_GetSenderStats(t *testing.T) {
+	cache := newUnconstrainedCacheToTest()
+
+	stats, ok := cache.GetSenderStats("alice")
+	require.False(t, ok)
+	require.Nil(t, stats)
+
+	cache.AddTx(createTx([]byte("hash-1"), "alice", 1))
+	cache.AddTx(createTx([]byte("hash-2"), "alice", 3))
+
+	stats, ok = cache.GetSenderStats("alice")
+	require.True(t, ok)
+	require.Equal(t, uint64(2), stats.NumTxs)
+	require.Equal(t, cache.getScoreOfSender("alice"), stats.Score)
+	require.Equal(t, []NonceGap{{From: 2, To: 2}}, stats.NonceGaps)
+}
+
 func Test_SelectTransactions_Dummy(t *testing.T) {
 	cache := newUnconstrainedCacheToTest()
 
@@ -625,6 +646,95 @@ func TestTxCache_NoCriticalInconsistency_WhenConcurrentAdditionsAndRemovals(t *t
 	cache.Clear()
 }
 
+func Test_AddTx_ReplaceByFee(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled should keep the transactions with the same nonce", func(t *testing.T) {
+		t.Parallel()
+
+		cache := newUnconstrainedCacheToTest()
+		cache.AddTx(createTxWithParams([]byte("hash-alice-1"), "alice", 1, 128, 50000, oneBillion))
+		cache.AddTx(createTxWithParams([]byte("hash-alice-1-bis"), "alice", 1, 128, 50000, 2*oneBillion))
+
+		require.Equal(t, []string{"hash-alice-1-bis", "hash-alice-1"}, cache.getHashesForSender("alice"))
+		require.Empty(t, cache.GetReplacementsForSender("alice"))
+	})
+	t.Run("gas price bump too low should not add", func(t *testing.T) {
+		t.Parallel()
+
+		cache := newCacheWithReplaceByFeeToTest(10)
+		cache.AddTx(createTxWithParams([]byte("hash-alice-1"), "alice", 1, 128, 50000, oneBillion))
+		ok, added := cache.AddTx(createTxWithParams([]byte("hash-alice-1-bis"), "alice", 1, 128, 50000, oneBillion*109/100))
+
+		require.True(t, ok)
+		require.False(t, added)
+		require.Equal(t, []string{"hash-alice-1"}, cache.getHashesForSender("alice"))
+		_, found := cache.GetByTxHash([]byte("hash-alice-1-bis"))
+		require.False(t, found)
+		require.True(t, cache.areInternalMapsConsistent())
+	})
+	t.Run("gas price bump high enough should replace", func(t *testing.T) {
+		t.Parallel()
+
+		cache := newCacheWithReplaceByFeeToTest(10)
+		var notifiedReplacement *TxReplacement
+		cache.RegisterOnTxReplaced(func(replacement *TxReplacement) {
+			notifiedReplacement = replacement
+		})
+
+		cache.AddTx(createTxWithParams([]byte("hash-alice-1"), "alice", 1, 128, 50000, oneBillion))
+		cache.AddTx(createTxWithParams([]byte("hash-alice-2"), "alice", 2, 128, 50000, oneBillion))
+		ok, added := cache.AddTx(createTxWithParams([]byte("hash-alice-1-bis"), "alice", 1, 128, 50000, oneBillion*110/100))
+
+		require.True(t, ok)
+		require.True(t, added)
+		require.Equal(t, []string{"hash-alice-1-bis", "hash-alice-2"}, cache.getHashesForSender("alice"))
+		_, found := cache.GetByTxHash([]byte("hash-alice-1"))
+		require.False(t, found)
+		require.True(t, cache.areInternalMapsConsistent())
+
+		expectedReplacement := &TxReplacement{
+			Sender:              []byte("alice"),
+			Nonce:               1,
+			ReplacedTxHash:      []byte("hash-alice-1"),
+			ReplacedGasPrice:    oneBillion,
+			ReplacementTxHash:   []byte("hash-alice-1-bis"),
+			ReplacementGasPrice: oneBillion * 110 / 100,
+		}
+		require.Equal(t, expectedReplacement, notifiedReplacement)
+		require.Equal(t, []*TxReplacement{expectedReplacement}, cache.GetReplacementsForSender("alice"))
+		require.Empty(t, cache.GetReplacementsForSender("bob"))
+	})
+	t.Run("same transaction should not replace itself", func(t *testing.T) {
+		t.Parallel()
+
+		cache := newCacheWithReplaceByFeeToTest(10)
+		cache.AddTx(createTxWithParams([]byte("hash-alice-1"), "alice", 1, 128, 50000, oneBillion))
+		ok, added := cache.AddTx(createTxWithParams([]byte("hash-alice-1"), "alice", 1, 128, 50000, oneBillion))
+
+		require.True(t, ok)
+		require.False(t, added)
+		require.Equal(t, []string{"hash-alice-1"}, cache.getHashesForSender("alice"))
+		require.Empty(t, cache.GetReplacementsForSender("alice"))
+	})
+}
+
+func newCacheWithReplaceByFeeToTest(minGasPriceBumpPercent uint32) *TxCache {
+	txGasHandler, _ := dummyParams()
+	cache, err := NewTxCache(ConfigSourceMe{
+		Name:                               "test",
+		NumChunks:                          16,
+		NumBytesPerSenderThreshold:         maxNumBytesPerSenderUpperBound,
+		CountPerSenderThreshold:            math.MaxUint32,
+		ReplaceByFeeMinGasPriceBumpPercent: minGasPriceBumpPercent,
+	}, txGasHandler)
+	if err != nil {
+		panic(fmt.Sprintf("newCacheWithReplaceByFeeToTest(): %s", err))
+	}
+
+	return cache
+}
+
 func newUnconstrainedCacheToTest() *TxCache {
 	txGasHandler, _ := dummyParams()
 	cache, err := NewTxCache(ConfigSourceMe{
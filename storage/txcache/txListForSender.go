@@ -209,6 +209,29 @@ func (listForSender *txListForSender) findListElementWithTx(txToFind *WrappedTra
 	return nil
 }
 
+// getTxsWithNonce returns the transactions of the list having the provided nonce
+func (listForSender *txListForSender) getTxsWithNonce(nonce uint64) []*WrappedTransaction {
+	listForSender.mutex.RLock()
+	defer listForSender.mutex.RUnlock()
+
+	result := make([]*WrappedTransaction, 0)
+	for element := listForSender.items.Front(); element != nil; element = element.Next() {
+		value := element.Value.(*WrappedTransaction)
+		txNonce := value.Tx.GetNonce()
+
+		if txNonce == nonce {
+			result = append(result, value)
+		}
+
+		// Optimization: stop search at this point, since the list is sorted by nonce
+		if txNonce > nonce {
+			break
+		}
+	}
+
+	return result
+}
+
 // IsEmpty checks whether the list is empty
 func (listForSender *txListForSender) IsEmpty() bool {
 	return listForSender.countTxWithLock() == 0
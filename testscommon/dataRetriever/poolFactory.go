@@ -46,6 +46,7 @@ func CreateTxPool(numShards uint32, selfShard uint32) (dataRetriever.ShardedData
 				MinimumGasPrice:      200000000000,
 				GasProcessingDivisor: 100,
 			},
+			Journal: txpool.NewDisabledTxPoolJournal(),
 		},
 	)
 }
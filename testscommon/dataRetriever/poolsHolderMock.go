@@ -52,6 +52,7 @@ func NewPoolsHolderMock() *PoolsHolderMock {
 				GasProcessingDivisor: 100,
 			},
 			NumberOfShards: 1,
+			Journal:        txpool.NewDisabledTxPoolJournal(),
 		},
 	)
 	panicIfError("NewPoolsHolderMock", err)
@@ -7,17 +7,24 @@ import (
 	"github.com/ElrondNetwork/elrond-go-core/data/block"
 	"github.com/ElrondNetwork/elrond-go/dblookupext"
 	"github.com/ElrondNetwork/elrond-go/dblookupext/esdtSupply"
+	"github.com/ElrondNetwork/elrond-go/state"
 )
 
 // HistoryRepositoryStub -
 type HistoryRepositoryStub struct {
-	RecordBlockCalled                  func(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler, scrsPool map[string]data.TransactionHandler, receipts map[string]data.TransactionHandler, createdIntraMiniBlocks []*block.MiniBlock, logs []*data.LogData) error
-	OnNotarizedBlocksCalled            func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)
-	GetMiniblockMetadataByTxHashCalled func(hash []byte) (*dblookupext.MiniblockMetadata, error)
-	GetEpochByHashCalled               func(hash []byte) (uint32, error)
-	GetEventsHashesByTxHashCalled      func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error)
-	GetESDTSupplyCalled                func(token string) (*esdtSupply.SupplyESDT, error)
-	IsEnabledCalled                    func() bool
+	RecordBlockCalled                   func(blockHeaderHash []byte, blockHeader data.HeaderHandler, blockBody data.BodyHandler, txsPool map[string]data.TransactionHandler, scrsPool map[string]data.TransactionHandler, receipts map[string]data.TransactionHandler, createdIntraMiniBlocks []*block.MiniBlock, logs []*data.LogData) error
+	OnNotarizedBlocksCalled             func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)
+	RegisterRecordedBlocksHandlerCalled func(handler func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte))
+	GetMiniblockMetadataByTxHashCalled  func(hash []byte) (*dblookupext.MiniblockMetadata, error)
+	GetEpochByHashCalled                func(hash []byte) (uint32, error)
+	GetEventsHashesByTxHashCalled       func(hash []byte, epoch uint32) (*dblookupext.ResultsHashesByTxHash, error)
+	GetESDTSupplyCalled                 func(token string) (*esdtSupply.SupplyESDT, error)
+	GetESDTSupplyHistoryCalled          func(token string, fromEpoch uint32, toEpoch uint32) ([]*esdtSupply.SupplyESDTInEpoch, error)
+	GetTxHashesByAddressCalled          func(address []byte, from uint32, size uint32, ascending bool) ([]*dblookupext.TxHashByAddress, error)
+	GetLogEventsByBlockNonceCalled      func(blockNonce uint64) (*dblookupext.LogEventsByBlock, error)
+	AddStateChangesCalled               func(txHash []byte, stateChanges []*state.AccountStateChanges)
+	GetStateDiffByTxHashCalled          func(txHash []byte, epoch uint32) ([]*state.AccountStateChanges, error)
+	IsEnabledCalled                     func() bool
 }
 
 // RecordBlock -
@@ -25,13 +32,14 @@ func (hp *HistoryRepositoryStub) RecordBlock(
 	blockHeaderHash []byte,
 	blockHeader data.HeaderHandler,
 	blockBody data.BodyHandler,
+	txsPool map[string]data.TransactionHandler,
 	scrsPool map[string]data.TransactionHandler,
 	receipts map[string]data.TransactionHandler,
 	createdIntraMiniBlocks []*block.MiniBlock,
 	logs []*data.LogData,
 ) error {
 	if hp.RecordBlockCalled != nil {
-		return hp.RecordBlockCalled(blockHeaderHash, blockHeader, blockBody, scrsPool, receipts, createdIntraMiniBlocks, logs)
+		return hp.RecordBlockCalled(blockHeaderHash, blockHeader, blockBody, txsPool, scrsPool, receipts, createdIntraMiniBlocks, logs)
 	}
 	return nil
 }
@@ -43,6 +51,13 @@ func (hp *HistoryRepositoryStub) OnNotarizedBlocks(shardID uint32, headers []dat
 	}
 }
 
+// RegisterRecordedBlocksHandler -
+func (hp *HistoryRepositoryStub) RegisterRecordedBlocksHandler(handler func(shardID uint32, headers []data.HeaderHandler, headersHashes [][]byte)) {
+	if hp.RegisterRecordedBlocksHandlerCalled != nil {
+		hp.RegisterRecordedBlocksHandlerCalled(handler)
+	}
+}
+
 // GetMiniblockMetadataByTxHash -
 func (hp *HistoryRepositoryStub) GetMiniblockMetadataByTxHash(hash []byte) (*dblookupext.MiniblockMetadata, error) {
 	if hp.GetMiniblockMetadataByTxHashCalled != nil {
@@ -89,6 +104,49 @@ func (hp *HistoryRepositoryStub) GetESDTSupply(token string) (*esdtSupply.Supply
 	return nil, nil
 }
 
+// GetESDTSupplyHistory -
+func (hp *HistoryRepositoryStub) GetESDTSupplyHistory(token string, fromEpoch uint32, toEpoch uint32) ([]*esdtSupply.SupplyESDTInEpoch, error) {
+	if hp.GetESDTSupplyHistoryCalled != nil {
+		return hp.GetESDTSupplyHistoryCalled(token, fromEpoch, toEpoch)
+	}
+
+	return nil, nil
+}
+
+// GetTxHashesByAddress -
+func (hp *HistoryRepositoryStub) GetTxHashesByAddress(address []byte, from uint32, size uint32, ascending bool) ([]*dblookupext.TxHashByAddress, error) {
+	if hp.GetTxHashesByAddressCalled != nil {
+		return hp.GetTxHashesByAddressCalled(address, from, size, ascending)
+	}
+
+	return nil, nil
+}
+
+// GetLogEventsByBlockNonce -
+func (hp *HistoryRepositoryStub) GetLogEventsByBlockNonce(blockNonce uint64) (*dblookupext.LogEventsByBlock, error) {
+	if hp.GetLogEventsByBlockNonceCalled != nil {
+		return hp.GetLogEventsByBlockNonceCalled(blockNonce)
+	}
+
+	return nil, nil
+}
+
+// AddStateChanges -
+func (hp *HistoryRepositoryStub) AddStateChanges(txHash []byte, stateChanges []*state.AccountStateChanges) {
+	if hp.AddStateChangesCalled != nil {
+		hp.AddStateChangesCalled(txHash, stateChanges)
+	}
+}
+
+// GetStateDiffByTxHash -
+func (hp *HistoryRepositoryStub) GetStateDiffByTxHash(txHash []byte, epoch uint32) ([]*state.AccountStateChanges, error) {
+	if hp.GetStateDiffByTxHashCalled != nil {
+		return hp.GetStateDiffByTxHashCalled(txHash, epoch)
+	}
+
+	return nil, nil
+}
+
 // IsInterfaceNil -
 func (hp *HistoryRepositoryStub) IsInterfaceNil() bool {
 	return hp == nil
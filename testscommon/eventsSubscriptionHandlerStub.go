@@ -0,0 +1,32 @@
+package testscommon
+
+import "github.com/ElrondNetwork/elrond-go/outport"
+
+// EventsSubscriptionHandlerStub -
+type EventsSubscriptionHandlerStub struct {
+	ServeSubscriberCalled func(conn outport.WSConnection) error
+	IsEnabledCalled       func() bool
+}
+
+// ServeSubscriber -
+func (stub *EventsSubscriptionHandlerStub) ServeSubscriber(conn outport.WSConnection) error {
+	if stub.ServeSubscriberCalled != nil {
+		return stub.ServeSubscriberCalled(conn)
+	}
+
+	return nil
+}
+
+// IsEnabled -
+func (stub *EventsSubscriptionHandlerStub) IsEnabled() bool {
+	if stub.IsEnabledCalled != nil {
+		return stub.IsEnabledCalled()
+	}
+
+	return false
+}
+
+// IsInterfaceNil -
+func (stub *EventsSubscriptionHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
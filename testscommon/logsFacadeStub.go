@@ -2,12 +2,14 @@ package testscommon
 
 import (
 	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+	"github.com/ElrondNetwork/elrond-go/common"
 )
 
 // LogsFacadeStub -
 type LogsFacadeStub struct {
 	GetLogCalled                    func(txHash []byte, epoch uint32) (*transaction.ApiLogs, error)
 	IncludeLogsInTransactionsCalled func(txs []*transaction.ApiTransactionResult, logsKeys [][]byte, epoch uint32) error
+	GetLogEventsCalled              func(options common.LogEventsQueryOptions) (*common.LogEventsApiResponse, error)
 }
 
 // GetLog -
@@ -28,6 +30,15 @@ func (stub *LogsFacadeStub) IncludeLogsInTransactions(txs []*transaction.ApiTran
 	return nil
 }
 
+// GetLogEvents -
+func (stub *LogsFacadeStub) GetLogEvents(options common.LogEventsQueryOptions) (*common.LogEventsApiResponse, error) {
+	if stub.GetLogEventsCalled != nil {
+		return stub.GetLogEventsCalled(options)
+	}
+
+	return nil, nil
+}
+
 // IsInterfaceNil -
 func (stub *LogsFacadeStub) IsInterfaceNil() bool {
 	return stub == nil
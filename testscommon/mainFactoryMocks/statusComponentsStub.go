@@ -8,9 +8,10 @@ import (
 
 // StatusComponentsStub -
 type StatusComponentsStub struct {
-	Outport              outport.OutportHandler
-	SoftwareVersionCheck statistics.SoftwareVersionChecker
-	AppStatusHandler     core.AppStatusHandler
+	Outport                  outport.OutportHandler
+	EventsSubscriptionHandle outport.EventsSubscriptionHandler
+	SoftwareVersionCheck     statistics.SoftwareVersionChecker
+	AppStatusHandler         core.AppStatusHandler
 }
 
 // Create -
@@ -33,6 +34,11 @@ func (scs *StatusComponentsStub) OutportHandler() outport.OutportHandler {
 	return scs.Outport
 }
 
+// EventsSubscriptionHandler -
+func (scs *StatusComponentsStub) EventsSubscriptionHandler() outport.EventsSubscriptionHandler {
+	return scs.EventsSubscriptionHandle
+}
+
 // SoftwareVersionChecker -
 func (scs *StatusComponentsStub) SoftwareVersionChecker() statistics.SoftwareVersionChecker {
 	return scs.SoftwareVersionCheck
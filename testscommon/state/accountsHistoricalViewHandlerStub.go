@@ -0,0 +1,32 @@
+package state
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data/api"
+)
+
+// AccountsHistoricalViewHandlerStub -
+type AccountsHistoricalViewHandlerStub struct {
+	SetHistoricalViewCalled   func(options api.AccountQueryOptions) error
+	ResetHistoricalViewCalled func()
+}
+
+// SetHistoricalView -
+func (stub *AccountsHistoricalViewHandlerStub) SetHistoricalView(options api.AccountQueryOptions) error {
+	if stub.SetHistoricalViewCalled != nil {
+		return stub.SetHistoricalViewCalled(options)
+	}
+
+	return nil
+}
+
+// ResetHistoricalView -
+func (stub *AccountsHistoricalViewHandlerStub) ResetHistoricalView() {
+	if stub.ResetHistoricalViewCalled != nil {
+		stub.ResetHistoricalViewCalled()
+	}
+}
+
+// IsInterfaceNil -
+func (stub *AccountsHistoricalViewHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
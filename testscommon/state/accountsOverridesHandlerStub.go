@@ -0,0 +1,32 @@
+package state
+
+import (
+	"github.com/ElrondNetwork/elrond-go/state"
+)
+
+// AccountsOverridesHandlerStub -
+type AccountsOverridesHandlerStub struct {
+	SetOverridesCalled   func(overrides map[string]*state.AccountOverride) error
+	ResetOverridesCalled func()
+}
+
+// SetOverrides -
+func (stub *AccountsOverridesHandlerStub) SetOverrides(overrides map[string]*state.AccountOverride) error {
+	if stub.SetOverridesCalled != nil {
+		return stub.SetOverridesCalled(overrides)
+	}
+
+	return nil
+}
+
+// ResetOverrides -
+func (stub *AccountsOverridesHandlerStub) ResetOverrides() {
+	if stub.ResetOverridesCalled != nil {
+		stub.ResetOverridesCalled()
+	}
+}
+
+// IsInterfaceNil -
+func (stub *AccountsOverridesHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
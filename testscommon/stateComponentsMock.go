@@ -7,12 +7,13 @@ import (
 
 // StateComponentsMock -
 type StateComponentsMock struct {
-	PeersAcc        state.AccountsAdapter
-	Accounts        state.AccountsAdapter
-	AccountsAPI     state.AccountsAdapter
-	AccountsRepo    state.AccountsRepository
-	Tries           common.TriesHolder
-	StorageManagers map[string]common.StorageManager
+	PeersAcc         state.AccountsAdapter
+	Accounts         state.AccountsAdapter
+	AccountsAPI      state.AccountsAdapter
+	AccountsRepo     state.AccountsRepository
+	ChangesCollector state.StateChangesCollector
+	Tries            common.TriesHolder
+	StorageManagers  map[string]common.StorageManager
 }
 
 // Create -
@@ -50,6 +51,11 @@ func (scm *StateComponentsMock) AccountsRepository() state.AccountsRepository {
 	return scm.AccountsRepo
 }
 
+// StateChangesCollector -
+func (scm *StateComponentsMock) StateChangesCollector() state.StateChangesCollector {
+	return scm.ChangesCollector
+}
+
 // TriesContainer -
 func (scm *StateComponentsMock) TriesContainer() common.TriesHolder {
 	return scm.Tries
@@ -12,26 +12,27 @@ var errNotImplemented = errors.New("not implemented")
 
 // TrieStub -
 type TrieStub struct {
-	GetCalled                         func(key []byte) ([]byte, error)
-	UpdateCalled                      func(key, value []byte) error
-	DeleteCalled                      func(key []byte) error
-	RootCalled                        func() ([]byte, error)
-	CommitCalled                      func() error
-	RecreateCalled                    func(root []byte) (common.Trie, error)
-	RecreateFromEpochCalled           func(options common.RootHashHolder) (common.Trie, error)
-	GetObsoleteHashesCalled           func() [][]byte
-	AppendToOldHashesCalled           func([][]byte)
-	GetSerializedNodesCalled          func([]byte, uint64) ([][]byte, uint64, error)
-	GetAllHashesCalled                func() ([][]byte, error)
-	GetAllLeavesOnChannelCalled       func(leavesChannel chan core.KeyValueHolder, ctx context.Context, rootHash []byte) error
-	GetProofCalled                    func(key []byte) ([][]byte, []byte, error)
-	VerifyProofCalled                 func(rootHash []byte, key []byte, proof [][]byte) (bool, error)
-	GetStorageManagerCalled           func() common.StorageManager
-	GetSerializedNodeCalled           func(bytes []byte) ([]byte, error)
-	GetNumNodesCalled                 func() common.NumNodesDTO
-	GetOldRootCalled                  func() []byte
-	MarkStorerAsSyncedAndActiveCalled func()
-	CloseCalled                       func() error
+	GetCalled                          func(key []byte) ([]byte, error)
+	UpdateCalled                       func(key, value []byte) error
+	DeleteCalled                       func(key []byte) error
+	RootCalled                         func() ([]byte, error)
+	CommitCalled                       func() error
+	RecreateCalled                     func(root []byte) (common.Trie, error)
+	RecreateFromEpochCalled            func(options common.RootHashHolder) (common.Trie, error)
+	GetObsoleteHashesCalled            func() [][]byte
+	AppendToOldHashesCalled            func([][]byte)
+	GetSerializedNodesCalled           func([]byte, uint64) ([][]byte, uint64, error)
+	GetAllHashesCalled                 func() ([][]byte, error)
+	GetAllLeavesOnChannelCalled        func(leavesChannel chan core.KeyValueHolder, ctx context.Context, rootHash []byte) error
+	GetAllLeavesFromKeyOnChannelCalled func(leavesChannel chan core.KeyValueHolder, ctx context.Context, rootHash []byte, startKey []byte) error
+	GetProofCalled                     func(key []byte) ([][]byte, []byte, error)
+	VerifyProofCalled                  func(rootHash []byte, key []byte, proof [][]byte) (bool, error)
+	GetStorageManagerCalled            func() common.StorageManager
+	GetSerializedNodeCalled            func(bytes []byte) ([]byte, error)
+	GetNumNodesCalled                  func() common.NumNodesDTO
+	GetOldRootCalled                   func() []byte
+	MarkStorerAsSyncedAndActiveCalled  func()
+	CloseCalled                        func() error
 }
 
 // GetStorageManager -
@@ -70,6 +71,15 @@ func (ts *TrieStub) GetAllLeavesOnChannel(leavesChannel chan core.KeyValueHolder
 	return nil
 }
 
+// GetAllLeavesFromKeyOnChannel -
+func (ts *TrieStub) GetAllLeavesFromKeyOnChannel(leavesChannel chan core.KeyValueHolder, ctx context.Context, rootHash []byte, startKey []byte) error {
+	if ts.GetAllLeavesFromKeyOnChannelCalled != nil {
+		return ts.GetAllLeavesFromKeyOnChannelCalled(leavesChannel, ctx, rootHash, startKey)
+	}
+
+	return nil
+}
+
 // Get -
 func (ts *TrieStub) Get(key []byte) ([]byte, error) {
 	if ts.GetCalled != nil {
@@ -0,0 +1,23 @@
+package txsSenderMock
+
+import (
+	"github.com/ElrondNetwork/elrond-go-core/data/transaction"
+)
+
+// TransactionValidatorStub -
+type TransactionValidatorStub struct {
+	ValidateTransactionCalled func(tx *transaction.Transaction) error
+}
+
+// ValidateTransaction -
+func (tvs *TransactionValidatorStub) ValidateTransaction(tx *transaction.Transaction) error {
+	if tvs.ValidateTransactionCalled != nil {
+		return tvs.ValidateTransactionCalled(tx)
+	}
+	return nil
+}
+
+// IsInterfaceNil -
+func (tvs *TransactionValidatorStub) IsInterfaceNil() bool {
+	return tvs == nil
+}
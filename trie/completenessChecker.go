@@ -0,0 +1,141 @@
+package trie
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/hashing"
+	"github.com/ElrondNetwork/elrond-go-core/marshal"
+)
+
+// ArgsCheckCompleteness holds the arguments needed to check that all the nodes of a trie are stored
+type ArgsCheckCompleteness struct {
+	RootHash    []byte
+	GetNode     func(hash []byte) ([]byte, error)
+	Marshalizer marshal.Marshalizer
+	Hasher      hashing.Hasher
+	LeafHandler func(key []byte, value []byte)
+}
+
+// MissingNode describes a trie node which could not be loaded
+type MissingNode struct {
+	Hash       []byte
+	ParentHash []byte
+	Err        error
+}
+
+// CompletenessResult holds the outcome of a trie completeness check
+type CompletenessResult struct {
+	NumNodes     uint64
+	NumLeaves    uint64
+	NumBytes     uint64
+	MissingNodes []*MissingNode
+}
+
+type nodeToCheck struct {
+	hash       []byte
+	parentHash []byte
+	keyPrefix  []byte
+}
+
+// CheckCompleteness walks all the nodes of the trie having the provided root hash, as read by the provided getter.
+// Unlike the trie iteration, the walk does not stop at the first node which is missing or cannot be decoded: all of
+// them are reported, together with the hash of the node referencing them. The leaves are passed to the leaf handler,
+// if one is provided, with their full key
+func CheckCompleteness(args ArgsCheckCompleteness) (*CompletenessResult, error) {
+	if args.GetNode == nil {
+		return nil, ErrNilNodeGetter
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	result := &CompletenessResult{
+		MissingNodes: make([]*MissingNode, 0),
+	}
+	if len(args.RootHash) == 0 {
+		return result, nil
+	}
+
+	stack := []*nodeToCheck{{hash: args.RootHash}}
+	for len(stack) > 0 {
+		current := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		n, numBytes, err := loadAndCheckNode(current.hash, args)
+		if err != nil {
+			result.MissingNodes = append(result.MissingNodes, &MissingNode{
+				Hash:       current.hash,
+				ParentHash: current.parentHash,
+				Err:        err,
+			})
+			continue
+		}
+
+		result.NumNodes++
+		result.NumBytes += uint64(numBytes)
+
+		switch typedNode := n.(type) {
+		case *branchNode:
+			for i := range typedNode.EncodedChildren {
+				if len(typedNode.EncodedChildren[i]) == 0 {
+					continue
+				}
+
+				stack = append(stack, &nodeToCheck{
+					hash:       typedNode.EncodedChildren[i],
+					parentHash: current.hash,
+					keyPrefix:  concat(current.keyPrefix, byte(i)),
+				})
+			}
+		case *extensionNode:
+			stack = append(stack, &nodeToCheck{
+				hash:       typedNode.EncodedChild,
+				parentHash: current.hash,
+				keyPrefix:  concat(current.keyPrefix, typedNode.Key...),
+			})
+		case *leafNode:
+			result.NumLeaves++
+			if args.LeafHandler == nil {
+				continue
+			}
+
+			key, errKey := hexToKeyBytes(concat(current.keyPrefix, typedNode.Key...))
+			if errKey != nil {
+				result.MissingNodes = append(result.MissingNodes, &MissingNode{
+					Hash:       current.hash,
+					ParentHash: current.parentHash,
+					Err:        errKey,
+				})
+				continue
+			}
+
+			args.LeafHandler(key, typedNode.Value)
+		}
+	}
+
+	return result, nil
+}
+
+func loadAndCheckNode(hash []byte, args ArgsCheckCompleteness) (node, int, error) {
+	encNode, err := args.GetNode(hash)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: %s", ErrNodeNotFound, err.Error())
+	}
+
+	computedHash := args.Hasher.Compute(string(encNode))
+	if !bytes.Equal(computedHash, hash) {
+		return nil, 0, ErrNodeHashMismatch
+	}
+
+	n, err := decodeNode(encNode, args.Marshalizer, args.Hasher)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return n, len(encNode), nil
+}
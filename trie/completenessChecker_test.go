@@ -0,0 +1,159 @@
+package trie_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/ElrondNetwork/elrond-go/testscommon"
+	"github.com/ElrondNetwork/elrond-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createArgsCheckCompleteness(numValues int) (trie.ArgsCheckCompleteness, [][]byte) {
+	tr, values := initTrieMultipleValues(numValues)
+	_ = tr.Commit()
+	rootHash, _ := tr.RootHash()
+
+	return trie.ArgsCheckCompleteness{
+		RootHash:    rootHash,
+		GetNode:     tr.GetSerializedNode,
+		Marshalizer: &testscommon.ProtobufMarshalizerMock{},
+		Hasher:      &testscommon.KeccakMock{},
+	}, values
+}
+
+func TestCheckCompleteness(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil node getter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createArgsCheckCompleteness(1)
+		args.GetNode = nil
+
+		result, err := trie.CheckCompleteness(args)
+		assert.Nil(t, result)
+		assert.Equal(t, trie.ErrNilNodeGetter, err)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createArgsCheckCompleteness(1)
+		args.Marshalizer = nil
+
+		result, err := trie.CheckCompleteness(args)
+		assert.Nil(t, result)
+		assert.Equal(t, trie.ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createArgsCheckCompleteness(1)
+		args.Hasher = nil
+
+		result, err := trie.CheckCompleteness(args)
+		assert.Nil(t, result)
+		assert.Equal(t, trie.ErrNilHasher, err)
+	})
+	t.Run("empty root hash should return an empty result", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createArgsCheckCompleteness(1)
+		args.RootHash = nil
+
+		result, err := trie.CheckCompleteness(args)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(0), result.NumNodes)
+		assert.Empty(t, result.MissingNodes)
+	})
+	t.Run("complete trie should pass all the leaves", func(t *testing.T) {
+		t.Parallel()
+
+		numValues := 100
+		args, values := createArgsCheckCompleteness(numValues)
+		leaves := make(map[string][]byte)
+		args.LeafHandler = func(key []byte, value []byte) {
+			leaves[string(key)] = value
+		}
+
+		result, err := trie.CheckCompleteness(args)
+		require.Nil(t, err)
+		assert.Empty(t, result.MissingNodes)
+		assert.Equal(t, uint64(numValues), result.NumLeaves)
+		assert.True(t, result.NumNodes > result.NumLeaves)
+		assert.True(t, result.NumBytes > 0)
+		require.Equal(t, numValues, len(leaves))
+		for _, value := range values {
+			assert.Equal(t, value, leaves[string(value)])
+		}
+	})
+	t.Run("missing node should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createArgsCheckCompleteness(100)
+		completeResult, err := trie.CheckCompleteness(args)
+		require.Nil(t, err)
+
+		missingHash := getNonRootHash(t, args)
+		getNode := args.GetNode
+		args.GetNode = func(hash []byte) ([]byte, error) {
+			if bytes.Equal(hash, missingHash) {
+				return nil, errors.New("missing")
+			}
+
+			return getNode(hash)
+		}
+
+		result, err := trie.CheckCompleteness(args)
+		require.Nil(t, err)
+		require.Equal(t, 1, len(result.MissingNodes))
+		assert.Equal(t, missingHash, result.MissingNodes[0].Hash)
+		assert.Equal(t, args.RootHash, result.MissingNodes[0].ParentHash)
+		assert.True(t, errors.Is(result.MissingNodes[0].Err, trie.ErrNodeNotFound))
+		assert.True(t, result.NumNodes < completeResult.NumNodes)
+		assert.True(t, result.NumLeaves < completeResult.NumLeaves)
+	})
+	t.Run("corrupted node should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		args, _ := createArgsCheckCompleteness(100)
+
+		corruptedHash := getNonRootHash(t, args)
+		getNode := args.GetNode
+		args.GetNode = func(hash []byte) ([]byte, error) {
+			encNode, errGet := getNode(hash)
+			if bytes.Equal(hash, corruptedHash) {
+				return append(encNode, 0), errGet
+			}
+
+			return encNode, errGet
+		}
+
+		result, err := trie.CheckCompleteness(args)
+		require.Nil(t, err)
+		require.Equal(t, 1, len(result.MissingNodes))
+		assert.Equal(t, corruptedHash, result.MissingNodes[0].Hash)
+		assert.Equal(t, trie.ErrNodeHashMismatch, result.MissingNodes[0].Err)
+	})
+}
+
+// getNonRootHash returns the hash of the first child of the root node loaded by the completeness check
+func getNonRootHash(t *testing.T, args trie.ArgsCheckCompleteness) []byte {
+	var childHash []byte
+	getNode := args.GetNode
+	args.GetNode = func(hash []byte) ([]byte, error) {
+		if !bytes.Equal(hash, args.RootHash) && childHash == nil {
+			childHash = hash
+		}
+
+		return getNode(hash)
+	}
+
+	_, err := trie.CheckCompleteness(args)
+	require.Nil(t, err)
+	require.NotNil(t, childHash)
+
+	return childHash
+}
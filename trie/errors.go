@@ -108,3 +108,9 @@ var ErrNilIdleNodeProvider = errors.New("nil idle node provider")
 
 // ErrNilRootHashHolder signals that a nil root hash holder was provided
 var ErrNilRootHashHolder = errors.New("nil root hash holder provided")
+
+// ErrNodeHashMismatch signals that the hash of a stored trie node does not match the key it was stored under
+var ErrNodeHashMismatch = errors.New("the hash of the node does not match its key")
+
+// ErrNilNodeGetter signals that a nil node getter was provided
+var ErrNilNodeGetter = errors.New("nil node getter")
@@ -1,7 +1,11 @@
 package trie
 
 import (
+	"bytes"
+
+	"github.com/ElrondNetwork/elrond-go-core/core"
 	"github.com/ElrondNetwork/elrond-go-core/core/check"
+	"github.com/ElrondNetwork/elrond-go-core/core/keyValStorage"
 	"github.com/ElrondNetwork/elrond-go/common"
 )
 
@@ -79,3 +83,119 @@ func (it *iterator) GetHash() ([]byte, error) {
 
 	return it.currentNode.getHash(), nil
 }
+
+type nodeWithPath struct {
+	node node
+	path []byte
+}
+
+// leavesIterator walks the trie leaves in the trie order, starting from the first leaf whose hex key is not lower than
+// the start key. The subtrees holding only lower keys are skipped without being loaded from the storage
+type leavesIterator struct {
+	nextNodes []nodeWithPath
+	startKey  []byte
+	db        common.DBWriteCacher
+}
+
+func newLeavesIterator(root node, startKey []byte, db common.DBWriteCacher) *leavesIterator {
+	it := &leavesIterator{
+		nextNodes: make([]nodeWithPath, 0),
+		db:        db,
+	}
+	if len(startKey) > 0 {
+		it.startKey = keyBytesToHex(startKey)
+	}
+	if root != nil {
+		it.nextNodes = append(it.nextNodes, nodeWithPath{node: root, path: []byte{}})
+	}
+
+	return it
+}
+
+// next returns the next trie leaf or nil if all the leaves were walked
+func (it *leavesIterator) next() (core.KeyValueHolder, error) {
+	for len(it.nextNodes) > 0 {
+		lastIndex := len(it.nextNodes) - 1
+		current := it.nextNodes[lastIndex]
+		it.nextNodes = it.nextNodes[:lastIndex]
+
+		err := current.node.isEmptyOrNil()
+		if err != nil {
+			return nil, ErrNilNode
+		}
+
+		switch n := current.node.(type) {
+		case *leafNode:
+			leafPath := concat(current.path, n.Key...)
+			if it.isBeforeStartKey(leafPath) {
+				continue
+			}
+
+			nodeKey, errConvert := hexToKeyBytes(leafPath)
+			if errConvert != nil {
+				return nil, errConvert
+			}
+
+			return keyValStorage.NewKeyValStorage(nodeKey, n.Value), nil
+		case *extensionNode:
+			err = it.pushExtensionChild(n, current.path)
+		case *branchNode:
+			err = it.pushBranchChildren(n, current.path)
+		default:
+			err = ErrWrongTypeAssertion
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+func (it *leavesIterator) pushExtensionChild(en *extensionNode, path []byte) error {
+	childPath := concat(path, en.Key...)
+	if it.isBeforeStartKey(childPath) {
+		return nil
+	}
+
+	err := resolveIfCollapsed(en, 0, it.db)
+	if err != nil {
+		return err
+	}
+
+	it.nextNodes = append(it.nextNodes, nodeWithPath{node: en.child, path: childPath})
+
+	return nil
+}
+
+func (it *leavesIterator) pushBranchChildren(bn *branchNode, path []byte) error {
+	// the children are pushed in the reverse order, so that the lowest one is the next to be walked
+	for i := len(bn.children) - 1; i >= 0; i-- {
+		childPath := concat(path, byte(i))
+		if it.isBeforeStartKey(childPath) {
+			continue
+		}
+
+		err := resolveIfCollapsed(bn, byte(i), it.db)
+		if err != nil {
+			return err
+		}
+		if bn.children[i] == nil {
+			continue
+		}
+
+		it.nextNodes = append(it.nextNodes, nodeWithPath{node: bn.children[i], path: childPath})
+	}
+
+	return nil
+}
+
+// isBeforeStartKey returns true if all the keys found under the provided path are lower than the start key
+func (it *leavesIterator) isBeforeStartKey(path []byte) bool {
+	commonLength := len(path)
+	if len(it.startKey) < commonLength {
+		commonLength = len(it.startKey)
+	}
+
+	return bytes.Compare(path[:commonLength], it.startKey[:commonLength]) < 0
+}
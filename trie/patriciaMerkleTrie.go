@@ -492,6 +492,73 @@ func (tr *patriciaMerkleTrie) GetAllLeavesOnChannel(
 	return nil
 }
 
+// GetAllLeavesFromKeyOnChannel adds to the given channel, in the trie order, the trie leaves starting with the one
+// having the provided key. The trie is seeked to the start key, so the nodes holding only the lower keys are not loaded
+func (tr *patriciaMerkleTrie) GetAllLeavesFromKeyOnChannel(
+	leavesChannel chan core.KeyValueHolder,
+	ctx context.Context,
+	rootHash []byte,
+	startKey []byte,
+) error {
+	tr.mutOperation.RLock()
+	newTrie, err := tr.recreate(rootHash, tr.trieStorage)
+	if err != nil {
+		tr.mutOperation.RUnlock()
+		close(leavesChannel)
+		return err
+	}
+
+	if check.IfNil(newTrie) || newTrie.root == nil {
+		tr.mutOperation.RUnlock()
+		close(leavesChannel)
+		return nil
+	}
+
+	tr.trieStorage.EnterPruningBufferingMode()
+	tr.mutOperation.RUnlock()
+
+	go func() {
+		err = tr.sendLeavesOnChannel(newLeavesIterator(newTrie.root, startKey, tr.trieStorage), leavesChannel, ctx)
+		if err != nil {
+			log.Error("could not get the trie leaves starting with key: ", "error", err)
+		}
+
+		tr.mutOperation.Lock()
+		tr.trieStorage.ExitPruningBufferingMode()
+		tr.mutOperation.Unlock()
+
+		close(leavesChannel)
+	}()
+
+	return nil
+}
+
+func (tr *patriciaMerkleTrie) sendLeavesOnChannel(
+	it *leavesIterator,
+	leavesChannel chan core.KeyValueHolder,
+	ctx context.Context,
+) error {
+	for {
+		trieLeaf, err := it.next()
+		if err != nil {
+			return err
+		}
+		if trieLeaf == nil {
+			return nil
+		}
+
+		select {
+		case <-tr.chanClose:
+			log.Trace("patriciaMerkleTrie.sendLeavesOnChannel interrupted")
+			return nil
+		case <-ctx.Done():
+			log.Trace("patriciaMerkleTrie.sendLeavesOnChannel context done")
+			return nil
+		case leavesChannel <- trieLeaf:
+		}
+	}
+}
+
 // GetAllHashes returns all the hashes from the trie
 func (tr *patriciaMerkleTrie) GetAllHashes() ([][]byte, error) {
 	tr.mutOperation.Lock()
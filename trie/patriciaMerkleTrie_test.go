@@ -576,6 +576,61 @@ func TestPatriciaMerkleTrie_GetAllLeavesOnChannel(t *testing.T) {
 	assert.Equal(t, leaves, recovered)
 }
 
+func TestPatriciaMerkleTrie_GetAllLeavesFromKeyOnChannelEmptyTrie(t *testing.T) {
+	t.Parallel()
+
+	tr := emptyTrie()
+
+	leavesChannel := make(chan core.KeyValueHolder, common.TrieLeavesChannelDefaultCapacity)
+	err := tr.GetAllLeavesFromKeyOnChannel(leavesChannel, context.Background(), []byte{}, []byte("dog"))
+	assert.Nil(t, err)
+
+	_, ok := <-leavesChannel
+	assert.False(t, ok)
+}
+
+func TestPatriciaMerkleTrie_GetAllLeavesFromKeyOnChannel(t *testing.T) {
+	t.Parallel()
+
+	tr, _ := initTrieMultipleValues(100)
+	_ = tr.Update([]byte("doe"), []byte("reindeer"))
+	_ = tr.Update([]byte("dog"), []byte("puppy"))
+	_ = tr.Update([]byte("doge"), []byte("coin"))
+	_ = tr.Update([]byte("ddog"), []byte("cat"))
+	_ = tr.Commit()
+	rootHash, _ := tr.RootHash()
+
+	getLeaves := func(startKey []byte) []core.KeyValueHolder {
+		leavesChannel := make(chan core.KeyValueHolder, common.TrieLeavesChannelDefaultCapacity)
+		err := tr.GetAllLeavesFromKeyOnChannel(leavesChannel, context.Background(), rootHash, startKey)
+		require.Nil(t, err)
+
+		leaves := make([]core.KeyValueHolder, 0)
+		for leaf := range leavesChannel {
+			leaves = append(leaves, leaf)
+		}
+
+		return leaves
+	}
+
+	allLeaves := getLeaves(nil)
+	require.Equal(t, 104, len(allLeaves))
+
+	leavesChannel := make(chan core.KeyValueHolder, common.TrieLeavesChannelDefaultCapacity)
+	_ = tr.GetAllLeavesOnChannel(leavesChannel, context.Background(), rootHash)
+	index := 0
+	for leaf := range leavesChannel {
+		assert.Equal(t, leaf.Key(), allLeaves[index].Key())
+		assert.Equal(t, leaf.Value(), allLeaves[index].Value())
+		index++
+	}
+	assert.Equal(t, len(allLeaves), index)
+
+	for i, leaf := range allLeaves {
+		assert.Equal(t, allLeaves[i:], getLeaves(leaf.Key()))
+	}
+}
+
 func TestPatriciaMerkleTree_Prove(t *testing.T) {
 	t.Parallel()
 